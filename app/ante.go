@@ -16,6 +16,8 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 
+	smartaccountante "github.com/osmosis-labs/osmosis/v21/x/smart-account/ante"
+	smartaccountkeeper "github.com/osmosis-labs/osmosis/v21/x/smart-account/keeper"
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v21/x/txfees/keeper"
 	txfeestypes "github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 )
@@ -33,13 +35,16 @@ func NewAnteHandler(
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 	channelKeeper *ibckeeper.Keeper,
+	smartAccountKeeper *smartaccountkeeper.Keeper,
 ) sdk.AnteHandler {
 	mempoolFeeOptions := txfeestypes.NewMempoolFeeOptions(appOpts)
 	mempoolFeeDecorator := txfeeskeeper.NewMempoolFeeDecorator(*txFeesKeeper, mempoolFeeOptions)
 	sendblockOptions := osmoante.NewSendBlockOptions(appOpts)
 	sendblockDecorator := osmoante.NewSendBlockDecorator(sendblockOptions)
+	msgGasLimitDecorator := txfeeskeeper.NewMsgGasLimitDecorator(*txFeesKeeper)
 	deductFeeDecorator := txfeeskeeper.NewDeductFeeDecorator(*txFeesKeeper, ak, bankKeeper, nil)
-	return sdk.ChainAnteDecorators(
+	// The classic flow verifies the signatures of the signers with the public keys of their accounts.
+	classicFlow := sdk.ChainAnteDecorators(
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(wasmConfig.SimulationGasLimit),
 		wasmkeeper.NewCountTXDecorator(txCounterStoreKey),
@@ -48,6 +53,7 @@ func NewAnteHandler(
 		// Use Mempool Fee Decorator from our txfees module instead of default one from auth
 		// https://github.com/cosmos/cosmos-sdk/blob/master/x/auth/middleware/fee.go#L34
 		mempoolFeeDecorator,
+		msgGasLimitDecorator,
 		sendblockDecorator,
 		ante.NewValidateBasicDecorator(),
 		ante.TxTimeoutHeightDecorator{},
@@ -61,4 +67,30 @@ func NewAnteHandler(
 		ante.NewIncrementSequenceDecorator(ak),
 		ibcante.NewRedundantRelayDecorator(channelKeeper),
 	)
+
+	// The authenticator flow authenticates every message with the authenticator of its signer selected in the tx,
+	// replacing the signature verification decorators of the classic flow.
+	authenticatorFlow := sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		wasmkeeper.NewLimitSimulationGasDecorator(wasmConfig.SimulationGasLimit),
+		wasmkeeper.NewCountTXDecorator(txCounterStoreKey),
+		ante.NewExtensionOptionsDecorator(nil),
+		v9.MsgFilterDecorator{},
+		mempoolFeeDecorator,
+		msgGasLimitDecorator,
+		sendblockDecorator,
+		ante.NewValidateBasicDecorator(),
+		ante.TxTimeoutHeightDecorator{},
+		ante.NewValidateMemoDecorator(ak),
+		ante.NewConsumeGasForTxSizeDecorator(ak),
+		// The authenticator decorator deducts the fees once the fee payer is authenticated.
+		smartaccountante.NewAuthenticatorDecorator(*smartAccountKeeper, ak, signModeHandler, deductFeeDecorator),
+		ante.NewIncrementSequenceDecorator(ak),
+		ibcante.NewRedundantRelayDecorator(channelKeeper),
+	)
+
+	// The circuit breaker routes txs through the authenticator flow only while smart accounts are active.
+	return sdk.ChainAnteDecorators(
+		smartaccountante.NewCircuitBreakerDecorator(*smartAccountKeeper, authenticatorFlow, classicFlow),
+	)
 }
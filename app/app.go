@@ -83,6 +83,7 @@ import (
 	v19 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v19"
 	v20 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v20"
 	v21 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v21"
+	v22 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v22"
 	v3 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v3"
 	v4 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v4"
 	v5 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v5"
@@ -130,7 +131,7 @@ var (
 
 	_ runtime.AppI = (*OsmosisApp)(nil)
 
-	Upgrades = []upgrades.Upgrade{v4.Upgrade, v5.Upgrade, v7.Upgrade, v9.Upgrade, v11.Upgrade, v12.Upgrade, v13.Upgrade, v14.Upgrade, v15.Upgrade, v16.Upgrade, v17.Upgrade, v18.Upgrade, v19.Upgrade, v20.Upgrade, v21.Upgrade}
+	Upgrades = []upgrades.Upgrade{v4.Upgrade, v5.Upgrade, v7.Upgrade, v9.Upgrade, v11.Upgrade, v12.Upgrade, v13.Upgrade, v14.Upgrade, v15.Upgrade, v16.Upgrade, v17.Upgrade, v18.Upgrade, v19.Upgrade, v20.Upgrade, v21.Upgrade, v22.Upgrade}
 	Forks    = []upgrades.Fork{v3.Fork, v6.Fork, v8.Fork, v10.Fork}
 )
 
@@ -290,7 +291,10 @@ func NewOsmosisApp(
 
 	app.mm.RegisterInvariants(app.CrisisKeeper)
 
-	app.configurator = module.NewConfigurator(app.AppCodec(), app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// Module query services are registered through queryLimitsServer, so that the gRPC server of the node
+	// enforces the query limits configured in app.toml.
+	queryServer := queryLimitsServer{Server: app.GRPCQueryRouter(), opts: NewQueryLimitOptions(appOpts)}
+	app.configurator = module.NewConfigurator(app.AppCodec(), app.MsgServiceRouter(), queryServer)
 	app.mm.RegisterServices(app.configurator)
 
 	app.setupUpgradeHandlers()
@@ -340,13 +344,14 @@ func NewOsmosisApp(
 		ante.DefaultSigVerificationGasConsumer,
 		encodingConfig.TxConfig.SignModeHandler(),
 		app.IBCKeeper,
+		app.SmartAccountKeeper,
 	)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(anteHandler)
-	app.SetPostHandler(NewPostHandler(app.ProtoRevKeeper))
+	app.SetPostHandler(NewPostHandler(app.ProtoRevKeeper, app.SmartAccountKeeper))
 	app.SetEndBlocker(app.EndBlocker)
 
 	// Register snapshot extensions to enable state-sync for wasm.
@@ -42,6 +42,8 @@ import (
 	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
 	"github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool"
 	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/types"
+	denomalias "github.com/osmosis-labs/osmosis/v21/x/denom-alias"
+	denomaliastypes "github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
 	downtimedetector "github.com/osmosis-labs/osmosis/v21/x/downtime-detector"
 	downtimetypes "github.com/osmosis-labs/osmosis/v21/x/downtime-detector/types"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm"
@@ -92,6 +94,9 @@ import (
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v21/x/pool-incentives/types"
 	protorevkeeper "github.com/osmosis-labs/osmosis/v21/x/protorev/keeper"
 	protorevtypes "github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+	smartaccountauthenticator "github.com/osmosis-labs/osmosis/v21/x/smart-account/authenticator"
+	smartaccountkeeper "github.com/osmosis-labs/osmosis/v21/x/smart-account/keeper"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
 	"github.com/osmosis-labs/osmosis/v21/x/superfluid"
 	superfluidkeeper "github.com/osmosis-labs/osmosis/v21/x/superfluid/keeper"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
@@ -143,6 +148,7 @@ type AppKeepers struct {
 	ICAHostKeeper                *icahostkeeper.Keeper
 	ICQKeeper                    *icqkeeper.Keeper
 	TransferKeeper               *ibctransferkeeper.Keeper
+	DenomAliasKeeper             *denomalias.Keeper
 	EvidenceKeeper               *evidencekeeper.Keeper
 	GAMMKeeper                   *gammkeeper.Keeper
 	TwapKeeper                   *twap.Keeper
@@ -156,12 +162,14 @@ type AppKeepers struct {
 	SuperfluidKeeper             *superfluidkeeper.Keeper
 	GovKeeper                    *govkeeper.Keeper
 	WasmKeeper                   *wasmkeeper.Keeper
+	StargateWhitelistKeeper      *owasm.StargateWhitelistKeeper
 	ContractKeeper               *wasmkeeper.PermissionedKeeper
 	TokenFactoryKeeper           *tokenfactorykeeper.Keeper
 	PoolManagerKeeper            *poolmanager.Keeper
 	ValidatorSetPreferenceKeeper *valsetpref.Keeper
 	ConcentratedLiquidityKeeper  *concentratedliquidity.Keeper
 	CosmwasmPoolKeeper           *cosmwasmpool.Keeper
+	SmartAccountKeeper           *smartaccountkeeper.Keeper
 
 	IngestManager ingest.IngestManager
 
@@ -273,6 +281,11 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 
 	appKeepers.WireICS20PreWasmKeeper(appCodec, bApp, appKeepers.IBCHooksKeeper)
 
+	appKeepers.DenomAliasKeeper = denomalias.NewKeeper(
+		appKeepers.keys[denomaliastypes.StoreKey],
+		appKeepers.TransferKeeper,
+	)
+
 	icaHostKeeper := icahostkeeper.NewKeeper(
 		appCodec, appKeepers.keys[icahosttypes.StoreKey],
 		appKeepers.GetSubspace(icahosttypes.SubModuleName),
@@ -327,7 +340,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.keys[lockuptypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
-		appKeepers.DistrKeeper, appKeepers.GetSubspace(lockuptypes.ModuleName))
+		appKeepers.DistrKeeper, appKeepers.GetSubspace(lockuptypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	appKeepers.ConcentratedLiquidityKeeper = concentratedliquidity.NewKeeper(
 		appCodec,
@@ -341,6 +355,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.DistrKeeper,
 		appKeepers.ContractKeeper,
 		appKeepers.GetSubspace(concentratedliquiditytypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	gammKeeper := gammkeeper.NewKeeper(
@@ -351,7 +366,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper, appKeepers.DistrKeeper,
 		appKeepers.ConcentratedLiquidityKeeper,
 		appKeepers.PoolIncentivesKeeper,
-		appKeepers.IncentivesKeeper)
+		appKeepers.IncentivesKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	appKeepers.GAMMKeeper = &gammKeeper
 	appKeepers.ConcentratedLiquidityKeeper.SetGammKeeper(appKeepers.GAMMKeeper)
 
@@ -368,6 +384,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.DistrKeeper,
 		appKeepers.StakingKeeper,
 		appKeepers.ProtoRevKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.PoolManagerKeeper.SetStakingKeeper(appKeepers.StakingKeeper)
 	appKeepers.GAMMKeeper.SetPoolManager(appKeepers.PoolManagerKeeper)
@@ -379,14 +396,16 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.PoolManagerKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
-	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
+	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
 		appKeepers.GetSubspace(protorevtypes.ModuleName),
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
 		appKeepers.GAMMKeeper,
 		appKeepers.EpochsKeeper,
 		appKeepers.PoolManagerKeeper,
@@ -400,11 +419,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.keys[txfeestypes.StoreKey],
+		appKeepers.tkeys[txfeestypes.TransientStoreKey],
 		appKeepers.PoolManagerKeeper,
 		appKeepers.GAMMKeeper,
 		appKeepers.ProtoRevKeeper,
 		appKeepers.DistrKeeper,
 		dataDir,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.TxFeesKeeper = &txFeesKeeper
 	appKeepers.ProtoRevKeeper.SetTxFeesKeeper(appKeepers.TxFeesKeeper)
@@ -421,6 +442,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.ConcentratedLiquidityKeeper,
 		appKeepers.PoolManagerKeeper,
 		appKeepers.PoolIncentivesKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.ConcentratedLiquidityKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
 	appKeepers.GAMMKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
@@ -433,6 +455,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.DistrKeeper,
 		appKeepers.EpochsKeeper,
 		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.MintKeeper = &mintKeeper
 
@@ -475,14 +498,16 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.SuperfluidKeeper = superfluidkeeper.NewKeeper(
 		appKeepers.keys[superfluidtypes.StoreKey], appKeepers.GetSubspace(superfluidtypes.ModuleName),
 		*appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.StakingKeeper, appKeepers.DistrKeeper, appKeepers.EpochsKeeper, appKeepers.LockupKeeper, appKeepers.GAMMKeeper, appKeepers.IncentivesKeeper,
-		lockupkeeper.NewMsgServerImpl(appKeepers.LockupKeeper), appKeepers.ConcentratedLiquidityKeeper, appKeepers.PoolManagerKeeper, appKeepers.ValidatorSetPreferenceKeeper)
+		lockupkeeper.NewMsgServerImpl(appKeepers.LockupKeeper), appKeepers.ConcentratedLiquidityKeeper, appKeepers.PoolManagerKeeper, appKeepers.ValidatorSetPreferenceKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_4"
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(&appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper), wasmOpts...)
-	wasmOpts = append(owasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(&appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper, appKeepers.PoolManagerKeeper, appKeepers.TwapKeeper, appKeepers.ConcentratedLiquidityKeeper), wasmOpts...)
+	appKeepers.StargateWhitelistKeeper = owasm.NewStargateWhitelistKeeper(appKeepers.GetSubspace(owasm.ParamsSubspace))
+	wasmOpts = append(owasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec, appKeepers.StargateWhitelistKeeper), wasmOpts...)
 
 	wasmKeeper := wasmkeeper.NewKeeper(
 		appCodec,
@@ -518,6 +543,21 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// set token factory contract keeper
 	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.ContractKeeper)
 
+	// register the authenticators accounts can use, once the contract keeper is available for cosmwasm authenticators
+	authenticatorManager := smartaccountauthenticator.NewAuthenticatorManager()
+	authenticatorManager.RegisterAuthenticator(smartaccountauthenticator.NewSignatureVerification(appKeepers.AccountKeeper))
+	authenticatorManager.RegisterAuthenticator(smartaccountauthenticator.NewAllOf(authenticatorManager))
+	authenticatorManager.RegisterAuthenticator(smartaccountauthenticator.NewAnyOf(authenticatorManager))
+	authenticatorManager.RegisterAuthenticator(smartaccountauthenticator.NewSpendLimit(appKeepers.BankKeeper, appKeepers.tkeys[smartaccounttypes.TransientStoreKey]))
+	authenticatorManager.RegisterAuthenticator(smartaccountauthenticator.NewCosmwasmAuthenticator(appKeepers.ContractKeeper, appKeepers.WasmKeeper))
+
+	smartAccountKeeper := smartaccountkeeper.NewKeeper(
+		appKeepers.keys[smartaccounttypes.StoreKey],
+		authenticatorManager,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.SmartAccountKeeper = &smartAccountKeeper
+
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper))
 
@@ -539,7 +579,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(concentratedliquiditytypes.RouterKey, concentratedliquidity.NewConcentratedLiquidityProposalHandler(*appKeepers.ConcentratedLiquidityKeeper)).
 		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper)).
 		AddRoute(poolmanagertypes.RouterKey, poolmanager.NewPoolManagerProposalHandler(*appKeepers.PoolManagerKeeper)).
-		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper))
+		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper)).
+		AddRoute(denomaliastypes.RouterKey, denomalias.NewSetDenomAliasesProposalHandler(*appKeepers.DenomAliasKeeper))
 
 	govConfig := govtypes.DefaultConfig()
 	govKeeper := govkeeper.NewKeeper(
@@ -702,6 +743,7 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(poolmanagertypes.ModuleName)
 	paramsKeeper.Subspace(gammtypes.ModuleName)
 	paramsKeeper.Subspace(wasmtypes.ModuleName)
+	paramsKeeper.Subspace(owasm.ParamsSubspace).WithKeyTable(owasm.ParamKeyTable())
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 	paramsKeeper.Subspace(twaptypes.ModuleName)
 	paramsKeeper.Subspace(ibcratelimittypes.ModuleName)
@@ -781,6 +823,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.PoolManagerKeeper.EpochHooks(),
 		),
 	)
 
@@ -830,5 +873,7 @@ func KVStoreKeys() []string {
 		icqtypes.StoreKey,
 		packetforwardtypes.StoreKey,
 		cosmwasmpooltypes.StoreKey,
+		denomaliastypes.StoreKey,
+		smartaccounttypes.StoreKey,
 	}
 }
@@ -7,7 +7,9 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
 	twaptypes "github.com/osmosis-labs/osmosis/v21/x/twap/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 )
 
 // GenerateKeys generates new keys (KV Store, Transient store, and memory store).
@@ -17,7 +19,7 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 	appKeepers.keys = sdk.NewKVStoreKeys(KVStoreKeys()...)
 
 	// Define transient store keys
-	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, twaptypes.TransientStoreKey)
+	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, twaptypes.TransientStoreKey, txfeestypes.TransientStoreKey, smartaccounttypes.TransientStoreKey)
 
 	// MemKeys are for information that is stored only in RAM.
 	appKeepers.memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -38,6 +38,8 @@ import (
 	concentratedliquidity "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/clmodule"
 	cwpoolclient "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/client"
 	cosmwasmpoolmodule "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/module"
+	denomaliasclient "github.com/osmosis-labs/osmosis/v21/x/denom-alias/client"
+	denomaliasmodule "github.com/osmosis-labs/osmosis/v21/x/denom-alias/module"
 	downtimemodule "github.com/osmosis-labs/osmosis/v21/x/downtime-detector/module"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm"
 	gammclient "github.com/osmosis-labs/osmosis/v21/x/gamm/client"
@@ -51,6 +53,7 @@ import (
 	poolmanagerclient "github.com/osmosis-labs/osmosis/v21/x/poolmanager/client"
 	poolmanager "github.com/osmosis-labs/osmosis/v21/x/poolmanager/module"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev"
+	smartaccount "github.com/osmosis-labs/osmosis/v21/x/smart-account"
 	superfluid "github.com/osmosis-labs/osmosis/v21/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v21/x/superfluid/client"
 	"github.com/osmosis-labs/osmosis/v21/x/tokenfactory"
@@ -90,11 +93,13 @@ var AppModuleBasics = []module.AppModuleBasic{
 			gammclient.SetScalingFactorControllerProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.SetPoolsWithdrawOnlyModeProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
+			denomaliasclient.SubmitSetDenomAliasesProposalHandler,
 		},
 	),
 	params.AppModuleBasic{},
@@ -125,7 +130,9 @@ var AppModuleBasics = []module.AppModuleBasic{
 	ica.AppModuleBasic{},
 	ibc_hooks.AppModuleBasic{},
 	ibcratelimitmodule.AppModuleBasic{},
+	denomaliasmodule.AppModuleBasic{},
 	packetforward.AppModuleBasic{},
 	cosmwasmpoolmodule.AppModuleBasic{},
+	smartaccount.AppModuleBasic{},
 	tendermint.AppModuleBasic{},
 }
@@ -69,6 +69,8 @@ import (
 	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	cwpoolmodule "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/module"
 	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/types"
+	denomaliasmodule "github.com/osmosis-labs/osmosis/v21/x/denom-alias/module"
+	denomaliastypes "github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm"
 	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v21/x/ibc-rate-limit/ibcratelimitmodule"
@@ -85,6 +87,8 @@ import (
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev"
 	protorevtypes "github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+	smartaccount "github.com/osmosis-labs/osmosis/v21/x/smart-account"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
 	superfluid "github.com/osmosis-labs/osmosis/v21/x/superfluid"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
 	"github.com/osmosis-labs/osmosis/v21/x/tokenfactory"
@@ -125,8 +129,9 @@ var moduleAccountPermissions = map[string][]string{
 	wasmtypes.ModuleName:                          {authtypes.Burner},
 	tokenfactorytypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
 	valsetpreftypes.ModuleName:                    {authtypes.Staking},
-	poolmanagertypes.ModuleName:                   nil,
+	poolmanagertypes.ModuleName:                   {authtypes.Burner},
 	cosmwasmpooltypes.ModuleName:                  nil,
+	concentratedliquiditytypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
 }
 
 // appModules return modules to initialize module manager.
@@ -186,9 +191,11 @@ func appModules(
 		valsetprefmodule.NewAppModule(appCodec, *app.ValidatorSetPreferenceKeeper),
 		ibcratelimitmodule.NewAppModule(*app.RateLimitingICS4Wrapper),
 		ibc_hooks.NewAppModule(app.AccountKeeper, *app.IBCHooksKeeper),
+		denomaliasmodule.NewAppModule(*app.DenomAliasKeeper),
 		icq.NewAppModule(*app.AppKeepers.ICQKeeper, app.GetSubspace(icqtypes.ModuleName)),
 		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		cwpoolmodule.NewAppModule(appCodec, *app.CosmwasmPoolKeeper),
+		smartaccount.NewAppModule(*app.SmartAccountKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 	}
 }
@@ -254,6 +261,8 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		protorevtypes.ModuleName,
 		twaptypes.ModuleName,
 		txfeestypes.ModuleName,
+		// smart account before genutil, since the ante handler of the gentxs reads its params
+		smartaccounttypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		paramstypes.ModuleName,
@@ -278,6 +287,7 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		icqtypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		cosmwasmpooltypes.ModuleName,
+		denomaliastypes.ModuleName,
 	}
 }
 
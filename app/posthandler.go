@@ -4,9 +4,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	protorevkeeper "github.com/osmosis-labs/osmosis/v21/x/protorev/keeper"
+	smartaccountkeeper "github.com/osmosis-labs/osmosis/v21/x/smart-account/keeper"
+	smartaccountpost "github.com/osmosis-labs/osmosis/v21/x/smart-account/post"
 )
 
-func NewPostHandler(protoRevKeeper *protorevkeeper.Keeper) sdk.PostHandler {
+func NewPostHandler(protoRevKeeper *protorevkeeper.Keeper, smartAccountKeeper *smartaccountkeeper.Keeper) sdk.PostHandler {
+	authenticatorDecorator := smartaccountpost.NewAuthenticatorPostDecorator(*smartAccountKeeper)
 	protoRevDecorator := protorevkeeper.NewProtoRevDecorator(*protoRevKeeper)
-	return sdk.ChainPostDecorators(protoRevDecorator)
+	return sdk.ChainPostDecorators(authenticatorDecorator, protoRevDecorator)
 }
@@ -0,0 +1,171 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// If the query limits are not set in app.toml, e.g. for configs written by older node software,
+// no limit is enforced to preserve functionality.
+var (
+	DefaultMaxPageLimit     = uint64(0)
+	DefaultServiceGasLimits = map[string]uint64{}
+)
+
+// QueryLimitOptions are the limits enforced on the gRPC queries served by the node, configured
+// in the osmosis-query section of app.toml.
+// They only apply to the module queries received by the gRPC server of the node (and the REST server,
+// which forwards to it), never to queries made within the state machine such as cosmwasm stargate queries,
+// since the limits are node specific and must not affect consensus.
+type QueryLimitOptions struct {
+	// MaxPageLimit is the maximum page size of paginated queries, larger page requests are capped to it.
+	// Zero disables the cap.
+	MaxPageLimit uint64
+	// ServiceGasLimits are the gas limits of the queries of each gRPC service, keyed by full service name,
+	// e.g. "osmosis.concentratedliquidity.v1beta1.Query". Queries of other services are not gas limited.
+	ServiceGasLimits map[string]uint64
+}
+
+func NewDefaultQueryLimitOptions() QueryLimitOptions {
+	return QueryLimitOptions{
+		MaxPageLimit:     DefaultMaxPageLimit,
+		ServiceGasLimits: DefaultServiceGasLimits,
+	}
+}
+
+func NewQueryLimitOptions(opts servertypes.AppOptions) QueryLimitOptions {
+	return QueryLimitOptions{
+		MaxPageLimit:     parseMaxPageLimit(opts),
+		ServiceGasLimits: parseServiceGasLimits(opts),
+	}
+}
+
+func parseMaxPageLimit(opts servertypes.AppOptions) uint64 {
+	valueInterface := opts.Get("osmosis-query.max-page-limit")
+	if valueInterface == nil {
+		return DefaultMaxPageLimit
+	}
+	value, err := cast.ToUint64E(valueInterface)
+	if err != nil {
+		panic("invalidly configured osmosis-query.max-page-limit")
+	}
+	return value
+}
+
+// parseServiceGasLimits parses the service gas limits, configured as a list of "<service name>=<gas limit>".
+func parseServiceGasLimits(opts servertypes.AppOptions) map[string]uint64 {
+	valueInterface := opts.Get("osmosis-query.service-gas-limits")
+	if valueInterface == nil {
+		return DefaultServiceGasLimits
+	}
+	entries, err := cast.ToStringSliceE(valueInterface)
+	if err != nil {
+		panic("invalidly configured osmosis-query.service-gas-limits")
+	}
+
+	gasLimits := make(map[string]uint64, len(entries))
+	for _, entry := range entries {
+		serviceName, gasLimitStr, found := strings.Cut(entry, "=")
+		serviceName = strings.TrimSpace(serviceName)
+		if !found || serviceName == "" {
+			panic(fmt.Errorf("invalidly configured osmosis-query.service-gas-limits, entry %q is not of the form <service name>=<gas limit>", entry))
+		}
+		gasLimit, err := cast.ToUint64E(strings.TrimSpace(gasLimitStr))
+		if err != nil {
+			panic(fmt.Errorf("invalidly configured osmosis-query.service-gas-limits, entry %q, err= %v", entry, err))
+		}
+		gasLimits[serviceName] = gasLimit
+	}
+	return gasLimits
+}
+
+// queryLimitsServer wraps the gRPC query router, so that the queries of the module services registered with it
+// enforce the query limits when served by the gRPC server of the node.
+type queryLimitsServer struct {
+	gogogrpc.Server
+	opts QueryLimitOptions
+}
+
+var _ gogogrpc.Server = queryLimitsServer{}
+
+// RegisterService implements gogogrpc.Server, wrapping every method of the service so that the query limits
+// are enforced after the interceptor of the gRPC server has attached the sdk.Context of the query.
+// The query router calls the methods without interceptor when routing queries within the state machine
+// (and ABCI queries), in which case no limit is enforced.
+func (s queryLimitsServer) RegisterService(desc *grpc.ServiceDesc, handler interface{}) {
+	gasLimit := s.opts.ServiceGasLimits[desc.ServiceName]
+	if s.opts.MaxPageLimit == 0 && gasLimit == 0 {
+		s.Server.RegisterService(desc, handler)
+		return
+	}
+
+	limitsInterceptor := newQueryLimitsInterceptor(s.opts.MaxPageLimit, gasLimit)
+	newMethods := make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		methodHandler := method.Handler
+		newMethods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if interceptor == nil {
+					return methodHandler(srv, ctx, dec, nil)
+				}
+				return methodHandler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+					return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+						return limitsInterceptor(ctx, req, info, handler)
+					})
+				})
+			},
+		}
+	}
+
+	s.Server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: desc.ServiceName,
+		HandlerType: desc.HandlerType,
+		Methods:     newMethods,
+		Streams:     desc.Streams,
+		Metadata:    desc.Metadata,
+	}, handler)
+}
+
+// newQueryLimitsInterceptor returns an interceptor that caps the page size of paginated queries to maxPageLimit
+// and runs queries with a gas meter limited to gasLimit, returning a resource exhausted error if it runs out of gas.
+// A zero limit is not enforced.
+// CONTRACT: the sdk.Context of the query is attached to the context.
+func newQueryLimitsInterceptor(maxPageLimit, gasLimit uint64) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if paginatedReq, ok := req.(interface{ GetPagination() *query.PageRequest }); ok && maxPageLimit > 0 {
+			if pageReq := paginatedReq.GetPagination(); pageReq != nil && pageReq.Limit > maxPageLimit {
+				pageReq.Limit = maxPageLimit
+			}
+		}
+
+		if gasLimit == 0 {
+			return handler(ctx, req)
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		sdkCtx = sdkCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+		defer func() {
+			if r := recover(); r != nil {
+				outOfGasErr, ok := r.(storetypes.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+				resp, err = nil, status.Errorf(codes.ResourceExhausted, "query %s ran out of gas in %s, gas limit %d", info.FullMethod, outOfGasErr.Descriptor, gasLimit)
+			}
+		}()
+		return handler(context.WithValue(ctx, sdk.SdkContextKey, sdkCtx), req)
+	}
+}
@@ -0,0 +1,191 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} {
+	return m[key]
+}
+
+// capturingServer records the service descriptions registered with it.
+type capturingServer struct {
+	descs []*grpc.ServiceDesc
+}
+
+func (s *capturingServer) RegisterService(desc *grpc.ServiceDesc, _ interface{}) {
+	s.descs = append(s.descs, desc)
+}
+
+func TestNewQueryLimitOptions(t *testing.T) {
+	tests := map[string]struct {
+		opts          mapAppOptions
+		expectedOpts  QueryLimitOptions
+		expectedPanic bool
+	}{
+		"not configured": {
+			opts:         mapAppOptions{},
+			expectedOpts: NewDefaultQueryLimitOptions(),
+		},
+		"configured": {
+			opts: mapAppOptions{
+				"osmosis-query.max-page-limit":     "1000",
+				"osmosis-query.service-gas-limits": []interface{}{"osmosis.lockup.Query=100", " osmosis.incentives.Query = 200 "},
+			},
+			expectedOpts: QueryLimitOptions{
+				MaxPageLimit:     1000,
+				ServiceGasLimits: map[string]uint64{"osmosis.lockup.Query": 100, "osmosis.incentives.Query": 200},
+			},
+		},
+		"invalid max page limit": {
+			opts:          mapAppOptions{"osmosis-query.max-page-limit": "-1"},
+			expectedPanic: true,
+		},
+		"service gas limit without gas limit": {
+			opts:          mapAppOptions{"osmosis-query.service-gas-limits": []interface{}{"osmosis.lockup.Query"}},
+			expectedPanic: true,
+		},
+		"service gas limit with invalid gas limit": {
+			opts:          mapAppOptions{"osmosis-query.service-gas-limits": []interface{}{"osmosis.lockup.Query=foo"}},
+			expectedPanic: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.expectedPanic {
+				require.Panics(t, func() { NewQueryLimitOptions(tc.opts) })
+				return
+			}
+			require.Equal(t, tc.expectedOpts, NewQueryLimitOptions(tc.opts))
+		})
+	}
+}
+
+func TestQueryLimitsServer(t *testing.T) {
+	const (
+		maxPageLimit = uint64(10)
+		gasLimit     = uint64(1000)
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: "/osmosis.lockup.Query/AccountLockedCoins"}
+
+	tests := map[string]struct {
+		serviceName string
+		// withInterceptor is whether the method is called by the gRPC server, with an interceptor
+		// attaching the sdk.Context, or by the query router, without interceptor.
+		withInterceptor   bool
+		pageLimit         uint64
+		gasConsumed       uint64
+		expectedPageLimit uint64
+		expectedErrCode   codes.Code
+	}{
+		"page limit within max page limit": {
+			serviceName:       "osmosis.lockup.Query",
+			withInterceptor:   true,
+			pageLimit:         maxPageLimit,
+			expectedPageLimit: maxPageLimit,
+		},
+		"page limit capped to max page limit": {
+			serviceName:       "osmosis.lockup.Query",
+			withInterceptor:   true,
+			pageLimit:         maxPageLimit + 1,
+			expectedPageLimit: maxPageLimit,
+		},
+		"gas consumed within gas limit": {
+			serviceName:       "osmosis.lockup.Query",
+			withInterceptor:   true,
+			gasConsumed:       gasLimit,
+			expectedPageLimit: 0,
+		},
+		"gas consumed exceeds gas limit": {
+			serviceName:     "osmosis.lockup.Query",
+			withInterceptor: true,
+			gasConsumed:     gasLimit + 1,
+			expectedErrCode: codes.ResourceExhausted,
+		},
+		"service without gas limit": {
+			serviceName:       "osmosis.incentives.Query",
+			withInterceptor:   true,
+			pageLimit:         maxPageLimit + 1,
+			gasConsumed:       gasLimit + 1,
+			expectedPageLimit: maxPageLimit,
+		},
+		"called by the query router, no limit is enforced": {
+			serviceName:       "osmosis.lockup.Query",
+			pageLimit:         maxPageLimit + 1,
+			gasConsumed:       gasLimit + 1,
+			expectedPageLimit: maxPageLimit + 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			underlyingServer := &capturingServer{}
+			server := queryLimitsServer{Server: underlyingServer, opts: QueryLimitOptions{
+				MaxPageLimit:     maxPageLimit,
+				ServiceGasLimits: map[string]uint64{"osmosis.lockup.Query": gasLimit},
+			}}
+
+			var receivedReq *lockuptypes.AccountLockedCoinsRequest
+			queryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				receivedReq = req.(*lockuptypes.AccountLockedCoinsRequest)
+				sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(tc.gasConsumed, "query")
+				return &lockuptypes.AccountLockedCoinsResponse{}, nil
+			}
+			server.RegisterService(&grpc.ServiceDesc{
+				ServiceName: tc.serviceName,
+				Methods: []grpc.MethodDesc{{
+					MethodName: "AccountLockedCoins",
+					// Mirrors the generated method handlers.
+					Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+						in := new(lockuptypes.AccountLockedCoinsRequest)
+						if err := dec(in); err != nil {
+							return nil, err
+						}
+						if interceptor == nil {
+							return queryHandler(ctx, in)
+						}
+						return interceptor(ctx, in, info, queryHandler)
+					},
+				}},
+			}, nil)
+			require.Len(t, underlyingServer.descs, 1)
+
+			ctx := sdk.WrapSDKContext(sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+			dec := func(i interface{}) error {
+				i.(*lockuptypes.AccountLockedCoinsRequest).Pagination = &query.PageRequest{Limit: tc.pageLimit}
+				return nil
+			}
+			var interceptor grpc.UnaryServerInterceptor
+			if tc.withInterceptor {
+				interceptor = func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+					return handler(ctx, req)
+				}
+			}
+
+			// System under test.
+			_, err := underlyingServer.descs[0].Methods[0].Handler(nil, ctx, dec, interceptor)
+
+			if tc.expectedErrCode != codes.OK {
+				require.Equal(t, tc.expectedErrCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPageLimit, receivedReq.Pagination.Limit)
+		})
+	}
+}
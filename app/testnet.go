@@ -0,0 +1,166 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/bytes"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
+	minttypes "github.com/osmosis-labs/osmosis/v21/x/mint/types"
+)
+
+var (
+	// TestnetValidatorTokens are the tokens bonded to the validator of an in place testnet.
+	// They exceed the tokens bonded on mainnet so that the validator holds the majority of the stake.
+	TestnetValidatorTokens = osmomath.NewInt(900_000_000_000_000)
+
+	// TestnetOperatorCoins are the coins sent to the operator of the validator of an in place testnet, to pay fees.
+	TestnetOperatorCoins = sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000_000_000))
+
+	// TestnetVotingPeriod and TestnetExpeditedVotingPeriod are the governance voting periods of an in place testnet,
+	// short enough to rehearse upgrades through governance.
+	TestnetVotingPeriod          = 3 * time.Minute
+	TestnetExpeditedVotingPeriod = time.Minute
+
+	// TestnetUpgradeDelay is the number of blocks after which the upgrade triggered on an in place testnet is applied.
+	TestnetUpgradeDelay = int64(10)
+)
+
+// InitOsmosisAppForTestnet rewrites the state of the app so that it can run as a single node testnet:
+//   - the validator of newValPubKey, operated by newOperatorAddress, becomes the only validator in the
+//     validator set, with TestnetValidatorTokens self delegated, and signing info to not be jailed for downtime.
+//   - the operator is funded with TestnetOperatorCoins.
+//   - the governance voting periods are shortened to TestnetVotingPeriod and TestnetExpeditedVotingPeriod.
+//   - if upgradeToTrigger is not empty, an upgrade plan with that name is scheduled TestnetUpgradeDelay blocks later.
+//
+// The changes are written to the uncommitted state of the app, and are committed with the next block.
+// The other validators are removed from the validator set, but their state is left untouched.
+func InitOsmosisAppForTestnet(app *OsmosisApp, newValAddr bytes.HexBytes, newValPubKey crypto.PubKey, newOperatorAddress, upgradeToTrigger string) error {
+	ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	operatorAddr, err := sdk.AccAddressFromBech32(newOperatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr := sdk.ValAddress(operatorAddr)
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(&ed25519.PubKey{Key: newValPubKey.Bytes()})
+	if err != nil {
+		return err
+	}
+
+	// STAKING
+	// Bond the tokens of the new validator, which are self delegated by its operator.
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, TestnetValidatorTokens))
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, bondedCoins); err != nil {
+		return err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, bondedCoins); err != nil {
+		return err
+	}
+
+	newVal := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		ConsensusPubkey: pubKeyAny,
+		Jailed:          false,
+		Status:          stakingtypes.Bonded,
+		Tokens:          TestnetValidatorTokens,
+		DelegatorShares: osmomath.NewDecFromInt(TestnetValidatorTokens),
+		Description: stakingtypes.Description{
+			Moniker: "Testnet Validator",
+		},
+		Commission: stakingtypes.NewCommission(
+			osmomath.NewDecWithPrec(5, 2),
+			osmomath.NewDecWithPrec(10, 2),
+			osmomath.NewDecWithPrec(5, 2),
+		),
+		MinSelfDelegation: osmomath.OneInt(),
+	}
+
+	// Remove all the validators from the power index and the last validator set, so that the new validator
+	// is the only one in the validator set.
+	stakingStore := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
+	iterator := app.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	iterator = app.StakingKeeper.LastValidatorsIterator(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		stakingStore.Delete(key)
+	}
+
+	app.StakingKeeper.SetValidator(ctx, newVal)
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, newVal); err != nil {
+		return err
+	}
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, newVal)
+	app.StakingKeeper.SetLastValidatorPower(ctx, valAddr, newVal.ConsensusPower(app.StakingKeeper.PowerReduction(ctx)))
+	app.StakingKeeper.SetLastTotalPower(ctx, osmomath.NewInt(newVal.ConsensusPower(app.StakingKeeper.PowerReduction(ctx))))
+
+	// DISTRIBUTION and SLASHING
+	// Initialize the records of the new validator and of its self delegation.
+	if err := app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr); err != nil {
+		return err
+	}
+	if err := app.SlashingKeeper.Hooks().AfterValidatorCreated(ctx, valAddr); err != nil {
+		return err
+	}
+	app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(operatorAddr, valAddr, newVal.DelegatorShares))
+	if err := app.DistrKeeper.Hooks().AfterDelegationModified(ctx, operatorAddr, valAddr); err != nil {
+		return err
+	}
+
+	// Set the signing info of the new validator, so that it is not jailed for missing the blocks
+	// signed by the previous validator set.
+	newConsAddr := sdk.ConsAddress(newValAddr.Bytes())
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, newConsAddr, slashingtypes.NewValidatorSigningInfo(
+		newConsAddr, app.LastBlockHeight(), 0, time.Unix(0, 0), false, 0,
+	))
+
+	// BANK
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, TestnetOperatorCoins); err != nil {
+		return err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, operatorAddr, TestnetOperatorCoins); err != nil {
+		return err
+	}
+
+	// GOV
+	govParams := app.GovKeeper.GetParams(ctx)
+	govParams.VotingPeriod = &TestnetVotingPeriod
+	govParams.ExpeditedVotingPeriod = &TestnetExpeditedVotingPeriod
+	if err := app.GovKeeper.SetParams(ctx, govParams); err != nil {
+		return err
+	}
+
+	// UPGRADE
+	if upgradeToTrigger != "" {
+		upgradePlan := upgradetypes.Plan{
+			Name:   upgradeToTrigger,
+			Height: app.LastBlockHeight() + TestnetUpgradeDelay,
+		}
+		if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradePlan); err != nil {
+			return fmt.Errorf("failed to schedule upgrade %s: %w", upgradeToTrigger, err)
+		}
+	}
+
+	return nil
+}
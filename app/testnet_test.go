@@ -0,0 +1,55 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
+)
+
+func TestInitOsmosisAppForTestnet(t *testing.T) {
+	app := Setup(false)
+	app.Commit()
+
+	valPubKey := ed25519.GenPrivKey().PubKey()
+	operatorAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	err := InitOsmosisAppForTestnet(app, valPubKey.Address(), valPubKey, operatorAddr.String(), "v99")
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: app.LastBlockHeight()})
+
+	// The new validator is the only one in the validator set.
+	lastValidators := app.StakingKeeper.GetLastValidators(ctx)
+	require.Len(t, lastValidators, 1)
+	require.Equal(t, sdk.ValAddress(operatorAddr).String(), lastValidators[0].OperatorAddress)
+	require.Equal(t, TestnetValidatorTokens, lastValidators[0].Tokens)
+
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(valPubKey.Address()))
+	require.True(t, found)
+	require.Equal(t, lastValidators[0].OperatorAddress, validator.OperatorAddress)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, operatorAddr, sdk.ValAddress(operatorAddr))
+	require.True(t, found)
+
+	signingInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(valPubKey.Address()))
+	require.True(t, found)
+	require.False(t, signingInfo.Tombstoned)
+
+	// The operator is funded, and the governance voting periods are shortened.
+	require.Equal(t, TestnetOperatorCoins.AmountOf(appparams.BaseCoinUnit), app.BankKeeper.GetBalance(ctx, operatorAddr, appparams.BaseCoinUnit).Amount)
+
+	govParams := app.GovKeeper.GetParams(ctx)
+	require.Equal(t, TestnetVotingPeriod, *govParams.VotingPeriod)
+	require.Equal(t, TestnetExpeditedVotingPeriod, *govParams.ExpeditedVotingPeriod)
+
+	// The upgrade is scheduled.
+	plan, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, "v99", plan.Name)
+	require.Equal(t, app.LastBlockHeight()+TestnetUpgradeDelay, plan.Height)
+}
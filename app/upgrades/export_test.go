@@ -0,0 +1,11 @@
+package upgrades
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func ValidateNewModuleState(ctx sdk.Context, genesisModule module.HasGenesis, moduleName string, cdc codec.JSONCodec) error {
+	return validateNewModuleState(ctx, genesisModule, moduleName, cdc)
+}
@@ -0,0 +1,69 @@
+package upgrades
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// RunMigrationsWithNewModuleDefaults runs the module migrations like module.Manager.RunMigrations, but
+// additionally guarantees that every module whose store is listed in storeUpgrades.Added is initialized
+// from its default genesis, params included. This is done by removing these modules from the fromVM
+// version map, which makes RunMigrations call InitGenesis with the module's DefaultGenesis.
+//
+// After the migrations have run, the state of every newly added module is exported and validated
+// against the module's genesis validation, so that an upgrade leaving a new module without readable
+// params or in an otherwise invalid state fails at upgrade time rather than on the first query.
+//
+// Added stores that do not belong to a module with genesis (e.g. middleware stores) are skipped.
+func RunMigrationsWithNewModuleDefaults(
+	ctx sdk.Context,
+	mm *module.Manager,
+	configurator module.Configurator,
+	fromVM module.VersionMap,
+	storeUpgrades store.StoreUpgrades,
+	cdc codec.JSONCodec,
+) (module.VersionMap, error) {
+	newModules := make([]string, 0, len(storeUpgrades.Added))
+	for _, storeName := range storeUpgrades.Added {
+		if _, ok := mm.Modules[storeName].(module.HasGenesis); !ok {
+			ctx.Logger().Info(fmt.Sprintf("added store %s does not belong to a module with genesis, skipping default initialization", storeName))
+			continue
+		}
+		delete(fromVM, storeName)
+		newModules = append(newModules, storeName)
+	}
+
+	migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, moduleName := range newModules {
+		if err := validateNewModuleState(ctx, mm.Modules[moduleName].(module.HasGenesis), moduleName, cdc); err != nil {
+			return nil, err
+		}
+	}
+
+	return migrations, nil
+}
+
+// validateNewModuleState checks that the state of a newly added module can be exported and that the
+// exported state passes the module's genesis validation.
+func validateNewModuleState(ctx sdk.Context, genesisModule module.HasGenesis, moduleName string, cdc codec.JSONCodec) (err error) {
+	// Exporting genesis reads the module params, which panics if they were never set.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to export state of new module %s: %v", moduleName, r)
+		}
+	}()
+
+	exported := genesisModule.ExportGenesis(ctx, cdc)
+	if err := genesisModule.ValidateGenesis(cdc, nil, exported); err != nil {
+		return fmt.Errorf("invalid state of new module %s after upgrade: %w", moduleName, err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package upgrades_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/app/upgrades"
+	twaptypes "github.com/osmosis-labs/osmosis/v21/x/twap/types"
+)
+
+type UpgradesTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestUpgradesTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradesTestSuite))
+}
+
+func (s *UpgradesTestSuite) TestRunMigrationsWithNewModuleDefaults() {
+	tests := map[string]struct {
+		addedStores          []string
+		expectDefaultsForced bool
+	}{
+		"no added stores": {
+			addedStores: []string{},
+		},
+		"added store of a module with genesis is initialized from defaults": {
+			addedStores:          []string{twaptypes.StoreKey},
+			expectDefaultsForced: true,
+		},
+		"added store without a module is skipped": {
+			addedStores: []string{"nonexistentstore"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			nonDefaultParams := twaptypes.NewParams("week", 24*time.Hour)
+			s.App.TwapKeeper.SetParams(s.Ctx, nonDefaultParams)
+
+			mm := s.App.ModuleManager()
+			configurator := module.NewConfigurator(s.App.AppCodec(), s.App.MsgServiceRouter(), s.App.GRPCQueryRouter())
+			fromVM := mm.GetVersionMap()
+
+			migrations, err := upgrades.RunMigrationsWithNewModuleDefaults(s.Ctx, &mm, configurator, fromVM, store.StoreUpgrades{Added: tc.addedStores}, s.App.AppCodec())
+			s.Require().NoError(err)
+			s.Require().Equal(mm.GetVersionMap(), migrations)
+
+			if tc.expectDefaultsForced {
+				s.Require().Equal(twaptypes.DefaultParams(), s.App.TwapKeeper.GetParams(s.Ctx))
+			} else {
+				s.Require().Equal(nonDefaultParams, s.App.TwapKeeper.GetParams(s.Ctx))
+			}
+		})
+	}
+}
+
+func (s *UpgradesTestSuite) TestValidateNewModuleState() {
+	s.Setup()
+	mm := s.App.ModuleManager()
+	twapModule := mm.Modules[twaptypes.ModuleName].(module.HasGenesis)
+
+	// Valid state.
+	err := upgrades.ValidateNewModuleState(s.Ctx, twapModule, twaptypes.ModuleName, s.App.AppCodec())
+	s.Require().NoError(err)
+
+	// Remove the module params, as would be the case for a module whose params were never initialized.
+	paramsStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), []byte(twaptypes.ModuleName+"/"))
+	iter := paramsStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		paramsStore.Delete(key)
+	}
+
+	err = upgrades.ValidateNewModuleState(s.Ctx, twapModule, twaptypes.ModuleName, s.App.AppCodec())
+	s.Require().ErrorContains(err, "failed to export state of new module twap")
+}
@@ -0,0 +1,110 @@
+package upgrades
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v21/app/keepers"
+)
+
+// StoreChange is a change of the value of a key of a KV store.
+// OldValue is nil if the key is added, and NewValue is nil if the key is deleted.
+type StoreChange struct {
+	StoreName string
+	Key       []byte
+	OldValue  []byte
+	NewValue  []byte
+}
+
+// storeChangeRecorder records the writes flushed to the KV stores it listens to.
+type storeChangeRecorder struct {
+	changes []StoreChange
+}
+
+var _ store.WriteListener = &storeChangeRecorder{}
+
+func (r *storeChangeRecorder) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	change := StoreChange{StoreName: storeKey.Name(), Key: key}
+	if !delete {
+		change.NewValue = value
+	}
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+// SimulateUpgrade applies the upgrade upgradeName, whose handler must be registered in the upgrade keeper,
+// on a branch of the state of ms in a block with the given header, and returns the changes it makes to the
+// KV stores, sorted by store name and key. The branch is discarded, so the state of ms is left untouched.
+//
+// The protocol version of the app is still incremented by the upgrade keeper, as it is not part of the state.
+// Returns error if the upgrade has no handler, or if its handler fails.
+func SimulateUpgrade(ms store.MultiStore, appKeepers *keepers.AppKeepers, header tmproto.Header, logger log.Logger, upgradeName string) (changes []StoreChange, err error) {
+	if !appKeepers.UpgradeKeeper.HasHandler(upgradeName) {
+		return nil, fmt.Errorf("no upgrade handler registered for upgrade %s", upgradeName)
+	}
+
+	// Branch the stores of ms twice: the writes to the KV stores are recorded when the first branch is
+	// written to the second one, which is discarded.
+	recorder := &storeChangeRecorder{}
+	stores := make(map[store.StoreKey]store.CacheWrapper)
+	keysByName := make(map[string]store.StoreKey)
+	for _, key := range appKeepers.GetKVStoreKey() {
+		stores[key] = listenkv.NewStore(cachekv.NewStore(ms.GetKVStore(key)), key, []store.WriteListener{recorder})
+		keysByName[key.Name()] = key
+	}
+	for _, key := range appKeepers.GetTransientStoreKey() {
+		stores[key] = cachekv.NewStore(ms.GetKVStore(key))
+		keysByName[key.Name()] = key
+	}
+	for _, key := range appKeepers.GetMemoryStoreKey() {
+		stores[key] = cachekv.NewStore(ms.GetKVStore(key))
+		keysByName[key.Name()] = key
+	}
+	branch := cachemulti.NewStore(dbm.NewMemDB(), stores, keysByName, nil, nil)
+
+	ctx := sdk.NewContext(branch, header, false, logger)
+	if err := applyUpgrade(ctx, appKeepers, upgradetypes.Plan{Name: upgradeName, Height: header.Height}); err != nil {
+		return nil, err
+	}
+	branch.Write()
+
+	// Look up the values before the upgrade, leaving out the writes which do not change the value of their key.
+	changes = make([]StoreChange, 0, len(recorder.changes))
+	for _, change := range recorder.changes {
+		change.OldValue = ms.GetKVStore(keysByName[change.StoreName]).Get(change.Key)
+		if bytes.Equal(change.OldValue, change.NewValue) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].StoreName != changes[j].StoreName {
+			return changes[i].StoreName < changes[j].StoreName
+		}
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+
+	return changes, nil
+}
+
+// applyUpgrade applies the upgrade plan, and returns the panic of a failed upgrade handler as an error.
+func applyUpgrade(ctx sdk.Context, appKeepers *keepers.AppKeepers, plan upgradetypes.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade %s failed: %v", plan.Name, r)
+		}
+	}()
+	appKeepers.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+	return nil
+}
@@ -0,0 +1,73 @@
+package upgrades_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v21/app/upgrades"
+	twaptypes "github.com/osmosis-labs/osmosis/v21/x/twap/types"
+)
+
+func (s *UpgradesTestSuite) TestSimulateUpgrade() {
+	const upgradeName = "simulated"
+
+	tests := map[string]struct {
+		upgradeName string
+		handlerErr  error
+		expectedErr bool
+	}{
+		"upgrade changes are returned": {
+			upgradeName: upgradeName,
+		},
+		"error: no handler for upgrade": {
+			upgradeName: "unknown",
+			expectedErr: true,
+		},
+		"error: upgrade handler fails": {
+			upgradeName: upgradeName,
+			handlerErr:  errors.New("handler failure"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			paramsBefore := s.App.TwapKeeper.GetParams(s.Ctx)
+			s.App.UpgradeKeeper.SetUpgradeHandler(upgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				s.App.TwapKeeper.SetParams(ctx, twaptypes.NewParams("week", 24*time.Hour))
+				return fromVM, tc.handlerErr
+			})
+
+			changes, err := upgrades.SimulateUpgrade(s.Ctx.MultiStore(), &s.App.AppKeepers, s.Ctx.BlockHeader(), s.Ctx.Logger(), tc.upgradeName)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The twap params are changed, and the upgrade is marked as done.
+			changedStores := map[string]bool{}
+			paramsChanged := false
+			for _, change := range changes {
+				changedStores[change.StoreName] = true
+				if change.StoreName == paramstypes.StoreKey && strings.Contains(string(change.NewValue), "week") {
+					paramsChanged = true
+				}
+			}
+			s.Require().True(paramsChanged)
+			s.Require().True(changedStores[upgradetypes.StoreKey])
+
+			// The state is left untouched.
+			s.Require().Equal(paramsBefore, s.App.TwapKeeper.GetParams(s.Ctx))
+			s.Require().Zero(s.App.UpgradeKeeper.GetDoneHeight(s.Ctx, upgradeName))
+		})
+	}
+}
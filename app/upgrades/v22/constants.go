@@ -0,0 +1,24 @@
+package v22
+
+import (
+	"github.com/osmosis-labs/osmosis/v21/app/upgrades"
+	denomaliastypes "github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+
+	store "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Osmosis v22 upgrade.
+const UpgradeName = "v22"
+
+// storeUpgrades defines the stores added and deleted by the Osmosis v22 upgrade.
+var storeUpgrades = store.StoreUpgrades{
+	Added:   []string{denomaliastypes.StoreKey, smartaccounttypes.StoreKey},
+	Deleted: []string{},
+}
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        storeUpgrades,
+}
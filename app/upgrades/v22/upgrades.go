@@ -0,0 +1,120 @@
+package v22
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	"github.com/osmosis-labs/osmosis/v21/app/keepers"
+	"github.com/osmosis-labs/osmosis/v21/app/upgrades"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v21/x/tokenfactory/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v21/x/txfees/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// The denom-alias and smart-account modules are added in this upgrade, they are initialized from
+		// their default genesis and their state is validated once the migrations have run.
+		cdc, ok := keepers.AccountKeeper.GetCodec().(codec.JSONCodec)
+		if !ok {
+			return nil, fmt.Errorf("unexpected app codec type %T", keepers.AccountKeeper.GetCodec())
+		}
+
+		// Run migrations before applying any other state changes.
+		// NOTE: DO NOT PUT ANY STATE CHANGES BEFORE RunMigrations().
+		migrations, err := upgrades.RunMigrationsWithNewModuleDefaults(ctx, mm, configurator, fromVM, storeUpgrades, cdc)
+		if err != nil {
+			return nil, err
+		}
+
+		// The gamm, lockup, incentives, CL and poolmanager params were moved from their x/params subspaces
+		// to the module stores by the migrations above. The params added in this upgrade are set below.
+
+		// Set CL params:
+		clParams := keepers.ConcentratedLiquidityKeeper.GetParams(ctx)
+		clParams.MinPositionLiquidity = concentratedliquiditytypes.DefaultMinPositionLiquidity
+		clParams.TickCrossGasCost = concentratedliquiditytypes.DefaultTickCrossGasCost
+		clParams.AccumulatorUpdateGasCost = concentratedliquiditytypes.DefaultAccumulatorUpdateGasCost
+		clParams.AllPoolsWithdrawOnly = false
+		clParams.WithdrawOnlyModeEmergencyWhitelist = concentratedliquiditytypes.DefaultWithdrawOnlyModeEmergencyWhitelist
+		keepers.ConcentratedLiquidityKeeper.SetParams(ctx, clParams)
+
+		// Set poolmanager chain statistics params:
+		defaultPoolManagerParams := poolmanagertypes.DefaultParams()
+		poolManagerParams := keepers.PoolManagerKeeper.GetParams(ctx)
+		poolManagerParams.StatisticsQuoteDenom = defaultPoolManagerParams.StatisticsQuoteDenom
+		poolManagerParams.StatisticsEpochIdentifier = defaultPoolManagerParams.StatisticsEpochIdentifier
+
+		// Set poolmanager OSMO-routed multihop discount param, the discount is disabled by default:
+		poolManagerParams.OsmoRoutedMultihopDiscountEnabled = defaultPoolManagerParams.OsmoRoutedMultihopDiscountEnabled
+
+		// Set poolmanager taker fee burn params, burning is disabled by default:
+		poolManagerParams.TakerFeeParams.OsmoTakerFeeDistribution.Burn = osmomath.ZeroDec()
+		poolManagerParams.TakerFeeParams.NonOsmoTakerFeeDistribution.Burn = osmomath.ZeroDec()
+		keepers.PoolManagerKeeper.SetParams(ctx, poolManagerParams)
+
+		// Set gamm pool creation fee refund param, refunds are disabled by default:
+		gammParams := keepers.GAMMKeeper.GetParams(ctx)
+		gammParams.PoolCreationFeeRefundRatio = gammtypes.DefaultParams().PoolCreationFeeRefundRatio
+		keepers.GAMMKeeper.SetParams(ctx, gammParams)
+
+		// Set lockup instant unlock params, instant unlocks are disabled by default:
+		defaultLockupParams := lockuptypes.DefaultParams()
+		lockupParams := keepers.LockupKeeper.GetParams(ctx)
+		lockupParams.InstantUnlockPenalty = defaultLockupParams.InstantUnlockPenalty
+		lockupParams.BurnInstantUnlockPenalty = defaultLockupParams.BurnInstantUnlockPenalty
+		keepers.LockupKeeper.SetParams(ctx, lockupParams)
+
+		// Set incentives gauge creation fee and min value for distribution params, both are disabled by default:
+		defaultIncentivesParams := incentivestypes.DefaultParams()
+		incentivesParams := keepers.IncentivesKeeper.GetParams(ctx)
+		incentivesParams.GaugeCreationFee = defaultIncentivesParams.GaugeCreationFee
+		incentivesParams.MinValueForDistribution = defaultIncentivesParams.MinValueForDistribution
+		keepers.IncentivesKeeper.SetParams(ctx, incentivesParams)
+
+		// Index the upcoming and active gauges by denom and start time, which backs the gauges per denom queries.
+		keepers.IncentivesKeeper.IndexGaugesByDenomAndStartTime(ctx)
+
+		// Set tokenfactory before send hook gas limit param, to the gas limit that was previously hardcoded:
+		keepers.TokenFactoryKeeper.SetParam(ctx, tokenfactorytypes.KeyBeforeSendHookGasLimit, tokenfactorytypes.DefaultBeforeSendHookGasLimit)
+
+		// Set txfees params, the module did not have any params before this upgrade.
+		keepers.TxFeesKeeper.SetParams(ctx, txfeestypes.DefaultParams())
+
+		// The developer rewards distributed before this upgrade were not tracked, so the total
+		// is derived from the developer vesting module account balance.
+		keepers.MintKeeper.InitTotalDeveloperRewardsDistributed(ctx)
+
+		// Set epochs params, the module did not have any params before this upgrade.
+		// The epoch hooks are not gas limited by default.
+		keepers.EpochsKeeper.SetParams(ctx, epochstypes.DefaultParams())
+
+		// The poolmanager module account requires the burner permission to burn OSMO taker fees.
+		// Permissions of existing module accounts are persisted in state, so they must be updated explicitly.
+		poolManagerAcc, ok := keepers.AccountKeeper.GetModuleAccount(ctx, poolmanagertypes.ModuleName).(*authtypes.ModuleAccount)
+		if !ok {
+			return nil, fmt.Errorf("unexpected account type for %s module account", poolmanagertypes.ModuleName)
+		}
+		poolManagerAcc.Permissions = []string{authtypes.Burner}
+		keepers.AccountKeeper.SetModuleAccount(ctx, poolManagerAcc)
+
+		return migrations, nil
+	}
+}
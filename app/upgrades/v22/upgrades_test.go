@@ -0,0 +1,189 @@
+package v22_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	v22 "github.com/osmosis-labs/osmosis/v21/app/upgrades/v22"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v21/x/incentives/keeper"
+	incentivestypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	mintkeeper "github.com/osmosis-labs/osmosis/v21/x/mint/keeper"
+	minttypes "github.com/osmosis-labs/osmosis/v21/x/mint/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v21/x/tokenfactory/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v21/x/txfees/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+const (
+	v22UpgradeHeight = int64(10)
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	s.Setup()
+
+	// Mimic the mainnet poolmanager module account, which was created without permissions.
+	poolManagerAcc := s.App.AccountKeeper.GetModuleAccount(s.Ctx, poolmanagertypes.ModuleName).(*authtypes.ModuleAccount)
+	poolManagerAcc.Permissions = nil
+	s.App.AccountKeeper.SetModuleAccount(s.Ctx, poolManagerAcc)
+
+	// Mimic the developer rewards distributed on mainnet before they were tracked.
+	mintDenom := s.App.MintKeeper.GetParams(s.Ctx).MintDenom
+	err := s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.DeveloperVestingModuleAcctName, s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(mintDenom, 1_000_000)))
+	s.Require().NoError(err)
+
+	// Mimic the gauges created before they were indexed by denom and start time.
+	poolId := s.PrepareBalancerPool()
+	gaugeDenom := gammtypes.GetPoolShareDenom(poolId)
+	gaugesByDenomStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(incentivestypes.StoreKey)), incentivestypes.KeyPrefixUpcomingGaugesByDenom)
+	iterator := gaugesByDenomStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		gaugesByDenomStore.Delete(key)
+	}
+
+	// Mimic the mainnet params of the modules storing them in their own store since v22,
+	// which are stored in the x/params subspaces of the modules at their first consensus version.
+	gammParams := s.App.GAMMKeeper.GetParams(s.Ctx)
+	lockupParams := s.App.LockupKeeper.GetParams(s.Ctx)
+	incentivesParams := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	clParams := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	subspaceParams := []struct {
+		moduleName string
+		paramsKey  []byte
+		params     paramstypes.ParamSet
+	}{
+		{gammtypes.ModuleName, gammtypes.KeyParams, &gammParams},
+		{lockuptypes.ModuleName, lockuptypes.KeyParams, &lockupParams},
+		{incentivestypes.ModuleName, incentivestypes.KeyParams, &incentivesParams},
+		{concentratedliquiditytypes.ModuleName, concentratedliquiditytypes.KeyParams, &clParams},
+		{poolmanagertypes.ModuleName, poolmanagertypes.KeyParams, &poolManagerParams},
+	}
+	fromVM := s.App.UpgradeKeeper.GetModuleVersionMap(s.Ctx)
+	for _, module := range subspaceParams {
+		s.App.GetSubspace(module.moduleName).SetParamSet(s.Ctx, module.params)
+		s.Ctx.KVStore(s.App.GetKey(module.moduleName)).Delete(module.paramsKey)
+		fromVM[module.moduleName] = 1
+	}
+	s.App.UpgradeKeeper.SetModuleVersionMap(s.Ctx, fromVM)
+	s.Require().Panics(func() { s.App.PoolManagerKeeper.GetParams(s.Ctx) })
+
+	// Mimic the poolmanager params added in v22, which are missing from the mainnet state.
+	poolManagerParamsStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), []byte(poolmanagertypes.ModuleName+"/"))
+	poolManagerParamsStore.Delete(poolmanagertypes.KeyStatisticsQuoteDenom)
+	poolManagerParamsStore.Delete(poolmanagertypes.KeyStatisticsEpochIdentifier)
+	poolManagerParamsStore.Delete(poolmanagertypes.KeyOsmoRoutedMultihopDiscountEnabled)
+
+	// The smart-account module is added in v22, any state of it is overwritten by its default genesis.
+	smartAccountParams := s.App.SmartAccountKeeper.GetParams(s.Ctx)
+	smartAccountParams.IsSmartAccountActive = true
+	s.App.SmartAccountKeeper.SetParams(s.Ctx, smartAccountParams)
+
+	dummyUpgrade(s)
+	s.Require().NotPanics(func() {
+		s.App.BeginBlocker(s.Ctx, abci.RequestBeginBlock{})
+	})
+
+	// Check that the params are migrated from the x/params subspaces to the module stores.
+	for _, module := range subspaceParams {
+		s.Require().Equal(uint64(2), s.App.UpgradeKeeper.GetModuleVersionMap(s.Ctx)[module.moduleName])
+	}
+	s.Require().Equal(gammParams.PoolCreationFee, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+	s.Require().Equal(lockupParams.ForceUnlockAllowedAddresses, s.App.LockupKeeper.GetParams(s.Ctx).ForceUnlockAllowedAddresses)
+	s.Require().Equal(incentivesParams.DistrEpochIdentifier, s.App.IncentivesKeeper.GetParams(s.Ctx).DistrEpochIdentifier)
+	s.Require().Equal(clParams.AuthorizedTickSpacing, s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx).AuthorizedTickSpacing)
+	s.Require().Equal(poolManagerParams.TakerFeeParams.DefaultTakerFee, s.App.PoolManagerKeeper.GetParams(s.Ctx).TakerFeeParams.DefaultTakerFee)
+
+	// Check that the modules added in v22 are initialized from their default genesis.
+	s.Require().False(s.App.SmartAccountKeeper.GetParams(s.Ctx).IsSmartAccountActive)
+
+	// Check that the new CL params are set.
+	clParams = s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	s.Require().Equal(concentratedliquiditytypes.DefaultMinPositionLiquidity, clParams.MinPositionLiquidity)
+	s.Require().Equal(concentratedliquiditytypes.DefaultTickCrossGasCost, clParams.TickCrossGasCost)
+	s.Require().Equal(concentratedliquiditytypes.DefaultAccumulatorUpdateGasCost, clParams.AccumulatorUpdateGasCost)
+	s.Require().False(clParams.AllPoolsWithdrawOnly)
+	s.Require().Empty(clParams.WithdrawOnlyModeEmergencyWhitelist)
+
+	// Check that the taker fee burn params are set and the poolmanager module account can burn.
+	poolManagerParams = s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	s.Require().Equal(osmomath.ZeroDec(), poolManagerParams.TakerFeeParams.OsmoTakerFeeDistribution.Burn)
+	s.Require().Equal(osmomath.ZeroDec(), poolManagerParams.TakerFeeParams.NonOsmoTakerFeeDistribution.Burn)
+	poolManagerAcc = s.App.AccountKeeper.GetModuleAccount(s.Ctx, poolmanagertypes.ModuleName).(*authtypes.ModuleAccount)
+	s.Require().True(poolManagerAcc.HasPermission(authtypes.Burner))
+
+	// Check that the chain statistics params are set.
+	s.Require().Equal(poolmanagertypes.DefaultParams().StatisticsQuoteDenom, poolManagerParams.StatisticsQuoteDenom)
+	s.Require().Equal(poolmanagertypes.DefaultParams().StatisticsEpochIdentifier, poolManagerParams.StatisticsEpochIdentifier)
+	s.Require().False(poolManagerParams.OsmoRoutedMultihopDiscountEnabled)
+
+	// Check that the gamm pool creation fee refund param is set.
+	s.Require().Equal(osmomath.ZeroDec(), s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFeeRefundRatio)
+
+	// Check that the lockup instant unlock params are set.
+	lockupParams = s.App.LockupKeeper.GetParams(s.Ctx)
+	s.Require().Equal(osmomath.ZeroDec(), lockupParams.InstantUnlockPenalty)
+	s.Require().False(lockupParams.BurnInstantUnlockPenalty)
+
+	// Check that the incentives gauge creation fee and min value for distribution params are set.
+	incentivesParams = s.App.IncentivesKeeper.GetParams(s.Ctx)
+	s.Require().True(incentivesParams.GaugeCreationFee.Empty())
+	s.Require().True(incentivesParams.MinValueForDistribution.Empty())
+
+	// Check that the upcoming gauges are indexed by denom and start time.
+	upcomingGauges, err := incentiveskeeper.NewQuerier(*s.App.IncentivesKeeper).UpcomingGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &incentivestypes.UpcomingGaugesPerDenomRequest{Denom: gaugeDenom})
+	s.Require().NoError(err)
+	s.Require().Len(upcomingGauges.UpcomingGauges, len(s.App.PoolIncentivesKeeper.GetLockableDurations(s.Ctx)))
+
+	// Check that the tokenfactory before send hook gas limit param is set.
+	s.Require().Equal(tokenfactorytypes.DefaultBeforeSendHookGasLimit, s.App.TokenFactoryKeeper.GetParams(s.Ctx).BeforeSendHookGasLimit)
+
+	// Check that the txfees params are set.
+	s.Require().Equal(txfeestypes.DefaultParams(), s.App.TxFeesKeeper.GetParams(s.Ctx))
+
+	// Check that the epochs params are set.
+	s.Require().Equal(epochstypes.DefaultParams(), s.App.EpochsKeeper.GetParams(s.Ctx))
+
+	// Check that the total developer rewards distributed is initialized from the developer vesting balance.
+	s.Require().Equal(osmomath.NewInt(1_000_000), s.App.MintKeeper.GetTotalDeveloperRewardsDistributed(s.Ctx))
+	_, broken := mintkeeper.DeveloperVestingBalanceInvariant(*s.App.MintKeeper)(s.Ctx)
+	s.Require().False(broken)
+}
+
+func dummyUpgrade(s *UpgradeTestSuite) {
+	s.Ctx = s.Ctx.WithBlockHeight(v22UpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v22.UpgradeName, Height: v22UpgradeHeight}
+	err := s.App.UpgradeKeeper.ScheduleUpgrade(s.Ctx, plan)
+	s.Require().NoError(err)
+	_, exists := s.App.UpgradeKeeper.GetUpgradePlan(s.Ctx)
+	s.Require().True(exists)
+
+	s.Ctx = s.Ctx.WithBlockHeight(v22UpgradeHeight)
+}
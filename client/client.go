@@ -0,0 +1,188 @@
+// Package client provides typed helpers for Go integrators, such as bots and backend services,
+// to query an Osmosis node and to sign and broadcast Osmosis messages through its gRPC endpoint
+// without hand-rolling the proto plumbing.
+//
+// Queries are retried on transient gRPC errors and paginated queries return all the pages.
+// Transactions are signed with keys from a keyring, and the account sequences are tracked locally,
+// so that several transactions can be broadcasted before the previous ones are included in a block.
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app"
+	"github.com/osmosis-labs/osmosis/v21/app/params"
+)
+
+// Config defines how the client connects to the node and signs transactions.
+type Config struct {
+	// GRPCAddress is the address of the gRPC endpoint of the node.
+	GRPCAddress string
+	// ChainId is the chain id of the node.
+	ChainId string
+	// Keyring holds the signer keys. If nil, the keyring at KeyringHome with KeyringBackend is opened.
+	Keyring        keyring.Keyring
+	KeyringHome    string
+	KeyringBackend string
+	// Gas is the gas limit of every transaction.
+	Gas uint64
+	// GasPrice is the gas price paid by every transaction.
+	GasPrice sdk.DecCoin
+	// MaxRetries is the number of times a query is retried on transient errors.
+	MaxRetries int
+	// RetryInterval is the interval between two retries of a query.
+	RetryInterval time.Duration
+	// TxInclusionTimeout is how long to wait for a broadcasted transaction to be included in a block.
+	TxInclusionTimeout time.Duration
+	// PollInterval is the interval at which the node is polled while waiting for a transaction to be included.
+	PollInterval time.Duration
+}
+
+// DefaultConfig returns the config of a client connecting to a local node, such as localosmosis.
+func DefaultConfig() Config {
+	return Config{
+		GRPCAddress:        "localhost:9090",
+		ChainId:            "localosmosis",
+		KeyringBackend:     keyring.BackendTest,
+		Gas:                1_000_000,
+		GasPrice:           sdk.NewDecCoinFromDec(params.BaseCoinUnit, osmomath.NewDecWithPrec(25, 3)),
+		MaxRetries:         3,
+		RetryInterval:      time.Second,
+		TxInclusionTimeout: time.Minute,
+		PollInterval:       time.Second,
+	}
+}
+
+// account is a signer whose account number and sequence are tracked locally.
+type account struct {
+	name          string
+	address       sdk.AccAddress
+	accountNumber uint64
+	sequence      uint64
+}
+
+// Client queries an Osmosis node and broadcasts transactions to it through its gRPC endpoint only.
+// It is safe for concurrent use.
+type Client struct {
+	cfg      Config
+	encoding params.EncodingConfig
+	conn     *grpc.ClientConn
+	keyring  keyring.Keyring
+	txClient txtypes.ServiceClient
+
+	// mu guards accounts, and is held while signing so that the sequences are not used twice.
+	mu       sync.Mutex
+	accounts map[string]*account
+}
+
+// New returns a client connected to the node at cfg.GRPCAddress.
+func New(cfg Config) (*Client, error) {
+	encoding := app.MakeEncodingConfig()
+
+	conn, err := grpc.Dial(
+		cfg.GRPCAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(encoding.InterfaceRegistry).GRPCCodec())),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", cfg.GRPCAddress, err)
+	}
+
+	kr := cfg.Keyring
+	if kr == nil {
+		kr, err = keyring.New(sdk.KeyringServiceName(), cfg.KeyringBackend, cfg.KeyringHome, nil, encoding.Marshaler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Client{
+		cfg:      cfg,
+		encoding: encoding,
+		conn:     conn,
+		keyring:  kr,
+		txClient: txtypes.NewServiceClient(conn),
+		accounts: map[string]*account{},
+	}, nil
+}
+
+// Close closes the connection to the node.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Conn returns the gRPC connection to the node, to query the services that have no typed helper.
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Encoding returns the encoding config of the client, used to decode the queried interfaces.
+func (c *Client) Encoding() params.EncodingConfig {
+	return c.encoding
+}
+
+// ResolveAddress returns the address of the given keyring key name, or parses the given bech32 address.
+func (c *Client) ResolveAddress(nameOrAddress string) (sdk.AccAddress, error) {
+	if address, err := sdk.AccAddressFromBech32(nameOrAddress); err == nil {
+		return address, nil
+	}
+	record, err := c.keyring.Key(nameOrAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%s is neither a bech32 address nor a key in the keyring: %w", nameOrAddress, err)
+	}
+	return record.GetAddress()
+}
+
+// getAccount returns the signer with the given keyring key name, querying its account number
+// and sequence from the node the first time it is used. Must be called with mu held.
+func (c *Client) getAccount(ctx context.Context, name string) (*account, error) {
+	if acc, ok := c.accounts[name]; ok {
+		return acc, nil
+	}
+
+	record, err := c.keyring.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("signer %s not found in the keyring: %w", name, err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	acc := &account{name: name, address: address}
+	if err := c.refreshAccount(ctx, acc); err != nil {
+		return nil, err
+	}
+	c.accounts[name] = acc
+	return acc, nil
+}
+
+// refreshAccount queries the account number and sequence of the account from the node.
+func (c *Client) refreshAccount(ctx context.Context, acc *account) error {
+	resp, err := withRetry(ctx, c.cfg, func() (*authtypes.QueryAccountResponse, error) {
+		return authtypes.NewQueryClient(c.conn).Account(ctx, &authtypes.QueryAccountRequest{Address: acc.address.String()})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query account %s (%s): %w", acc.name, acc.address, err)
+	}
+	var accountI authtypes.AccountI
+	if err := c.encoding.InterfaceRegistry.UnpackAny(resp.Account, &accountI); err != nil {
+		return err
+	}
+	acc.accountNumber = accountI.GetAccountNumber()
+	acc.sequence = accountI.GetSequence()
+	return nil
+}
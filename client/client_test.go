@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+var testConfig = Config{MaxRetries: 2, RetryInterval: time.Millisecond}
+
+func TestWithRetry(t *testing.T) {
+	tests := map[string]struct {
+		errs          []error
+		expectedCalls int
+		expectedErr   error
+	}{
+		"no error": {
+			errs:          []error{nil},
+			expectedCalls: 1,
+		},
+		"transient error then success": {
+			errs:          []error{status.Error(codes.Unavailable, "unavailable"), nil},
+			expectedCalls: 2,
+		},
+		"transient errors exceed max retries": {
+			errs:          []error{status.Error(codes.Unavailable, "1"), status.Error(codes.Unavailable, "2"), status.Error(codes.Unavailable, "3"), nil},
+			expectedCalls: 3,
+			expectedErr:   status.Error(codes.Unavailable, "3"),
+		},
+		"non transient error is not retried": {
+			errs:          []error{status.Error(codes.NotFound, "not found"), nil},
+			expectedCalls: 1,
+			expectedErr:   status.Error(codes.NotFound, "not found"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			resp, err := withRetry(context.Background(), testConfig, func() (int, error) {
+				calls++
+				return calls, tc.errs[calls-1]
+			})
+			require.Equal(t, tc.expectedCalls, calls)
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCalls, resp)
+		})
+	}
+}
+
+func TestCollectPages(t *testing.T) {
+	pages := map[string]struct {
+		items   []int
+		nextKey string
+	}{
+		"":  {items: []int{1, 2}, nextKey: "b"},
+		"b": {items: []int{3, 4}, nextKey: "c"},
+		"c": {items: []int{5}},
+	}
+
+	failedOnce := false
+	items, err := collectPages(context.Background(), testConfig, func(pageReq *query.PageRequest) ([]int, *query.PageResponse, error) {
+		// The second page fails once with a transient error, and is retried.
+		if string(pageReq.Key) == "b" && !failedOnce {
+			failedOnce = true
+			return nil, nil, status.Error(codes.Unavailable, "unavailable")
+		}
+		page := pages[string(pageReq.Key)]
+		return page.items, &query.PageResponse{NextKey: []byte(page.nextKey)}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, items)
+
+	_, err = collectPages(context.Background(), testConfig, func(pageReq *query.PageRequest) ([]int, *query.PageResponse, error) {
+		return nil, nil, errors.New("failed")
+	})
+	require.ErrorContains(t, err, "failed")
+}
+
+func TestDecodeMsgResponse(t *testing.T) {
+	lockResponse, err := codectypes.NewAnyWithValue(&lockuptypes.MsgLockTokensResponse{ID: 5})
+	require.NoError(t, err)
+	txMsgData := sdk.TxMsgData{MsgResponses: []*codectypes.Any{lockResponse}}
+	data, err := txMsgData.Marshal()
+	require.NoError(t, err)
+	txResp := &sdk.TxResponse{TxHash: "hash", Data: hex.EncodeToString(data)}
+
+	resp := lockuptypes.MsgLockTokensResponse{}
+	require.NoError(t, DecodeMsgResponse(txResp, 0, &resp))
+	require.Equal(t, uint64(5), resp.ID)
+
+	require.ErrorContains(t, DecodeMsgResponse(txResp, 1, &resp), "has 1 message responses, requested index 1")
+}
+
+func TestCreatedPoolId(t *testing.T) {
+	txResp := &sdk.TxResponse{
+		TxHash: "hash",
+		Events: []abci.Event{
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "create_pool"}}},
+			{Type: poolmanagertypes.TypeEvtPoolCreated, Attributes: []abci.EventAttribute{{Key: poolmanagertypes.AttributeKeyPoolId, Value: "12"}}},
+		},
+	}
+	poolId, err := CreatedPoolId(txResp)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), poolId)
+
+	_, err = CreatedPoolId(&sdk.TxResponse{TxHash: "hash"})
+	require.ErrorContains(t, err, "no pool created in transaction hash")
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	poolmanagerqueryproto "github.com/osmosis-labs/osmosis/v21/x/poolmanager/client/queryproto"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// LatestHeight returns the height of the latest block committed by the node.
+func (c *Client) LatestHeight(ctx context.Context) (int64, error) {
+	resp, err := withRetry(ctx, c.cfg, func() (*tmservice.GetLatestBlockResponse, error) {
+		return tmservice.NewServiceClient(c.conn).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.SdkBlock.Header.Height, nil
+}
+
+// CurrentEpoch returns the current number of the epoch with the given identifier.
+func (c *Client) CurrentEpoch(ctx context.Context, identifier string) (int64, error) {
+	resp, err := withRetry(ctx, c.cfg, func() (*epochstypes.QueryCurrentEpochResponse, error) {
+		return epochstypes.NewQueryClient(c.conn).CurrentEpoch(ctx, &epochstypes.QueryCurrentEpochRequest{Identifier: identifier})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.CurrentEpoch, nil
+}
+
+// Balances returns all the balances of the given key name or bech32 address.
+func (c *Client) Balances(ctx context.Context, nameOrAddress string) (sdk.Coins, error) {
+	address, err := c.ResolveAddress(nameOrAddress)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := collectPages(ctx, c.cfg, func(pageReq *query.PageRequest) ([]sdk.Coin, *query.PageResponse, error) {
+		resp, err := banktypes.NewQueryClient(c.conn).AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: address.String(), Pagination: pageReq})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Balances, resp.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(balances...), nil
+}
+
+// Pool returns the pool with the given id, of any pool type.
+func (c *Client) Pool(ctx context.Context, poolId uint64) (poolmanagertypes.PoolI, error) {
+	resp, err := withRetry(ctx, c.cfg, func() (*poolmanagerqueryproto.PoolResponse, error) {
+		return poolmanagerqueryproto.NewQueryClient(c.conn).Pool(ctx, &poolmanagerqueryproto.PoolRequest{PoolId: poolId})
+	})
+	if err != nil {
+		return nil, err
+	}
+	var pool poolmanagertypes.PoolI
+	if err := c.encoding.InterfaceRegistry.UnpackAny(resp.Pool, &pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// AllPools returns all the pools, of all pool types.
+func (c *Client) AllPools(ctx context.Context) ([]poolmanagertypes.PoolI, error) {
+	resp, err := withRetry(ctx, c.cfg, func() (*poolmanagerqueryproto.AllPoolsResponse, error) {
+		return poolmanagerqueryproto.NewQueryClient(c.conn).AllPools(ctx, &poolmanagerqueryproto.AllPoolsRequest{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]poolmanagertypes.PoolI, 0, len(resp.Pools))
+	for _, poolAny := range resp.Pools {
+		var pool poolmanagertypes.PoolI
+		if err := c.encoding.InterfaceRegistry.UnpackAny(poolAny, &pool); err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote asset in the given pool.
+func (c *Client) SpotPrice(ctx context.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string) (osmomath.Dec, error) {
+	resp, err := withRetry(ctx, c.cfg, func() (*poolmanagerqueryproto.SpotPriceResponse, error) {
+		return poolmanagerqueryproto.NewQueryClient(c.conn).SpotPrice(ctx, &poolmanagerqueryproto.SpotPriceRequest{
+			PoolId:          poolId,
+			BaseAssetDenom:  baseAssetDenom,
+			QuoteAssetDenom: quoteAssetDenom,
+		})
+	})
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return osmomath.NewDecFromStr(resp.SpotPrice)
+}
+
+// EstimateSwapExactAmountIn returns the amount of the token out of swapping the exact tokenIn through the given routes.
+func (c *Client) EstimateSwapExactAmountIn(ctx context.Context, routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin) (osmomath.Int, error) {
+	resp, err := withRetry(ctx, c.cfg, func() (*poolmanagerqueryproto.EstimateSwapExactAmountInResponse, error) {
+		return poolmanagerqueryproto.NewQueryClient(c.conn).EstimateSwapExactAmountIn(ctx, &poolmanagerqueryproto.EstimateSwapExactAmountInRequest{
+			TokenIn: tokenIn.String(),
+			Routes:  routes,
+		})
+	})
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	return resp.TokenOutAmount, nil
+}
+
+// EstimateSwapExactAmountOut returns the amount of the token in needed to get the exact tokenOut through the given routes.
+func (c *Client) EstimateSwapExactAmountOut(ctx context.Context, routes []poolmanagertypes.SwapAmountOutRoute, tokenOut sdk.Coin) (osmomath.Int, error) {
+	resp, err := withRetry(ctx, c.cfg, func() (*poolmanagerqueryproto.EstimateSwapExactAmountOutResponse, error) {
+		return poolmanagerqueryproto.NewQueryClient(c.conn).EstimateSwapExactAmountOut(ctx, &poolmanagerqueryproto.EstimateSwapExactAmountOutRequest{
+			Routes:   routes,
+			TokenOut: tokenOut.String(),
+		})
+	})
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	return resp.TokenInAmount, nil
+}
+
+// UserPositions returns all the concentrated liquidity positions of the given key name or bech32 address
+// in the given pool, or in all pools if poolId is zero.
+func (c *Client) UserPositions(ctx context.Context, nameOrAddress string, poolId uint64) ([]model.FullPositionBreakdown, error) {
+	address, err := c.ResolveAddress(nameOrAddress)
+	if err != nil {
+		return nil, err
+	}
+	return collectPages(ctx, c.cfg, func(pageReq *query.PageRequest) ([]model.FullPositionBreakdown, *query.PageResponse, error) {
+		resp, err := queryproto.NewQueryClient(c.conn).UserPositions(ctx, &queryproto.UserPositionsRequest{
+			Address:    address.String(),
+			PoolId:     poolId,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Positions, resp.Pagination, nil
+	})
+}
+
+// AccountLocks returns all the locks of the given key name or bech32 address, including the unlocking ones.
+func (c *Client) AccountLocks(ctx context.Context, nameOrAddress string) ([]lockuptypes.PeriodLock, error) {
+	address, err := c.ResolveAddress(nameOrAddress)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := withRetry(ctx, c.cfg, func() (*lockuptypes.AccountLockedLongerDurationResponse, error) {
+		return lockuptypes.NewQueryClient(c.conn).AccountLockedLongerDuration(ctx, &lockuptypes.AccountLockedLongerDurationRequest{Owner: address.String()})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Locks, nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// isTransientError returns true if the query failed for a reason that may not occur again,
+// such as the node being temporarily unavailable.
+func isTransientError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// withRetry runs the query, retrying it up to cfg.MaxRetries times on transient errors.
+func withRetry[T any](ctx context.Context, cfg Config, query func() (T, error)) (T, error) {
+	resp, err := query()
+	for retry := 0; retry < cfg.MaxRetries && err != nil && isTransientError(err); retry++ {
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-time.After(cfg.RetryInterval):
+		}
+		resp, err = query()
+	}
+	return resp, err
+}
+
+// collectPages runs the paginated query until all of its pages are returned, retrying every page on transient errors.
+func collectPages[T any](ctx context.Context, cfg Config, queryPage func(pageReq *query.PageRequest) ([]T, *query.PageResponse, error)) ([]T, error) {
+	type page struct {
+		items   []T
+		pageRes *query.PageResponse
+	}
+
+	all := []T{}
+	pageReq := &query.PageRequest{}
+	for {
+		p, err := withRetry(ctx, cfg, func() (page, error) {
+			items, pageRes, err := queryPage(pageReq)
+			return page{items, pageRes}, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, p.items...)
+
+		if p.pageRes == nil || len(p.pageRes.NextKey) == 0 {
+			return all, nil
+		}
+		pageReq = &query.PageRequest{Key: p.pageRes.NextKey}
+	}
+}
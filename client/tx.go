@@ -0,0 +1,319 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// Broadcast signs the given messages with the signer's key and broadcasts them in a single transaction
+// without waiting for it to be included in a block. Returns the transaction hash.
+// If the transaction is rejected because the locally tracked sequence is out of sync with the node,
+// the sequence is queried again and the transaction is signed and broadcasted once more.
+func (c *Client) Broadcast(ctx context.Context, signer string, msgs ...sdk.Msg) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acc, err := c.getAccount(ctx, signer)
+	if err != nil {
+		return "", err
+	}
+
+	txResp, err := c.signAndBroadcast(ctx, acc, msgs)
+	if err == nil && txResp.Code == sdkerrors.ErrWrongSequence.ABCICode() && txResp.Codespace == sdkerrors.ErrWrongSequence.Codespace() {
+		if err := c.refreshAccount(ctx, acc); err != nil {
+			return "", err
+		}
+		txResp, err = c.signAndBroadcast(ctx, acc, msgs)
+	}
+	if err != nil {
+		return "", err
+	}
+	if txResp.Code != 0 {
+		// The sequence may be out of sync with the node if a previous transaction failed, so it is queried again.
+		if err := c.refreshAccount(ctx, acc); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("transaction from %s rejected with code %d: %s", signer, txResp.Code, txResp.RawLog)
+	}
+	acc.sequence++
+	return txResp.TxHash, nil
+}
+
+// signAndBroadcast signs the messages with the current sequence of the account and broadcasts them
+// in sync mode. Must be called with mu held.
+func (c *Client) signAndBroadcast(ctx context.Context, acc *account, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	txBuilder := c.encoding.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txBuilder.SetGasLimit(c.cfg.Gas)
+	fee := c.cfg.GasPrice.Amount.MulInt64(int64(c.cfg.Gas)).Ceil().TruncateInt()
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(c.cfg.GasPrice.Denom, fee)))
+
+	factory := tx.Factory{}.
+		WithChainID(c.cfg.ChainId).
+		WithKeybase(c.keyring).
+		WithTxConfig(c.encoding.TxConfig).
+		WithAccountNumber(acc.accountNumber).
+		WithSequence(acc.sequence).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	if err := tx.Sign(factory, acc.name, txBuilder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := c.encoding.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.txClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{TxBytes: txBytes, Mode: txtypes.BroadcastMode_BROADCAST_MODE_SYNC})
+	if err != nil {
+		return nil, err
+	}
+	return resp.TxResponse, nil
+}
+
+// BroadcastAndWait broadcasts the given messages like Broadcast and waits for the transaction to be included in a block.
+// Returns error if the transaction failed when delivered.
+func (c *Client) BroadcastAndWait(ctx context.Context, signer string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txHash, err := c.Broadcast(ctx, signer, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForTx(ctx, txHash)
+}
+
+// WaitForTx waits until the transaction with the given hash is included in a block.
+// Returns error if the transaction is not included within the TxInclusionTimeout or if it failed when delivered.
+func (c *Client) WaitForTx(ctx context.Context, txHash string) (*sdk.TxResponse, error) {
+	deadline := time.Now().Add(c.cfg.TxInclusionTimeout)
+	for {
+		resp, err := c.txClient.GetTx(ctx, &txtypes.GetTxRequest{Hash: txHash})
+		if err == nil {
+			if resp.TxResponse.Code != 0 {
+				return nil, fmt.Errorf("transaction %s failed with code %d: %s", txHash, resp.TxResponse.Code, resp.TxResponse.RawLog)
+			}
+			return resp.TxResponse, nil
+		}
+		if status.Code(err) != codes.NotFound && !isTransientError(err) {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("transaction %s was not included in a block within %s", txHash, c.cfg.TxInclusionTimeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.cfg.PollInterval):
+		}
+	}
+}
+
+// DecodeMsgResponse decodes the response of the message at the given index of a delivered transaction into resp.
+func DecodeMsgResponse(txResp *sdk.TxResponse, index int, resp proto.Message) error {
+	data, err := hex.DecodeString(txResp.Data)
+	if err != nil {
+		return fmt.Errorf("invalid data in transaction %s: %w", txResp.TxHash, err)
+	}
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(data, &txMsgData); err != nil {
+		return err
+	}
+	if index >= len(txMsgData.MsgResponses) {
+		return fmt.Errorf("transaction %s has %d message responses, requested index %d", txResp.TxHash, len(txMsgData.MsgResponses), index)
+	}
+	return proto.Unmarshal(txMsgData.MsgResponses[index].Value, resp)
+}
+
+// CreatedPoolId returns the id of the pool created by the given transaction.
+func CreatedPoolId(txResp *sdk.TxResponse) (uint64, error) {
+	for _, event := range txResp.Events {
+		if event.Type != poolmanagertypes.TypeEvtPoolCreated {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == poolmanagertypes.AttributeKeyPoolId {
+				poolId, err := strconv.ParseUint(attribute.Value, 10, 64)
+				if err != nil {
+					return 0, fmt.Errorf("invalid pool id %s in transaction %s: %w", attribute.Value, txResp.TxHash, err)
+				}
+				return poolId, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no pool created in transaction %s", txResp.TxHash)
+}
+
+// Send sends the coins from the signer to the recipient, given as a keyring key name or a bech32 address.
+func (c *Client) Send(ctx context.Context, signer, recipient string, coins sdk.Coins) (*sdk.TxResponse, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return nil, err
+	}
+	recipientAddress, err := c.ResolveAddress(recipient)
+	if err != nil {
+		return nil, err
+	}
+	return c.BroadcastAndWait(ctx, signer, banktypes.NewMsgSend(sender, recipientAddress, coins))
+}
+
+// CreateBalancerPool creates a balancer pool with the given assets and returns its id.
+func (c *Client) CreateBalancerPool(ctx context.Context, signer string, poolParams balancer.PoolParams, poolAssets []balancer.PoolAsset) (uint64, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return 0, err
+	}
+	msg := balancer.NewMsgCreateBalancerPool(sender, poolParams, poolAssets, "")
+	return c.createPool(ctx, signer, &msg)
+}
+
+// CreateConcentratedPool creates a concentrated liquidity pool and returns its id.
+func (c *Client) CreateConcentratedPool(ctx context.Context, signer, denom0, denom1 string, tickSpacing uint64, spreadFactor osmomath.Dec) (uint64, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return 0, err
+	}
+	msg := model.NewMsgCreateConcentratedPool(sender, denom0, denom1, tickSpacing, spreadFactor)
+	return c.createPool(ctx, signer, &msg)
+}
+
+func (c *Client) createPool(ctx context.Context, signer string, msg sdk.Msg) (uint64, error) {
+	txResp, err := c.BroadcastAndWait(ctx, signer, msg)
+	if err != nil {
+		return 0, err
+	}
+	return CreatedPoolId(txResp)
+}
+
+// SwapExactAmountIn swaps the exact tokenIn through the given routes and returns the amount of the token out.
+// Fails if the amount out is lower than tokenOutMinAmount.
+func (c *Client) SwapExactAmountIn(ctx context.Context, signer string, routes []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount osmomath.Int) (osmomath.Int, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	txResp, err := c.BroadcastAndWait(ctx, signer, &poolmanagertypes.MsgSwapExactAmountIn{
+		Sender:            sender.String(),
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: tokenOutMinAmount,
+	})
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	resp := poolmanagertypes.MsgSwapExactAmountInResponse{}
+	if err := DecodeMsgResponse(txResp, 0, &resp); err != nil {
+		return osmomath.Int{}, err
+	}
+	return resp.TokenOutAmount, nil
+}
+
+// SwapExactAmountOut swaps through the given routes to get the exact tokenOut and returns the amount of the token in.
+// Fails if the amount in is greater than tokenInMaxAmount.
+func (c *Client) SwapExactAmountOut(ctx context.Context, signer string, routes []poolmanagertypes.SwapAmountOutRoute, tokenInMaxAmount osmomath.Int, tokenOut sdk.Coin) (osmomath.Int, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	txResp, err := c.BroadcastAndWait(ctx, signer, &poolmanagertypes.MsgSwapExactAmountOut{
+		Sender:           sender.String(),
+		Routes:           routes,
+		TokenInMaxAmount: tokenInMaxAmount,
+		TokenOut:         tokenOut,
+	})
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	resp := poolmanagertypes.MsgSwapExactAmountOutResponse{}
+	if err := DecodeMsgResponse(txResp, 0, &resp); err != nil {
+		return osmomath.Int{}, err
+	}
+	return resp.TokenInAmount, nil
+}
+
+// CreatePosition creates a concentrated liquidity position between the given ticks.
+func (c *Client) CreatePosition(ctx context.Context, signer string, poolId uint64, lowerTick, upperTick int64, tokensProvided sdk.Coins, tokenMinAmount0, tokenMinAmount1 osmomath.Int) (*cltypes.MsgCreatePositionResponse, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return nil, err
+	}
+	txResp, err := c.BroadcastAndWait(ctx, signer, &cltypes.MsgCreatePosition{
+		PoolId:          poolId,
+		Sender:          sender.String(),
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		TokensProvided:  tokensProvided,
+		TokenMinAmount0: tokenMinAmount0,
+		TokenMinAmount1: tokenMinAmount1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &cltypes.MsgCreatePositionResponse{}
+	return resp, DecodeMsgResponse(txResp, 0, resp)
+}
+
+// WithdrawPosition withdraws the given liquidity amount from the concentrated liquidity position.
+func (c *Client) WithdrawPosition(ctx context.Context, signer string, positionId uint64, liquidityAmount osmomath.Dec) (*cltypes.MsgWithdrawPositionResponse, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return nil, err
+	}
+	txResp, err := c.BroadcastAndWait(ctx, signer, &cltypes.MsgWithdrawPosition{
+		PositionId:      positionId,
+		Sender:          sender.String(),
+		LiquidityAmount: liquidityAmount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &cltypes.MsgWithdrawPositionResponse{}
+	return resp, DecodeMsgResponse(txResp, 0, resp)
+}
+
+// LockTokens locks the coins for the given duration and returns the id of the lock.
+func (c *Client) LockTokens(ctx context.Context, signer string, duration time.Duration, coins sdk.Coins) (uint64, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return 0, err
+	}
+	txResp, err := c.BroadcastAndWait(ctx, signer, lockuptypes.NewMsgLockTokens(sender, duration, coins))
+	if err != nil {
+		return 0, err
+	}
+	resp := lockuptypes.MsgLockTokensResponse{}
+	if err := DecodeMsgResponse(txResp, 0, &resp); err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// BeginUnlocking starts unlocking the given coins of the lock, or all of them if coins is empty.
+func (c *Client) BeginUnlocking(ctx context.Context, signer string, lockId uint64, coins sdk.Coins) (*sdk.TxResponse, error) {
+	sender, err := c.ResolveAddress(signer)
+	if err != nil {
+		return nil, err
+	}
+	return c.BroadcastAndWait(ctx, signer, lockuptypes.NewMsgBeginUnlocking(sender, lockId, coins))
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -15,6 +16,9 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	clgenesis "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types/genesis"
 	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 
@@ -46,6 +50,10 @@ type DerivedAccount struct {
 	Bonded              sdk.Coins            `json:"bonded"`
 	BondedBySelectPools map[uint64]sdk.Coins `json:"bonded_by_select_pools"`
 	TotalBalances       sdk.Coins            `json:"total_balances"`
+
+	// ConcentratedPositions are the underlying coins of the concentrated liquidity positions of the account,
+	// locked or not.
+	ConcentratedPositions sdk.Coins `json:"concentrated_positions"`
 }
 
 // newDerivedAccount returns a new derived account.
@@ -56,6 +64,8 @@ func newDerivedAccount(address string) DerivedAccount {
 		Staked:         osmomath.ZeroInt(),
 		UnbondingStake: osmomath.ZeroInt(),
 		Bonded:         sdk.Coins{},
+
+		ConcentratedPositions: sdk.Coins{},
 	}
 }
 
@@ -153,6 +163,8 @@ func ExportDeriveBalancesCmd() *cobra.Command {
 		Use:   "export-derive-balances [input-genesis-file] [output-snapshot-json]",
 		Short: "Export a derive balances from a provided genesis export",
 		Long: `Export a derive balances from a provided genesis export
+The gamm shares of the accounts, liquid or locked, are converted to the underlying assets of their pool,
+and their concentrated liquidity positions are converted to their underlying assets at the current tick of their pool.
 Example:
 	osmosisd export-derive-balances ../genesis.json ../snapshot.json
 `,
@@ -256,7 +268,13 @@ Example:
 					acc = newDerivedAccount(address)
 				}
 
-				acc.Bonded = acc.Bonded.Add(lock.Coins...)
+				// The shares of concentrated liquidity locks are accounted for by the underlying coins of their position.
+				for _, coin := range lock.Coins {
+					if strings.HasPrefix(coin.Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
+						continue
+					}
+					acc.Bonded = acc.Bonded.Add(coin)
+				}
 				snapshotAccs[address] = acc
 			}
 
@@ -276,6 +294,54 @@ Example:
 				pools[gammtypes.GetPoolShareDenom(pool.GetId())] = pool
 			}
 
+			clGenesis := clgenesis.GenesisState{}
+			if len(genState[cltypes.ModuleName]) > 0 {
+				clientCtx.Codec.MustUnmarshalJSON(genState[cltypes.ModuleName], &clGenesis)
+			}
+
+			// collect concentrated liquidity pools
+			clPools := make(map[uint64]cltypes.ConcentratedPoolExtension)
+			for _, poolData := range clGenesis.PoolData {
+				var pool cltypes.ConcentratedPoolExtension
+				err := clientCtx.InterfaceRegistry.UnpackAny(poolData.Pool, &pool)
+				if err != nil {
+					panic(err)
+				}
+				clPools[pool.GetId()] = pool
+			}
+
+			// convert concentrated liquidity positions to their underlying coins, at the current tick of their pool
+			clPositionsBySelectPools := make(map[string]map[uint64]sdk.Coins)
+			for _, positionData := range clGenesis.PositionData {
+				position := positionData.Position
+				address := position.Address
+
+				acc, ok := snapshotAccs[address]
+				if !ok {
+					acc = newDerivedAccount(address)
+				}
+
+				pool, ok := clPools[position.PoolId]
+				if !ok {
+					return fmt.Errorf("pool %d of position %d not found", position.PoolId, position.PositionId)
+				}
+				asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(sdk.Context{}, *position, pool)
+				if err != nil {
+					return err
+				}
+				positionCoins := sdk.NewCoins(asset0, asset1)
+
+				acc.ConcentratedPositions = acc.ConcentratedPositions.Add(positionCoins...)
+				snapshotAccs[address] = acc
+
+				if osmoutils.Contains(selectBondedPoolIDs, position.PoolId) {
+					if clPositionsBySelectPools[address] == nil {
+						clPositionsBySelectPools[address] = make(map[uint64]sdk.Coins)
+					}
+					clPositionsBySelectPools[address][position.PoolId] = clPositionsBySelectPools[address][position.PoolId].Add(positionCoins...)
+				}
+			}
+
 			// convert balances to underlying coins and sum up balances to total balance
 			for addr, account := range snapshotAccs {
 				// All pool shares are in liquid balances OR bonded balances (locked),
@@ -283,13 +349,17 @@ Example:
 				// will include everything that is in one of those two pools.
 				account.BondedBySelectPools = underlyingCoinsForSelectPools(
 					account.LiquidBalances.Add(account.Bonded...), pools, selectBondedPoolIDs)
+				for poolID, positionCoins := range clPositionsBySelectPools[addr] {
+					account.BondedBySelectPools[poolID] = positionCoins
+				}
 				account.LiquidBalances = underlyingCoins(account.LiquidBalances, pools)
 				account.Bonded = underlyingCoins(account.Bonded, pools)
 				account.TotalBalances = sdk.NewCoins().
 					Add(account.LiquidBalances...).
 					Add(sdk.NewCoin(appparams.BaseCoinUnit, account.Staked)).
 					Add(sdk.NewCoin(appparams.BaseCoinUnit, account.UnbondingStake)).
-					Add(account.Bonded...)
+					Add(account.Bonded...).
+					Add(account.ConcentratedPositions...)
 				snapshotAccs[addr] = account
 			}
 
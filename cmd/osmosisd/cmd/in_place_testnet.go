@@ -0,0 +1,275 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtnode "github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	osmosis "github.com/osmosis-labs/osmosis/v21/app"
+)
+
+const (
+	flagTriggerTestnetUpgrade = "trigger-testnet-upgrade"
+	flagSkipConfirmation      = "skip-confirmation"
+
+	genesisDocKey = "genesisDoc"
+)
+
+// InPlaceTestnetCmd gets the cmd to turn the state of a node into the state of a single validator testnet and start it.
+func InPlaceTestnetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "in-place-testnet [newChainID] [newOperatorAddress]",
+		Short: "Create and start a single validator testnet from the state of the local node",
+		Long: `Create and start a single validator testnet from the state of the local node, to rehearse upgrades on real state.
+The node must be stopped before running the command, and its data directory is modified in place, so run it on a copy of the data directory.
+
+The command:
+	- changes the chain id of the node to newChainID.
+	- replaces the validator set by the validator of the local priv_validator_key.json, operated by newOperatorAddress.
+	- rewrites the last commit of the block store, so that it is signed by the new validator set.
+	- funds newOperatorAddress and shortens the governance voting periods.
+	- if --trigger-testnet-upgrade is set, schedules an upgrade with the given name 10 blocks after the current height.
+
+The IBC light clients of the counterparty chains cannot be updated, so the IBC channels of the testnet do not relay packets.
+The node is started without the API and gRPC servers, restart it with "osmosisd start" after stopping it to serve them.
+Example:
+	osmosisd in-place-testnet localosmosis osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj --trigger-testnet-upgrade v22
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			newChainID := args[0]
+			newOperatorAddress := args[1]
+			if _, err := sdk.AccAddressFromBech32(newOperatorAddress); err != nil {
+				return fmt.Errorf("invalid operator address %s: %w", newOperatorAddress, err)
+			}
+
+			upgradeToTrigger, err := cmd.Flags().GetString(flagTriggerTestnetUpgrade)
+			if err != nil {
+				return err
+			}
+
+			skipConfirmation, err := cmd.Flags().GetBool(flagSkipConfirmation)
+			if err != nil {
+				return err
+			}
+			if !skipConfirmation {
+				prompt := fmt.Sprintf("This will irreversibly modify the data directory of %s, continue?", config.RootDir)
+				ok, err := input.GetConfirmation(prompt, bufio.NewReader(os.Stdin), os.Stderr)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return nil
+				}
+			}
+
+			// The app checks the chain id of the blocks it executes against the one it is created with.
+			serverCtx.Viper.Set(flags.FlagChainID, newChainID)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			app, ok := newApp(serverCtx.Logger, db, nil, serverCtx.Viper).(*osmosis.OsmosisApp)
+			if !ok {
+				return fmt.Errorf("expected app of type %T", &osmosis.OsmosisApp{})
+			}
+
+			if err := testnetify(serverCtx, app, newChainID, newOperatorAddress, upgradeToTrigger); err != nil {
+				return err
+			}
+
+			nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+			if err != nil {
+				return err
+			}
+			tmNode, err := cmtnode.NewNode(
+				config,
+				privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+				nodeKey,
+				proxy.NewLocalClientCreator(app),
+				cmtnode.DefaultGenesisDocProviderFunc(config),
+				cmtnode.DefaultDBProvider,
+				cmtnode.DefaultMetricsProvider(config.Instrumentation),
+				serverCtx.Logger,
+			)
+			if err != nil {
+				return err
+			}
+			if err := tmNode.Start(); err != nil {
+				return err
+			}
+			defer func() {
+				if tmNode.IsRunning() {
+					_ = tmNode.Stop()
+				}
+			}()
+
+			server.WaitForQuitSignals()
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTriggerTestnetUpgrade, "", "Name of the upgrade to schedule on the testnet")
+	cmd.Flags().Bool(flagSkipConfirmation, false, "Skip the confirmation prompt")
+	cmd.Flags().String(flags.FlagHome, osmosis.DefaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// testnetify rewrites the block store and the state of CometBFT so that the validator of the local
+// priv_validator_key.json is the only validator of the chain, and the last block is committed by it
+// on newChainID. The state of the app is then rewritten accordingly with osmosis.InitOsmosisAppForTestnet.
+func testnetify(ctx *server.Context, app *osmosis.OsmosisApp, newChainID, newOperatorAddress, upgradeToTrigger string) error {
+	config := ctx.Config
+
+	blockStoreDB, err := cmtnode.DefaultDBProvider(&cmtnode.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := cmtnode.DefaultDBProvider(&cmtnode.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	})
+
+	state, genDoc, err := cmtnode.LoadStateFromDBOrGenesisDocProvider(stateDB, cmtnode.DefaultGenesisDocProviderFunc(config))
+	if err != nil {
+		return err
+	}
+
+	privValidator := privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	validatorPubKey, err := privValidator.GetPubKey()
+	if err != nil {
+		return err
+	}
+	validatorAddress := validatorPubKey.Address()
+
+	appInfo := app.Info(abci.RequestInfo{})
+	appHeight := appInfo.LastBlockHeight
+
+	switch {
+	case appHeight == blockStore.Height() && state.LastBlockHeight < appHeight:
+		// The node was stopped by a halt height, after the app committed the last block but before the state was saved.
+		blockMeta := blockStore.LoadBlockMeta(appHeight)
+		if blockMeta == nil {
+			return fmt.Errorf("block %d not found in the block store", appHeight)
+		}
+		state.LastBlockHeight = appHeight
+		state.LastBlockID = blockMeta.BlockID
+		state.LastBlockTime = blockMeta.Header.Time
+		state.AppHash = appInfo.LastBlockAppHash
+	case blockStore.Height() > appHeight:
+		// The node was stopped after saving the next block, but before the app executed it.
+		if err := blockStore.DeleteLatestBlock(); err != nil {
+			return err
+		}
+	}
+	if state.LastBlockHeight != appHeight || blockStore.Height() != appHeight {
+		return fmt.Errorf("state height %d and block store height %d do not match app height %d",
+			state.LastBlockHeight, blockStore.Height(), appHeight)
+	}
+
+	// COMMIT
+	// Sign the last block with the new validator on the new chain id, and make it the commit of the last block,
+	// which is verified against the new validator set when the next block is executed.
+	vote := cmttypes.Vote{
+		Type:             cmtproto.PrecommitType,
+		Height:           state.LastBlockHeight,
+		Round:            0,
+		BlockID:          state.LastBlockID,
+		Timestamp:        time.Now(),
+		ValidatorAddress: validatorAddress,
+		ValidatorIndex:   0,
+	}
+	voteProto := vote.ToProto()
+	if err := privValidator.SignVote(newChainID, voteProto); err != nil {
+		return err
+	}
+	seenCommit := cmttypes.NewCommit(state.LastBlockHeight, 0, state.LastBlockID, []cmttypes.CommitSig{
+		cmttypes.NewCommitSigForBlock(voteProto.Signature, validatorAddress, voteProto.Timestamp),
+	})
+	if err := blockStore.SaveSeenCommit(state.LastBlockHeight, seenCommit); err != nil {
+		return err
+	}
+
+	// VALIDATORS
+	// The new validator holds all the voting power, matching the tokens bonded to it in the app.
+	newValidator := cmttypes.NewValidator(validatorPubKey, sdk.TokensToConsensusPower(osmosis.TestnetValidatorTokens, sdk.DefaultPowerReduction))
+	newValidatorSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{newValidator})
+
+	state.ChainID = newChainID
+	state.Validators = newValidatorSet
+	state.LastValidators = newValidatorSet.Copy()
+	state.NextValidators = newValidatorSet.Copy()
+	state.LastHeightValidatorsChanged = state.LastBlockHeight
+	if err := stateStore.Save(state); err != nil {
+		return err
+	}
+
+	// Save uses the validator set of the last height it changed for the heights before the next one,
+	// which is looked up when loading the validators of the last and current heights.
+	validatorSetProto, err := newValidatorSet.ToProto()
+	if err != nil {
+		return err
+	}
+	validatorsInfo := &cmtstate.ValidatorsInfo{
+		ValidatorSet:      validatorSetProto,
+		LastHeightChanged: state.LastBlockHeight,
+	}
+	validatorsInfoBz, err := validatorsInfo.Marshal()
+	if err != nil {
+		return err
+	}
+	for _, height := range []int64{state.LastBlockHeight, state.LastBlockHeight + 1} {
+		if err := stateDB.Set([]byte(fmt.Sprintf("%s%d", kValidators, height)), validatorsInfoBz); err != nil {
+			return err
+		}
+	}
+
+	// GENESIS
+	// The genesis doc stored in the state db takes precedence over the genesis file when the node starts.
+	genDoc.ChainID = newChainID
+	genDocBz, err := cmtjson.Marshal(genDoc)
+	if err != nil {
+		return err
+	}
+	if err := stateDB.SetSync([]byte(genesisDocKey), genDocBz); err != nil {
+		return err
+	}
+
+	// APP
+	return osmosis.InitOsmosisAppForTestnet(app, validatorAddress, validatorPubKey, newOperatorAddress, upgradeToTrigger)
+}
@@ -0,0 +1,166 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	tmdb "github.com/cometbft/cometbft-db"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+const flagAppDBBackend = "app-db-backend"
+
+// pruneCmd prunes the application state heights beyond the retention configured in app.toml,
+// compacts application.db and reports the disk space reclaimed.
+func pruneCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune [pruning-method]",
+		Short: "Prune and compact application.db, keeping the recent heights configured in app.toml.",
+		Long: `Prune and compact application.db, keeping the recent heights configured in app.toml. One needs to shut down the node before running prune.
+The heights to keep are taken from the pruning options of app.toml, unless overridden by the optional pruning method argument
+(default|everything|custom) and the --pruning-keep-recent flag. Every height older than the recent heights to keep is deleted
+from application.db, which is then compacted to release the space on disk when using goleveldb, and the space reclaimed is reported.
+
+Loading the application store at startup migrates it to IAVL fast nodes, unless iavl-disable-fastnode is set in app.toml
+or with the --iavl-disable-fastnode flag. The migration is long and grows application.db, so nodes that do not need fast
+nodes may disable it when pruning.
+Example:
+	osmosisd prune custom --pruning-keep-recent 100000 --pruning-interval 10,
+which would keep the application state of the last 100000 heights.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			vp := serverCtx.Viper
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				vp.Set(server.FlagPruning, args[0])
+			}
+
+			pruningOptions, err := server.GetPruningOptionsFromFlags(vp)
+			if err != nil {
+				return err
+			}
+			if pruningOptions.Strategy == pruningtypes.PruningNothing {
+				return fmt.Errorf("pruning strategy %q keeps every height, nothing to prune", pruningtypes.PruningOptionNothing)
+			}
+			fmt.Printf("Pruning options, strategy: %s, keep-recent: %d\n", vp.GetString(server.FlagPruning), pruningOptions.KeepRecent)
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			appDBPath := filepath.Join(dataDir, "application.db")
+			sizeBefore, err := dirSize(appDBPath)
+			if err != nil {
+				return fmt.Errorf("failed to read the size of application.db: %w", err)
+			}
+
+			backend := server.GetAppDBBackend(vp)
+			if err := pruneAppStore(cmd, appCreator, vp, dataDir, backend, int64(pruningOptions.KeepRecent)); err != nil {
+				return err
+			}
+
+			if backend == tmdb.GoLevelDBBackend {
+				if err := compactAppStore(appDBPath); err != nil {
+					return err
+				}
+			} else {
+				fmt.Printf("Skipping compaction, only supported for %s application.db\n", tmdb.GoLevelDBBackend)
+			}
+
+			sizeAfter, err := dirSize(appDBPath)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Done, application.db size went from %.2f MB to %.2f MB, reclaimed %.2f MB\n",
+				bytesToMB(sizeBefore), bytesToMB(sizeAfter), bytesToMB(sizeBefore-sizeAfter))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(server.FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(server.FlagPruningInterval, 10, "Height interval at which pruned heights are removed from disk, not used by prune but required by 'custom' pruning options")
+	cmd.Flags().Bool(server.FlagDisableIAVLFastNode, false, "Disable the IAVL fast node migration when loading the application store")
+	cmd.Flags().String(flagAppDBBackend, "", "The type of database of application.db (goleveldb|rocksdb|pebbledb)")
+	return cmd
+}
+
+// pruneAppStore deletes every height of the application store older than the keepRecent latest heights.
+func pruneAppStore(cmd *cobra.Command, appCreator servertypes.AppCreator, appOpts servertypes.AppOptions, dataDir string, backend tmdb.BackendType, keepRecent int64) error {
+	db, err := tmdb.NewDB("application", backend, dataDir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	latestHeight := rootmulti.GetLatestVersion(db)
+	if latestHeight <= 0 {
+		return fmt.Errorf("application.db has no valid heights to prune, latest height: %d", latestHeight)
+	}
+
+	// heights are pruned from 1, as the earliest height of the store is not recorded.
+	var pruningHeights []int64
+	for height := int64(1); height < latestHeight-keepRecent; height++ {
+		pruningHeights = append(pruningHeights, height)
+	}
+	if len(pruningHeights) == 0 {
+		fmt.Println("No heights to prune ...")
+		return nil
+	}
+
+	app := appCreator(server.GetServerContextFromCmd(cmd).Logger, db, nil, appOpts)
+	rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("only the pruning of rootmulti.Store is supported")
+	}
+
+	fmt.Printf("Pruning Application Store from height %d to %d ...\n", pruningHeights[0], pruningHeights[len(pruningHeights)-1])
+	return rootMultiStore.PruneStores(false, pruningHeights)
+}
+
+// compactAppStore compacts application storage.
+func compactAppStore(appDBPath string) error {
+	db, err := leveldb.OpenFile(appDBPath, &opt.Options{DisableSeeksCompaction: true})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	fmt.Println("Compacting Application Store ...")
+	return db.CompactRange(*util.BytesPrefix([]byte{}))
+}
+
+// dirSize returns the total size in bytes of the files in the given directory.
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+func bytesToMB(size int64) float64 {
+	return float64(size) / (1 << 20)
+}
@@ -461,6 +461,28 @@ min-gas-price-for-high-gas-tx = ".0025"
 
 # This parameter enables EIP-1559 like fee market logic in the mempool
 adaptive-fee-enabled = "true"
+
+###############################################################################
+###                       Osmosis Query Configuration                       ###
+###############################################################################
+
+[osmosis-query]
+# These limits only apply to module queries served by the gRPC and REST servers of this node,
+# they never apply to queries within the state machine, such as cosmwasm stargate queries.
+
+# This is the max page size of paginated queries, larger page requests are capped to it.
+# Set to "0" to disable the cap.
+max-page-limit = "1000"
+
+# These are the gas limits of the queries of each gRPC query service, as "<service name>=<gas limit>".
+# Queries running out of gas fail with a resource exhausted error, which protects public nodes
+# from expensive unbounded reads, such as all ticks, all locks or all gauges.
+# Queries of services not listed are not gas limited.
+service-gas-limits = [
+  "osmosis.concentratedliquidity.v1beta1.Query=100000000",
+  "osmosis.lockup.Query=100000000",
+  "osmosis.incentives.Query=100000000",
+]
 `
 
 	return OsmosisAppTemplate, OsmosisAppCfg
@@ -483,6 +505,9 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(
 		// genutilcli.InitCmd(osmosis.ModuleBasics, osmosis.DefaultNodeHome),
 		forceprune(),
+		pruneCmd(newApp),
+		InPlaceTestnetCmd(),
+		TestUpgradeCmd(),
 		InitCmd(osmosis.ModuleBasics, osmosis.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, osmosis.DefaultNodeHome, gentxModule.GenTxValidator),
 		genutilcli.MigrateGenesisCmd(),
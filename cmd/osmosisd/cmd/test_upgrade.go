@@ -0,0 +1,146 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	osmosis "github.com/osmosis-labs/osmosis/v21/app"
+	"github.com/osmosis-labs/osmosis/v21/app/upgrades"
+)
+
+const flagSummary = "summary"
+
+// TestUpgradeCmd gets the cmd to simulate an upgrade on the state of the local node and print the changes it makes.
+func TestUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test-upgrade [upgrade-name]",
+		Short: "Simulate an upgrade on the state of the local node and print the state changes it makes",
+		Long: `Simulate an upgrade on the state of the local node and print the state changes it makes, to validate an upgrade handler before a release.
+The upgrade handler registered under upgrade-name is applied on a branch of the last committed state of the node, which is discarded, so the data directory of the node is left untouched.
+The node must be stopped before running the command. Upgrades adding stores can only be simulated on a state which already has these stores.
+
+The changes are printed per store, with the keys in hex, and the values as strings if printable or in hex otherwise:
+	+ key: value            for an added key
+	~ key: old -> new       for an updated key
+	- key: old              for a deleted key
+Example:
+	osmosisd test-upgrade v22 --summary
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			upgradeName := args[0]
+
+			summaryOnly, err := cmd.Flags().GetBool(flagSummary)
+			if err != nil {
+				return err
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app, ok := newApp(serverCtx.Logger, db, nil, serverCtx.Viper).(*osmosis.OsmosisApp)
+			if !ok {
+				return fmt.Errorf("expected app of type %T", &osmosis.OsmosisApp{})
+			}
+
+			header := tmproto.Header{
+				ChainID: genDoc.ChainID,
+				Height:  app.LastBlockHeight() + 1,
+				Time:    time.Now().UTC(),
+			}
+			changes, err := upgrades.SimulateUpgrade(app.CommitMultiStore(), &app.AppKeepers, header, serverCtx.Logger, upgradeName)
+			if err != nil {
+				return err
+			}
+
+			printStoreChanges(cmd.OutOrStdout(), changes, summaryOnly)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagSummary, false, "Only print the number of keys added, updated and deleted per store")
+	cmd.Flags().String(flags.FlagHome, osmosis.DefaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// printStoreChanges prints the changes, which are sorted by store, grouped by store.
+func printStoreChanges(w io.Writer, changes []upgrades.StoreChange, summaryOnly bool) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "no state changes")
+		return
+	}
+
+	for start := 0; start < len(changes); {
+		storeName := changes[start].StoreName
+		end := start
+		added, updated, deleted := 0, 0, 0
+		for ; end < len(changes) && changes[end].StoreName == storeName; end++ {
+			switch {
+			case changes[end].OldValue == nil:
+				added++
+			case changes[end].NewValue == nil:
+				deleted++
+			default:
+				updated++
+			}
+		}
+
+		fmt.Fprintf(w, "%s: %d added, %d updated, %d deleted\n", storeName, added, updated, deleted)
+		if !summaryOnly {
+			for _, change := range changes[start:end] {
+				switch {
+				case change.OldValue == nil:
+					fmt.Fprintf(w, "\t+ %X: %s\n", change.Key, formatStoreValue(change.NewValue))
+				case change.NewValue == nil:
+					fmt.Fprintf(w, "\t- %X: %s\n", change.Key, formatStoreValue(change.OldValue))
+				default:
+					fmt.Fprintf(w, "\t~ %X: %s -> %s\n", change.Key, formatStoreValue(change.OldValue), formatStoreValue(change.NewValue))
+				}
+			}
+		}
+		start = end
+	}
+}
+
+// formatStoreValue returns the value as a quoted string if it is printable, such as the JSON values of
+// the params store, or in hex otherwise.
+func formatStoreValue(value []byte) string {
+	if utf8.Valid(value) {
+		printable := true
+		for _, r := range string(value) {
+			if !unicode.IsPrint(r) {
+				printable = false
+				break
+			}
+		}
+		if printable {
+			return strconv.Quote(string(value))
+		}
+	}
+	return fmt.Sprintf("%X", value)
+}
@@ -41,6 +41,8 @@ localnet-help:
 	@echo "  cl-refresh-subgraph-positions   Refresh subgraph positions"
 	@echo "  cl-refresh-subgraph-genesis     Refresh subgraph genesis"
 	@echo "  cl-create-bigbang-config        Create Big Bang configuration"
+	@echo "  scenario                        Run the scenario in SCENARIO=<file>"
+	@echo "  faucet                          Send COINS=<coins> to TO=<address>"
 localnet: localnet-help
 
 localnet-keys:
@@ -152,3 +154,12 @@ localnet-cl-refresh-subgraph-genesis:
 # It writes the file under tests/cl-genesis-positions/bigbang_positions.json
 localnet-cl-create-bigbang-config:
 	go run ./tests/cl-genesis-positions --operation 1 --big-bang
+
+# runs the scripted scenario in the given file against localosmosis.
+# See tests/scenarios/README.md for the scenario format.
+localnet-scenario:
+	go run ./tests/scenarios run $(SCENARIO)
+
+# sends the given coins to the given address from the lo-test1 key.
+localnet-faucet:
+	go run ./tests/scenarios faucet $(TO) $(COINS)
@@ -176,6 +176,22 @@ func (n *NodeConfig) QueryParams(subspace, key string) string {
 	return result.Value
 }
 
+// QueryModuleParams returns the params of the given module, for the modules storing their params in their own store.
+// The params are returned as generic json, to be modified and submitted in a MsgUpdateParams.
+func (n *NodeConfig) QueryModuleParams(module string) map[string]interface{} {
+	cmd := []string{"osmosisd", "query", module, "params", "--output=json"}
+
+	out, _, err := n.containerManager.ExecCmd(n.t, n.Name, cmd, "", false, false)
+	require.NoError(n.t, err)
+
+	var result struct {
+		Params map[string]interface{} `json:"params"`
+	}
+	err = json.Unmarshal(out.Bytes(), &result)
+	require.NoError(n.t, err)
+	return result.Params
+}
+
 func (n *NodeConfig) QueryGovModuleAccount() string {
 	cmd := []string{"osmosisd", "query", "auth", "module-accounts", "--output=json"}
 
@@ -824,6 +840,44 @@ func (n *NodeConfig) ParamChangeProposal(subspace, key string, value []byte, cha
 	return nil
 }
 
+// UpdateParamsProposal passes a proposal executing the MsgUpdateParams of the given module,
+// with the current params of the module modified by updateParams.
+func (n *NodeConfig) UpdateParamsProposal(module, msgUpdateParamsTypeUrl string, updateParams func(params map[string]interface{}), chain *Config) error {
+	params := n.QueryModuleParams(module)
+	updateParams(params)
+
+	proposal := map[string]interface{}{
+		"messages": []interface{}{
+			map[string]interface{}{
+				"@type":     msgUpdateParamsTypeUrl,
+				"authority": n.QueryGovModuleAccount(),
+				"params":    params,
+			},
+		},
+		"title":     "Params Update",
+		"summary":   fmt.Sprintf("Updating the %s params", module),
+		"deposit":   strconv.Itoa(int(config.InitialMinExpeditedDeposit)) + appparams.BaseCoinUnit,
+		"expedited": false,
+	}
+	proposalJson, err := json.Marshal(proposal)
+	if err != nil {
+		return err
+	}
+
+	propNumber := n.SubmitNewV1ProposalType(string(proposalJson), initialization.ValidatorWalletName)
+
+	AllValsVoteOnProposal(chain, propNumber)
+
+	require.Eventually(n.t, func() bool {
+		status, err := n.QueryPropStatus(propNumber)
+		if err != nil {
+			return false
+		}
+		return status == proposalStatusPassed
+	}, time.Minute*2, 10*time.Millisecond)
+	return nil
+}
+
 func AllValsVoteOnProposal(chain *Config, propNumber int) {
 	var wg sync.WaitGroup
 
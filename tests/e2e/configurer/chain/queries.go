@@ -414,6 +414,18 @@ func (n *NodeConfig) QueryLatestWasmCodeID() uint64 {
 	return response.CodeInfos[len(response.CodeInfos)-1].CodeID
 }
 
+func (n *NodeConfig) QueryWasmCode(codeId uint64) []byte {
+	path := fmt.Sprintf("/cosmwasm/wasm/v1/code/%d", codeId)
+
+	bz, err := n.QueryGRPCGateway(path)
+	require.NoError(n.t, err)
+
+	var response wasmtypes.QueryCodeResponse
+	err = util.Cdc.UnmarshalJSON(bz, &response)
+	require.NoError(n.t, err)
+	return response.Data
+}
+
 func (n *NodeConfig) QueryWasmSmart(contract string, msg string, result any) error {
 	// base64-encode the msg
 	encodedMsg := base64.StdEncoding.EncodeToString([]byte(msg))
@@ -2,7 +2,6 @@ package e2e
 
 import (
 	"fmt"
-	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -148,16 +147,18 @@ func (s *IntegrationTestSuite) ConcentratedLiquidity() {
 
 	enablePermissionlessCl := func() {
 		// Get the permisionless pool creation parameter.
-		isPermisionlessCreationEnabledStr := chainBNode.QueryParams(cltypes.ModuleName, string(cltypes.KeyIsPermisionlessPoolCreationEnabled))
-		if !strings.EqualFold(isPermisionlessCreationEnabledStr, "true") {
+		isPermisionlessCreationEnabled := chainBNode.QueryModuleParams(cltypes.ModuleName)["is_permissionless_pool_creation_enabled"]
+		if isPermisionlessCreationEnabled != true {
 			// Change the parameter to enable permisionless pool creation.
-			err := chainBNode.ParamChangeProposal("concentratedliquidity", string(cltypes.KeyIsPermisionlessPoolCreationEnabled), []byte("true"), chainB, true)
+			err := chainBNode.UpdateParamsProposal(cltypes.ModuleName, sdk.MsgTypeURL(&cltypes.MsgUpdateParams{}), func(params map[string]interface{}) {
+				params["is_permissionless_pool_creation_enabled"] = true
+			}, chainB)
 			s.Require().NoError(err)
 		}
 
 		// Confirm that the parameter has been changed.
-		isPermisionlessCreationEnabledStr = chainBNode.QueryParams(cltypes.ModuleName, string(cltypes.KeyIsPermisionlessPoolCreationEnabled))
-		if !strings.EqualFold(isPermisionlessCreationEnabledStr, "true") {
+		isPermisionlessCreationEnabled = chainBNode.QueryModuleParams(cltypes.ModuleName)["is_permissionless_pool_creation_enabled"]
+		if isPermisionlessCreationEnabled != true {
 			s.T().Fatal("concentrated liquidity pool creation is not enabled")
 		}
 
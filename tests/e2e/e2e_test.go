@@ -1017,7 +1017,9 @@ func (s *IntegrationTestSuite) GeometricTWAP() {
 // As a result, we deterministically configure chain B's taker fee prior to running CL tests.
 func (s *IntegrationTestSuite) SetDefaultTakerFeeChainB() {
 	chainB, chainBNode := s.getChainBCfgs()
-	err := chainBNode.ParamChangeProposal("poolmanager", string(poolmanagertypes.KeyDefaultTakerFee), json.RawMessage(`"0.001500000000000000"`), chainB, true)
+	err := chainBNode.UpdateParamsProposal(poolmanagertypes.ModuleName, sdk.MsgTypeURL(&poolmanagertypes.MsgUpdateParams{}), func(params map[string]interface{}) {
+		params["taker_fee_params"].(map[string]interface{})["default_taker_fee"] = "0.001500000000000000"
+	}, chainB)
 	s.Require().NoError(err)
 }
 
@@ -7,7 +7,9 @@ import (
 	"time"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v21/tests/e2e/configurer/chain"
 	"github.com/osmosis-labs/osmosis/v21/tests/e2e/initialization"
 )
 
@@ -104,7 +106,35 @@ func (s *IntegrationTestSuite) StateSync() {
 		10*time.Millisecond,
 	)
 
+	s.verifyStateSyncedQueries(chainANode, stateSynchingNode)
+
 	// stop the state synching node.
 	err = chainA.RemoveTempNode(stateSynchingNode.Name)
 	s.Require().NoError(err)
 }
+
+// verifyStateSyncedQueries verifies that the state synced node serves the wasm code blobs, restored by the wasm
+// snapshot extension, and the concentrated liquidity pools, restored from the IAVL stores, as soon as it caught up.
+func (s *IntegrationTestSuite) verifyStateSyncedQueries(runningNode, stateSyncedNode *chain.NodeConfig) {
+	// Code and pools are compared up to the latest ones of the state synced node, since parallel tests may
+	// have added new ones to the running node since.
+	latestCodeId := stateSyncedNode.QueryLatestWasmCodeID()
+	for codeId := uint64(1); codeId <= latestCodeId; codeId++ {
+		s.Require().Equal(runningNode.QueryWasmCode(codeId), stateSyncedNode.QueryWasmCode(codeId))
+	}
+
+	numPools := stateSyncedNode.QueryNumPools()
+	for poolId := uint64(1); poolId <= numPools; poolId++ {
+		expectedPool, err := runningNode.QueryConcentratedPool(poolId)
+		if err != nil {
+			// not a concentrated liquidity pool.
+			continue
+		}
+		pool, err := stateSyncedNode.QueryConcentratedPool(poolId)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedPool.GetToken0(), pool.GetToken0())
+		s.Require().Equal(expectedPool.GetToken1(), pool.GetToken1())
+		s.Require().Equal(expectedPool.GetTickSpacing(), pool.GetTickSpacing())
+		s.Require().Equal(expectedPool.GetSpreadFactor(sdk.Context{}), pool.GetSpreadFactor(sdk.Context{}))
+	}
+}
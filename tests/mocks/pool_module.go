@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -115,6 +116,20 @@ func (m *MockBankI) EXPECT() *MockBankIMockRecorder {
 	return m.recorder
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankI) BurnCoins(ctx types.Context, moduleName string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankIMockRecorder) BurnCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankI)(nil).BurnCoins), ctx, moduleName, amt)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankI) GetAllBalances(ctx types.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -129,6 +144,35 @@ func (mr *MockBankIMockRecorder) GetAllBalances(ctx, addr interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankI)(nil).GetAllBalances), ctx, addr)
 }
 
+// GetDenomMetaData mocks base method.
+func (m *MockBankI) GetDenomMetaData(ctx types.Context, denom string) (types1.Metadata, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomMetaData", ctx, denom)
+	ret0, _ := ret[0].(types1.Metadata)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDenomMetaData indicates an expected call of GetDenomMetaData.
+func (mr *MockBankIMockRecorder) GetDenomMetaData(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankI)(nil).GetDenomMetaData), ctx, denom)
+}
+
+// GetSupply mocks base method.
+func (m *MockBankI) GetSupply(ctx types.Context, denom string) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupply", ctx, denom)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// GetSupply indicates an expected call of GetSupply.
+func (mr *MockBankIMockRecorder) GetSupply(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankI)(nil).GetSupply), ctx, denom)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankI) SendCoins(ctx types.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -533,6 +577,20 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// TotalBondedTokens mocks base method.
+func (m *MockStakingKeeper) TotalBondedTokens(ctx types.Context) osmomath.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalBondedTokens", ctx)
+	ret0, _ := ret[0].(osmomath.Int)
+	return ret0
+}
+
+// TotalBondedTokens indicates an expected call of TotalBondedTokens.
+func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), ctx)
+}
+
 // MockProtorevKeeper is a mock of ProtorevKeeper interface.
 type MockProtorevKeeper struct {
 	ctrl     *gomock.Controller
@@ -570,3 +628,41 @@ func (mr *MockProtorevKeeperMockRecorder) GetPoolForDenomPair(ctx, baseDenom, de
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolForDenomPair", reflect.TypeOf((*MockProtorevKeeper)(nil).GetPoolForDenomPair), ctx, baseDenom, denomToMatch)
 }
+
+// MockTwapKeeper is a mock of TwapKeeper interface.
+type MockTwapKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwapKeeperMockRecorder
+}
+
+// MockTwapKeeperMockRecorder is the mock recorder for MockTwapKeeper.
+type MockTwapKeeperMockRecorder struct {
+	mock *MockTwapKeeper
+}
+
+// NewMockTwapKeeper creates a new mock instance.
+func NewMockTwapKeeper(ctrl *gomock.Controller) *MockTwapKeeper {
+	mock := &MockTwapKeeper{ctrl: ctrl}
+	mock.recorder = &MockTwapKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwapKeeper) EXPECT() *MockTwapKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetArithmeticTwapToNow mocks base method.
+func (m *MockTwapKeeper) GetArithmeticTwapToNow(ctx types.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArithmeticTwapToNow", ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	ret0, _ := ret[0].(osmomath.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArithmeticTwapToNow indicates an expected call of GetArithmeticTwapToNow.
+func (mr *MockTwapKeeperMockRecorder) GetArithmeticTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArithmeticTwapToNow", reflect.TypeOf((*MockTwapKeeper)(nil).GetArithmeticTwapToNow), ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+}
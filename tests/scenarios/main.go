@@ -0,0 +1,104 @@
+// Command scenarios drives a running node, such as localosmosis, through scripted scenarios via gRPC,
+// so that the state and load conditions referenced in issues can be reproduced deterministically.
+//
+// Usage:
+//
+//	go run ./tests/scenarios [flags] run <scenario.json>
+//	go run ./tests/scenarios [flags] faucet <key name or address> <coins>
+//
+// See tests/scenarios/README.md for the scenario file format.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/client"
+)
+
+const (
+	// localosmosisHomeDir is the home directory of localosmosis, relative to the user home directory.
+	localosmosisHomeDir = ".osmosisd-local"
+	// defaultFaucetKey is the localosmosis key funding the faucet.
+	defaultFaucetKey = "lo-test1"
+)
+
+func main() {
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var (
+		cfg       = client.DefaultConfig()
+		gasPrice  string
+		faucetKey string
+	)
+	flag.StringVar(&cfg.GRPCAddress, "grpc", cfg.GRPCAddress, "gRPC address of the node")
+	flag.StringVar(&cfg.ChainId, "chain-id", cfg.ChainId, "chain id of the node")
+	flag.StringVar(&cfg.KeyringHome, "home", filepath.Join(userHome, localosmosisHomeDir), "home directory of the test keyring holding the signer keys")
+	flag.Uint64Var(&cfg.Gas, "gas", cfg.Gas, "gas limit of every transaction")
+	flag.StringVar(&gasPrice, "gas-price", cfg.GasPrice.String(), "gas price paid by every transaction")
+	flag.StringVar(&faucetKey, "faucet-key", defaultFaucetKey, "key funding the faucet")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage:\n  %[1]s [flags] run <scenario.json>\n  %[1]s [flags] faucet <key name or address> <coins>\n\nflags:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	cfg.GasPrice, err = sdk.ParseDecCoin(gasPrice)
+	if err != nil {
+		log.Fatalf("invalid gas price: %v", err)
+	}
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	switch {
+	case args[0] == "run" && len(args) == 2:
+		scenario, err := loadScenario(args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		c := mustNewClient(cfg)
+		defer c.Close()
+
+		log.Printf("running %d steps from %s against %s with seed %d", len(scenario.Steps), args[1], cfg.GRPCAddress, scenario.Seed)
+		if err := newRunner(context.Background(), c, scenario.Seed).run(scenario); err != nil {
+			log.Fatal(err)
+		}
+		log.Println("scenario completed")
+	case args[0] == "faucet" && len(args) == 3:
+		fund := &fundStep{From: faucetKey, To: []string{args[1]}, Coins: args[2]}
+		if err := fund.validate(nil); err != nil {
+			log.Fatal(err)
+		}
+		c := mustNewClient(cfg)
+		defer c.Close()
+
+		if err := fund.run(newRunner(context.Background(), c, defaultSeed)); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("sent %s to %s", args[2], args[1])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func mustNewClient(cfg client.Config) *client.Client {
+	c, err := client.New(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return c
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// defaultSeed is the seed of the scenario randomness if the scenario does not set one.
+const defaultSeed = 1
+
+// Scenario is a scripted sequence of steps run against a node.
+// Steps are run one after the other, each waiting for its transactions to be included in a block,
+// so that running the same scenario against the same initial state yields the same final state.
+type Scenario struct {
+	// Seed is the seed of the randomness used by the steps, such as the swap amounts of a swap load.
+	Seed int64 `json:"seed"`
+	// Steps are the steps of the scenario, in the order they are run.
+	Steps []step `json:"-"`
+}
+
+// step is a single step of a scenario.
+type step interface {
+	// validate checks the step without connecting to the node.
+	// definedPools are the pool aliases defined by the previous steps.
+	validate(definedPools map[string]bool) error
+	// run runs the step against the node.
+	run(r *runner) error
+}
+
+// stepTypes maps the type of each step in the scenario file to a constructor of the step.
+var stepTypes = map[string]func() step{
+	"fund":                     func() step { return &fundStep{} },
+	"create_balancer_pool":     func() step { return &createBalancerPoolStep{} },
+	"create_concentrated_pool": func() step { return &createConcentratedPoolStep{} },
+	"join_pool":                func() step { return &joinPoolStep{} },
+	"create_position":          func() step { return &createPositionStep{} },
+	"swap_load":                func() step { return &swapLoadStep{} },
+	"wait_epoch":               func() step { return &waitEpochStep{} },
+	"wait_blocks":              func() step { return &waitBlocksStep{} },
+}
+
+// fundStep sends coins from a funded key to each recipient, acting as a faucet.
+type fundStep struct {
+	From string `json:"from"`
+	// To are the key names or bech32 addresses of the recipients.
+	To    []string `json:"to"`
+	Coins string   `json:"coins"`
+}
+
+// createBalancerPoolStep creates a balancer pool, seeding it with its initial deposit.
+type createBalancerPoolStep struct {
+	Sender         string `json:"sender"`
+	Weights        string `json:"weights"`
+	InitialDeposit string `json:"initial_deposit"`
+	SwapFee        string `json:"swap_fee"`
+	ExitFee        string `json:"exit_fee"`
+	// As is the alias under which the created pool is referenced by the following steps.
+	As string `json:"as"`
+}
+
+// createConcentratedPoolStep creates a concentrated liquidity pool.
+type createConcentratedPoolStep struct {
+	Sender       string `json:"sender"`
+	Denom0       string `json:"denom0"`
+	Denom1       string `json:"denom1"`
+	TickSpacing  uint64 `json:"tick_spacing"`
+	SpreadFactor string `json:"spread_factor"`
+	// As is the alias under which the created pool is referenced by the following steps.
+	As string `json:"as"`
+}
+
+// joinPoolStep adds single sided liquidity to a balancer or stableswap pool.
+type joinPoolStep struct {
+	Sender  string `json:"sender"`
+	Pool    string `json:"pool"`
+	TokenIn string `json:"token_in"`
+}
+
+// createPositionStep creates a concentrated liquidity position, over the full range if no ticks are given.
+type createPositionStep struct {
+	Sender    string `json:"sender"`
+	Pool      string `json:"pool"`
+	LowerTick *int64 `json:"lower_tick"`
+	UpperTick *int64 `json:"upper_tick"`
+	Tokens    string `json:"tokens"`
+}
+
+// swapLoadStep makes many swaps of pseudo-random amounts through a single pool,
+// rotating between the senders.
+type swapLoadStep struct {
+	Pool          string   `json:"pool"`
+	Senders       []string `json:"senders"`
+	TokenInDenom  string   `json:"token_in_denom"`
+	TokenOutDenom string   `json:"token_out_denom"`
+	Count         int      `json:"count"`
+	MinAmount     int64    `json:"min_amount"`
+	MaxAmount     int64    `json:"max_amount"`
+	// Bidirectional makes every other swap go in the opposite direction, keeping the price around its initial value.
+	Bidirectional bool `json:"bidirectional"`
+	// BatchSize is the number of swaps broadcasted before waiting for them to be included in a block.
+	// Defaults to the number of senders.
+	BatchSize int `json:"batch_size"`
+}
+
+// waitEpochStep waits for the given number of epochs with the given identifier to end.
+type waitEpochStep struct {
+	Identifier string `json:"identifier"`
+	Count      int64  `json:"count"`
+}
+
+// waitBlocksStep waits for the given number of blocks to be committed.
+type waitBlocksStep struct {
+	Count int64 `json:"count"`
+}
+
+// loadScenario reads and validates the scenario in the given JSON file.
+func loadScenario(path string) (Scenario, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+	return parseScenario(bz)
+}
+
+// parseScenario parses and validates the given JSON scenario.
+func parseScenario(bz []byte) (Scenario, error) {
+	var raw struct {
+		Seed  *int64            `json:"seed"`
+		Steps []json.RawMessage `json:"steps"`
+	}
+	if err := strictUnmarshal(bz, &raw); err != nil {
+		return Scenario{}, fmt.Errorf("invalid scenario: %w", err)
+	}
+	if len(raw.Steps) == 0 {
+		return Scenario{}, errors.New("invalid scenario: no steps")
+	}
+
+	scenario := Scenario{Seed: defaultSeed}
+	if raw.Seed != nil {
+		scenario.Seed = *raw.Seed
+	}
+
+	definedPools := map[string]bool{}
+	for i, rawStep := range raw.Steps {
+		var header struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(rawStep, &header); err != nil {
+			return Scenario{}, fmt.Errorf("invalid step %d: %w", i, err)
+		}
+		newStep, ok := stepTypes[header.Type]
+		if !ok {
+			return Scenario{}, fmt.Errorf("invalid step %d: unknown step type %q", i, header.Type)
+		}
+
+		s := newStep()
+		if err := strictUnmarshal(withoutType(rawStep), s); err != nil {
+			return Scenario{}, fmt.Errorf("invalid step %d (%s): %w", i, header.Type, err)
+		}
+		if err := s.validate(definedPools); err != nil {
+			return Scenario{}, fmt.Errorf("invalid step %d (%s): %w", i, header.Type, err)
+		}
+		scenario.Steps = append(scenario.Steps, s)
+	}
+	return scenario, nil
+}
+
+// strictUnmarshal unmarshals the given JSON into v, failing on unknown fields so that typos in scenarios are caught.
+func strictUnmarshal(bz []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// withoutType returns the given JSON step without its type field.
+func withoutType(rawStep json.RawMessage) []byte {
+	fields := map[string]json.RawMessage{}
+	// The step was already unmarshalled successfully, so this cannot fail.
+	_ = json.Unmarshal(rawStep, &fields)
+	delete(fields, "type")
+	bz, _ := json.Marshal(fields)
+	return bz
+}
+
+func (s *fundStep) validate(_ map[string]bool) error {
+	if s.From == "" {
+		return errors.New("from is required")
+	}
+	if len(s.To) == 0 {
+		return errors.New("to is required")
+	}
+	return validateCoins("coins", s.Coins)
+}
+
+func (s *createBalancerPoolStep) validate(definedPools map[string]bool) error {
+	if s.Sender == "" {
+		return errors.New("sender is required")
+	}
+	weights, err := sdk.ParseDecCoins(s.Weights)
+	if err != nil {
+		return fmt.Errorf("invalid weights: %w", err)
+	}
+	if err := validateCoins("initial_deposit", s.InitialDeposit); err != nil {
+		return err
+	}
+	deposit, _ := sdk.ParseCoinsNormalized(s.InitialDeposit)
+	if len(weights) != len(deposit) {
+		return errors.New("initial_deposit and weights must have the same denoms")
+	}
+	for i := range weights {
+		if weights[i].Denom != deposit[i].Denom {
+			return errors.New("initial_deposit and weights must have the same denoms")
+		}
+	}
+	if err := validateDec("swap_fee", s.SwapFee); err != nil {
+		return err
+	}
+	if err := validateDec("exit_fee", s.ExitFee); err != nil {
+		return err
+	}
+	return definePool(definedPools, s.As)
+}
+
+func (s *createConcentratedPoolStep) validate(definedPools map[string]bool) error {
+	if s.Sender == "" {
+		return errors.New("sender is required")
+	}
+	if err := sdk.ValidateDenom(s.Denom0); err != nil {
+		return fmt.Errorf("invalid denom0: %w", err)
+	}
+	if err := sdk.ValidateDenom(s.Denom1); err != nil {
+		return fmt.Errorf("invalid denom1: %w", err)
+	}
+	if s.TickSpacing == 0 {
+		return errors.New("tick_spacing must be positive")
+	}
+	if err := validateDec("spread_factor", s.SpreadFactor); err != nil {
+		return err
+	}
+	return definePool(definedPools, s.As)
+}
+
+func (s *joinPoolStep) validate(definedPools map[string]bool) error {
+	if s.Sender == "" {
+		return errors.New("sender is required")
+	}
+	if err := validatePoolRef(definedPools, s.Pool); err != nil {
+		return err
+	}
+	if _, err := sdk.ParseCoinNormalized(s.TokenIn); err != nil {
+		return fmt.Errorf("invalid token_in: %w", err)
+	}
+	return nil
+}
+
+func (s *createPositionStep) validate(definedPools map[string]bool) error {
+	if s.Sender == "" {
+		return errors.New("sender is required")
+	}
+	if err := validatePoolRef(definedPools, s.Pool); err != nil {
+		return err
+	}
+	if (s.LowerTick == nil) != (s.UpperTick == nil) {
+		return errors.New("lower_tick and upper_tick must be set together")
+	}
+	if s.LowerTick != nil && *s.LowerTick >= *s.UpperTick {
+		return errors.New("lower_tick must be less than upper_tick")
+	}
+	return validateCoins("tokens", s.Tokens)
+}
+
+func (s *swapLoadStep) validate(definedPools map[string]bool) error {
+	if err := validatePoolRef(definedPools, s.Pool); err != nil {
+		return err
+	}
+	if len(s.Senders) == 0 {
+		return errors.New("senders is required")
+	}
+	if err := sdk.ValidateDenom(s.TokenInDenom); err != nil {
+		return fmt.Errorf("invalid token_in_denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(s.TokenOutDenom); err != nil {
+		return fmt.Errorf("invalid token_out_denom: %w", err)
+	}
+	if s.Count <= 0 {
+		return errors.New("count must be positive")
+	}
+	if s.MinAmount <= 0 || s.MaxAmount < s.MinAmount {
+		return errors.New("min_amount must be positive and not greater than max_amount")
+	}
+	if s.BatchSize < 0 {
+		return errors.New("batch_size must not be negative")
+	}
+	return nil
+}
+
+func (s *waitEpochStep) validate(_ map[string]bool) error {
+	if s.Identifier == "" {
+		return errors.New("identifier is required")
+	}
+	if s.Count < 0 {
+		return errors.New("count must not be negative")
+	}
+	return nil
+}
+
+func (s *waitBlocksStep) validate(_ map[string]bool) error {
+	if s.Count <= 0 {
+		return errors.New("count must be positive")
+	}
+	return nil
+}
+
+func validateCoins(field, coins string) error {
+	parsed, err := sdk.ParseCoinsNormalized(coins)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", field, err)
+	}
+	if parsed.Empty() {
+		return fmt.Errorf("%s is required", field)
+	}
+	return nil
+}
+
+func validateDec(field, dec string) error {
+	parsed, err := osmomath.NewDecFromStr(dec)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", field, err)
+	}
+	if parsed.IsNegative() {
+		return fmt.Errorf("%s must not be negative", field)
+	}
+	return nil
+}
+
+// definePool records the given pool alias as defined, if set.
+func definePool(definedPools map[string]bool, alias string) error {
+	if alias == "" {
+		return nil
+	}
+	if _, err := strconv.ParseUint(alias, 10, 64); err == nil {
+		return fmt.Errorf("pool alias %s must not be a number", alias)
+	}
+	if definedPools[alias] {
+		return fmt.Errorf("pool alias %s is already defined", alias)
+	}
+	definedPools[alias] = true
+	return nil
+}
+
+// validatePoolRef checks that the given pool is either a pool id or an alias defined by a previous step.
+func validatePoolRef(definedPools map[string]bool, pool string) error {
+	if pool == "" {
+		return errors.New("pool is required")
+	}
+	if _, err := strconv.ParseUint(pool, 10, 64); err == nil {
+		return nil
+	}
+	if !definedPools[pool] {
+		return fmt.Errorf("pool %s is neither a pool id nor an alias defined by a previous step", pool)
+	}
+	return nil
+}
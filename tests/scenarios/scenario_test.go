@@ -0,0 +1,93 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScenario(t *testing.T) {
+	tests := map[string]struct {
+		scenario      string
+		expectedSeed  int64
+		expectedSteps int
+		expectedErr   string
+	}{
+		"valid scenario": {
+			scenario: `{"seed": 5, "steps": [
+				{"type": "create_balancer_pool", "sender": "lo-test1", "weights": "1uosmo,1uusdc", "initial_deposit": "100uosmo,100uusdc", "swap_fee": "0.01", "exit_fee": "0", "as": "pool"},
+				{"type": "swap_load", "pool": "pool", "senders": ["lo-test2"], "token_in_denom": "uosmo", "token_out_denom": "uusdc", "count": 2, "min_amount": 1, "max_amount": 10},
+				{"type": "join_pool", "sender": "lo-test1", "pool": "1", "token_in": "10uosmo"},
+				{"type": "wait_epoch", "identifier": "hour"}
+			]}`,
+			expectedSeed:  5,
+			expectedSteps: 4,
+		},
+		"seed defaults": {
+			scenario:      `{"steps": [{"type": "wait_blocks", "count": 1}]}`,
+			expectedSeed:  defaultSeed,
+			expectedSteps: 1,
+		},
+		"no steps": {
+			scenario:    `{"seed": 1}`,
+			expectedErr: "no steps",
+		},
+		"unknown step type": {
+			scenario:    `{"steps": [{"type": "teleport"}]}`,
+			expectedErr: `unknown step type "teleport"`,
+		},
+		"unknown step field": {
+			scenario:    `{"steps": [{"type": "wait_blocks", "count": 1, "cuont": 2}]}`,
+			expectedErr: `unknown field "cuont"`,
+		},
+		"pool alias used before being defined": {
+			scenario:    `{"steps": [{"type": "join_pool", "sender": "lo-test1", "pool": "pool", "token_in": "10uosmo"}]}`,
+			expectedErr: "pool pool is neither a pool id nor an alias defined by a previous step",
+		},
+		"pool alias defined twice": {
+			scenario: `{"steps": [
+				{"type": "create_concentrated_pool", "sender": "lo-test1", "denom0": "uosmo", "denom1": "uusdc", "tick_spacing": 100, "spread_factor": "0.001", "as": "pool"},
+				{"type": "create_concentrated_pool", "sender": "lo-test1", "denom0": "uosmo", "denom1": "uion", "tick_spacing": 100, "spread_factor": "0.001", "as": "pool"}
+			]}`,
+			expectedErr: "pool alias pool is already defined",
+		},
+		"balancer weights and deposit mismatch": {
+			scenario:    `{"steps": [{"type": "create_balancer_pool", "sender": "lo-test1", "weights": "1uosmo,1uion", "initial_deposit": "100uosmo,100uusdc", "swap_fee": "0.01", "exit_fee": "0"}]}`,
+			expectedErr: "initial_deposit and weights must have the same denoms",
+		},
+		"position with only one tick": {
+			scenario:    `{"steps": [{"type": "create_position", "sender": "lo-test1", "pool": "1", "lower_tick": -100, "tokens": "100uosmo"}]}`,
+			expectedErr: "lower_tick and upper_tick must be set together",
+		},
+		"swap load with invalid amount range": {
+			scenario:    `{"steps": [{"type": "swap_load", "pool": "1", "senders": ["lo-test2"], "token_in_denom": "uosmo", "token_out_denom": "uusdc", "count": 2, "min_amount": 10, "max_amount": 1}]}`,
+			expectedErr: "min_amount must be positive and not greater than max_amount",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			scenario, err := parseScenario([]byte(tc.scenario))
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSeed, scenario.Seed)
+			require.Len(t, scenario.Steps, tc.expectedSteps)
+		})
+	}
+}
+
+// TestExampleScenarios checks that the example scenarios are valid.
+func TestExampleScenarios(t *testing.T) {
+	paths, err := filepath.Glob("examples/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		_, err := loadScenario(path)
+		require.NoError(t, err, path)
+	}
+}
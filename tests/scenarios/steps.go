@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/client"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// pollInterval is the interval at which the node is polled while waiting for a state change.
+const pollInterval = time.Second
+
+// runner runs the steps of a scenario against a node.
+type runner struct {
+	ctx    context.Context
+	client *client.Client
+	rand   *rand.Rand
+	// pools maps the pool aliases defined by the steps run so far to the ids of the created pools.
+	pools map[string]uint64
+}
+
+func newRunner(ctx context.Context, c *client.Client, seed int64) *runner {
+	return &runner{
+		ctx:    ctx,
+		client: c,
+		rand:   rand.New(rand.NewSource(seed)),
+		pools:  map[string]uint64{},
+	}
+}
+
+// run runs all the steps of the scenario in order, stopping at the first step that fails.
+func (r *runner) run(scenario Scenario) error {
+	for i, s := range scenario.Steps {
+		start := time.Now()
+		log.Printf("step %d/%d: running %T", i+1, len(scenario.Steps), s)
+		if err := s.run(r); err != nil {
+			return fmt.Errorf("step %d failed: %w", i+1, err)
+		}
+		log.Printf("step %d/%d: done in %s", i+1, len(scenario.Steps), time.Since(start))
+	}
+	return nil
+}
+
+// poolId returns the id of the given pool id or alias.
+func (r *runner) poolId(pool string) (uint64, error) {
+	if poolId, err := strconv.ParseUint(pool, 10, 64); err == nil {
+		return poolId, nil
+	}
+	poolId, ok := r.pools[pool]
+	if !ok {
+		return 0, fmt.Errorf("pool alias %s is not defined", pool)
+	}
+	return poolId, nil
+}
+
+// createPool broadcasts the given pool creation message and records the id of the created pool under the given alias.
+func (r *runner) createPool(sender, alias string, msg sdk.Msg) error {
+	txResp, err := r.client.BroadcastAndWait(r.ctx, sender, msg)
+	if err != nil {
+		return err
+	}
+	poolId, err := client.CreatedPoolId(txResp)
+	if err != nil {
+		return err
+	}
+	if alias != "" {
+		r.pools[alias] = poolId
+	}
+	log.Printf("created pool %d %s", poolId, alias)
+	return nil
+}
+
+func (s *fundStep) run(r *runner) error {
+	sender, err := r.client.ResolveAddress(s.From)
+	if err != nil {
+		return err
+	}
+	coins, err := sdk.ParseCoinsNormalized(s.Coins)
+	if err != nil {
+		return err
+	}
+
+	msgs := make([]sdk.Msg, 0, len(s.To))
+	for _, to := range s.To {
+		recipient, err := r.client.ResolveAddress(to)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, banktypes.NewMsgSend(sender, recipient, coins))
+	}
+	_, err = r.client.BroadcastAndWait(r.ctx, s.From, msgs...)
+	return err
+}
+
+func (s *createBalancerPoolStep) run(r *runner) error {
+	sender, err := r.client.ResolveAddress(s.Sender)
+	if err != nil {
+		return err
+	}
+	weights, err := sdk.ParseDecCoins(s.Weights)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(s.InitialDeposit)
+	if err != nil {
+		return err
+	}
+
+	poolAssets := make([]balancer.PoolAsset, 0, len(weights))
+	for i := range weights {
+		poolAssets = append(poolAssets, balancer.PoolAsset{
+			Weight: weights[i].Amount.RoundInt(),
+			Token:  deposit[i],
+		})
+	}
+	poolParams := balancer.NewPoolParams(osmomath.MustNewDecFromStr(s.SwapFee), osmomath.MustNewDecFromStr(s.ExitFee), nil)
+
+	msg := balancer.NewMsgCreateBalancerPool(sender, poolParams, poolAssets, "")
+	return r.createPool(s.Sender, s.As, &msg)
+}
+
+func (s *createConcentratedPoolStep) run(r *runner) error {
+	sender, err := r.client.ResolveAddress(s.Sender)
+	if err != nil {
+		return err
+	}
+
+	msg := model.NewMsgCreateConcentratedPool(sender, s.Denom0, s.Denom1, s.TickSpacing, osmomath.MustNewDecFromStr(s.SpreadFactor))
+	return r.createPool(s.Sender, s.As, &msg)
+}
+
+func (s *joinPoolStep) run(r *runner) error {
+	sender, err := r.client.ResolveAddress(s.Sender)
+	if err != nil {
+		return err
+	}
+	poolId, err := r.poolId(s.Pool)
+	if err != nil {
+		return err
+	}
+	tokenIn, err := sdk.ParseCoinNormalized(s.TokenIn)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.BroadcastAndWait(r.ctx, s.Sender, &gammtypes.MsgJoinSwapExternAmountIn{
+		Sender:            sender.String(),
+		PoolId:            poolId,
+		TokenIn:           tokenIn,
+		ShareOutMinAmount: osmomath.OneInt(),
+	})
+	return err
+}
+
+func (s *createPositionStep) run(r *runner) error {
+	sender, err := r.client.ResolveAddress(s.Sender)
+	if err != nil {
+		return err
+	}
+	poolId, err := r.poolId(s.Pool)
+	if err != nil {
+		return err
+	}
+	tokens, err := sdk.ParseCoinsNormalized(s.Tokens)
+	if err != nil {
+		return err
+	}
+
+	lowerTick, upperTick := cltypes.MinInitializedTick, cltypes.MaxTick
+	if s.LowerTick != nil {
+		lowerTick, upperTick = *s.LowerTick, *s.UpperTick
+	}
+
+	_, err = r.client.BroadcastAndWait(r.ctx, s.Sender, &cltypes.MsgCreatePosition{
+		PoolId:          poolId,
+		Sender:          sender.String(),
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		TokensProvided:  tokens,
+		TokenMinAmount0: osmomath.ZeroInt(),
+		TokenMinAmount1: osmomath.ZeroInt(),
+	})
+	return err
+}
+
+func (s *swapLoadStep) run(r *runner) error {
+	poolId, err := r.poolId(s.Pool)
+	if err != nil {
+		return err
+	}
+	batchSize := s.BatchSize
+	if batchSize == 0 {
+		batchSize = len(s.Senders)
+	}
+
+	start := time.Now()
+	txHashes := make([]string, 0, batchSize)
+	for i := 0; i < s.Count; i++ {
+		signer := s.Senders[i%len(s.Senders)]
+		sender, err := r.client.ResolveAddress(signer)
+		if err != nil {
+			return err
+		}
+
+		tokenInDenom, tokenOutDenom := s.TokenInDenom, s.TokenOutDenom
+		if s.Bidirectional && i%2 == 1 {
+			tokenInDenom, tokenOutDenom = tokenOutDenom, tokenInDenom
+		}
+		amount := s.MinAmount + r.rand.Int63n(s.MaxAmount-s.MinAmount+1)
+
+		txHash, err := r.client.Broadcast(r.ctx, signer, &poolmanagertypes.MsgSwapExactAmountIn{
+			Sender:            sender.String(),
+			Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}},
+			TokenIn:           sdk.NewCoin(tokenInDenom, osmomath.NewInt(amount)),
+			TokenOutMinAmount: osmomath.OneInt(),
+		})
+		if err != nil {
+			return fmt.Errorf("swap %d failed: %w", i, err)
+		}
+		txHashes = append(txHashes, txHash)
+
+		if len(txHashes) == batchSize || i == s.Count-1 {
+			for _, txHash := range txHashes {
+				if _, err := r.client.WaitForTx(r.ctx, txHash); err != nil {
+					return err
+				}
+			}
+			txHashes = txHashes[:0]
+			log.Printf("%d/%d swaps done", i+1, s.Count)
+		}
+	}
+
+	elapsed := time.Since(start)
+	log.Printf("made %d swaps in pool %d in %s (%.2f swaps/s)", s.Count, poolId, elapsed, float64(s.Count)/elapsed.Seconds())
+	return nil
+}
+
+func (s *waitEpochStep) run(r *runner) error {
+	count := s.Count
+	if count == 0 {
+		count = 1
+	}
+
+	currentEpoch, err := r.client.CurrentEpoch(r.ctx, s.Identifier)
+	if err != nil {
+		return err
+	}
+	targetEpoch := currentEpoch + count
+	log.Printf("waiting for epoch %s to reach %d, currently %d", s.Identifier, targetEpoch, currentEpoch)
+
+	for currentEpoch < targetEpoch {
+		time.Sleep(pollInterval)
+		currentEpoch, err = r.client.CurrentEpoch(r.ctx, s.Identifier)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *waitBlocksStep) run(r *runner) error {
+	height, err := r.client.LatestHeight(r.ctx)
+	if err != nil {
+		return err
+	}
+	targetHeight := height + s.Count
+
+	for height < targetHeight {
+		time.Sleep(pollInterval)
+		height, err = r.client.LatestHeight(r.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package bindings
 
-import "github.com/osmosis-labs/osmosis/osmomath"
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
 
 type OsmosisMsg struct {
 	/// Contracts can create denoms, namespaced under the contract's address.
@@ -15,6 +19,9 @@ type OsmosisMsg struct {
 	/// that they are the admin of.
 	/// Currently, the burn from address must be the admin contract.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	/// Contracts can swap an exact amount of tokens in, through a route of pools,
+	/// for at least a minimum amount of tokens out.
+	SwapExactAmountIn *SwapExactAmountIn `json:"swap_exact_amount_in,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -46,3 +53,19 @@ type BurnTokens struct {
 	// BurnFromAddress must be set to "" for now.
 	BurnFromAddress string `json:"burn_from_address"`
 }
+
+type SwapExactAmountIn struct {
+	Routes            []SwapAmountInRoute `json:"routes"`
+	TokenIn           wasmvmtypes.Coin    `json:"token_in"`
+	TokenOutMinAmount osmomath.Int        `json:"token_out_min_amount"`
+}
+
+type SwapAmountInRoute struct {
+	PoolId        uint64 `json:"pool_id"`
+	TokenOutDenom string `json:"token_out_denom"`
+}
+
+// SwapExactAmountInResponse is returned as the data of the SwapExactAmountIn message.
+type SwapExactAmountInResponse struct {
+	TokenOutAmount osmomath.Int `json:"token_out_amount"`
+}
@@ -1,5 +1,7 @@
 package bindings
 
+import wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
 // OsmosisQuery contains osmosis custom queries.
 // See https://github.com/osmosis-labs/osmosis-bindings/blob/main/packages/bindings/src/query.rs
 type OsmosisQuery struct {
@@ -8,6 +10,15 @@ type OsmosisQuery struct {
 	FullDenom *FullDenom `json:"full_denom,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Returns the liquidity of a pool, and its total shares if it is a gamm pool.
+	PoolState *PoolState `json:"pool_state,omitempty"`
+	/// Returns the spot price of the base asset of a pool, in units of its quote asset.
+	SpotPrice *SpotPrice `json:"spot_price,omitempty"`
+	/// Returns the arithmetic TWAP of the base asset of a pool, in units of its quote asset,
+	/// between the start time and the end time, or the block time if the end time is not set.
+	ArithmeticTwap *ArithmeticTwap `json:"arithmetic_twap,omitempty"`
+	/// Returns the concentrated liquidity positions of an address, in a pool or in all pools if the pool id is 0.
+	ConcentratedPositions *ConcentratedPositions `json:"concentrated_positions,omitempty"`
 }
 
 type FullDenom struct {
@@ -26,3 +37,59 @@ type DenomAdminResponse struct {
 type FullDenomResponse struct {
 	Denom string `json:"denom"`
 }
+
+type PoolState struct {
+	PoolId uint64 `json:"pool_id"`
+}
+
+type PoolStateResponse struct {
+	Assets wasmvmtypes.Coins `json:"assets"`
+	// Shares is only set for gamm pools.
+	Shares *wasmvmtypes.Coin `json:"shares,omitempty"`
+}
+
+type SpotPrice struct {
+	PoolId          uint64 `json:"pool_id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+}
+
+type SpotPriceResponse struct {
+	// SpotPrice is a decimal string.
+	SpotPrice string `json:"spot_price"`
+}
+
+type ArithmeticTwap struct {
+	PoolId          uint64 `json:"pool_id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	// StartTime and EndTime are unix times in milliseconds.
+	StartTime int64  `json:"start_time"`
+	EndTime   *int64 `json:"end_time,omitempty"`
+}
+
+type ArithmeticTwapResponse struct {
+	// Twap is a decimal string.
+	Twap string `json:"twap"`
+}
+
+type ConcentratedPositions struct {
+	Address string `json:"address"`
+	PoolId  uint64 `json:"pool_id"`
+}
+
+type ConcentratedPosition struct {
+	PositionId uint64 `json:"position_id"`
+	PoolId     uint64 `json:"pool_id"`
+	LowerTick  int64  `json:"lower_tick"`
+	UpperTick  int64  `json:"upper_tick"`
+	// Liquidity is a decimal string.
+	Liquidity string `json:"liquidity"`
+	// Asset0 and Asset1 are the amounts of the pool assets the position holds at the current tick.
+	Asset0 wasmvmtypes.Coin `json:"asset0"`
+	Asset1 wasmvmtypes.Coin `json:"asset1"`
+}
+
+type ConcentratedPositionsResponse struct {
+	Positions []ConcentratedPosition `json:"positions"`
+}
@@ -11,17 +11,20 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v21/wasmbinding/bindings"
 
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v21/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v21/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper, poolManager *poolmanager.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
 			wrapped:      old,
 			bank:         bank,
 			tokenFactory: tokenFactory,
+			poolManager:  poolManager,
 		}
 	}
 }
@@ -30,6 +33,7 @@ type CustomMessenger struct {
 	wrapped      wasmkeeper.Messenger
 	bank         *bankkeeper.BaseKeeper
 	tokenFactory *tokenfactorykeeper.Keeper
+	poolManager  *poolmanager.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -55,6 +59,9 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 		}
+		if contractMsg.SwapExactAmountIn != nil {
+			return m.swapExactAmountIn(ctx, contractAddr, contractMsg.SwapExactAmountIn)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -193,6 +200,58 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	return nil
 }
 
+// swapExactAmountIn swaps an exact amount of tokens in through a route of pools.
+func (m *CustomMessenger) swapExactAmountIn(ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapExactAmountIn) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformSwapExactAmountIn(m.poolManager, ctx, contractAddr, swap)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform swap exact amount in")
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "swap exact amount in response")
+	}
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformSwapExactAmountIn validates the swap message and swaps through the pool manager, on behalf of the contract.
+func PerformSwapExactAmountIn(p *poolmanager.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapExactAmountIn) (*bindings.SwapExactAmountInResponse, error) {
+	if swap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "swap exact amount in null swap"}
+	}
+
+	tokenIn, err := wasmkeeper.ConvertWasmCoinToSdkCoin(swap.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]poolmanagertypes.SwapAmountInRoute, 0, len(swap.Routes))
+	for _, route := range swap.Routes {
+		routes = append(routes, poolmanagertypes.SwapAmountInRoute{
+			PoolId:        route.PoolId,
+			TokenOutDenom: route.TokenOutDenom,
+		})
+	}
+
+	sdkMsg := &poolmanagertypes.MsgSwapExactAmountIn{
+		Sender:            contractAddr.String(),
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: swap.TokenOutMinAmount,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Swap through pool manager / message server
+	msgServer := poolmanager.NewMsgServerImpl(p)
+	res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "swapping from message")
+	}
+	return &bindings.SwapExactAmountInResponse{TokenOutAmount: res.TokenOutAmount}, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
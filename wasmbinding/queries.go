@@ -2,21 +2,33 @@ package wasmbinding
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/wasmbinding/bindings"
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v21/x/tokenfactory/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/twap"
 )
 
 type QueryPlugin struct {
-	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	tokenFactoryKeeper          *tokenfactorykeeper.Keeper
+	poolManagerKeeper           *poolmanager.Keeper
+	twapKeeper                  *twap.Keeper
+	concentratedLiquidityKeeper *concentratedliquidity.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper, pmk *poolmanager.Keeper, tk *twap.Keeper, clk *concentratedliquidity.Keeper) *QueryPlugin {
 	return &QueryPlugin{
-		tokenFactoryKeeper: tfk,
+		tokenFactoryKeeper:          tfk,
+		poolManagerKeeper:           pmk,
+		twapKeeper:                  tk,
+		concentratedLiquidityKeeper: clk,
 	}
 }
 
@@ -29,3 +41,92 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindings.De
 
 	return &bindings.DenomAdminResponse{Admin: metadata.Admin}, nil
 }
+
+// GetPoolState is a query to get the liquidity of a pool, and its total shares if it is a gamm pool.
+func (qp QueryPlugin) GetPoolState(ctx sdk.Context, poolId uint64) (*bindings.PoolStateResponse, error) {
+	pool, err := qp.poolManagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pool %d: %w", poolId, err)
+	}
+
+	liquidity, err := qp.poolManagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get liquidity of pool %d: %w", poolId, err)
+	}
+
+	res := &bindings.PoolStateResponse{Assets: ConvertSdkCoinsToWasmCoins(liquidity)}
+	if cfmmPool, ok := pool.(gammtypes.CFMMPoolI); ok {
+		shares := ConvertSdkCoinToWasmCoin(sdk.NewCoin(gammtypes.GetPoolShareDenom(poolId), cfmmPool.GetTotalShares()))
+		res.Shares = &shares
+	}
+
+	return res, nil
+}
+
+// GetSpotPrice is a query to get the spot price of the base asset of a pool, in units of its quote asset.
+func (qp QueryPlugin) GetSpotPrice(ctx sdk.Context, spotPrice *bindings.SpotPrice) (*bindings.SpotPriceResponse, error) {
+	price, err := qp.poolManagerKeeper.RouteCalculateSpotPrice(ctx, spotPrice.PoolId, spotPrice.QuoteAssetDenom, spotPrice.BaseAssetDenom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spot price of pool %d: %w", spotPrice.PoolId, err)
+	}
+
+	return &bindings.SpotPriceResponse{SpotPrice: price.String()}, nil
+}
+
+// GetArithmeticTwap is a query to get the arithmetic TWAP of the base asset of a pool, in units of its quote asset.
+func (qp QueryPlugin) GetArithmeticTwap(ctx sdk.Context, arithmeticTwap *bindings.ArithmeticTwap) (*bindings.ArithmeticTwapResponse, error) {
+	startTime := time.UnixMilli(arithmeticTwap.StartTime).UTC()
+
+	var value osmomath.Dec
+	var err error
+	if arithmeticTwap.EndTime == nil {
+		value, err = qp.twapKeeper.GetArithmeticTwapToNow(ctx, arithmeticTwap.PoolId, arithmeticTwap.BaseAssetDenom, arithmeticTwap.QuoteAssetDenom, startTime)
+	} else {
+		endTime := time.UnixMilli(*arithmeticTwap.EndTime).UTC()
+		value, err = qp.twapKeeper.GetArithmeticTwap(ctx, arithmeticTwap.PoolId, arithmeticTwap.BaseAssetDenom, arithmeticTwap.QuoteAssetDenom, startTime, endTime)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get arithmetic twap of pool %d: %w", arithmeticTwap.PoolId, err)
+	}
+
+	return &bindings.ArithmeticTwapResponse{Twap: value.String()}, nil
+}
+
+// GetConcentratedPositions is a query to get the concentrated liquidity positions of an address, with the amounts
+// of the pool assets they hold at the current tick.
+func (qp QueryPlugin) GetConcentratedPositions(ctx sdk.Context, concentratedPositions *bindings.ConcentratedPositions) (*bindings.ConcentratedPositionsResponse, error) {
+	address, err := parseAddress(concentratedPositions.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := qp.concentratedLiquidityKeeper.GetUserPositions(ctx, address, concentratedPositions.PoolId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get positions of %s: %w", concentratedPositions.Address, err)
+	}
+
+	res := &bindings.ConcentratedPositionsResponse{Positions: make([]bindings.ConcentratedPosition, 0, len(positions))}
+	for _, position := range positions {
+		pool, err := qp.concentratedLiquidityKeeper.GetConcentratedPoolById(ctx, position.PoolId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pool %d: %w", position.PoolId, err)
+		}
+
+		asset0, asset1, err := concentratedliquidity.CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get assets of position %d: %w", position.PositionId, err)
+		}
+
+		res.Positions = append(res.Positions, bindings.ConcentratedPosition{
+			PositionId: position.PositionId,
+			PoolId:     position.PoolId,
+			LowerTick:  position.LowerTick,
+			UpperTick:  position.UpperTick,
+			Liquidity:  position.Liquidity.String(),
+			Asset0:     ConvertSdkCoinToWasmCoin(asset0),
+			Asset1:     ConvertSdkCoinToWasmCoin(asset1),
+		})
+	}
+
+	return res, nil
+}
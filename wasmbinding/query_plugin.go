@@ -14,10 +14,10 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/wasmbinding/bindings"
 )
 
-// StargateQuerier dispatches whitelisted stargate queries
-func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+// StargateQuerier dispatches stargate queries whitelisted by the chain or by governance
+func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec, whitelistKeeper *StargateWhitelistKeeper) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
-		protoResponseType, err := GetWhitelistedQuery(request.Path)
+		protoResponseType, err := whitelistKeeper.GetWhitelistedQuery(ctx, request.Path)
 		if err != nil {
 			return nil, err
 		}
@@ -86,6 +86,58 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.PoolState != nil:
+			res, err := qp.GetPoolState(ctx, contractQuery.PoolState.PoolId)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal PoolStateResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.SpotPrice != nil:
+			res, err := qp.GetSpotPrice(ctx, contractQuery.SpotPrice)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal SpotPriceResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.ArithmeticTwap != nil:
+			res, err := qp.GetArithmeticTwap(ctx, contractQuery.ArithmeticTwap)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal ArithmeticTwapResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.ConcentratedPositions != nil:
+			res, err := qp.GetConcentratedPositions(ctx, contractQuery.ConcentratedPositions)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal ConcentratedPositionsResponse response: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
@@ -16,6 +16,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	proto "github.com/golang/protobuf/proto" //nolint:staticcheck // we're intentionally using this deprecated package to be compatible with cosmos protos
 	"github.com/stretchr/testify/suite"
 
@@ -197,6 +198,37 @@ func (suite *StargateTestSuite) TestStargateQuerier() {
 			},
 			expectedQuerierError: true,
 		},
+		{
+			name: "happy path query whitelisted by governance",
+			path: "/cosmos.staking.v1beta1.Query/Pool",
+			testSetup: func() {
+				suite.app.StargateWhitelistKeeper.SetParams(suite.ctx, wasmbinding.Params{
+					WhitelistedQueries: []wasmbinding.WhitelistedQuery{{
+						QueryPath:    "/cosmos.staking.v1beta1.Query/Pool",
+						ResponseType: "cosmos.staking.v1beta1.QueryPoolResponse",
+					}},
+				})
+			},
+			requestData: func() []byte {
+				poolrequest := stakingtypes.QueryPoolRequest{}
+				bz, err := proto.Marshal(&poolrequest)
+				suite.Require().NoError(err)
+				return bz
+			},
+			responseProtoStruct: &stakingtypes.QueryPoolResponse{},
+			resendRequest:       true,
+		},
+		{
+			name: "query not whitelisted by governance",
+			path: "/cosmos.staking.v1beta1.Query/Pool",
+			requestData: func() []byte {
+				poolrequest := stakingtypes.QueryPoolRequest{}
+				bz, err := proto.Marshal(&poolrequest)
+				suite.Require().NoError(err)
+				return bz
+			},
+			expectedQuerierError: true,
+		},
 		{
 			name: "unmatching path and data in request",
 			path: "/osmosis.epochs.v1beta1.Query/EpochInfos",
@@ -249,7 +281,7 @@ func (suite *StargateTestSuite) TestStargateQuerier() {
 				tc.testSetup()
 			}
 
-			stargateQuerier := wasmbinding.StargateQuerier(*suite.app.GRPCQueryRouter(), suite.app.AppCodec())
+			stargateQuerier := wasmbinding.StargateQuerier(*suite.app.GRPCQueryRouter(), suite.app.AppCodec(), suite.app.StargateWhitelistKeeper)
 			stargateRequest := &wasmvmtypes.StargateQuery{
 				Path: tc.path,
 				Data: tc.requestData(),
@@ -282,7 +314,7 @@ func (suite *StargateTestSuite) TestStargateQuerier() {
 			}
 
 			if tc.resendRequest {
-				stargateQuerier = wasmbinding.StargateQuerier(*suite.app.GRPCQueryRouter(), suite.app.AppCodec())
+				stargateQuerier = wasmbinding.StargateQuerier(*suite.app.GRPCQueryRouter(), suite.app.AppCodec(), suite.app.StargateWhitelistKeeper)
 				stargateRequest = &wasmvmtypes.StargateQuery{
 					Path: tc.path,
 					Data: tc.requestData(),
@@ -0,0 +1,148 @@
+package wasmbinding
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ParamsSubspace is the name of the params subspace of the governance-controlled stargate whitelist.
+const ParamsSubspace = "wasmbinding"
+
+// KeyWhitelistedQueries is the param key of the stargate queries whitelisted by governance.
+var KeyWhitelistedQueries = []byte("WhitelistedQueries")
+
+// WhitelistedQuery is a stargate query whitelisted by governance, with the full name of the
+// protobuf message of its response, e.g. "osmosis.poolmanager.v1beta1.NumPoolsResponse".
+type WhitelistedQuery struct {
+	QueryPath    string `json:"query_path" yaml:"query_path"`
+	ResponseType string `json:"response_type" yaml:"response_type"`
+}
+
+// Params are the params of the governance-controlled stargate whitelist, which extends the
+// stargate whitelist of the chain without requiring a chain upgrade.
+// CONTRACT: as for the stargate whitelist of the chain, queries whitelisted by governance
+// should always be deterministic.
+type Params struct {
+	WhitelistedQueries []WhitelistedQuery `json:"whitelisted_queries" yaml:"whitelisted_queries"`
+}
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable returns the key table of the governance-controlled stargate whitelist.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default params, which whitelist no query on top of the whitelist of the chain.
+func DefaultParams() Params {
+	return Params{WhitelistedQueries: []WhitelistedQuery{}}
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyWhitelistedQueries, &p.WhitelistedQueries, validateWhitelistedQueries),
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	return validateWhitelistedQueries(p.WhitelistedQueries)
+}
+
+func validateWhitelistedQueries(i interface{}) error {
+	queries, ok := i.([]WhitelistedQuery)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPaths := make(map[string]bool, len(queries))
+	for _, query := range queries {
+		if !strings.HasPrefix(query.QueryPath, "/") {
+			return fmt.Errorf("invalid query path %s, must start with /", query.QueryPath)
+		}
+		if seenPaths[query.QueryPath] {
+			return fmt.Errorf("duplicate query path %s", query.QueryPath)
+		}
+		seenPaths[query.QueryPath] = true
+
+		if _, err := newProtoResponseType(query.ResponseType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newProtoResponseType returns a new message of the registered protobuf message type with the given full name.
+func newProtoResponseType(responseType string) (codec.ProtoMarshaler, error) {
+	messageType := proto.MessageType(responseType)
+	if messageType == nil || messageType.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("unregistered protobuf message type %s", responseType)
+	}
+	protoResponseType, ok := reflect.New(messageType.Elem()).Interface().(codec.ProtoMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("protobuf message type %s cannot be marshaled", responseType)
+	}
+	return protoResponseType, nil
+}
+
+// StargateWhitelistKeeper manages the stargate queries whitelisted by governance, through param change proposals
+// on the params subspace ParamsSubspace.
+type StargateWhitelistKeeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewStargateWhitelistKeeper returns a new StargateWhitelistKeeper.
+func NewStargateWhitelistKeeper(paramSpace paramtypes.Subspace) *StargateWhitelistKeeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(ParamKeyTable())
+	}
+
+	return &StargateWhitelistKeeper{paramSpace: paramSpace}
+}
+
+// GetParams returns the params of the governance-controlled stargate whitelist, which are empty if never set.
+func (k StargateWhitelistKeeper) GetParams(ctx sdk.Context) (params Params) {
+	params = DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the params of the governance-controlled stargate whitelist.
+func (k StargateWhitelistKeeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetWhitelistedQuery returns the whitelisted query at the provided path, looking it up in the
+// whitelist of the chain first, and in the queries whitelisted by governance otherwise.
+// If the query does not exist, or it was setup wrong, this returns an error.
+func (k StargateWhitelistKeeper) GetWhitelistedQuery(ctx sdk.Context, queryPath string) (codec.ProtoMarshaler, error) {
+	protoResponseType, err := GetWhitelistedQuery(queryPath)
+	var unsupportedErr wasmvmtypes.UnsupportedRequest
+	if !errors.As(err, &unsupportedErr) {
+		return protoResponseType, err
+	}
+
+	for _, query := range k.GetParams(ctx).WhitelistedQueries {
+		if query.QueryPath != queryPath {
+			continue
+		}
+		protoResponseType, err := newProtoResponseType(query.ResponseType)
+		if err != nil {
+			return nil, wasmvmtypes.Unknown{}
+		}
+		return protoResponseType, nil
+	}
+
+	return nil, err
+}
@@ -0,0 +1,89 @@
+package wasmbinding_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/v21/wasmbinding"
+)
+
+func (suite *StargateTestSuite) TestStargateWhitelistParamsValidate() {
+	testCases := []struct {
+		name          string
+		queries       []wasmbinding.WhitelistedQuery
+		expectedError bool
+	}{
+		{
+			name: "valid queries",
+			queries: []wasmbinding.WhitelistedQuery{
+				{QueryPath: "/cosmos.staking.v1beta1.Query/Pool", ResponseType: "cosmos.staking.v1beta1.QueryPoolResponse"},
+				{QueryPath: "/osmosis.poolmanager.v1beta1.Query/NumPools", ResponseType: "osmosis.poolmanager.v1beta1.NumPoolsResponse"},
+			},
+		},
+		{
+			name:    "no queries",
+			queries: []wasmbinding.WhitelistedQuery{},
+		},
+		{
+			name: "invalid query path",
+			queries: []wasmbinding.WhitelistedQuery{
+				{QueryPath: "cosmos.staking.v1beta1.Query/Pool", ResponseType: "cosmos.staking.v1beta1.QueryPoolResponse"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "duplicate query path",
+			queries: []wasmbinding.WhitelistedQuery{
+				{QueryPath: "/cosmos.staking.v1beta1.Query/Pool", ResponseType: "cosmos.staking.v1beta1.QueryPoolResponse"},
+				{QueryPath: "/cosmos.staking.v1beta1.Query/Pool", ResponseType: "cosmos.staking.v1beta1.QueryPoolResponse"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "unregistered response type",
+			queries: []wasmbinding.WhitelistedQuery{
+				{QueryPath: "/cosmos.staking.v1beta1.Query/Pool", ResponseType: "cosmos.staking.v1beta1.UnknownResponse"},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := wasmbinding.Params{WhitelistedQueries: tc.queries}.Validate()
+			if tc.expectedError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *StargateTestSuite) TestStargateWhitelistParamChange() {
+	suite.SetupTest()
+
+	// No query is whitelisted by governance by default.
+	suite.Require().Empty(suite.app.StargateWhitelistKeeper.GetParams(suite.ctx).WhitelistedQueries)
+	_, err := suite.app.StargateWhitelistKeeper.GetWhitelistedQuery(suite.ctx, "/cosmos.staking.v1beta1.Query/Pool")
+	suite.Require().Error(err)
+
+	// The queries are updated through the params subspace, as by a param change proposal.
+	subspace, ok := suite.app.ParamsKeeper.GetSubspace(wasmbinding.ParamsSubspace)
+	suite.Require().True(ok)
+	err = subspace.Update(suite.ctx, wasmbinding.KeyWhitelistedQueries,
+		[]byte(`[{"query_path":"/cosmos.staking.v1beta1.Query/Pool","response_type":"cosmos.staking.v1beta1.QueryPoolResponse"}]`))
+	suite.Require().NoError(err)
+
+	protoResponseType, err := suite.app.StargateWhitelistKeeper.GetWhitelistedQuery(suite.ctx, "/cosmos.staking.v1beta1.Query/Pool")
+	suite.Require().NoError(err)
+	suite.Require().Equal("cosmos.staking.v1beta1.QueryPoolResponse", proto.MessageName(protoResponseType))
+
+	// Queries whitelisted by the chain are still whitelisted.
+	_, err = suite.app.StargateWhitelistKeeper.GetWhitelistedQuery(suite.ctx, "/cosmos.staking.v1beta1.Query/Params")
+	suite.Require().NoError(err)
+
+	// Invalid queries are rejected.
+	err = subspace.Update(suite.ctx, wasmbinding.KeyWhitelistedQueries,
+		[]byte(`[{"query_path":"/cosmos.staking.v1beta1.Query/Pool","response_type":"cosmos.staking.v1beta1.UnknownResponse"}]`))
+	suite.Require().Error(err)
+}
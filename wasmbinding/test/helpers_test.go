@@ -15,6 +15,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app"
+	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
 )
 
 func CreateTestInput() (*app.OsmosisApp, sdk.Context) {
@@ -47,3 +49,42 @@ func RandomAccountAddress() sdk.AccAddress {
 func RandomBech32AccountAddress() string {
 	return RandomAccountAddress().String()
 }
+
+// preparePool creates a balancer pool with equal weights of the given assets, funding the creator with them
+// and the pool creation fee.
+func preparePool(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, creator sdk.AccAddress, assets sdk.Coins) uint64 {
+	t.Helper()
+
+	poolCreationFee := osmosis.PoolManagerKeeper.GetParams(ctx).PoolCreationFee
+	err := testutil.FundAccount(osmosis.BankKeeper, ctx, creator, assets.Add(poolCreationFee...))
+	require.NoError(t, err)
+
+	poolAssets := make([]balancer.PoolAsset, 0, len(assets))
+	for _, asset := range assets {
+		poolAssets = append(poolAssets, balancer.PoolAsset{Token: asset, Weight: osmomath.NewInt(1)})
+	}
+	poolParams := balancer.PoolParams{SwapFee: osmomath.ZeroDec(), ExitFee: osmomath.ZeroDec()}
+	msg := balancer.NewMsgCreateBalancerPool(creator, poolParams, poolAssets, "")
+	poolId, err := osmosis.PoolManagerKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	return poolId
+}
+
+// prepareConcentratedPool creates a concentrated liquidity pool of denom0 and denom1, funding the creator with
+// the pool creation fee.
+func prepareConcentratedPool(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, creator sdk.AccAddress, denom0, denom1 string) uint64 {
+	t.Helper()
+
+	clParams := osmosis.ConcentratedLiquidityKeeper.GetParams(ctx)
+	clParams.IsPermissionlessPoolCreationEnabled = true
+	osmosis.ConcentratedLiquidityKeeper.SetParams(ctx, clParams)
+
+	poolCreationFee := osmosis.PoolManagerKeeper.GetParams(ctx).PoolCreationFee
+	err := testutil.FundAccount(osmosis.BankKeeper, ctx, creator, poolCreationFee)
+	require.NoError(t, err)
+
+	msg := clmodel.NewMsgCreateConcentratedPool(creator, denom0, denom1, 1, osmomath.ZeroDec())
+	poolId, err := osmosis.PoolManagerKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	return poolId
+}
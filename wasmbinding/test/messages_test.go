@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -410,3 +411,81 @@ func TestBurn(t *testing.T) {
 		})
 	}
 }
+
+func TestSwapExactAmountIn(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	poolId := preparePool(t, ctx, osmosis, RandomAccountAddress(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000), sdk.NewInt64Coin("uosmo", 1_000_000)))
+	FundAccount(t, ctx, osmosis, actor)
+
+	specs := map[string]struct {
+		swap   *bindings.SwapExactAmountIn
+		expErr bool
+	}{
+		"valid swap": {
+			swap: &bindings.SwapExactAmountIn{
+				Routes:            []bindings.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "uatom"}},
+				TokenIn:           wasmvmtypes.NewCoin(1000, "uosmo"),
+				TokenOutMinAmount: osmomath.NewInt(1),
+			},
+		},
+		"token out below min amount": {
+			swap: &bindings.SwapExactAmountIn{
+				Routes:            []bindings.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "uatom"}},
+				TokenIn:           wasmvmtypes.NewCoin(1000, "uosmo"),
+				TokenOutMinAmount: osmomath.NewInt(1_000_000),
+			},
+			expErr: true,
+		},
+		"insufficient funds": {
+			swap: &bindings.SwapExactAmountIn{
+				Routes:            []bindings.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "uosmo"}},
+				TokenIn:           wasmvmtypes.NewCoin(100_000_000, "uatom"),
+				TokenOutMinAmount: osmomath.NewInt(1),
+			},
+			expErr: true,
+		},
+		"non-existent pool": {
+			swap: &bindings.SwapExactAmountIn{
+				Routes:            []bindings.SwapAmountInRoute{{PoolId: poolId + 1, TokenOutDenom: "uatom"}},
+				TokenIn:           wasmvmtypes.NewCoin(1000, "uosmo"),
+				TokenOutMinAmount: osmomath.NewInt(1),
+			},
+			expErr: true,
+		},
+		"empty routes": {
+			swap: &bindings.SwapExactAmountIn{
+				TokenIn:           wasmvmtypes.NewCoin(1000, "uosmo"),
+				TokenOutMinAmount: osmomath.NewInt(1),
+			},
+			expErr: true,
+		},
+		"null swap": {
+			swap:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// failed swaps are not reverted by PerformSwapExactAmountIn, so each swap runs on a cache of the state,
+			// as messages dispatched by contracts do
+			ctx, _ := ctx.CacheContext()
+			balanceBefore := osmosis.BankKeeper.GetBalance(ctx, actor, "uatom")
+
+			// when
+			gotResp, gotErr := wasmbinding.PerformSwapExactAmountIn(osmosis.PoolManagerKeeper, ctx, actor, spec.swap)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.True(t, gotResp.TokenOutAmount.GTE(spec.swap.TokenOutMinAmount))
+
+			balanceAfter := osmosis.BankKeeper.GetBalance(ctx, actor, "uatom")
+			require.Equal(t, balanceBefore.Amount.Add(gotResp.TokenOutAmount), balanceAfter.Amount)
+		})
+	}
+}
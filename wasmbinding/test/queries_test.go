@@ -3,14 +3,20 @@ package wasmbinding
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v21/wasmbinding"
+	"github.com/osmosis-labs/osmosis/v21/wasmbinding/bindings"
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -79,7 +85,7 @@ func TestDenomAdmin(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, tfDenom)
 
-	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.PoolManagerKeeper, app.TwapKeeper, app.ConcentratedLiquidityKeeper)
 
 	testCases := []struct {
 		name        string
@@ -115,3 +121,264 @@ func TestDenomAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolState(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	creator := RandomAccountAddress()
+	app, ctx := CreateTestInput()
+
+	poolAssets := sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000), sdk.NewInt64Coin("uosmo", 1_000_000))
+	balancerPoolId := preparePool(t, ctx, app, creator, poolAssets)
+	clPoolId := prepareConcentratedPool(t, ctx, app, creator, "uatom", "uosmo")
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.PoolManagerKeeper, app.TwapKeeper, app.ConcentratedLiquidityKeeper)
+
+	testCases := []struct {
+		name           string
+		poolId         uint64
+		expectErr      bool
+		expectAssets   sdk.Coins
+		expectNoShares bool
+	}{
+		{
+			name:         "balancer pool",
+			poolId:       balancerPoolId,
+			expectAssets: poolAssets,
+		},
+		{
+			name:           "concentrated pool has no shares",
+			poolId:         clPoolId,
+			expectAssets:   sdk.NewCoins(),
+			expectNoShares: true,
+		},
+		{
+			name:      "non-existent pool",
+			poolId:    clPoolId + 1,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := queryPlugin.GetPoolState(ctx, tc.poolId)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, wasmbinding.ConvertSdkCoinsToWasmCoins(tc.expectAssets), resp.Assets)
+			if tc.expectNoShares {
+				require.Nil(t, resp.Shares)
+			} else {
+				require.NotNil(t, resp.Shares)
+				require.Equal(t, gammtypes.GetPoolShareDenom(tc.poolId), resp.Shares.Denom)
+				require.Equal(t, gammtypes.InitPoolSharesSupply.String(), resp.Shares.Amount)
+			}
+		})
+	}
+}
+
+func TestSpotPrice(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	creator := RandomAccountAddress()
+	app, ctx := CreateTestInput()
+
+	poolId := preparePool(t, ctx, app, creator, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000), sdk.NewInt64Coin("uosmo", 1_000_000)))
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.PoolManagerKeeper, app.TwapKeeper, app.ConcentratedLiquidityKeeper)
+
+	testCases := []struct {
+		name        string
+		spotPrice   bindings.SpotPrice
+		expectErr   bool
+		expectPrice osmomath.BigDec
+	}{
+		{
+			name:        "base asset in quote asset",
+			spotPrice:   bindings.SpotPrice{PoolId: poolId, QuoteAssetDenom: "uosmo", BaseAssetDenom: "uatom"},
+			expectPrice: osmomath.MustNewBigDecFromStr("0.5"),
+		},
+		{
+			name:        "reversed assets",
+			spotPrice:   bindings.SpotPrice{PoolId: poolId, QuoteAssetDenom: "uatom", BaseAssetDenom: "uosmo"},
+			expectPrice: osmomath.MustNewBigDecFromStr("2"),
+		},
+		{
+			name:      "asset not in pool",
+			spotPrice: bindings.SpotPrice{PoolId: poolId, QuoteAssetDenom: "uosmo", BaseAssetDenom: "uion"},
+			expectErr: true,
+		},
+		{
+			name:      "non-existent pool",
+			spotPrice: bindings.SpotPrice{PoolId: poolId + 1, QuoteAssetDenom: "uosmo", BaseAssetDenom: "uatom"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := queryPlugin.GetSpotPrice(ctx, &tc.spotPrice)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPrice.String(), resp.SpotPrice)
+		})
+	}
+}
+
+func TestArithmeticTwap(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	creator := RandomAccountAddress()
+	app, ctx := CreateTestInput()
+
+	// The times of the query are in milliseconds.
+	creationTime := ctx.BlockTime().Truncate(time.Millisecond)
+	ctx = ctx.WithBlockTime(creationTime)
+	poolId := preparePool(t, ctx, app, creator, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000_000), sdk.NewInt64Coin("uosmo", 1_000_000)))
+	ctx = ctx.WithBlockTime(creationTime.Add(time.Hour))
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.PoolManagerKeeper, app.TwapKeeper, app.ConcentratedLiquidityKeeper)
+
+	endTime := creationTime.Add(time.Minute).UnixMilli()
+	futureTime := creationTime.Add(2 * time.Hour).UnixMilli()
+
+	testCases := []struct {
+		name           string
+		arithmeticTwap bindings.ArithmeticTwap
+		expectErr      bool
+		expectTwap     osmomath.Dec
+	}{
+		{
+			name: "twap to now",
+			arithmeticTwap: bindings.ArithmeticTwap{
+				PoolId: poolId, QuoteAssetDenom: "uosmo", BaseAssetDenom: "uatom",
+				StartTime: creationTime.UnixMilli(),
+			},
+			expectTwap: osmomath.MustNewDecFromStr("0.5"),
+		},
+		{
+			name: "twap to end time",
+			arithmeticTwap: bindings.ArithmeticTwap{
+				PoolId: poolId, QuoteAssetDenom: "uatom", BaseAssetDenom: "uosmo",
+				StartTime: creationTime.UnixMilli(), EndTime: &endTime,
+			},
+			expectTwap: osmomath.NewDec(2),
+		},
+		{
+			name: "start time before pool creation",
+			arithmeticTwap: bindings.ArithmeticTwap{
+				PoolId: poolId, QuoteAssetDenom: "uosmo", BaseAssetDenom: "uatom",
+				StartTime: creationTime.Add(-time.Hour).UnixMilli(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "end time after block time",
+			arithmeticTwap: bindings.ArithmeticTwap{
+				PoolId: poolId, QuoteAssetDenom: "uosmo", BaseAssetDenom: "uatom",
+				StartTime: creationTime.UnixMilli(), EndTime: &futureTime,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := queryPlugin.GetArithmeticTwap(ctx, &tc.arithmeticTwap)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectTwap.String(), resp.Twap)
+		})
+	}
+}
+
+func TestConcentratedPositions(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	owner := RandomAccountAddress()
+	app, ctx := CreateTestInput()
+
+	poolId := prepareConcentratedPool(t, ctx, app, owner, "uatom", "uosmo")
+	tokensProvided := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uosmo", 1_000_000))
+	err := testutil.FundAccount(app.BankKeeper, ctx, owner, tokensProvided)
+	require.NoError(t, err)
+	positionData, err := app.ConcentratedLiquidityKeeper.CreateFullRangePosition(ctx, poolId, owner, tokensProvided)
+	require.NoError(t, err)
+
+	position, err := app.ConcentratedLiquidityKeeper.GetPosition(ctx, positionData.ID)
+	require.NoError(t, err)
+	pool, err := app.ConcentratedLiquidityKeeper.GetConcentratedPoolById(ctx, poolId)
+	require.NoError(t, err)
+	asset0, asset1, err := concentratedliquidity.CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.PoolManagerKeeper, app.TwapKeeper, app.ConcentratedLiquidityKeeper)
+
+	testCases := []struct {
+		name            string
+		positions       bindings.ConcentratedPositions
+		expectErr       bool
+		expectPositions int
+	}{
+		{
+			name:            "positions in pool",
+			positions:       bindings.ConcentratedPositions{Address: owner.String(), PoolId: poolId},
+			expectPositions: 1,
+		},
+		{
+			name:            "positions in all pools",
+			positions:       bindings.ConcentratedPositions{Address: owner.String()},
+			expectPositions: 1,
+		},
+		{
+			name:            "address without positions",
+			positions:       bindings.ConcentratedPositions{Address: RandomBech32AccountAddress(), PoolId: poolId},
+			expectPositions: 0,
+		},
+		{
+			name:      "invalid address",
+			positions: bindings.ConcentratedPositions{Address: "invalid", PoolId: poolId},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := queryPlugin.GetConcentratedPositions(ctx, &tc.positions)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.Positions, tc.expectPositions)
+			if tc.expectPositions == 0 {
+				return
+			}
+
+			got := resp.Positions[0]
+			require.Equal(t, position.PositionId, got.PositionId)
+			require.Equal(t, poolId, got.PoolId)
+			require.Equal(t, position.LowerTick, got.LowerTick)
+			require.Equal(t, position.UpperTick, got.UpperTick)
+			require.Equal(t, position.Liquidity.String(), got.Liquidity)
+			require.Equal(t, wasmbinding.ConvertSdkCoinToWasmCoin(asset0), got.Asset0)
+			require.Equal(t, wasmbinding.ConvertSdkCoinToWasmCoin(asset1), got.Asset1)
+
+			// The full range position holds the provided tokens, up to rounding in favor of the pool.
+			require.True(t, asset0.Amount.IsPositive() && asset0.Amount.LTE(positionData.Amount0))
+			require.True(t, asset1.Amount.IsPositive() && asset1.Amount.LTE(positionData.Amount1))
+		})
+	}
+}
@@ -7,20 +7,26 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v21/x/tokenfactory/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/twap"
 )
 
 func RegisterCustomPlugins(
 	bank *bankkeeper.BaseKeeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	poolManager *poolmanager.Keeper,
+	twapKeeper *twap.Keeper,
+	concentratedLiquidity *concentratedliquidity.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(tokenFactory, poolManager, twapKeeper, concentratedLiquidity)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(bank, tokenFactory),
+		CustomMessageDecorator(bank, tokenFactory, poolManager),
 	)
 
 	return []wasmkeeper.Option{
@@ -29,9 +35,9 @@ func RegisterCustomPlugins(
 	}
 }
 
-func RegisterStargateQueries(queryRouter baseapp.GRPCQueryRouter, codec codec.Codec) []wasmkeeper.Option {
+func RegisterStargateQueries(queryRouter baseapp.GRPCQueryRouter, codec codec.Codec, whitelistKeeper *StargateWhitelistKeeper) []wasmkeeper.Option {
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-		Stargate: StargateQuerier(queryRouter, codec),
+		Stargate: StargateQuerier(queryRouter, codec, whitelistKeeper),
 	})
 
 	return []wasmkeeper.Option{
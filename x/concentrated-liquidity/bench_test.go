@@ -15,6 +15,7 @@ import (
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
 	clmath "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
 	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/swapstrategy"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
 	gammmigration "github.com/osmosis-labs/osmosis/v21/x/gamm/types/migration"
@@ -281,3 +282,48 @@ func BenchmarkGetTickLiquidityForFullRange(b *testing.B) {
 		fmt.Println("num_ticks_traversed", len(liquidityNet))
 	})
 }
+
+// BenchmarkInitializeNextTickIterator measures the lookup of the next initialized tick by the swap loop
+// for increasing gaps between the current tick and the next initialized tick. The store iterator seeks
+// directly to the next initialized tick key, so the lookup cost does not grow with the gap.
+func BenchmarkInitializeNextTickIterator(b *testing.B) {
+	const numberOfTicksBelow = 1000
+
+	for _, gap := range []int64{10, 10_000, 10_000_000, types.MaxTick - 1} {
+		b.Run(fmt.Sprintf("gap %d", gap), func(b *testing.B) {
+			s := BenchTestSuite{}
+			cleanup := s.SetupWithLevelDb()
+			defer cleanup()
+
+			denom0, denom1 := DefaultCoin0.Denom, DefaultCoin1.Denom
+			testutil.FundAccount(s.App.BankKeeper, s.Ctx, s.TestAccs[0], s.App.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee)
+			poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, clmodel.NewMsgCreateConcentratedPool(
+				s.TestAccs[0], denom0, denom1, 1, osmomath.ZeroDec(),
+			))
+			noError(b, err)
+
+			// Initialize ticks below the current tick of zero, and a single tick gap ticks above it.
+			tokenDesired0 := sdk.NewCoin(denom0, osmomath.NewInt(1_000_000))
+			tokenDesired1 := sdk.NewCoin(denom1, osmomath.NewInt(1_000_000))
+			for i := int64(1); i <= numberOfTicksBelow; i++ {
+				testutil.FundAccount(s.App.BankKeeper, s.Ctx, s.TestAccs[0], sdk.NewCoins(tokenDesired0, tokenDesired1))
+				s.createPosition(0, poolId, tokenDesired0, tokenDesired1, -i, gap)
+			}
+			s.Commit()
+
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+			noError(b, err)
+			strategy := swapstrategy.New(false, osmomath.ZeroBigDec(), s.App.GetKey(types.StoreKey), osmomath.ZeroDec())
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// System under test
+				iter := strategy.InitializeNextTickIterator(s.Ctx, pool.GetId(), pool.GetCurrentTick())
+				if !iter.Valid() {
+					b.Fatal("next initialized tick not found")
+				}
+				iter.Close()
+			}
+		})
+	}
+}
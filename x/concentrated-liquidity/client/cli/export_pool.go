@@ -0,0 +1,293 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types/genesis"
+)
+
+const (
+	FlagTopPositions = "top-positions"
+	FlagTickBuckets  = "tick-buckets"
+
+	defaultTopPositions = 10
+	defaultTickBuckets  = 20
+)
+
+// PoolSummary is a human-readable summary of a concentrated pool's state at a given height.
+type PoolSummary struct {
+	PoolId               uint64                  `json:"pool_id"`
+	Height               int64                   `json:"height"`
+	Token0               string                  `json:"token0"`
+	Token1               string                  `json:"token1"`
+	TickSpacing          uint64                  `json:"tick_spacing"`
+	SpreadFactor         osmomath.Dec            `json:"spread_factor"`
+	CurrentTick          int64                   `json:"current_tick"`
+	CurrentSqrtPrice     osmomath.BigDec         `json:"current_sqrt_price"`
+	CurrentTickLiquidity osmomath.Dec            `json:"current_tick_liquidity"`
+	NumInitializedTicks  int                     `json:"num_initialized_ticks"`
+	TickHistogram        []TickBucket            `json:"tick_histogram"`
+	NumPositions         int                     `json:"num_positions"`
+	TopPositions         []PositionSummary       `json:"top_positions"`
+	IncentiveRecords     []types.IncentiveRecord `json:"incentive_records"`
+}
+
+// TickBucket aggregates the initialized ticks within the inclusive [LowerTick, UpperTick] range.
+type TickBucket struct {
+	LowerTick      int64        `json:"lower_tick"`
+	UpperTick      int64        `json:"upper_tick"`
+	NumTicks       int          `json:"num_ticks"`
+	LiquidityGross osmomath.Dec `json:"liquidity_gross"`
+}
+
+// PositionSummary is a condensed view of a position used for ranking positions by liquidity.
+type PositionSummary struct {
+	PositionId uint64       `json:"position_id"`
+	Address    string       `json:"address"`
+	LowerTick  int64        `json:"lower_tick"`
+	UpperTick  int64        `json:"upper_tick"`
+	Liquidity  osmomath.Dec `json:"liquidity"`
+	InRange    bool         `json:"in_range"`
+}
+
+// GetCmdExportPool returns a command that reads the raw store contents of a concentrated pool
+// at the given height and prints a structured summary of it. It is intended for debugging
+// and governance review of pools rather than for programmatic consumption.
+func GetCmdExportPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-pool [pool-id]",
+		Short: "Export a human-readable summary of a concentrated pool's state",
+		Long: `Export a human-readable summary of a concentrated pool's state, including the current tick,
+liquidity, a histogram of initialized ticks, the largest positions and the incentive records.
+The summary is derived from the module's store contents at the queried height.
+
+Example:
+$ osmosisd q concentratedliquidity export-pool 1 --top-positions 5 --tick-buckets 10 --height 1000`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			topPositions, err := cmd.Flags().GetInt(FlagTopPositions)
+			if err != nil {
+				return err
+			}
+
+			tickBuckets, err := cmd.Flags().GetInt(FlagTickBuckets)
+			if err != nil {
+				return err
+			}
+
+			summary, err := exportPoolSummary(clientCtx, poolId, topPositions, tickBuckets)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(summary, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().Int(FlagTopPositions, defaultTopPositions, "Number of largest positions (by liquidity) to include")
+	cmd.Flags().Int(FlagTickBuckets, defaultTickBuckets, "Number of buckets in the initialized tick histogram")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// exportPoolSummary reads the pool, its ticks, positions and incentive records from the
+// concentrated-liquidity store and assembles them into a PoolSummary.
+func exportPoolSummary(clientCtx client.Context, poolId uint64, topPositions, tickBuckets int) (PoolSummary, error) {
+	poolBz, height, err := clientCtx.QueryStore(types.KeyPool(poolId), types.StoreKey)
+	if err != nil {
+		return PoolSummary{}, err
+	}
+	if len(poolBz) == 0 {
+		return PoolSummary{}, types.PoolNotFoundError{PoolId: poolId}
+	}
+
+	// Pin all subsequent reads to the height of the pool read so that the summary is consistent.
+	clientCtx = clientCtx.WithHeight(height)
+
+	pool := model.Pool{}
+	if err := clientCtx.Codec.Unmarshal(poolBz, &pool); err != nil {
+		return PoolSummary{}, err
+	}
+
+	tickPairs, err := querySubspace(clientCtx, types.KeyTickPrefixByPoolId(poolId))
+	if err != nil {
+		return PoolSummary{}, err
+	}
+	ticks := make([]genesis.FullTick, 0, len(tickPairs))
+	for _, pair := range tickPairs {
+		tick, err := cl.ParseFullTickFromBytes(pair.Key, pair.Value)
+		if err != nil {
+			return PoolSummary{}, err
+		}
+		ticks = append(ticks, tick)
+	}
+
+	positionIdPairs, err := querySubspace(clientCtx, types.KeyPoolPosition(poolId))
+	if err != nil {
+		return PoolSummary{}, err
+	}
+	positions := make([]model.Position, 0, len(positionIdPairs))
+	for _, pair := range positionIdPairs {
+		// The key is (PoolPositionPrefix | pool id | KeySeparator | position id), position id being big endian encoded.
+		positionId := sdk.BigEndianToUint64(pair.Key[len(pair.Key)-8:])
+		positionBz, _, err := clientCtx.QueryStore(types.KeyPositionId(positionId), types.StoreKey)
+		if err != nil {
+			return PoolSummary{}, err
+		}
+		position := model.Position{}
+		if err := clientCtx.Codec.Unmarshal(positionBz, &position); err != nil {
+			return PoolSummary{}, err
+		}
+		positions = append(positions, position)
+	}
+
+	incentivePairs, err := querySubspace(clientCtx, types.KeyPoolIncentiveRecords(poolId))
+	if err != nil {
+		return PoolSummary{}, err
+	}
+	incentiveRecords := make([]types.IncentiveRecord, 0, len(incentivePairs))
+	for _, pair := range incentivePairs {
+		incentiveRecord, err := cl.ParseFullIncentiveRecordFromBz(pair.Key, pair.Value)
+		if err != nil {
+			return PoolSummary{}, err
+		}
+		incentiveRecords = append(incentiveRecords, incentiveRecord)
+	}
+
+	return PoolSummary{
+		PoolId:               poolId,
+		Height:               height,
+		Token0:               pool.Token0,
+		Token1:               pool.Token1,
+		TickSpacing:          pool.TickSpacing,
+		SpreadFactor:         pool.SpreadFactor,
+		CurrentTick:          pool.CurrentTick,
+		CurrentSqrtPrice:     pool.CurrentSqrtPrice,
+		CurrentTickLiquidity: pool.CurrentTickLiquidity,
+		NumInitializedTicks:  len(ticks),
+		TickHistogram:        bucketTicks(ticks, tickBuckets),
+		NumPositions:         len(positions),
+		TopPositions:         largestPositions(positions, topPositions, pool),
+		IncentiveRecords:     incentiveRecords,
+	}, nil
+}
+
+// querySubspace returns all key-value pairs under the given prefix of the concentrated-liquidity store.
+func querySubspace(clientCtx client.Context, prefix []byte) ([]kv.Pair, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/subspace", types.StoreKey),
+		Data:   prefix,
+		Height: clientCtx.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := kv.Pairs{}
+	if err := pairs.Unmarshal(res.Value); err != nil {
+		return nil, err
+	}
+	return pairs.Pairs, nil
+}
+
+// bucketTicks splits the range spanned by the given initialized ticks into at most numBuckets
+// equally sized buckets and aggregates the tick count and gross liquidity per bucket.
+// The ticks are expected to be sorted in ascending order, as returned by store iteration.
+func bucketTicks(ticks []genesis.FullTick, numBuckets int) []TickBucket {
+	if len(ticks) == 0 || numBuckets <= 0 {
+		return nil
+	}
+
+	minTick := ticks[0].TickIndex
+	maxTick := ticks[len(ticks)-1].TickIndex
+	tickRange := maxTick - minTick + 1
+
+	bucketWidth := tickRange / int64(numBuckets)
+	if tickRange%int64(numBuckets) != 0 {
+		bucketWidth++
+	}
+	numBuckets = int((tickRange + bucketWidth - 1) / bucketWidth)
+
+	buckets := make([]TickBucket, numBuckets)
+	for i := range buckets {
+		lowerTick := minTick + int64(i)*bucketWidth
+		buckets[i] = TickBucket{
+			LowerTick:      lowerTick,
+			UpperTick:      lowerTick + bucketWidth - 1,
+			LiquidityGross: osmomath.ZeroDec(),
+		}
+	}
+
+	for _, tick := range ticks {
+		bucket := &buckets[(tick.TickIndex-minTick)/bucketWidth]
+		bucket.NumTicks++
+		bucket.LiquidityGross = bucket.LiquidityGross.Add(tick.Info.LiquidityGross)
+	}
+
+	return buckets
+}
+
+// largestPositions returns up to n positions with the largest liquidity, in descending order.
+// Ties are broken by ascending position id so that the output is deterministic.
+func largestPositions(positions []model.Position, n int, pool model.Pool) []PositionSummary {
+	sorted := make([]model.Position, len(positions))
+	copy(sorted, positions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].Liquidity.Equal(sorted[j].Liquidity) {
+			return sorted[i].Liquidity.GT(sorted[j].Liquidity)
+		}
+		return sorted[i].PositionId < sorted[j].PositionId
+	})
+
+	if n < 0 {
+		n = 0
+	}
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+
+	summaries := make([]PositionSummary, 0, len(sorted))
+	for _, position := range sorted {
+		summaries = append(summaries, PositionSummary{
+			PositionId: position.PositionId,
+			Address:    position.Address,
+			LowerTick:  position.LowerTick,
+			UpperTick:  position.UpperTick,
+			Liquidity:  position.Liquidity,
+			InRange:    pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick),
+		})
+	}
+	return summaries
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types/genesis"
+)
+
+func withLiquidityGross(tickIndex int64, liquidityGross int64) genesis.FullTick {
+	return genesis.FullTick{
+		PoolId:    1,
+		TickIndex: tickIndex,
+		Info:      model.TickInfo{LiquidityGross: osmomath.NewDec(liquidityGross)},
+	}
+}
+
+func TestBucketTicks(t *testing.T) {
+	tests := map[string]struct {
+		ticks           []genesis.FullTick
+		numBuckets      int
+		expectedBuckets []TickBucket
+	}{
+		"no ticks": {
+			ticks:      []genesis.FullTick{},
+			numBuckets: 10,
+		},
+		"zero buckets": {
+			ticks:      []genesis.FullTick{withLiquidityGross(-10, 1)},
+			numBuckets: 0,
+		},
+		"single tick": {
+			ticks:      []genesis.FullTick{withLiquidityGross(100, 5)},
+			numBuckets: 10,
+			expectedBuckets: []TickBucket{
+				{LowerTick: 100, UpperTick: 100, NumTicks: 1, LiquidityGross: osmomath.NewDec(5)},
+			},
+		},
+		"range evenly divisible by number of buckets": {
+			ticks:      []genesis.FullTick{withLiquidityGross(-10, 1), withLiquidityGross(-1, 2), withLiquidityGross(0, 3), withLiquidityGross(9, 4)},
+			numBuckets: 2,
+			expectedBuckets: []TickBucket{
+				{LowerTick: -10, UpperTick: -1, NumTicks: 2, LiquidityGross: osmomath.NewDec(3)},
+				{LowerTick: 0, UpperTick: 9, NumTicks: 2, LiquidityGross: osmomath.NewDec(7)},
+			},
+		},
+		"range not divisible by number of buckets, empty bucket in between": {
+			ticks:      []genesis.FullTick{withLiquidityGross(0, 1), withLiquidityGross(10, 2)},
+			numBuckets: 3,
+			expectedBuckets: []TickBucket{
+				{LowerTick: 0, UpperTick: 3, NumTicks: 1, LiquidityGross: osmomath.NewDec(1)},
+				{LowerTick: 4, UpperTick: 7, NumTicks: 0, LiquidityGross: osmomath.ZeroDec()},
+				{LowerTick: 8, UpperTick: 11, NumTicks: 1, LiquidityGross: osmomath.NewDec(2)},
+			},
+		},
+		"more buckets than ticks in range": {
+			ticks:      []genesis.FullTick{withLiquidityGross(1, 1), withLiquidityGross(2, 1)},
+			numBuckets: 10,
+			expectedBuckets: []TickBucket{
+				{LowerTick: 1, UpperTick: 1, NumTicks: 1, LiquidityGross: osmomath.NewDec(1)},
+				{LowerTick: 2, UpperTick: 2, NumTicks: 1, LiquidityGross: osmomath.NewDec(1)},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			buckets := bucketTicks(tc.ticks, tc.numBuckets)
+			require.Equal(t, tc.expectedBuckets, buckets)
+		})
+	}
+}
+
+func TestLargestPositions(t *testing.T) {
+	positions := []model.Position{
+		{PositionId: 1, LowerTick: -100, UpperTick: 100, Liquidity: osmomath.NewDec(10)},
+		{PositionId: 2, LowerTick: 0, UpperTick: 100, Liquidity: osmomath.NewDec(30)},
+		{PositionId: 3, LowerTick: -100, UpperTick: 0, Liquidity: osmomath.NewDec(30)},
+		{PositionId: 4, LowerTick: 200, UpperTick: 300, Liquidity: osmomath.NewDec(20)},
+	}
+	pool := model.Pool{CurrentTick: 0}
+
+	top := largestPositions(positions, 3, pool)
+	require.Len(t, top, 3)
+
+	// Ties are broken by position id.
+	require.Equal(t, uint64(2), top[0].PositionId)
+	require.True(t, top[0].InRange)
+	require.Equal(t, uint64(3), top[1].PositionId)
+	require.False(t, top[1].InRange)
+	require.Equal(t, uint64(4), top[2].PositionId)
+	require.False(t, top[2].InRange)
+
+	// Input is not mutated.
+	require.Equal(t, uint64(1), positions[0].PositionId)
+
+	require.Len(t, largestPositions(positions, 10, pool), len(positions))
+	require.Empty(t, largestPositions(positions, 0, pool))
+}
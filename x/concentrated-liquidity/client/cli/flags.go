@@ -8,10 +8,24 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagLowerTick                  = "lower-tick"
+	FlagUpperTick                  = "upper-tick"
 )
 
+var tickRangeFlagOverride = map[string]string{
+	"lowertick": FlagLowerTick,
+	"uppertick": FlagUpperTick,
+}
+
 func FlagSetJustPoolId() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetTickRange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagLowerTick, "0", "The lower tick of the returned tick ranges, the full range is returned if both ticks are zero")
+	fs.String(FlagUpperTick, "0", "The upper tick of the returned tick ranges, the full range is returned if both ticks are zero")
+	return fs
+}
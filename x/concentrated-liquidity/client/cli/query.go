@@ -18,6 +18,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableSpreadRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableIncentives)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetIncentiveRecords)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetIncentiveRecordAttributions)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetWithdrawOnlyPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCFMMPoolIdLinkFromConcentratedPoolId)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickLiquidityNetInDirection)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
@@ -26,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
+		GetCmdExportPool(),
 	)
 	return cmd
 }
@@ -88,6 +91,24 @@ func GetIncentiveRecords() (*osmocli.QueryDescriptor, *queryproto.IncentiveRecor
 	}, &queryproto.IncentiveRecordsRequest{}
 }
 
+func GetIncentiveRecordAttributions() (*osmocli.QueryDescriptor, *queryproto.IncentiveRecordAttributionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "incentive-record-attributions",
+		Short: "Query how the incentives of a given pool are attributed to its emitting incentive records",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} incentive-record-attributions 1`,
+	}, &queryproto.IncentiveRecordAttributionsRequest{}
+}
+
+func GetWithdrawOnlyPools() (*osmocli.QueryDescriptor, *queryproto.WithdrawOnlyPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "withdraw-only-pools",
+		Short: "Query the ids of the pools in withdraw-only mode",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} withdraw-only-pools`,
+	}, &queryproto.WithdrawOnlyPoolsRequest{}
+}
+
 func GetCFMMPoolIdLinkFromConcentratedPoolId() (*osmocli.QueryDescriptor, *queryproto.CFMMPoolIdLinkFromConcentratedPoolIdRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "cfmm-pool-link-from-cl",
@@ -124,8 +145,11 @@ func GetLiquidityPerTickRange() (*osmocli.QueryDescriptor, *queryproto.Liquidity
 		Short: "Query liquidity per tick range",
 		Long: `{{.Short}}{{.ExampleHeader}}
 {{.CommandPrefix}} 1
+{{.CommandPrefix}} 1 --lower-tick=-100000 --upper-tick=100000
 
 [poolid]`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetTickRange()}},
+		CustomFlagOverrides: tickRangeFlagOverride,
 	}, &queryproto.LiquidityPerTickRangeRequest{}
 }
 
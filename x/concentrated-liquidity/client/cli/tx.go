@@ -16,6 +16,7 @@ import (
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
@@ -31,6 +32,10 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPoolsWithdrawOnlyModeCmd)
+	osmocli.AddTxCmd(txCmd, NewTokenizePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewDetokenizePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimFilledRangeOrderCmd)
 	return txCmd
 }
 
@@ -103,6 +108,38 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewSetPoolsWithdrawOnlyModeCmd() (*osmocli.TxCliDesc, *types.MsgSetPoolsWithdrawOnlyMode) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-pools-withdraw-only-mode",
+		Short:   "enable or disable the withdraw-only mode of a list of pools, the sender must be in the withdraw-only mode emergency whitelist",
+		Example: "osmosisd tx concentratedliquidity set-pools-withdraw-only-mode 1,2 true --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgSetPoolsWithdrawOnlyMode{}
+}
+
+func NewTokenizePositionCmd() (*osmocli.TxCliDesc, *types.MsgTokenizePosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "tokenize-position",
+		Short:   "escrow a concentrated liquidity position and mint a transferable token representing it",
+		Example: "osmosisd tx concentratedliquidity tokenize-position 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgTokenizePosition{}
+}
+
+func NewDetokenizePositionCmd() (*osmocli.TxCliDesc, *types.MsgDetokenizePosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "detokenize-position",
+		Short:   "burn a position token to own the concentrated liquidity position it represents",
+		Example: "osmosisd tx concentratedliquidity detokenize-position 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgDetokenizePosition{}
+}
+
+func NewClaimFilledRangeOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimFilledRangeOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-filled-range-order",
+		Short:   "withdraw a filled range order to its owner, on behalf of the owner",
+		Example: "osmosisd tx concentratedliquidity claim-filled-range-order 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimFilledRangeOrder{}
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -196,6 +233,59 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewSetPoolsWithdrawOnlyModeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pools-withdraw-only-mode-proposal [pool-ids] [withdraw-only] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to enable or disable the withdraw-only mode of pools",
+		Long: strings.TrimSpace(`Submit a proposal to enable or disable the withdraw-only mode of pools.
+
+While a pool is in withdraw-only mode, swaps and new positions are rejected,
+but positions can still be withdrawn and their rewards collected.
+Ex) set-pools-withdraw-only-mode-proposal 1,5 true -> enables withdraw-only mode for pools 1 and 5
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return err
+			}
+
+			withdrawOnly, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPoolsWithdrawOnlyModeProposal(proposalTitle, summary, poolIds, withdrawOnly)
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) WithdrawOnlyPools(grpcCtx context.Context,
+	req *queryproto.WithdrawOnlyPoolsRequest,
+) (*queryproto.WithdrawOnlyPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.WithdrawOnlyPools(ctx, *req)
+}
+
 func (q Querier) UserUnbondingPositions(grpcCtx context.Context,
 	req *queryproto.UserUnbondingPositionsRequest,
 ) (*queryproto.UserUnbondingPositionsResponse, error) {
@@ -131,6 +141,16 @@ func (q Querier) IncentiveRecords(grpcCtx context.Context,
 	return q.Q.IncentiveRecords(ctx, *req)
 }
 
+func (q Querier) IncentiveRecordAttributions(grpcCtx context.Context,
+	req *queryproto.IncentiveRecordAttributionsRequest,
+) (*queryproto.IncentiveRecordAttributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.IncentiveRecordAttributions(ctx, *req)
+}
+
 func (q Querier) GetTotalLiquidity(grpcCtx context.Context,
 	req *queryproto.GetTotalLiquidityRequest,
 ) (*queryproto.GetTotalLiquidityResponse, error) {
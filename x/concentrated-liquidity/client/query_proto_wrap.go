@@ -112,11 +112,18 @@ func (q Querier) Params(ctx sdk.Context, req clquery.ParamsRequest) (*clquery.Pa
 
 // LiquidityPerTickRange returns the amount of liquidity per every tick range
 // existing within the given pool. The amounts are returned as a slice of ranges with their liquidity depths.
+// If a lower or upper tick is given, the ranges are clipped to [lower tick, upper tick].
 func (q Querier) LiquidityPerTickRange(ctx sdk.Context, req clquery.LiquidityPerTickRangeRequest) (*clquery.LiquidityPerTickRangeResponse, error) {
-	liquidity, bucketIndex, err := q.Keeper.GetTickLiquidityForFullRange(
-		ctx,
-		req.PoolId,
+	var (
+		liquidity   []clquery.LiquidityDepthWithRange
+		bucketIndex int64
+		err         error
 	)
+	if req.LowerTick == 0 && req.UpperTick == 0 {
+		liquidity, bucketIndex, err = q.Keeper.GetTickLiquidityForFullRange(ctx, req.PoolId)
+	} else {
+		liquidity, bucketIndex, err = q.Keeper.GetTickLiquidityForRange(ctx, req.PoolId, req.LowerTick, req.UpperTick)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +238,29 @@ func (q Querier) IncentiveRecords(ctx sdk.Context, req clquery.IncentiveRecordsR
 	}, nil
 }
 
+// IncentiveRecordAttributions returns how the uptime accumulator growth of the given pool
+// is attributed to each of its emitting incentive records.
+func (q Querier) IncentiveRecordAttributions(ctx sdk.Context, req clquery.IncentiveRecordAttributionsRequest) (*clquery.IncentiveRecordAttributionsResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	attributions, err := q.Keeper.GetIncentiveRecordAttributions(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clquery.IncentiveRecordAttributionsResponse{
+		Attributions: attributions,
+	}, nil
+}
+
+// WithdrawOnlyPools returns the ids of the pools in withdraw-only mode.
+func (q Querier) WithdrawOnlyPools(ctx sdk.Context, req clquery.WithdrawOnlyPoolsRequest) (*clquery.WithdrawOnlyPoolsResponse, error) {
+	return &clquery.WithdrawOnlyPoolsResponse{
+		PoolIds: q.Keeper.GetWithdrawOnlyPoolIds(ctx),
+	}, nil
+}
+
 // TickAccumulatorTrackers returns tick accumulator trackers.
 // It includes spread reward growth in the opposite direction of last traversal and uptime tracker values.
 func (q Querier) TickAccumulatorTrackers(ctx sdk.Context, req clquery.TickAccumulatorTrackersRequest) (*clquery.TickAccumulatorTrackersResponse, error) {
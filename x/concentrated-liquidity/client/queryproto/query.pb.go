@@ -662,6 +662,11 @@ func (m *LiquidityNetInDirectionResponse) GetCurrentTick() int64 {
 // =============================== LiquidityPerTickRange
 type LiquidityPerTickRangeRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// lower_tick and upper_tick bound the returned tick ranges, which are
+	// clipped to [lower_tick, upper_tick]. If both are zero, the full range
+	// from min tick to max tick is returned.
+	LowerTick int64 `protobuf:"varint,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
 }
 
 func (m *LiquidityPerTickRangeRequest) Reset()         { *m = LiquidityPerTickRangeRequest{} }
@@ -704,6 +709,20 @@ func (m *LiquidityPerTickRangeRequest) GetPoolId() uint64 {
 	return 0
 }
 
+func (m *LiquidityPerTickRangeRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *LiquidityPerTickRangeRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
 type LiquidityPerTickRangeResponse struct {
 	Liquidity   []LiquidityDepthWithRange `protobuf:"bytes,1,rep,name=liquidity,proto3" json:"liquidity"`
 	BucketIndex int64                     `protobuf:"varint,2,opt,name=bucket_index,json=bucketIndex,proto3" json:"bucket_index,omitempty" yaml:"bucket_index"`
@@ -1250,6 +1269,176 @@ func (m *IncentiveRecordsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// ===================== QueryIncentiveRecordAttributions
+type IncentiveRecordAttributionsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *IncentiveRecordAttributionsRequest) Reset()         { *m = IncentiveRecordAttributionsRequest{} }
+func (m *IncentiveRecordAttributionsRequest) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecordAttributionsRequest) ProtoMessage()    {}
+func (*IncentiveRecordAttributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{24}
+}
+func (m *IncentiveRecordAttributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRecordAttributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecordAttributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveRecordAttributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecordAttributionsRequest.Merge(m, src)
+}
+func (m *IncentiveRecordAttributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecordAttributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecordAttributionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecordAttributionsRequest proto.InternalMessageInfo
+
+func (m *IncentiveRecordAttributionsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type IncentiveRecordAttributionsResponse struct {
+	Attributions []types1.IncentiveRecordAttribution `protobuf:"bytes,1,rep,name=attributions,proto3" json:"attributions"`
+}
+
+func (m *IncentiveRecordAttributionsResponse) Reset()         { *m = IncentiveRecordAttributionsResponse{} }
+func (m *IncentiveRecordAttributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecordAttributionsResponse) ProtoMessage()    {}
+func (*IncentiveRecordAttributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{25}
+}
+func (m *IncentiveRecordAttributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRecordAttributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecordAttributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveRecordAttributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecordAttributionsResponse.Merge(m, src)
+}
+func (m *IncentiveRecordAttributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecordAttributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecordAttributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecordAttributionsResponse proto.InternalMessageInfo
+
+func (m *IncentiveRecordAttributionsResponse) GetAttributions() []types1.IncentiveRecordAttribution {
+	if m != nil {
+		return m.Attributions
+	}
+	return nil
+}
+
+// ===================== QueryWithdrawOnlyPools
+type WithdrawOnlyPoolsRequest struct {
+}
+
+func (m *WithdrawOnlyPoolsRequest) Reset()         { *m = WithdrawOnlyPoolsRequest{} }
+func (m *WithdrawOnlyPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawOnlyPoolsRequest) ProtoMessage()    {}
+func (*WithdrawOnlyPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{26}
+}
+func (m *WithdrawOnlyPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawOnlyPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawOnlyPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawOnlyPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawOnlyPoolsRequest.Merge(m, src)
+}
+func (m *WithdrawOnlyPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawOnlyPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawOnlyPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawOnlyPoolsRequest proto.InternalMessageInfo
+
+type WithdrawOnlyPoolsResponse struct {
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *WithdrawOnlyPoolsResponse) Reset()         { *m = WithdrawOnlyPoolsResponse{} }
+func (m *WithdrawOnlyPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawOnlyPoolsResponse) ProtoMessage()    {}
+func (*WithdrawOnlyPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{27}
+}
+func (m *WithdrawOnlyPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawOnlyPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawOnlyPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawOnlyPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawOnlyPoolsResponse.Merge(m, src)
+}
+func (m *WithdrawOnlyPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawOnlyPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawOnlyPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawOnlyPoolsResponse proto.InternalMessageInfo
+
+func (m *WithdrawOnlyPoolsResponse) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
 // =============================== CFMMPoolIdLinkFromConcentratedPoolId
 type CFMMPoolIdLinkFromConcentratedPoolIdRequest struct {
 	ConcentratedPoolId uint64 `protobuf:"varint,1,opt,name=concentrated_pool_id,json=concentratedPoolId,proto3" json:"concentrated_pool_id,omitempty" yaml:"concentrated_pool_id"`
@@ -1263,7 +1452,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) String() string {
 }
 func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) ProtoMessage() {}
 func (*CFMMPoolIdLinkFromConcentratedPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{24}
+	return fileDescriptor_5da291368ba4d8e3, []int{28}
 }
 func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1500,7 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) String() string {
 }
 func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) ProtoMessage() {}
 func (*CFMMPoolIdLinkFromConcentratedPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{25}
+	return fileDescriptor_5da291368ba4d8e3, []int{29}
 }
 func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,7 +1545,7 @@ func (m *UserUnbondingPositionsRequest) Reset()         { *m = UserUnbondingPosi
 func (m *UserUnbondingPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*UserUnbondingPositionsRequest) ProtoMessage()    {}
 func (*UserUnbondingPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{26}
+	return fileDescriptor_5da291368ba4d8e3, []int{30}
 }
 func (m *UserUnbondingPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,7 +1589,7 @@ func (m *UserUnbondingPositionsResponse) Reset()         { *m = UserUnbondingPos
 func (m *UserUnbondingPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*UserUnbondingPositionsResponse) ProtoMessage()    {}
 func (*UserUnbondingPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{27}
+	return fileDescriptor_5da291368ba4d8e3, []int{31}
 }
 func (m *UserUnbondingPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1633,7 @@ func (m *GetTotalLiquidityRequest) Reset()         { *m = GetTotalLiquidityReque
 func (m *GetTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTotalLiquidityRequest) ProtoMessage()    {}
 func (*GetTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{28}
+	return fileDescriptor_5da291368ba4d8e3, []int{32}
 }
 func (m *GetTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1481,7 +1670,7 @@ func (m *GetTotalLiquidityResponse) Reset()         { *m = GetTotalLiquidityResp
 func (m *GetTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTotalLiquidityResponse) ProtoMessage()    {}
 func (*GetTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{29}
+	return fileDescriptor_5da291368ba4d8e3, []int{33}
 }
 func (m *GetTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1717,7 @@ func (m *NumNextInitializedTicksRequest) Reset()         { *m = NumNextInitializ
 func (m *NumNextInitializedTicksRequest) String() string { return proto.CompactTextString(m) }
 func (*NumNextInitializedTicksRequest) ProtoMessage()    {}
 func (*NumNextInitializedTicksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{30}
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
 }
 func (m *NumNextInitializedTicksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,7 +1777,7 @@ func (m *NumNextInitializedTicksResponse) Reset()         { *m = NumNextInitiali
 func (m *NumNextInitializedTicksResponse) String() string { return proto.CompactTextString(m) }
 func (*NumNextInitializedTicksResponse) ProtoMessage()    {}
 func (*NumNextInitializedTicksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5da291368ba4d8e3, []int{31}
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
 }
 func (m *NumNextInitializedTicksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1656,6 +1845,10 @@ func init() {
 	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
 	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
 	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
+	proto.RegisterType((*IncentiveRecordAttributionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordAttributionsRequest")
+	proto.RegisterType((*IncentiveRecordAttributionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordAttributionsResponse")
+	proto.RegisterType((*WithdrawOnlyPoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.WithdrawOnlyPoolsRequest")
+	proto.RegisterType((*WithdrawOnlyPoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.WithdrawOnlyPoolsResponse")
 	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
 	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
 	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
@@ -1671,153 +1864,162 @@ func init() {
 }
 
 var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2335 bytes of a gzipped FileDescriptorProto
+	// 2474 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0xf5, 0x4f, 0x39, 0x1f, 0x9b, 0x79, 0x71, 0xbe, 0xca, 0x8e, 0xed, 0x4c, 0x92, 0x99, 0x6c, 0xfd,
-	0xff, 0x61, 0x2d, 0x92, 0xcc, 0x90, 0x2f, 0x42, 0xbe, 0x36, 0xeb, 0xb1, 0xd7, 0xd1, 0x68, 0x1d,
-	0xaf, 0xd3, 0x89, 0x01, 0x71, 0xa0, 0xb7, 0xa7, 0xbb, 0x3c, 0x6e, 0x4d, 0x4f, 0xd7, 0xb8, 0xbb,
-	0x3a, 0x89, 0x59, 0x22, 0xad, 0x76, 0x8f, 0x48, 0xb0, 0x88, 0x2b, 0x42, 0x42, 0x5c, 0xd0, 0x8a,
-	0x23, 0x17, 0xb8, 0x20, 0x38, 0xa0, 0x88, 0xc3, 0x6a, 0x25, 0x84, 0x84, 0xf6, 0x30, 0x0b, 0x09,
-	0x07, 0xa4, 0x05, 0x24, 0xcc, 0x85, 0x23, 0xea, 0xea, 0xaa, 0x9e, 0x9e, 0x71, 0x8f, 0xdd, 0x33,
-	0x36, 0x27, 0x4e, 0x33, 0xd5, 0xaf, 0xde, 0x7b, 0xbf, 0xf7, 0x51, 0xaf, 0xeb, 0xbd, 0x19, 0xb8,
-	0xc4, 0xfc, 0x26, 0xf3, 0x6d, 0xbf, 0x6c, 0x32, 0xd7, 0xa4, 0x2e, 0xf7, 0x0c, 0x4e, 0x2d, 0xc7,
-	0x5e, 0x0b, 0x6c, 0xcb, 0xe6, 0xeb, 0xe5, 0xc7, 0x97, 0x6a, 0x94, 0x1b, 0x97, 0xca, 0x6b, 0x01,
-	0xf5, 0xd6, 0x4b, 0x2d, 0x8f, 0x71, 0x86, 0xcf, 0x49, 0x96, 0x52, 0x2a, 0x4b, 0x49, 0xb2, 0xe4,
-	0xc7, 0xeb, 0xac, 0xce, 0x04, 0x47, 0x39, 0xfc, 0x16, 0x31, 0xe7, 0xbf, 0xb8, 0xb5, 0xbe, 0x96,
-	0xe1, 0x19, 0x4d, 0x5f, 0xee, 0xbd, 0x9a, 0x0d, 0x1b, 0xb7, 0xcd, 0x46, 0xd5, 0x5d, 0x51, 0x1a,
-	0x0a, 0xa6, 0x60, 0x2b, 0xd7, 0x0c, 0x9f, 0xc6, 0x7b, 0x4c, 0x66, 0xbb, 0x0a, 0x41, 0x92, 0x2e,
-	0xec, 0x8a, 0x77, 0xb5, 0x8c, 0xba, 0xed, 0x1a, 0xdc, 0x66, 0x6a, 0xef, 0xe9, 0x3a, 0x63, 0x75,
-	0x87, 0x96, 0x8d, 0x96, 0x5d, 0x36, 0x5c, 0x97, 0x71, 0x41, 0x54, 0xf8, 0x4e, 0x4a, 0xaa, 0x58,
-	0xd5, 0x82, 0x95, 0xb2, 0xe1, 0xae, 0x2b, 0x52, 0xa4, 0x44, 0x8f, 0xec, 0x8f, 0x16, 0x92, 0x54,
-	0xec, 0xe5, 0xe2, 0x76, 0x93, 0xfa, 0xdc, 0x68, 0xb6, 0x94, 0x01, 0xbd, 0x1b, 0xac, 0xc0, 0x4b,
-	0x82, 0xca, 0xe8, 0x96, 0x16, 0xf3, 0xed, 0x04, 0xd7, 0xed, 0x6c, 0x5c, 0xb6, 0x20, 0xda, 0x8f,
-	0xa9, 0xee, 0x51, 0x93, 0x79, 0x56, 0xc4, 0x4d, 0x7e, 0x81, 0x60, 0x7c, 0xd9, 0xa7, 0xde, 0x92,
-	0x14, 0xea, 0x6b, 0x74, 0x2d, 0xa0, 0x3e, 0xc7, 0x17, 0xe0, 0x15, 0xc3, 0xb2, 0x3c, 0xea, 0xfb,
-	0x53, 0xe8, 0x2c, 0x9a, 0xce, 0x55, 0xf0, 0x46, 0xbb, 0x78, 0x64, 0xdd, 0x68, 0x3a, 0x37, 0x89,
-	0x24, 0x10, 0x4d, 0x6d, 0xc1, 0xe7, 0xe1, 0x95, 0x16, 0x63, 0x8e, 0x6e, 0x5b, 0x53, 0x23, 0x67,
-	0xd1, 0xf4, 0xbe, 0xe4, 0x6e, 0x49, 0x20, 0xda, 0x81, 0xf0, 0x5b, 0xd5, 0xc2, 0xf3, 0x00, 0x9d,
-	0x80, 0x4c, 0xed, 0x3d, 0x8b, 0xa6, 0x0f, 0x5d, 0xfe, 0x42, 0x49, 0xfa, 0x32, 0x8c, 0x5e, 0x29,
-	0xca, 0x4a, 0x09, 0xbd, 0xb4, 0x64, 0xd4, 0xa9, 0x84, 0xa5, 0x25, 0x38, 0xc9, 0x6f, 0x10, 0x9c,
-	0xe8, 0xc1, 0xee, 0xb7, 0x98, 0xeb, 0x53, 0xfc, 0x0e, 0xe4, 0x94, 0x97, 0x42, 0xf8, 0x7b, 0xa7,
-	0x0f, 0x5d, 0xbe, 0x5d, 0xca, 0x94, 0xdd, 0xa5, 0xf9, 0xc0, 0x71, 0x94, 0xc0, 0x8a, 0x47, 0x8d,
-	0x86, 0xc5, 0x9e, 0xb8, 0x95, 0x7d, 0xcf, 0xdb, 0xc5, 0x3d, 0x5a, 0x47, 0x28, 0xbe, 0xd7, 0x65,
-	0xc3, 0x88, 0xb0, 0xe1, 0xb5, 0x6d, 0x6d, 0x88, 0xe0, 0x75, 0x19, 0xb1, 0x08, 0x63, 0xb1, 0xba,
-	0xf5, 0xaa, 0xa5, 0xdc, 0x7f, 0x1d, 0x0e, 0x29, 0x65, 0xa1, 0x53, 0x91, 0x70, 0xea, 0xc4, 0x46,
-	0xbb, 0x88, 0x95, 0x53, 0x63, 0x22, 0xd1, 0x40, 0xad, 0xaa, 0x16, 0x79, 0x0c, 0xe3, 0xdd, 0xf2,
-	0xa4, 0x4b, 0xbe, 0x09, 0x07, 0xd5, 0x2e, 0x21, 0x6d, 0x77, 0x3c, 0x12, 0xcb, 0x24, 0x5f, 0x85,
-	0xd1, 0x25, 0xc6, 0x9c, 0x38, 0x7f, 0xe6, 0x53, 0x1c, 0x34, 0x4c, 0x90, 0xbf, 0x87, 0xe0, 0xb0,
-	0x14, 0x2c, 0x2d, 0xb9, 0x06, 0xfb, 0xc3, 0x44, 0x52, 0x81, 0x1d, 0x2f, 0x45, 0xc7, 0xaa, 0xa4,
-	0x8e, 0x55, 0x69, 0xc6, 0x5d, 0xaf, 0xe4, 0x7e, 0xf7, 0xf3, 0x8b, 0xfb, 0x43, 0xbe, 0xaa, 0x16,
-	0xed, 0xde, 0xbd, 0x88, 0x1d, 0x85, 0xc3, 0x4b, 0xa2, 0x9a, 0x49, 0xb8, 0x64, 0x19, 0x8e, 0xa8,
-	0x07, 0x12, 0xe2, 0x2c, 0x1c, 0x88, 0x0a, 0x9e, 0x74, 0xf5, 0xb9, 0x6d, 0x5c, 0x1d, 0xb1, 0x4b,
-	0x9f, 0x4a, 0x56, 0xf2, 0x11, 0x82, 0x63, 0x8f, 0x6c, 0xb3, 0xb1, 0xa0, 0xb6, 0x2d, 0x52, 0x8e,
-	0xdf, 0x81, 0xc3, 0x31, 0x9b, 0xee, 0x52, 0x2e, 0x0f, 0xe7, 0xad, 0x90, 0xf3, 0xd3, 0x76, 0xf1,
-	0x54, 0x64, 0x8f, 0x6f, 0x35, 0x4a, 0x36, 0x2b, 0x37, 0x0d, 0xbe, 0x5a, 0x5a, 0xa0, 0x75, 0xc3,
-	0x5c, 0x9f, 0xa3, 0xe6, 0x46, 0xbb, 0x38, 0x1e, 0x25, 0x4f, 0x97, 0x04, 0xa2, 0x8d, 0x3a, 0x49,
-	0x0d, 0x57, 0x01, 0xc2, 0xc2, 0xab, 0xdb, 0xae, 0x45, 0x9f, 0x0a, 0x3f, 0xed, 0xad, 0x9c, 0xd8,
-	0x68, 0x17, 0x8f, 0x47, 0xbc, 0x1d, 0x1a, 0xd1, 0x72, 0x51, 0x85, 0x0e, 0xbf, 0xff, 0x1d, 0xc1,
-	0x64, 0x0c, 0x74, 0x8e, 0xb6, 0xf8, 0xea, 0xd7, 0x6c, 0xbe, 0xaa, 0x19, 0x6e, 0x9d, 0xe2, 0x15,
-	0x38, 0xd6, 0xd1, 0x68, 0x34, 0x59, 0xe0, 0xee, 0x0a, 0xec, 0xa3, 0xf1, 0x7a, 0x46, 0xc8, 0x0c,
-	0x91, 0x3b, 0xec, 0x09, 0xf5, 0xf4, 0x10, 0xd6, 0x66, 0xe4, 0x1d, 0x1a, 0xd1, 0x72, 0x62, 0x11,
-	0x7a, 0x37, 0xe4, 0x0a, 0x5a, 0x2d, 0xc5, 0xb5, 0xb7, 0x97, 0xab, 0x43, 0x23, 0x5a, 0x4e, 0x2c,
-	0x42, 0x2e, 0xf2, 0xd9, 0x08, 0x14, 0x92, 0x81, 0xa9, 0xba, 0x73, 0xb6, 0x47, 0xcd, 0x30, 0x41,
-	0xd4, 0x09, 0x48, 0xd4, 0x44, 0xb4, 0x6d, 0x4d, 0x2c, 0xc1, 0x41, 0xce, 0x1a, 0xd4, 0xd5, 0xed,
-	0x28, 0x37, 0x73, 0x95, 0xb1, 0x8d, 0x76, 0xf1, 0xa8, 0xf4, 0xb9, 0xa4, 0x10, 0xed, 0x15, 0xf1,
-	0xb5, 0xea, 0x86, 0xa8, 0x7d, 0x6e, 0x78, 0xbc, 0x0f, 0xea, 0x0e, 0x8d, 0x68, 0x39, 0xb1, 0x10,
-	0xb6, 0xde, 0x80, 0xd1, 0xc0, 0xa7, 0xba, 0x19, 0x48, 0x6b, 0xf7, 0x9d, 0x45, 0xd3, 0x07, 0x2b,
-	0x93, 0x1b, 0xed, 0xe2, 0x98, 0xb4, 0x36, 0x41, 0x25, 0x1a, 0x04, 0x3e, 0x9d, 0x0d, 0x62, 0x37,
-	0xd5, 0x58, 0xe0, 0x5a, 0x11, 0xe3, 0xfe, 0x5e, 0x85, 0x1d, 0x1a, 0xd1, 0x72, 0x62, 0x91, 0x54,
-	0xe8, 0x32, 0x5d, 0x3c, 0x9b, 0x3a, 0x90, 0xa6, 0x50, 0x51, 0x23, 0x85, 0x8b, 0xac, 0x22, 0x16,
-	0x3f, 0xde, 0x0b, 0xc5, 0xbe, 0x1e, 0x96, 0xe7, 0x6c, 0x35, 0x99, 0x59, 0x56, 0x98, 0x75, 0xaa,
-	0x2a, 0x5c, 0xcf, 0x58, 0xdc, 0x7a, 0x0f, 0x98, 0x3c, 0x83, 0x9d, 0xdc, 0x12, 0xb9, 0xec, 0xe3,
-	0x57, 0x61, 0xd4, 0x0c, 0x3c, 0x8f, 0xba, 0x3c, 0x91, 0x5d, 0xda, 0x21, 0xf9, 0x4c, 0xd8, 0xea,
-	0xc0, 0x71, 0xb5, 0x25, 0xe6, 0x16, 0x91, 0xc9, 0x55, 0xee, 0x66, 0xcb, 0xf3, 0xa9, 0xc8, 0x27,
-	0x9b, 0xa4, 0x10, 0xed, 0x98, 0x7c, 0x16, 0x43, 0xc5, 0xef, 0x23, 0xc0, 0x6a, 0xa3, 0xbf, 0xe6,
-	0x71, 0xbd, 0xe5, 0xd9, 0x26, 0x15, 0x11, 0xcd, 0x55, 0x1e, 0x49, 0x7d, 0xe5, 0xba, 0xcd, 0x57,
-	0x83, 0x5a, 0xc9, 0x64, 0xcd, 0xb2, 0xf4, 0xc7, 0x45, 0xc7, 0xa8, 0xf9, 0x6a, 0x21, 0x3e, 0x05,
-	0x8c, 0x8a, 0x5d, 0x8f, 0x30, 0x9c, 0xec, 0xc6, 0xd0, 0x11, 0xdd, 0x01, 0xf1, 0x70, 0xcd, 0xe3,
-	0x4b, 0xe2, 0xd1, 0x5b, 0x70, 0x3a, 0x46, 0xb4, 0x14, 0x9d, 0x0c, 0x71, 0xe4, 0x87, 0x39, 0x02,
-	0xe4, 0x57, 0x08, 0xce, 0xf4, 0x91, 0x26, 0xc3, 0x5d, 0x83, 0x5c, 0xc7, 0xb3, 0x51, 0x9c, 0x5f,
-	0xcf, 0x18, 0xe7, 0x3e, 0xb5, 0x49, 0xbd, 0xd8, 0x63, 0x06, 0x7c, 0x13, 0x46, 0x6b, 0x81, 0xd9,
-	0xa0, 0xbc, 0xab, 0x00, 0x26, 0x32, 0x36, 0x49, 0x25, 0xda, 0xa1, 0x68, 0x19, 0x15, 0xc1, 0xaf,
-	0xc3, 0x99, 0x59, 0xc7, 0xb0, 0x9b, 0x46, 0xcd, 0xa1, 0x0f, 0x5b, 0x1e, 0x35, 0x2c, 0x8d, 0x3e,
-	0x31, 0x3c, 0xcb, 0xdf, 0xf1, 0x5b, 0xfd, 0x47, 0x08, 0x0a, 0xfd, 0x44, 0x4b, 0xe7, 0x7c, 0x1b,
-	0xa6, 0x4c, 0xb5, 0x43, 0xf7, 0xc5, 0x16, 0xdd, 0x8b, 0xf6, 0x48, 0x5f, 0x9d, 0xec, 0x7a, 0xdb,
-	0x29, 0xcf, 0xcc, 0x32, 0xdb, 0xad, 0xbc, 0x16, 0xba, 0x61, 0xa3, 0x5d, 0x2c, 0xca, 0xe8, 0xf7,
-	0x11, 0x44, 0xb4, 0x09, 0x33, 0x15, 0x05, 0x59, 0x86, 0x7c, 0x8c, 0xaf, 0xaa, 0xae, 0x9a, 0x3b,
-	0xb7, 0xfb, 0x83, 0x11, 0x38, 0x95, 0x2a, 0x57, 0x1a, 0xbd, 0x06, 0xe3, 0x1d, 0xac, 0xf1, 0x15,
-	0x37, 0x83, 0xc1, 0xff, 0x27, 0x0d, 0x3e, 0xd5, 0x6b, 0x70, 0x47, 0x08, 0xd1, 0xc6, 0xcc, 0xcd,
-	0xaa, 0x43, 0x95, 0x2b, 0xcc, 0x5b, 0xa1, 0x36, 0xa7, 0x56, 0x52, 0xe5, 0xc8, 0x80, 0x2a, 0xd3,
-	0x84, 0x10, 0x6d, 0x2c, 0x7e, 0xdc, 0x51, 0x49, 0x16, 0xe0, 0x4c, 0x78, 0x95, 0x99, 0x31, 0xcd,
-	0xa0, 0x19, 0x38, 0x06, 0x67, 0x5e, 0x4f, 0x5e, 0x0d, 0x74, 0xce, 0x7e, 0x3d, 0x02, 0x85, 0x7e,
-	0xe2, 0xa4, 0x5b, 0x3f, 0x44, 0x70, 0xaa, 0x2b, 0xf2, 0x7a, 0xdd, 0x63, 0x4f, 0xf8, 0xaa, 0x5e,
-	0x77, 0x58, 0xcd, 0x70, 0xa4, 0x7b, 0x4f, 0xa7, 0xda, 0x3a, 0x47, 0x4d, 0x61, 0xee, 0x95, 0xd0,
-	0xdc, 0x8f, 0x3e, 0x2b, 0x9e, 0x4f, 0xd4, 0x20, 0xd9, 0xa1, 0x45, 0x1f, 0x17, 0x7d, 0xab, 0x51,
-	0xe6, 0xeb, 0x2d, 0xea, 0x2b, 0x1e, 0x5f, 0x9b, 0xf2, 0x13, 0x59, 0x75, 0x4f, 0xe8, 0xbc, 0x27,
-	0x54, 0xe2, 0xef, 0x20, 0x18, 0x0f, 0x5a, 0x61, 0x4b, 0xd5, 0x83, 0x25, 0xf2, 0xfb, 0xd5, 0x8c,
-	0x75, 0x60, 0x59, 0x88, 0x78, 0xe4, 0x19, 0x66, 0x83, 0x7a, 0xbd, 0x21, 0x49, 0x93, 0x4f, 0x34,
-	0x1c, 0x3d, 0x4e, 0xa2, 0x21, 0x1f, 0x20, 0x28, 0x84, 0xf5, 0x29, 0xe1, 0x43, 0x29, 0x73, 0xa8,
-	0x98, 0x0c, 0x79, 0xe9, 0xfa, 0x7c, 0x04, 0x8a, 0x7d, 0x51, 0xc8, 0x50, 0x3e, 0x47, 0x70, 0x23,
-	0x35, 0x94, 0xac, 0x25, 0xce, 0x19, 0xd5, 0x2d, 0xf5, 0x5a, 0xd5, 0xd9, 0x8a, 0xee, 0x18, 0x3e,
-	0xd7, 0xb9, 0x67, 0x3c, 0xa6, 0x9e, 0xff, 0xdf, 0x0c, 0xf4, 0xe5, 0xcd, 0x81, 0x7e, 0x5b, 0x02,
-	0x8a, 0x5f, 0xf3, 0x6f, 0xaf, 0x2c, 0x18, 0x3e, 0x7f, 0xa4, 0xc0, 0xe0, 0x67, 0x70, 0x54, 0x46,
-	0x88, 0x4b, 0x2b, 0x77, 0x14, 0xfc, 0x82, 0x0c, 0xfe, 0x44, 0x57, 0xf0, 0x95, 0x68, 0xa2, 0x1d,
-	0x09, 0x92, 0xdb, 0x7d, 0xf2, 0x5d, 0x04, 0x93, 0xf1, 0xa1, 0xd4, 0x44, 0x13, 0x3d, 0x5c, 0xb0,
-	0x77, 0xab, 0x35, 0xfa, 0x18, 0xc1, 0xd4, 0x66, 0x40, 0x32, 0xee, 0x36, 0x1c, 0xef, 0x6d, 0xf9,
-	0x55, 0x59, 0xfc, 0x72, 0x46, 0x77, 0xf5, 0xc8, 0x96, 0xef, 0xca, 0x63, 0x76, 0x8f, 0xca, 0xdd,
-	0xeb, 0xac, 0xde, 0x43, 0x70, 0x7e, 0x76, 0xfe, 0xfe, 0x7d, 0xd1, 0xb7, 0x59, 0x0b, 0xb6, 0xdb,
-	0x98, 0xf7, 0x58, 0x73, 0x36, 0x01, 0x32, 0xa2, 0x28, 0xaf, 0x3f, 0x80, 0xf1, 0xa4, 0x05, 0x7a,
-	0x77, 0x08, 0x8a, 0x89, 0xf2, 0x9e, 0xb2, 0x8b, 0x68, 0xd8, 0xdc, 0x24, 0x99, 0xd8, 0x70, 0x21,
-	0x1b, 0x02, 0xe9, 0xe6, 0x1b, 0x30, 0x6a, 0xae, 0x34, 0x9b, 0x3d, 0xaa, 0x13, 0xd7, 0x85, 0x24,
-	0x95, 0x68, 0x10, 0x2e, 0xa5, 0xaa, 0xfb, 0x70, 0x66, 0xd9, 0xa7, 0xde, 0xb2, 0x5b, 0x63, 0xae,
-	0x65, 0xbb, 0xf5, 0x9d, 0x8d, 0x60, 0xc8, 0x4f, 0x10, 0x14, 0xfa, 0xc9, 0x93, 0x60, 0xdf, 0x43,
-	0x90, 0x8f, 0x47, 0x18, 0xfa, 0x13, 0x9b, 0xaf, 0xea, 0x2d, 0xea, 0xd9, 0xcc, 0xd2, 0x1d, 0x66,
-	0x36, 0x64, 0x76, 0xdc, 0xc9, 0x98, 0x1d, 0x4a, 0x7c, 0x78, 0x97, 0x5a, 0x12, 0x52, 0x16, 0x98,
-	0xd9, 0x90, 0x49, 0x32, 0x19, 0xab, 0xe9, 0x26, 0x93, 0x3c, 0x4c, 0xdd, 0xa3, 0xfc, 0x11, 0xe3,
-	0x86, 0x13, 0x5f, 0xc9, 0x54, 0x1f, 0xfd, 0x7d, 0x04, 0x27, 0x53, 0x88, 0x12, 0x3c, 0x87, 0xa3,
-	0x3c, 0xa4, 0xe8, 0xbd, 0x57, 0xc0, 0x2d, 0x5e, 0xb9, 0x5f, 0x92, 0xa5, 0x69, 0x3a, 0x43, 0x69,
-	0x8a, 0xea, 0xd2, 0x11, 0xde, 0xa5, 0x9d, 0x6c, 0x20, 0x28, 0x2c, 0x06, 0xcd, 0x45, 0xfa, 0x94,
-	0x57, 0x5d, 0x9b, 0xdb, 0x86, 0x63, 0x7f, 0x8b, 0x8a, 0xde, 0x66, 0xb8, 0xb3, 0x7f, 0x17, 0x8e,
-	0xa8, 0x6e, 0x4e, 0xb7, 0xa8, 0xcb, 0x9a, 0xb2, 0xdb, 0x3b, 0xb9, 0xd1, 0x2e, 0x9e, 0xe8, 0xee,
-	0xf6, 0x22, 0x3a, 0xd1, 0x46, 0x65, 0xcf, 0x37, 0x17, 0x2e, 0x71, 0x0d, 0xf2, 0x6e, 0xd0, 0xd4,
-	0x5d, 0xfa, 0x34, 0xbc, 0x83, 0xc6, 0x88, 0x44, 0x57, 0xe2, 0x8b, 0x76, 0x63, 0x5f, 0xe5, 0xdc,
-	0x46, 0xbb, 0xf8, 0x6a, 0x24, 0xac, 0xff, 0x5e, 0xa2, 0x4d, 0xba, 0xe9, 0x86, 0x91, 0x1f, 0x8e,
-	0x40, 0xb1, 0xaf, 0xd1, 0xff, 0xf3, 0xad, 0xd7, 0xe5, 0x7f, 0xe6, 0x61, 0xff, 0x83, 0xb0, 0xa2,
-	0xe1, 0x9f, 0x22, 0x10, 0x43, 0x26, 0x1f, 0x5f, 0xc9, 0x7c, 0x6a, 0x3a, 0x33, 0xb2, 0xfc, 0xd5,
-	0xc1, 0x98, 0x22, 0xcf, 0x93, 0xab, 0xef, 0xff, 0xfe, 0x2f, 0x3f, 0x18, 0x29, 0xe1, 0x0b, 0xe5,
-	0xac, 0xf3, 0xe2, 0x10, 0xe0, 0xcf, 0x10, 0x1c, 0x88, 0xc6, 0x4c, 0x38, 0xb3, 0xda, 0xe4, 0x94,
-	0x2b, 0x7f, 0x6d, 0x40, 0x2e, 0x89, 0xf6, 0x9a, 0x40, 0x5b, 0xc6, 0x17, 0xb3, 0xa2, 0x8d, 0x30,
-	0x7e, 0x8c, 0xe0, 0x70, 0xd7, 0x6c, 0x17, 0xdf, 0xca, 0xfa, 0x92, 0x4f, 0x99, 0x66, 0xe7, 0x6f,
-	0x0f, 0xc7, 0x2c, 0x6d, 0xa8, 0x08, 0x1b, 0x6e, 0xe3, 0x9b, 0xe5, 0xc1, 0x26, 0xf4, 0x7e, 0xf9,
-	0x5d, 0x59, 0x9d, 0x9f, 0xe1, 0xcf, 0x11, 0x9c, 0x48, 0xed, 0x6e, 0xf1, 0xec, 0xa0, 0x2d, 0x6c,
-	0x4a, 0xa7, 0x9d, 0x9f, 0xdb, 0x99, 0x10, 0x69, 0xe8, 0x3d, 0x61, 0xe8, 0x0c, 0xbe, 0x9b, 0xd1,
-	0xd0, 0x4e, 0x05, 0x50, 0x43, 0x32, 0xdd, 0x13, 0x36, 0xfd, 0x2b, 0x39, 0x0e, 0xec, 0x1e, 0xde,
-	0xe0, 0x37, 0x07, 0x85, 0x9a, 0x3a, 0x5e, 0xcb, 0xcf, 0xef, 0x54, 0x8c, 0xb4, 0xb9, 0x2a, 0x6c,
-	0x9e, 0xc5, 0x33, 0x03, 0xdb, 0xec, 0x8a, 0x31, 0x40, 0xe7, 0xfe, 0x8c, 0xff, 0x81, 0x60, 0x22,
-	0xbd, 0x4b, 0xc7, 0x59, 0xe3, 0xb3, 0xe5, 0xfc, 0x20, 0xff, 0xe6, 0x0e, 0xa5, 0x0c, 0x19, 0xe6,
-	0x7e, 0xe3, 0x00, 0xfc, 0x67, 0x04, 0x63, 0x29, 0xed, 0x39, 0x9e, 0x19, 0x14, 0xe7, 0xa6, 0x91,
-	0x41, 0xbe, 0xb2, 0x13, 0x11, 0xd2, 0xce, 0x59, 0x61, 0xe7, 0x1d, 0x7c, 0x6b, 0x60, 0x3b, 0x3b,
-	0x2d, 0x39, 0xfe, 0x2d, 0x82, 0xd1, 0xe4, 0x2f, 0x2a, 0xf8, 0xe6, 0x80, 0x17, 0xa4, 0xc4, 0xcf,
-	0x3a, 0xf9, 0x5b, 0x43, 0xf1, 0x4a, 0x73, 0xee, 0x08, 0x73, 0xae, 0xe3, 0x6b, 0x03, 0x96, 0x21,
-	0xbd, 0xb6, 0xae, 0xdb, 0x16, 0xfe, 0x2b, 0x82, 0x89, 0xf4, 0xbe, 0x3f, 0x73, 0x76, 0x6e, 0x39,
-	0x85, 0xc8, 0x9c, 0x9d, 0x5b, 0x0f, 0x1f, 0xc8, 0x8c, 0x30, 0xf3, 0x16, 0xbe, 0x31, 0xc0, 0xfb,
-	0x4d, 0x37, 0x42, 0x79, 0x71, 0x5e, 0xfe, 0x01, 0xc1, 0xb1, 0xde, 0xce, 0x08, 0xbf, 0x3e, 0x5c,
-	0xdb, 0x13, 0x9b, 0x77, 0x77, 0x68, 0x7e, 0x69, 0xd8, 0x1b, 0xc2, 0xb0, 0x9b, 0xf8, 0x2b, 0xe5,
-	0xe1, 0x7e, 0xb2, 0xf5, 0xf1, 0xdf, 0x10, 0x4c, 0xf6, 0x69, 0xf8, 0x33, 0x97, 0xd5, 0xad, 0xc7,
-	0x16, 0x99, 0xcb, 0xea, 0x36, 0x73, 0x87, 0x81, 0xdf, 0x99, 0xe2, 0xe5, 0x11, 0x45, 0x51, 0xb5,
-	0xe0, 0xf8, 0x97, 0x23, 0xf0, 0xff, 0x59, 0xba, 0x31, 0xac, 0x65, 0x2d, 0x16, 0xd9, 0x9b, 0xcb,
-	0xfc, 0xc3, 0x5d, 0x95, 0x29, 0xbd, 0x62, 0x0b, 0xaf, 0x98, 0xd8, 0xc8, 0x5a, 0x91, 0x12, 0xdd,
-	0xa3, 0xee, 0xd8, 0x6e, 0x43, 0x5f, 0xf1, 0x58, 0x53, 0x4f, 0x32, 0x95, 0xdf, 0x4d, 0xeb, 0x6e,
-	0x9f, 0xe1, 0x7f, 0x23, 0x98, 0x48, 0xef, 0x07, 0x33, 0x1f, 0xf7, 0x2d, 0xdb, 0xd3, 0xcc, 0xc7,
-	0x7d, 0xeb, 0xa6, 0x94, 0x3c, 0x10, 0x2e, 0x79, 0x0b, 0x57, 0x33, 0xba, 0x24, 0xf0, 0xa9, 0xa7,
-	0x07, 0x4a, 0x9e, 0x9e, 0x76, 0xd7, 0xfa, 0x14, 0xc1, 0xf1, 0x4d, 0x8d, 0x24, 0xce, 0x7a, 0x7e,
-	0xfb, 0xf5, 0xa7, 0xf9, 0x37, 0x86, 0x17, 0x30, 0xe4, 0xa1, 0xa8, 0x53, 0xae, 0xf7, 0x34, 0xbd,
-	0xe2, 0x6a, 0xd5, 0xa7, 0x39, 0xcb, 0x5c, 0x03, 0xb6, 0xee, 0x68, 0x33, 0xd7, 0x80, 0x6d, 0x7a,
-	0xc4, 0x81, 0xaf, 0x56, 0xfd, 0x9b, 0xd5, 0xca, 0xea, 0xf3, 0x17, 0x05, 0xf4, 0xc9, 0x8b, 0x02,
-	0xfa, 0xd3, 0x8b, 0x02, 0xfa, 0xf0, 0x65, 0x61, 0xcf, 0x27, 0x2f, 0x0b, 0x7b, 0xfe, 0xf8, 0xb2,
-	0xb0, 0xe7, 0x1b, 0x8b, 0xdb, 0xfd, 0xc6, 0xf5, 0xf8, 0xf2, 0xa5, 0xf2, 0xd3, 0x2e, 0xcd, 0x17,
-	0x3b, 0xaa, 0x4d, 0xc7, 0xa6, 0x2e, 0x8f, 0xfe, 0x2e, 0x14, 0xfd, 0x81, 0xe0, 0x80, 0xf8, 0xb8,
-	0xf2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x97, 0xdd, 0xc0, 0x41, 0x25, 0x00, 0x00,
+	0x15, 0x4e, 0x39, 0xbf, 0xf3, 0xe2, 0xc4, 0x49, 0xd9, 0xf1, 0xcf, 0x64, 0x33, 0x93, 0xad, 0x65,
+	0x59, 0x8b, 0x24, 0x33, 0xe4, 0x8f, 0x90, 0xbf, 0x4d, 0x3c, 0x76, 0x1c, 0x0d, 0xeb, 0x38, 0x4e,
+	0x27, 0x01, 0xc4, 0x81, 0xde, 0x9e, 0xee, 0xf2, 0xb8, 0x35, 0x3d, 0x5d, 0xe3, 0xee, 0xea, 0x38,
+	0xc3, 0x12, 0x69, 0x95, 0x3d, 0x22, 0xc1, 0x02, 0x57, 0x84, 0x84, 0xb8, 0xa0, 0x15, 0x47, 0x24,
+	0x04, 0x17, 0x04, 0x07, 0x14, 0x71, 0x58, 0xad, 0xb4, 0x42, 0x42, 0x7b, 0x98, 0x85, 0x84, 0x03,
+	0xd2, 0x02, 0x07, 0x73, 0xe1, 0x88, 0xba, 0xba, 0xba, 0xa7, 0x67, 0xdc, 0xe3, 0xf4, 0xcc, 0x18,
+	0x2e, 0x9c, 0x3c, 0xd5, 0xaf, 0xde, 0xcf, 0xf7, 0xde, 0xab, 0xd7, 0xf5, 0x5e, 0x1b, 0xce, 0x32,
+	0xb7, 0xce, 0x5c, 0xd3, 0x2d, 0xea, 0xcc, 0xd6, 0xa9, 0xcd, 0x1d, 0x8d, 0x53, 0xc3, 0x32, 0xd7,
+	0x3d, 0xd3, 0x30, 0x79, 0xb3, 0xf8, 0xe8, 0x6c, 0x85, 0x72, 0xed, 0x6c, 0x71, 0xdd, 0xa3, 0x4e,
+	0xb3, 0xd0, 0x70, 0x18, 0x67, 0xf8, 0x75, 0xc9, 0x52, 0x48, 0x64, 0x29, 0x48, 0x96, 0xec, 0x44,
+	0x95, 0x55, 0x99, 0xe0, 0x28, 0xfa, 0xbf, 0x02, 0xe6, 0xec, 0x17, 0xb6, 0xd7, 0xd7, 0xd0, 0x1c,
+	0xad, 0xee, 0xca, 0xbd, 0x17, 0xd2, 0xd9, 0xc6, 0x4d, 0xbd, 0x56, 0xb6, 0x57, 0x43, 0x0d, 0x39,
+	0x5d, 0xb0, 0x15, 0x2b, 0x9a, 0x4b, 0xa3, 0x3d, 0x3a, 0x33, 0xed, 0xd0, 0x82, 0x38, 0x5d, 0xe0,
+	0x8a, 0x76, 0x35, 0xb4, 0xaa, 0x69, 0x6b, 0xdc, 0x64, 0xe1, 0xde, 0x57, 0xaa, 0x8c, 0x55, 0x2d,
+	0x5a, 0xd4, 0x1a, 0x66, 0x51, 0xb3, 0x6d, 0xc6, 0x05, 0x31, 0xb4, 0x6f, 0x46, 0x52, 0xc5, 0xaa,
+	0xe2, 0xad, 0x16, 0x35, 0xbb, 0x19, 0x92, 0x02, 0x25, 0x6a, 0x80, 0x3f, 0x58, 0x48, 0x52, 0xbe,
+	0x9b, 0x8b, 0x9b, 0x75, 0xea, 0x72, 0xad, 0xde, 0x08, 0x01, 0x74, 0x6f, 0x30, 0x3c, 0x27, 0x6e,
+	0x54, 0x4a, 0xb7, 0x34, 0x98, 0x6b, 0xc6, 0xb8, 0xae, 0xa5, 0xe3, 0x32, 0x05, 0xd1, 0x7c, 0x44,
+	0x55, 0x87, 0xea, 0xcc, 0x31, 0x02, 0x6e, 0xf2, 0x2b, 0x04, 0x13, 0x0f, 0x5d, 0xea, 0xac, 0x48,
+	0xa1, 0xae, 0x42, 0xd7, 0x3d, 0xea, 0x72, 0x7c, 0x1a, 0xf6, 0x6b, 0x86, 0xe1, 0x50, 0xd7, 0x9d,
+	0x46, 0x27, 0xd1, 0x6c, 0xa6, 0x84, 0x37, 0x5b, 0xf9, 0xc3, 0x4d, 0xad, 0x6e, 0x5d, 0x21, 0x92,
+	0x40, 0x94, 0x70, 0x0b, 0x3e, 0x05, 0xfb, 0x1b, 0x8c, 0x59, 0xaa, 0x69, 0x4c, 0x8f, 0x9c, 0x44,
+	0xb3, 0x7b, 0xe2, 0xbb, 0x25, 0x81, 0x28, 0xfb, 0xfc, 0x5f, 0x65, 0x03, 0x2f, 0x02, 0xb4, 0x03,
+	0x32, 0xbd, 0xfb, 0x24, 0x9a, 0x3d, 0x78, 0xee, 0xf3, 0x05, 0xe9, 0x4b, 0x3f, 0x7a, 0x85, 0x20,
+	0x2b, 0xa5, 0xe9, 0x85, 0x15, 0xad, 0x4a, 0xa5, 0x59, 0x4a, 0x8c, 0x93, 0xfc, 0x0e, 0xc1, 0xb1,
+	0x2e, 0xdb, 0xdd, 0x06, 0xb3, 0x5d, 0x8a, 0xdf, 0x86, 0x4c, 0xe8, 0x25, 0xdf, 0xfc, 0xdd, 0xb3,
+	0x07, 0xcf, 0x5d, 0x2b, 0xa4, 0xca, 0xee, 0xc2, 0xa2, 0x67, 0x59, 0xa1, 0xc0, 0x92, 0x43, 0xb5,
+	0x9a, 0xc1, 0x36, 0xec, 0xd2, 0x9e, 0x67, 0xad, 0xfc, 0x2e, 0xa5, 0x2d, 0x14, 0xdf, 0xee, 0xc0,
+	0x30, 0x22, 0x30, 0xbc, 0xf1, 0x52, 0x0c, 0x81, 0x79, 0x1d, 0x20, 0x96, 0x61, 0x3c, 0x52, 0xd7,
+	0x2c, 0x1b, 0xa1, 0xfb, 0x2f, 0xc1, 0xc1, 0x50, 0x99, 0xef, 0x54, 0x24, 0x9c, 0x3a, 0xb9, 0xd9,
+	0xca, 0xe3, 0xd0, 0xa9, 0x11, 0x91, 0x28, 0x10, 0xae, 0xca, 0x06, 0x79, 0x04, 0x13, 0x9d, 0xf2,
+	0xa4, 0x4b, 0xbe, 0x09, 0x07, 0xc2, 0x5d, 0x42, 0xda, 0xce, 0x78, 0x24, 0x92, 0x49, 0xbe, 0x0a,
+	0xa3, 0x2b, 0x8c, 0x59, 0x51, 0xfe, 0x2c, 0x26, 0x38, 0x68, 0x90, 0x20, 0x7f, 0x0f, 0xc1, 0x21,
+	0x29, 0x58, 0x22, 0xb9, 0x08, 0x7b, 0xfd, 0x44, 0x0a, 0x03, 0x3b, 0x51, 0x08, 0x8e, 0x55, 0x21,
+	0x3c, 0x56, 0x85, 0x39, 0xbb, 0x59, 0xca, 0xfc, 0xe1, 0x17, 0x67, 0xf6, 0xfa, 0x7c, 0x65, 0x25,
+	0xd8, 0xbd, 0x73, 0x11, 0x1b, 0x83, 0x43, 0x2b, 0xa2, 0x9a, 0x49, 0x73, 0xc9, 0x43, 0x38, 0x1c,
+	0x3e, 0x90, 0x26, 0xce, 0xc3, 0xbe, 0xa0, 0xe0, 0x49, 0x57, 0xbf, 0xfe, 0x12, 0x57, 0x07, 0xec,
+	0xd2, 0xa7, 0x92, 0x95, 0x7c, 0x80, 0xe0, 0xc8, 0x03, 0x53, 0xaf, 0x2d, 0x85, 0xdb, 0x96, 0x29,
+	0xc7, 0x6f, 0xc3, 0xa1, 0x88, 0x4d, 0xb5, 0x29, 0x97, 0x87, 0xf3, 0xaa, 0xcf, 0xf9, 0x49, 0x2b,
+	0x7f, 0x3c, 0xc0, 0xe3, 0x1a, 0xb5, 0x82, 0xc9, 0x8a, 0x75, 0x8d, 0xaf, 0x15, 0x96, 0x68, 0x55,
+	0xd3, 0x9b, 0x0b, 0x54, 0xdf, 0x6c, 0xe5, 0x27, 0x82, 0xe4, 0xe9, 0x90, 0x40, 0x94, 0x51, 0x2b,
+	0xae, 0xe1, 0x02, 0x80, 0x5f, 0x78, 0x55, 0xd3, 0x36, 0xe8, 0x63, 0xe1, 0xa7, 0xdd, 0xa5, 0x63,
+	0x9b, 0xad, 0xfc, 0xd1, 0x80, 0xb7, 0x4d, 0x23, 0x4a, 0x26, 0xa8, 0xd0, 0xfe, 0xef, 0x7f, 0x20,
+	0x98, 0x8a, 0x0c, 0x5d, 0xa0, 0x0d, 0xbe, 0xf6, 0x35, 0x93, 0xaf, 0x29, 0x9a, 0x5d, 0xa5, 0x78,
+	0x15, 0x8e, 0xb4, 0x35, 0x6a, 0x75, 0xe6, 0xd9, 0x3b, 0x62, 0xf6, 0x58, 0xb4, 0x9e, 0x13, 0x32,
+	0x7d, 0xcb, 0x2d, 0xb6, 0x41, 0x1d, 0xd5, 0x37, 0x6b, 0xab, 0xe5, 0x6d, 0x1a, 0x51, 0x32, 0x62,
+	0xe1, 0x7b, 0xd7, 0xe7, 0xf2, 0x1a, 0x8d, 0x90, 0x6b, 0x77, 0x37, 0x57, 0x9b, 0x46, 0x94, 0x8c,
+	0x58, 0xf8, 0x5c, 0xe4, 0xd3, 0x11, 0xc8, 0xc5, 0x03, 0x53, 0xb6, 0x17, 0x4c, 0x87, 0xea, 0x7e,
+	0x82, 0x84, 0x27, 0x20, 0x56, 0x13, 0xd1, 0x4b, 0x6b, 0x62, 0x01, 0x0e, 0x70, 0x56, 0xa3, 0xb6,
+	0x6a, 0x06, 0xb9, 0x99, 0x29, 0x8d, 0x6f, 0xb6, 0xf2, 0x63, 0xd2, 0xe7, 0x92, 0x42, 0x94, 0xfd,
+	0xe2, 0x67, 0xd9, 0xf6, 0xad, 0x76, 0xb9, 0xe6, 0xf0, 0x1e, 0x56, 0xb7, 0x69, 0x44, 0xc9, 0x88,
+	0x85, 0xc0, 0x7a, 0x19, 0x46, 0x3d, 0x97, 0xaa, 0xba, 0x27, 0xd1, 0xee, 0x39, 0x89, 0x66, 0x0f,
+	0x94, 0xa6, 0x36, 0x5b, 0xf9, 0x71, 0x89, 0x36, 0x46, 0x25, 0x0a, 0x78, 0x2e, 0x9d, 0xf7, 0x22,
+	0x37, 0x55, 0x98, 0x67, 0x1b, 0x01, 0xe3, 0xde, 0x6e, 0x85, 0x6d, 0x1a, 0x51, 0x32, 0x62, 0x11,
+	0x57, 0x68, 0x33, 0x55, 0x3c, 0x9b, 0xde, 0x97, 0xa4, 0x30, 0xa4, 0x06, 0x0a, 0x97, 0x59, 0x49,
+	0x2c, 0x7e, 0xb2, 0x1b, 0xf2, 0x3d, 0x3d, 0x2c, 0xcf, 0xd9, 0x5a, 0x3c, 0xb3, 0x0c, 0x3f, 0xeb,
+	0xc2, 0xaa, 0x70, 0x29, 0x65, 0x71, 0xeb, 0x3e, 0x60, 0xf2, 0x0c, 0x8e, 0x59, 0x1d, 0xb9, 0xec,
+	0xe2, 0x57, 0x61, 0x54, 0xf7, 0x1c, 0x87, 0xda, 0x3c, 0x96, 0x5d, 0xca, 0x41, 0xf9, 0x4c, 0x60,
+	0xb5, 0xe0, 0x68, 0xb8, 0x25, 0xe2, 0x16, 0x91, 0xc9, 0x94, 0x6e, 0xa4, 0xcb, 0xf3, 0xe9, 0xc0,
+	0x27, 0x5b, 0xa4, 0x10, 0xe5, 0x88, 0x7c, 0x16, 0x99, 0x8a, 0x9f, 0x22, 0xc0, 0xe1, 0x46, 0x77,
+	0xdd, 0xe1, 0x6a, 0xc3, 0x31, 0x75, 0x2a, 0x22, 0x9a, 0x29, 0x3d, 0x90, 0xfa, 0x8a, 0x55, 0x93,
+	0xaf, 0x79, 0x95, 0x82, 0xce, 0xea, 0x45, 0xe9, 0x8f, 0x33, 0x96, 0x56, 0x71, 0xc3, 0x85, 0xf8,
+	0x2b, 0xcc, 0x28, 0x99, 0xd5, 0xc0, 0x86, 0x99, 0x4e, 0x1b, 0xda, 0xa2, 0xdb, 0x46, 0xdc, 0x5f,
+	0x77, 0xf8, 0x8a, 0x78, 0xf4, 0x4b, 0x04, 0xaf, 0x44, 0x26, 0xad, 0x04, 0x47, 0x43, 0x9c, 0xf9,
+	0x81, 0xce, 0xc0, 0xff, 0xf2, 0xfc, 0xfe, 0x06, 0xc1, 0x89, 0x1e, 0x96, 0xcb, 0xdc, 0xaa, 0x40,
+	0xa6, 0x1d, 0xc6, 0x20, 0xa9, 0xde, 0x4c, 0x99, 0x54, 0x3d, 0x0a, 0x61, 0x78, 0x8b, 0x88, 0x18,
+	0xf0, 0x15, 0x18, 0xad, 0x78, 0x7a, 0x8d, 0xf2, 0x8e, 0x6a, 0x1b, 0x3b, 0x1e, 0x71, 0x2a, 0x51,
+	0x0e, 0x06, 0xcb, 0xa0, 0xe2, 0x7e, 0x1d, 0x4e, 0xcc, 0x5b, 0x9a, 0x59, 0xd7, 0x2a, 0x16, 0xbd,
+	0xdf, 0x70, 0xa8, 0x66, 0x28, 0x74, 0x43, 0x73, 0x0c, 0x77, 0xe8, 0x2b, 0xc4, 0x8f, 0x11, 0xe4,
+	0x7a, 0x89, 0x96, 0xce, 0xf9, 0x36, 0x4c, 0xeb, 0xe1, 0x0e, 0xd5, 0x15, 0x5b, 0x54, 0x27, 0xd8,
+	0x23, 0x7d, 0x35, 0xd3, 0xf1, 0x6a, 0x0d, 0x3d, 0x33, 0xcf, 0x4c, 0xbb, 0xf4, 0x86, 0xef, 0x86,
+	0xcd, 0x56, 0x3e, 0x2f, 0x53, 0xad, 0x87, 0x20, 0xa2, 0x4c, 0xea, 0x89, 0x56, 0x90, 0x87, 0x90,
+	0x8d, 0xec, 0x2b, 0x87, 0xf7, 0xda, 0xe1, 0x71, 0xbf, 0x37, 0x02, 0xc7, 0x13, 0xe5, 0x4a, 0xd0,
+	0xeb, 0x30, 0xd1, 0xb6, 0x35, 0xba, 0x4f, 0xa7, 0x00, 0xfc, 0x9a, 0x04, 0x7c, 0xbc, 0x1b, 0x70,
+	0x5b, 0x08, 0x51, 0xc6, 0xf5, 0xad, 0xaa, 0x7d, 0x95, 0xab, 0xcc, 0x59, 0xa5, 0x26, 0xa7, 0x46,
+	0x5c, 0xe5, 0x48, 0x9f, 0x2a, 0x93, 0x84, 0x10, 0x65, 0x3c, 0x7a, 0xdc, 0x56, 0x49, 0x96, 0xe0,
+	0x84, 0x7f, 0x6f, 0x9a, 0xd3, 0x75, 0xaf, 0xee, 0x59, 0x1a, 0x67, 0x4e, 0x57, 0x5e, 0xf5, 0x73,
+	0xa6, 0xc9, 0x6f, 0x47, 0x20, 0xd7, 0x4b, 0x9c, 0x74, 0xeb, 0xfb, 0x08, 0x8e, 0x77, 0x44, 0x5e,
+	0xad, 0x3a, 0x6c, 0x83, 0xaf, 0xa9, 0x55, 0x8b, 0x55, 0x34, 0x4b, 0xba, 0xf7, 0x95, 0x44, 0xac,
+	0x0b, 0x54, 0x17, 0x70, 0xcf, 0xfb, 0x70, 0x3f, 0xf8, 0x34, 0x7f, 0x2a, 0x56, 0xf0, 0x82, 0xfd,
+	0xf2, 0xcf, 0x19, 0xd7, 0xa8, 0x15, 0x79, 0xb3, 0x41, 0xdd, 0x90, 0xc7, 0x55, 0xa6, 0xdd, 0x58,
+	0x56, 0xdd, 0x16, 0x3a, 0x6f, 0x0b, 0x95, 0xf8, 0x3b, 0x08, 0x26, 0xbc, 0x86, 0xdf, 0xbf, 0x75,
+	0xd9, 0x12, 0xf8, 0xfd, 0x42, 0xca, 0x3a, 0xf0, 0x50, 0x88, 0x78, 0xe0, 0x68, 0x7a, 0x8d, 0x3a,
+	0xdd, 0x21, 0x49, 0x92, 0x4f, 0x14, 0x1c, 0x3c, 0x8e, 0x5b, 0x43, 0xde, 0x43, 0x90, 0xf3, 0xeb,
+	0x53, 0xcc, 0x87, 0x52, 0xa6, 0x3b, 0x68, 0x9d, 0x1d, 0xe0, 0x86, 0xf7, 0xd9, 0x08, 0xe4, 0x7b,
+	0x5a, 0x21, 0x43, 0xf9, 0x0c, 0xc1, 0xe5, 0xc4, 0x50, 0xb2, 0x86, 0x38, 0x67, 0x54, 0x35, 0xc2,
+	0x77, 0xb8, 0xca, 0x56, 0x55, 0x4b, 0x73, 0xb9, 0xca, 0x1d, 0xed, 0x11, 0x75, 0xdc, 0xff, 0x66,
+	0xa0, 0xcf, 0x6d, 0x0d, 0xf4, 0x5d, 0x69, 0x50, 0x74, 0xa7, 0xb8, 0xbb, 0xba, 0xa4, 0xb9, 0xfc,
+	0x41, 0x68, 0x0c, 0x7e, 0x02, 0x63, 0x32, 0x42, 0x5c, 0xa2, 0x1c, 0x2a, 0xf8, 0x39, 0x19, 0xfc,
+	0xc9, 0x8e, 0xe0, 0x87, 0xa2, 0x89, 0x72, 0xd8, 0x8b, 0x6f, 0x77, 0xc9, 0x77, 0x11, 0x4c, 0x45,
+	0x87, 0x52, 0x11, 0x1d, 0xfb, 0x60, 0xc1, 0xde, 0xa9, 0x3e, 0xec, 0x43, 0x04, 0xd3, 0x5b, 0x0d,
+	0x92, 0x71, 0x37, 0xe1, 0x68, 0xf7, 0x7c, 0x21, 0x2c, 0x8b, 0x5f, 0x4a, 0xe9, 0xae, 0x2e, 0xd9,
+	0xf2, 0x5d, 0x79, 0xc4, 0xec, 0x52, 0xb9, 0x73, 0x6d, 0xdc, 0x3d, 0x20, 0x5d, 0x3a, 0xe7, 0x38,
+	0x77, 0xcc, 0x8a, 0xd7, 0x31, 0x06, 0xe9, 0xab, 0xd8, 0xfd, 0x00, 0xc1, 0x6b, 0xdb, 0xca, 0x94,
+	0xee, 0xaa, 0xc1, 0xa8, 0x16, 0x7b, 0x2e, 0x3d, 0x35, 0x37, 0x98, 0xa7, 0x62, 0x1a, 0xa4, 0xd3,
+	0x3a, 0x84, 0x93, 0x2c, 0x4c, 0xfb, 0x37, 0x10, 0xc3, 0xd1, 0x36, 0xee, 0xda, 0x56, 0x33, 0xde,
+	0xa4, 0x93, 0xb7, 0x60, 0x26, 0x81, 0x26, 0xad, 0x2c, 0xc0, 0x01, 0x89, 0x30, 0xb0, 0x70, 0x4f,
+	0xbc, 0x25, 0x09, 0x29, 0x44, 0xd9, 0x1f, 0x80, 0x77, 0xc9, 0xbb, 0x08, 0x4e, 0xcd, 0x2f, 0xde,
+	0xb9, 0x23, 0xba, 0x6e, 0x63, 0xc9, 0xb4, 0x6b, 0x8b, 0x0e, 0xab, 0xcf, 0xc7, 0xb0, 0x04, 0x94,
+	0xd0, 0xb5, 0xf7, 0x60, 0x22, 0x0e, 0x54, 0xed, 0xf4, 0x73, 0x3e, 0xf6, 0xbe, 0x4c, 0xd8, 0x45,
+	0x14, 0xac, 0x6f, 0x91, 0x4c, 0x4c, 0x38, 0x9d, 0xce, 0x02, 0x09, 0xf1, 0x32, 0x8c, 0xea, 0xab,
+	0xf5, 0x7a, 0x97, 0xea, 0xd8, 0xfd, 0x2b, 0x4e, 0x25, 0x0a, 0xf8, 0x4b, 0xa9, 0xea, 0x0e, 0x9c,
+	0xf0, 0x67, 0x4f, 0x0f, 0xed, 0x0a, 0xb3, 0x0d, 0xd3, 0xae, 0x0e, 0x37, 0x40, 0x23, 0x3f, 0x45,
+	0x90, 0xeb, 0x25, 0x4f, 0x1a, 0xfb, 0x2e, 0x82, 0x6c, 0x34, 0x80, 0x52, 0x37, 0x4c, 0xbe, 0xa6,
+	0x36, 0xa8, 0x63, 0x32, 0x43, 0xb5, 0x98, 0x5e, 0x93, 0x49, 0x74, 0x3d, 0x65, 0x12, 0x85, 0xe2,
+	0xfd, 0xf0, 0xaf, 0x08, 0x29, 0x4b, 0x4c, 0xaf, 0xc9, 0x04, 0x9a, 0x8a, 0xd4, 0x74, 0x92, 0xfd,
+	0x5c, 0xba, 0x4d, 0xf9, 0x03, 0xc6, 0x35, 0x2b, 0xba, 0xe3, 0x86, 0xb9, 0xf4, 0x7d, 0x04, 0x33,
+	0x09, 0x44, 0x69, 0x3c, 0x87, 0x31, 0xee, 0x53, 0xd4, 0xee, 0x3b, 0xf5, 0x36, 0x77, 0x98, 0x2f,
+	0xca, 0x5a, 0x3f, 0x9b, 0xa2, 0xd6, 0x07, 0x85, 0xfe, 0x30, 0xef, 0xd0, 0x4e, 0x36, 0x11, 0xe4,
+	0x96, 0xbd, 0xfa, 0x32, 0x7d, 0xcc, 0xcb, 0xb6, 0xc9, 0x4d, 0xcd, 0x32, 0xbf, 0x45, 0x45, 0x67,
+	0x3a, 0x58, 0x31, 0xbd, 0x01, 0x87, 0xc3, 0x5e, 0x5c, 0x35, 0xa8, 0xcd, 0xea, 0xb2, 0x57, 0x9f,
+	0xd9, 0x6c, 0xe5, 0x8f, 0x75, 0xf6, 0xea, 0x01, 0x9d, 0x28, 0xa3, 0xb2, 0x63, 0x5f, 0xf0, 0x97,
+	0xb8, 0x02, 0x59, 0xdb, 0xab, 0xab, 0x36, 0x7d, 0xec, 0x5f, 0xea, 0x23, 0x8b, 0x44, 0x7f, 0xe2,
+	0x8a, 0xe6, 0x65, 0x4f, 0xe9, 0xf5, 0xcd, 0x56, 0xfe, 0xd5, 0x40, 0x58, 0xef, 0xbd, 0x44, 0x99,
+	0xb2, 0x93, 0x81, 0x91, 0x1f, 0x8d, 0x40, 0xbe, 0x27, 0xe8, 0xff, 0xfb, 0xc6, 0xf9, 0xdc, 0xc7,
+	0x39, 0xd8, 0x7b, 0xcf, 0x7f, 0x45, 0xe0, 0x9f, 0x21, 0x10, 0x23, 0x42, 0x17, 0x9f, 0x4f, 0x7d,
+	0x6a, 0xda, 0xc5, 0x33, 0x7b, 0xa1, 0x3f, 0xa6, 0xc0, 0xf3, 0xe4, 0xc2, 0xd3, 0x8f, 0xff, 0xfa,
+	0xc3, 0x91, 0x02, 0x3e, 0x5d, 0x4c, 0x3b, 0xed, 0xf7, 0x0d, 0xfc, 0x39, 0x82, 0x7d, 0xc1, 0x90,
+	0x10, 0xa7, 0x56, 0x1b, 0x9f, 0x51, 0x66, 0x2f, 0xf6, 0xc9, 0x25, 0xad, 0xbd, 0x28, 0xac, 0x2d,
+	0xe2, 0x33, 0x69, 0xad, 0x0d, 0x6c, 0xfc, 0x10, 0xc1, 0xa1, 0x8e, 0xc9, 0x3c, 0xbe, 0x9a, 0xf6,
+	0xd6, 0x94, 0xf0, 0x2d, 0x22, 0x7b, 0x6d, 0x30, 0x66, 0x89, 0xa1, 0x24, 0x30, 0x5c, 0xc3, 0x57,
+	0x8a, 0xfd, 0x7d, 0x5f, 0x71, 0x8b, 0xef, 0xc8, 0xea, 0xfc, 0x04, 0x7f, 0x86, 0xe0, 0x58, 0xe2,
+	0xb8, 0x00, 0xcf, 0xf7, 0x3b, 0x13, 0x48, 0x18, 0x93, 0x64, 0x17, 0x86, 0x13, 0x22, 0x81, 0xde,
+	0x16, 0x40, 0xe7, 0xf0, 0x8d, 0x94, 0x40, 0xa3, 0x27, 0x6a, 0x38, 0x22, 0x51, 0x1d, 0x81, 0xe9,
+	0x5f, 0xf1, 0x61, 0x6e, 0xe7, 0xe8, 0x0d, 0xdf, 0xea, 0xd7, 0xd4, 0xc4, 0xe1, 0x68, 0x76, 0x71,
+	0x58, 0x31, 0x12, 0x73, 0x59, 0x60, 0x9e, 0xc7, 0x73, 0x7d, 0x63, 0xb6, 0xc5, 0x5c, 0xa5, 0xdd,
+	0x90, 0xe0, 0x7f, 0x22, 0x98, 0x4c, 0x1e, 0x7b, 0xe0, 0xb4, 0xf1, 0xd9, 0x76, 0x20, 0x93, 0xbd,
+	0x35, 0xa4, 0x94, 0x01, 0xc3, 0xdc, 0x6b, 0xbe, 0x82, 0xff, 0x82, 0x60, 0x3c, 0x61, 0xde, 0x81,
+	0xe7, 0xfa, 0xb5, 0x73, 0xcb, 0x0c, 0x26, 0x5b, 0x1a, 0x46, 0x84, 0xc4, 0x39, 0x2f, 0x70, 0x5e,
+	0xc7, 0x57, 0xfb, 0xc6, 0xd9, 0x9e, 0x71, 0xe0, 0xdf, 0x23, 0xff, 0xbb, 0x54, 0xfb, 0x7b, 0x18,
+	0xbe, 0xd2, 0xe7, 0x05, 0x29, 0xf6, 0x51, 0x2e, 0x7b, 0x75, 0x20, 0x5e, 0x09, 0xe7, 0xba, 0x80,
+	0x73, 0x09, 0x5f, 0xec, 0xb3, 0x0c, 0xa9, 0x95, 0xa6, 0x6a, 0x1a, 0xf8, 0x6f, 0x08, 0x26, 0x93,
+	0x07, 0x29, 0xa9, 0xb3, 0x73, 0xdb, 0xb1, 0x4e, 0xf6, 0xd6, 0x90, 0x52, 0x24, 0xcc, 0x39, 0x01,
+	0xf3, 0x2a, 0xbe, 0xdc, 0xc7, 0xfb, 0x4d, 0xd5, 0x7c, 0x79, 0x51, 0x5e, 0xfe, 0x11, 0xc1, 0x91,
+	0xee, 0x56, 0x13, 0xbf, 0x39, 0x58, 0x77, 0x14, 0xc1, 0xbb, 0x31, 0x30, 0xbf, 0x04, 0x76, 0x53,
+	0x00, 0xbb, 0x82, 0xbf, 0x5c, 0x1c, 0xec, 0x83, 0xbb, 0x8b, 0x9f, 0x8e, 0xc0, 0xf1, 0x6d, 0xda,
+	0x43, 0x5c, 0x1e, 0xba, 0x01, 0x8c, 0xd0, 0x7e, 0x65, 0x27, 0x44, 0x49, 0xe0, 0x4b, 0x02, 0xf8,
+	0x22, 0x5e, 0x18, 0x10, 0xb8, 0x1a, 0x6f, 0x47, 0xf1, 0x27, 0x08, 0x8e, 0x6e, 0xe9, 0x39, 0x71,
+	0xda, 0xe8, 0xf4, 0xea, 0x64, 0xb3, 0x37, 0x07, 0x17, 0x30, 0xe0, 0x35, 0x61, 0x43, 0x4a, 0x52,
+	0x99, 0x6d, 0x35, 0xd5, 0xe0, 0x9a, 0xf6, 0x77, 0x04, 0x53, 0x3d, 0x66, 0x64, 0xa9, 0x5f, 0x9c,
+	0xdb, 0x4f, 0xfa, 0xb2, 0x8b, 0xc3, 0x8a, 0x19, 0x10, 0xae, 0xb8, 0x1e, 0x04, 0xe7, 0x34, 0x9c,
+	0x5a, 0xe1, 0x5f, 0x8f, 0xc0, 0xe7, 0xd2, 0xf4, 0xdb, 0x58, 0x49, 0xfb, 0x3a, 0x48, 0x3f, 0x3e,
+	0xc8, 0xde, 0xdf, 0x51, 0x99, 0xd2, 0x2b, 0xa6, 0xf0, 0x8a, 0x8e, 0xb5, 0xb4, 0xef, 0x9c, 0xd8,
+	0x7c, 0x40, 0xb5, 0x4c, 0xbb, 0xa6, 0xae, 0x3a, 0xac, 0xae, 0xc6, 0x99, 0x8a, 0xef, 0x24, 0xcd,
+	0x2f, 0x9e, 0xe0, 0x7f, 0x23, 0x98, 0x4c, 0xee, 0xf8, 0x53, 0x17, 0xf4, 0x6d, 0x07, 0x10, 0xd9,
+	0x5b, 0x43, 0x4a, 0x91, 0x2e, 0xb9, 0x27, 0x5c, 0xf2, 0x16, 0x2e, 0xa7, 0x74, 0x89, 0xe7, 0x52,
+	0x47, 0xf5, 0x42, 0x79, 0x6a, 0xd2, 0x6d, 0xda, 0xaf, 0x01, 0x5b, 0x46, 0x05, 0xa9, 0x6b, 0x40,
+	0xaf, 0x09, 0x44, 0xf6, 0xe6, 0xe0, 0x02, 0x06, 0x3c, 0x14, 0x55, 0xca, 0xd5, 0xae, 0xb1, 0x86,
+	0xb8, 0x3c, 0xf7, 0x68, 0xbf, 0x53, 0xd7, 0x80, 0xed, 0x67, 0x16, 0xd9, 0xc5, 0x61, 0xc5, 0x0c,
+	0x78, 0x79, 0xee, 0x3d, 0x8e, 0x28, 0xad, 0x3d, 0x7b, 0x9e, 0x43, 0x1f, 0x3d, 0xcf, 0xa1, 0x3f,
+	0x3f, 0xcf, 0xa1, 0xf7, 0x5f, 0xe4, 0x76, 0x7d, 0xf4, 0x22, 0xb7, 0xeb, 0x4f, 0x2f, 0x72, 0xbb,
+	0xbe, 0xb1, 0xfc, 0xb2, 0x6f, 0xd0, 0x8f, 0xce, 0x9d, 0x2d, 0x3e, 0xee, 0xd0, 0x7c, 0xa6, 0xad,
+	0x5a, 0xb7, 0x4c, 0x6a, 0xf3, 0xe0, 0xdf, 0xf9, 0x82, 0x7f, 0xf0, 0xd9, 0x27, 0xfe, 0x9c, 0xff,
+	0xcf, 0x00, 0x3f, 0xb3, 0x14, 0xa9, 0xe1, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1839,7 +2041,8 @@ type QueryClient interface {
 	// UserPositions returns all concentrated postitions of some address.
 	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
 	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
+	// existing within the given pool, optionally bounded by a lower and upper
+	// tick
 	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
 	// LiquidityNetInDirection returns liquidity net in the direction given.
 	// Uses the bound if specified, if not uses either min tick / max tick
@@ -1858,6 +2061,11 @@ type QueryClient interface {
 	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
 	// IncentiveRecords returns the incentive records for a given poolId
 	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
+	// IncentiveRecordAttributions returns how the uptime accumulator growth of
+	// a given poolId is attributed to each of its emitting incentive records.
+	IncentiveRecordAttributions(ctx context.Context, in *IncentiveRecordAttributionsRequest, opts ...grpc.CallOption) (*IncentiveRecordAttributionsResponse, error)
+	// WithdrawOnlyPools returns the ids of the pools in withdraw-only mode.
+	WithdrawOnlyPools(ctx context.Context, in *WithdrawOnlyPoolsRequest, opts ...grpc.CallOption) (*WithdrawOnlyPoolsResponse, error)
 	// TickAccumulatorTrackers returns the tick accumulator trackers.
 	// Contains spread factor and uptime accumulator trackers.
 	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
@@ -1972,6 +2180,24 @@ func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecords
 	return out, nil
 }
 
+func (c *queryClient) IncentiveRecordAttributions(ctx context.Context, in *IncentiveRecordAttributionsRequest, opts ...grpc.CallOption) (*IncentiveRecordAttributionsResponse, error) {
+	out := new(IncentiveRecordAttributionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecordAttributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawOnlyPools(ctx context.Context, in *WithdrawOnlyPoolsRequest, opts ...grpc.CallOption) (*WithdrawOnlyPoolsResponse, error) {
+	out := new(WithdrawOnlyPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/WithdrawOnlyPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
 	out := new(TickAccumulatorTrackersResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
@@ -2026,7 +2252,8 @@ type QueryServer interface {
 	// UserPositions returns all concentrated postitions of some address.
 	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
 	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
+	// existing within the given pool, optionally bounded by a lower and upper
+	// tick
 	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
 	// LiquidityNetInDirection returns liquidity net in the direction given.
 	// Uses the bound if specified, if not uses either min tick / max tick
@@ -2045,6 +2272,11 @@ type QueryServer interface {
 	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
 	// IncentiveRecords returns the incentive records for a given poolId
 	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
+	// IncentiveRecordAttributions returns how the uptime accumulator growth of
+	// a given poolId is attributed to each of its emitting incentive records.
+	IncentiveRecordAttributions(context.Context, *IncentiveRecordAttributionsRequest) (*IncentiveRecordAttributionsResponse, error)
+	// WithdrawOnlyPools returns the ids of the pools in withdraw-only mode.
+	WithdrawOnlyPools(context.Context, *WithdrawOnlyPoolsRequest) (*WithdrawOnlyPoolsResponse, error)
 	// TickAccumulatorTrackers returns the tick accumulator trackers.
 	// Contains spread factor and uptime accumulator trackers.
 	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
@@ -2095,6 +2327,12 @@ func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req
 func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
 }
+func (*UnimplementedQueryServer) IncentiveRecordAttributions(ctx context.Context, req *IncentiveRecordAttributionsRequest) (*IncentiveRecordAttributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecordAttributions not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawOnlyPools(ctx context.Context, req *WithdrawOnlyPoolsRequest) (*WithdrawOnlyPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOnlyPools not implemented")
+}
 func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
 }
@@ -2295,6 +2533,42 @@ func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IncentiveRecordAttributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncentiveRecordAttributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveRecordAttributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecordAttributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveRecordAttributions(ctx, req.(*IncentiveRecordAttributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawOnlyPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawOnlyPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawOnlyPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/WithdrawOnlyPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawOnlyPools(ctx, req.(*WithdrawOnlyPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TickAccumulatorTrackersRequest)
 	if err := dec(in); err != nil {
@@ -2429,6 +2703,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IncentiveRecords",
 			Handler:    _Query_IncentiveRecords_Handler,
 		},
+		{
+			MethodName: "IncentiveRecordAttributions",
+			Handler:    _Query_IncentiveRecordAttributions_Handler,
+		},
+		{
+			MethodName: "WithdrawOnlyPools",
+			Handler:    _Query_WithdrawOnlyPools_Handler,
+		},
 		{
 			MethodName: "TickAccumulatorTrackers",
 			Handler:    _Query_TickAccumulatorTrackers_Handler,
@@ -2979,6 +3261,16 @@ func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3425,7 +3717,7 @@ func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *IncentiveRecordAttributionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3435,25 +3727,25 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *IncentiveRecordAttributionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncentiveRecordAttributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *IncentiveRecordAttributionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3463,25 +3755,34 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *IncentiveRecordAttributionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncentiveRecordAttributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CfmmPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Attributions) > 0 {
+		for iNdEx := len(m.Attributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawOnlyPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3491,27 +3792,20 @@ func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawOnlyPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawOnlyPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawOnlyPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3521,34 +3815,38 @@ func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawOnlyPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawOnlyPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.PoolIds) > 0 {
+		dAtA10 := make([]byte, len(m.PoolIds)*10)
+		var j9 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3558,20 +3856,25 @@ func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ConcentratedPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3581,20 +3884,138 @@ func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	if m.CfmmPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3915,6 +4336,12 @@ func (m *LiquidityPerTickRangeRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
 	return n
 }
 
@@ -4100,6 +4527,58 @@ func (m *IncentiveRecordsResponse) Size() (n int) {
 	return n
 }
 
+func (m *IncentiveRecordAttributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *IncentiveRecordAttributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attributions) > 0 {
+		for _, e := range m.Attributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawOnlyPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WithdrawOnlyPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5592,6 +6071,44 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6674,6 +7191,335 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IncentiveRecordAttributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecordAttributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecordAttributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveRecordAttributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecordAttributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecordAttributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributions = append(m.Attributions, types1.IncentiveRecordAttribution{})
+			if err := m.Attributions[len(m.Attributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawOnlyPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawOnlyPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawOnlyPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawOnlyPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawOnlyPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawOnlyPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
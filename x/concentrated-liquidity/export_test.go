@@ -239,6 +239,14 @@ func (k Keeper) UpdateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 	return k.updateGivenPoolUptimeAccumulatorsToNow(ctx, pool, uptimeAccums)
 }
 
+func (k Keeper) PrepareBalancerPoolAsFullRange(ctx sdk.Context, pool types.ConcentratedPoolExtension, uptimeAccums []*accum.AccumulatorObject) (uint64, osmomath.Dec, error) {
+	return k.prepareBalancerPoolAsFullRange(ctx, pool, uptimeAccums)
+}
+
+func (k Keeper) ClaimAndResetFullRangeBalancerPool(ctx sdk.Context, pool types.ConcentratedPoolExtension, balPoolId uint64, uptimeAccums []*accum.AccumulatorObject) (sdk.Coins, error) {
+	return k.claimAndResetFullRangeBalancerPool(ctx, pool, balPoolId, uptimeAccums)
+}
+
 func (k Keeper) SetIncentiveRecord(ctx sdk.Context, incentiveRecord types.IncentiveRecord) error {
 	return k.setIncentiveRecord(ctx, incentiveRecord)
 }
@@ -101,6 +101,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set pools in withdraw-only mode
+	if err := k.SetPoolsWithdrawOnlyMode(ctx, genState.WithdrawOnlyPoolIds, true); err != nil {
+		panic(err)
+	}
+
+	// set range orders
+	for _, rangeOrder := range genState.RangeOrders {
+		k.setRangeOrder(ctx, rangeOrder)
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 }
@@ -230,12 +240,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		})
 	}
 
+	rangeOrders, err := k.GetAllRangeOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
 		Params:                k.GetParams(ctx),
 		PoolData:              poolData,
 		PositionData:          positionData,
 		NextPositionId:        k.GetNextPositionId(ctx),
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
+		WithdrawOnlyPoolIds:   k.GetWithdrawOnlyPoolIds(ctx),
+		RangeOrders:           rangeOrders,
 	}
 }
 
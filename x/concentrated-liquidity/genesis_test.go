@@ -37,6 +37,9 @@ var (
 			AuthorizedQuoteDenoms:        []string{ETH, USDC},
 			BalancerSharesRewardDiscount: types.DefaultBalancerSharesDiscount,
 			AuthorizedUptimes:            types.DefaultAuthorizedUptimes,
+			MinPositionLiquidity:         types.DefaultMinPositionLiquidity,
+			TickCrossGasCost:             types.DefaultTickCrossGasCost,
+			AccumulatorUpdateGasCost:     types.DefaultAccumulatorUpdateGasCost,
 		},
 		PoolData:              []genesis.PoolData{},
 		NextIncentiveRecordId: 2,
@@ -30,6 +30,11 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleSetPoolsWithdrawOnlyModeProposal handles a set pools withdraw-only mode proposal to the corresponding keeper method.
+func (k Keeper) HandleSetPoolsWithdrawOnlyModeProposal(ctx sdk.Context, p *types.SetPoolsWithdrawOnlyModeProposal) error {
+	return k.SetPoolsWithdrawOnlyMode(ctx, p.PoolIds, p.WithdrawOnly)
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
@@ -37,6 +42,8 @@ func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
+		case *types.SetPoolsWithdrawOnlyModeProposal:
+			return k.HandleSetPoolsWithdrawOnlyModeProposal(ctx, c)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
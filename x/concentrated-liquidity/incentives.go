@@ -14,8 +14,10 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 )
 
 // createUptimeAccumulators creates accumulator objects in store for each supported uptime for the given poolId.
@@ -174,6 +176,14 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 
 	poolId := pool.GetId()
 
+	// Set up the canonical balancer pool as a full range position for the purposes of incentives.
+	// Note that this fails quietly if no canonical balancer pool is linked to the pool and
+	// simply returns a placeholder value (0) for balancerPoolId.
+	balancerPoolId, qualifyingBalancerShares, err := k.prepareBalancerPoolAsFullRange(ctx, pool, uptimeAccums)
+	if err != nil {
+		return err
+	}
+
 	// Get relevant pool-level values
 	poolIncentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
 	if err != nil {
@@ -184,7 +194,7 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 	// uptime-related checks in forfeiting logic.
 
 	// If there is no share to be incentivized for the current uptime accumulator, we leave it unchanged
-	qualifyingLiquidity := pool.GetLiquidity()
+	qualifyingLiquidity := pool.GetLiquidity().Add(qualifyingBalancerShares)
 	if !qualifyingLiquidity.LT(osmomath.OneDec()) {
 		for uptimeIndex := range uptimeAccums {
 			// Get relevant uptime-level values
@@ -202,6 +212,17 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 		}
 	}
 
+	// Claim and clear the balancer full range shares from the uptime accumulators, so that they do not have
+	// to be updated every time the canonical balancer pool changes state.
+	// Even though this exposes CL LPs to getting immediately diluted by a large balancer position, this would
+	// require a lot of capital to be tied up in a two week bond, which is a viable tradeoff given the relative
+	// simplicity of this approach.
+	if balancerPoolId != 0 {
+		if _, err := k.claimAndResetFullRangeBalancerPool(ctx, pool, balancerPoolId, uptimeAccums); err != nil {
+			return err
+		}
+	}
+
 	// Update pool incentive records and LastLiquidityUpdate time in state to reflect emitted incentives
 	err = k.setMultipleIncentiveRecords(ctx, poolIncentiveRecords)
 	if err != nil {
@@ -217,10 +238,155 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 	return nil
 }
 
+// prepareBalancerPoolAsFullRange finds the canonical balancer pool linked to the given CL pool and, if it exists,
+// adds the number of full range shares its bonded liquidity qualifies for to the CL pool uptime accumulators.
+// This is functionally equivalent to treating the balancer pool liquidity as a single full range position on the CL pool,
+// but just for the purposes of incentives. The balancer pool liquidity is not traded against in CL pool swaps.
+// The qualifying shares are discounted by the BalancerSharesRewardDiscount parameter.
+//
+// Only the balancer liquidity bonded for the longest lockable duration qualifies, pro-rata to the bonded pool shares.
+//
+// If no canonical balancer pool exists, this function is a no-op.
+//
+// Returns the balancer pool ID if it exists (otherwise 0), and the number of full range shares it qualifies for.
+// Returns error if the canonical balancer pool liquidity cannot be retrieved or does not consist of the CL pool denoms.
+// The records added to the uptime accumulators are expected to be cleared by claimAndResetFullRangeBalancerPool.
+//
+// CONTRACT: caller is responsible for the uptimeAccums to be up-to-date.
+// CONTRACT: uptimeAccums are associated with the given pool.
+func (k Keeper) prepareBalancerPoolAsFullRange(ctx sdk.Context, pool types.ConcentratedPoolExtension, uptimeAccums []*accum.AccumulatorObject) (uint64, osmomath.Dec, error) {
+	clPoolId := pool.GetId()
+
+	// We let this check fail quietly if no canonical balancer pool ID exists.
+	canonicalBalancerPoolId, _ := k.gammKeeper.GetLinkedBalancerPoolID(ctx, clPoolId)
+	if canonicalBalancerPoolId == 0 {
+		return 0, osmomath.ZeroDec(), nil
+	}
+
+	totalBalancerPoolLiquidity, err := k.gammKeeper.GetTotalPoolLiquidity(ctx, canonicalBalancerPoolId)
+	if err != nil {
+		return 0, osmomath.ZeroDec(), err
+	}
+
+	// The balancer pool liquidity must consist of exactly the CL pool denoms.
+	denom0, denom1 := pool.GetToken0(), pool.GetToken1()
+	if len(totalBalancerPoolLiquidity) != 2 || totalBalancerPoolLiquidity.AmountOf(denom0).IsZero() || totalBalancerPoolLiquidity.AmountOf(denom1).IsZero() {
+		return 0, osmomath.ZeroDec(), types.ErrInvalidBalancerPoolLiquidityError{ClPoolId: clPoolId, BalancerPoolId: canonicalBalancerPoolId, BalancerPoolLiquidity: totalBalancerPoolLiquidity}
+	}
+
+	totalBalancerPoolShares, err := k.gammKeeper.GetTotalPoolShares(ctx, canonicalBalancerPoolId)
+	if err != nil {
+		return 0, osmomath.ZeroDec(), err
+	}
+	if totalBalancerPoolShares.IsZero() {
+		return 0, osmomath.ZeroDec(), nil
+	}
+
+	// Only the balancer pool shares bonded for the longest lockable duration qualify for incentives.
+	longestDuration, err := k.poolIncentivesKeeper.GetLongestLockableDuration(ctx)
+	if err != nil {
+		return 0, osmomath.ZeroDec(), err
+	}
+	bondedShares := k.lockupKeeper.GetLockedDenom(ctx, gammtypes.GetPoolShareDenom(canonicalBalancerPoolId), longestDuration)
+	bondedShareRatio := bondedShares.ToLegacyDec().QuoTruncate(totalBalancerPoolShares.ToLegacyDec())
+
+	// Calculate the rough amount of each balancer pool asset that is bonded.
+	balancerPoolLiquidity := sdk.NewCoins()
+	for _, liquidityToken := range totalBalancerPoolLiquidity {
+		bondedLiquidityAmount := liquidityToken.Amount.ToLegacyDec().Mul(bondedShareRatio).TruncateInt()
+		balancerPoolLiquidity = balancerPoolLiquidity.Add(sdk.NewCoin(liquidityToken.Denom, bondedLiquidityAmount))
+	}
+
+	// Calculate the liquidity the balancer amounts qualify for as a full range position in the CL pool.
+	// Since we use the CL spot price, this is safe against the prices of the two pools drifting apart:
+	// only the amounts that could be deposited at the current CL price qualify.
+	qualifyingFullRangeSharesPreDiscount := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), types.MinSqrtPriceBigDec, types.MaxSqrtPriceBigDec, balancerPoolLiquidity.AmountOf(denom0), balancerPoolLiquidity.AmountOf(denom1))
+	qualifyingFullRangeShares := osmomath.OneDec().Sub(k.GetParams(ctx).BalancerSharesRewardDiscount).Mul(qualifyingFullRangeSharesPreDiscount)
+	if !qualifyingFullRangeShares.IsPositive() {
+		return 0, osmomath.ZeroDec(), nil
+	}
+
+	// Create a temporary position record on all uptime accumulators with this amount.
+	for uptimeIndex, uptimeAccum := range uptimeAccums {
+		balancerPositionName := string(types.KeyBalancerFullRange(clPoolId, canonicalBalancerPoolId, uint64(uptimeIndex)))
+		if err := uptimeAccum.NewPosition(balancerPositionName, qualifyingFullRangeShares, nil); err != nil {
+			return 0, osmomath.ZeroDec(), err
+		}
+	}
+
+	return canonicalBalancerPoolId, qualifyingFullRangeShares, nil
+}
+
+// claimAndResetFullRangeBalancerPool claims the rewards of the full range shares of the given balancer pool and
+// deletes its records from the uptime accumulators. The claimed rewards are added to the gauge of the balancer pool
+// for the longest lockable duration. Importantly, this is a dynamic check such that if a longer lockable duration is
+// added in the future, its gauge will receive the rewards, the longest duration lock being the most "trusted" one.
+//
+// Returns the coins that were claimed and added to the gauge.
+// Returns error if the records are missing, or if claiming the rewards, deleting the records or adding to the gauge fails.
+//
+// CONTRACT: prepareBalancerPoolAsFullRange was called with the same uptime accumulators beforehand.
+// CONTRACT: uptimeAccums are associated with the given pool.
+func (k Keeper) claimAndResetFullRangeBalancerPool(ctx sdk.Context, pool types.ConcentratedPoolExtension, balPoolId uint64, uptimeAccums []*accum.AccumulatorObject) (sdk.Coins, error) {
+	clPoolId := pool.GetId()
+
+	longestLockableDuration, err := k.poolIncentivesKeeper.GetLongestLockableDuration(ctx)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	gaugeId, err := k.poolIncentivesKeeper.GetPoolGaugeId(ctx, balPoolId, longestLockableDuration)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Claim rewards on each uptime accumulator, deleting each record after claiming.
+	totalRewards := sdk.NewCoins()
+	for uptimeIndex, uptimeAccum := range uptimeAccums {
+		balancerPositionName := string(types.KeyBalancerFullRange(clPoolId, balPoolId, uint64(uptimeIndex)))
+		if !uptimeAccum.HasPosition(balancerPositionName) {
+			return sdk.Coins{}, types.BalancerRecordNotFoundError{ClPoolId: clPoolId, BalancerPoolId: balPoolId, UptimeIndex: uint64(uptimeIndex)}
+		}
+
+		// Remove all the shares of the record so that it is deleted when its rewards are claimed.
+		numShares, err := uptimeAccum.GetPositionSize(balancerPositionName)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		if err := uptimeAccum.RemoveFromPosition(balancerPositionName, numShares); err != nil {
+			return sdk.Coins{}, err
+		}
+
+		claimedRewards, _, err := uptimeAccum.ClaimRewards(balancerPositionName)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		totalRewards = totalRewards.Add(claimedRewards...)
+
+		if uptimeAccum.HasPosition(balancerPositionName) {
+			return sdk.Coins{}, types.BalancerRecordNotClearedError{ClPoolId: clPoolId, BalancerPoolId: balPoolId, UptimeIndex: uint64(uptimeIndex)}
+		}
+	}
+
+	// The rewards are sent from the pool incentives address to the gauge.
+	if !totalRewards.Empty() {
+		if err := k.incentivesKeeper.AddToGaugeRewards(ctx, pool.GetIncentivesAddress(), totalRewards, gaugeId); err != nil {
+			return sdk.Coins{}, err
+		}
+	}
+
+	return totalRewards, nil
+}
+
 // calcAccruedIncentivesForAccum calculates IncentivesPerLiquidity to be added to an accum.
 // This function is non-mutative. It operates on and returns an updated _copy_ of the passed in incentives records.
 // Returns the IncentivesPerLiquidity value and an updated list of IncentiveRecords that
 // reflect emitted incentives
+//
+// When multiple incentive records of the same denom target the same uptime, they share the same accumulator.
+// The amounts emitted by each record in the given time are summed per denom before being divided by the qualifying
+// liquidity, so the growth of the accumulator is split between the records pro-rata by their emission rates.
+// Records are processed in the order they are stored in, making the split deterministic.
 // Returns error if the qualifying liquidity/time elapsed are zero.
 func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, liquidityInAccum osmomath.Dec, timeElapsed osmomath.Dec, poolIncentiveRecords []types.IncentiveRecord) (sdk.DecCoins, []types.IncentiveRecord, error) {
 	if !liquidityInAccum.IsPositive() || !timeElapsed.IsPositive() {
@@ -229,11 +395,11 @@ func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, l
 
 	copyPoolIncentiveRecords := make([]types.IncentiveRecord, len(poolIncentiveRecords))
 	copy(copyPoolIncentiveRecords, poolIncentiveRecords)
-	incentivesToAddToCurAccum := sdk.NewDecCoins()
+	totalEmittedIncentives := sdk.NewDecCoins()
 	for incentiveIndex, incentiveRecord := range copyPoolIncentiveRecords {
 		// We consider all incentives matching the current uptime that began emitting before the current blocktime
 		incentiveRecordBody := incentiveRecord.IncentiveRecordBody
-		if !incentiveRecordBody.StartTime.UTC().Before(ctx.BlockTime().UTC()) || incentiveRecord.MinUptime != accumUptime {
+		if !isIncentiveRecordEmitting(ctx, incentiveRecord) || incentiveRecord.MinUptime != accumUptime {
 			// If the incentive does not match the current uptime or has not started emitting, we skip it
 			continue
 		}
@@ -241,37 +407,102 @@ func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, l
 		// Total amount emitted = time elapsed * emission
 		totalEmittedAmount := timeElapsed.Mul(incentiveRecordBody.EmissionRate)
 
-		// Incentives to emit per unit of qualifying liquidity = total emitted / liquidityInAccum
-		// Note that we truncate to ensure we do not overdistribute incentives
-		incentivesPerLiquidity := totalEmittedAmount.QuoTruncate(liquidityInAccum)
-		emittedIncentivesPerLiquidity := sdk.NewDecCoinFromDec(incentiveRecordBody.RemainingCoin.Denom, incentivesPerLiquidity)
-
 		// Ensure that we only emit if there are enough incentives remaining to be emitted
 		remainingRewards := poolIncentiveRecords[incentiveIndex].IncentiveRecordBody.RemainingCoin.Amount
 
 		// if total amount emitted does not exceed remaining rewards,
 		if totalEmittedAmount.LTE(remainingRewards) {
-			incentivesToAddToCurAccum = incentivesToAddToCurAccum.Add(emittedIncentivesPerLiquidity)
-
 			// Update incentive record to reflect the incentives that were emitted
-			remainingRewards = remainingRewards.Sub(totalEmittedAmount)
-
 			// Each incentive record should only be modified once
-			copyPoolIncentiveRecords[incentiveIndex].IncentiveRecordBody.RemainingCoin.Amount = remainingRewards
+			copyPoolIncentiveRecords[incentiveIndex].IncentiveRecordBody.RemainingCoin.Amount = remainingRewards.Sub(totalEmittedAmount)
 		} else {
 			// If there are not enough incentives remaining to be emitted, we emit the remaining rewards.
 			// When the returned records are set in state, all records with remaining rewards of zero will be cleared.
-			remainingIncentivesPerLiquidity := remainingRewards.QuoTruncate(liquidityInAccum)
-			emittedIncentivesPerLiquidity = sdk.NewDecCoinFromDec(incentiveRecordBody.RemainingCoin.Denom, remainingIncentivesPerLiquidity)
-			incentivesToAddToCurAccum = incentivesToAddToCurAccum.Add(emittedIncentivesPerLiquidity)
-
+			totalEmittedAmount = remainingRewards
 			copyPoolIncentiveRecords[incentiveIndex].IncentiveRecordBody.RemainingCoin.Amount = osmomath.ZeroDec()
 		}
+
+		totalEmittedIncentives = totalEmittedIncentives.Add(sdk.NewDecCoinFromDec(incentiveRecordBody.RemainingCoin.Denom, totalEmittedAmount))
 	}
 
+	// Incentives to emit per unit of qualifying liquidity = total emitted / liquidityInAccum
+	// Note that we truncate to ensure we do not overdistribute incentives
+	incentivesToAddToCurAccum := sdk.NewDecCoins(totalEmittedIncentives.QuoDecTruncate(liquidityInAccum)...)
+
 	return incentivesToAddToCurAccum, copyPoolIncentiveRecords, nil
 }
 
+// isIncentiveRecordEmitting returns true if the given incentive record has started emitting
+// before the current block time and has rewards remaining to be emitted.
+func isIncentiveRecordEmitting(ctx sdk.Context, incentiveRecord types.IncentiveRecord) bool {
+	return incentiveRecord.IncentiveRecordBody.StartTime.UTC().Before(ctx.BlockTime().UTC()) &&
+		incentiveRecord.IncentiveRecordBody.RemainingCoin.Amount.IsPositive()
+}
+
+// GetIncentiveRecordAttributions returns how the incentives emitted to the uptime accumulators of the given pool
+// are attributed to each of the pool's currently emitting incentive records.
+//
+// Records of the same denom that target the same uptime share an accumulator, and its growth is split between
+// them pro-rata by emission rate. For every emitting record, the attribution contains the record's share of the
+// combined emission rate and the amount of incentives it emits per second per unit of the pool's current liquidity.
+// The latter is zero if the pool has no active liquidity.
+// Returns error if the pool does not exist or if it fails to retrieve the pool's incentive records.
+func (k Keeper) GetIncentiveRecordAttributions(ctx sdk.Context, poolId uint64) ([]types.IncentiveRecordAttribution, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	incentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sum the emission rates of the emitting records sharing an accumulator, keyed by uptime and denom.
+	type accumulatorShareKey struct {
+		minUptime time.Duration
+		denom     string
+	}
+	combinedEmissionRates := make(map[accumulatorShareKey]osmomath.Dec)
+	emittingRecords := make([]types.IncentiveRecord, 0, len(incentiveRecords))
+	for _, incentiveRecord := range incentiveRecords {
+		if !isIncentiveRecordEmitting(ctx, incentiveRecord) {
+			continue
+		}
+		emittingRecords = append(emittingRecords, incentiveRecord)
+
+		key := accumulatorShareKey{minUptime: incentiveRecord.MinUptime, denom: incentiveRecord.IncentiveRecordBody.RemainingCoin.Denom}
+		combinedEmissionRate, ok := combinedEmissionRates[key]
+		if !ok {
+			combinedEmissionRate = osmomath.ZeroDec()
+		}
+		combinedEmissionRates[key] = combinedEmissionRate.Add(incentiveRecord.IncentiveRecordBody.EmissionRate)
+	}
+
+	liquidity := pool.GetLiquidity()
+	attributions := make([]types.IncentiveRecordAttribution, 0, len(emittingRecords))
+	for _, incentiveRecord := range emittingRecords {
+		incentiveRecordBody := incentiveRecord.IncentiveRecordBody
+		key := accumulatorShareKey{minUptime: incentiveRecord.MinUptime, denom: incentiveRecordBody.RemainingCoin.Denom}
+
+		emissionPerLiquidity := osmomath.ZeroDec()
+		if liquidity.IsPositive() {
+			emissionPerLiquidity = incentiveRecordBody.EmissionRate.QuoTruncate(liquidity)
+		}
+
+		attributions = append(attributions, types.IncentiveRecordAttribution{
+			IncentiveId:          incentiveRecord.IncentiveId,
+			MinUptime:            incentiveRecord.MinUptime,
+			Denom:                incentiveRecordBody.RemainingCoin.Denom,
+			EmissionRate:         incentiveRecordBody.EmissionRate,
+			EmissionShare:        incentiveRecordBody.EmissionRate.Quo(combinedEmissionRates[key]),
+			EmissionPerLiquidity: emissionPerLiquidity,
+		})
+	}
+
+	return attributions, nil
+}
+
 // findUptimeIndex finds the uptime index for the passed in min uptime.
 // Returns error if uptime index cannot be found.
 func findUptimeIndex(uptime time.Duration) (int, error) {
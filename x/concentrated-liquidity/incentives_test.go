@@ -10,11 +10,15 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
 	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	gammmigration "github.com/osmosis-labs/osmosis/v21/x/gamm/types/migration"
 )
 
 var (
@@ -616,6 +620,26 @@ func (s *KeeperTestSuite) TestCalcAccruedIncentivesForAccum() {
 			},
 			expectedPass: true,
 		},
+		"two incentive records with same denom, emissions are summed before being split by qualifying liquidity": {
+			poolId:              defaultPoolId,
+			accumUptime:         types.SupportedUptimes[0],
+			qualifyingLiquidity: osmomath.NewDec(3),
+			timeElapsed:         time.Second,
+
+			poolIncentiveRecords: []types.IncentiveRecord{
+				withEmissionRate(incentiveRecordOne, osmomath.NewDec(1)),
+				withEmissionRate(incentiveRecordOneWithDifferentStartTime, osmomath.NewDec(2)),
+			},
+
+			// (1 + 2) / 3 = 1. Dividing each record's emissions separately would
+			// truncate to 0.333333333333333333 + 0.666666666666666666.
+			expectedResult: sdk.NewDecCoins(sdk.NewDecCoinFromDec(incentiveRecordOne.IncentiveRecordBody.RemainingCoin.Denom, osmomath.OneDec())),
+			expectedIncentiveRecords: []types.IncentiveRecord{
+				chargeIncentiveRecord(withEmissionRate(incentiveRecordOne, osmomath.NewDec(1)), time.Second),
+				chargeIncentiveRecord(withEmissionRate(incentiveRecordOneWithDifferentStartTime, osmomath.NewDec(2)), time.Second),
+			},
+			expectedPass: true,
+		},
 		"two incentive records with different denom, different start time and same uptime": {
 			poolId:              defaultPoolId,
 			accumUptime:         types.SupportedUptimes[0],
@@ -964,6 +988,197 @@ func (s *KeeperTestSuite) TestUpdateUptimeAccumulatorsToNow() {
 	})
 }
 
+// linkBalancerPool links the given balancer pool to the given CL pool as its canonical balancer pool.
+// Records are set without validation so that invalid links can be tested.
+func (s *KeeperTestSuite) linkBalancerPool(balancerPoolId, clPoolId uint64) {
+	s.App.GAMMKeeper.SetMigrationRecords(s.Ctx, gammmigration.MigrationRecords{
+		BalancerToConcentratedPoolLinks: []gammmigration.BalancerToConcentratedPoolLink{{BalancerPoolId: balancerPoolId, ClPoolId: clPoolId}},
+	})
+}
+
+func (s *KeeperTestSuite) TestPrepareBalancerPoolAsFullRange() {
+	fooBarAssets := func(fooAmount, barAmount int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("foo", osmomath.NewInt(fooAmount))},
+			{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("bar", osmomath.NewInt(barAmount))},
+		}
+	}
+
+	tests := map[string]struct {
+		// balancerAssets are the assets of the balancer pool linked to the CL pool, no link if nil.
+		balancerAssets []balancer.PoolAsset
+		// fractionLocked is the fraction of the balancer pool shares bonded for the longest lockable duration.
+		fractionLocked osmomath.Dec
+		// noRecords is whether no balancer records are expected on the uptime accumulators.
+		noRecords     bool
+		expectedError error
+	}{
+		"no linked balancer pool": {
+			noRecords: true,
+		},
+		"linked balancer pool, fully bonded": {
+			balancerAssets: defaultBalancerAssets,
+			fractionLocked: osmomath.OneDec(),
+		},
+		"linked balancer pool, half bonded": {
+			balancerAssets: fooBarAssets(1_000_000, 1_000_000),
+			fractionLocked: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"linked balancer pool with unbalanced amounts": {
+			balancerAssets: fooBarAssets(1_000_000, 3_000_000),
+			fractionLocked: osmomath.OneDec(),
+		},
+		"linked balancer pool, not bonded": {
+			balancerAssets: defaultBalancerAssets,
+			fractionLocked: osmomath.ZeroDec(),
+			noRecords:      true,
+		},
+		"linked balancer pool with an additional denom": {
+			balancerAssets: append(fooBarAssets(100, 100), balancer.PoolAsset{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("baz", osmomath.NewInt(100))}),
+			fractionLocked: osmomath.OneDec(),
+			expectedError:  types.ErrInvalidBalancerPoolLiquidityError{},
+		},
+		"linked balancer pool with different denoms": {
+			balancerAssets: []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("foo", osmomath.NewInt(100))},
+				{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("baz", osmomath.NewInt(100))},
+			},
+			fractionLocked: osmomath.OneDec(),
+			expectedError:  types.ErrInvalidBalancerPoolLiquidityError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition("foo", "bar")
+
+			balancerPoolId := uint64(0)
+			expectedShares := osmomath.ZeroDec()
+			if tc.balancerAssets != nil {
+				balancerPoolId = s.setupBalancerPoolWithFractionLocked(tc.balancerAssets, tc.fractionLocked)
+				s.linkBalancerPool(balancerPoolId, clPool.GetId())
+
+				bondedAmount := func(denom string) osmomath.Int {
+					for _, asset := range tc.balancerAssets {
+						if asset.Token.Denom == denom {
+							return asset.Token.Amount.ToLegacyDec().Mul(tc.fractionLocked).TruncateInt()
+						}
+					}
+					return osmomath.ZeroInt()
+				}
+				fullRangeShares := math.GetLiquidityFromAmounts(clPool.GetCurrentSqrtPrice(), types.MinSqrtPriceBigDec, types.MaxSqrtPriceBigDec, bondedAmount("foo"), bondedAmount("bar"))
+				expectedShares = osmomath.OneDec().Sub(s.Clk.GetParams(s.Ctx).BalancerSharesRewardDiscount).Mul(fullRangeShares)
+			}
+
+			uptimeAccums, err := s.Clk.GetUptimeAccumulators(s.Ctx, clPool.GetId())
+			s.Require().NoError(err)
+
+			// System under test.
+			preparedBalancerPoolId, shares, err := s.Clk.PrepareBalancerPoolAsFullRange(s.Ctx, clPool, uptimeAccums)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorAs(err, &tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Each uptime accumulator has a record of the discounted full range shares of the bonded balancer liquidity.
+			for uptimeIndex, uptimeAccum := range uptimeAccums {
+				balancerPositionName := string(types.KeyBalancerFullRange(clPool.GetId(), balancerPoolId, uint64(uptimeIndex)))
+				if tc.noRecords {
+					s.Require().False(uptimeAccum.HasPosition(balancerPositionName))
+					continue
+				}
+				positionSize, err := uptimeAccum.GetPositionSize(balancerPositionName)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedShares, positionSize)
+			}
+
+			if tc.noRecords {
+				s.Require().Equal(uint64(0), preparedBalancerPoolId)
+				s.Require().Equal(osmomath.ZeroDec(), shares)
+				return
+			}
+			s.Require().Equal(balancerPoolId, preparedBalancerPoolId)
+			s.Require().Equal(expectedShares, shares)
+		})
+	}
+}
+
+// Testing strategy:
+// 1. Create a CL pool with a full range position and incentives, linked to a fully bonded balancer pool of the same size
+// 2. Let time pass and update the uptime accumulators
+// 3. Ensure the rewards of the balancer full range shares are added to the gauge of the balancer pool for the
+// longest lockable duration, and that the balancer records are cleared from the uptime accumulators
+func (s *KeeperTestSuite) TestClaimAndResetFullRangeBalancerPool() {
+	tests := map[string]struct {
+		linkBalancerPool bool
+	}{
+		"linked balancer pool": {
+			linkBalancerPool: true,
+		},
+		"no linked balancer pool": {},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+			clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition("foo", "bar")
+			balancerPoolId := s.setupBalancerPoolWithFractionLocked([]balancer.PoolAsset{
+				{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("foo", apptesting.DefaultCoinAmount)},
+				{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("bar", apptesting.DefaultCoinAmount)},
+			}, osmomath.OneDec())
+			if tc.linkBalancerPool {
+				s.linkBalancerPool(balancerPoolId, clPool.GetId())
+			}
+
+			longestLockableDuration, err := s.App.PoolIncentivesKeeper.GetLongestLockableDuration(s.Ctx)
+			s.Require().NoError(err)
+			gaugeId, err := s.App.PoolIncentivesKeeper.GetPoolGaugeId(s.Ctx, balancerPoolId, longestLockableDuration)
+			s.Require().NoError(err)
+
+			incentiveCoin := sdk.NewCoin(testDenomOne, osmomath.NewInt(1_000_000))
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+			_, err = s.Clk.CreateIncentive(s.Ctx, clPool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.NewDec(100), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+			// System under test.
+			err = s.Clk.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, clPool.GetId())
+			s.Require().NoError(err)
+
+			gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+			s.Require().NoError(err)
+			if !tc.linkBalancerPool {
+				s.Require().True(gauge.Coins.Empty())
+				return
+			}
+
+			// The balancer pool has the same liquidity as the CL pool, so its discounted shares qualify it for
+			// slightly less than half of the emitted incentives.
+			emitted := osmomath.NewDec(100 * 3600)
+			discount := s.Clk.GetParams(s.Ctx).BalancerSharesRewardDiscount
+			expectedRewards := emitted.Mul(osmomath.OneDec().Sub(discount)).Quo(osmomath.NewDec(2).Sub(discount))
+			s.Require().Equal(1, len(gauge.Coins))
+			osmoassert.Equal(s.T(), osmomath.ErrTolerance{AdditiveTolerance: osmomath.OneDec()}, expectedRewards.TruncateInt(), gauge.Coins.AmountOf(testDenomOne))
+
+			// The balancer records are cleared from the uptime accumulators.
+			uptimeAccums, err := s.Clk.GetUptimeAccumulators(s.Ctx, clPool.GetId())
+			s.Require().NoError(err)
+			for uptimeIndex, uptimeAccum := range uptimeAccums {
+				s.Require().False(uptimeAccum.HasPosition(string(types.KeyBalancerFullRange(clPool.GetId(), balancerPoolId, uint64(uptimeIndex)))))
+			}
+
+			// Claiming without records on the accumulators fails.
+			_, err = s.Clk.ClaimAndResetFullRangeBalancerPool(s.Ctx, clPool, balancerPoolId, uptimeAccums)
+			s.Require().ErrorIs(err, types.BalancerRecordNotFoundError{ClPoolId: clPool.GetId(), BalancerPoolId: balancerPoolId, UptimeIndex: 0})
+		})
+	}
+}
+
 // Note: we test that incentive records are properly deducted by emissions in `TestUpdateUptimeAccumulatorsToNow` above.
 // This test aims to cover the behavior of a series of state read/writes relating to incentive records.
 // Since these are lower level functions, we expect that validation logic for authorized uptimes is done at a higher level (see `TestCreateIncentive` tests).
@@ -3548,3 +3763,87 @@ func (s *KeeperTestSuite) TestGetIncentiveRecordSerialized() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetIncentiveRecordAttributions() {
+	// Shares the accumulator of incentiveRecordOne, emitting at three times its rate.
+	incentiveRecordOneTripleRate := incentiveRecordOne
+	incentiveRecordOneTripleRate.IncentiveId = defaultIncentiveRecordId + 5
+	incentiveRecordOneTripleRate.IncentiveRecordBody.EmissionRate = testEmissionOne.MulInt64(3)
+
+	// Has not started emitting yet, so it is not attributed anything.
+	incentiveRecordOneNotStarted := withStartTime(incentiveRecordOne, defaultStartTime.Add(time.Hour*24))
+	incentiveRecordOneNotStarted.IncentiveId = defaultIncentiveRecordId + 6
+
+	tests := map[string]struct {
+		poolId       uint64
+		withPosition bool
+
+		expectedShares map[uint64]osmomath.Dec
+		expectedErr    bool
+	}{
+		"records sharing an accumulator are split pro-rata by emission rate, no liquidity": {
+			poolId: validPoolId,
+
+			expectedShares: map[uint64]osmomath.Dec{
+				incentiveRecordOne.IncentiveId:           osmomath.MustNewDecFromStr("0.25"),
+				incentiveRecordOneTripleRate.IncentiveId: osmomath.MustNewDecFromStr("0.75"),
+				incentiveRecordTwo.IncentiveId:           osmomath.OneDec(),
+			},
+		},
+		"records sharing an accumulator are split pro-rata by emission rate, with liquidity": {
+			poolId:       validPoolId,
+			withPosition: true,
+
+			expectedShares: map[uint64]osmomath.Dec{
+				incentiveRecordOne.IncentiveId:           osmomath.MustNewDecFromStr("0.25"),
+				incentiveRecordOneTripleRate.IncentiveId: osmomath.MustNewDecFromStr("0.75"),
+				incentiveRecordTwo.IncentiveId:           osmomath.OneDec(),
+			},
+		},
+		"error: pool does not exist": {
+			poolId: invalidPoolId,
+
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(defaultStartTime.Add(time.Hour))
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			pool := s.PrepareConcentratedPool()
+			if tc.withPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			err := clKeeper.SetMultipleIncentiveRecords(s.Ctx, []types.IncentiveRecord{incentiveRecordOne, incentiveRecordOneTripleRate, incentiveRecordOneNotStarted, incentiveRecordTwo})
+			s.Require().NoError(err)
+
+			// System under test.
+			attributions, err := clKeeper.GetIncentiveRecordAttributions(s.Ctx, tc.poolId)
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			s.Require().Len(attributions, len(tc.expectedShares))
+			for _, attribution := range attributions {
+				expectedShare, ok := tc.expectedShares[attribution.IncentiveId]
+				s.Require().True(ok, "unexpected attribution for incentive record %d", attribution.IncentiveId)
+				s.Require().Equal(expectedShare, attribution.EmissionShare)
+
+				expectedEmissionPerLiquidity := osmomath.ZeroDec()
+				if tc.withPosition {
+					expectedEmissionPerLiquidity = attribution.EmissionRate.QuoTruncate(pool.GetLiquidity())
+				}
+				s.Require().Equal(expectedEmissionPerLiquidity, attribution.EmissionPerLiquidity)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 )
@@ -18,10 +19,24 @@ type ExpectedGlobalRewardValues struct {
 // assertGlobalInvariants asserts all available global invariants (i.e. invariants that should hold on all valid states).
 // Does not persist any changes to state.
 func (s *KeeperTestSuite) assertGlobalInvariants(expectedGlobalRewardValues ExpectedGlobalRewardValues) {
+	s.assertRegisteredInvariants()
 	s.assertTotalRewardsInvariant(expectedGlobalRewardValues)
 	s.assertWithdrawAllInvariant()
 }
 
+// assertRegisteredInvariants asserts that none of the invariants registered by the module is broken.
+func (s *KeeperTestSuite) assertRegisteredInvariants() {
+	for _, invariant := range []sdk.Invariant{
+		cl.IncentivesEscrowInvariant(*s.App.ConcentratedLiquidityKeeper),
+		cl.PoolBalanceInvariant(*s.App.ConcentratedLiquidityKeeper),
+		cl.TickLiquidityInvariant(*s.App.ConcentratedLiquidityKeeper),
+		cl.PoolLiquidityInvariant(*s.App.ConcentratedLiquidityKeeper),
+	} {
+		msg, broken := invariant(s.Ctx)
+		s.Require().False(broken, msg)
+	}
+}
+
 // getAllPositionsAndBalances returns all the positions in state alongside all the pool balances for all pools in state.
 //
 // Returns:
@@ -208,3 +223,69 @@ func (s *KeeperTestSuite) assertWithdrawAllInvariant() {
 	s.Require().True(errTolerance.EqualCoins(remainingTotalSpreadRewards, sdk.NewCoins()))
 	s.Require().True(errTolerance.EqualCoins(remainingTotalIncentives, sdk.NewCoins()))
 }
+
+func (s *KeeperTestSuite) TestPoolBalanceInvariant() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+
+	// Swap so that the current tick moves, which changes the amounts of the positions.
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(DefaultCoin1))
+	_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool.GetId(), DefaultCoin1, ETH, osmomath.OneInt())
+	s.Require().NoError(err)
+
+	_, broken := cl.PoolBalanceInvariant(*s.App.ConcentratedLiquidityKeeper)(s.Ctx)
+	s.Require().False(broken)
+
+	// Removing funds from the pool breaks the invariant.
+	poolBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+	err = s.App.BankKeeper.SendCoins(s.Ctx, pool.GetAddress(), s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(ETH, poolBalance.AmountOf(ETH).QuoRaw(2))))
+	s.Require().NoError(err)
+
+	_, broken = cl.PoolBalanceInvariant(*s.App.ConcentratedLiquidityKeeper)(s.Ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestTickLiquidityInvariant() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+
+	_, broken := cl.TickLiquidityInvariant(*s.App.ConcentratedLiquidityKeeper)(s.Ctx)
+	s.Require().False(broken)
+
+	// Changing the net liquidity of a tick breaks the invariant.
+	tickInfo, err := s.App.ConcentratedLiquidityKeeper.GetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick)
+	s.Require().NoError(err)
+	tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(osmomath.OneDec())
+	s.App.ConcentratedLiquidityKeeper.SetTickInfo(s.Ctx, pool.GetId(), DefaultLowerTick, &tickInfo)
+
+	_, broken = cl.TickLiquidityInvariant(*s.App.ConcentratedLiquidityKeeper)(s.Ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestPoolLiquidityInvariant() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+
+	// Swap so that the current tick moves.
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(DefaultCoin1))
+	_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool.GetId(), DefaultCoin1, ETH, osmomath.OneInt())
+	s.Require().NoError(err)
+
+	_, broken := cl.PoolLiquidityInvariant(*s.App.ConcentratedLiquidityKeeper)(s.Ctx)
+	s.Require().False(broken)
+
+	// Changing the current tick liquidity of the pool breaks the invariant.
+	pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	pool.UpdateLiquidity(osmomath.OneDec())
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPool(s.Ctx, pool))
+
+	_, broken = cl.PoolLiquidityInvariant(*s.App.ConcentratedLiquidityKeeper)(s.Ctx)
+	s.Require().True(broken)
+}
@@ -0,0 +1,227 @@
+package concentrated_liquidity
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+const (
+	incentivesEscrowInvariantName = "incentives-escrow-covers-remaining-incentives"
+	poolBalanceInvariantName      = "pool-balance-covers-positions"
+	tickLiquidityInvariantName    = "tick-liquidity-net-sums-to-zero"
+	poolLiquidityInvariantName    = "pool-liquidity-matches-in-range-positions"
+)
+
+// RegisterInvariants registers all concentrated-liquidity invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, incentivesEscrowInvariantName, IncentivesEscrowInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolBalanceInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, tickLiquidityInvariantName, TickLiquidityInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, poolLiquidityInvariantName, PoolLiquidityInvariant(keeper))
+}
+
+// IncentivesEscrowInvariant ensures that the incentives address of every pool holds at least the
+// coins that are yet to be emitted by the pool's incentive records. The balance may exceed the
+// remaining coins since emitted but unclaimed incentives are escrowed in the same address.
+func IncentivesEscrowInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, incentivesEscrowInvariantName,
+				fmt.Sprintf("\tpool retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range pools {
+			concentratedPool, ok := pool.(types.ConcentratedPoolExtension)
+			if !ok {
+				return sdk.FormatInvariant(types.ModuleName, incentivesEscrowInvariantName,
+					fmt.Sprintf("\tpool %d is not a concentrated pool\n", pool.GetId())), true
+			}
+
+			incentiveRecords, err := keeper.GetAllIncentiveRecordsForPool(ctx, pool.GetId())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, incentivesEscrowInvariantName,
+					fmt.Sprintf("\tincentive record retrieval failed for pool %d: %s\n", pool.GetId(), err)), true
+			}
+
+			remainingIncentives := sdk.NewCoins()
+			for _, incentiveRecord := range incentiveRecords {
+				remainingCoin, _ := incentiveRecord.IncentiveRecordBody.RemainingCoin.TruncateDecimal()
+				remainingIncentives = remainingIncentives.Add(remainingCoin)
+			}
+
+			escrowBalance := keeper.bankKeeper.GetAllBalances(ctx, concentratedPool.GetIncentivesAddress())
+			if !escrowBalance.IsAllGTE(remainingIncentives) {
+				return sdk.FormatInvariant(types.ModuleName, incentivesEscrowInvariantName,
+					fmt.Sprintf("\tpool %d incentives address balance is below remaining incentives: %s < %s\n",
+						pool.GetId(), escrowBalance, remainingIncentives)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, incentivesEscrowInvariantName,
+			"\tall pool incentives addresses cover remaining incentives\n"), false
+	}
+}
+
+// PoolBalanceInvariant ensures that the address of every pool holds at least the amounts that withdrawing
+// all of its positions at the current tick of the pool would return.
+func PoolBalanceInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
+				fmt.Sprintf("\tpool retrieval failed: %s\n", err)), true
+		}
+
+		concentratedPools := make(map[uint64]types.ConcentratedPoolExtension, len(pools))
+		for _, pool := range pools {
+			concentratedPool, ok := pool.(types.ConcentratedPoolExtension)
+			if !ok {
+				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
+					fmt.Sprintf("\tpool %d is not a concentrated pool\n", pool.GetId())), true
+			}
+			concentratedPools[pool.GetId()] = concentratedPool
+		}
+
+		positions, err := keeper.getAllPositions(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
+				fmt.Sprintf("\tposition retrieval failed: %s\n", err)), true
+		}
+
+		// Sum up the amounts returned by withdrawing every position, which are rounded down in favor of the pool.
+		withdrawableByPool := make(map[uint64]sdk.Coins, len(pools))
+		for _, position := range positions {
+			if position.Liquidity.IsZero() {
+				continue
+			}
+			pool, ok := concentratedPools[position.PoolId]
+			if !ok {
+				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
+					fmt.Sprintf("\tpool %d of position %d not found\n", position.PoolId, position.PositionId)), true
+			}
+
+			amount0, amount1, err := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, position.Liquidity.Neg())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
+					fmt.Sprintf("\tamounts calculation failed for position %d: %s\n", position.PositionId, err)), true
+			}
+			withdrawableByPool[position.PoolId] = withdrawableByPool[position.PoolId].Add(sdk.NewCoins(
+				sdk.NewCoin(pool.GetToken0(), amount0.Neg().TruncateInt()),
+				sdk.NewCoin(pool.GetToken1(), amount1.Neg().TruncateInt()),
+			)...)
+		}
+
+		for _, pool := range pools {
+			withdrawable := withdrawableByPool[pool.GetId()]
+			poolBalance := keeper.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+			if !poolBalance.IsAllGTE(withdrawable) {
+				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
+					fmt.Sprintf("\tpool %d balance is below the amounts of its positions: %s < %s\n",
+						pool.GetId(), poolBalance, withdrawable)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
+			"\tall pool balances cover the amounts of their positions\n"), false
+	}
+}
+
+// TickLiquidityInvariant ensures that the net liquidity of the initialized ticks of every pool sums up to zero,
+// as every position adds its liquidity at its lower tick and removes it at its upper tick.
+func TickLiquidityInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, tickLiquidityInvariantName,
+				fmt.Sprintf("\tpool retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range pools {
+			ticks, err := keeper.GetAllInitializedTicksForPool(ctx, pool.GetId())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, tickLiquidityInvariantName,
+					fmt.Sprintf("\ttick retrieval failed for pool %d: %s\n", pool.GetId(), err)), true
+			}
+
+			liquidityNet := osmomath.ZeroDec()
+			for _, tick := range ticks {
+				liquidityNet = liquidityNet.Add(tick.Info.LiquidityNet)
+			}
+			if !liquidityNet.IsZero() {
+				return sdk.FormatInvariant(types.ModuleName, tickLiquidityInvariantName,
+					fmt.Sprintf("\tnet liquidity of the ticks of pool %d sums up to %s\n", pool.GetId(), liquidityNet)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, tickLiquidityInvariantName,
+			"\tthe net liquidity of the ticks of all pools sums up to zero\n"), false
+	}
+}
+
+// PoolLiquidityInvariant ensures that the current tick liquidity of every pool is the liquidity of the positions
+// whose range includes the current tick of the pool.
+func PoolLiquidityInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolLiquidityInvariantName,
+				fmt.Sprintf("\tpool retrieval failed: %s\n", err)), true
+		}
+
+		concentratedPools := make(map[uint64]types.ConcentratedPoolExtension, len(pools))
+		for _, pool := range pools {
+			concentratedPool, ok := pool.(types.ConcentratedPoolExtension)
+			if !ok {
+				return sdk.FormatInvariant(types.ModuleName, poolLiquidityInvariantName,
+					fmt.Sprintf("\tpool %d is not a concentrated pool\n", pool.GetId())), true
+			}
+			concentratedPools[pool.GetId()] = concentratedPool
+		}
+
+		positions, err := keeper.getAllPositions(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolLiquidityInvariantName,
+				fmt.Sprintf("\tposition retrieval failed: %s\n", err)), true
+		}
+
+		inRangeLiquidityByPool := make(map[uint64]osmomath.Dec, len(pools))
+		for _, position := range positions {
+			pool, ok := concentratedPools[position.PoolId]
+			if !ok {
+				return sdk.FormatInvariant(types.ModuleName, poolLiquidityInvariantName,
+					fmt.Sprintf("\tpool %d of position %d not found\n", position.PoolId, position.PositionId)), true
+			}
+			if !pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick) {
+				continue
+			}
+			if inRangeLiquidity, ok := inRangeLiquidityByPool[position.PoolId]; ok {
+				inRangeLiquidityByPool[position.PoolId] = inRangeLiquidity.Add(position.Liquidity)
+			} else {
+				inRangeLiquidityByPool[position.PoolId] = position.Liquidity
+			}
+		}
+
+		for _, pool := range pools {
+			inRangeLiquidity, ok := inRangeLiquidityByPool[pool.GetId()]
+			if !ok {
+				inRangeLiquidity = osmomath.ZeroDec()
+			}
+			currentTickLiquidity := concentratedPools[pool.GetId()].GetLiquidity()
+			if !currentTickLiquidity.Equal(inRangeLiquidity) {
+				return sdk.FormatInvariant(types.ModuleName, poolLiquidityInvariantName,
+					fmt.Sprintf("\tpool %d current tick liquidity does not match the liquidity of its in range positions: %s != %s\n",
+						pool.GetId(), currentTickLiquidity, inRangeLiquidity)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolLiquidityInvariantName,
+			"\tall pool current tick liquidities match the liquidity of their in range positions\n"), false
+	}
+}
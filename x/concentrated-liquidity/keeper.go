@@ -16,6 +16,7 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
+	// paramSpace is the legacy x/params subspace of the concentrated-liquidity parameters, read to migrate them to the module store.
 	paramSpace paramtypes.Subspace
 	listeners  types.ConcentratedLiquidityListeners
 
@@ -29,9 +30,12 @@ type Keeper struct {
 	lockupKeeper         types.LockupKeeper
 	communityPoolKeeper  types.CommunityPoolKeeper
 	contractKeeper       types.ContractKeeper
+
+	// authority is the address allowed to update the module parameters, the governance module account.
+	authority string
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, gammKeeper types.GAMMKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper, lockupKeeper types.LockupKeeper, communityPoolKeeper types.CommunityPoolKeeper, contractKeeper types.ContractKeeper, paramSpace paramtypes.Subspace) *Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, gammKeeper types.GAMMKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper, lockupKeeper types.LockupKeeper, communityPoolKeeper types.CommunityPoolKeeper, contractKeeper types.ContractKeeper, paramSpace paramtypes.Subspace, authority string) *Keeper {
 	// ParamSubspace must be initialized within app/keepers/keepers.go
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -48,21 +52,23 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, accountKeepe
 		lockupKeeper:         lockupKeeper,
 		communityPoolKeeper:  communityPoolKeeper,
 		contractKeeper:       contractKeeper,
+		authority:            authority,
 	}
 }
 
 // GetParams returns the total set of concentrated-liquidity module's parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 	return params
 }
 
 // SetParams sets the concentrated-liquidity module's parameters with the provided parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 }
 
-// SetParam sets a specific concentrated-liquidity module's parameter with the provided parameter.
+// SetParam sets a specific concentrated-liquidity parameter in the legacy x/params subspace.
+// It is only used by the upgrade handlers that ran before the parameters were moved to the module store.
 func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 	k.paramSpace.Set(ctx, key, value)
 }
@@ -40,7 +40,9 @@ type CreatePositionData struct {
 // - the provided ticks are out of range / invalid
 // - if one of the provided min amounts are negative
 // - the pool provided does not exist
+// - the pool is in withdraw-only mode
 // - the liquidity delta is zero
+// - the liquidity delta is below the minimum position liquidity param
 // - the amount0 or amount1 returned from the position update is less than the given minimums
 // - the pool or user does not have enough tokens to satisfy the requested amount
 //
@@ -55,6 +57,9 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	if err != nil {
 		return CreatePositionData{}, err
 	}
+	if k.IsPoolWithdrawOnly(ctx, poolId) {
+		return CreatePositionData{}, types.PoolWithdrawOnlyError{PoolId: poolId}
+	}
 
 	for _, token := range tokensProvided {
 		if token.Denom != pool.GetToken0() && token.Denom != pool.GetToken1() {
@@ -137,6 +142,13 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		The given tick range becoming activated after being inactive. If the given range becomes activated, two tokens will be needed as opposed to one.`, amount0Desired)
 	}
 
+	// Reject dust positions to prevent state bloat and swap slowdowns from a large number of tiny positions.
+	// Since adding to a position re-creates it with the combined amounts, this also covers AddToPosition.
+	minPositionLiquidity := k.GetParams(ctx).MinPositionLiquidity
+	if liquidityDelta.LT(minPositionLiquidity) {
+		return CreatePositionData{}, types.PositionLiquidityBelowMinimumError{PoolId: poolId, Liquidity: liquidityDelta, MinLiquidity: minPositionLiquidity}
+	}
+
 	// Initialize / update the position in the pool based on the provided tick range and liquidity delta.
 	updateData, err := k.UpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, joinTime, positionId)
 	if err != nil {
@@ -171,6 +183,12 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}
 	event.emit(ctx)
 
+	// Positions created entirely above or below the current tick are range orders, that can be claimed once filled.
+	// The initial position of a pool sets its spot price, so it is never a range order.
+	if hasPositions {
+		k.setRangeOrderIfOutOfRange(ctx, poolId, pool.GetCurrentTick(), positionId, lowerTick, upperTick)
+	}
+
 	if !hasPositions {
 		// N.B. calling this listener propagates to x/twap for twap record creation.
 		// This is done after initial pool position only because only the first position
@@ -381,6 +399,7 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 // - Creating new position with added liquidity fails
 // - Position with `positionId` is the last position in the pool
 // - Position is superfluid staked
+// - The pool of the position is in withdraw-only mode
 func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, amount0Added, amount1Added, amount0MinGiven, amount1MinGiven osmomath.Int) (uint64, osmomath.Int, osmomath.Int, error) {
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
@@ -392,6 +411,10 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
 	}
 
+	if k.IsPoolWithdrawOnly(ctx, position.PoolId) {
+		return 0, osmomath.Int{}, osmomath.Int{}, types.PoolWithdrawOnlyError{PoolId: position.PoolId}
+	}
+
 	// if one of the liquidity is negative, or both liquidity being added is zero, error
 	if amount0Added.IsNegative() || amount1Added.IsNegative() {
 		return 0, osmomath.Int{}, osmomath.Int{}, types.NegativeAmountAddedError{PositionId: position.PositionId, Asset0Amount: amount0Added, Asset1Amount: amount1Added}
@@ -38,6 +38,7 @@ type lpTest struct {
 	tickSpacing                       uint64
 	isNotFirstPosition                bool
 	isNotFirstPositionWithSameAccount bool
+	minPositionLiquidity              osmomath.Dec
 	expectedError                     error
 
 	// spread reward related fields
@@ -229,6 +230,18 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 			tokensProvided:       sdk.Coins{},
 			expectedError:        errors.New("cannot create a position with zero amounts of both pool tokens"),
 		},
+		"error: position liquidity is below the minimum position liquidity": {
+			minPositionLiquidity: DefaultLiquidityAmt.Add(osmomath.OneDec()),
+			expectedError:        types.PositionLiquidityBelowMinimumError{PoolId: 1, Liquidity: DefaultLiquidityAmt, MinLiquidity: DefaultLiquidityAmt.Add(osmomath.OneDec())},
+		},
+		"position liquidity is equal to the minimum position liquidity": {
+			minPositionLiquidity:                   DefaultLiquidityAmt,
+			expectedSpreadRewardGrowthOutsideLower: oneEthCoins,
+
+			// Rounding up in favor of the pool.
+			amount0Expected: DefaultAmt0Expected.Add(roundingError),
+			amount1Expected: DefaultAmt1Expected,
+		},
 		// TODO: add more tests
 		// - custom hand-picked values
 		// - think of overflows
@@ -288,6 +301,12 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 				expectedNumCreatePositionEvents += 1
 			}
 
+			if !tc.minPositionLiquidity.IsNil() {
+				params := clKeeper.GetParams(s.Ctx)
+				params.MinPositionLiquidity = tc.minPositionLiquidity
+				clKeeper.SetParams(s.Ctx, params)
+			}
+
 			// Fund test account and create the desired position
 			s.FundAcc(s.TestAccs[0], DefaultCoins)
 
@@ -1360,6 +1379,9 @@ func mergeConfigs(dst *lpTest, overwrite *lpTest) {
 		if overwrite.expectedUpperTick != 0 {
 			dst.expectedUpperTick = overwrite.expectedUpperTick
 		}
+		if !overwrite.minPositionLiquidity.IsNil() {
+			dst.minPositionLiquidity = overwrite.minPositionLiquidity
+		}
 	}
 }
 
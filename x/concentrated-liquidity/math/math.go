@@ -57,34 +57,45 @@ func Liquidity1(amount osmomath.Int, sqrtPriceA, sqrtPriceB osmomath.BigDec) osm
 // sqrtPriceA is the smaller of sqrtpCur and the nextPrice
 // sqrtPriceB is the larger of sqrtpCur and the nextPrice
 // CalcAmount0Delta = (liquidity * (sqrtPriceB - sqrtPriceA)) / (sqrtPriceB * sqrtPriceA)
+// It rounds up if roundUp is true and down otherwise, callers with a fixed rounding direction should use
+// CalcAmount0DeltaRoundingUp or CalcAmount0DeltaRoundingDown directly.
 func CalcAmount0Delta(liq, sqrtPriceA, sqrtPriceB osmomath.BigDec, roundUp bool) osmomath.BigDec {
+	if roundUp {
+		return CalcAmount0DeltaRoundingUp(liq, sqrtPriceA, sqrtPriceB)
+	}
+	return CalcAmount0DeltaRoundingDown(liq, sqrtPriceA, sqrtPriceB)
+}
+
+// CalcAmount0DeltaRoundingUp calculates the amount of asset 0 between sqrtPriceA and sqrtPriceB, rounded up to an integer.
+// It favors the pool when the amount is paid to the pool, e.g.:
+// - calculating amountIn during swap
+// - adding liquidity (request user to provide more tokens in in favor of the pool)
+// Rounding up also guarantees that the swap loop, where amountSpecifiedRemaining must eventually reach zero,
+// does not leave dust that would never get counted towards the amount (numbers after the 10^6 place).
+func CalcAmount0DeltaRoundingUp(liq, sqrtPriceA, sqrtPriceB osmomath.BigDec) osmomath.BigDec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	// Note that we do MulRoundUp so that the numerator is larger as this is
+	// the case where we want to round up to favor the pool.
+	// For the same reasons, QuoRoundUp to round up at precision end after division.
+	// The denominator is truncated to get a higher final amount.
+	// Note that the order of divisions is important here. First, we divide by a larger number (sqrtPriceB) and then by a smaller number (sqrtPriceA).
+	// This leads to a smaller error amplification. This only matters in cases where at least one of the sqrt prices is below 1.
+	// TODO (perf): QuoRoundUpMut with no reallocation.
+	return liq.MulRoundUp(diff).QuoRoundUpMut(sqrtPriceB).QuoRoundUpMut(sqrtPriceA).Ceil()
+}
+
+// CalcAmount0DeltaRoundingDown calculates the amount of asset 0 between sqrtPriceA and sqrtPriceB, truncated at precision end.
+// It favors the pool when the amount is paid by the pool, e.g.:
+// - calculating amount out during swap
+// - withdrawing liquidity
+func CalcAmount0DeltaRoundingDown(liq, sqrtPriceA, sqrtPriceB osmomath.BigDec) osmomath.BigDec {
 	if sqrtPriceA.GT(sqrtPriceB) {
 		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
 	}
 	diff := sqrtPriceB.Sub(sqrtPriceA)
-	// if calculating for amountIn, we round up
-	// if calculating for amountOut, we round down at precision end
-	// this is to prevent removing more from the pool than expected due to rounding
-	// example: we calculate 1000000.9999999 uusdc (~$1) amountIn and 2000000.999999 uosmo amountOut
-	// we would want the user to put in 1000001 uusdc rather than 1000000 uusdc to ensure we are charging enough for the amount they are removing
-	// additionally, without rounding, there exists cases where the swapState.amountSpecifiedRemaining.IsPositive() for loop within
-	// the CalcOut/In functions never actually reach zero due to dust that would have never gotten counted towards the amount (numbers after the 10^6 place)
-	if roundUp {
-		// Note that we do MulRoundUp so that the numerator is larger as this is
-		// the case where we want to round up to favor the pool.
-		// For the same reasons, QuoRoundUp to round up at precision end after division.
-		// Examples include:
-		// - calculating amountIn during swap
-		// - adding liquidity (request user to provide more tokens in in favor of the pool)
-		// The denominator is truncated to get a higher final amount.
-		// Note that the order of divisions is important here. First, we divide by a larger number (sqrtPriceB) and then by a smaller number (sqrtPriceA).
-		// This leads to a smaller error amplification. This only matters in cases where at least one of the sqrt prices is below 1.
-		// TODO (perf): QuoRoundUpMut with no reallocation.
-		return liq.MulRoundUp(diff).QuoRoundUpMut(sqrtPriceB).QuoRoundUpMut(sqrtPriceA).Ceil()
-	}
-	// These are truncated at precision end to round in favor of the pool when:
-	// - calculating amount out during swap
-	// - withdrawing liquidity
 	// Each intermediary step is truncated at precision end to get a smaller final amount.
 	// Note that the order of divisions is important here. First, we divide by a larger number (sqrtPriceB) and then by a smaller number (sqrtPriceA).
 	// This leads to a smaller error amplification.
@@ -95,31 +106,40 @@ func CalcAmount0Delta(liq, sqrtPriceA, sqrtPriceB osmomath.BigDec, roundUp bool)
 // sqrtPriceA is the smaller of sqrtpCur and the nextPrice
 // sqrtPriceB is the larger of sqrtpCur and the nextPrice
 // CalcAmount1Delta = liq * (sqrtPriceB - sqrtPriceA)
+// It rounds up if roundUp is true and down otherwise, callers with a fixed rounding direction should use
+// CalcAmount1DeltaRoundingUp or CalcAmount1DeltaRoundingDown directly.
 func CalcAmount1Delta(liq, sqrtPriceA, sqrtPriceB osmomath.BigDec, roundUp bool) osmomath.BigDec {
+	if roundUp {
+		return CalcAmount1DeltaRoundingUp(liq, sqrtPriceA, sqrtPriceB)
+	}
+	return CalcAmount1DeltaRoundingDown(liq, sqrtPriceA, sqrtPriceB)
+}
+
+// CalcAmount1DeltaRoundingUp calculates the amount of asset 1 between sqrtPriceA and sqrtPriceB, rounded up to an integer.
+// It favors the pool when the amount is paid to the pool, e.g.:
+// - calculating amountIn during swap
+// - adding liquidity (request user to provide more tokens in in favor of the pool)
+func CalcAmount1DeltaRoundingUp(liq, sqrtPriceA, sqrtPriceB osmomath.BigDec) osmomath.BigDec {
+	// make sqrtPriceA the smaller value amongst sqrtPriceA and sqrtPriceB
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	// Note that we round up at the end so that the end result is larger as this is
+	// the case where we want to round up to favor the pool.
+	return liq.Mul(diff).Ceil()
+}
+
+// CalcAmount1DeltaRoundingDown calculates the amount of asset 1 between sqrtPriceA and sqrtPriceB, truncated at precision end.
+// It favors the pool when the amount is paid by the pool, e.g.:
+// - calculating amount out during swap
+// - withdrawing liquidity
+func CalcAmount1DeltaRoundingDown(liq, sqrtPriceA, sqrtPriceB osmomath.BigDec) osmomath.BigDec {
 	// make sqrtPriceA the smaller value amongst sqrtPriceA and sqrtPriceB
 	if sqrtPriceA.GT(sqrtPriceB) {
 		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
 	}
 	diff := sqrtPriceB.Sub(sqrtPriceA)
-	// if calculating for amountIn, we round up
-	// if calculating for amountOut, we don't round at all
-	// this is to prevent removing more from the pool than expected due to rounding
-	// example: we calculate 1000000.9999999 uusdc (~$1) amountIn and 2000000.999999 uosmo amountOut
-	// we would want the used to put in 1000001 uusdc rather than 1000000 uusdc to ensure we are charging enough for the amount they are removing
-	// additionally, without rounding, there exists cases where the swapState.amountSpecifiedRemaining.IsPositive() for loop within
-	// the CalcOut/In functions never actually reach zero due to dust that would have never gotten counted towards the amount (numbers after the 10^6 place)
-	if roundUp {
-		// Note that we do MulRoundUp so that the end result is larger as this is
-		// the case where we want to round up to favor the pool.
-		// Examples include:
-		// - calculating amountIn during swap
-		// - adding liquidity (request user to provide more tokens in in favor of the pool)
-		return liq.Mul(diff).Ceil()
-	}
-	// This is truncated at precision end to round in favor of the pool when:
-	// - calculating amount out during swap
-	// - withdrawing liquidity
-	// The denominator is rounded up to get a higher final amount.
 	return liq.MulTruncate(diff)
 }
 
@@ -142,6 +162,21 @@ func GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amount
 	return liquidity.MulRoundUp(sqrtPriceCurrent).QuoRoundUpMut(denominator)
 }
 
+// GetNextSqrtPriceFromAmount0InRoundingDown is GetNextSqrtPriceFromAmount0InRoundingUp rounding down at precision end.
+// It moves the sqrt price more than the exact result, against the pool when swapping in, so it must not be used by swaps.
+// sqrt_next = liq * sqrt_cur / (liq + token_in * sqrt_cur)
+func GetNextSqrtPriceFromAmount0InRoundingDown(sqrtPriceCurrent, liquidity, amountZeroRemainingIn osmomath.BigDec) (sqrtPriceNext osmomath.BigDec) {
+	if amountZeroRemainingIn.IsZero() {
+		return sqrtPriceCurrent
+	}
+
+	// Round up at precision end to make denominator larger so that the final result is smaller.
+	product := amountZeroRemainingIn.MulRoundUp(sqrtPriceCurrent)
+	denominator := product
+	denominator.AddMut(liquidity)
+	return liquidity.MulTruncate(sqrtPriceCurrent).QuoTruncateMut(denominator)
+}
+
 // GetNextSqrtPriceFromAmount0OutRoundingUp utilizes sqrtPriceCurrent, liquidity, and amount of denom0 that still needs
 // to be swapped out order to determine the sqrtPriceNext.
 // When we swap for token one in given token zero out, the price is increasing and we need to move the price up enough
@@ -160,6 +195,20 @@ func GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amoun
 	return liquidity.MulRoundUp(sqrtPriceCurrent).QuoRoundUpMut(denominator)
 }
 
+// GetNextSqrtPriceFromAmount0OutRoundingDown is GetNextSqrtPriceFromAmount0OutRoundingUp rounding down at precision end.
+// It moves the sqrt price less than the exact result, against the pool when swapping out, so it must not be used by swaps.
+// sqrt_next = liq * sqrt_cur / (liq - token_out * sqrt_cur)
+func GetNextSqrtPriceFromAmount0OutRoundingDown(sqrtPriceCurrent, liquidity, amountZeroRemainingOut osmomath.BigDec) (sqrtPriceNext osmomath.BigDec) {
+	if amountZeroRemainingOut.IsZero() {
+		return sqrtPriceCurrent
+	}
+
+	// truncate to make the final denominator larger and final result smaller
+	product := amountZeroRemainingOut.MulTruncate(sqrtPriceCurrent)
+	denominator := liquidity.Sub(product)
+	return liquidity.MulTruncate(sqrtPriceCurrent).QuoTruncateMut(denominator)
+}
+
 // GetNextSqrtPriceFromAmount1InRoundingDown utilizes the current sqrtPriceCurrent, liquidity, and amount of denom1 that still needs
 // to be swapped in order to determine the sqrtPriceNext.
 // When we swap for token zero out given token one in, the price is increasing and we need to move the sqrt price (increase it) less to
@@ -169,6 +218,13 @@ func GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceCurrent, liquidity, amou
 	return sqrtPriceCurrent.Add(amountOneRemainingIn.QuoTruncate(liquidity))
 }
 
+// GetNextSqrtPriceFromAmount1InRoundingUp is GetNextSqrtPriceFromAmount1InRoundingDown rounding up at precision end.
+// It moves the sqrt price more than the exact result, against the pool when swapping in, so it must not be used by swaps.
+// sqrt_next = sqrt_cur + token_in / liq
+func GetNextSqrtPriceFromAmount1InRoundingUp(sqrtPriceCurrent, liquidity, amountOneRemainingIn osmomath.BigDec) (sqrtPriceNext osmomath.BigDec) {
+	return sqrtPriceCurrent.Add(amountOneRemainingIn.QuoRoundUp(liquidity))
+}
+
 // GetNextSqrtPriceFromAmount1OutRoundingDown utilizes the current sqrtPriceCurrent, liquidity, and amount of denom1 that still needs
 // to be swapped out order to determine the sqrtPriceNext.
 // When we swap for token zero in given token one out, the price is decrearing and we need to move the price down enough
@@ -178,6 +234,13 @@ func GetNextSqrtPriceFromAmount1OutRoundingDown(sqrtPriceCurrent, liquidity, amo
 	return sqrtPriceCurrent.Sub(amountOneRemainingOut.QuoRoundUp(liquidity))
 }
 
+// GetNextSqrtPriceFromAmount1OutRoundingUp is GetNextSqrtPriceFromAmount1OutRoundingDown rounding up at precision end.
+// It moves the sqrt price less than the exact result, against the pool when swapping out, so it must not be used by swaps.
+// sqrt_next = sqrt_cur - token_out / liq
+func GetNextSqrtPriceFromAmount1OutRoundingUp(sqrtPriceCurrent, liquidity, amountOneRemainingOut osmomath.BigDec) (sqrtPriceNext osmomath.BigDec) {
+	return sqrtPriceCurrent.Sub(amountOneRemainingOut.QuoTruncate(liquidity))
+}
+
 // GetLiquidityFromAmounts takes the current sqrtPrice and the sqrtPrice for the upper and lower ticks as well as the amounts of asset0 and asset1
 // and returns the resulting liquidity from these inputs.
 func GetLiquidityFromAmounts(sqrtPrice osmomath.BigDec, sqrtPriceA, sqrtPriceB osmomath.BigDec, amount0, amount1 osmomath.Int) (liquidity osmomath.Dec) {
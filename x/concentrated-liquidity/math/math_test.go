@@ -1,6 +1,7 @@
 package math_test
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -619,3 +620,144 @@ func TestGetNextSqrtPriceFromAmount1OutRoundingDown(t *testing.T) {
 	}
 	runSqrtRoundingTestCase(t, "TestGetNextSqrtPriceFromAmount1OutRoundingDown", math.GetNextSqrtPriceFromAmount1OutRoundingDown, tests)
 }
+
+func TestGetNextSqrtPriceFromAmount0InRoundingDown(t *testing.T) {
+	tests := map[string]sqrtRoundingTestCase{
+		"no round down due zeroes at precision end": {
+			sqrtPriceCurrent: osmomath.MustNewBigDecFromStr("2"),
+			liquidity:        osmomath.MustNewBigDecFromStr("10"),
+			amountRemaining:  osmomath.MustNewBigDecFromStr("15"),
+			// liq * sqrt_cur / (liq + token_in * sqrt_cur) = 0.5
+			expected: osmomath.MustNewBigDecFromStr("0.5"),
+		},
+	}
+	runSqrtRoundingTestCase(t, "TestGetNextSqrtPriceFromAmount0InRoundingDown", math.GetNextSqrtPriceFromAmount0InRoundingDown, tests)
+}
+
+func TestGetNextSqrtPriceFromAmount0OutRoundingDown(t *testing.T) {
+	tests := map[string]sqrtRoundingTestCase{
+		"no round down due zeroes at precision end": {
+			sqrtPriceCurrent: osmomath.MustNewBigDecFromStr("2"),
+			liquidity:        osmomath.MustNewBigDecFromStr("10"),
+			amountRemaining:  osmomath.MustNewBigDecFromStr("1"),
+			// liq * sqrt_cur / (liq - token_out * sqrt_cur) = 2.5
+			expected: osmomath.MustNewBigDecFromStr("2.5"),
+		},
+	}
+	runSqrtRoundingTestCase(t, "TestGetNextSqrtPriceFromAmount0OutRoundingDown", math.GetNextSqrtPriceFromAmount0OutRoundingDown, tests)
+}
+
+func TestGetNextSqrtPriceFromAmount1InRoundingUp(t *testing.T) {
+	tests := map[string]sqrtRoundingTestCase{
+		"no round up due zeroes at precision end": {
+			sqrtPriceCurrent: osmomath.MustNewBigDecFromStr("2.5"),
+			liquidity:        osmomath.MustNewBigDecFromStr("1"),
+			amountRemaining:  osmomath.MustNewBigDecFromStr("10"),
+			// sqrt_next = sqrt_cur + token_in / liq
+			expected: osmomath.MustNewBigDecFromStr("12.5"),
+		},
+	}
+	runSqrtRoundingTestCase(t, "TestGetNextSqrtPriceFromAmount1InRoundingUp", math.GetNextSqrtPriceFromAmount1InRoundingUp, tests)
+}
+
+func TestGetNextSqrtPriceFromAmount1OutRoundingUp(t *testing.T) {
+	tests := map[string]sqrtRoundingTestCase{
+		"no round up due zeroes at precision end": {
+			sqrtPriceCurrent: osmomath.MustNewBigDecFromStr("12.5"),
+			liquidity:        osmomath.MustNewBigDecFromStr("1"),
+			amountRemaining:  osmomath.MustNewBigDecFromStr("10"),
+			// sqrt_next = sqrt_cur - token_out / liq
+			expected: osmomath.MustNewBigDecFromStr("2.5"),
+		},
+	}
+	runSqrtRoundingTestCase(t, "TestGetNextSqrtPriceFromAmount1OutRoundingUp", math.GetNextSqrtPriceFromAmount1OutRoundingUp, tests)
+}
+
+// TestRoundingDirection validates that every variant used by swaps and LP operations rounds
+// in favor of the pool, compared to its counterpart rounding in the opposite direction.
+func TestRoundingDirection(t *testing.T) {
+	tests := map[string]sqrtRoundingTestCase{
+		"rounded at precision end": {
+			sqrtPriceCurrent: sqrt5000BigDec,
+			liquidity:        osmomath.MustNewBigDecFromStr("3035764687.503020836176699298"),
+			amountRemaining:  osmomath.MustNewBigDecFromStr("8398"),
+		},
+		"happy path": {
+			sqrtPriceCurrent: sqrt5000BigDec,
+			liquidity:        osmomath.MustNewBigDecFromStr("1519437308.014768571721000000"),
+			amountRemaining:  osmomath.NewBigDec(42000000),
+		},
+		"low price range": {
+			sqrtPriceCurrent: sqrtANearMin,
+			liquidity:        smallLiquidity,
+			amountRemaining:  osmomath.MustNewBigDecFromStr("0.000000000000000001"),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// Swapping token0 in moves the price down, the pool favoring variant moves it less.
+			sqrtPriceUp := math.GetNextSqrtPriceFromAmount0InRoundingUp(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			sqrtPriceDown := math.GetNextSqrtPriceFromAmount0InRoundingDown(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			require.True(t, sqrtPriceUp.GTE(sqrtPriceDown), "amount0 in: up %s, down %s", sqrtPriceUp, sqrtPriceDown)
+
+			// Swapping token0 out moves the price up, the pool favoring variant moves it more.
+			sqrtPriceUp = math.GetNextSqrtPriceFromAmount0OutRoundingUp(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			sqrtPriceDown = math.GetNextSqrtPriceFromAmount0OutRoundingDown(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			require.True(t, sqrtPriceUp.GTE(sqrtPriceDown), "amount0 out: up %s, down %s", sqrtPriceUp, sqrtPriceDown)
+
+			// Swapping token1 in moves the price up, the pool favoring variant moves it less.
+			sqrtPriceUp = math.GetNextSqrtPriceFromAmount1InRoundingUp(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			sqrtPriceDown = math.GetNextSqrtPriceFromAmount1InRoundingDown(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			require.True(t, sqrtPriceDown.LTE(sqrtPriceUp), "amount1 in: up %s, down %s", sqrtPriceUp, sqrtPriceDown)
+
+			// Swapping token1 out moves the price down, the pool favoring variant moves it more.
+			sqrtPriceUp = math.GetNextSqrtPriceFromAmount1OutRoundingUp(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			sqrtPriceDown = math.GetNextSqrtPriceFromAmount1OutRoundingDown(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			require.True(t, sqrtPriceDown.LTE(sqrtPriceUp), "amount1 out: up %s, down %s", sqrtPriceUp, sqrtPriceDown)
+
+			sqrtPriceNext := math.GetNextSqrtPriceFromAmount1InRoundingDown(tc.sqrtPriceCurrent, tc.liquidity, tc.amountRemaining)
+			amount0Up := math.CalcAmount0DeltaRoundingUp(tc.liquidity, tc.sqrtPriceCurrent, sqrtPriceNext)
+			amount0Down := math.CalcAmount0DeltaRoundingDown(tc.liquidity, tc.sqrtPriceCurrent, sqrtPriceNext)
+			require.True(t, amount0Up.GTE(amount0Down), "amount0: up %s, down %s", amount0Up, amount0Down)
+
+			amount1Up := math.CalcAmount1DeltaRoundingUp(tc.liquidity, tc.sqrtPriceCurrent, sqrtPriceNext)
+			amount1Down := math.CalcAmount1DeltaRoundingDown(tc.liquidity, tc.sqrtPriceCurrent, sqrtPriceNext)
+			require.True(t, amount1Up.GTE(amount1Down), "amount1: up %s, down %s", amount1Up, amount1Down)
+		})
+	}
+}
+
+// TestSwapRoundTripFavorsPool fuzzes swapping an amount of one token in and swapping the output
+// back in, using the rounding direction of swaps. The pool must never pay out more than it receives,
+// so the round trip may never return more than the original amount in.
+func TestSwapRoundTripFavorsPool(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	randBigDec := func(maxExponent int) osmomath.BigDec {
+		// A random number of up to 18 significant digits, scaled by a random power of ten.
+		value := osmomath.NewBigDec(r.Int63n(1_000_000_000_000_000_000) + 1)
+		return value.Mul(osmomath.NewBigDec(10).PowerInteger(uint64(r.Intn(maxExponent))))
+	}
+
+	for i := 0; i < 1000; i++ {
+		sqrtPriceCurrent := randBigDec(4).Quo(osmomath.NewBigDec(1_000_000_000_000_000_000))
+		liquidity := randBigDec(12)
+		amountIn := osmomath.NewBigDecFromBigInt(randBigDec(4).Dec().TruncateInt().BigInt())
+
+		// Zero for one: token0 in, token1 out, then token1 back in and token0 out.
+		sqrtPriceNext := math.GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amountIn)
+		amount1Out := math.CalcAmount1DeltaRoundingDown(liquidity, sqrtPriceNext, sqrtPriceCurrent).TruncateDec()
+		sqrtPriceBack := math.GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceNext, liquidity, amount1Out)
+		amount0Out := math.CalcAmount0DeltaRoundingDown(liquidity, sqrtPriceNext, sqrtPriceBack).TruncateDec()
+		require.True(t, amount0Out.LTE(amountIn), "zero for one, sqrt price %s, liquidity %s: paid in %s, got back %s", sqrtPriceCurrent, liquidity, amountIn, amount0Out)
+
+		// One for zero: token1 in, token0 out, then token0 back in and token1 out.
+		sqrtPriceNext = math.GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceCurrent, liquidity, amountIn)
+		amount0Out = math.CalcAmount0DeltaRoundingDown(liquidity, sqrtPriceCurrent, sqrtPriceNext).TruncateDec()
+		sqrtPriceBack = math.GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceNext, liquidity, amount0Out)
+		amount1Out = math.CalcAmount1DeltaRoundingDown(liquidity, sqrtPriceBack, sqrtPriceNext).TruncateDec()
+		require.True(t, amount1Out.LTE(amountIn), "one for zero, sqrt price %s, liquidity %s: paid in %s, got back %s", sqrtPriceCurrent, liquidity, amountIn, amount1Out)
+	}
+}
@@ -243,6 +243,10 @@ func (p Pool) CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, liq
 	// Therefore, we should round down to require user provide a lower amount
 	// in favor of the pool.
 	roundUp := liquidityDelta.IsPositive()
+	calcAmount0Delta, calcAmount1Delta := math.CalcAmount0DeltaRoundingDown, math.CalcAmount1DeltaRoundingDown
+	if roundUp {
+		calcAmount0Delta, calcAmount1Delta = math.CalcAmount0DeltaRoundingUp, math.CalcAmount1DeltaRoundingUp
+	}
 
 	var (
 		liquidityDeltaBigDec = osmomath.BigDecFromDec(liquidityDelta)
@@ -255,18 +259,18 @@ func (p Pool) CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, liq
 		// if this is the case, we attempt to provide liquidity evenly between asset0 and asset1
 		// we also update the pool liquidity since the virtual liquidity is modified by this position's creation
 		currentSqrtPrice := p.CurrentSqrtPrice
-		actualAmountDenom0 = math.CalcAmount0Delta(liquidityDeltaBigDec, currentSqrtPrice, sqrtPriceUpperTick, roundUp)
-		actualAmountDenom1 = math.CalcAmount1Delta(liquidityDeltaBigDec, currentSqrtPrice, sqrtPriceLowerTick, roundUp)
+		actualAmountDenom0 = calcAmount0Delta(liquidityDeltaBigDec, currentSqrtPrice, sqrtPriceUpperTick)
+		actualAmountDenom1 = calcAmount1Delta(liquidityDeltaBigDec, currentSqrtPrice, sqrtPriceLowerTick)
 	} else if p.CurrentTick < lowerTick {
 		// outcome two: position is below current price
 		// this means position is solely made up of asset0
 		actualAmountDenom1 = osmomath.ZeroBigDec()
-		actualAmountDenom0 = math.CalcAmount0Delta(liquidityDeltaBigDec, sqrtPriceLowerTick, sqrtPriceUpperTick, roundUp)
+		actualAmountDenom0 = calcAmount0Delta(liquidityDeltaBigDec, sqrtPriceLowerTick, sqrtPriceUpperTick)
 	} else {
 		// outcome three: position is above current price
 		// this means position is solely made up of asset1
 		actualAmountDenom0 = osmomath.ZeroBigDec()
-		actualAmountDenom1 = math.CalcAmount1Delta(liquidityDeltaBigDec, sqrtPriceLowerTick, sqrtPriceUpperTick, roundUp)
+		actualAmountDenom1 = calcAmount1Delta(liquidityDeltaBigDec, sqrtPriceLowerTick, sqrtPriceUpperTick)
 	}
 
 	if roundUp {
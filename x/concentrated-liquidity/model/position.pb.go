@@ -124,6 +124,66 @@ func (m *Position) GetJoinTime() time.Time {
 	return time.Time{}
 }
 
+// RangeOrder marks a position created entirely above or below the current tick
+// of its pool with a single asset, which is fully converted to the other asset
+// once the price moves through its range, as a limit order.
+type RangeOrder struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	// zero_for_one is true if the range order converts token0 to token1, i.e. it
+	// was created above the current tick and is filled once the current tick is
+	// at or above its upper tick. It is false if the range order converts token1
+	// to token0, i.e. it was created below the current tick and is filled once
+	// the current tick is below its lower tick.
+	ZeroForOne bool `protobuf:"varint,2,opt,name=zero_for_one,json=zeroForOne,proto3" json:"zero_for_one,omitempty" yaml:"zero_for_one"`
+}
+
+func (m *RangeOrder) Reset()         { *m = RangeOrder{} }
+func (m *RangeOrder) String() string { return proto.CompactTextString(m) }
+func (*RangeOrder) ProtoMessage()    {}
+func (*RangeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1363e25aa5179fb1, []int{1}
+}
+func (m *RangeOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeOrder.Merge(m, src)
+}
+func (m *RangeOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeOrder proto.InternalMessageInfo
+
+func (m *RangeOrder) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetZeroForOne() bool {
+	if m != nil {
+		return m.ZeroForOne
+	}
+	return false
+}
+
 // FullPositionBreakdown returns:
 // - the position itself
 // - the amount the position translates in terms of asset0 and asset1
@@ -144,7 +204,7 @@ func (m *FullPositionBreakdown) Reset()         { *m = FullPositionBreakdown{} }
 func (m *FullPositionBreakdown) String() string { return proto.CompactTextString(m) }
 func (*FullPositionBreakdown) ProtoMessage()    {}
 func (*FullPositionBreakdown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1363e25aa5179fb1, []int{1}
+	return fileDescriptor_1363e25aa5179fb1, []int{2}
 }
 func (m *FullPositionBreakdown) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +284,7 @@ func (m *PositionWithPeriodLock) Reset()         { *m = PositionWithPeriodLock{}
 func (m *PositionWithPeriodLock) String() string { return proto.CompactTextString(m) }
 func (*PositionWithPeriodLock) ProtoMessage()    {}
 func (*PositionWithPeriodLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1363e25aa5179fb1, []int{2}
+	return fileDescriptor_1363e25aa5179fb1, []int{3}
 }
 func (m *PositionWithPeriodLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,6 +329,7 @@ func (m *PositionWithPeriodLock) GetLocks() types1.PeriodLock {
 
 func init() {
 	proto.RegisterType((*Position)(nil), "osmosis.concentratedliquidity.v1beta1.Position")
+	proto.RegisterType((*RangeOrder)(nil), "osmosis.concentratedliquidity.v1beta1.RangeOrder")
 	proto.RegisterType((*FullPositionBreakdown)(nil), "osmosis.concentratedliquidity.v1beta1.FullPositionBreakdown")
 	proto.RegisterType((*PositionWithPeriodLock)(nil), "osmosis.concentratedliquidity.v1beta1.PositionWithPeriodLock")
 }
@@ -278,52 +339,55 @@ func init() {
 }
 
 var fileDescriptor_1363e25aa5179fb1 = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x18, 0x8d, 0xff, 0x5c, 0xda, 0x4e, 0xa4, 0x5f, 0xc8, 0x2d, 0x95, 0x9b, 0x42, 0x1c, 0x19, 0xa1,
-	0x46, 0x82, 0xda, 0x24, 0x20, 0x2a, 0xb1, 0x0c, 0x08, 0xa9, 0x52, 0x17, 0xc5, 0x14, 0x21, 0x21,
-	0xa4, 0x68, 0xec, 0x99, 0xa6, 0x43, 0xec, 0x8c, 0xeb, 0x99, 0xb4, 0x44, 0xe2, 0x09, 0x58, 0x75,
-	0xc5, 0x0b, 0xb0, 0xe3, 0x49, 0xba, 0xec, 0x12, 0xb1, 0x48, 0x51, 0xfb, 0x06, 0x7d, 0x02, 0x34,
-	0x17, 0xdb, 0xa1, 0x2a, 0xb7, 0x45, 0x57, 0xf6, 0x7c, 0xe7, 0x3b, 0xe7, 0xcc, 0xcc, 0xf9, 0x64,
-	0x83, 0x47, 0x94, 0xc5, 0x94, 0x11, 0xe6, 0x85, 0x74, 0x14, 0xe2, 0x11, 0x4f, 0x21, 0xc7, 0x28,
-	0x22, 0xfb, 0x63, 0x82, 0x08, 0x9f, 0x78, 0x07, 0x9d, 0x00, 0x73, 0xd8, 0xf1, 0x12, 0xca, 0x08,
-	0x27, 0x74, 0xe4, 0x26, 0x29, 0xe5, 0xd4, 0xbc, 0xab, 0x59, 0xee, 0x95, 0x2c, 0x57, 0xb3, 0x1a,
-	0x2b, 0xa1, 0xec, 0xeb, 0x4b, 0x92, 0xa7, 0x16, 0x4a, 0xa1, 0x61, 0x0f, 0x28, 0x1d, 0x44, 0xd8,
-	0x93, 0xab, 0x60, 0xbc, 0xeb, 0x71, 0x12, 0x63, 0xc6, 0x61, 0x9c, 0xe8, 0x86, 0xe6, 0xe5, 0x06,
-	0x34, 0x4e, 0x61, 0xb1, 0x85, 0xc6, 0xd2, 0x80, 0x0e, 0xa8, 0x12, 0x16, 0x6f, 0x19, 0x4b, 0x99,
-	0x78, 0x01, 0x64, 0x38, 0xdf, 0x7c, 0x48, 0x49, 0xc6, 0x5a, 0xc9, 0x8e, 0x1b, 0xd1, 0x70, 0x38,
-	0x4e, 0xe4, 0x43, 0x41, 0xce, 0xc7, 0x32, 0x98, 0xdf, 0xd6, 0xc7, 0x34, 0x37, 0x40, 0x3d, 0x3b,
-	0x72, 0x9f, 0x20, 0xcb, 0x68, 0x19, 0xed, 0x4a, 0x6f, 0xf9, 0x62, 0x6a, 0x9b, 0x13, 0x18, 0x47,
-	0x4f, 0x9c, 0x19, 0xd0, 0xf1, 0x41, 0xb6, 0xda, 0x44, 0xe6, 0x7d, 0x30, 0x07, 0x11, 0x4a, 0x31,
-	0x63, 0xd6, 0x7f, 0x2d, 0xa3, 0xbd, 0xd0, 0x33, 0x2f, 0xa6, 0xf6, 0xff, 0x8a, 0xa4, 0x01, 0xc7,
-	0xcf, 0x5a, 0xcc, 0x7b, 0x60, 0x2e, 0xa1, 0x34, 0x12, 0x16, 0x65, 0x69, 0x31, 0xd3, 0xad, 0x01,
-	0xc7, 0xaf, 0x89, 0xb7, 0x4d, 0x64, 0xde, 0x06, 0x20, 0xa2, 0x87, 0x38, 0xed, 0x73, 0x12, 0x0e,
-	0xad, 0x4a, 0xcb, 0x68, 0x97, 0xfd, 0x05, 0x59, 0xd9, 0x21, 0xe1, 0x50, 0xc0, 0xe3, 0x24, 0xc9,
-	0xe0, 0xaa, 0x82, 0x65, 0x45, 0xc2, 0xaf, 0xc0, 0xc2, 0x3b, 0x4a, 0x46, 0x7d, 0x71, 0xcf, 0x56,
-	0xad, 0x65, 0xb4, 0xeb, 0xdd, 0x86, 0xab, 0xee, 0xd8, 0xcd, 0xee, 0xd8, 0xdd, 0xc9, 0x42, 0xe8,
-	0xdd, 0x3a, 0x9e, 0xda, 0xa5, 0x8b, 0xa9, 0x7d, 0x43, 0x6d, 0x26, 0xa7, 0x3a, 0x47, 0xa7, 0xb6,
-	0xe1, 0xcf, 0x8b, 0xb5, 0x68, 0x16, 0xb2, 0x79, 0xee, 0xd6, 0x9c, 0x3c, 0xf1, 0x86, 0xa0, 0x7e,
-	0x9b, 0xda, 0xab, 0x2a, 0x0b, 0x86, 0x86, 0x2e, 0xa1, 0x5e, 0x0c, 0xf9, 0x9e, 0xbb, 0x85, 0x07,
-	0x30, 0x9c, 0x3c, 0xc3, 0x61, 0xa1, 0x9c, 0xb3, 0x1d, 0xbf, 0x50, 0x72, 0x3e, 0x55, 0xc1, 0xcd,
-	0xe7, 0xe3, 0x28, 0xca, 0x02, 0xe9, 0xa5, 0x18, 0x0e, 0x11, 0x3d, 0x1c, 0x99, 0x2f, 0xc0, 0x7c,
-	0x76, 0xdd, 0x32, 0x96, 0x7a, 0xd7, 0x73, 0xff, 0x6a, 0x1a, 0xdd, 0x5c, 0xab, 0x22, 0x36, 0xe8,
-	0xe7, 0x32, 0x66, 0x00, 0x6a, 0x90, 0x31, 0xcc, 0x1f, 0xc8, 0xc8, 0xea, 0xdd, 0x15, 0x57, 0x8f,
-	0xaa, 0x98, 0xa2, 0x9c, 0xfe, 0x94, 0x92, 0x51, 0xcf, 0x13, 0xd4, 0x2f, 0xa7, 0xf6, 0xda, 0x80,
-	0xf0, 0xbd, 0x71, 0xe0, 0x86, 0x34, 0xd6, 0x73, 0xad, 0x1f, 0xeb, 0x0c, 0x0d, 0x3d, 0x3e, 0x49,
-	0x30, 0x93, 0x04, 0x5f, 0x2b, 0xe7, 0x1e, 0x1d, 0x19, 0xf4, 0x75, 0x78, 0x74, 0xcc, 0x0f, 0xc0,
-	0x0a, 0x23, 0x48, 0x62, 0x18, 0x44, 0xb8, 0xcf, 0x92, 0x14, 0x43, 0xd4, 0x4f, 0xf1, 0x21, 0x4c,
-	0x11, 0xb3, 0x2a, 0xad, 0xf2, 0xef, 0x5d, 0xd7, 0x74, 0xe0, 0xb6, 0x8a, 0xe5, 0x57, 0x42, 0x8e,
-	0xbf, 0x9c, 0x43, 0x2f, 0x25, 0xe2, 0x2b, 0xc0, 0xdc, 0x07, 0x4b, 0x05, 0x89, 0xc8, 0x20, 0xc8,
-	0x01, 0x66, 0x56, 0xf5, 0x4f, 0xce, 0x77, 0xb4, 0xf3, 0xea, 0x65, 0xe7, 0x42, 0xc4, 0xf1, 0x17,
-	0xf3, 0xf2, 0x66, 0x5e, 0x15, 0x96, 0xbb, 0x34, 0xdd, 0xc5, 0x84, 0x63, 0x34, 0x6b, 0x59, 0xfb,
-	0x47, 0xcb, 0xab, 0x44, 0x1c, 0x7f, 0x31, 0x2f, 0x17, 0x96, 0xce, 0x67, 0x03, 0x2c, 0x67, 0x83,
-	0xf4, 0x9a, 0xf0, 0xbd, 0x6d, 0x9c, 0x12, 0x8a, 0xb6, 0x68, 0x38, 0xbc, 0x8e, 0xc9, 0x7c, 0x0c,
-	0xaa, 0xe2, 0x0b, 0xc5, 0xf4, 0x60, 0x36, 0x72, 0x3d, 0xf5, 0xf9, 0x72, 0x0b, 0x77, 0x4d, 0x55,
-	0xed, 0xbd, 0xb7, 0xc7, 0x67, 0x4d, 0xe3, 0xe4, 0xac, 0x69, 0x7c, 0x3f, 0x6b, 0x1a, 0x47, 0xe7,
-	0xcd, 0xd2, 0xc9, 0x79, 0xb3, 0xf4, 0xf5, 0xbc, 0x59, 0x7a, 0xd3, 0x9b, 0x19, 0x2a, 0x2d, 0xb6,
-	0x1e, 0xc1, 0x80, 0x65, 0x0b, 0xef, 0xa0, 0xdb, 0xf1, 0xde, 0xff, 0xf4, 0x37, 0x58, 0x2f, 0x7e,
-	0x07, 0x31, 0x45, 0x38, 0x0a, 0x6a, 0xf2, 0x7b, 0xf1, 0xf0, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0xcf, 0x41, 0xe9, 0x3c, 0x06, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x6f, 0x7e, 0x9a, 0x4e, 0xae, 0xae, 0xae, 0xdc, 0xde, 0x5e, 0x37, 0xbd, 0x37, 0x8e,
+	0x8c, 0x50, 0x23, 0x41, 0x6d, 0x12, 0x10, 0x15, 0x2c, 0x0d, 0xaa, 0x54, 0xa9, 0x52, 0x8b, 0x29,
+	0x42, 0x42, 0x48, 0xd6, 0xd8, 0x33, 0x49, 0x87, 0xd8, 0x1e, 0x77, 0xc6, 0x69, 0x09, 0x62, 0xc1,
+	0x9a, 0x55, 0x57, 0xbc, 0x00, 0x3b, 0x9e, 0xa4, 0xcb, 0x2e, 0x11, 0x8b, 0x14, 0xb5, 0x6f, 0x90,
+	0x27, 0x40, 0x1e, 0xff, 0x85, 0xaa, 0xfc, 0x4a, 0x5d, 0xd9, 0x67, 0xbe, 0xf3, 0x7d, 0xdf, 0x99,
+	0x39, 0xc7, 0x63, 0x70, 0x87, 0x72, 0x9f, 0x72, 0xc2, 0x0d, 0x97, 0x06, 0x2e, 0x0e, 0x22, 0x06,
+	0x23, 0x8c, 0x3c, 0xb2, 0x3f, 0x22, 0x88, 0x44, 0x63, 0xe3, 0xa0, 0xeb, 0xe0, 0x08, 0x76, 0x8d,
+	0x90, 0x72, 0x12, 0x11, 0x1a, 0xe8, 0x21, 0xa3, 0x11, 0x95, 0xaf, 0xa7, 0x2c, 0xfd, 0x52, 0x96,
+	0x9e, 0xb2, 0x9a, 0xcb, 0xae, 0xc8, 0xb3, 0x05, 0xc9, 0x48, 0x82, 0x44, 0xa1, 0xa9, 0x0e, 0x28,
+	0x1d, 0x78, 0xd8, 0x10, 0x91, 0x33, 0xea, 0x1b, 0x11, 0xf1, 0x31, 0x8f, 0xa0, 0x1f, 0xa6, 0x09,
+	0xad, 0x8b, 0x09, 0x68, 0xc4, 0x60, 0x51, 0x42, 0x73, 0x71, 0x40, 0x07, 0x34, 0x11, 0x8e, 0xdf,
+	0x32, 0x56, 0x62, 0x62, 0x38, 0x90, 0xe3, 0xbc, 0x78, 0x97, 0x92, 0x8c, 0xb5, 0x9c, 0x6d, 0xd7,
+	0xa3, 0xee, 0x70, 0x14, 0x8a, 0x47, 0x02, 0x69, 0x6f, 0xcb, 0xa0, 0xbe, 0x93, 0x6e, 0x53, 0x5e,
+	0x07, 0x8d, 0x6c, 0xcb, 0x36, 0x41, 0x8a, 0xd4, 0x96, 0x3a, 0x15, 0x73, 0x69, 0x3a, 0x51, 0xe5,
+	0x31, 0xf4, 0xbd, 0xfb, 0xda, 0x0c, 0xa8, 0x59, 0x20, 0x8b, 0x36, 0x91, 0x7c, 0x13, 0xcc, 0x41,
+	0x84, 0x18, 0xe6, 0x5c, 0xf9, 0xa3, 0x2d, 0x75, 0xe6, 0x4d, 0x79, 0x3a, 0x51, 0xff, 0x4a, 0x48,
+	0x29, 0xa0, 0x59, 0x59, 0x8a, 0x7c, 0x03, 0xcc, 0x85, 0x94, 0x7a, 0xb1, 0x45, 0x59, 0x58, 0xcc,
+	0x64, 0xa7, 0x80, 0x66, 0xd5, 0xe2, 0xb7, 0x4d, 0x24, 0xff, 0x0f, 0x80, 0x47, 0x0f, 0x31, 0xb3,
+	0x23, 0xe2, 0x0e, 0x95, 0x4a, 0x5b, 0xea, 0x94, 0xad, 0x79, 0xb1, 0xb2, 0x4b, 0xdc, 0x61, 0x0c,
+	0x8f, 0xc2, 0x30, 0x83, 0xab, 0x09, 0x2c, 0x56, 0x04, 0xfc, 0x04, 0xcc, 0xbf, 0xa0, 0x24, 0xb0,
+	0xe3, 0x73, 0x56, 0x6a, 0x6d, 0xa9, 0xd3, 0xe8, 0x35, 0xf5, 0xe4, 0x8c, 0xf5, 0xec, 0x8c, 0xf5,
+	0xdd, 0xac, 0x09, 0xe6, 0x7f, 0xc7, 0x13, 0xb5, 0x34, 0x9d, 0xa8, 0x7f, 0x27, 0xc5, 0xe4, 0x54,
+	0xed, 0xe8, 0x54, 0x95, 0xac, 0x7a, 0x1c, 0xc7, 0xc9, 0xb1, 0x6c, 0xde, 0x77, 0x65, 0x4e, 0xec,
+	0x78, 0x3d, 0xa6, 0x7e, 0x9a, 0xa8, 0x2b, 0x49, 0x2f, 0x38, 0x1a, 0xea, 0x84, 0x1a, 0x3e, 0x8c,
+	0xf6, 0xf4, 0x2d, 0x3c, 0x80, 0xee, 0xf8, 0x21, 0x76, 0x0b, 0xe5, 0x9c, 0xad, 0x59, 0x85, 0x92,
+	0xf6, 0x46, 0x02, 0xc0, 0x82, 0xc1, 0x00, 0x6f, 0x33, 0x84, 0xd9, 0xef, 0xb7, 0xe3, 0x1e, 0xf8,
+	0xf3, 0x15, 0x66, 0xd4, 0xee, 0x53, 0x66, 0xd3, 0x00, 0x8b, 0x9e, 0xd4, 0xcd, 0x7f, 0xa7, 0x13,
+	0x75, 0x21, 0x61, 0xce, 0xa2, 0x9a, 0x05, 0xe2, 0x70, 0x83, 0xb2, 0xed, 0x00, 0x6b, 0xef, 0xaa,
+	0xe0, 0x9f, 0x8d, 0x91, 0xe7, 0x65, 0x33, 0x61, 0x32, 0x0c, 0x87, 0x88, 0x1e, 0x06, 0xf2, 0x23,
+	0x50, 0xcf, 0x2c, 0x44, 0x29, 0x8d, 0x9e, 0xa1, 0xff, 0xd4, 0x07, 0xa1, 0xe7, 0x5a, 0x95, 0xf8,
+	0x8c, 0xac, 0x5c, 0x46, 0x76, 0x40, 0x0d, 0x72, 0x8e, 0xa3, 0x5b, 0xa2, 0xc2, 0x46, 0x6f, 0x59,
+	0x4f, 0xbf, 0x96, 0x78, 0x90, 0x73, 0xfa, 0x03, 0x4a, 0x02, 0xd3, 0x88, 0xa9, 0x1f, 0x4e, 0xd5,
+	0xd5, 0x01, 0x89, 0xf6, 0x46, 0x8e, 0xee, 0x52, 0x3f, 0xfd, 0xb4, 0xd2, 0xc7, 0x1a, 0x47, 0x43,
+	0x23, 0x1a, 0x87, 0x98, 0x0b, 0x82, 0x95, 0x2a, 0xe7, 0x1e, 0x5d, 0xa5, 0x7c, 0x45, 0x1e, 0x5d,
+	0xf9, 0x35, 0x50, 0x5c, 0x0f, 0x12, 0x1f, 0x3a, 0x1e, 0xb6, 0x79, 0xc8, 0x30, 0x44, 0x36, 0xc3,
+	0x87, 0x90, 0x21, 0xae, 0x54, 0xda, 0xe5, 0xef, 0xbb, 0xae, 0xa6, 0x33, 0xa7, 0x26, 0xad, 0xf9,
+	0x96, 0x90, 0x66, 0x2d, 0xe5, 0xd0, 0x63, 0x81, 0x58, 0x09, 0x20, 0xef, 0x83, 0xc5, 0x82, 0x44,
+	0x44, 0x23, 0xc8, 0x01, 0xe6, 0x4a, 0xf5, 0x47, 0xce, 0xd7, 0x52, 0xe7, 0x95, 0x8b, 0xce, 0x85,
+	0x88, 0x66, 0x2d, 0xe4, 0xcb, 0x9b, 0xf9, 0x6a, 0x6c, 0xd9, 0xa7, 0xac, 0x8f, 0x49, 0x84, 0xd1,
+	0xac, 0x65, 0xed, 0x17, 0x2d, 0x2f, 0x13, 0xd1, 0xac, 0x85, 0x7c, 0xb9, 0xb0, 0xd4, 0xde, 0x4b,
+	0x60, 0x29, 0x1b, 0xa4, 0xa7, 0x24, 0xda, 0xdb, 0xc1, 0x8c, 0x50, 0xb4, 0x45, 0xdd, 0xe1, 0x55,
+	0x4c, 0xe6, 0x5d, 0x50, 0x8d, 0x2f, 0x49, 0x9e, 0x0e, 0x66, 0x33, 0xd7, 0x4b, 0x6e, 0x50, 0xbd,
+	0x70, 0x4f, 0xa9, 0x49, 0xba, 0xf9, 0xfc, 0xf8, 0xac, 0x25, 0x9d, 0x9c, 0xb5, 0xa4, 0xcf, 0x67,
+	0x2d, 0xe9, 0xe8, 0xbc, 0x55, 0x3a, 0x39, 0x6f, 0x95, 0x3e, 0x9e, 0xb7, 0x4a, 0xcf, 0xcc, 0x99,
+	0xa1, 0x4a, 0xc5, 0xd6, 0x3c, 0xe8, 0xf0, 0x2c, 0x30, 0x0e, 0x7a, 0x5d, 0xe3, 0xe5, 0x57, 0x3f,
+	0xa4, 0xb5, 0xe2, 0x8f, 0xe4, 0x53, 0x84, 0x3d, 0xa7, 0x26, 0xae, 0xac, 0xdb, 0x5f, 0x06, 0x00,
+	0xcf, 0xe8, 0x2f, 0xd8, 0xbf, 0x06, 0x00, 0x00,
 }
 
 func (m *Position) Marshal() (dAtA []byte, err error) {
@@ -394,6 +458,44 @@ func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RangeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ZeroForOne {
+		i--
+		if m.ZeroForOne {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FullPositionBreakdown) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -572,6 +674,21 @@ func (m *Position) Size() (n int) {
 	return n
 }
 
+func (m *RangeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovPosition(uint64(m.PositionId))
+	}
+	if m.ZeroForOne {
+		n += 2
+	}
+	return n
+}
+
 func (m *FullPositionBreakdown) Size() (n int) {
 	if m == nil {
 		return 0
@@ -849,6 +966,95 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RangeOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroForOne", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ZeroForOne = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FullPositionBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,8 +2,11 @@ package concentrated_liquidity
 
 import (
 	"context"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
@@ -215,6 +218,11 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 		return nil, err
 	}
 
+	// Positions are only escrowed when tokenized, for their token to be minted.
+	if newOwner.Equals(types.PositionEscrowAddress) {
+		return nil, types.TransferToPositionEscrowError{}
+	}
+
 	err = server.keeper.transferPositions(ctx, msg.PositionIds, sender, newOwner)
 	if err != nil {
 		return nil, err
@@ -237,3 +245,145 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+func (server msgServer) SetPoolsWithdrawOnlyMode(goCtx context.Context, msg *types.MsgSetPoolsWithdrawOnlyMode) (*types.MsgSetPoolsWithdrawOnlyModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.setPoolsWithdrawOnlyModeAsEmergencyAddress(ctx, sender, msg.PoolIds, msg.WithdrawOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetPoolsWithdrawOnlyModeResponse{}, nil
+}
+
+func (server msgServer) TokenizePosition(goCtx context.Context, msg *types.MsgTokenizePosition) (*types.MsgTokenizePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionDenom, err := server.keeper.tokenizePosition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtTokenizePosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributePositionDenom, positionDenom),
+		),
+	})
+
+	return &types.MsgTokenizePositionResponse{PositionDenom: positionDenom}, nil
+}
+
+func (server msgServer) DetokenizePosition(goCtx context.Context, msg *types.MsgDetokenizePosition) (*types.MsgDetokenizePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collectedSpreadRewards, collectedIncentives, err := server.keeper.detokenizePosition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtDetokenizePosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributePositionDenom, types.GetPositionTokenDenom(msg.PositionId)),
+		),
+	})
+
+	return &types.MsgDetokenizePositionResponse{CollectedSpreadRewards: collectedSpreadRewards, CollectedIncentives: collectedIncentives}, nil
+}
+
+func (server msgServer) ClaimFilledRangeOrder(goCtx context.Context, msg *types.MsgClaimFilledRangeOrder) (*types.MsgClaimFilledRangeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.claimFilledRangeOrder(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgClaimFilledRangeOrderResponse{Amount0: amount0, Amount1: amount1}, nil
+}
+
+// UpdateParams sets the concentrated-liquidity module parameters, it can only be executed by governance.
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	server.keeper.SetParams(ctx, msg.Params)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateAuthority returns an error if the given address is not the authority of the concentrated-liquidity parameters.
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
@@ -685,3 +687,215 @@ func (s *KeeperTestSuite) TestTransferPositions_Events() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSetPoolsWithdrawOnlyMode_EmergencyWhitelist() {
+	testcases := map[string]struct {
+		isWhitelisted bool
+		poolIds       []uint64
+		expectedError error
+	}{
+		"happy path": {
+			isWhitelisted: true,
+		},
+		"error: sender not whitelisted": {
+			isWhitelisted: false,
+		},
+		"error: pool does not exist": {
+			isWhitelisted: true,
+			poolIds:       []uint64{100},
+			expectedError: types.PoolNotFoundError{PoolId: 100},
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+			ctx := s.Ctx
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			sender := s.TestAccs[0]
+
+			pool := s.PrepareConcentratedPool()
+			poolIds := tc.poolIds
+			if poolIds == nil {
+				poolIds = []uint64{pool.GetId()}
+			}
+			expectedError := tc.expectedError
+			if tc.isWhitelisted {
+				params := s.App.ConcentratedLiquidityKeeper.GetParams(ctx)
+				params.WithdrawOnlyModeEmergencyWhitelist = []string{s.TestAccs[1].String(), sender.String()}
+				s.App.ConcentratedLiquidityKeeper.SetParams(ctx, params)
+			} else {
+				expectedError = types.NotWithdrawOnlyModeEmergencyAddressError{Sender: sender.String()}
+			}
+
+			// System under test.
+			for _, withdrawOnly := range []bool{true, false} {
+				response, err := msgServer.SetPoolsWithdrawOnlyMode(sdk.WrapSDKContext(ctx), &types.MsgSetPoolsWithdrawOnlyMode{
+					Sender:       sender.String(),
+					PoolIds:      poolIds,
+					WithdrawOnly: withdrawOnly,
+				})
+
+				if expectedError != nil {
+					s.Require().ErrorIs(err, expectedError)
+					s.Require().Nil(response)
+					s.Require().False(s.App.ConcentratedLiquidityKeeper.IsPoolWithdrawOnly(ctx, pool.GetId()))
+					continue
+				}
+				s.Require().NoError(err)
+				s.Require().Equal(withdrawOnly, s.App.ConcentratedLiquidityKeeper.IsPoolWithdrawOnly(ctx, pool.GetId()))
+			}
+		})
+	}
+}
+
+// TestTokenizePosition_TransferAndDetokenize tests that a tokenized position is owned by the holder of its position token:
+// the position is escrowed on tokenization, and is transferred to whoever holds and burns its token on detokenization,
+// along with the spread rewards accrued while the position was tokenized.
+func (s *KeeperTestSuite) TestTokenizePosition_TransferAndDetokenize() {
+	s.SetupTest()
+	owner, holder := s.TestAccs[0], s.TestAccs[1]
+	positionDenom := types.GetPositionTokenDenom(DefaultPositionId)
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	// Setup a far out of range position, so that the tokenized position is not the last position in the pool.
+	s.SetupPosition(pool.GetId(), s.TestAccs[2], sdk.NewCoins(DefaultCoin1), DefaultMinTick, DefaultMinTick+100, true)
+
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+	// Tokenizing a position of another owner fails.
+	_, err := msgServer.TokenizePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgTokenizePosition{PositionId: DefaultPositionId, Sender: holder.String()})
+	s.Require().ErrorAs(err, &types.PositionOwnerMismatchError{})
+
+	// Spread rewards accrued before tokenization are collected to the owner.
+	expectedOwnerRewards := s.fundSpreadRewardsAddr(s.Ctx, pool.GetSpreadRewardsAddress(), []uint64{DefaultPositionId})
+	s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(10)))
+	ownerBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	tokenizeResponse, err := msgServer.TokenizePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgTokenizePosition{PositionId: DefaultPositionId, Sender: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(positionDenom, tokenizeResponse.PositionDenom)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtTokenizePosition, 1)
+
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, DefaultPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PositionEscrowAddress.String(), position.Address)
+	s.Require().Equal(osmomath.OneInt(), s.App.BankKeeper.GetBalance(s.Ctx, owner, positionDenom).Amount)
+	s.Require().Equal(ownerBalanceBefore.Amount.Add(expectedOwnerRewards.AmountOf(ETH)), s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount)
+
+	// Escrowed positions cannot be transferred directly, and positions cannot be transferred to the escrow.
+	_, err = msgServer.TransferPositions(sdk.WrapSDKContext(s.Ctx), &types.MsgTransferPositions{PositionIds: []uint64{DefaultPositionId}, Sender: owner.String(), NewOwner: holder.String()})
+	s.Require().ErrorAs(err, &types.PositionOwnerMismatchError{})
+	_, err = msgServer.TransferPositions(sdk.WrapSDKContext(s.Ctx), &types.MsgTransferPositions{PositionIds: []uint64{DefaultPositionId + 1}, Sender: s.TestAccs[2].String(), NewOwner: types.PositionEscrowAddress.String()})
+	s.Require().ErrorIs(err, types.TransferToPositionEscrowError{})
+
+	// The position token is transferred as any other token.
+	err = s.App.BankKeeper.SendCoins(s.Ctx, owner, holder, sdk.NewCoins(sdk.NewCoin(positionDenom, osmomath.OneInt())))
+	s.Require().NoError(err)
+
+	// The former owner no longer holds the position token, so it cannot detokenize the position.
+	_, err = msgServer.DetokenizePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgDetokenizePosition{PositionId: DefaultPositionId, Sender: owner.String()})
+	s.Require().ErrorIs(err, types.PositionTokenNotHeldError{PositionId: DefaultPositionId, Address: owner.String()})
+
+	// Spread rewards accrued while the position was tokenized are paid to the holder on detokenization.
+	expectedHolderRewards := s.fundSpreadRewardsAddr(s.Ctx, pool.GetSpreadRewardsAddress(), []uint64{DefaultPositionId})
+	s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(10)))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	detokenizeResponse, err := msgServer.DetokenizePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgDetokenizePosition{PositionId: DefaultPositionId, Sender: holder.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedHolderRewards, detokenizeResponse.CollectedSpreadRewards)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtDetokenizePosition, 1)
+
+	position, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, DefaultPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(holder.String(), position.Address)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, holder, positionDenom).IsZero())
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, positionDenom).IsZero())
+	s.Require().Equal(expectedHolderRewards.AmountOf(ETH), s.App.BankKeeper.GetBalance(s.Ctx, holder, ETH).Amount)
+
+	// Once detokenized, the position cannot be detokenized again.
+	_, err = msgServer.DetokenizePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgDetokenizePosition{PositionId: DefaultPositionId, Sender: holder.String()})
+	s.Require().ErrorIs(err, types.PositionTokenNotHeldError{PositionId: DefaultPositionId, Address: holder.String()})
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := map[string]struct {
+		authority    string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		"enable permissionless pool creation and authorize a tick spacing": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.IsPermissionlessPoolCreationEnabled = !params.IsPermissionlessPoolCreationEnabled
+				params.AuthorizedTickSpacing = append(params.AuthorizedTickSpacing, 10_000)
+			},
+		},
+		"update swap gas costs and min position liquidity": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.TickCrossGasCost = 2_000
+				params.AccumulatorUpdateGasCost = 1_000
+				params.MinPositionLiquidity = osmomath.NewDec(1_000)
+			},
+		},
+		"error: not the governance authority": {
+			authority: s.TestAccs[0].String(),
+			updateParams: func(params *types.Params) {
+				params.IsPermissionlessPoolCreationEnabled = !params.IsPermissionlessPoolCreationEnabled
+			},
+			expectedErr: "invalid authority",
+		},
+		"error: zero tick spacing": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.AuthorizedTickSpacing = []uint64{0} },
+			expectedErr:  "tick spacing cannot be zero",
+		},
+		"error: no authorized quote denoms": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.AuthorizedQuoteDenoms = []string{} },
+			expectedErr:  "authorized quote denoms cannot be empty",
+		},
+		"error: no authorized uptimes": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.AuthorizedUptimes = []time.Duration{} },
+			expectedErr:  "authorized uptimes cannot be empty",
+		},
+		"error: negative min position liquidity": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.MinPositionLiquidity = osmomath.NewDec(-1) },
+			expectedErr:  "min position liquidity must be non-negative",
+		},
+		"error: invalid withdraw-only mode emergency address": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.WithdrawOnlyModeEmergencyWhitelist = []string{"invalid"} },
+			expectedErr:  "invalid address",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			originalParams := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			newParams := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			tc.updateParams(&newParams)
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: tc.authority, Params: newParams})
+
+			params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, params)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package concentrated_liquidity
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -310,6 +311,77 @@ func (k Keeper) DecreaseConcentratedPoolTickSpacing(ctx sdk.Context, poolIdToTic
 	return nil
 }
 
+// SetPoolsWithdrawOnlyMode enables or disables the withdraw-only mode of the given pools.
+// While a pool is in withdraw-only mode, swaps and new positions are rejected, but existing positions
+// can still be withdrawn and their spread rewards and incentives collected.
+// This allows responding to a discovered bug without freezing user funds.
+// Returns error if one of the pools does not exist, in which case the mode of none of the pools is changed.
+func (k Keeper) SetPoolsWithdrawOnlyMode(ctx sdk.Context, poolIds []uint64, withdrawOnly bool) error {
+	for _, poolId := range poolIds {
+		if _, err := k.GetConcentratedPoolById(ctx, poolId); err != nil {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, poolId := range poolIds {
+		if withdrawOnly {
+			store.Set(types.KeyWithdrawOnlyPool(poolId), []byte{1})
+		} else {
+			store.Delete(types.KeyWithdrawOnlyPool(poolId))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSetPoolWithdrawOnlyMode,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeWithdrawOnly, strconv.FormatBool(withdrawOnly)),
+		))
+	}
+	return nil
+}
+
+// setPoolsWithdrawOnlyModeAsEmergencyAddress sets the withdraw-only mode of the given pools on behalf of an
+// address of the withdraw-only mode emergency whitelist, so that pools can be paused without waiting
+// for a governance proposal to pass.
+// Returns error if the sender is not in the whitelist or if one of the pools does not exist.
+func (k Keeper) setPoolsWithdrawOnlyModeAsEmergencyAddress(ctx sdk.Context, sender sdk.AccAddress, poolIds []uint64, withdrawOnly bool) error {
+	isWhitelisted := false
+	for _, addr := range k.GetParams(ctx).WithdrawOnlyModeEmergencyWhitelist {
+		// okay to use MustAccAddressFromBech32 because already validated in params
+		if sdk.MustAccAddressFromBech32(addr).Equals(sender) {
+			isWhitelisted = true
+			break
+		}
+	}
+	if !isWhitelisted {
+		return types.NotWithdrawOnlyModeEmergencyAddressError{Sender: sender.String()}
+	}
+
+	return k.SetPoolsWithdrawOnlyMode(ctx, poolIds, withdrawOnly)
+}
+
+// IsPoolWithdrawOnly returns true if the given pool is in withdraw-only mode, either on its own or
+// because the all pools withdraw-only param is enabled. False otherwise.
+func (k Keeper) IsPoolWithdrawOnly(ctx sdk.Context, poolId uint64) bool {
+	if k.GetParams(ctx).AllPoolsWithdrawOnly {
+		return true
+	}
+	return ctx.KVStore(k.storeKey).Has(types.KeyWithdrawOnlyPool(poolId))
+}
+
+// GetWithdrawOnlyPoolIds returns the ids of all pools in withdraw-only mode in ascending order.
+func (k Keeper) GetWithdrawOnlyPoolIds(ctx sdk.Context) []uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.WithdrawOnlyPoolPrefix)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Key()[len(types.WithdrawOnlyPoolPrefix):]))
+	}
+	return poolIds
+}
+
 // validateTickSpacing returns true if the given tick spacing is one of the authorized tick spacings set in the
 // params. False otherwise.
 func (k Keeper) validateTickSpacing(params types.Params, tickSpacing uint64) bool {
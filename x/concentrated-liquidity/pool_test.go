@@ -474,6 +474,118 @@ func (s *KeeperTestSuite) TestValidateAuthorizedQuoteDenoms() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSetPoolsWithdrawOnlyMode() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+	swapper := s.TestAccs[1]
+
+	concentratedPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	poolId := concentratedPool.GetId()
+	positionId := s.SetupDefaultPositionAcc(poolId, owner)
+	otherPool := s.PrepareConcentratedPool()
+
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(1_000))
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn).Add(tokenIn))
+
+	// Pools are not in withdraw-only mode by default.
+	s.Require().False(clKeeper.IsPoolWithdrawOnly(s.Ctx, poolId))
+	s.Require().Empty(clKeeper.GetWithdrawOnlyPoolIds(s.Ctx))
+
+	// Setting the mode of a non-existent pool fails without changing any pool.
+	err := clKeeper.SetPoolsWithdrawOnlyMode(s.Ctx, []uint64{poolId, 100}, true)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: 100})
+	s.Require().False(clKeeper.IsPoolWithdrawOnly(s.Ctx, poolId))
+
+	// System under test.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = clKeeper.SetPoolsWithdrawOnlyMode(s.Ctx, []uint64{poolId}, true)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSetPoolWithdrawOnlyMode, 1)
+
+	s.Require().True(clKeeper.IsPoolWithdrawOnly(s.Ctx, poolId))
+	s.Require().False(clKeeper.IsPoolWithdrawOnly(s.Ctx, otherPool.GetId()))
+	s.Require().Equal([]uint64{poolId}, clKeeper.GetWithdrawOnlyPoolIds(s.Ctx))
+
+	expectedErr := types.PoolWithdrawOnlyError{PoolId: poolId}
+
+	// Swaps and swap estimates are rejected.
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, concentratedPool, tokenIn, USDC, osmomath.ZeroInt(), osmomath.ZeroDec())
+	s.Require().ErrorIs(err, expectedErr)
+	_, err = clKeeper.SwapExactAmountOut(s.Ctx, swapper, concentratedPool, ETH, tokenIn.Amount, sdk.NewCoin(USDC, osmomath.NewInt(1)), osmomath.ZeroDec())
+	s.Require().ErrorIs(err, expectedErr)
+	_, err = clKeeper.CalcOutAmtGivenIn(s.Ctx, concentratedPool, tokenIn, USDC, osmomath.ZeroDec())
+	s.Require().ErrorIs(err, expectedErr)
+
+	// New positions and additions to existing positions are rejected.
+	s.FundAcc(owner, DefaultCoins)
+	_, err = clKeeper.CreatePosition(s.Ctx, poolId, owner, DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().ErrorIs(err, expectedErr)
+	_, _, _, err = clKeeper.AddToPosition(s.Ctx, owner, positionId, DefaultAmt0, DefaultAmt1, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().ErrorIs(err, expectedErr)
+
+	// Rewards can still be collected and positions withdrawn.
+	_, err = clKeeper.CollectSpreadRewards(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	_, _, err = clKeeper.CollectIncentives(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+
+	// Other pools are not affected.
+	s.SetupDefaultPositionAcc(otherPool.GetId(), owner)
+
+	// Disabling the withdraw-only mode enables swaps again.
+	err = clKeeper.SetPoolsWithdrawOnlyMode(s.Ctx, []uint64{poolId}, false)
+	s.Require().NoError(err)
+	s.Require().False(clKeeper.IsPoolWithdrawOnly(s.Ctx, poolId))
+	s.Require().Empty(clKeeper.GetWithdrawOnlyPoolIds(s.Ctx))
+
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, concentratedPool, tokenIn, USDC, osmomath.ZeroInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestAllPoolsWithdrawOnly() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+
+	concentratedPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
+	poolId := concentratedPool.GetId()
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(1_000))
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+
+	// System under test.
+	params := clKeeper.GetParams(s.Ctx)
+	params.AllPoolsWithdrawOnly = true
+	clKeeper.SetParams(s.Ctx, params)
+
+	// Every pool is in withdraw-only mode, without being set individually.
+	s.Require().True(clKeeper.IsPoolWithdrawOnly(s.Ctx, poolId))
+	s.Require().Empty(clKeeper.GetWithdrawOnlyPoolIds(s.Ctx))
+
+	expectedErr := types.PoolWithdrawOnlyError{PoolId: poolId}
+	_, err := clKeeper.SwapExactAmountIn(s.Ctx, owner, concentratedPool, tokenIn, USDC, osmomath.ZeroInt(), osmomath.ZeroDec())
+	s.Require().ErrorIs(err, expectedErr)
+	s.FundAcc(owner, DefaultCoins)
+	_, err = clKeeper.CreatePosition(s.Ctx, poolId, owner, DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().ErrorIs(err, expectedErr)
+
+	// The mode set on the pool itself is kept once the param is disabled.
+	err = clKeeper.SetPoolsWithdrawOnlyMode(s.Ctx, []uint64{poolId}, true)
+	s.Require().NoError(err)
+	params.AllPoolsWithdrawOnly = false
+	clKeeper.SetParams(s.Ctx, params)
+	s.Require().True(clKeeper.IsPoolWithdrawOnly(s.Ctx, poolId))
+
+	err = clKeeper.SetPoolsWithdrawOnlyMode(s.Ctx, []uint64{poolId}, false)
+	s.Require().NoError(err)
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, owner, concentratedPool, tokenIn, USDC, osmomath.ZeroInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestDecreaseConcentratedPoolTickSpacing() {
 	type positionRange struct {
 		lowerTick int64
@@ -389,6 +389,9 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
+	// Remove the range order of the position (if it exists)
+	k.deleteRangeOrder(ctx, positionId)
+
 	return nil
 }
 
@@ -739,6 +742,65 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 	return nil
 }
 
+// tokenizePosition escrows the position in the module account and mints to the sender the position token representing it,
+// which can be transferred as any other token, e.g. to a contract as collateral. Its holder can detokenize it to own the position.
+// The checks of transferPositions apply: the sender must own the position, which must not have an active underlying lock,
+// and must not be the last position in its pool. The outstanding rewards of the position are collected to the sender.
+// Returns the denom of the position token.
+func (k Keeper) tokenizePosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (string, error) {
+	if err := k.transferPositions(ctx, []uint64{positionId}, sender, types.PositionEscrowAddress); err != nil {
+		return "", err
+	}
+
+	positionDenom := types.GetPositionTokenDenom(positionId)
+	positionToken := sdk.NewCoins(sdk.NewCoin(positionDenom, osmomath.OneInt()))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, positionToken); err != nil {
+		return "", err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, positionToken); err != nil {
+		return "", err
+	}
+
+	return positionDenom, nil
+}
+
+// detokenizePosition burns the position token held by the sender, and transfers the position it represents out of escrow
+// to the sender. The rewards accrued by the position while it was tokenized are collected to the sender.
+// Returns error if the sender does not hold the position token, or if the position is the last position in its pool.
+func (k Keeper) detokenizePosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (collectedSpreadRewards sdk.Coins, collectedIncentives sdk.Coins, err error) {
+	positionToken := sdk.NewCoin(types.GetPositionTokenDenom(positionId), osmomath.OneInt())
+	if !k.bankKeeper.HasBalance(ctx, sender, positionToken) {
+		return nil, nil, types.PositionTokenNotHeldError{PositionId: positionId, Address: sender.String()}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(positionToken)); err != nil {
+		return nil, nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(positionToken)); err != nil {
+		return nil, nil, err
+	}
+
+	// Collect the rewards to the module account before the transfer does, to pay them to the sender.
+	collectedSpreadRewards, err = k.collectSpreadRewards(ctx, types.PositionEscrowAddress, positionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	collectedIncentives, _, err = k.collectIncentives(ctx, types.PositionEscrowAddress, positionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rewards := collectedSpreadRewards.Add(collectedIncentives...); !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, rewards); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := k.transferPositions(ctx, []uint64{positionId}, types.PositionEscrowAddress, sender); err != nil {
+		return nil, nil, err
+	}
+
+	return collectedSpreadRewards, collectedIncentives, nil
+}
+
 // underlyingPositionsValue calculates the value of the underlying assets in the given positions.
 func (k Keeper) UnderlyingPositionsValue(ctx sdk.Context, positionIds []uint64) (sdk.Coins, error) {
 	underlyingAssets := sdk.Coins{}
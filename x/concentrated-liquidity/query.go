@@ -27,6 +27,25 @@ const invalidTickIndex = int64(-1)
 // For cases where there is no liquidity in the bucket but there may be liquidity to the left, the value will be len(liquidityDepthsForRange).
 // Otherwise, the index points to the bucket that corresponds to the current tick.
 func (k Keeper) GetTickLiquidityForFullRange(ctx sdk.Context, poolId uint64) ([]queryproto.LiquidityDepthWithRange, int64, error) {
+	return k.GetTickLiquidityForRange(ctx, poolId, types.MinInitializedTick, types.MaxTick)
+}
+
+// GetTickLiquidityForRange returns a slice of liquidity buckets for the tick ranges existing between lowerTick and upperTick,
+// clipped to [lowerTick, upperTick], so that front-ends can chart the liquidity depth around a price.
+// Returns index of the bucket that corresponds to the current tick, as GetTickLiquidityForFullRange does.
+// If the current tick is below lowerTick, the value will be -1. If it is at or above upperTick, the value will be len(liquidityDepthsForRange).
+// Errors if lowerTick is not lesser than upperTick, or if they are outside of [MinInitializedTick, MaxTick].
+func (k Keeper) GetTickLiquidityForRange(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) ([]queryproto.LiquidityDepthWithRange, int64, error) {
+	if lowerTick >= upperTick {
+		return []queryproto.LiquidityDepthWithRange{}, invalidTickIndex, types.InvalidLowerUpperTickError{LowerTick: lowerTick, UpperTick: upperTick}
+	}
+	if lowerTick < types.MinInitializedTick {
+		return []queryproto.LiquidityDepthWithRange{}, invalidTickIndex, types.TickIndexMinimumError{MinTick: types.MinInitializedTick}
+	}
+	if upperTick > types.MaxTick {
+		return []queryproto.LiquidityDepthWithRange{}, invalidTickIndex, types.TickIndexMaximumError{MaxTick: types.MaxTick}
+	}
+
 	// use false for zeroForOne since we're going from lower tick -> upper tick
 	zeroForOne := false
 	swapStrategy := swapstrategy.New(zeroForOne, osmomath.ZeroBigDec(), k.storeKey, osmomath.ZeroDec())
@@ -73,8 +92,10 @@ func (k Keeper) GetTickLiquidityForFullRange(ctx sdk.Context, poolId uint64) ([]
 	)
 
 	// start from the next index so that the current tick can become lower tick.
+	// The liquidity is accumulated from the smallest initialized tick, and the buckets are only returned
+	// from lowerTick, until a bucket starts at or above upperTick.
 	nextTickIter.Next()
-	for ; nextTickIter.Valid(); nextTickIter.Next() {
+	for ; nextTickIter.Valid() && previousTickIndex < upperTick; nextTickIter.Next() {
 		tickIndex, err := types.TickIndexFromBytes(nextTickIter.Key())
 		if err != nil {
 			return []queryproto.LiquidityDepthWithRange{}, invalidTickIndex, err
@@ -85,17 +106,26 @@ func (k Keeper) GetTickLiquidityForFullRange(ctx sdk.Context, poolId uint64) ([]
 			return []queryproto.LiquidityDepthWithRange{}, invalidTickIndex, err
 		}
 
-		// Found the current bucket, update its index.
-		if currentBucketIndex == invalidTickIndex && concentratedPool.IsCurrentTickInRange(previousTickIndex, tickIndex) && currentTickLiquidity.Equal(totalLiquidityWithinRange) {
-			currentBucketIndex = int64(len(liquidityDepthsForRange))
+		bucketLowerTick, bucketUpperTick := previousTickIndex, tickIndex
+		if bucketLowerTick < lowerTick {
+			bucketLowerTick = lowerTick
 		}
-
-		liquidityDepthForRange := queryproto.LiquidityDepthWithRange{
-			LowerTick:       previousTickIndex,
-			UpperTick:       tickIndex,
-			LiquidityAmount: totalLiquidityWithinRange,
+		if bucketUpperTick > upperTick {
+			bucketUpperTick = upperTick
+		}
+		if bucketLowerTick < bucketUpperTick {
+			// Found the current bucket, update its index.
+			if currentBucketIndex == invalidTickIndex && concentratedPool.IsCurrentTickInRange(bucketLowerTick, bucketUpperTick) && currentTickLiquidity.Equal(totalLiquidityWithinRange) {
+				currentBucketIndex = int64(len(liquidityDepthsForRange))
+			}
+
+			liquidityDepthForRange := queryproto.LiquidityDepthWithRange{
+				LowerTick:       bucketLowerTick,
+				UpperTick:       bucketUpperTick,
+				LiquidityAmount: totalLiquidityWithinRange,
+			}
+			liquidityDepthsForRange = append(liquidityDepthsForRange, liquidityDepthForRange)
 		}
-		liquidityDepthsForRange = append(liquidityDepthsForRange, liquidityDepthForRange)
 
 		currentLiquidity = tickStruct.LiquidityNet
 
@@ -103,8 +133,8 @@ func (k Keeper) GetTickLiquidityForFullRange(ctx sdk.Context, poolId uint64) ([]
 		totalLiquidityWithinRange = totalLiquidityWithinRange.Add(currentLiquidity)
 	}
 
-	// This signifies that currrent tick is above the max initialized tick
-	if currentTick >= previousTickIndex && currentTickLiquidity.IsZero() {
+	// This signifies that currrent tick is above the max initialized tick, or above the upper tick.
+	if currentBucketIndex == invalidTickIndex && ((currentTick >= previousTickIndex && currentTickLiquidity.IsZero()) || currentTick >= upperTick) {
 		currentBucketIndex = int64(len(liquidityDepthsForRange))
 	}
 
@@ -6,6 +6,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types/genesis"
 )
 
@@ -307,6 +308,125 @@ func (s *KeeperTestSuite) TestGetTickLiquidityForFullRange_CreatePosition() {
 	s.Require().Equal(expectedCurrentBucketIndex, currentBucketIndex)
 }
 
+// Tests GetTickLiquidityForRange by creating positions around the current tick of about 4_000_000,
+// which corresponds to the price of 5 set by the first position.
+func (s *KeeperTestSuite) TestGetTickLiquidityForRange() {
+	var (
+		positionOneLowerTick = int64(3_000_000)
+		positionOneUpperTick = int64(5_000_000)
+		positionTwoLowerTick = int64(3_500_000)
+		positionTwoUpperTick = int64(6_000_000)
+
+		defaultTokenAmount = osmomath.NewInt(1000000000000000000)
+		defaultCoins       = sdk.NewCoins(sdk.NewCoin(ETH, defaultTokenAmount), sdk.NewCoin(USDC, defaultTokenAmount.MulRaw(5)))
+	)
+
+	tests := map[string]struct {
+		lowerTick int64
+		upperTick int64
+
+		// the liquidity of the expected ranges, from the liquidity of the positions.
+		expectedRanges             []queryproto.LiquidityDepthWithRange
+		expectedPositionsLiquidity [][]int
+		expectedCurrentBucketIndex int64
+		expectedErr                error
+	}{
+		"full range": {
+			lowerTick: types.MinInitializedTick,
+			upperTick: types.MaxTick,
+			expectedRanges: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: positionOneLowerTick, UpperTick: positionTwoLowerTick},
+				{LowerTick: positionTwoLowerTick, UpperTick: positionOneUpperTick},
+				{LowerTick: positionOneUpperTick, UpperTick: positionTwoUpperTick},
+			},
+			expectedPositionsLiquidity: [][]int{{0}, {0, 1}, {1}},
+			expectedCurrentBucketIndex: 1,
+		},
+		"ranges clipped on both sides": {
+			lowerTick: 3_200_000,
+			upperTick: 5_500_000,
+			expectedRanges: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: 3_200_000, UpperTick: positionTwoLowerTick},
+				{LowerTick: positionTwoLowerTick, UpperTick: positionOneUpperTick},
+				{LowerTick: positionOneUpperTick, UpperTick: 5_500_000},
+			},
+			expectedPositionsLiquidity: [][]int{{0}, {0, 1}, {1}},
+			expectedCurrentBucketIndex: 1,
+		},
+		"current tick below lower tick": {
+			lowerTick: 4_500_000,
+			upperTick: 7_000_000,
+			expectedRanges: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: 4_500_000, UpperTick: positionOneUpperTick},
+				{LowerTick: positionOneUpperTick, UpperTick: positionTwoUpperTick},
+			},
+			expectedPositionsLiquidity: [][]int{{0, 1}, {1}},
+			expectedCurrentBucketIndex: -1,
+		},
+		"current tick above upper tick": {
+			lowerTick: 1_000_000,
+			upperTick: 3_800_000,
+			expectedRanges: []queryproto.LiquidityDepthWithRange{
+				{LowerTick: positionOneLowerTick, UpperTick: positionTwoLowerTick},
+				{LowerTick: positionTwoLowerTick, UpperTick: 3_800_000},
+			},
+			expectedPositionsLiquidity: [][]int{{0}, {0, 1}},
+			expectedCurrentBucketIndex: 2,
+		},
+		"no initialized ticks in range": {
+			lowerTick:                  1_000_000,
+			upperTick:                  2_000_000,
+			expectedRanges:             []queryproto.LiquidityDepthWithRange{},
+			expectedCurrentBucketIndex: 0,
+		},
+		"error: lower tick equal to upper tick": {
+			lowerTick:   positionOneUpperTick,
+			upperTick:   positionOneUpperTick,
+			expectedErr: types.InvalidLowerUpperTickError{LowerTick: positionOneUpperTick, UpperTick: positionOneUpperTick},
+		},
+		"error: lower tick below min tick": {
+			lowerTick:   types.MinInitializedTick - 1,
+			upperTick:   positionOneUpperTick,
+			expectedErr: types.TickIndexMinimumError{MinTick: types.MinInitializedTick},
+		},
+		"error: upper tick above max tick": {
+			lowerTick:   positionOneLowerTick,
+			upperTick:   types.MaxTick + 1,
+			expectedErr: types.TickIndexMaximumError{MaxTick: types.MaxTick},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			concentratedPool := s.PrepareConcentratedPool()
+			s.FundAcc(s.TestAccs[0], defaultCoins.Add(defaultCoins...))
+
+			positionOneData, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, concentratedPool.GetId(), s.TestAccs[0], defaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), positionOneLowerTick, positionOneUpperTick)
+			s.Require().NoError(err)
+			positionTwoData, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, concentratedPool.GetId(), s.TestAccs[0], defaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), positionTwoLowerTick, positionTwoUpperTick)
+			s.Require().NoError(err)
+			positionsLiquidity := []osmomath.Dec{positionOneData.Liquidity, positionTwoData.Liquidity}
+
+			liquidityForRange, currentBucketIndex, err := s.App.ConcentratedLiquidityKeeper.GetTickLiquidityForRange(s.Ctx, concentratedPool.GetId(), tc.lowerTick, tc.upperTick)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			for i, positionIndexes := range tc.expectedPositionsLiquidity {
+				tc.expectedRanges[i].LiquidityAmount = osmomath.ZeroDec()
+				for _, positionIndex := range positionIndexes {
+					tc.expectedRanges[i].LiquidityAmount = tc.expectedRanges[i].LiquidityAmount.Add(positionsLiquidity[positionIndex])
+				}
+			}
+			s.Require().Equal(tc.expectedRanges, liquidityForRange)
+			s.Require().Equal(tc.expectedCurrentBucketIndex, currentBucketIndex)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetTickLiquidityNetInDirection() {
 	defaultTick := withPoolId(defaultTick, defaultPoolId)
 
@@ -0,0 +1,139 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// setRangeOrderIfOutOfRange records the given position as a range order if its range is entirely above or below
+// the current tick of the pool, i.e. if it was created with a single asset. A range order above the current tick
+// converts token0 to token1 as the price moves up through its range, and a range order below the current tick
+// converts token1 to token0 as the price moves down through its range.
+// No-op if the position is in range.
+func (k Keeper) setRangeOrderIfOutOfRange(ctx sdk.Context, poolId uint64, currentTick int64, positionId uint64, lowerTick, upperTick int64) {
+	var zeroForOne bool
+	switch {
+	case currentTick < lowerTick:
+		zeroForOne = true
+	case currentTick >= upperTick:
+		zeroForOne = false
+	default:
+		return
+	}
+
+	k.setRangeOrder(ctx, model.RangeOrder{PositionId: positionId, ZeroForOne: zeroForOne})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCreateRangeOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeZeroForOne, strconv.FormatBool(zeroForOne)),
+	))
+}
+
+func (k Keeper) setRangeOrder(ctx sdk.Context, rangeOrder model.RangeOrder) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyRangeOrder(rangeOrder.PositionId), &rangeOrder)
+}
+
+// deleteRangeOrder deletes the range order of the given position, if any.
+func (k Keeper) deleteRangeOrder(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyRangeOrder(positionId))
+}
+
+// GetRangeOrder returns the range order of the given position.
+// Returns error if the position is not a range order.
+func (k Keeper) GetRangeOrder(ctx sdk.Context, positionId uint64) (model.RangeOrder, error) {
+	rangeOrder := model.RangeOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyRangeOrder(positionId), &rangeOrder)
+	if err != nil {
+		return model.RangeOrder{}, err
+	}
+	if !found {
+		return model.RangeOrder{}, types.RangeOrderNotFoundError{PositionId: positionId}
+	}
+	return rangeOrder, nil
+}
+
+// GetAllRangeOrders returns all range orders in ascending order of position id.
+func (k Keeper) GetAllRangeOrders(ctx sdk.Context) ([]model.RangeOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.RangeOrderPrefix, func(value []byte) (model.RangeOrder, error) {
+		rangeOrder := model.RangeOrder{}
+		err := k.cdc.Unmarshal(value, &rangeOrder)
+		return rangeOrder, err
+	})
+}
+
+// isRangeOrderFilled returns true if the range order is fully converted to the other asset at the given current tick,
+// i.e. if the current tick is at or above its upper tick for a range order converting token0 to token1, or below its
+// lower tick for a range order converting token1 to token0.
+func isRangeOrderFilled(rangeOrder model.RangeOrder, position model.Position, currentTick int64) bool {
+	if rangeOrder.ZeroForOne {
+		return currentTick >= position.UpperTick
+	}
+	return currentTick < position.LowerTick
+}
+
+// claimFilledRangeOrder withdraws the full liquidity of a filled range order to its owner, along with its spread
+// rewards and incentives, and emits a range order filled event. It can be called on behalf of the owner by anyone.
+// Returns the amounts of token0 and token1 withdrawn.
+// Returns error if:
+// - the position is not a range order
+// - the range order is not filled
+// - the range order is tokenized, since withdrawing it would pay its escrow instead of the holder of its token
+// - withdrawing the position fails, e.g. if it has an active underlying lock
+func (k Keeper) claimFilledRangeOrder(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (amount0, amount1 osmomath.Int, err error) {
+	rangeOrder, err := k.GetRangeOrder(ctx, positionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	if owner.Equals(types.PositionEscrowAddress) {
+		return osmomath.Int{}, osmomath.Int{}, types.TokenizedRangeOrderError{PositionId: positionId}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	if !isRangeOrderFilled(rangeOrder, position, pool.GetCurrentTick()) {
+		return osmomath.Int{}, osmomath.Int{}, types.RangeOrderNotFilledError{PositionId: positionId, CurrentTick: pool.GetCurrentTick()}
+	}
+
+	// Withdrawing the full liquidity deletes the position, and with it the range order.
+	amount0, amount1, err = k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtRangeOrderFilled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeZeroForOne, strconv.FormatBool(rangeOrder.ZeroForOne)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(position.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(position.UpperTick, 10)),
+		sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+	))
+
+	return amount0, amount1, nil
+}
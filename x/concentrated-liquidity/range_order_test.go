@@ -0,0 +1,171 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+const (
+	// Range order above the default current tick, between prices 5100 and 5200, converting ETH to USDC.
+	rangeOrderAboveLowerTick = int64(31100000)
+	rangeOrderAboveUpperTick = int64(31200000)
+	// Range order below the default current tick, between prices 4800 and 4900, converting USDC to ETH.
+	rangeOrderBelowLowerTick = int64(30800000)
+	rangeOrderBelowUpperTick = int64(30900000)
+)
+
+// TestCreatePosition_RangeOrders tests that positions created entirely above or below the current tick
+// with a single asset are recorded as range orders, and that positions in range are not.
+func (s *KeeperTestSuite) TestCreatePosition_RangeOrders() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	inRangePositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, aboveId := s.SetupPosition(pool.GetId(), s.TestAccs[1], sdk.NewCoins(DefaultCoin0), rangeOrderAboveLowerTick, rangeOrderAboveUpperTick, false)
+	_, belowId := s.SetupPosition(pool.GetId(), s.TestAccs[1], sdk.NewCoins(DefaultCoin1), rangeOrderBelowLowerTick, rangeOrderBelowUpperTick, false)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCreateRangeOrder, 2)
+
+	rangeOrder, err := s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, aboveId)
+	s.Require().NoError(err)
+	s.Require().True(rangeOrder.ZeroForOne)
+
+	rangeOrder, err = s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, belowId)
+	s.Require().NoError(err)
+	s.Require().False(rangeOrder.ZeroForOne)
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, inRangePositionId)
+	s.Require().ErrorIs(err, types.RangeOrderNotFoundError{PositionId: inRangePositionId})
+
+	expectedRangeOrders := []model.RangeOrder{{PositionId: aboveId, ZeroForOne: true}, {PositionId: belowId, ZeroForOne: false}}
+	s.Require().Equal(expectedRangeOrders, s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx).RangeOrders)
+
+	// Withdrawing a range order in full deletes it.
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, aboveId)
+	s.Require().NoError(err)
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, s.TestAccs[1], aboveId, position.Liquidity)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, aboveId)
+	s.Require().ErrorIs(err, types.RangeOrderNotFoundError{PositionId: aboveId})
+}
+
+func (s *KeeperTestSuite) TestClaimFilledRangeOrder() {
+	tests := map[string]struct {
+		// rangeOrderAbove creates the range order above the current tick with ETH, below with USDC otherwise.
+		rangeOrderAbove bool
+		// swapToPrice is the spot price the pool is swapped to before claiming, no swap if nil.
+		swapToPrice          osmomath.BigDec
+		claimInRangePosition bool
+		tokenize             bool
+		expectedError        error
+	}{
+		"range order above the current tick, filled": {
+			rangeOrderAbove: true,
+			swapToPrice:     osmomath.NewBigDec(5300),
+		},
+		"range order below the current tick, filled": {
+			rangeOrderAbove: false,
+			swapToPrice:     osmomath.NewBigDec(4700),
+		},
+		"range order above the current tick, not swapped": {
+			rangeOrderAbove: true,
+			expectedError:   types.RangeOrderNotFilledError{},
+		},
+		"range order above the current tick, partially filled": {
+			rangeOrderAbove: true,
+			swapToPrice:     osmomath.NewBigDec(5150),
+			expectedError:   types.RangeOrderNotFilledError{},
+		},
+		"range order below the current tick, price moved away": {
+			rangeOrderAbove: false,
+			swapToPrice:     osmomath.NewBigDec(5300),
+			expectedError:   types.RangeOrderNotFilledError{},
+		},
+		"position in range is not a range order": {
+			claimInRangePosition: true,
+			expectedError:        types.RangeOrderNotFoundError{},
+		},
+		"tokenized range order": {
+			rangeOrderAbove: true,
+			swapToPrice:     osmomath.NewBigDec(5300),
+			tokenize:        true,
+			expectedError:   types.TokenizedRangeOrderError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			owner, claimer := s.TestAccs[1], s.TestAccs[2]
+
+			pool := s.PrepareConcentratedPool()
+			inRangePositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+
+			rangeOrderCoin, lowerTick, upperTick := DefaultCoin1, rangeOrderBelowLowerTick, rangeOrderBelowUpperTick
+			if tc.rangeOrderAbove {
+				rangeOrderCoin, lowerTick, upperTick = DefaultCoin0, rangeOrderAboveLowerTick, rangeOrderAboveUpperTick
+			}
+			_, positionId := s.SetupPosition(pool.GetId(), owner, sdk.NewCoins(rangeOrderCoin), lowerTick, upperTick, false)
+			if tc.claimInRangePosition {
+				positionId = inRangePositionId
+			}
+
+			if !tc.swapToPrice.IsNil() {
+				// Swap enough to reach the price limit.
+				coinIn, coinOutDenom := sdk.NewCoin(USDC, DefaultAmt1.MulRaw(10)), ETH
+				if tc.swapToPrice.LT(osmomath.BigDecFromDec(DefaultCurrPrice)) {
+					coinIn, coinOutDenom = sdk.NewCoin(ETH, DefaultAmt0.MulRaw(10)), USDC
+				}
+				s.FundAcc(s.TestAccs[0], sdk.NewCoins(coinIn))
+				pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+				_, _, _, err = s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[0], pool, coinIn, coinOutDenom, pool.GetSpreadFactor(s.Ctx), tc.swapToPrice)
+				s.Require().NoError(err)
+			}
+
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			if tc.tokenize {
+				_, err := msgServer.TokenizePosition(sdk.WrapSDKContext(s.Ctx), &types.MsgTokenizePosition{PositionId: positionId, Sender: owner.String()})
+				s.Require().NoError(err)
+			}
+
+			ownerBalancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			claimerBalancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, claimer)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test, the range order is claimed on behalf of its owner.
+			response, err := msgServer.ClaimFilledRangeOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgClaimFilledRangeOrder{PositionId: positionId, Sender: claimer.String()})
+
+			if tc.expectedError != nil {
+				s.Require().ErrorAs(err, &tc.expectedError)
+				s.Require().Nil(response)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRangeOrderFilled, 1)
+
+			// The range order is fully converted to the other asset, which is paid to the owner along with the spread rewards.
+			if tc.rangeOrderAbove {
+				s.Require().True(response.Amount0.IsZero())
+				s.Require().True(response.Amount1.IsPositive())
+			} else {
+				s.Require().True(response.Amount0.IsPositive())
+				s.Require().True(response.Amount1.IsZero())
+			}
+			ownerBalancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().True(ownerBalancesAfter.AmountOf(ETH).Sub(ownerBalancesBefore.AmountOf(ETH)).GTE(response.Amount0))
+			s.Require().True(ownerBalancesAfter.AmountOf(USDC).Sub(ownerBalancesBefore.AmountOf(USDC)).GTE(response.Amount1))
+			s.Require().Equal(claimerBalancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, claimer))
+
+			// The position and its range order are deleted.
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+			_, err = s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.RangeOrderNotFoundError{PositionId: positionId})
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	clmodeltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	minttypes "github.com/osmosis-labs/osmosis/v21/x/mint/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 // preparePoolConfig defines the parameters for creating a new pool
@@ -221,6 +222,41 @@ func RandMsgCollectSpreadRewards(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ct
 	}, nil
 }
 
+// RandMsgSwapExactAmountIn swaps a random amount of a random denom of a random pool for its other denom,
+// through the pool manager. Swaps that the pool cannot fill, as it runs out of liquidity, are not generated.
+func RandMsgSwapExactAmountIn(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*poolmanagertypes.MsgSwapExactAmountIn, error) {
+	// get random pool
+	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// randomly select the token in denom, the other pool denom is the token out denom
+	tokenInIndex := sim.GetRand().Intn(len(poolDenoms))
+	tokenInDenom, tokenOutDenom := poolDenoms[tokenInIndex], poolDenoms[1-tokenInIndex]
+
+	sender, senderTokenIn, senderExists := sim.SelAddrWithDenom(ctx, tokenInDenom)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denom %s exists", tokenInDenom)
+	}
+	tokenIn := sdk.NewCoin(tokenInDenom, sim.RandPositiveInt(senderTokenIn.Amount))
+
+	// ensure that the pool can fill the swap, the pool manager charges the taker fee on top of it
+	// we must use cacheCtx when calling a mutative method within a simulator method
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.SwapExactAmountIn(cacheCtx, sender.Address, clPool, tokenIn, tokenOutDenom, osmomath.OneInt(), clPool.GetSpreadFactor(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolmanagertypes.MsgSwapExactAmountIn{
+		Sender:            sender.Address.String(),
+		Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: clPool.GetId(), TokenOutDenom: tokenOutDenom}},
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: osmomath.OneInt(),
+	}, nil
+}
+
 func RandMsgCollectIncentives(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgCollectIncentives, error) {
 	// get random pool
 	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
@@ -1478,6 +1478,83 @@ func (s *KeeperTestSuite) TestFunctional_SpreadRewards_LP() {
 	s.Require().Equal(sdk.Coins{}, collectedThree)
 }
 
+// This test interleaves swaps with partial withdrawals of one of two positions in the same range, and checks
+// that each position claims exactly the spread rewards accrued by its liquidity between each withdrawal,
+// since partial withdrawals snapshot the spread reward growth inside of the position without claiming.
+func (s *KeeperTestSuite) TestFunctional_SpreadRewards_InterleavedSwapsAndPartialWithdrawals() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+
+	var (
+		concentratedLiquidityKeeper = s.App.ConcentratedLiquidityKeeper
+		ownerA                      = s.TestAccs[0]
+		ownerB                      = s.TestAccs[1]
+	)
+
+	// Create pool with 0.2% spread factor, and two positions with the same liquidity in the same range.
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.002"))
+	s.FundAcc(ownerA, DefaultCoins)
+	s.FundAcc(ownerB, DefaultCoins)
+	positionDataA, err := concentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), ownerA, DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	positionDataB, err := concentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), ownerB, DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+
+	liquidityA := positionDataA.Liquidity
+	liquidityB := positionDataB.Liquidity
+	expectedSpreadRewardsA := sdk.NewDecCoins()
+	expectedSpreadRewardsB := sdk.NewDecCoins()
+
+	// Each step swaps, attributing the spread rewards to the positions pro rata of their current liquidity,
+	// and then withdraws half of the liquidity of position A.
+	swaps := []struct {
+		coinIn       sdk.Coin
+		coinOutDenom string
+		priceLimit   osmomath.BigDec
+	}{
+		{DefaultCoin1, ETH, types.MaxSpotPriceBigDec},
+		{DefaultCoin0, USDC, types.MinSpotPriceBigDec},
+		{DefaultCoin1, ETH, types.MaxSpotPriceBigDec},
+	}
+	for _, swap := range swaps {
+		_, spreadRewards, _, _ := s.swapAndTrackXTimesInARow(pool.GetId(), swap.coinIn, swap.coinOutDenom, swap.priceLimit, 1)
+		totalLiquidity := liquidityA.Add(liquidityB)
+		for _, spreadReward := range spreadRewards {
+			expectedSpreadRewardsA = expectedSpreadRewardsA.Add(sdk.NewDecCoinFromDec(spreadReward.Denom, spreadReward.Amount.ToLegacyDec().Mul(liquidityA).Quo(totalLiquidity)))
+			expectedSpreadRewardsB = expectedSpreadRewardsB.Add(sdk.NewDecCoinFromDec(spreadReward.Denom, spreadReward.Amount.ToLegacyDec().Mul(liquidityB).Quo(totalLiquidity)))
+		}
+
+		// The partial withdrawal only returns the liquidity withdrawn, the spread rewards are left to claim.
+		halfLiquidityA := liquidityA.QuoInt64(2)
+		balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, ownerA)
+		amtDenom0, amtDenom1, err := concentratedLiquidityKeeper.WithdrawPosition(s.Ctx, ownerA, positionDataA.ID, halfLiquidityA)
+		s.Require().NoError(err)
+		balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, ownerA)
+		s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, amtDenom0), sdk.NewCoin(USDC, amtDenom1)), balancesAfter.Sub(balancesBefore...))
+
+		liquidityA = liquidityA.Sub(halfLiquidityA)
+		position, err := concentratedLiquidityKeeper.GetPosition(s.Ctx, positionDataA.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(liquidityA, position.Liquidity)
+	}
+
+	spreadRewardsA, err := concentratedLiquidityKeeper.CollectSpreadRewards(s.Ctx, ownerA, positionDataA.ID)
+	s.Require().NoError(err)
+	spreadRewardsB, err := concentratedLiquidityKeeper.CollectSpreadRewards(s.Ctx, ownerB, positionDataB.ID)
+	s.Require().NoError(err)
+
+	// The claimed spread rewards are rounded down in favor of the pool.
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: osmomath.OneDec(), RoundingDir: osmomath.RoundDown}
+	for _, denom := range []string{ETH, USDC} {
+		osmoassert.Equal(s.T(), errTolerance, expectedSpreadRewardsA.AmountOf(denom).TruncateInt(), spreadRewardsA.AmountOf(denom))
+		osmoassert.Equal(s.T(), errTolerance, expectedSpreadRewardsB.AmountOf(denom).TruncateInt(), spreadRewardsB.AmountOf(denom))
+	}
+
+	// Nothing is left to claim.
+	s.validatePositionSpreadRewardGrowth(pool.GetId(), positionDataA.ID, cl.EmptyCoins)
+	s.validatePositionSpreadRewardGrowth(pool.GetId(), positionDataB.ID, cl.EmptyCoins)
+}
+
 // CollectAndAssertSpreadRewards collects spread rewards from a given pool for all positions and verifies that the total spread rewards collected match the expected total spread rewards.
 // The method also checks that if the ticks that were active during the swap lie within the range of a position, then the position's spread reward accumulators
 // are not empty. The total spread rewards collected are compared to the expected total spread rewards within an additive tolerance defined by an error tolerance struct.
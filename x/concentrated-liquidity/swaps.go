@@ -297,6 +297,44 @@ func (k *Keeper) swapInAmtGivenOut(
 	return tokenIn, tokenOut, poolUpdates, nil
 }
 
+// QuoteOutAmtGivenIn computes the result of swapping tokenIn for tokenOutDenom in the given pool without
+// committing any write, returning the amounts swapped, the spread rewards charged and the tick, liquidity
+// and sqrt price the pool would have after the swap.
+// It runs the same computation as swaps in a cache context, so that a quote never diverges from the execution
+// of the swap against the same state.
+// Note that passing in 0 for `priceLimit` will result in the price limit being set to the max/min value based on swap direction
+func (k Keeper) QuoteOutAmtGivenIn(
+	ctx sdk.Context,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	spreadFactor osmomath.Dec,
+	priceLimit osmomath.BigDec,
+) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.computeOutAmtGivenIn(cacheCtx, poolId, tokenIn, tokenOutDenom, spreadFactor, priceLimit)
+}
+
+// QuoteInAmtGivenOut computes the result of swapping tokenInDenom for tokenOut in the given pool without
+// committing any write, returning the amounts swapped, the spread rewards charged and the tick, liquidity
+// and sqrt price the pool would have after the swap.
+// It runs the same computation as swaps in a cache context, so that a quote never diverges from the execution
+// of the swap against the same state.
+// Note that passing in 0 for `priceLimit` will result in the price limit being set to the max/min value based on swap direction
+func (k Keeper) QuoteInAmtGivenOut(
+	ctx sdk.Context,
+	poolId uint64,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	spreadFactor osmomath.Dec,
+	priceLimit osmomath.BigDec,
+) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, spreadFactor, priceLimit, poolId)
+}
+
+// CalcOutAmtGivenIn returns the amount of tokenOutDenom received when swapping tokenIn in the given pool,
+// without mutating state.
 func (k Keeper) CalcOutAmtGivenIn(
 	ctx sdk.Context,
 	poolI poolmanagertypes.PoolI,
@@ -304,14 +342,15 @@ func (k Keeper) CalcOutAmtGivenIn(
 	tokenOutDenom string,
 	spreadFactor osmomath.Dec,
 ) (tokenOut sdk.Coin, err error) {
-	cacheCtx, _ := ctx.CacheContext()
-	swapResult, _, err := k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, osmomath.ZeroBigDec())
+	swapResult, _, err := k.QuoteOutAmtGivenIn(ctx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, osmomath.ZeroBigDec())
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 	return sdk.NewCoin(tokenOutDenom, swapResult.AmountOut), nil
 }
 
+// CalcInAmtGivenOut returns the amount of tokenInDenom required to receive tokenOut from the given pool,
+// without mutating state.
 func (k Keeper) CalcInAmtGivenOut(
 	ctx sdk.Context,
 	poolI poolmanagertypes.PoolI,
@@ -319,8 +358,7 @@ func (k Keeper) CalcInAmtGivenOut(
 	tokenInDenom string,
 	spreadFactor osmomath.Dec,
 ) (sdk.Coin, error) {
-	cacheCtx, _ := ctx.CacheContext()
-	swapResult, _, err := k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, spreadFactor, osmomath.ZeroBigDec(), poolI.GetId())
+	swapResult, _, err := k.QuoteInAmtGivenOut(ctx, poolI.GetId(), tokenOut, tokenInDenom, spreadFactor, osmomath.ZeroBigDec())
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -393,6 +431,8 @@ func (k Keeper) computeOutAmtGivenIn(
 		return SwapResult{}, PoolUpdates{}, err
 	}
 
+	tickCrossGasCost := k.getTickCrossGasCost(ctx, uptimeAccums)
+
 	// initialize swap state with the following parameters:
 	// as we iterate through the following for loop, this swap state will get updated after each required iteration
 	swapState := newSwapState(tokenInMin.Amount, p, swapStrategy)
@@ -445,7 +485,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		// bucket has been consumed and we must move on to the next bucket to complete the swap
 		if nextInitializedTickSqrtPrice.Equal(computedSqrtPrice) {
 			swapState, err = k.swapCrossTickLogic(ctx, swapState, swapStrategy,
-				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInMin.Denom)
+				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInMin.Denom, tickCrossGasCost)
 			if err != nil {
 				return SwapResult{}, PoolUpdates{}, err
 			}
@@ -521,6 +561,8 @@ func (k Keeper) computeInAmtGivenOut(
 		return SwapResult{}, PoolUpdates{}, err
 	}
 
+	tickCrossGasCost := k.getTickCrossGasCost(ctx, uptimeAccums)
+
 	// initialize swap state with the following parameters:
 	// as we iterate through the following for loop, this swap state will get updated after each required iteration
 	swapState := newSwapState(desiredTokenOut.Amount, p, swapStrategy)
@@ -568,7 +610,7 @@ func (k Keeper) computeInAmtGivenOut(
 		// bucket has been consumed and we must move on to the next bucket by crossing a tick to complete the swap
 		if nextInitializedTickSqrtPrice.Equal(computedSqrtPrice) {
 			swapState, err = k.swapCrossTickLogic(ctx, swapState, swapStrategy,
-				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInDenom)
+				nextInitializedTick, nextInitTickIter, p, spreadRewardAccumulator, uptimeAccums, tokenInDenom, tickCrossGasCost)
 			if err != nil {
 				return SwapResult{}, PoolUpdates{}, err
 			}
@@ -630,13 +672,23 @@ func emitSwapDebugLogs(ctx sdk.Context, swapState SwapState, reachedPrice osmoma
 	ctx.Logger().Debug("spreadRewardChargeTotal", spreadCharge)
 }
 
+// getTickCrossGasCost returns the gas consumed by a swap for every tick it crosses: the tick cross gas cost
+// plus the accumulator update gas cost of the spread reward accumulator and of each uptime accumulator.
+func (k Keeper) getTickCrossGasCost(ctx sdk.Context, uptimeAccums []*accum.AccumulatorObject) uint64 {
+	params := k.GetParams(ctx)
+	return params.TickCrossGasCost + params.AccumulatorUpdateGasCost*uint64(1+len(uptimeAccums))
+}
+
 // logic for crossing a tick during a swap
 func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 	swapState SwapState, strategy swapstrategy.SwapStrategy,
 	nextInitializedTick int64, nextTickIter db.Iterator,
 	p types.ConcentratedPoolExtension,
 	spreadRewardAccum *accum.AccumulatorObject, uptimeAccums []*accum.AccumulatorObject,
-	tokenInDenom string) (SwapState, error) {
+	tokenInDenom string, tickCrossGasCost uint64) (SwapState, error) {
+	// Gas consumption per tick crossed, so that the gas of a swap correlates with the number of ticks it crosses.
+	ctx.GasMeter().ConsumeGas(tickCrossGasCost, "cl tick cross")
+
 	nextInitializedTickInfo, err := ParseTickFromBz(nextTickIter.Value())
 	if err != nil {
 		return swapState, err
@@ -787,6 +839,9 @@ func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.Concentrat
 	if err != nil {
 		return p, err
 	}
+	if k.IsPoolWithdrawOnly(ctx, poolId) {
+		return p, types.PoolWithdrawOnlyError{PoolId: poolId}
+	}
 	hasPositionInPool, err := k.HasAnyPositionForPool(ctx, poolId)
 	if err != nil {
 		return p, err
@@ -2706,6 +2706,78 @@ func (s *KeeperTestSuite) TestCalcOutAmtGivenIn_NonMutative() {
 	}
 }
 
+// TestQuoteOutAmtGivenIn_MatchesSwap tests that QuoteOutAmtGivenIn is non-mutative and
+// returns the same result as executing the swap against the same state.
+func (s *KeeperTestSuite) TestQuoteOutAmtGivenIn_MatchesSwap() {
+	tests := makeTests(swapOutGivenInCases, swapOutGivenInSpreadRewardCases)
+	for name, test := range tests {
+		test := test
+		s.Run(name, func() {
+			s.SetupAndFundSwapTest()
+			poolBeforeCalc := s.preparePoolAndDefaultPositions(test)
+
+			// perform quote
+			swapResult, quotedPoolUpdates, err := s.App.ConcentratedLiquidityKeeper.QuoteOutAmtGivenIn(
+				s.Ctx,
+				poolBeforeCalc.GetId(),
+				test.TokenIn, test.TokenOutDenom,
+				test.SpreadFactor, test.PriceLimit)
+			s.Require().NoError(err)
+
+			// check that the pool has not been modified after performing quote
+			s.assertPoolNotModified(poolBeforeCalc)
+			s.assertZeroSpreadRewards(poolBeforeCalc.GetId())
+
+			// perform swap
+			tokenIn, tokenOut, poolUpdates, err := s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(
+				s.Ctx, s.TestAccs[0], poolBeforeCalc,
+				test.TokenIn, test.TokenOutDenom,
+				test.SpreadFactor, test.PriceLimit)
+			s.Require().NoError(err)
+
+			s.Require().Equal(tokenIn.Amount, swapResult.AmountIn)
+			s.Require().Equal(tokenOut.Amount, swapResult.AmountOut)
+			s.Require().Equal(poolUpdates, quotedPoolUpdates)
+		})
+	}
+}
+
+// TestQuoteInAmtGivenOut_MatchesSwap tests that QuoteInAmtGivenOut is non-mutative and
+// returns the same result as executing the swap against the same state.
+func (s *KeeperTestSuite) TestQuoteInAmtGivenOut_MatchesSwap() {
+	tests := makeTests(swapInGivenOutTestCases, swapInGivenOutSpreadRewardTestCases)
+	for name, test := range tests {
+		test := test
+		s.Run(name, func() {
+			s.SetupAndFundSwapTest()
+			poolBeforeCalc := s.preparePoolAndDefaultPositions(test)
+
+			// perform quote
+			swapResult, quotedPoolUpdates, err := s.App.ConcentratedLiquidityKeeper.QuoteInAmtGivenOut(
+				s.Ctx,
+				poolBeforeCalc.GetId(),
+				test.TokenOut, test.TokenInDenom,
+				test.SpreadFactor, test.PriceLimit)
+			s.Require().NoError(err)
+
+			// check that the pool has not been modified after performing quote
+			s.assertPoolNotModified(poolBeforeCalc)
+			s.assertZeroSpreadRewards(poolBeforeCalc.GetId())
+
+			// perform swap
+			tokenIn, tokenOut, poolUpdates, err := s.App.ConcentratedLiquidityKeeper.SwapInAmtGivenOut(
+				s.Ctx, s.TestAccs[0], poolBeforeCalc,
+				test.TokenOut, test.TokenInDenom,
+				test.SpreadFactor, test.PriceLimit)
+			s.Require().NoError(err)
+
+			s.Require().Equal(tokenIn.Amount, swapResult.AmountIn)
+			s.Require().Equal(tokenOut.Amount, swapResult.AmountOut)
+			s.Require().Equal(poolUpdates, quotedPoolUpdates)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) SetupSecondPosition(test apptesting.ConcentratedSwapTest, pool types.ConcentratedPoolExtension) {
 	if !test.SecondPositionLowerPrice.IsNil() {
 		newLowerTick, newUpperTick := s.LowerUpperPricesToTick(test.SecondPositionLowerPrice, test.SecondPositionUpperPrice, pool.GetTickSpacing())
@@ -1551,3 +1551,52 @@ func (s *KeeperTestSuite) TestSwapOutGivenIn_GetLiquidityFromAmountsPositionBoun
 		s.Ctx = setupCtx
 	})
 }
+
+// TestSwap_TickCrossGasCost validates that a swap consumes the tick cross gas cost, and the accumulator update
+// gas cost of every accumulator of the pool, for each tick it crosses.
+func (s *KeeperTestSuite) TestSwap_TickCrossGasCost() {
+	s.SetupTest()
+	poolId, _ := s.setupPoolAndPositions(tickSpacing100, defaultTickSpacingsAway, DefaultCoins)
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	// Swap past the lower ticks of all the narrow range positions.
+	amountIn, _, _ := s.computeSwapAmounts(poolId, osmomath.BigDec{}, -450, true, false)
+	tokenIn := sdk.NewCoin(pool.GetToken0(), amountIn.Ceil().TruncateInt())
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+
+	// swapGasAndTicksCrossed swaps tokenIn in a branch of the state with the given gas costs,
+	// returning the gas consumed by the swap and the number of ticks crossed.
+	swapGasAndTicksCrossed := func(tickCrossGasCost, accumulatorUpdateGasCost uint64) (uint64, int) {
+		ctx, _ := s.Ctx.CacheContext()
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+		params := s.App.ConcentratedLiquidityKeeper.GetParams(ctx)
+		params.TickCrossGasCost = tickCrossGasCost
+		params.AccumulatorUpdateGasCost = accumulatorUpdateGasCost
+		s.App.ConcentratedLiquidityKeeper.SetParams(ctx, params)
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+		_, err := s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(ctx, s.TestAccs[0], pool, tokenIn, pool.GetToken1(), osmomath.ZeroInt(), osmomath.ZeroDec())
+		s.Require().NoError(err)
+		gasConsumed := ctx.GasMeter().GasConsumed() - gasBefore
+
+		ticksCrossed := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.TypeEvtCrossTick {
+				ticksCrossed++
+			}
+		}
+		return gasConsumed, ticksCrossed
+	}
+
+	// The gas costs are compared with costs of the same varint encoded length, as reading the params consumes gas per byte.
+	gasWithLowCost, ticksCrossed := swapGasAndTicksCrossed(1_000, 200)
+	s.Require().GreaterOrEqual(ticksCrossed, len(defaultTickSpacingsAway))
+
+	gasWithHighCost, ticksCrossedWithHighCost := swapGasAndTicksCrossed(5_000, 600)
+	s.Require().Equal(ticksCrossed, ticksCrossedWithHighCost)
+
+	// The spread reward accumulator and each uptime accumulator are updated per tick crossed.
+	numAccumulators := uint64(1 + len(types.SupportedUptimes))
+	expectedGasPerTick := (5_000 - 1_000) + (600-200)*numAccumulators
+	s.Require().Equal(uint64(ticksCrossed)*expectedGasPerTick, gasWithHighCost-gasWithLowCost)
+}
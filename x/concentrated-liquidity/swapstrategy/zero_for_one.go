@@ -64,7 +64,7 @@ func (s zeroForOneStrategy) ComputeSwapWithinBucketOutGivenIn(sqrtPriceCurrent,
 	amountZeroInRemainingBigDec := osmomath.BigDecFromDec(amountZeroInRemaining)
 
 	// Estimate the amount of token zero needed until the target sqrt price is reached.
-	amountZeroIn := math.CalcAmount0Delta(liquidityBigDec, sqrtPriceTarget, sqrtPriceCurrent, true) // N.B.: if this is false, causes infinite loop
+	amountZeroIn := math.CalcAmount0DeltaRoundingUp(liquidityBigDec, sqrtPriceTarget, sqrtPriceCurrent) // N.B.: if this rounds down, causes infinite loop
 
 	// Calculate sqrtPriceNext on the amount of token remaining after spread reward.
 	amountZeroInRemainingLessSpreadReward := amountZeroInRemainingBigDec.Mul(oneBigDec.Sub(osmomath.BigDecFromDec(s.spreadFactor)))
@@ -85,11 +85,11 @@ func (s zeroForOneStrategy) ComputeSwapWithinBucketOutGivenIn(sqrtPriceCurrent,
 	// to complete the swap step. This implies that some of the amount remaining after spread reward is left over after the
 	// current swap step.
 	if !hasReachedTarget {
-		amountZeroIn = math.CalcAmount0Delta(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent, true) // N.B.: if this is false, causes infinite loop
+		amountZeroIn = math.CalcAmount0DeltaRoundingUp(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent) // N.B.: if this rounds down, causes infinite loop
 	}
 
 	// Calculate the amount of the other token given the sqrt price range.
-	amountOneOut := math.CalcAmount1Delta(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent, false)
+	amountOneOut := math.CalcAmount1DeltaRoundingDown(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent)
 
 	// Round up to charge user more in pool's favor.
 	amountZeroInFinal := amountZeroIn.DecRoundUp()
@@ -127,7 +127,7 @@ func (s zeroForOneStrategy) ComputeSwapWithinBucketInGivenOut(sqrtPriceCurrent,
 	amountOneRemainingOutBigDec := osmomath.BigDecFromDec(amountOneRemainingOut)
 
 	// Estimate the amount of token one needed until the target sqrt price is reached.
-	amountOneOut := math.CalcAmount1Delta(liquidityBigDec, sqrtPriceTarget, sqrtPriceCurrent, false)
+	amountOneOut := math.CalcAmount1DeltaRoundingDown(liquidityBigDec, sqrtPriceTarget, sqrtPriceCurrent)
 
 	// Calculate sqrtPriceNext on the amount of token remaining. Note that the
 	// spread reward is not charged as amountRemaining is amountOut, and we only charge spread reward on
@@ -148,11 +148,11 @@ func (s zeroForOneStrategy) ComputeSwapWithinBucketInGivenOut(sqrtPriceCurrent,
 	// to complete the swap step. This implies that some of the amount remaining after spread reward is left over after the
 	// current swap step.
 	if !hasReachedTarget {
-		amountOneOut = math.CalcAmount1Delta(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent, false)
+		amountOneOut = math.CalcAmount1DeltaRoundingDown(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent)
 	}
 
 	// Calculate the amount of the other token given the sqrt price range.
-	amountZeroIn := math.CalcAmount0Delta(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent, true)
+	amountZeroIn := math.CalcAmount0DeltaRoundingUp(liquidityBigDec, sqrtPriceNext, sqrtPriceCurrent)
 
 	// Round up to charge user more in pool's favor.
 	amountZeroInFinal := amountZeroIn.DecRoundUp()
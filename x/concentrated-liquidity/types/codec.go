@@ -19,10 +19,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgSetPoolsWithdrawOnlyMode{}, "osmosis/cl-set-withdraw-only", nil)
+	cdc.RegisterConcrete(&MsgTokenizePosition{}, "osmosis/cl-tokenize-position", nil)
+	cdc.RegisterConcrete(&MsgDetokenizePosition{}, "osmosis/cl-detokenize-position", nil)
+	cdc.RegisterConcrete(&MsgClaimFilledRangeOrder{}, "osmosis/cl-claim-filled-range-order", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "osmosis/cl-update-params", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&SetPoolsWithdrawOnlyModeProposal{}, "osmosis/cl-withdraw-only-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -39,12 +45,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgSetPoolsWithdrawOnlyMode{},
+		&MsgTokenizePosition{},
+		&MsgDetokenizePosition{},
+		&MsgClaimFilledRangeOrder{},
+		&MsgUpdateParams{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypesv1.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&SetPoolsWithdrawOnlyModeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -59,6 +59,8 @@ var (
 	// By default, we only authorize one nanosecond (one block) uptime as an option
 	DefaultAuthorizedUptimes                = []time.Duration{time.Nanosecond}
 	DefaultUnrestrictedPoolCreatorWhitelist = []string{}
+	// By default, only governance can set the withdraw-only mode of pools.
+	DefaultWithdrawOnlyModeEmergencyWhitelist = []string{}
 	// This is a (very generous) gas limit intended to protect against CL hooks that are
 	// executed with malicious intent in begin block code.
 	//
@@ -69,4 +71,12 @@ var (
 	// 2M gas is enough to execute tens of expensive CL operations and is only set this high
 	// to accommodate position withdrawals, which are unusually expensive.
 	DefaultContractHookGasLimit = uint64(2_000_000)
+	// By default, the minimum position liquidity check is disabled. Governance is expected
+	// to raise it to a value that makes spamming dust positions economically unviable.
+	DefaultMinPositionLiquidity = osmomath.ZeroDec()
+	// A tick crossing reads and writes the tick info and flips its trackers of every accumulator
+	// of the pool, on top of the fixed gas of the swap. With the default 6 authorized uptimes,
+	// crossing a tick costs 8_500 gas, so that a swap crossing hundreds of ticks pays millions of gas.
+	DefaultTickCrossGasCost         = uint64(5_000)
+	DefaultAccumulatorUpdateGasCost = uint64(500)
 )
@@ -139,6 +139,16 @@ func (e InsufficientLiquidityCreatedError) Error() string {
 	return fmt.Sprintf("slippage bound: insufficient amount of token %d created. Actual: (%s). Minimum estimated: (%s)", tokenNum, e.Actual, e.Minimum)
 }
 
+type PositionLiquidityBelowMinimumError struct {
+	PoolId       uint64
+	Liquidity    osmomath.Dec
+	MinLiquidity osmomath.Dec
+}
+
+func (e PositionLiquidityBelowMinimumError) Error() string {
+	return fmt.Sprintf("position liquidity (%s) in pool (%d) is below the minimum position liquidity (%s)", e.Liquidity, e.PoolId, e.MinLiquidity)
+}
+
 type NegativeLiquidityError struct {
 	Liquidity osmomath.Dec
 }
@@ -930,3 +940,59 @@ type InvalidActionPrefixError struct {
 func (e InvalidActionPrefixError) Error() string {
 	return fmt.Sprintf("invalid action prefix (%s). Valid actions: %s", e.ActionPrefix, e.ValidActions)
 }
+
+type PoolWithdrawOnlyError struct {
+	PoolId uint64
+}
+
+func (e PoolWithdrawOnlyError) Error() string {
+	return fmt.Sprintf("pool %d is in withdraw-only mode, swaps and new positions are disabled", e.PoolId)
+}
+
+type NotWithdrawOnlyModeEmergencyAddressError struct {
+	Sender string
+}
+
+func (e NotWithdrawOnlyModeEmergencyAddressError) Error() string {
+	return fmt.Sprintf("address %s is not in the withdraw-only mode emergency whitelist", e.Sender)
+}
+
+type PositionTokenNotHeldError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e PositionTokenNotHeldError) Error() string {
+	return fmt.Sprintf("address %s does not hold the token of position %d", e.Address, e.PositionId)
+}
+
+type TransferToPositionEscrowError struct{}
+
+func (e TransferToPositionEscrowError) Error() string {
+	return "positions cannot be transferred to the position escrow, tokenize them with MsgTokenizePosition instead"
+}
+
+type RangeOrderNotFoundError struct {
+	PositionId uint64
+}
+
+func (e RangeOrderNotFoundError) Error() string {
+	return fmt.Sprintf("position %d is not a range order", e.PositionId)
+}
+
+type RangeOrderNotFilledError struct {
+	PositionId  uint64
+	CurrentTick int64
+}
+
+func (e RangeOrderNotFilledError) Error() string {
+	return fmt.Sprintf("range order %d is not filled at current tick %d", e.PositionId, e.CurrentTick)
+}
+
+type TokenizedRangeOrderError struct {
+	PositionId uint64
+}
+
+func (e TokenizedRangeOrderError) Error() string {
+	return fmt.Sprintf("range order %d is tokenized, it must be detokenized to be claimed", e.PositionId)
+}
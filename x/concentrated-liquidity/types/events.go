@@ -13,6 +13,11 @@ const (
 	TypeEvtMoveRewards               = "move_rewards"
 	TypeEvtCrossTick                 = "cross_tick"
 	TypeEvtTransferPositions         = "transfer_positions"
+	TypeEvtSetPoolWithdrawOnlyMode   = "set_pool_withdraw_only_mode"
+	TypeEvtTokenizePosition          = "tokenize_position"
+	TypeEvtDetokenizePosition        = "detokenize_position"
+	TypeEvtCreateRangeOrder          = "create_range_order"
+	TypeEvtRangeOrderFilled          = "range_order_filled"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -48,4 +53,8 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeWithdrawOnly                                          = "withdraw_only"
+	AttributePositionDenom                                         = "position_denom"
+	AttributeOwner                                                 = "owner"
+	AttributeZeroForOne                                            = "zero_for_one"
 )
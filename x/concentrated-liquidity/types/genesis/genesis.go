@@ -1,6 +1,8 @@
 package genesis
 
 import (
+	"fmt"
+
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 )
 
@@ -25,5 +27,19 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	seenWithdrawOnlyPoolIds := map[uint64]struct{}{}
+	for _, poolId := range gs.WithdrawOnlyPoolIds {
+		if _, ok := seenWithdrawOnlyPoolIds[poolId]; ok {
+			return fmt.Errorf("duplicate withdraw-only pool id %d", poolId)
+		}
+		seenWithdrawOnlyPoolIds[poolId] = struct{}{}
+	}
+	seenRangeOrderPositionIds := map[uint64]struct{}{}
+	for _, rangeOrder := range gs.RangeOrders {
+		if _, ok := seenRangeOrderPositionIds[rangeOrder.PositionId]; ok {
+			return fmt.Errorf("duplicate range order position id %d", rangeOrder.PositionId)
+		}
+		seenRangeOrderPositionIds[rangeOrder.PositionId] = struct{}{}
+	}
 	return nil
 }
@@ -252,6 +252,11 @@ type GenesisState struct {
 	PositionData          []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
 	NextPositionId        uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
 	NextIncentiveRecordId uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	// withdraw_only_pool_ids are the ids of the pools in withdraw-only mode.
+	WithdrawOnlyPoolIds []uint64 `protobuf:"varint,6,rep,packed,name=withdraw_only_pool_ids,json=withdrawOnlyPoolIds,proto3" json:"withdraw_only_pool_ids,omitempty" yaml:"withdraw_only_pool_ids"`
+	// range_orders are the positions created as range orders that are not yet
+	// claimed.
+	RangeOrders []model.RangeOrder `protobuf:"bytes,7,rep,name=range_orders,json=rangeOrders,proto3" json:"range_orders" yaml:"range_orders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -322,6 +327,20 @@ func (m *GenesisState) GetNextIncentiveRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetWithdrawOnlyPoolIds() []uint64 {
+	if m != nil {
+		return m.WithdrawOnlyPoolIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRangeOrders() []model.RangeOrder {
+	if m != nil {
+		return m.RangeOrders
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -388,62 +407,67 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0xee, 0x34, 0x69, 0x69, 0x9d, 0xec, 0xd2, 0xb5, 0xba, 0x74, 0xb6, 0x68, 0x93, 0xe0, 0x55,
-	0xa5, 0x02, 0xea, 0x8c, 0x9a, 0xae, 0x38, 0x20, 0x2e, 0x9d, 0xe5, 0x43, 0x01, 0x09, 0x2a, 0xb3,
-	0x5c, 0xf8, 0x0a, 0xce, 0xd8, 0x0d, 0x66, 0x67, 0xc6, 0xc3, 0xd8, 0x29, 0xcd, 0x95, 0x5f, 0x80,
-	0x38, 0xf1, 0x23, 0x38, 0x22, 0x71, 0xe6, 0xb6, 0x42, 0x1c, 0xf6, 0xc8, 0x29, 0x42, 0xed, 0x3f,
-	0xc8, 0x2f, 0x40, 0xe3, 0x8f, 0x64, 0x52, 0xba, 0x90, 0x72, 0xb3, 0xf3, 0xbc, 0xcf, 0xf3, 0x3e,
-	0xf6, 0xfb, 0xbe, 0x9e, 0x80, 0x23, 0x21, 0x53, 0x21, 0xb9, 0x0c, 0x63, 0x91, 0xc5, 0x2c, 0x53,
-	0x05, 0x51, 0x8c, 0x26, 0xfc, 0xdb, 0x11, 0xa7, 0x5c, 0x8d, 0xc3, 0xb3, 0xc3, 0x01, 0x53, 0xe4,
-	0x30, 0x1c, 0xb2, 0x8c, 0x49, 0x2e, 0x83, 0xbc, 0x10, 0x4a, 0xc0, 0x3d, 0x4b, 0x0a, 0xae, 0x25,
-	0x05, 0x96, 0xb4, 0xbb, 0x3d, 0x14, 0x43, 0xa1, 0x19, 0x61, 0xb9, 0x32, 0xe4, 0xdd, 0x7b, 0xb1,
-	0x66, 0xf7, 0x0d, 0x60, 0x36, 0x16, 0x6a, 0x99, 0x5d, 0x38, 0x20, 0x92, 0xcd, 0x52, 0xc7, 0x82,
-	0x67, 0x8e, 0x3a, 0x14, 0x62, 0x98, 0xb0, 0x50, 0xef, 0x06, 0xa3, 0xd3, 0x90, 0x64, 0x63, 0x0b,
-	0xbd, 0xe2, 0xce, 0x41, 0xe2, 0x78, 0x94, 0xce, 0xc8, 0x7a, 0x67, 0x43, 0x5e, 0xfb, 0xf7, 0xa3,
-	0xe6, 0xa4, 0x20, 0xa9, 0x73, 0xf2, 0x70, 0xb9, 0x6b, 0xc9, 0x85, 0xe4, 0x8a, 0x8b, 0xec, 0x66,
-	0x2c, 0xc5, 0xe3, 0x27, 0xbd, 0xec, 0xd4, 0x5d, 0xc8, 0x5b, 0xcb, 0xb1, 0xb8, 0x06, 0xf9, 0x19,
-	0xeb, 0x17, 0x2c, 0x16, 0x05, 0x35, 0x6c, 0xf4, 0x87, 0x07, 0x36, 0xde, 0x1d, 0x25, 0xc9, 0x63,
-	0x1e, 0x3f, 0x81, 0xaf, 0x83, 0x17, 0x72, 0x21, 0x92, 0x3e, 0xa7, 0xbe, 0xd7, 0xf1, 0xf6, 0xeb,
-	0x11, 0x9c, 0x4e, 0xda, 0xb7, 0xc7, 0x24, 0x4d, 0xde, 0x44, 0x16, 0x40, 0x78, 0xbd, 0x5c, 0xf5,
-	0x28, 0x7c, 0x08, 0x40, 0xe9, 0xa4, 0xcf, 0x33, 0xca, 0xce, 0xfd, 0xd5, 0x8e, 0xb7, 0x5f, 0x8b,
-	0xee, 0x4e, 0x27, 0xed, 0x3b, 0x26, 0x7e, 0x8e, 0x21, 0xbc, 0x69, 0x2c, 0x53, 0x76, 0x0e, 0xbf,
-	0x00, 0x75, 0x9e, 0x9d, 0x0a, 0xbf, 0xd6, 0xf1, 0xf6, 0x1b, 0xdd, 0x30, 0x58, 0xaa, 0x15, 0x82,
-	0xc7, 0xf6, 0xc8, 0x91, 0xff, 0x74, 0xd2, 0x5e, 0x99, 0x4e, 0xda, 0x5b, 0x0b, 0x49, 0x4e, 0x05,
-	0xc2, 0x5a, 0x16, 0xfd, 0x5a, 0x07, 0x1b, 0x27, 0x42, 0x24, 0x6f, 0x13, 0x45, 0xe0, 0x11, 0xa8,
-	0x97, 0x5e, 0xf5, 0x59, 0x1a, 0xdd, 0xed, 0xc0, 0x94, 0x3f, 0x70, 0xe5, 0x0f, 0x8e, 0xb3, 0x71,
-	0xb4, 0xf9, 0xfb, 0x2f, 0x07, 0x6b, 0x25, 0xa3, 0x87, 0x75, 0x30, 0xfc, 0x0c, 0xac, 0x95, 0xaa,
-	0xd2, 0x5f, 0xed, 0xd4, 0x6e, 0xe0, 0xd0, 0xdd, 0x61, 0xb4, 0x6d, 0x1d, 0x36, 0xe7, 0x0e, 0x25,
-	0xc2, 0x46, 0x13, 0xfe, 0xe4, 0x81, 0x7b, 0x32, 0x2f, 0x18, 0xa1, 0xfd, 0x82, 0x7d, 0x47, 0x0a,
-	0xda, 0xd7, 0x1d, 0x36, 0x4a, 0x88, 0x12, 0x85, 0xbd, 0x93, 0xee, 0x92, 0x19, 0x8f, 0x4b, 0xe6,
-	0x47, 0x83, 0x6f, 0x58, 0xac, 0xa2, 0x7d, 0x9b, 0xb4, 0x63, 0x92, 0x3e, 0x37, 0x05, 0xc2, 0x3b,
-	0x06, 0xc3, 0x1a, 0x3a, 0x9e, 0x23, 0xf0, 0x47, 0x0f, 0xec, 0xcc, 0x7a, 0x44, 0x56, 0x49, 0xd2,
-	0xaf, 0xeb, 0xab, 0xf8, 0x3f, 0xc6, 0xf6, 0xac, 0xb1, 0xfb, 0xc6, 0xd8, 0xf5, 0x09, 0x10, 0x7e,
-	0x69, 0x0e, 0x54, 0x3c, 0x49, 0xc8, 0xc1, 0x9d, 0xab, 0x7d, 0x2b, 0xfd, 0x35, 0xed, 0xe6, 0x8d,
-	0x25, 0xdd, 0xf4, 0x1c, 0x1f, 0x6b, 0x7a, 0x54, 0x2f, 0x1d, 0xe1, 0x2d, 0xbe, 0xf8, 0xb3, 0x44,
-	0xbf, 0xad, 0x82, 0xe6, 0x89, 0x9d, 0x47, 0xdd, 0x3d, 0x1f, 0x80, 0x0d, 0x37, 0x9f, 0xb6, 0x83,
-	0x96, 0xed, 0x05, 0x27, 0x83, 0x67, 0x02, 0xe5, 0x64, 0x25, 0xa2, 0xec, 0x55, 0xaa, 0x27, 0x65,
-	0x61, 0xb2, 0x2c, 0x80, 0xf0, 0x7a, 0xb9, 0xea, 0x51, 0xf8, 0x15, 0xd8, 0xbd, 0xa6, 0x82, 0xf6,
-	0xfc, 0xb6, 0x4b, 0xee, 0xcf, 0xbc, 0x98, 0x37, 0xca, 0xe5, 0x5e, 0x38, 0xe5, 0x3f, 0x8b, 0x6d,
-	0x60, 0xf8, 0x09, 0xd8, 0x1e, 0xe5, 0x8a, 0xa7, 0x6c, 0x41, 0xda, 0x15, 0x7a, 0x29, 0x6d, 0x68,
-	0x04, 0x2a, 0xaa, 0x12, 0xfd, 0x5c, 0x03, 0xcd, 0xf7, 0xcc, 0x53, 0xff, 0xb1, 0x22, 0x8a, 0xc1,
-	0x47, 0x60, 0xdd, 0xbc, 0x8b, 0xf6, 0x06, 0xf7, 0xfe, 0xe3, 0x06, 0x4f, 0x74, 0xb0, 0xcd, 0x60,
-	0xa9, 0x10, 0x83, 0x4d, 0xfd, 0xf8, 0x50, 0xa2, 0xc8, 0x0d, 0xa7, 0xd2, 0x3d, 0x05, 0x56, 0x71,
-	0x23, 0x77, 0x4f, 0xc3, 0x97, 0xe0, 0x96, 0xab, 0x8d, 0xd1, 0xad, 0x69, 0xdd, 0xa3, 0x1b, 0x56,
-	0xb8, 0xa2, 0xdd, 0xcc, 0xab, 0xcd, 0xf3, 0x0e, 0xd8, 0xca, 0xd8, 0xb9, 0xea, 0xcf, 0x92, 0x70,
-	0xea, 0xd7, 0x75, 0xe1, 0x5f, 0x9e, 0x4e, 0xda, 0x3b, 0xa6, 0xf0, 0x57, 0x23, 0x10, 0xbe, 0x5d,
-	0xfe, 0xe4, 0xc4, 0x7b, 0x14, 0x7e, 0x0e, 0x7c, 0x1d, 0x74, 0x75, 0x08, 0x4a, 0xb9, 0x35, 0x2d,
-	0xf7, 0x60, 0x3a, 0x69, 0xb7, 0x2b, 0x72, 0xd7, 0x44, 0x22, 0x7c, 0xb7, 0x84, 0xae, 0x0c, 0x42,
-	0x8f, 0xa2, 0xef, 0x3d, 0xd0, 0xa8, 0x0c, 0x2b, 0x7c, 0x00, 0xea, 0x19, 0x49, 0x99, 0xae, 0xd5,
-	0x66, 0xf4, 0xe2, 0x74, 0xd2, 0x6e, 0x58, 0x65, 0x92, 0x32, 0x84, 0x35, 0x08, 0x3f, 0x04, 0xb7,
-	0x4c, 0xcf, 0xc4, 0x22, 0x53, 0x2c, 0x53, 0xba, 0x9f, 0x1b, 0xdd, 0x57, 0x9f, 0xd3, 0x33, 0x95,
-	0x71, 0x7e, 0x64, 0x08, 0xb8, 0xa9, 0x23, 0xec, 0x2e, 0xa2, 0x4f, 0x2f, 0x5a, 0xde, 0xb3, 0x8b,
-	0x96, 0xf7, 0xd7, 0x45, 0xcb, 0xfb, 0xe1, 0xb2, 0xb5, 0xf2, 0xec, 0xb2, 0xb5, 0xf2, 0xe7, 0x65,
-	0x6b, 0xe5, 0xd3, 0xf7, 0x87, 0x5c, 0x7d, 0x3d, 0x1a, 0x04, 0xb1, 0x48, 0x43, 0x2b, 0x7e, 0x90,
-	0x90, 0x81, 0x74, 0x9b, 0xf0, 0xac, 0x7b, 0x18, 0x9e, 0x2f, 0x7c, 0xf6, 0x0e, 0xe6, 0xdf, 0x3d,
-	0x35, 0xce, 0x99, 0x74, 0x7f, 0x3c, 0x06, 0xeb, 0xfa, 0xd1, 0x3f, 0xfa, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0x78, 0x6e, 0x5f, 0x4a, 0xb0, 0x08, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0x1b, 0x37, 0x19, 0xbb, 0x25, 0x9d, 0xa6, 0xcd, 0x36, 0x55, 0x6d, 0x77, 0xaa,
+	0x48, 0x06, 0x14, 0xaf, 0xe2, 0x54, 0x1c, 0x10, 0x97, 0x6c, 0xf9, 0x90, 0x41, 0x22, 0xd1, 0x50,
+	0x38, 0xf0, 0xb5, 0x8c, 0x77, 0x26, 0xee, 0xd0, 0xf5, 0x8e, 0xbb, 0x33, 0x4e, 0xe2, 0x2b, 0xbf,
+	0x00, 0x71, 0xe2, 0x87, 0x20, 0x71, 0xe6, 0x56, 0x21, 0x0e, 0x3d, 0x72, 0xb2, 0x50, 0xf2, 0x0f,
+	0x7c, 0xe1, 0x8a, 0x76, 0x66, 0xd6, 0x5f, 0xb8, 0xe0, 0xf4, 0xb6, 0xe3, 0xf7, 0x7d, 0x9e, 0xf7,
+	0x99, 0xf7, 0x6b, 0x0c, 0x0e, 0x84, 0xec, 0x0a, 0xc9, 0xa5, 0x1f, 0x89, 0x24, 0x62, 0x89, 0x4a,
+	0x89, 0x62, 0x34, 0xe6, 0xcf, 0xfb, 0x9c, 0x72, 0x35, 0xf0, 0x4f, 0xf7, 0xdb, 0x4c, 0x91, 0x7d,
+	0xbf, 0xc3, 0x12, 0x26, 0xb9, 0x6c, 0xf4, 0x52, 0xa1, 0x04, 0xdc, 0xb5, 0xa0, 0xc6, 0x42, 0x50,
+	0xc3, 0x82, 0x76, 0xb6, 0x3a, 0xa2, 0x23, 0x34, 0xc2, 0xcf, 0xbe, 0x0c, 0x78, 0xe7, 0x6e, 0xa4,
+	0xd1, 0xa1, 0x31, 0x98, 0x83, 0x35, 0x55, 0xcc, 0xc9, 0x6f, 0x13, 0xc9, 0xc6, 0xa1, 0x23, 0xc1,
+	0x93, 0x1c, 0xda, 0x11, 0xa2, 0x13, 0x33, 0x5f, 0x9f, 0xda, 0xfd, 0x13, 0x9f, 0x24, 0x03, 0x6b,
+	0x7a, 0x90, 0xdf, 0x83, 0x44, 0x51, 0xbf, 0x3b, 0x06, 0xeb, 0x93, 0x75, 0x79, 0xeb, 0xbf, 0xaf,
+	0xda, 0x23, 0x29, 0xe9, 0xe6, 0x4a, 0x1e, 0x2d, 0x97, 0x96, 0x9e, 0x90, 0x5c, 0x71, 0x91, 0x5c,
+	0x0d, 0xa5, 0x78, 0xf4, 0xac, 0x95, 0x9c, 0xe4, 0x09, 0x79, 0x6f, 0x39, 0x14, 0xd7, 0x46, 0x7e,
+	0xca, 0xc2, 0x94, 0x45, 0x22, 0xa5, 0x06, 0x8d, 0xfe, 0x70, 0xc0, 0xfa, 0x87, 0xfd, 0x38, 0x7e,
+	0xc2, 0xa3, 0x67, 0xf0, 0x6d, 0x70, 0xad, 0x27, 0x44, 0x1c, 0x72, 0xea, 0x39, 0x35, 0xa7, 0xee,
+	0x06, 0x70, 0x34, 0xac, 0xde, 0x18, 0x90, 0x6e, 0xfc, 0x2e, 0xb2, 0x06, 0x84, 0x8b, 0xd9, 0x57,
+	0x8b, 0xc2, 0x47, 0x00, 0x64, 0x4a, 0x42, 0x9e, 0x50, 0x76, 0xee, 0xad, 0xd6, 0x9c, 0x7a, 0x21,
+	0xb8, 0x3d, 0x1a, 0x56, 0x6f, 0x1a, 0xff, 0x89, 0x0d, 0xe1, 0x0d, 0x23, 0x99, 0xb2, 0x73, 0xf8,
+	0x0d, 0x70, 0x79, 0x72, 0x22, 0xbc, 0x42, 0xcd, 0xa9, 0x97, 0x9a, 0x7e, 0x63, 0xa9, 0x56, 0x68,
+	0x3c, 0xb1, 0x57, 0x0e, 0xbc, 0x17, 0xc3, 0xea, 0xca, 0x68, 0x58, 0xdd, 0x9c, 0x09, 0x72, 0x22,
+	0x10, 0xd6, 0xb4, 0xe8, 0x57, 0x17, 0xac, 0x1f, 0x0b, 0x11, 0xbf, 0x4f, 0x14, 0x81, 0x07, 0xc0,
+	0xcd, 0xb4, 0xea, 0xbb, 0x94, 0x9a, 0x5b, 0x0d, 0x53, 0xfe, 0x46, 0x5e, 0xfe, 0xc6, 0x61, 0x32,
+	0x08, 0x36, 0x7e, 0xff, 0x65, 0x6f, 0x2d, 0x43, 0xb4, 0xb0, 0x76, 0x86, 0x5f, 0x81, 0xb5, 0x8c,
+	0x55, 0x7a, 0xab, 0xb5, 0xc2, 0x15, 0x14, 0xe6, 0x39, 0x0c, 0xb6, 0xac, 0xc2, 0xf2, 0x44, 0xa1,
+	0x44, 0xd8, 0x70, 0xc2, 0x9f, 0x1d, 0x70, 0x57, 0xf6, 0x52, 0x46, 0x68, 0x98, 0xb2, 0x33, 0x92,
+	0xd2, 0x50, 0x77, 0x58, 0x3f, 0x26, 0x4a, 0xa4, 0x36, 0x27, 0xcd, 0x25, 0x23, 0x1e, 0x66, 0xc8,
+	0xa3, 0xf6, 0xf7, 0x2c, 0x52, 0x41, 0xdd, 0x06, 0xad, 0x99, 0xa0, 0xaf, 0x0c, 0x81, 0xf0, 0xb6,
+	0xb1, 0x61, 0x6d, 0x3a, 0x9c, 0x58, 0xe0, 0x4f, 0x0e, 0xd8, 0x1e, 0xf7, 0x88, 0x9c, 0x06, 0x49,
+	0xcf, 0xad, 0x15, 0x5e, 0x53, 0xd8, 0xae, 0x15, 0x76, 0xdf, 0x08, 0x5b, 0x1c, 0x00, 0xe1, 0x3b,
+	0x13, 0xc3, 0x94, 0x26, 0x09, 0x39, 0xb8, 0x39, 0xdf, 0xb7, 0xd2, 0x5b, 0xd3, 0x6a, 0xde, 0x59,
+	0x52, 0x4d, 0x2b, 0xc7, 0x63, 0x0d, 0x0f, 0xdc, 0x4c, 0x11, 0xde, 0xe4, 0xb3, 0x3f, 0x4b, 0xf4,
+	0xdb, 0x2a, 0x28, 0x1f, 0xdb, 0x79, 0xd4, 0xdd, 0xf3, 0x09, 0x58, 0xcf, 0xe7, 0xd3, 0x76, 0xd0,
+	0xb2, 0xbd, 0x90, 0xd3, 0xe0, 0x31, 0x41, 0x36, 0x59, 0xb1, 0xc8, 0x7a, 0x95, 0x7a, 0xab, 0xf3,
+	0x93, 0x65, 0x0d, 0x08, 0x17, 0xb3, 0xaf, 0x16, 0x85, 0xdf, 0x81, 0x9d, 0x05, 0x15, 0xb4, 0xf7,
+	0xb7, 0x5d, 0x72, 0x7f, 0xac, 0x45, 0x1b, 0xc7, 0xb1, 0x67, 0x6e, 0xf9, 0xef, 0x62, 0x1b, 0x33,
+	0xfc, 0x1c, 0x6c, 0xf5, 0x7b, 0x8a, 0x77, 0xd9, 0x0c, 0x75, 0x5e, 0xe8, 0xa5, 0xb8, 0xa1, 0x21,
+	0x98, 0x62, 0x95, 0xe8, 0x6f, 0x17, 0x94, 0x3f, 0x32, 0xab, 0xfe, 0x33, 0x45, 0x14, 0x83, 0x8f,
+	0x41, 0xd1, 0xec, 0x45, 0x9b, 0xc1, 0xdd, 0xff, 0xc9, 0xe0, 0xb1, 0x76, 0xb6, 0x11, 0x2c, 0x14,
+	0x62, 0xb0, 0xa1, 0x97, 0x0f, 0x25, 0x8a, 0x5c, 0x71, 0x2a, 0xf3, 0x55, 0x60, 0x19, 0xd7, 0x7b,
+	0xf9, 0x6a, 0xf8, 0x16, 0x5c, 0xcf, 0x6b, 0x63, 0x78, 0x0b, 0x9a, 0xf7, 0xe0, 0x8a, 0x15, 0x9e,
+	0xe2, 0x2e, 0xf7, 0xa6, 0x9b, 0xe7, 0x03, 0xb0, 0x99, 0xb0, 0x73, 0x15, 0x8e, 0x83, 0x70, 0xea,
+	0xb9, 0xba, 0xf0, 0xf7, 0x46, 0xc3, 0xea, 0xb6, 0x29, 0xfc, 0xbc, 0x07, 0xc2, 0x37, 0xb2, 0x9f,
+	0x72, 0xf2, 0x16, 0x85, 0x5f, 0x03, 0x4f, 0x3b, 0xcd, 0x0f, 0x41, 0x46, 0xb7, 0xa6, 0xe9, 0x1e,
+	0x8e, 0x86, 0xd5, 0xea, 0x14, 0xdd, 0x02, 0x4f, 0x84, 0x6f, 0x67, 0xa6, 0xb9, 0x41, 0x68, 0x51,
+	0xf8, 0x05, 0xb8, 0x73, 0xc6, 0xd5, 0x53, 0x9a, 0x92, 0xb3, 0x50, 0x24, 0xf1, 0x20, 0xb4, 0x3b,
+	0x5e, 0x7a, 0xc5, 0x5a, 0xa1, 0xee, 0x06, 0x0f, 0x26, 0x83, 0xbb, 0xd8, 0x0f, 0xe1, 0x5b, 0xb9,
+	0xe1, 0x28, 0x89, 0x07, 0x7a, 0x8d, 0x52, 0x09, 0x9f, 0x83, 0x72, 0x4a, 0x92, 0x0e, 0x0b, 0x45,
+	0x4a, 0x59, 0x2a, 0xbd, 0x6b, 0x3a, 0xb7, 0xfb, 0x4b, 0xe6, 0x16, 0x67, 0xd0, 0xa3, 0x0c, 0x19,
+	0xdc, 0xb3, 0xdb, 0xe3, 0x96, 0x11, 0x31, 0x4d, 0x8a, 0x70, 0x29, 0x1d, 0x3b, 0x4a, 0xf4, 0x83,
+	0x03, 0x4a, 0x53, 0x7b, 0x07, 0x3e, 0x04, 0x6e, 0x42, 0xba, 0x4c, 0xb7, 0xdd, 0x46, 0xf0, 0xc6,
+	0x68, 0x58, 0x2d, 0xd9, 0x24, 0x91, 0x2e, 0x43, 0x58, 0x1b, 0xe1, 0xa7, 0xe0, 0xba, 0x69, 0xff,
+	0x48, 0x24, 0x8a, 0x25, 0x4a, 0x8f, 0x66, 0xa9, 0xf9, 0xe6, 0x2b, 0xda, 0x7f, 0x6a, 0x33, 0x3d,
+	0x36, 0x00, 0x5c, 0xd6, 0x1e, 0xf6, 0x14, 0xd0, 0x17, 0x17, 0x15, 0xe7, 0xe5, 0x45, 0xc5, 0xf9,
+	0xeb, 0xa2, 0xe2, 0xfc, 0x78, 0x59, 0x59, 0x79, 0x79, 0x59, 0x59, 0xf9, 0xf3, 0xb2, 0xb2, 0xf2,
+	0xe5, 0xc7, 0x1d, 0xae, 0x9e, 0xf6, 0xdb, 0x8d, 0x48, 0x74, 0x7d, 0x4b, 0xbe, 0x17, 0x93, 0xb6,
+	0xcc, 0x0f, 0xfe, 0x69, 0x73, 0xdf, 0x3f, 0x9f, 0x79, 0xc1, 0xf7, 0x26, 0x4f, 0xb8, 0x1a, 0xf4,
+	0x98, 0xcc, 0xff, 0x43, 0xb5, 0x8b, 0xfa, 0xfd, 0x3a, 0xf8, 0x67, 0x00, 0xd9, 0x3b, 0x5c, 0x66,
+	0x7b, 0x09, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -660,6 +684,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RangeOrders) > 0 {
+		for iNdEx := len(m.RangeOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.WithdrawOnlyPoolIds) > 0 {
+		dAtA7 := make([]byte, len(m.WithdrawOnlyPoolIds)*10)
+		var j6 int
+		for _, num := range m.WithdrawOnlyPoolIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintGenesis(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.NextIncentiveRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveRecordId))
 		i--
@@ -864,6 +920,19 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextIncentiveRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextIncentiveRecordId))
 	}
+	if len(m.WithdrawOnlyPoolIds) > 0 {
+		l = 0
+		for _, e := range m.WithdrawOnlyPoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.RangeOrders) > 0 {
+		for _, e := range m.RangeOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1572,6 +1641,116 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WithdrawOnlyPoolIds = append(m.WithdrawOnlyPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WithdrawOnlyPoolIds) == 0 {
+					m.WithdrawOnlyPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WithdrawOnlyPoolIds = append(m.WithdrawOnlyPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawOnlyPoolIds", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeOrders = append(m.RangeOrders, model.RangeOrder{})
+			if err := m.RangeOrders[len(m.RangeOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -14,16 +14,19 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeSetPoolsWithdrawOnlyMode        = "SetPoolsWithdrawOnlyMode"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPool)
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeSetPoolsWithdrawOnlyMode)
 }
 
 var (
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &SetPoolsWithdrawOnlyModeProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -155,3 +158,62 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+// NewSetPoolsWithdrawOnlyModeProposal returns a new instance of a set pools withdraw-only mode proposal struct.
+func NewSetPoolsWithdrawOnlyModeProposal(title, description string, poolIds []uint64, withdrawOnly bool) govtypesv1.Content {
+	return &SetPoolsWithdrawOnlyModeProposal{
+		Title:        title,
+		Description:  description,
+		PoolIds:      poolIds,
+		WithdrawOnly: withdrawOnly,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetPoolsWithdrawOnlyModeProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetPoolsWithdrawOnlyModeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetPoolsWithdrawOnlyModeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetPoolsWithdrawOnlyModeProposal) ProposalType() string {
+	return ProposalTypeSetPoolsWithdrawOnlyMode
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SetPoolsWithdrawOnlyModeProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIds) == 0 {
+		return fmt.Errorf("empty pool ids")
+	}
+
+	seenPoolIds := map[uint64]struct{}{}
+	for _, poolId := range p.PoolIds {
+		if poolId == 0 {
+			return fmt.Errorf("pool id cannot be zero")
+		}
+		if _, ok := seenPoolIds[poolId]; ok {
+			return fmt.Errorf("duplicate pool id %d", poolId)
+		}
+		seenPoolIds[poolId] = struct{}{}
+	}
+	return nil
+}
+
+// String returns a string containing the set pools withdraw-only mode proposal.
+func (p SetPoolsWithdrawOnlyModeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pools Withdraw-Only Mode Proposal:
+Title:         %s
+Description:   %s
+Pool IDs:      %v
+Withdraw Only: %t
+`, p.Title, p.Description, p.PoolIds, p.WithdrawOnly))
+	return b.String()
+}
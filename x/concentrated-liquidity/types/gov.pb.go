@@ -109,6 +109,50 @@ func (m *TickSpacingDecreaseProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TickSpacingDecreaseProposal proto.InternalMessageInfo
 
+// SetPoolsWithdrawOnlyModeProposal is a gov Content type for enabling or
+// disabling the withdraw-only mode of concentrated liquidity pools. While a
+// pool is in withdraw-only mode, swaps and new positions are rejected, but
+// positions can still be withdrawn and rewards collected. The proposal will
+// fail if one of the pools does not exist.
+type SetPoolsWithdrawOnlyModeProposal struct {
+	Title        string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIds      []uint64 `protobuf:"varint,3,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	WithdrawOnly bool     `protobuf:"varint,4,opt,name=withdraw_only,json=withdrawOnly,proto3" json:"withdraw_only,omitempty" yaml:"withdraw_only"`
+}
+
+func (m *SetPoolsWithdrawOnlyModeProposal) Reset()      { *m = SetPoolsWithdrawOnlyModeProposal{} }
+func (*SetPoolsWithdrawOnlyModeProposal) ProtoMessage() {}
+func (*SetPoolsWithdrawOnlyModeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{2}
+}
+func (m *SetPoolsWithdrawOnlyModeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolsWithdrawOnlyModeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolsWithdrawOnlyModeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolsWithdrawOnlyModeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolsWithdrawOnlyModeProposal.Merge(m, src)
+}
+func (m *SetPoolsWithdrawOnlyModeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolsWithdrawOnlyModeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolsWithdrawOnlyModeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolsWithdrawOnlyModeProposal proto.InternalMessageInfo
+
 // PoolIdToTickSpacingRecord is a struct that contains a pool id to new tick
 // spacing pair.
 type PoolIdToTickSpacingRecord struct {
@@ -120,7 +164,7 @@ func (m *PoolIdToTickSpacingRecord) Reset()         { *m = PoolIdToTickSpacingRe
 func (m *PoolIdToTickSpacingRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolIdToTickSpacingRecord) ProtoMessage()    {}
 func (*PoolIdToTickSpacingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{2}
+	return fileDescriptor_a96adc35f4989ef7, []int{3}
 }
 func (m *PoolIdToTickSpacingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +218,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{3}
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +271,7 @@ func (m *PoolRecord) GetTickSpacing() uint64 {
 func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
+	proto.RegisterType((*SetPoolsWithdrawOnlyModeProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SetPoolsWithdrawOnlyModeProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
@@ -236,42 +281,46 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x5b, 0x27, 0xc0, 0x25, 0x45, 0xc5, 0x44, 0x6a, 0x68, 0x24, 0x3b, 0xb2, 0x84, 0x14,
-	0x86, 0xda, 0xb8, 0x6c, 0x61, 0x41, 0x69, 0x85, 0x04, 0xea, 0x50, 0x99, 0x4e, 0x08, 0x29, 0x5c,
-	0xce, 0x87, 0x7b, 0x8a, 0xe3, 0xe7, 0xfa, 0xae, 0x29, 0xf9, 0x0f, 0x90, 0x60, 0x60, 0x64, 0xcc,
-	0x9f, 0xd3, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xb0, 0x92, 0xbf, 0x00, 0xe5, 0x2e, 0x3f, 0x9c,
-	0x42, 0x25, 0x10, 0x9b, 0xef, 0xee, 0xfb, 0xbe, 0xf7, 0x7d, 0xef, 0xf9, 0x21, 0x0f, 0x78, 0x0f,
-	0x38, 0xe3, 0x1e, 0x81, 0x84, 0xd0, 0x44, 0x64, 0x58, 0xd0, 0x30, 0x66, 0x67, 0xe7, 0x2c, 0x64,
-	0x62, 0xe0, 0xf5, 0xfd, 0x0e, 0x15, 0xd8, 0xf7, 0x22, 0xe8, 0xbb, 0x69, 0x06, 0x02, 0xcc, 0x87,
-	0x73, 0x82, 0xfb, 0x47, 0x82, 0x3b, 0x27, 0xec, 0x56, 0x22, 0x88, 0x40, 0x32, 0xbc, 0xd9, 0x97,
-	0x22, 0x3b, 0x13, 0x1d, 0x35, 0x0e, 0x32, 0x8a, 0x05, 0x3d, 0xc8, 0xb1, 0x8f, 0x16, 0xec, 0x63,
-	0x80, 0x98, 0x1f, 0x67, 0x90, 0x02, 0xc7, 0xb1, 0x59, 0x41, 0x05, 0xc1, 0x44, 0x4c, 0xab, 0x7a,
-	0x5d, 0x6f, 0xdc, 0x09, 0xd4, 0xc1, 0xac, 0xa3, 0x52, 0x48, 0x39, 0xc9, 0x58, 0x2a, 0x18, 0x24,
-	0xd5, 0x0d, 0xf9, 0x96, 0xbf, 0x32, 0xcf, 0x50, 0x39, 0x05, 0x88, 0xdb, 0x19, 0x25, 0x90, 0x85,
-	0xbc, 0xba, 0x59, 0xdf, 0x6c, 0x94, 0xf6, 0x7d, 0xf7, 0xaf, 0x8c, 0xbb, 0x33, 0x0f, 0x81, 0x64,
-	0xb6, 0x6a, 0x97, 0x23, 0x5b, 0x9b, 0x8e, 0xec, 0xfb, 0x03, 0xdc, 0x8b, 0x9b, 0x4e, 0x5e, 0xd4,
-	0x09, 0x4a, 0xe9, 0x12, 0xc8, 0x9b, 0xe5, 0x0f, 0x43, 0x5b, 0xfb, 0x32, 0xb4, 0xb5, 0x1f, 0x43,
-	0x5b, 0x77, 0x7e, 0xea, 0xa8, 0x76, 0xc2, 0x48, 0xf7, 0x55, 0x8a, 0x09, 0x4b, 0xa2, 0x43, 0x4a,
-	0x32, 0x8a, 0x39, 0xfd, 0xef, 0x60, 0x1f, 0x75, 0x64, 0x4b, 0x13, 0x2c, 0x6c, 0x0b, 0x68, 0x0b,
-	0x46, 0xba, 0x6d, 0xae, 0x6a, 0x5c, 0x0b, 0xfb, 0xec, 0x1f, 0xc2, 0xbe, 0x08, 0x4f, 0x20, 0xe7,
-	0x76, 0x9e, 0xdd, 0x98, 0x65, 0x0f, 0x76, 0xd3, 0x9b, 0x00, 0xd7, 0x33, 0x87, 0xe8, 0xc1, 0x8d,
-	0x62, 0xe6, 0x0e, 0xba, 0x35, 0xf7, 0x2d, 0x23, 0x1b, 0x41, 0x51, 0xe9, 0x9a, 0x0d, 0xb4, 0x9d,
-	0xd0, 0x8b, 0xb5, 0x24, 0x32, 0xb8, 0x11, 0xdc, 0x4d, 0xe8, 0x45, 0x4e, 0xa8, 0x69, 0xc8, 0x2a,
-	0x9f, 0x36, 0x10, 0x5a, 0x0d, 0xc8, 0x7c, 0x84, 0x8a, 0x21, 0x4d, 0xa0, 0xf7, 0x58, 0x75, 0xb2,
-	0x75, 0x6f, 0x3a, 0xb2, 0xb7, 0xd4, 0xb0, 0xd4, 0xbd, 0x13, 0xcc, 0x01, 0x4b, 0xa8, 0xaf, 0x1a,
-	0xfb, 0x1b, 0xd4, 0x5f, 0x40, 0x7d, 0xb3, 0x89, 0xca, 0x6b, 0x86, 0x36, 0x67, 0x86, 0x5a, 0x3b,
-	0xab, 0x1f, 0x21, 0xff, 0xea, 0x04, 0x25, 0xb1, 0xb2, 0x69, 0xbe, 0x45, 0x5b, 0x3c, 0xcd, 0x28,
-	0x0e, 0xdb, 0xef, 0x30, 0x11, 0x90, 0x55, 0x0b, 0xb2, 0xda, 0xd3, 0x59, 0x37, 0xbf, 0x8d, 0xec,
-	0x1a, 0x91, 0x73, 0xe1, 0x61, 0xd7, 0x65, 0xe0, 0xf5, 0xb0, 0x38, 0x75, 0x8f, 0x68, 0x84, 0xc9,
-	0xe0, 0x90, 0x92, 0xe9, 0xc8, 0xae, 0x28, 0xfd, 0x35, 0x05, 0x27, 0x28, 0xab, 0xf3, 0x73, 0x79,
-	0x54, 0x8d, 0x78, 0x69, 0xdc, 0x36, 0xb6, 0x0b, 0xad, 0x37, 0x97, 0x63, 0x4b, 0xbf, 0x1a, 0x5b,
-	0xfa, 0xf7, 0xb1, 0xa5, 0x7f, 0x9e, 0x58, 0xda, 0xd5, 0xc4, 0xd2, 0xbe, 0x4e, 0x2c, 0xed, 0x75,
-	0x2b, 0x62, 0xe2, 0xf4, 0xbc, 0xe3, 0x12, 0xe8, 0x2d, 0x36, 0x7c, 0x2f, 0xc6, 0x1d, 0xbe, 0x5c,
-	0xf7, 0xfe, 0xbe, 0xef, 0xbd, 0x5f, 0x5b, 0xfa, 0xbd, 0xd5, 0xd6, 0x8b, 0x41, 0x4a, 0x79, 0xa7,
-	0x28, 0x77, 0xf6, 0xc9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0x5e, 0x54, 0x68, 0x23, 0x04,
-	0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6b, 0xdb, 0x4e,
+	0x10, 0xb5, 0x12, 0xe5, 0xcf, 0x6f, 0xed, 0xfc, 0x9a, 0x2a, 0x81, 0xa8, 0x09, 0x58, 0x46, 0x50,
+	0x70, 0x0f, 0x91, 0xaa, 0xf4, 0xe6, 0x52, 0x28, 0x4a, 0x28, 0xb4, 0xa4, 0x34, 0x28, 0x81, 0x42,
+	0x29, 0xb8, 0xeb, 0xd5, 0xd6, 0x59, 0x22, 0x6b, 0x14, 0xed, 0x26, 0xae, 0xbf, 0x41, 0xa1, 0x3d,
+	0xf4, 0xd8, 0x63, 0x3e, 0x4e, 0x8e, 0xb9, 0xb5, 0xf4, 0x60, 0x4a, 0x7c, 0xe9, 0xb5, 0xfe, 0x04,
+	0x45, 0xbb, 0x72, 0x2c, 0x9b, 0x04, 0x5a, 0x72, 0xd3, 0x68, 0xdf, 0x9b, 0x79, 0x6f, 0x66, 0x18,
+	0xe4, 0x02, 0xef, 0x00, 0x67, 0xdc, 0x25, 0x10, 0x13, 0x1a, 0x8b, 0x14, 0x0b, 0x1a, 0x46, 0xec,
+	0xf8, 0x84, 0x85, 0x4c, 0xf4, 0xdc, 0x53, 0xaf, 0x45, 0x05, 0xf6, 0xdc, 0x36, 0x9c, 0x3a, 0x49,
+	0x0a, 0x02, 0x8c, 0xfb, 0x39, 0xc1, 0xb9, 0x96, 0xe0, 0xe4, 0x84, 0xf5, 0xd5, 0x36, 0xb4, 0x41,
+	0x32, 0xdc, 0xec, 0x4b, 0x91, 0xed, 0x81, 0x86, 0xea, 0xdb, 0x29, 0xc5, 0x82, 0x6e, 0x17, 0xd8,
+	0xbb, 0x23, 0xf6, 0x1e, 0x40, 0xc4, 0xf7, 0x52, 0x48, 0x80, 0xe3, 0xc8, 0x58, 0x45, 0x73, 0x82,
+	0x89, 0x88, 0x9a, 0x5a, 0x4d, 0xab, 0xff, 0x17, 0xa8, 0xc0, 0xa8, 0xa1, 0x72, 0x48, 0x39, 0x49,
+	0x59, 0x22, 0x18, 0xc4, 0xe6, 0x8c, 0x7c, 0x2b, 0xfe, 0x32, 0x8e, 0x51, 0x25, 0x01, 0x88, 0x9a,
+	0x29, 0x25, 0x90, 0x86, 0xdc, 0x9c, 0xad, 0xcd, 0xd6, 0xcb, 0x5b, 0x9e, 0xf3, 0x57, 0xc2, 0x9d,
+	0x4c, 0x43, 0x20, 0x99, 0xfe, 0xc6, 0x79, 0xdf, 0x2a, 0x0d, 0xfb, 0xd6, 0x4a, 0x0f, 0x77, 0xa2,
+	0x86, 0x5d, 0x4c, 0x6a, 0x07, 0xe5, 0xe4, 0x0a, 0xc8, 0x1b, 0x95, 0x8f, 0x67, 0x56, 0xe9, 0xeb,
+	0x99, 0x55, 0xfa, 0x75, 0x66, 0x69, 0xf6, 0x6f, 0x0d, 0x6d, 0x1c, 0x30, 0x72, 0xb4, 0x9f, 0x60,
+	0xc2, 0xe2, 0xf6, 0x0e, 0x25, 0x29, 0xc5, 0x9c, 0xde, 0xda, 0xd8, 0x27, 0x0d, 0x59, 0x52, 0x04,
+	0x0b, 0x9b, 0x02, 0x9a, 0x82, 0x91, 0xa3, 0x26, 0x57, 0x35, 0xa6, 0xcc, 0x3e, 0xfd, 0x07, 0xb3,
+	0xcf, 0xc3, 0x03, 0x28, 0xa8, 0xcd, 0xbd, 0xeb, 0x99, 0xf7, 0x60, 0x3d, 0xb9, 0x09, 0x30, 0xed,
+	0xf9, 0x9b, 0x86, 0x6a, 0xfb, 0x54, 0xc8, 0x09, 0xbe, 0x66, 0xe2, 0x30, 0x4c, 0x71, 0xf7, 0x55,
+	0x1c, 0xf5, 0x5e, 0x42, 0x78, 0x7b, 0xe3, 0x0e, 0x5a, 0xcc, 0x7d, 0x2b, 0x83, 0xba, 0xbf, 0x32,
+	0xec, 0x5b, 0x77, 0x0a, 0x63, 0x61, 0xd9, 0x48, 0x16, 0x94, 0x5a, 0x6e, 0x3c, 0x41, 0x4b, 0xdd,
+	0x5c, 0x43, 0x13, 0xe2, 0xa8, 0x67, 0xea, 0x35, 0xad, 0xbe, 0xe8, 0x9b, 0xc3, 0xbe, 0xb5, 0xaa,
+	0x48, 0x13, 0xcf, 0x76, 0x50, 0xe9, 0x16, 0x24, 0x4f, 0x39, 0x0b, 0xd1, 0xbd, 0x1b, 0xdb, 0x64,
+	0xac, 0xa1, 0x85, 0xbc, 0xbe, 0xf4, 0xa4, 0x07, 0xf3, 0x4a, 0x83, 0x51, 0x47, 0xcb, 0x31, 0xed,
+	0x4e, 0xcc, 0x48, 0x3a, 0xd3, 0x83, 0xff, 0x63, 0xda, 0x2d, 0x24, 0x6a, 0xe8, 0xb2, 0xca, 0xe7,
+	0x19, 0x84, 0xc6, 0xab, 0x67, 0x3c, 0x40, 0xf3, 0x21, 0x8d, 0xa1, 0xf3, 0x50, 0xb5, 0xca, 0xbf,
+	0x3b, 0xec, 0x5b, 0x4b, 0x4a, 0xba, 0xfa, 0x6f, 0x07, 0x39, 0xe0, 0x0a, 0xea, 0x99, 0x33, 0xd7,
+	0x42, 0xbd, 0x11, 0xd4, 0x33, 0x1a, 0xa8, 0x32, 0x21, 0x68, 0x36, 0x13, 0xe4, 0xaf, 0x8d, 0x57,
+	0xbc, 0xf8, 0x6a, 0x07, 0x65, 0x31, 0x96, 0x69, 0xbc, 0x43, 0x4b, 0x3c, 0x49, 0x29, 0x0e, 0x9b,
+	0xef, 0x31, 0x11, 0x90, 0x9a, 0x73, 0xb2, 0xda, 0xe3, 0x6c, 0x4f, 0x7e, 0xf4, 0xad, 0x0d, 0x22,
+	0x37, 0x8e, 0x87, 0x47, 0x0e, 0x03, 0xb7, 0x83, 0xc5, 0xa1, 0xb3, 0x4b, 0xdb, 0x98, 0xf4, 0x76,
+	0x28, 0x19, 0xb7, 0x7d, 0x22, 0x83, 0x1d, 0x54, 0x54, 0xfc, 0x4c, 0x86, 0xaa, 0x11, 0x2f, 0xf4,
+	0x45, 0x7d, 0x79, 0xce, 0x7f, 0x7b, 0x7e, 0x59, 0xd5, 0x2e, 0x2e, 0xab, 0xda, 0xcf, 0xcb, 0xaa,
+	0xf6, 0x65, 0x50, 0x2d, 0x5d, 0x0c, 0xaa, 0xa5, 0xef, 0x83, 0x6a, 0xe9, 0x8d, 0xdf, 0x66, 0xe2,
+	0xf0, 0xa4, 0xe5, 0x10, 0xe8, 0x8c, 0x6e, 0xd7, 0x66, 0x84, 0x5b, 0x7c, 0x14, 0xb8, 0xa7, 0x5b,
+	0x9e, 0xfb, 0x61, 0xe2, 0x9c, 0x6d, 0x8e, 0xef, 0x99, 0xe8, 0x25, 0x94, 0xb7, 0xe6, 0xe5, 0x35,
+	0x7a, 0xf4, 0x67, 0x00, 0x81, 0x89, 0x6b, 0xbd, 0xfd, 0x04, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -344,6 +393,44 @@ func (this *TickSpacingDecreaseProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetPoolsWithdrawOnlyModeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolsWithdrawOnlyModeProposal)
+	if !ok {
+		that2, ok := that.(SetPoolsWithdrawOnlyModeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIds) != len(that1.PoolIds) {
+		return false
+	}
+	for i := range this.PoolIds {
+		if this.PoolIds[i] != that1.PoolIds[i] {
+			return false
+		}
+	}
+	if this.WithdrawOnly != that1.WithdrawOnly {
+		return false
+	}
+	return true
+}
 func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -506,6 +593,71 @@ func (m *TickSpacingDecreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *SetPoolsWithdrawOnlyModeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolsWithdrawOnlyModeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolsWithdrawOnlyModeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawOnly {
+		i--
+		if m.WithdrawOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.PoolIds)*10)
+		var j1 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolIdToTickSpacingRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -648,6 +800,33 @@ func (m *TickSpacingDecreaseProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetPoolsWithdrawOnlyModeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	if m.WithdrawOnly {
+		n += 2
+	}
+	return n
+}
+
 func (m *PoolIdToTickSpacingRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -987,6 +1166,216 @@ func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetPoolsWithdrawOnlyModeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolsWithdrawOnlyModeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolsWithdrawOnlyModeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -189,3 +189,42 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestSetPoolsWithdrawOnlyModeProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		poolIds    []uint64
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			poolIds:    []uint64{1, 2},
+			expectPass: true,
+		},
+		{
+			name:       "empty pool ids",
+			poolIds:    []uint64{},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			poolIds:    []uint64{0},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate pool id",
+			poolIds:    []uint64{1, 2, 1},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		proposal := types.NewSetPoolsWithdrawOnlyModeProposal("title", "description", test.poolIds, true)
+
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -166,9 +166,85 @@ func (m *IncentiveRecordBody) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+// IncentiveRecordAttribution describes the part of an uptime accumulator's
+// growth that is attributed to a single emitting incentive record. Records of
+// the same denom targeting the same uptime share an accumulator and split its
+// growth pro-rata by emission rate.
+type IncentiveRecordAttribution struct {
+	// incentive_id is the id of the attributed incentive record.
+	IncentiveId uint64 `protobuf:"varint,1,opt,name=incentive_id,json=incentiveId,proto3" json:"incentive_id,omitempty" yaml:"incentive_id"`
+	// min_uptime is the uptime targeted by the incentive record.
+	MinUptime time.Duration `protobuf:"bytes,2,opt,name=min_uptime,json=minUptime,proto3,stdduration" json:"min_uptime" yaml:"min_uptime"`
+	// denom is the denom of the incentives emitted by the record.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// emission_rate is the incentive emission rate per second of the record.
+	EmissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=emission_rate,json=emissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_rate" yaml:"emission_rate"`
+	// emission_share is the record's share of the combined emission rate of all
+	// emitting records with the same denom and min uptime.
+	EmissionShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=emission_share,json=emissionShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_share" yaml:"emission_share"`
+	// emission_per_liquidity is the amount of incentives emitted by the record
+	// per second per unit of the pool's current active liquidity.
+	EmissionPerLiquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=emission_per_liquidity,json=emissionPerLiquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_per_liquidity" yaml:"emission_per_liquidity"`
+}
+
+func (m *IncentiveRecordAttribution) Reset()         { *m = IncentiveRecordAttribution{} }
+func (m *IncentiveRecordAttribution) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecordAttribution) ProtoMessage()    {}
+func (*IncentiveRecordAttribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bef31b586e827443, []int{2}
+}
+func (m *IncentiveRecordAttribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRecordAttribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecordAttribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveRecordAttribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecordAttribution.Merge(m, src)
+}
+func (m *IncentiveRecordAttribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecordAttribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecordAttribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecordAttribution proto.InternalMessageInfo
+
+func (m *IncentiveRecordAttribution) GetIncentiveId() uint64 {
+	if m != nil {
+		return m.IncentiveId
+	}
+	return 0
+}
+
+func (m *IncentiveRecordAttribution) GetMinUptime() time.Duration {
+	if m != nil {
+		return m.MinUptime
+	}
+	return 0
+}
+
+func (m *IncentiveRecordAttribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*IncentiveRecord)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecord")
 	proto.RegisterType((*IncentiveRecordBody)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordBody")
+	proto.RegisterType((*IncentiveRecordAttribution)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordAttribution")
 }
 
 func init() {
@@ -176,43 +252,50 @@ func init() {
 }
 
 var fileDescriptor_bef31b586e827443 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x4b, 0x29, 0xea, 0xa6, 0x05, 0xe1, 0x14, 0x9a, 0x86, 0x62, 0x57, 0x16, 0x48, 0x15,
-	0x52, 0xd6, 0x4a, 0xb8, 0x05, 0x4e, 0x26, 0x97, 0x48, 0x3d, 0x59, 0x20, 0x10, 0x42, 0x32, 0x6b,
-	0xef, 0xe2, 0xae, 0x1a, 0x7b, 0x83, 0x77, 0x13, 0xe1, 0xb7, 0x28, 0x12, 0x07, 0x9e, 0x81, 0x27,
-	0xe9, 0xb1, 0x27, 0x84, 0x38, 0xa4, 0x28, 0x11, 0x2f, 0x90, 0x27, 0x40, 0xbb, 0x5e, 0xe7, 0xaf,
-	0x3d, 0x70, 0xb2, 0xbf, 0x9d, 0xf9, 0xe6, 0x9b, 0xf9, 0x76, 0x16, 0xbc, 0x64, 0x3c, 0x61, 0x9c,
-	0x72, 0x37, 0x62, 0x69, 0x44, 0x52, 0x91, 0x21, 0x41, 0x70, 0x9f, 0x7e, 0x1e, 0x52, 0x4c, 0x45,
-	0xee, 0x8e, 0x5a, 0x21, 0x11, 0xa8, 0xe5, 0x52, 0x15, 0xa4, 0x23, 0x12, 0x64, 0x24, 0x62, 0x19,
-	0x86, 0x83, 0x8c, 0x09, 0x66, 0x3e, 0xd5, 0x6c, 0x78, 0x23, 0x1b, 0x6a, 0x76, 0xe3, 0x20, 0x52,
-	0x79, 0x81, 0x22, 0xb9, 0x05, 0x28, 0x2a, 0x34, 0xf6, 0x62, 0x16, 0xb3, 0xe2, 0x5c, 0xfe, 0xe9,
-	0x53, 0x3b, 0x66, 0x2c, 0xee, 0x13, 0x57, 0xa1, 0x70, 0xf8, 0xc9, 0x15, 0x34, 0x21, 0x5c, 0xa0,
-	0x64, 0xa0, 0x13, 0xac, 0xf5, 0x04, 0x3c, 0xcc, 0x90, 0xa0, 0x2c, 0x2d, 0xe3, 0x85, 0x88, 0x1b,
-	0x22, 0x4e, 0xe6, 0x43, 0x44, 0x8c, 0xea, 0xb8, 0xf3, 0x73, 0x03, 0xdc, 0xeb, 0x95, 0x33, 0xf9,
-	0x6a, 0x24, 0xb3, 0x03, 0x76, 0x16, 0x63, 0x52, 0x5c, 0x37, 0x8e, 0x8c, 0xe3, 0x4d, 0x6f, 0x7f,
-	0x36, 0xb6, 0x6b, 0x39, 0x4a, 0xfa, 0x1d, 0x67, 0x39, 0xea, 0xf8, 0xd5, 0x39, 0xec, 0x61, 0x73,
-	0x1f, 0xdc, 0x19, 0x30, 0xd6, 0x97, 0xb4, 0x0d, 0x49, 0xf3, 0xb7, 0x24, 0xec, 0x61, 0xf3, 0x9b,
-	0x01, 0x1e, 0xac, 0x9b, 0x17, 0x84, 0x0c, 0xe7, 0xf5, 0xcd, 0x23, 0xe3, 0xb8, 0xda, 0xee, 0xc0,
-	0xff, 0xb2, 0x10, 0xae, 0x35, 0xeb, 0x31, 0x9c, 0x7b, 0x4f, 0x2e, 0xc6, 0x76, 0x65, 0x36, 0xb6,
-	0x0f, 0xd7, 0xdb, 0x5b, 0x92, 0x71, 0xfc, 0x1a, 0xbd, 0x4e, 0x35, 0xdf, 0x02, 0x90, 0xd0, 0x34,
-	0x18, 0x0e, 0xa4, 0xb1, 0xf5, 0xdb, 0xaa, 0x95, 0x03, 0x58, 0x98, 0x0a, 0x4b, 0x53, 0x61, 0x57,
-	0x9b, 0xea, 0x3d, 0xd6, 0x4a, 0xf7, 0x0b, 0xa5, 0x05, 0xd5, 0xf9, 0x7e, 0x65, 0x1b, 0xfe, 0x76,
-	0x42, 0xd3, 0x37, 0x05, 0xfe, 0xbb, 0x01, 0x6a, 0x37, 0xf4, 0x6a, 0x7e, 0x35, 0xc0, 0xdd, 0x8c,
-	0x24, 0x88, 0xa6, 0x34, 0x8d, 0x03, 0x79, 0x13, 0xca, 0xdf, 0x6a, 0xfb, 0x10, 0xea, 0x7d, 0x90,
-	0x57, 0x35, 0x1f, 0xb7, 0x4b, 0xa2, 0x57, 0x8c, 0xa6, 0xde, 0x89, 0x16, 0x7e, 0x58, 0x08, 0xaf,
-	0x56, 0xe0, 0xce, 0x8f, 0x2b, 0xfb, 0x59, 0x4c, 0xc5, 0xe9, 0x30, 0x84, 0x11, 0x4b, 0xf4, 0x66,
-	0xe9, 0x4f, 0x93, 0xe3, 0x33, 0x57, 0xe4, 0x03, 0xc2, 0xcb, 0x6a, 0xfe, 0xee, 0x9c, 0x2f, 0xa1,
-	0xf9, 0x11, 0xec, 0x92, 0x84, 0x72, 0x4e, 0x59, 0x1a, 0x48, 0xdb, 0xd5, 0xd5, 0x6d, 0x7b, 0x2f,
-	0xa4, 0xe6, 0xef, 0xb1, 0xfd, 0xa8, 0xa8, 0xc3, 0xf1, 0x19, 0xa4, 0xcc, 0x4d, 0x90, 0x38, 0x85,
-	0x27, 0x24, 0x46, 0x51, 0xde, 0x25, 0xd1, 0x6c, 0x6c, 0xef, 0x15, 0x2d, 0xad, 0x54, 0x70, 0xfc,
-	0x9d, 0x12, 0xfb, 0x48, 0x10, 0xf3, 0x1d, 0x00, 0x5c, 0xa0, 0x4c, 0x04, 0xca, 0xe6, 0x5b, 0x6a,
-	0xe0, 0xc6, 0x35, 0x9b, 0x5f, 0x97, 0xcb, 0xbd, 0xee, 0xf3, 0x82, 0xeb, 0x9c, 0x2b, 0x9f, 0xd5,
-	0x81, 0x4c, 0xf7, 0x3e, 0x5c, 0x4c, 0x2c, 0xe3, 0x72, 0x62, 0x19, 0x7f, 0x26, 0x96, 0x71, 0x3e,
-	0xb5, 0x2a, 0x97, 0x53, 0xab, 0xf2, 0x6b, 0x6a, 0x55, 0xde, 0x7b, 0x4b, 0x86, 0xe8, 0xdd, 0x6a,
-	0xf6, 0x51, 0xc8, 0x4b, 0xe0, 0x8e, 0xda, 0x2d, 0xf7, 0xcb, 0xca, 0x7b, 0x6f, 0x2e, 0x1e, 0xbc,
-	0x32, 0x2c, 0xdc, 0x52, 0xbd, 0x3d, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x91, 0xb7, 0x7d,
-	0x1e, 0x04, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8e, 0xf3, 0xa7, 0xfd, 0x95, 0xe9, 0x05, 0x70, 0x6f, 0x69, 0x68, 0xed, 0xca, 0x02, 0x54,
+	0x21, 0xd5, 0x56, 0xcb, 0xae, 0x74, 0x83, 0xc9, 0xa6, 0x52, 0x17, 0xc8, 0x80, 0x40, 0x08, 0xc9,
+	0x8c, 0xed, 0xc1, 0x1d, 0x35, 0xf6, 0x04, 0xcf, 0xa4, 0x22, 0x3c, 0x45, 0x91, 0x58, 0xb0, 0xe0,
+	0x09, 0x78, 0x92, 0x2e, 0xbb, 0x42, 0x88, 0x85, 0x8b, 0x5a, 0xf1, 0x02, 0x79, 0x02, 0x34, 0x17,
+	0x3b, 0x8d, 0xdb, 0x45, 0x25, 0xba, 0x6a, 0xcf, 0xe5, 0x3b, 0xdf, 0x39, 0xdf, 0x39, 0x13, 0x83,
+	0x1d, 0x42, 0x13, 0x42, 0x31, 0x75, 0x42, 0x92, 0x86, 0x28, 0x65, 0x19, 0x64, 0x28, 0xea, 0xe2,
+	0x0f, 0x7d, 0x1c, 0x61, 0x36, 0x70, 0x0e, 0x37, 0x03, 0xc4, 0xe0, 0xa6, 0x83, 0x45, 0x10, 0x1f,
+	0x22, 0x3f, 0x43, 0x21, 0xc9, 0x22, 0xbb, 0x97, 0x11, 0x46, 0xf4, 0xfb, 0x0a, 0x6d, 0x5f, 0x89,
+	0xb6, 0x15, 0xba, 0xbd, 0x1c, 0x8a, 0x3c, 0x5f, 0x80, 0x1c, 0x69, 0xc8, 0x0a, 0xed, 0xf9, 0x98,
+	0xc4, 0x44, 0xfa, 0xf9, 0x7f, 0xca, 0x6b, 0xc6, 0x84, 0xc4, 0x5d, 0xe4, 0x08, 0x2b, 0xe8, 0xbf,
+	0x77, 0x18, 0x4e, 0x10, 0x65, 0x30, 0xe9, 0xa9, 0x04, 0xa3, 0x9a, 0x10, 0xf5, 0x33, 0xc8, 0x30,
+	0x49, 0x8b, 0xb8, 0x24, 0x71, 0x02, 0x48, 0x51, 0x39, 0x44, 0x48, 0xb0, 0x8a, 0x5b, 0x3f, 0xea,
+	0xe0, 0xd6, 0x6e, 0x31, 0x93, 0x27, 0x46, 0xd2, 0xb7, 0xc1, 0xf4, 0x68, 0x4c, 0x1c, 0xb5, 0xb4,
+	0x35, 0x6d, 0xbd, 0xe1, 0x2e, 0x0d, 0x73, 0x73, 0x6e, 0x00, 0x93, 0xee, 0xb6, 0x75, 0x31, 0x6a,
+	0x79, 0x53, 0xa5, 0xb9, 0x1b, 0xe9, 0x4b, 0xe0, 0xff, 0x1e, 0x21, 0x5d, 0x0e, 0xab, 0x73, 0x98,
+	0x37, 0xc9, 0xcd, 0xdd, 0x48, 0xff, 0xa2, 0x81, 0x85, 0xaa, 0x78, 0x7e, 0x40, 0xa2, 0x41, 0xab,
+	0xb1, 0xa6, 0xad, 0x4f, 0x6d, 0x6d, 0xdb, 0xd7, 0x92, 0xd0, 0xae, 0x34, 0xeb, 0x92, 0x68, 0xe0,
+	0xde, 0x3b, 0xce, 0xcd, 0xda, 0x30, 0x37, 0x57, 0xaa, 0xed, 0x5d, 0xa0, 0xb1, 0xbc, 0x39, 0x7c,
+	0x19, 0xaa, 0xbf, 0x02, 0x20, 0xc1, 0xa9, 0xdf, 0xef, 0x71, 0x61, 0x5b, 0x13, 0xa2, 0x95, 0x65,
+	0x5b, 0x8a, 0x6a, 0x17, 0xa2, 0xda, 0x1d, 0x25, 0xaa, 0xbb, 0xaa, 0x98, 0xee, 0x48, 0xa6, 0x11,
+	0xd4, 0xfa, 0x7a, 0x6a, 0x6a, 0x5e, 0x33, 0xc1, 0xe9, 0x4b, 0x69, 0xff, 0xa9, 0x83, 0xb9, 0x2b,
+	0x7a, 0xd5, 0x3f, 0x6b, 0x60, 0x36, 0x43, 0x09, 0xc4, 0x29, 0x4e, 0x63, 0x9f, 0x6f, 0x42, 0xe8,
+	0x3b, 0xb5, 0xb5, 0x62, 0xab, 0x7b, 0xe0, 0xab, 0x2a, 0xc7, 0xed, 0xa0, 0xf0, 0x29, 0xc1, 0xa9,
+	0xbb, 0xa7, 0x88, 0x17, 0x25, 0xf1, 0x78, 0x05, 0x6a, 0x7d, 0x3f, 0x35, 0x1f, 0xc6, 0x98, 0xed,
+	0xf7, 0x03, 0x3b, 0x24, 0x89, 0xba, 0x2c, 0xf5, 0x67, 0x83, 0x46, 0x07, 0x0e, 0x1b, 0xf4, 0x10,
+	0x2d, 0xaa, 0x79, 0x33, 0x25, 0x9e, 0x9b, 0xfa, 0x3b, 0x30, 0x83, 0x12, 0x4c, 0x29, 0x26, 0xa9,
+	0xcf, 0x65, 0x17, 0xab, 0x6b, 0xba, 0x8f, 0x39, 0xe7, 0xaf, 0xdc, 0xbc, 0x2b, 0xeb, 0xd0, 0xe8,
+	0xc0, 0xc6, 0xc4, 0x49, 0x20, 0xdb, 0xb7, 0xf7, 0x50, 0x0c, 0xc3, 0x41, 0x07, 0x85, 0xc3, 0xdc,
+	0x9c, 0x97, 0x2d, 0x8d, 0x55, 0xb0, 0xbc, 0xe9, 0xc2, 0xf6, 0x20, 0x43, 0xfa, 0x6b, 0x00, 0x28,
+	0x83, 0x19, 0xf3, 0x85, 0xcc, 0xff, 0x89, 0x81, 0xdb, 0x97, 0x64, 0x7e, 0x51, 0x1c, 0x77, 0x55,
+	0xe7, 0x11, 0xd6, 0x3a, 0x12, 0x3a, 0x0b, 0x07, 0x4f, 0xb7, 0xbe, 0x35, 0x40, 0xbb, 0xa2, 0xf3,
+	0x13, 0xc6, 0x32, 0x1c, 0xf4, 0xf9, 0xc2, 0xfe, 0xe9, 0x96, 0xc7, 0x6f, 0xa3, 0x7e, 0x63, 0xb7,
+	0xa1, 0x3f, 0x00, 0x13, 0x11, 0x4a, 0x49, 0x22, 0x84, 0x68, 0xba, 0xb7, 0x87, 0xb9, 0x39, 0x2d,
+	0x41, 0xc2, 0x6d, 0x79, 0x32, 0x7c, 0x79, 0x2f, 0x8d, 0x9b, 0xde, 0x4b, 0x08, 0x66, 0xcb, 0x38,
+	0xdd, 0x87, 0x99, 0x7c, 0x02, 0x4d, 0x77, 0xe7, 0x7a, 0x14, 0x0b, 0x15, 0x0a, 0x51, 0xc2, 0xf2,
+	0xca, 0xae, 0x9f, 0x73, 0x5b, 0xff, 0x04, 0x16, 0xcb, 0x8c, 0x1e, 0xca, 0xfc, 0xf2, 0x51, 0xb7,
+	0x26, 0x05, 0x59, 0xe7, 0x7a, 0x64, 0xab, 0x15, 0xb2, 0xb1, 0x52, 0x96, 0x37, 0x5f, 0x04, 0x9e,
+	0xa1, 0x6c, 0xaf, 0x70, 0xbb, 0x6f, 0x8f, 0xcf, 0x0c, 0xed, 0xe4, 0xcc, 0xd0, 0x7e, 0x9f, 0x19,
+	0xda, 0xd1, 0xb9, 0x51, 0x3b, 0x39, 0x37, 0x6a, 0x3f, 0xcf, 0x8d, 0xda, 0x1b, 0xf7, 0xc2, 0x7b,
+	0x51, 0x3f, 0x3d, 0x1b, 0x5d, 0x18, 0xd0, 0xc2, 0x70, 0x0e, 0xb7, 0x36, 0x9d, 0x8f, 0x63, 0x9f,
+	0x83, 0x8d, 0xd1, 0xf7, 0x40, 0xbc, 0xa7, 0x60, 0x52, 0x5c, 0xc1, 0xa3, 0xbf, 0x03, 0x00, 0x68,
+	0x22, 0x94, 0x25, 0x3d, 0x06, 0x00, 0x00,
 }
 
 func (m *IncentiveRecord) Marshal() (dAtA []byte, err error) {
@@ -317,6 +400,79 @@ func (m *IncentiveRecordBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IncentiveRecordAttribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveRecordAttribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecordAttribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EmissionPerLiquidity.Size()
+		i -= size
+		if _, err := m.EmissionPerLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EmissionShare.Size()
+		i -= size
+		if _, err := m.EmissionShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EmissionRate.Size()
+		i -= size
+		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinUptime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinUptime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintIncentiveRecord(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.IncentiveId != 0 {
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(m.IncentiveId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIncentiveRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIncentiveRecord(v)
 	base := offset
@@ -362,6 +518,30 @@ func (m *IncentiveRecordBody) Size() (n int) {
 	return n
 }
 
+func (m *IncentiveRecordAttribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncentiveId != 0 {
+		n += 1 + sovIncentiveRecord(uint64(m.IncentiveId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinUptime)
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIncentiveRecord(uint64(l))
+	}
+	l = m.EmissionRate.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = m.EmissionShare.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = m.EmissionPerLiquidity.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	return n
+}
+
 func sovIncentiveRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -672,6 +852,242 @@ func (m *IncentiveRecordBody) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IncentiveRecordAttribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentiveRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecordAttribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecordAttribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveId", wireType)
+			}
+			m.IncentiveId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentiveId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinUptime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionPerLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentiveRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIncentiveRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 const (
@@ -19,8 +20,12 @@ const (
 	base10         = 10
 
 	ConcentratedLiquidityTokenPrefix = "cl/pool"
+	PositionTokenPrefix              = "cl/position"
 )
 
+// PositionEscrowAddress is the address of the module account, which owns the tokenized positions.
+var PositionEscrowAddress = authtypes.NewModuleAddress(ModuleName)
+
 // Key prefixes
 var (
 	TickPrefix      = []byte{0x01}
@@ -50,6 +55,11 @@ var (
 	KeyTotalLiquidity     = []byte{0x13}
 	KeyContractHookPrefix = []byte{0x14}
 
+	WithdrawOnlyPoolPrefix = []byte{0x15}
+	RangeOrderPrefix       = []byte{0x16}
+
+	KeyParams = []byte{0x17}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -170,6 +180,16 @@ func KeyFullRangeLiquidityPrefix(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", FullRangeLiquidityPrefix, poolId))
 }
 
+// KeyWithdrawOnlyPool returns the key (WithdrawOnlyPoolPrefix | pool id) marking the given pool as being in withdraw-only mode.
+func KeyWithdrawOnlyPool(poolId uint64) []byte {
+	return append(WithdrawOnlyPoolPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyRangeOrder returns the key (RangeOrderPrefix | position id) used to store the range order of the given position.
+func KeyRangeOrder(positionId uint64) []byte {
+	return append(RangeOrderPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
 // KeyPositionId returns the prefix the key consisted of (PositionIdPrefix | position Id) and is used to store position info.
 func KeyPositionId(positionId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", PositionIdPrefix, positionId))
@@ -284,6 +304,24 @@ func GetPoolIdFromShareDenom(denom string) (uint64, error) {
 	return uint64(poolId), nil
 }
 
+// GetPositionTokenDenom returns the denom of the token representing the tokenized position with the given id.
+func GetPositionTokenDenom(positionId uint64) string {
+	return fmt.Sprintf("%s/%d", PositionTokenPrefix, positionId)
+}
+
+// GetPositionIdFromTokenDenom returns the id of the position represented by the position token denom.
+func GetPositionIdFromTokenDenom(denom string) (uint64, error) {
+	positionIdStr, found := strings.CutPrefix(denom, PositionTokenPrefix+"/")
+	if !found {
+		return 0, fmt.Errorf("denom does not start with the cl position token prefix")
+	}
+	positionId, err := strconv.ParseUint(positionIdStr, base10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert positionIdStr to integer: %v", err)
+	}
+	return positionId, nil
+}
+
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
 	poolId, err := GetPoolIdFromShareDenom(denom)
 	if err != nil {
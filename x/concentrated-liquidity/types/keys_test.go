@@ -128,3 +128,18 @@ func TestAddrKeyEncoding(t *testing.T) {
 	bz := types.KeyUserPositions(accAddr)
 	require.Equal(t, "\x02|62797465735f756e6465726c79696e675f61646472657373|", string(bz))
 }
+
+func TestPositionTokenDenom(t *testing.T) {
+	denom := types.GetPositionTokenDenom(42)
+	require.Equal(t, "cl/position/42", denom)
+	require.NoError(t, sdk.ValidateDenom(denom))
+
+	positionId, err := types.GetPositionIdFromTokenDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), positionId)
+
+	_, err = types.GetPositionIdFromTokenDenom("gamm/pool/42")
+	require.Error(t, err)
+	_, err = types.GetPositionIdFromTokenDenom("cl/position/abc")
+	require.Error(t, err)
+}
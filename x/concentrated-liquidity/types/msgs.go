@@ -19,6 +19,11 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgSetPoolsWithdrawOnly    = "set-pools-withdraw-only-mode"
+	TypeMsgTokenizePosition        = "tokenize-position"
+	TypeMsgDetokenizePosition      = "detokenize-position"
+	TypeMsgClaimFilledRangeOrder   = "claim-filled-range-order"
+	TypeMsgUpdateParams            = "update-params"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -267,3 +272,154 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetPoolsWithdrawOnlyMode{}
+
+func (msg MsgSetPoolsWithdrawOnlyMode) Route() string { return RouterKey }
+func (msg MsgSetPoolsWithdrawOnlyMode) Type() string  { return TypeMsgSetPoolsWithdrawOnly }
+func (msg MsgSetPoolsWithdrawOnlyMode) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if len(msg.PoolIds) < 1 {
+		return fmt.Errorf("Must provide at least 1 pool ID, got %d", len(msg.PoolIds))
+	}
+
+	if !osmoassert.Uint64ArrayValuesAreUnique(msg.PoolIds) {
+		return fmt.Errorf("Pool IDs must be unique, got %v", msg.PoolIds)
+	}
+
+	for _, poolId := range msg.PoolIds {
+		if poolId == 0 {
+			return fmt.Errorf("Pool ID cannot be zero")
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgSetPoolsWithdrawOnlyMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPoolsWithdrawOnlyMode) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgTokenizePosition{}
+
+func (msg MsgTokenizePosition) Route() string { return RouterKey }
+func (msg MsgTokenizePosition) Type() string  { return TypeMsgTokenizePosition }
+func (msg MsgTokenizePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return fmt.Errorf("Position ID cannot be zero")
+	}
+
+	return nil
+}
+
+func (msg MsgTokenizePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTokenizePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgDetokenizePosition{}
+
+func (msg MsgDetokenizePosition) Route() string { return RouterKey }
+func (msg MsgDetokenizePosition) Type() string  { return TypeMsgDetokenizePosition }
+func (msg MsgDetokenizePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return fmt.Errorf("Position ID cannot be zero")
+	}
+
+	return nil
+}
+
+func (msg MsgDetokenizePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDetokenizePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimFilledRangeOrder{}
+
+func (msg MsgClaimFilledRangeOrder) Route() string { return RouterKey }
+func (msg MsgClaimFilledRangeOrder) Type() string  { return TypeMsgClaimFilledRangeOrder }
+func (msg MsgClaimFilledRangeOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return fmt.Errorf("Position ID cannot be zero")
+	}
+
+	return nil
+}
+
+func (msg MsgClaimFilledRangeOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimFilledRangeOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return fmt.Errorf("Invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
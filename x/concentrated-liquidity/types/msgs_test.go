@@ -434,3 +434,160 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgSetPoolsWithdrawOnlyMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSetPoolsWithdrawOnlyMode
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetPoolsWithdrawOnlyMode{
+				Sender:       addr1,
+				PoolIds:      []uint64{1, 2},
+				WithdrawOnly: true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgSetPoolsWithdrawOnlyMode{
+				Sender:  invalidAddr.String(),
+				PoolIds: []uint64{1, 2},
+			},
+			expectPass: false,
+		},
+		{
+			name: "no pool ids",
+			msg: types.MsgSetPoolsWithdrawOnlyMode{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "pool ids are not unique",
+			msg: types.MsgSetPoolsWithdrawOnlyMode{
+				Sender:  addr1,
+				PoolIds: []uint64{1, 2, 1},
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero pool id",
+			msg: types.MsgSetPoolsWithdrawOnlyMode{
+				Sender:  addr1,
+				PoolIds: []uint64{0},
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetPoolsWithdrawOnly)
+	}
+}
+
+func TestMsgTokenizePosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgTokenizePosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTokenizePosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgTokenizePosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero position id",
+			msg: types.MsgTokenizePosition{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTokenizePosition)
+	}
+}
+
+func TestMsgDetokenizePosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgDetokenizePosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgDetokenizePosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgDetokenizePosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero position id",
+			msg: types.MsgDetokenizePosition{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgDetokenizePosition)
+	}
+}
+
+func TestMsgClaimFilledRangeOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgClaimFilledRangeOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgClaimFilledRangeOrder{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgClaimFilledRangeOrder{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero position id",
+			msg: types.MsgClaimFilledRangeOrder{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgClaimFilledRangeOrder)
+	}
+}
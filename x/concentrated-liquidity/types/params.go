@@ -21,6 +21,11 @@ var (
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
 	KeyUnrestrictedPoolCreatorWhitelist   = []byte("UnrestrictedPoolCreatorWhitelist")
 	KeyHookGasLimit                       = []byte("HookGasLimit")
+	KeyMinPositionLiquidity               = []byte("MinPositionLiquidity")
+	KeyTickCrossGasCost                   = []byte("TickCrossGasCost")
+	KeyAccumulatorUpdateGasCost           = []byte("AccumulatorUpdateGasCost")
+	KeyAllPoolsWithdrawOnly               = []byte("AllPoolsWithdrawOnly")
+	KeyWithdrawOnlyModeEmergencyWhitelist = []byte("WithdrawOnlyModeEmergencyWhitelist")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -30,7 +35,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64, minPositionLiquidity osmomath.Dec, tickCrossGasCost, accumulatorUpdateGasCost uint64, allPoolsWithdrawOnly bool, withdrawOnlyModeEmergencyWhitelist []string) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -40,6 +45,11 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomat
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
 		UnrestrictedPoolCreatorWhitelist:    unrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        hookGasLimit,
+		MinPositionLiquidity:                minPositionLiquidity,
+		TickCrossGasCost:                    tickCrossGasCost,
+		AccumulatorUpdateGasCost:            accumulatorUpdateGasCost,
+		AllPoolsWithdrawOnly:                allPoolsWithdrawOnly,
+		WithdrawOnlyModeEmergencyWhitelist:  withdrawOnlyModeEmergencyWhitelist,
 	}
 }
 
@@ -59,6 +69,11 @@ func DefaultParams() Params {
 		IsPermissionlessPoolCreationEnabled: false,
 		UnrestrictedPoolCreatorWhitelist:    DefaultUnrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        DefaultContractHookGasLimit,
+		MinPositionLiquidity:                DefaultMinPositionLiquidity,
+		TickCrossGasCost:                    DefaultTickCrossGasCost,
+		AccumulatorUpdateGasCost:            DefaultAccumulatorUpdateGasCost,
+		AllPoolsWithdrawOnly:                false,
+		WithdrawOnlyModeEmergencyWhitelist:  DefaultWithdrawOnlyModeEmergencyWhitelist,
 	}
 }
 
@@ -88,6 +103,21 @@ func (p Params) Validate() error {
 	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
 		return err
 	}
+	if err := validateMinPositionLiquidity(p.MinPositionLiquidity); err != nil {
+		return err
+	}
+	if err := validateSwapGasCost(p.TickCrossGasCost); err != nil {
+		return err
+	}
+	if err := validateSwapGasCost(p.AccumulatorUpdateGasCost); err != nil {
+		return err
+	}
+	if err := validateAllPoolsWithdrawOnly(p.AllPoolsWithdrawOnly); err != nil {
+		return err
+	}
+	if err := osmoutils.ValidateAddressList(p.WithdrawOnlyModeEmergencyWhitelist); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -102,6 +132,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
 		paramtypes.NewParamSetPair(KeyUnrestrictedPoolCreatorWhitelist, &p.UnrestrictedPoolCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyMinPositionLiquidity, &p.MinPositionLiquidity, validateMinPositionLiquidity),
+		paramtypes.NewParamSetPair(KeyTickCrossGasCost, &p.TickCrossGasCost, validateSwapGasCost),
+		paramtypes.NewParamSetPair(KeyAccumulatorUpdateGasCost, &p.AccumulatorUpdateGasCost, validateSwapGasCost),
+		paramtypes.NewParamSetPair(KeyAllPoolsWithdrawOnly, &p.AllPoolsWithdrawOnly, validateAllPoolsWithdrawOnly),
+		paramtypes.NewParamSetPair(KeyWithdrawOnlyModeEmergencyWhitelist, &p.WithdrawOnlyModeEmergencyWhitelist, osmoutils.ValidateAddressList),
 	}
 }
 
@@ -194,6 +229,16 @@ func validateIsPermissionLessPoolCreationEnabled(i interface{}) error {
 	return nil
 }
 
+// validateAllPoolsWithdrawOnly validates that the given parameter is a bool. Returns error if the parameter is not of the correct type.
+func validateAllPoolsWithdrawOnly(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for all pools withdraw-only flag: %T", i)
+	}
+
+	return nil
+}
+
 // validateBalancerSharesDiscount validates that the given parameter is a osmomath.Dec. Returns error if the parameter is not of the correct type.
 func validateBalancerSharesDiscount(i interface{}) error {
 	// Convert the given parameter to osmomath.Dec.
@@ -260,3 +305,28 @@ func validateHookGasLimit(i interface{}) error {
 
 	return nil
 }
+
+// validateMinPositionLiquidity validates that the minimum position liquidity is a non-negative osmomath.Dec.
+func validateMinPositionLiquidity(i interface{}) error {
+	minPositionLiquidity, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for min position liquidity: %T", i)
+	}
+
+	if minPositionLiquidity.IsNil() || minPositionLiquidity.IsNegative() {
+		return fmt.Errorf("min position liquidity must be non-negative, was (%s)", minPositionLiquidity)
+	}
+
+	return nil
+}
+
+// validateSwapGasCost validates that the given parameter is a uint64 gas cost.
+// A zero gas cost is valid and disables the corresponding gas consumption.
+func validateSwapGasCost(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for swap gas cost: %T", i)
+	}
+
+	return nil
+}
@@ -64,6 +64,29 @@ type Params struct {
 	// double creation of pools, etc.
 	UnrestrictedPoolCreatorWhitelist []string `protobuf:"bytes,7,rep,name=unrestricted_pool_creator_whitelist,json=unrestrictedPoolCreatorWhitelist,proto3" json:"unrestricted_pool_creator_whitelist,omitempty" yaml:"unrestricted_pool_creator_whitelist"`
 	HookGasLimit                     uint64   `protobuf:"varint,8,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+	// min_position_liquidity is the minimum amount of liquidity that a position
+	// must hold after it is created or added to. This prevents attackers from
+	// bloating state and slowing down swaps by creating a large number of dust
+	// positions and initialized ticks. A value of zero disables the check.
+	MinPositionLiquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_position_liquidity,json=minPositionLiquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_position_liquidity" yaml:"min_position_liquidity"`
+	// tick_cross_gas_cost is the gas consumed by a swap for every initialized
+	// tick it crosses, so that the gas paid by swaps crossing many ticks
+	// correlates with the work done.
+	TickCrossGasCost uint64 `protobuf:"varint,10,opt,name=tick_cross_gas_cost,json=tickCrossGasCost,proto3" json:"tick_cross_gas_cost,omitempty" yaml:"tick_cross_gas_cost"`
+	// accumulator_update_gas_cost is the gas consumed by a swap for every
+	// accumulator updated when crossing a tick: the spread reward accumulator
+	// and each of the uptime accumulators.
+	AccumulatorUpdateGasCost uint64 `protobuf:"varint,11,opt,name=accumulator_update_gas_cost,json=accumulatorUpdateGasCost,proto3" json:"accumulator_update_gas_cost,omitempty" yaml:"accumulator_update_gas_cost"`
+	// all_pools_withdraw_only puts every concentrated liquidity pool in
+	// withdraw-only mode when true: swaps and new positions are rejected, but
+	// positions can still be withdrawn and rewards collected. Meant for incident
+	// response when a bug affects the whole module.
+	AllPoolsWithdrawOnly bool `protobuf:"varint,12,opt,name=all_pools_withdraw_only,json=allPoolsWithdrawOnly,proto3" json:"all_pools_withdraw_only,omitempty" yaml:"all_pools_withdraw_only"`
+	// withdraw_only_mode_emergency_whitelist is a list of addresses that are
+	// allowed to enable or disable the withdraw-only mode of pools with
+	// MsgSetPoolsWithdrawOnlyMode, so that a pool can be paused without waiting
+	// for a governance proposal to pass.
+	WithdrawOnlyModeEmergencyWhitelist []string `protobuf:"bytes,13,rep,name=withdraw_only_mode_emergency_whitelist,json=withdrawOnlyModeEmergencyWhitelist,proto3" json:"withdraw_only_mode_emergency_whitelist,omitempty" yaml:"withdraw_only_mode_emergency_whitelist"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +164,34 @@ func (m *Params) GetHookGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetTickCrossGasCost() uint64 {
+	if m != nil {
+		return m.TickCrossGasCost
+	}
+	return 0
+}
+
+func (m *Params) GetAccumulatorUpdateGasCost() uint64 {
+	if m != nil {
+		return m.AccumulatorUpdateGasCost
+	}
+	return 0
+}
+
+func (m *Params) GetAllPoolsWithdrawOnly() bool {
+	if m != nil {
+		return m.AllPoolsWithdrawOnly
+	}
+	return false
+}
+
+func (m *Params) GetWithdrawOnlyModeEmergencyWhitelist() []string {
+	if m != nil {
+		return m.WithdrawOnlyModeEmergencyWhitelist
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
 }
@@ -150,47 +201,60 @@ func init() {
 }
 
 var fileDescriptor_42a3f6981164624c = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x37, 0xbf, 0xed, 0xaf, 0x7f, 0xa2, 0x08, 0x06, 0x8b, 0xd9, 0xaa, 0x49, 0x48, 0x41,
-	0x97, 0x62, 0x13, 0xac, 0x37, 0x3d, 0x08, 0x71, 0xb5, 0x97, 0x0a, 0x35, 0x55, 0x84, 0x22, 0x0c,
-	0xb3, 0x93, 0x69, 0x76, 0xd8, 0x24, 0x4f, 0x3a, 0x33, 0xb1, 0xae, 0xe0, 0x49, 0x04, 0x8f, 0x1e,
-	0x3c, 0xf8, 0x32, 0x7c, 0x19, 0x3d, 0xf6, 0x28, 0x1e, 0xa2, 0xb4, 0x37, 0x8f, 0xfb, 0x0a, 0x64,
-	0x33, 0x59, 0xbb, 0x6b, 0x2b, 0xee, 0x2d, 0xcf, 0xf3, 0xfd, 0x3c, 0x7f, 0xf2, 0xe5, 0x61, 0xf4,
-	0x35, 0x10, 0x29, 0x08, 0x26, 0x7c, 0x02, 0x19, 0xa1, 0x99, 0xe4, 0x58, 0xd2, 0x28, 0x61, 0xfb,
-	0x05, 0x8b, 0x98, 0x1c, 0xf8, 0x39, 0xe6, 0x38, 0x15, 0x5e, 0xce, 0x41, 0x82, 0x71, 0xa3, 0x66,
-	0xbd, 0x73, 0xd9, 0x95, 0x2b, 0x31, 0xc4, 0x50, 0x91, 0xfe, 0xe8, 0x4b, 0x15, 0xad, 0xb4, 0x48,
-	0x55, 0x85, 0x94, 0xa0, 0x82, 0x5a, 0xb2, 0x62, 0x80, 0x38, 0xa1, 0x7e, 0x15, 0x75, 0x8b, 0x3d,
-	0x3f, 0x2a, 0x38, 0x96, 0x0c, 0x32, 0xa5, 0xbb, 0x5f, 0x16, 0xf4, 0xf9, 0xed, 0x6a, 0x01, 0x63,
-	0x57, 0xbf, 0x8a, 0x0b, 0xd9, 0x03, 0xce, 0xde, 0xd0, 0x08, 0x49, 0x46, 0xfa, 0x48, 0xe4, 0x98,
-	0xb0, 0x2c, 0x36, 0x35, 0xa7, 0xd9, 0x9e, 0x0b, 0xdc, 0x61, 0x69, 0x5b, 0x03, 0x9c, 0x26, 0xf7,
-	0xdc, 0xbf, 0x80, 0x6e, 0xb8, 0x7c, 0xaa, 0x3c, 0x63, 0xa4, 0xbf, 0xa3, 0xf2, 0xc6, 0x3b, 0x4d,
-	0x6f, 0x4d, 0xd4, 0x88, 0x9c, 0x53, 0x1c, 0xa1, 0x3d, 0x4c, 0x24, 0x70, 0x61, 0xfe, 0xe7, 0x34,
-	0xdb, 0x4b, 0xc1, 0xe6, 0x61, 0x69, 0x37, 0xbe, 0x95, 0xf6, 0x35, 0xf5, 0x03, 0x22, 0xea, 0x7b,
-	0x0c, 0xfc, 0x14, 0xcb, 0x9e, 0xb7, 0x45, 0x63, 0x4c, 0x06, 0x1d, 0x4a, 0x86, 0xa5, 0xed, 0x9c,
-	0xd9, 0x60, 0xba, 0x9b, 0x1b, 0x4e, 0xfc, 0xc6, 0x4e, 0x25, 0x3d, 0x56, 0x8a, 0xf1, 0x49, 0xd3,
-	0xed, 0x2e, 0x4e, 0x70, 0x46, 0x28, 0x47, 0xa2, 0x87, 0x39, 0x15, 0x88, 0xd3, 0x03, 0xcc, 0x23,
-	0x14, 0x31, 0x41, 0xa0, 0xc8, 0xa4, 0xd9, 0x74, 0xb4, 0xf6, 0x52, 0xf0, 0x64, 0xb6, 0x5d, 0x6e,
-	0xaa, 0x5d, 0xfe, 0xd1, 0xd3, 0x0d, 0xaf, 0x8f, 0x89, 0x9d, 0x0a, 0x08, 0x2b, 0xbd, 0x53, 0xcb,
-	0x7f, 0x18, 0xbf, 0x5f, 0x80, 0xa4, 0x28, 0xa2, 0x19, 0xa4, 0xc2, 0x9c, 0xab, 0x9c, 0x39, 0xdf,
-	0xf8, 0x49, 0x70, 0xca, 0xf8, 0xa7, 0x23, 0xa1, 0x53, 0xe5, 0x8d, 0xf7, 0x9a, 0x6e, 0x4c, 0xd4,
-	0x14, 0xb9, 0x64, 0x29, 0x15, 0xe6, 0xff, 0x4e, 0xb3, 0x7d, 0x61, 0xa3, 0xe5, 0xa9, 0xeb, 0xf0,
-	0xc6, 0xd7, 0xe1, 0x75, 0xea, 0xeb, 0x08, 0xee, 0x8f, 0x0c, 0xf8, 0x59, 0xda, 0xc6, 0xf8, 0x5e,
-	0x6e, 0x43, 0xca, 0x24, 0x4d, 0x73, 0x39, 0x18, 0x96, 0x76, 0xeb, 0xcc, 0x32, 0x75, 0x63, 0xf7,
-	0xf3, 0x77, 0x5b, 0x0b, 0x2f, 0x9f, 0x0a, 0xcf, 0x55, 0xde, 0xf8, 0xa0, 0xe9, 0xb7, 0x98, 0x40,
-	0x39, 0xe5, 0x29, 0x13, 0x82, 0x41, 0x96, 0x50, 0x21, 0x50, 0x0e, 0x90, 0x20, 0xc2, 0x69, 0x35,
-	0x01, 0xd1, 0x0c, 0x77, 0x13, 0x1a, 0x99, 0xf3, 0x8e, 0xd6, 0x5e, 0x0c, 0x36, 0x86, 0xa5, 0xed,
-	0xa9, 0x39, 0x33, 0x16, 0xba, 0xe1, 0x2a, 0x13, 0xdb, 0x53, 0xe0, 0x36, 0x40, 0xf2, 0xb0, 0xc6,
-	0x1e, 0x29, 0xca, 0x78, 0xab, 0xaf, 0x16, 0x19, 0xa7, 0x42, 0x72, 0x46, 0x24, 0x8d, 0x26, 0x7a,
-	0x01, 0x47, 0x07, 0x3d, 0x26, 0x69, 0xc2, 0x84, 0x34, 0x17, 0x2a, 0xeb, 0xbd, 0x61, 0x69, 0xaf,
-	0xa9, 0x2d, 0x66, 0x28, 0x72, 0x43, 0x67, 0x92, 0xfa, 0x3d, 0x1d, 0xf8, 0x8b, 0x31, 0x62, 0x3c,
-	0xd0, 0x2f, 0xf5, 0x00, 0xfa, 0x28, 0xc6, 0x02, 0x25, 0x2c, 0x65, 0xd2, 0x5c, 0x74, 0xb4, 0xf6,
-	0x5c, 0xd0, 0x1a, 0x96, 0xf6, 0xb2, 0x9a, 0x34, 0xad, 0xbb, 0xe1, 0xc5, 0x51, 0x62, 0x13, 0x8b,
-	0xad, 0x51, 0x18, 0xbc, 0x3c, 0x3c, 0xb6, 0xb4, 0xa3, 0x63, 0x4b, 0xfb, 0x71, 0x6c, 0x69, 0x1f,
-	0x4f, 0xac, 0xc6, 0xd1, 0x89, 0xd5, 0xf8, 0x7a, 0x62, 0x35, 0x76, 0x83, 0x98, 0xc9, 0x5e, 0xd1,
-	0xf5, 0x08, 0xa4, 0x7e, 0xfd, 0x8e, 0xac, 0x27, 0xb8, 0x2b, 0xc6, 0x81, 0xff, 0x6a, 0xe3, 0x8e,
-	0xff, 0x7a, 0xea, 0x19, 0x5a, 0x3f, 0x7d, 0x87, 0xe4, 0x20, 0xa7, 0xa2, 0x3b, 0x5f, 0xdd, 0xc2,
-	0xdd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x56, 0xb5, 0x87, 0xb5, 0x04, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
+	0x18, 0x8d, 0x49, 0x08, 0x8d, 0x53, 0x10, 0x98, 0x94, 0x7a, 0x53, 0x6a, 0xaf, 0x5c, 0x29, 0xac,
+	0x2a, 0x62, 0xab, 0xe1, 0x06, 0x07, 0xa4, 0xcd, 0x96, 0x5c, 0x12, 0x11, 0x1c, 0xaa, 0x8a, 0x0a,
+	0x69, 0x34, 0x3b, 0x9e, 0x7a, 0x47, 0x19, 0xfb, 0x73, 0x67, 0xc6, 0x2c, 0x5b, 0x89, 0x13, 0x42,
+	0xe2, 0xc8, 0x81, 0x03, 0xff, 0x10, 0x52, 0x8f, 0x3d, 0x22, 0x0e, 0x06, 0x25, 0x37, 0x8e, 0xfe,
+	0x0b, 0x90, 0x67, 0xbc, 0x89, 0x97, 0x6e, 0xc5, 0xde, 0x3c, 0xef, 0xbd, 0xef, 0xc7, 0x7c, 0x7e,
+	0x33, 0x63, 0xdf, 0x07, 0x99, 0x81, 0x64, 0x32, 0x22, 0x90, 0x13, 0x9a, 0x2b, 0x81, 0x15, 0x4d,
+	0x38, 0x7b, 0x56, 0xb2, 0x84, 0xa9, 0x59, 0x54, 0x60, 0x81, 0x33, 0x19, 0x16, 0x02, 0x14, 0x38,
+	0x77, 0x5b, 0x6d, 0xb8, 0x54, 0xbb, 0xbb, 0x93, 0x42, 0x0a, 0x5a, 0x19, 0x35, 0x5f, 0x26, 0x68,
+	0xb7, 0x47, 0x74, 0x14, 0x32, 0x84, 0x59, 0xb4, 0x94, 0x97, 0x02, 0xa4, 0x9c, 0x46, 0x7a, 0x35,
+	0x2e, 0x9f, 0x46, 0x49, 0x29, 0xb0, 0x62, 0x90, 0x1b, 0x3e, 0xf8, 0x7d, 0xdb, 0xde, 0x3c, 0xd5,
+	0x0d, 0x38, 0x4f, 0xec, 0xdb, 0xb8, 0x54, 0x13, 0x10, 0xec, 0x39, 0x4d, 0x90, 0x62, 0xe4, 0x1c,
+	0xc9, 0x02, 0x13, 0x96, 0xa7, 0xae, 0xd5, 0x5f, 0x1f, 0x6c, 0x0c, 0x83, 0xba, 0xf2, 0xbd, 0x19,
+	0xce, 0xf8, 0xa7, 0xc1, 0x6b, 0x84, 0x41, 0x7c, 0xeb, 0x9a, 0xf9, 0x9a, 0x91, 0xf3, 0x33, 0x83,
+	0x3b, 0x3f, 0x5a, 0x76, 0xaf, 0x13, 0x23, 0x0b, 0x41, 0x71, 0x82, 0x9e, 0x62, 0xa2, 0x40, 0x48,
+	0xf7, 0x8d, 0xfe, 0xfa, 0x60, 0x6b, 0x78, 0xf4, 0xa2, 0xf2, 0xd7, 0xfe, 0xac, 0xfc, 0x3b, 0x66,
+	0x03, 0x32, 0x39, 0x0f, 0x19, 0x44, 0x19, 0x56, 0x93, 0xf0, 0x98, 0xa6, 0x98, 0xcc, 0x46, 0x94,
+	0xd4, 0x95, 0xdf, 0x7f, 0xa5, 0x83, 0xc5, 0x6c, 0x41, 0xdc, 0xd9, 0xc6, 0x99, 0xa6, 0xbe, 0x30,
+	0x8c, 0xf3, 0xab, 0x65, 0xfb, 0x63, 0xcc, 0x71, 0x4e, 0xa8, 0x40, 0x72, 0x82, 0x05, 0x95, 0x48,
+	0xd0, 0x29, 0x16, 0x09, 0x4a, 0x98, 0x24, 0x50, 0xe6, 0xca, 0x5d, 0xef, 0x5b, 0x83, 0xad, 0xe1,
+	0xc9, 0x6a, 0xbd, 0xec, 0x99, 0x5e, 0xfe, 0x27, 0x67, 0x10, 0x7f, 0x38, 0x57, 0x9c, 0x69, 0x41,
+	0xac, 0xf9, 0x51, 0x4b, 0xff, 0x67, 0xf0, 0xcf, 0x4a, 0x50, 0x14, 0x25, 0x34, 0x87, 0x4c, 0xba,
+	0x1b, 0x7a, 0x32, 0xcb, 0x07, 0xdf, 0x15, 0x2e, 0x0c, 0xfe, 0xab, 0x86, 0x18, 0x69, 0xdc, 0xf9,
+	0xc9, 0xb2, 0x9d, 0x4e, 0x4c, 0x59, 0x28, 0x96, 0x51, 0xe9, 0xbe, 0xd9, 0x5f, 0x1f, 0x6c, 0x1f,
+	0xf4, 0x42, 0xe3, 0x8e, 0x70, 0xee, 0x8e, 0x70, 0xd4, 0xba, 0x63, 0xf8, 0x59, 0x33, 0x80, 0x7f,
+	0x2a, 0xdf, 0x99, 0xfb, 0xe5, 0x63, 0xc8, 0x98, 0xa2, 0x59, 0xa1, 0x66, 0x75, 0xe5, 0xf7, 0x5e,
+	0x69, 0xa6, 0x4d, 0x1c, 0xfc, 0xf6, 0x97, 0x6f, 0xc5, 0xef, 0x5d, 0x13, 0x8f, 0x0c, 0xee, 0xfc,
+	0x6c, 0xd9, 0x1f, 0x31, 0x89, 0x0a, 0x2a, 0x32, 0x26, 0x25, 0x83, 0x9c, 0x53, 0x29, 0x51, 0x01,
+	0xc0, 0x11, 0x11, 0x54, 0x57, 0x40, 0x34, 0xc7, 0x63, 0x4e, 0x13, 0x77, 0xb3, 0x6f, 0x0d, 0x6e,
+	0x0c, 0x0f, 0xea, 0xca, 0x0f, 0x4d, 0x9d, 0x15, 0x03, 0x83, 0xf8, 0x1e, 0x93, 0xa7, 0x0b, 0xc2,
+	0x53, 0x00, 0x7e, 0xd8, 0xca, 0x1e, 0x1a, 0x95, 0xf3, 0x83, 0x7d, 0xaf, 0xcc, 0x05, 0x95, 0x4a,
+	0x30, 0xa2, 0x68, 0xd2, 0xc9, 0x05, 0x02, 0x4d, 0x27, 0x4c, 0x51, 0xce, 0xa4, 0x72, 0xdf, 0xd2,
+	0xa3, 0x0f, 0xeb, 0xca, 0xbf, 0x6f, 0xba, 0x58, 0x21, 0x28, 0x88, 0xfb, 0x5d, 0xd5, 0x55, 0x75,
+	0x10, 0x8f, 0xe7, 0x12, 0xe7, 0x73, 0xfb, 0x9d, 0x09, 0xc0, 0x39, 0x4a, 0xb1, 0x44, 0x9c, 0x65,
+	0x4c, 0xb9, 0x37, 0xfa, 0xd6, 0x60, 0x63, 0xd8, 0xab, 0x2b, 0xff, 0x96, 0xa9, 0xb4, 0xc8, 0x07,
+	0xf1, 0xcd, 0x06, 0x38, 0xc2, 0xf2, 0xb8, 0x59, 0x3a, 0xcf, 0xed, 0x0f, 0x32, 0x96, 0xa3, 0x02,
+	0x24, 0xd3, 0xbb, 0xbf, 0xba, 0x1d, 0xdc, 0x2d, 0xed, 0xdd, 0xd1, 0x6a, 0xde, 0xbd, 0x6b, 0x6a,
+	0x2d, 0x4f, 0x15, 0xc4, 0x3b, 0x19, 0xcb, 0x4f, 0x5b, 0xfc, 0x78, 0x0e, 0x3b, 0x27, 0xf6, 0xfb,
+	0xfa, 0xbc, 0x13, 0x01, 0x52, 0xea, 0x16, 0x09, 0x48, 0xe5, 0xda, 0x7a, 0x07, 0x5e, 0x5d, 0xf9,
+	0xbb, 0x26, 0xeb, 0x12, 0x51, 0x10, 0xbf, 0xdb, 0xa0, 0x87, 0x0d, 0x78, 0x84, 0xe5, 0x21, 0x48,
+	0xe5, 0x50, 0xfb, 0x0e, 0x26, 0xa4, 0xcc, 0x4a, 0xae, 0xe7, 0x58, 0x16, 0x09, 0x56, 0xf4, 0x3a,
+	0xed, 0xb6, 0x4e, 0xbb, 0x57, 0x57, 0x7e, 0xd0, 0x1a, 0xee, 0xf5, 0xe2, 0x20, 0x76, 0x3b, 0xec,
+	0x23, 0x4d, 0xce, 0xcb, 0x7c, 0x63, 0xdf, 0xc6, 0x9c, 0xeb, 0x7f, 0x26, 0xd1, 0x94, 0xa9, 0x49,
+	0x22, 0xf0, 0x14, 0x41, 0xce, 0x67, 0xee, 0x4d, 0xed, 0xb5, 0xee, 0x01, 0x5b, 0x2e, 0x0c, 0xe2,
+	0x1d, 0xcc, 0x79, 0xf3, 0x43, 0xe5, 0xe3, 0x16, 0xff, 0x32, 0xe7, 0xb3, 0xe6, 0x7c, 0xed, 0x2d,
+	0x08, 0x51, 0x06, 0x09, 0x45, 0x34, 0xa3, 0x22, 0xa5, 0x39, 0x99, 0x75, 0x0c, 0xf5, 0xb6, 0x36,
+	0xd4, 0x83, 0xba, 0xf2, 0xf7, 0x4d, 0xa9, 0xd5, 0xe2, 0x82, 0x38, 0x98, 0x76, 0x2a, 0x9e, 0x40,
+	0x42, 0x1f, 0xce, 0x55, 0x57, 0xae, 0x1a, 0x7e, 0xfb, 0xe2, 0xc2, 0xb3, 0x5e, 0x5e, 0x78, 0xd6,
+	0xdf, 0x17, 0x9e, 0xf5, 0xcb, 0xa5, 0xb7, 0xf6, 0xf2, 0xd2, 0x5b, 0xfb, 0xe3, 0xd2, 0x5b, 0x7b,
+	0x32, 0x4c, 0x99, 0x9a, 0x94, 0xe3, 0x90, 0x40, 0x16, 0xb5, 0x8f, 0xcb, 0x3e, 0xc7, 0x63, 0x39,
+	0x5f, 0x44, 0xdf, 0x1d, 0x3c, 0x88, 0xbe, 0x5f, 0x78, 0x9b, 0xf6, 0xaf, 0x1f, 0x27, 0x35, 0x2b,
+	0xa8, 0x1c, 0x6f, 0xea, 0x0b, 0xe2, 0x93, 0x7f, 0x07, 0x00, 0xc1, 0xc5, 0xd5, 0x23, 0xca, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -213,6 +277,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WithdrawOnlyModeEmergencyWhitelist) > 0 {
+		for iNdEx := len(m.WithdrawOnlyModeEmergencyWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WithdrawOnlyModeEmergencyWhitelist[iNdEx])
+			copy(dAtA[i:], m.WithdrawOnlyModeEmergencyWhitelist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.WithdrawOnlyModeEmergencyWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.AllPoolsWithdrawOnly {
+		i--
+		if m.AllPoolsWithdrawOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AccumulatorUpdateGasCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccumulatorUpdateGasCost))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TickCrossGasCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TickCrossGasCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MinPositionLiquidity.Size()
+		i -= size
+		if _, err := m.MinPositionLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.HookGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
 		i--
@@ -359,6 +462,23 @@ func (m *Params) Size() (n int) {
 	if m.HookGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.HookGasLimit))
 	}
+	l = m.MinPositionLiquidity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.TickCrossGasCost != 0 {
+		n += 1 + sovParams(uint64(m.TickCrossGasCost))
+	}
+	if m.AccumulatorUpdateGasCost != 0 {
+		n += 1 + sovParams(uint64(m.AccumulatorUpdateGasCost))
+	}
+	if m.AllPoolsWithdrawOnly {
+		n += 2
+	}
+	if len(m.WithdrawOnlyModeEmergencyWhitelist) > 0 {
+		for _, s := range m.WithdrawOnlyModeEmergencyWhitelist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -680,6 +800,130 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPositionLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPositionLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCrossGasCost", wireType)
+			}
+			m.TickCrossGasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickCrossGasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatorUpdateGasCost", wireType)
+			}
+			m.AccumulatorUpdateGasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccumulatorUpdateGasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllPoolsWithdrawOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllPoolsWithdrawOnly = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawOnlyModeEmergencyWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawOnlyModeEmergencyWhitelist = append(m.WithdrawOnlyModeEmergencyWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -107,3 +107,42 @@ func TestValidateBalancerSharesDiscount(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMinPositionLiquidity(t *testing.T) {
+	tests := map[string]struct {
+		i           interface{}
+		expectError bool
+	}{
+		"happy path": {
+			i: osmomath.NewDec(1_000_000),
+		},
+		"default (zero) min position liquidity": {
+			i: types.DefaultMinPositionLiquidity,
+		},
+		"error: negative min position liquidity": {
+			i:           osmomath.NewDec(-1),
+			expectError: true,
+		},
+		"error: nil min position liquidity": {
+			i:           osmomath.Dec{},
+			expectError: true,
+		},
+		"error: wrong type": {
+			i:           uint64(1),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateMinPositionLiquidity(tc.i)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
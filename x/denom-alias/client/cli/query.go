@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdDenomAlias)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdDenomByAlias)
+	cmd.AddCommand(GetCmdAllDenomAliases())
+
+	return cmd
+}
+
+func GetCmdDenomAlias() (*osmocli.QueryDescriptor, *queryproto.DenomAliasRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-alias [denom]",
+		Short: "Query the alias and the denom trace of an IBC denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} denom-alias ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`,
+	}, &queryproto.DenomAliasRequest{}
+}
+
+func GetCmdDenomByAlias() (*osmocli.QueryDescriptor, *queryproto.DenomByAliasRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-by-alias [alias]",
+		Short: "Query the IBC denom with the given alias",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} denom-by-alias atom`,
+	}, &queryproto.DenomByAliasRequest{}
+}
+
+func GetCmdAllDenomAliases() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.AllDenomAliasesRequest](
+		"all-denom-aliases",
+		"Query the aliases of all the IBC denoms",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} all-denom-aliases
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
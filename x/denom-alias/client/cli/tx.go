@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+const FlagAliases = "aliases"
+
+func NewCmdSubmitSetDenomAliasesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-denom-aliases [flags]",
+		Args:    cobra.ExactArgs(0),
+		Example: "set-denom-aliases --aliases ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2,atom --from val --chain-id osmosis-1",
+		Short:   "Submit a set denom aliases proposal",
+		Long: strings.TrimSpace(`Submit a set denom aliases proposal.
+
+Passing in denom,alias pairs separated by commas would be parsed automatically to pairs of denom alias records.
+An empty alias removes the alias of the denom.
+Ex) ibc/27394FB0...,atom,ibc/D189335C...,  -> [Sets atom as the alias of ibc/27394FB0..., Removes the alias of ibc/D189335C...]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseDenomAliasesArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagAliases, "", "The denom alias records array")
+
+	return cmd
+}
+
+func parseDenomAliases(cmd *cobra.Command) ([]types.DenomAlias, error) {
+	aliasesStr, err := cmd.Flags().GetString(FlagAliases)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := strings.Split(aliasesStr, ",")
+
+	if len(aliases)%2 != 0 {
+		return nil, errors.New("denom alias records should be a comma separated list of denom and alias pairs")
+	}
+
+	denomAliases := []types.DenomAlias{}
+	for i := 0; i < len(aliases); i += 2 {
+		denomAliases = append(denomAliases, types.DenomAlias{
+			Denom: aliases[i],
+			Alias: aliases[i+1],
+		})
+	}
+
+	return denomAliases, nil
+}
+
+func parseDenomAliasesArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	denomAliases, err := parseDenomAliases(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetDenomAliasesProposal{
+		Title:       title,
+		Description: description,
+		Aliases:     denomAliases,
+	}
+	return content, nil
+}
@@ -0,0 +1,53 @@
+
+package grpc
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/denomalias/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/client"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/client/queryproto"
+)
+
+type Querier struct {
+	Q client.Querier
+}
+
+var _ queryproto.QueryServer = Querier{}
+
+func (q Querier) DenomByAlias(grpcCtx context.Context,
+	req *queryproto.DenomByAliasRequest,
+) (*queryproto.DenomByAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DenomByAlias(ctx, *req)
+}
+
+func (q Querier) DenomAlias(grpcCtx context.Context,
+	req *queryproto.DenomAliasRequest,
+) (*queryproto.DenomAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DenomAlias(ctx, *req)
+}
+
+func (q Querier) AllDenomAliases(grpcCtx context.Context,
+	req *queryproto.AllDenomAliasesRequest,
+) (*queryproto.AllDenomAliasesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AllDenomAliases(ctx, *req)
+}
+
@@ -0,0 +1,11 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	SubmitSetDenomAliasesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetDenomAliasesProposal)
+)
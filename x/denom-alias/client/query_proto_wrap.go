@@ -0,0 +1,40 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	denomalias "github.com/osmosis-labs/osmosis/v21/x/denom-alias"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/client/queryproto"
+)
+
+type Querier struct {
+	K denomalias.Keeper
+}
+
+func (querier *Querier) DenomAlias(ctx sdk.Context, req queryproto.DenomAliasRequest) (*queryproto.DenomAliasResponse, error) {
+	alias, trace, err := querier.K.DenomAlias(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.DenomAliasResponse{
+		Alias:     alias,
+		Path:      trace.Path,
+		BaseDenom: trace.BaseDenom,
+	}, nil
+}
+
+func (querier *Querier) DenomByAlias(ctx sdk.Context, req queryproto.DenomByAliasRequest) (*queryproto.DenomByAliasResponse, error) {
+	denom, err := querier.K.GetDenomByAlias(ctx, req.Alias)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.DenomByAliasResponse{Denom: denom}, nil
+}
+
+func (querier *Querier) AllDenomAliases(ctx sdk.Context, req queryproto.AllDenomAliasesRequest) (*queryproto.AllDenomAliasesResponse, error) {
+	aliases, err := querier.K.GetAllDenomAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.AllDenomAliasesResponse{Aliases: aliases}, nil
+}
@@ -0,0 +1,1420 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/denomalias/v1beta1/query.proto
+
+package queryproto
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomAliasRequest takes the full IBC denom, such as ibc/27394FB0...
+type DenomAliasRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *DenomAliasRequest) Reset()         { *m = DenomAliasRequest{} }
+func (m *DenomAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomAliasRequest) ProtoMessage()    {}
+func (*DenomAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975ac1585d0e283e, []int{0}
+}
+func (m *DenomAliasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAliasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAliasRequest.Merge(m, src)
+}
+func (m *DenomAliasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAliasRequest proto.InternalMessageInfo
+
+func (m *DenomAliasRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type DenomAliasResponse struct {
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty" yaml:"alias"`
+	// path and base_denom are the denom trace of the IBC denom, empty if the
+	// trace is unknown to the transfer module.
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty" yaml:"path"`
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+}
+
+func (m *DenomAliasResponse) Reset()         { *m = DenomAliasResponse{} }
+func (m *DenomAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomAliasResponse) ProtoMessage()    {}
+func (*DenomAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975ac1585d0e283e, []int{1}
+}
+func (m *DenomAliasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAliasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAliasResponse.Merge(m, src)
+}
+func (m *DenomAliasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAliasResponse proto.InternalMessageInfo
+
+func (m *DenomAliasResponse) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *DenomAliasResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DenomAliasResponse) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+type DenomByAliasRequest struct {
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty" yaml:"alias"`
+}
+
+func (m *DenomByAliasRequest) Reset()         { *m = DenomByAliasRequest{} }
+func (m *DenomByAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomByAliasRequest) ProtoMessage()    {}
+func (*DenomByAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975ac1585d0e283e, []int{2}
+}
+func (m *DenomByAliasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomByAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomByAliasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomByAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomByAliasRequest.Merge(m, src)
+}
+func (m *DenomByAliasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomByAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomByAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomByAliasRequest proto.InternalMessageInfo
+
+func (m *DenomByAliasRequest) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+type DenomByAliasResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *DenomByAliasResponse) Reset()         { *m = DenomByAliasResponse{} }
+func (m *DenomByAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomByAliasResponse) ProtoMessage()    {}
+func (*DenomByAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975ac1585d0e283e, []int{3}
+}
+func (m *DenomByAliasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomByAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomByAliasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomByAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomByAliasResponse.Merge(m, src)
+}
+func (m *DenomByAliasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomByAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomByAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomByAliasResponse proto.InternalMessageInfo
+
+func (m *DenomByAliasResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type AllDenomAliasesRequest struct {
+}
+
+func (m *AllDenomAliasesRequest) Reset()         { *m = AllDenomAliasesRequest{} }
+func (m *AllDenomAliasesRequest) String() string { return proto.CompactTextString(m) }
+func (*AllDenomAliasesRequest) ProtoMessage()    {}
+func (*AllDenomAliasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975ac1585d0e283e, []int{4}
+}
+func (m *AllDenomAliasesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllDenomAliasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllDenomAliasesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllDenomAliasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllDenomAliasesRequest.Merge(m, src)
+}
+func (m *AllDenomAliasesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllDenomAliasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllDenomAliasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllDenomAliasesRequest proto.InternalMessageInfo
+
+type AllDenomAliasesResponse struct {
+	Aliases []types.DenomAlias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases"`
+}
+
+func (m *AllDenomAliasesResponse) Reset()         { *m = AllDenomAliasesResponse{} }
+func (m *AllDenomAliasesResponse) String() string { return proto.CompactTextString(m) }
+func (*AllDenomAliasesResponse) ProtoMessage()    {}
+func (*AllDenomAliasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975ac1585d0e283e, []int{5}
+}
+func (m *AllDenomAliasesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllDenomAliasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllDenomAliasesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllDenomAliasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllDenomAliasesResponse.Merge(m, src)
+}
+func (m *AllDenomAliasesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllDenomAliasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllDenomAliasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllDenomAliasesResponse proto.InternalMessageInfo
+
+func (m *AllDenomAliasesResponse) GetAliases() []types.DenomAlias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DenomAliasRequest)(nil), "osmosis.denomalias.v1beta1.DenomAliasRequest")
+	proto.RegisterType((*DenomAliasResponse)(nil), "osmosis.denomalias.v1beta1.DenomAliasResponse")
+	proto.RegisterType((*DenomByAliasRequest)(nil), "osmosis.denomalias.v1beta1.DenomByAliasRequest")
+	proto.RegisterType((*DenomByAliasResponse)(nil), "osmosis.denomalias.v1beta1.DenomByAliasResponse")
+	proto.RegisterType((*AllDenomAliasesRequest)(nil), "osmosis.denomalias.v1beta1.AllDenomAliasesRequest")
+	proto.RegisterType((*AllDenomAliasesResponse)(nil), "osmosis.denomalias.v1beta1.AllDenomAliasesResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/denomalias/v1beta1/query.proto", fileDescriptor_975ac1585d0e283e)
+}
+
+var fileDescriptor_975ac1585d0e283e = []byte{
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x6d, 0xaa, 0xf4, 0xb5, 0x50, 0x3b, 0x56, 0x0d, 0x8b, 0x6c, 0xca, 0x08, 0x21,
+	0x08, 0xd9, 0xc9, 0x0f, 0xf5, 0x60, 0x51, 0x68, 0x14, 0xef, 0xee, 0x51, 0x90, 0x30, 0x5b, 0x87,
+	0xed, 0xc2, 0x64, 0x27, 0xed, 0x6c, 0x8a, 0x41, 0xbc, 0x78, 0x17, 0x04, 0x0f, 0x9e, 0xfc, 0x17,
+	0x3c, 0xf8, 0x57, 0xf4, 0x58, 0xf0, 0xe2, 0x29, 0x48, 0xe2, 0x5f, 0x90, 0xbf, 0x40, 0x76, 0x66,
+	0xe2, 0x66, 0x1b, 0x8d, 0xeb, 0x69, 0x77, 0xdf, 0xfb, 0x7e, 0xdf, 0xfb, 0xec, 0x9b, 0xc7, 0x40,
+	0x4d, 0xaa, 0xbe, 0x54, 0x91, 0xa2, 0xaf, 0x78, 0x2c, 0xfb, 0x4c, 0x44, 0x4c, 0xd1, 0xb3, 0x56,
+	0xc0, 0x13, 0xd6, 0xa2, 0x27, 0x43, 0x7e, 0x3a, 0xf2, 0x06, 0xa7, 0x32, 0x91, 0xd8, 0xb1, 0x3a,
+	0x2f, 0xd3, 0x79, 0x56, 0xe7, 0xec, 0x85, 0x32, 0x94, 0x5a, 0x46, 0xd3, 0x37, 0xe3, 0x70, 0x6e,
+	0x87, 0x52, 0x86, 0x82, 0x53, 0x36, 0x88, 0x28, 0x8b, 0x63, 0x99, 0xb0, 0x24, 0x92, 0xb1, 0xb2,
+	0xd9, 0xfa, 0x8a, 0xbe, 0x21, 0x8f, 0xb9, 0x8a, 0xac, 0x92, 0x1c, 0xc0, 0xee, 0xd3, 0x54, 0x73,
+	0x98, 0x6a, 0x7c, 0x7e, 0x32, 0xe4, 0x2a, 0xc1, 0x35, 0xd8, 0xd0, 0xc6, 0x0a, 0xda, 0x47, 0xf5,
+	0xcd, 0xee, 0xb5, 0xd9, 0xb8, 0xba, 0x3d, 0x62, 0x7d, 0xf1, 0x90, 0xe8, 0x30, 0xf1, 0x4d, 0x9a,
+	0x7c, 0x42, 0x80, 0x17, 0xdd, 0x6a, 0x20, 0x63, 0xc5, 0x53, 0xbb, 0x6e, 0xb9, 0x6c, 0xd7, 0x61,
+	0xe2, 0x9b, 0x34, 0xbe, 0x03, 0xe5, 0x01, 0x4b, 0x8e, 0x2b, 0x6b, 0x5a, 0xb6, 0x33, 0x1b, 0x57,
+	0xb7, 0x8c, 0x2c, 0x8d, 0x12, 0x5f, 0x27, 0xf1, 0x3d, 0x80, 0x80, 0x29, 0xde, 0x33, 0x40, 0xeb,
+	0x5a, 0x7a, 0x63, 0x36, 0xae, 0xee, 0x1a, 0x69, 0x96, 0x23, 0xfe, 0x66, 0xfa, 0xa1, 0x79, 0xc8,
+	0x23, 0xb8, 0xae, 0x5f, 0xba, 0xa3, 0xcb, 0x3f, 0x56, 0x84, 0x8c, 0x3c, 0x86, 0xbd, 0xbc, 0x3d,
+	0xfb, 0xb3, 0x42, 0x83, 0xa9, 0xc0, 0xcd, 0x43, 0x21, 0xb2, 0xd1, 0xf0, 0x39, 0x01, 0x61, 0x70,
+	0x6b, 0x29, 0x63, 0x8b, 0x3f, 0x83, 0xab, 0xcc, 0x84, 0x2a, 0x68, 0x7f, 0xbd, 0xbe, 0xd5, 0xae,
+	0x79, 0x7f, 0x5f, 0x0b, 0x2f, 0x2b, 0xd1, 0x2d, 0x9f, 0x8f, 0xab, 0x25, 0x7f, 0x6e, 0x6e, 0xbf,
+	0x2f, 0xc3, 0xc6, 0xf3, 0x74, 0xb9, 0xf0, 0x67, 0x04, 0x90, 0xe9, 0x70, 0xa3, 0x58, 0x3d, 0x8b,
+	0xea, 0x78, 0x45, 0xe5, 0x86, 0x9f, 0x34, 0xdf, 0x7d, 0xfb, 0xf9, 0x71, 0xed, 0x2e, 0xae, 0xd3,
+	0xdc, 0xf6, 0x35, 0xf2, 0xeb, 0xa7, 0x63, 0x3d, 0xb3, 0x00, 0x5f, 0x10, 0x6c, 0x2f, 0xce, 0x19,
+	0xd3, 0x7f, 0xb6, 0xcc, 0x1f, 0xa8, 0xd3, 0x2c, 0x6e, 0xb0, 0x94, 0x07, 0x9a, 0xf2, 0x3e, 0xee,
+	0x14, 0xa0, 0x0c, 0x46, 0x06, 0x94, 0xbe, 0xd1, 0x8f, 0xb7, 0xf8, 0x2b, 0x82, 0x9d, 0x4b, 0xc7,
+	0x87, 0xdb, 0xab, 0x10, 0xfe, 0xbc, 0x05, 0x4e, 0xe7, 0xbf, 0x3c, 0x96, 0xfc, 0x81, 0x26, 0x6f,
+	0x62, 0x6f, 0x25, 0x39, 0x13, 0xa2, 0xb7, 0x30, 0x63, 0xae, 0xba, 0x2f, 0xcf, 0x27, 0x2e, 0xba,
+	0x98, 0xb8, 0xe8, 0xc7, 0xc4, 0x45, 0x1f, 0xa6, 0x6e, 0xe9, 0x62, 0xea, 0x96, 0xbe, 0x4f, 0xdd,
+	0xd2, 0x8b, 0x27, 0x61, 0x94, 0x1c, 0x0f, 0x03, 0xef, 0x48, 0xf6, 0xe7, 0x35, 0x1b, 0x82, 0x05,
+	0xea, 0x77, 0x83, 0xb3, 0x76, 0x8b, 0xbe, 0xce, 0xb5, 0x39, 0x12, 0x11, 0x8f, 0x13, 0x73, 0x79,
+	0xe9, 0x1b, 0x24, 0xb8, 0xa2, 0x1f, 0x9d, 0x5f, 0x03, 0x00, 0x4a, 0x65, 0xcf, 0xc9, 0xec, 0x04,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// DenomAlias returns the alias of an IBC denom, along with its denom trace.
+	DenomAlias(ctx context.Context, in *DenomAliasRequest, opts ...grpc.CallOption) (*DenomAliasResponse, error)
+	// DenomByAlias returns the IBC denom with the given alias.
+	DenomByAlias(ctx context.Context, in *DenomByAliasRequest, opts ...grpc.CallOption) (*DenomByAliasResponse, error)
+	// AllDenomAliases returns all the IBC denom aliases.
+	AllDenomAliases(ctx context.Context, in *AllDenomAliasesRequest, opts ...grpc.CallOption) (*AllDenomAliasesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DenomAlias(ctx context.Context, in *DenomAliasRequest, opts ...grpc.CallOption) (*DenomAliasResponse, error) {
+	out := new(DenomAliasResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.denomalias.v1beta1.Query/DenomAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomByAlias(ctx context.Context, in *DenomByAliasRequest, opts ...grpc.CallOption) (*DenomByAliasResponse, error) {
+	out := new(DenomByAliasResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.denomalias.v1beta1.Query/DenomByAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDenomAliases(ctx context.Context, in *AllDenomAliasesRequest, opts ...grpc.CallOption) (*AllDenomAliasesResponse, error) {
+	out := new(AllDenomAliasesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.denomalias.v1beta1.Query/AllDenomAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DenomAlias returns the alias of an IBC denom, along with its denom trace.
+	DenomAlias(context.Context, *DenomAliasRequest) (*DenomAliasResponse, error)
+	// DenomByAlias returns the IBC denom with the given alias.
+	DenomByAlias(context.Context, *DenomByAliasRequest) (*DenomByAliasResponse, error)
+	// AllDenomAliases returns all the IBC denom aliases.
+	AllDenomAliases(context.Context, *AllDenomAliasesRequest) (*AllDenomAliasesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DenomAlias(ctx context.Context, req *DenomAliasRequest) (*DenomAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAlias not implemented")
+}
+func (*UnimplementedQueryServer) DenomByAlias(ctx context.Context, req *DenomByAliasRequest) (*DenomByAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomByAlias not implemented")
+}
+func (*UnimplementedQueryServer) AllDenomAliases(ctx context.Context, req *AllDenomAliasesRequest) (*AllDenomAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDenomAliases not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DenomAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.denomalias.v1beta1.Query/DenomAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAlias(ctx, req.(*DenomAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomByAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomByAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomByAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.denomalias.v1beta1.Query/DenomByAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomByAlias(ctx, req.(*DenomByAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDenomAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllDenomAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDenomAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.denomalias.v1beta1.Query/AllDenomAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDenomAliases(ctx, req.(*AllDenomAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.denomalias.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DenomAlias",
+			Handler:    _Query_DenomAlias_Handler,
+		},
+		{
+			MethodName: "DenomByAlias",
+			Handler:    _Query_DenomByAlias_Handler,
+		},
+		{
+			MethodName: "AllDenomAliases",
+			Handler:    _Query_AllDenomAliases_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/denomalias/v1beta1/query.proto",
+}
+
+func (m *DenomAliasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAliasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAliasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomAliasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAliasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAliasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomByAliasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomByAliasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomByAliasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomByAliasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomByAliasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomByAliasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllDenomAliasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllDenomAliasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllDenomAliasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllDenomAliasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllDenomAliasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllDenomAliasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomAliasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomAliasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomByAliasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomByAliasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllDenomAliasesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllDenomAliasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for _, e := range m.Aliases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomAliasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAliasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAliasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomAliasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAliasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAliasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomByAliasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomByAliasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomByAliasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomByAliasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomByAliasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomByAliasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllDenomAliasesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllDenomAliasesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllDenomAliasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllDenomAliasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllDenomAliasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllDenomAliasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, types.DenomAlias{})
+			if err := m.Aliases[len(m.Aliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
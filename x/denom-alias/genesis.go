@@ -0,0 +1,26 @@
+package denomalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+func (k Keeper) InitGenesis(ctx sdk.Context, gen *types.GenesisState) {
+	for _, denomAlias := range gen.Aliases {
+		if err := k.SetDenomAlias(ctx, denomAlias); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the ibc denom alias module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	aliases, err := k.GetAllDenomAliases(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		Aliases: aliases,
+	}
+}
@@ -0,0 +1,17 @@
+package denomalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+func (k Keeper) HandleSetDenomAliasesProposal(ctx sdk.Context, p *types.SetDenomAliasesProposal) error {
+	// SetDenomAlias internally validates the alias
+	for _, denomAlias := range p.Aliases {
+		if err := k.SetDenomAlias(ctx, denomAlias); err != nil {
+			return err
+		}
+	}
+	return nil
+}
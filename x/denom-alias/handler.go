@@ -0,0 +1,23 @@
+package denomalias
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+func NewSetDenomAliasesProposalHandler(k Keeper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.SetDenomAliasesProposal:
+			return k.HandleSetDenomAliasesProposal(ctx, c)
+
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc denom alias proposal content type: %T", c)
+		}
+	}
+}
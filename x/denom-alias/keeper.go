@@ -0,0 +1,16 @@
+package denomalias
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+type Keeper struct {
+	storeKey       storetypes.StoreKey
+	transferKeeper types.TransferKeeper
+}
+
+func NewKeeper(storeKey storetypes.StoreKey, transferKeeper types.TransferKeeper) *Keeper {
+	return &Keeper{storeKey: storeKey, transferKeeper: transferKeeper}
+}
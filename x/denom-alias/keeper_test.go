@@ -0,0 +1,165 @@
+package denomalias_test
+
+import (
+	"testing"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+var (
+	atomTrace = ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	atomDenom = atomTrace.IBCDenom()
+	usdcTrace = ibctransfertypes.DenomTrace{Path: "transfer/channel-750", BaseDenom: "uusdc"}
+	usdcDenom = usdcTrace.IBCDenom()
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestSetDenomAlias() {
+	tests := map[string]struct {
+		existingAliases []types.DenomAlias
+		denomAlias      types.DenomAlias
+		expectedAliases []types.DenomAlias
+		expectedErr     error
+	}{
+		"set new alias": {
+			denomAlias:      types.DenomAlias{Denom: atomDenom, Alias: "atom"},
+			expectedAliases: []types.DenomAlias{{Denom: atomDenom, Alias: "atom"}},
+		},
+		"rename alias": {
+			existingAliases: []types.DenomAlias{{Denom: atomDenom, Alias: "atom"}},
+			denomAlias:      types.DenomAlias{Denom: atomDenom, Alias: "cosmos"},
+			expectedAliases: []types.DenomAlias{{Denom: atomDenom, Alias: "cosmos"}},
+		},
+		"remove alias": {
+			existingAliases: []types.DenomAlias{{Denom: atomDenom, Alias: "atom"}, {Denom: usdcDenom, Alias: "usdc"}},
+			denomAlias:      types.DenomAlias{Denom: atomDenom},
+			expectedAliases: []types.DenomAlias{{Denom: usdcDenom, Alias: "usdc"}},
+		},
+		"error: alias already used by another denom": {
+			existingAliases: []types.DenomAlias{{Denom: atomDenom, Alias: "atom"}},
+			denomAlias:      types.DenomAlias{Denom: usdcDenom, Alias: "atom"},
+			expectedAliases: []types.DenomAlias{{Denom: atomDenom, Alias: "atom"}},
+			expectedErr:     types.AliasAlreadyUsedError{Alias: "atom", ExistingDenom: atomDenom},
+		},
+		"error: not an IBC denom": {
+			denomAlias:      types.DenomAlias{Denom: "uosmo", Alias: "osmo"},
+			expectedAliases: []types.DenomAlias{},
+			expectedErr:     types.ErrInvalidIBCDenom,
+		},
+		"error: alias looks like an IBC denom": {
+			denomAlias:      types.DenomAlias{Denom: atomDenom, Alias: usdcDenom},
+			expectedAliases: []types.DenomAlias{},
+			expectedErr:     types.ErrInvalidAlias,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			k := s.App.DenomAliasKeeper
+
+			for _, denomAlias := range tc.existingAliases {
+				s.Require().NoError(k.SetDenomAlias(s.Ctx, denomAlias))
+			}
+
+			err := k.SetDenomAlias(s.Ctx, tc.denomAlias)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			aliases, err := k.GetAllDenomAliases(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expectedAliases, aliases)
+
+			// The reverse index must match the aliases.
+			for _, denomAlias := range aliases {
+				denom, err := k.GetDenomByAlias(s.Ctx, denomAlias.Alias)
+				s.Require().NoError(err)
+				s.Require().Equal(denomAlias.Denom, denom)
+			}
+			if tc.expectedErr == nil && tc.denomAlias.Alias == "" {
+				s.Require().Equal(tc.denomAlias.Denom, k.DisplayDenom(s.Ctx, tc.denomAlias.Denom))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRenamedAliasIsFreed() {
+	k := s.App.DenomAliasKeeper
+	s.Require().NoError(k.SetDenomAlias(s.Ctx, types.DenomAlias{Denom: atomDenom, Alias: "atom"}))
+	s.Require().NoError(k.SetDenomAlias(s.Ctx, types.DenomAlias{Denom: atomDenom, Alias: "cosmos"}))
+
+	_, err := k.GetDenomByAlias(s.Ctx, "atom")
+	s.Require().ErrorIs(err, types.AliasNotFoundError{Alias: "atom"})
+
+	// The previous alias can be given to another denom.
+	s.Require().NoError(k.SetDenomAlias(s.Ctx, types.DenomAlias{Denom: usdcDenom, Alias: "atom"}))
+	s.Require().Equal("atom", k.DisplayDenom(s.Ctx, usdcDenom))
+	s.Require().Equal("cosmos", k.DisplayDenom(s.Ctx, atomDenom))
+}
+
+func (s *KeeperTestSuite) TestDenomAlias() {
+	k := s.App.DenomAliasKeeper
+	s.App.TransferKeeper.SetDenomTrace(s.Ctx, atomTrace)
+	s.Require().NoError(k.SetDenomAlias(s.Ctx, types.DenomAlias{Denom: atomDenom, Alias: "atom"}))
+	s.Require().NoError(k.SetDenomAlias(s.Ctx, types.DenomAlias{Denom: usdcDenom, Alias: "usdc"}))
+
+	alias, trace, err := k.DenomAlias(s.Ctx, atomDenom)
+	s.Require().NoError(err)
+	s.Require().Equal("atom", alias)
+	s.Require().Equal(atomTrace, trace)
+
+	// The trace of usdc is unknown to the transfer module.
+	alias, trace, err = k.DenomAlias(s.Ctx, usdcDenom)
+	s.Require().NoError(err)
+	s.Require().Equal("usdc", alias)
+	s.Require().Equal(ibctransfertypes.DenomTrace{}, trace)
+
+	_, _, err = k.DenomAlias(s.Ctx, ibctransfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uion"}.IBCDenom())
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestHandleSetDenomAliasesProposal() {
+	k := s.App.DenomAliasKeeper
+	s.Require().NoError(k.SetDenomAlias(s.Ctx, types.DenomAlias{Denom: usdcDenom, Alias: "usdc"}))
+
+	proposal := types.NewSetDenomAliasesProposal("title", "description", []types.DenomAlias{
+		{Denom: atomDenom, Alias: "atom"},
+		{Denom: usdcDenom},
+	})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().NoError(k.HandleSetDenomAliasesProposal(s.Ctx, &proposal))
+
+	aliases, err := k.GetAllDenomAliases(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomAlias{{Denom: atomDenom, Alias: "atom"}}, aliases)
+}
+
+func (s *KeeperTestSuite) TestImportExport() {
+	genState := &types.GenesisState{Aliases: []types.DenomAlias{
+		{Denom: atomDenom, Alias: "atom"},
+		{Denom: usdcDenom, Alias: "usdc"},
+	}}
+	s.Require().NoError(genState.Validate())
+
+	s.App.DenomAliasKeeper.InitGenesis(s.Ctx, genState)
+	exportedState := s.App.DenomAliasKeeper.ExportGenesis(s.Ctx)
+	s.Require().ElementsMatch(genState.Aliases, exportedState.Aliases)
+}
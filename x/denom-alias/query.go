@@ -0,0 +1,24 @@
+package denomalias
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// DenomAlias returns the alias of the given IBC denom, along with its denom trace path and base denom.
+// The trace is empty if it is unknown to the transfer module.
+func (k Keeper) DenomAlias(ctx sdk.Context, denom string) (alias string, trace ibctransfertypes.DenomTrace, err error) {
+	alias, err = k.GetDenomAlias(ctx, denom)
+	if err != nil {
+		return "", ibctransfertypes.DenomTrace{}, err
+	}
+
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return "", ibctransfertypes.DenomTrace{}, err
+	}
+	trace, _ = k.transferKeeper.GetDenomTrace(ctx, hash)
+	return alias, trace, nil
+}
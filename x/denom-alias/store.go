@@ -0,0 +1,78 @@
+package denomalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/denom-alias/types"
+)
+
+// GetDenomAlias returns the alias of the given IBC denom.
+// Returns an error if the denom has no alias.
+func (k Keeper) GetDenomAlias(ctx sdk.Context, denom string) (string, error) {
+	store := ctx.KVStore(k.storeKey)
+	aliasBz := store.Get(types.GetDenomToAliasKey(denom))
+	if aliasBz == nil {
+		return "", types.DenomAliasNotFoundError{Denom: denom}
+	}
+	return string(aliasBz), nil
+}
+
+// GetDenomByAlias returns the IBC denom with the given alias.
+// Returns an error if no denom has this alias.
+func (k Keeper) GetDenomByAlias(ctx sdk.Context, alias string) (string, error) {
+	store := ctx.KVStore(k.storeKey)
+	denomBz := store.Get(types.GetAliasToDenomKey(alias))
+	if denomBz == nil {
+		return "", types.AliasNotFoundError{Alias: alias}
+	}
+	return string(denomBz), nil
+}
+
+// DisplayDenom returns the alias of the given denom if it has one, and the denom itself otherwise.
+// It is meant for other modules to display human readable denoms in their events and responses.
+func (k Keeper) DisplayDenom(ctx sdk.Context, denom string) string {
+	alias, err := k.GetDenomAlias(ctx, denom)
+	if err != nil {
+		return denom
+	}
+	return alias
+}
+
+// GetAllDenomAliases returns the aliases of all the IBC denoms, ordered by denom.
+func (k Keeper) GetAllDenomAliases(ctx sdk.Context) ([]types.DenomAlias, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetDenomToAliasPrefix()
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(store, prefix, func(key []byte, value []byte) (types.DenomAlias, error) {
+		return types.DenomAlias{Denom: string(key[len(prefix):]), Alias: string(value)}, nil
+	})
+}
+
+// SetDenomAlias sets the alias of the given IBC denom, replacing its previous alias if any.
+// If the alias is empty, the alias of the denom is removed instead.
+// Returns an error if the alias is invalid, or already used by another denom.
+func (k Keeper) SetDenomAlias(ctx sdk.Context, denomAlias types.DenomAlias) error {
+	if err := denomAlias.Validate(); err != nil {
+		return err
+	}
+
+	if denomAlias.Alias != "" {
+		existingDenom, err := k.GetDenomByAlias(ctx, denomAlias.Alias)
+		if err == nil && existingDenom != denomAlias.Denom {
+			return types.AliasAlreadyUsedError{Alias: denomAlias.Alias, ExistingDenom: existingDenom}
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if previousAlias, err := k.GetDenomAlias(ctx, denomAlias.Denom); err == nil {
+		store.Delete(types.GetAliasToDenomKey(previousAlias))
+	}
+
+	if denomAlias.Alias == "" {
+		store.Delete(types.GetDenomToAliasKey(denomAlias.Denom))
+		return nil
+	}
+	store.Set(types.GetDenomToAliasKey(denomAlias.Denom), []byte(denomAlias.Alias))
+	store.Set(types.GetAliasToDenomKey(denomAlias.Alias), []byte(denomAlias.Denom))
+	return nil
+}
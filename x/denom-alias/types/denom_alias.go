@@ -0,0 +1,32 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// Validate checks that the denom is an IBC denom, and that the alias is empty or
+// a valid denom that cannot be confused with an IBC denom.
+// An empty alias is only valid in proposals, where it removes the alias of the denom.
+func (a DenomAlias) Validate() error {
+	if !strings.HasPrefix(a.Denom, ibctransfertypes.DenomPrefix+"/") {
+		return errorsmod.Wrapf(ErrInvalidIBCDenom, "denom %s must start with %s/", a.Denom, ibctransfertypes.DenomPrefix)
+	}
+	if err := ibctransfertypes.ValidateIBCDenom(a.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidIBCDenom, err.Error())
+	}
+
+	if a.Alias == "" {
+		return nil
+	}
+	if err := sdk.ValidateDenom(a.Alias); err != nil {
+		return errorsmod.Wrap(ErrInvalidAlias, err.Error())
+	}
+	if strings.Contains(a.Alias, "/") {
+		return errorsmod.Wrapf(ErrInvalidAlias, "alias %s must not contain a /", a.Alias)
+	}
+	return nil
+}
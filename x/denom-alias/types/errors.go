@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidIBCDenom = errorsmod.Register(ModuleName, 2, "invalid IBC denom")
+	ErrInvalidAlias    = errorsmod.Register(ModuleName, 3, "invalid denom alias")
+)
+
+type AliasAlreadyUsedError struct {
+	Alias         string
+	ExistingDenom string
+}
+
+func (e AliasAlreadyUsedError) Error() string {
+	return fmt.Sprintf("alias %s is already used by denom %s", e.Alias, e.ExistingDenom)
+}
+
+type DenomAliasNotFoundError struct {
+	Denom string
+}
+
+func (e DenomAliasNotFoundError) Error() string {
+	return fmt.Sprintf("no alias set for denom %s", e.Denom)
+}
+
+type AliasNotFoundError struct {
+	Alias string
+}
+
+func (e AliasNotFoundError) Error() string {
+	return fmt.Sprintf("no denom found with alias %s", e.Alias)
+}
@@ -0,0 +1,32 @@
+package types
+
+import "fmt"
+
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Aliases: []DenomAlias{},
+	}
+}
+
+// Validate checks that every alias is valid and set, and that no denom or alias is used twice.
+func (g *GenesisState) Validate() error {
+	denoms := map[string]bool{}
+	aliases := map[string]bool{}
+	for _, denomAlias := range g.Aliases {
+		if err := denomAlias.Validate(); err != nil {
+			return err
+		}
+		if denomAlias.Alias == "" {
+			return fmt.Errorf("empty alias for denom %s", denomAlias.Denom)
+		}
+		if denoms[denomAlias.Denom] {
+			return fmt.Errorf("duplicate denom %s", denomAlias.Denom)
+		}
+		if aliases[denomAlias.Alias] {
+			return fmt.Errorf("duplicate alias %s", denomAlias.Alias)
+		}
+		denoms[denomAlias.Denom] = true
+		aliases[denomAlias.Alias] = true
+	}
+	return nil
+}
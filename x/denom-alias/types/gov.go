@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeSetDenomAliases = "SetDenomAliases"
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeSetDenomAliases)
+}
+
+var _ govtypesv1.Content = &SetDenomAliasesProposal{}
+
+func NewSetDenomAliasesProposal(title, description string, aliases []DenomAlias) SetDenomAliasesProposal {
+	return SetDenomAliasesProposal{
+		Title:       title,
+		Description: description,
+		Aliases:     aliases,
+	}
+}
+
+func (p *SetDenomAliasesProposal) GetTitle() string { return p.Title }
+
+func (p *SetDenomAliasesProposal) GetDescription() string { return p.Description }
+
+func (p *SetDenomAliasesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetDenomAliasesProposal) ProposalType() string {
+	return ProposalTypeSetDenomAliases
+}
+
+func (p *SetDenomAliasesProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Aliases) == 0 {
+		return fmt.Errorf("proposal must set at least one alias")
+	}
+	for _, denomAlias := range p.Aliases {
+		if err := denomAlias.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p SetDenomAliasesProposal) String() string {
+	var b strings.Builder
+	for _, denomAlias := range p.Aliases {
+		b.WriteString(fmt.Sprintf("(Denom: %s, Alias: %s) ", denomAlias.Denom, denomAlias.Alias))
+	}
+
+	recordsStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Set Denom Aliases Proposal:
+  Title:       %s
+  Description: %s
+  Records:     %s
+`, p.Title, p.Description, recordsStr))
+
+	return b.String()
+}
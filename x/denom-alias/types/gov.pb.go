@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/denomalias/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetDenomAliasesProposal is a gov Content type for setting the human readable
+// aliases of IBC denoms. It can be used to add new aliases, or to rename the
+// alias of a denom. If the alias is empty, the alias of the denom is removed.
+type SetDenomAliasesProposal struct {
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Aliases     []DenomAlias `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases" yaml:"aliases"`
+}
+
+func (m *SetDenomAliasesProposal) Reset()      { *m = SetDenomAliasesProposal{} }
+func (*SetDenomAliasesProposal) ProtoMessage() {}
+func (*SetDenomAliasesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea36ed63ab2a20ed, []int{0}
+}
+func (m *SetDenomAliasesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDenomAliasesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDenomAliasesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDenomAliasesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDenomAliasesProposal.Merge(m, src)
+}
+func (m *SetDenomAliasesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDenomAliasesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDenomAliasesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDenomAliasesProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetDenomAliasesProposal)(nil), "osmosis.denomalias.v1beta1.SetDenomAliasesProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/denomalias/v1beta1/gov.proto", fileDescriptor_ea36ed63ab2a20ed)
+}
+
+var fileDescriptor_ea36ed63ab2a20ed = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4b, 0xf3, 0x40,
+	0x1c, 0xc6, 0x93, 0x96, 0xf7, 0x7d, 0x79, 0xd3, 0x97, 0x17, 0x0d, 0x52, 0x6b, 0x86, 0x5c, 0x09,
+	0x52, 0x8a, 0xd0, 0x1c, 0xad, 0x83, 0xd2, 0xcd, 0xea, 0x2c, 0x5a, 0x17, 0x71, 0x91, 0x4b, 0x7b,
+	0xc4, 0x40, 0x92, 0x7f, 0xe8, 0x9d, 0xc5, 0x7e, 0x03, 0x71, 0x72, 0x74, 0xec, 0xe2, 0xee, 0xe0,
+	0x87, 0x28, 0x4e, 0x1d, 0x9d, 0x82, 0xb4, 0x83, 0xce, 0xfd, 0x04, 0x92, 0xbb, 0x0b, 0xed, 0x52,
+	0x97, 0x90, 0xbb, 0xe7, 0x77, 0xcf, 0xff, 0xcf, 0xf3, 0x18, 0xbb, 0xc0, 0x22, 0x60, 0x01, 0xc3,
+	0x7d, 0x1a, 0x43, 0x44, 0xc2, 0x80, 0x30, 0x3c, 0x6c, 0x7a, 0x94, 0x93, 0x26, 0xf6, 0x61, 0xe8,
+	0x26, 0x03, 0xe0, 0x60, 0x5a, 0x8a, 0x72, 0x97, 0x94, 0xab, 0x28, 0x6b, 0xcb, 0x07, 0x1f, 0x04,
+	0x86, 0xb3, 0x3f, 0xf9, 0xc2, 0xda, 0xe9, 0x89, 0x27, 0xd7, 0x52, 0x90, 0x07, 0x25, 0x6d, 0x92,
+	0x28, 0x88, 0x01, 0x8b, 0xaf, 0xba, 0xaa, 0xff, 0xb4, 0x05, 0x8d, 0x69, 0x36, 0x5a, 0x90, 0xce,
+	0x73, 0xc1, 0xd8, 0xbe, 0xa0, 0xfc, 0x24, 0xe3, 0x8e, 0x32, 0x8e, 0xb2, 0xb3, 0x01, 0x24, 0xc0,
+	0x48, 0x68, 0xd6, 0x8c, 0x5f, 0x3c, 0xe0, 0x21, 0xad, 0xe8, 0x55, 0xbd, 0xfe, 0xb7, 0xb3, 0xb1,
+	0x48, 0xd1, 0xbf, 0x11, 0x89, 0xc2, 0xb6, 0x23, 0xae, 0x9d, 0xae, 0x94, 0xcd, 0x43, 0xa3, 0xd4,
+	0xa7, 0xac, 0x37, 0x08, 0x12, 0x1e, 0x40, 0x5c, 0x29, 0x08, 0xba, 0xbc, 0x48, 0x91, 0x29, 0xe9,
+	0x15, 0xd1, 0xe9, 0xae, 0xa2, 0xe6, 0xa5, 0xf1, 0x87, 0xc8, 0xa1, 0x95, 0x62, 0xb5, 0x58, 0x2f,
+	0xb5, 0x6a, 0xee, 0xfa, 0x64, 0xdc, 0xe5, 0x92, 0x9d, 0xf2, 0x24, 0x45, 0xda, 0x22, 0x45, 0xff,
+	0xe5, 0x04, 0x65, 0xe2, 0x74, 0x73, 0xbb, 0xf6, 0xe9, 0xfd, 0x18, 0x69, 0x4f, 0x63, 0xa4, 0x7d,
+	0x8d, 0x91, 0xfe, 0xf6, 0xda, 0xb0, 0x54, 0x64, 0x59, 0x07, 0xb9, 0xdf, 0x31, 0xc4, 0x9c, 0xc6,
+	0xfc, 0xe1, 0xf3, 0x65, 0x0f, 0xe5, 0x81, 0xad, 0xc9, 0xa2, 0x73, 0x3e, 0x99, 0xd9, 0xfa, 0x74,
+	0x66, 0xeb, 0x1f, 0x33, 0x5b, 0x7f, 0x9c, 0xdb, 0xda, 0x74, 0x6e, 0x6b, 0xef, 0x73, 0x5b, 0xbb,
+	0x3a, 0xf0, 0x03, 0x7e, 0x73, 0xeb, 0xb9, 0x3d, 0x88, 0xb0, 0x72, 0x69, 0x84, 0xc4, 0x63, 0xf9,
+	0x01, 0x0f, 0x5b, 0x4d, 0x7c, 0x27, 0x9b, 0x68, 0xc8, 0x2a, 0xf8, 0x28, 0xa1, 0xcc, 0xfb, 0x2d,
+	0x1a, 0xd8, 0xff, 0x1e, 0x00, 0xe9, 0x20, 0x65, 0xfe, 0x33, 0x02, 0x00, 0x00,
+}
+
+func (this *SetDenomAliasesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetDenomAliasesProposal)
+	if !ok {
+		that2, ok := that.(SetDenomAliasesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Aliases) != len(that1.Aliases) {
+		return false
+	}
+	for i := range this.Aliases {
+		if !this.Aliases[i].Equal(&that1.Aliases[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *SetDenomAliasesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDenomAliasesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDenomAliasesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetDenomAliasesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, e := range m.Aliases {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetDenomAliasesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDenomAliasesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDenomAliasesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, DenomAlias{})
+			if err := m.Aliases[len(m.Aliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
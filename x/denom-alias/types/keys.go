@@ -0,0 +1,27 @@
+package types
+
+const (
+	// we don't use a `-` as RouterKey must be alphanumeric
+	ModuleName = "denomalias"
+	StoreKey   = ModuleName
+	RouterKey  = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// denomToAliasPrefix prefixes the alias of every IBC denom, keyed by denom.
+	denomToAliasPrefix = []byte{0x01}
+	// aliasToDenomPrefix prefixes the IBC denom of every alias, keyed by alias.
+	aliasToDenomPrefix = []byte{0x02}
+)
+
+func GetDenomToAliasPrefix() []byte { return denomToAliasPrefix }
+
+func GetDenomToAliasKey(denom string) []byte {
+	return append(GetDenomToAliasPrefix(), []byte(denom)...)
+}
+
+func GetAliasToDenomKey(alias string) []byte {
+	return append(aliasToDenomPrefix, []byte(alias)...)
+}
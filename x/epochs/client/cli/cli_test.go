@@ -31,3 +31,21 @@ func TestGetCmdEpochsInfo(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestGetCmdEpochTimings(t *testing.T) {
+	desc, _ := cli.GetCmdEpochTimings()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryEpochTimingsRequest]{
+		"basic test": {
+			Cmd:           "",
+			ExpectedQuery: &types.QueryEpochTimingsRequest{},
+		},
+		"with identifier and limit": {
+			Cmd: "--identifier=day --limit=10",
+			ExpectedQuery: &types.QueryEpochTimingsRequest{
+				Identifier: "day",
+				Limit:      10,
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
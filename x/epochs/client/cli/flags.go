@@ -0,0 +1,17 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagIdentifier = "identifier"
+	FlagLimit      = "limit"
+)
+
+func FlagSetEpochTimings() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagIdentifier, "", "Only return the timings of epochs with this identifier")
+	fs.Uint64(FlagLimit, 0, "The maximum number of timings to return, 0 returns all timings kept by the node")
+	return fs
+}
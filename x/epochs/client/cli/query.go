@@ -2,6 +2,7 @@ package cli
 
 import (
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
@@ -12,6 +13,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEpochInfos)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdCurrentEpoch)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEpochTimings)
+	cmd.AddCommand(
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
+	)
 
 	return cmd
 }
@@ -34,3 +40,17 @@ func GetCmdCurrentEpoch() (*osmocli.QueryDescriptor, *types.QueryCurrentEpochReq
 {{.CommandPrefix}} day`,
 	}, &types.QueryCurrentEpochRequest{}
 }
+
+func GetCmdEpochTimings() (*osmocli.QueryDescriptor, *types.QueryEpochTimingsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "epoch-timings",
+		Short: "Query the time this node spent in the epoch hooks of the most recently ended epochs.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} --identifier=day --limit=10`,
+		CustomFlagOverrides: map[string]string{
+			"identifier": FlagIdentifier,
+			"limit":      FlagLimit,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetEpochTimings()}},
+	}, &types.QueryEpochTimingsRequest{}
+}
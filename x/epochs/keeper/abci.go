@@ -31,6 +31,8 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		}
 		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
 
+		timings := hookTimings{}
+		endedEpoch := epochInfo.CurrentEpoch
 		if shouldInitialEpochStart {
 			epochInfo.EpochCountingStarted = true
 			epochInfo.CurrentEpoch = 1
@@ -43,7 +45,7 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 					sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochInfo.CurrentEpoch)),
 				),
 			)
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+			k.afterEpochEndTimed(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, &timings)
 			epochInfo.CurrentEpoch += 1
 			epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
 			logger.Info(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
@@ -58,7 +60,13 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 			),
 		)
 		k.setEpochInfo(ctx, epochInfo)
-		k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+		k.beforeEpochStartTimed(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, &timings)
+
+		// The processing of an epoch end spans the AfterEpochEnd hooks of the ended epoch
+		// and the BeforeEpochStart hooks of the next one.
+		if !shouldInitialEpochStart {
+			k.recordEpochTimings(ctx, epochInfo.Identifier, endedEpoch, timings)
+		}
 
 		return false
 	})
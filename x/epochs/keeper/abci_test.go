@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	epochskeeper "github.com/osmosis-labs/osmosis/x/epochs/keeper"
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
 
 	"golang.org/x/exp/maps"
@@ -172,3 +174,103 @@ func TestEpochStartingOneMonthAfterInitGenesis(t *testing.T) {
 	require.Equal(t, epochInfo.CurrentEpochStartTime.UTC().String(), now.Add(month).UTC().String())
 	require.Equal(t, epochInfo.EpochCountingStarted, true)
 }
+
+// namedEpochHook is an epoch hook that does nothing but report its module name.
+type namedEpochHook struct {
+	moduleName string
+}
+
+func (hook namedEpochHook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+func (hook namedEpochHook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+func (hook namedEpochHook) GetModuleName() string {
+	return hook.moduleName
+}
+
+func (suite *KeeperTestSuite) TestEpochTimings() {
+	block1Time := time.Unix(1656907200, 0).UTC()
+	const (
+		hourly = "hourly"
+		daily  = "daily"
+	)
+
+	ctx, epochsKeeper := NewTestKeeper()
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(block1Time)
+	epochsKeeper.SetHooks(types.NewMultiEpochHooks(namedEpochHook{"first"}, namedEpochHook{"second"}))
+	for identifier, duration := range map[string]time.Duration{hourly: time.Hour, daily: 24 * time.Hour} {
+		err := epochsKeeper.AddEpochInfo(ctx, types.EpochInfo{Identifier: identifier, StartTime: block1Time, Duration: duration})
+		suite.Require().NoError(err)
+	}
+
+	// The initial epoch start does not end an epoch, so no timings are recorded.
+	epochsKeeper.BeginBlocker(ctx)
+	suite.Require().Empty(epochsKeeper.GetEpochTimings("", 0))
+
+	// End the first hourly epoch.
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(block1Time.Add(time.Hour).Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	epochsKeeper.BeginBlocker(ctx)
+
+	epochTimings := epochsKeeper.GetEpochTimings("", 0)
+	suite.Require().Len(epochTimings, 1)
+	suite.Require().Equal(hourly, epochTimings[0].Identifier)
+	suite.Require().Equal(int64(1), epochTimings[0].EpochNumber)
+	suite.Require().Equal(int64(2), epochTimings[0].BlockHeight)
+	suite.Require().Len(epochTimings[0].HookTimings, 2)
+	suite.Require().Equal("first", epochTimings[0].HookTimings[0].ModuleName)
+	suite.Require().Equal("second", epochTimings[0].HookTimings[1].ModuleName)
+	suite.Require().Equal(epochTimings[0].HookTimings[0].Duration+epochTimings[0].HookTimings[1].Duration, epochTimings[0].TotalDuration)
+
+	emitted := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEpochTimings {
+			continue
+		}
+		emitted = true
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+		suite.Require().Equal(hourly, attributes[types.AttributeEpochIdentifier])
+		suite.Require().Equal("1", attributes[types.AttributeEpochNumber])
+		suite.Require().Contains(attributes, "first")
+		suite.Require().Contains(attributes, "second")
+		suite.Require().Equal(epochTimings[0].TotalDuration.String(), attributes[types.AttributeTotalDuration])
+	}
+	suite.Require().True(emitted)
+
+	// End the second hourly epoch and the first daily epoch.
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(block1Time.Add(24 * time.Hour).Add(time.Second))
+	epochsKeeper.BeginBlocker(ctx)
+
+	epochTimings = epochsKeeper.GetEpochTimings("", 0)
+	suite.Require().Len(epochTimings, 3)
+	suite.Require().Equal(int64(3), epochTimings[0].BlockHeight)
+	suite.Require().Equal(int64(2), epochTimings[2].BlockHeight)
+
+	epochTimings = epochsKeeper.GetEpochTimings(hourly, 0)
+	suite.Require().Len(epochTimings, 2)
+	suite.Require().Equal(int64(2), epochTimings[0].EpochNumber)
+	suite.Require().Equal(int64(1), epochTimings[1].EpochNumber)
+
+	epochTimings = epochsKeeper.GetEpochTimings(daily, 0)
+	suite.Require().Len(epochTimings, 1)
+	suite.Require().Equal(int64(1), epochTimings[0].EpochNumber)
+
+	epochTimings = epochsKeeper.GetEpochTimings(hourly, 1)
+	suite.Require().Len(epochTimings, 1)
+	suite.Require().Equal(int64(2), epochTimings[0].EpochNumber)
+
+	// Only the most recent timings are kept.
+	for i := 0; i < epochskeeper.MaxEpochTimingsKept; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		epochsKeeper.BeginBlocker(ctx)
+	}
+	epochTimings = epochsKeeper.GetEpochTimings("", 0)
+	suite.Require().Len(epochTimings, epochskeeper.MaxEpochTimingsKept)
+	suite.Require().Equal(ctx.BlockHeight(), epochTimings[0].BlockHeight)
+}
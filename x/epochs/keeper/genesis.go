@@ -8,6 +8,7 @@ import (
 
 // InitGenesis sets epoch info from genesis
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
 	for _, epoch := range genState.Epochs {
 		err := k.AddEpochInfo(ctx, epoch)
 		if err != nil {
@@ -20,5 +21,6 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Epochs = k.AllEpochInfos(ctx)
+	genesis.Params = k.GetParams(ctx)
 	return genesis
 }
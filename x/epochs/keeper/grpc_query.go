@@ -24,16 +24,23 @@ func NewQuerier(k Keeper) Querier {
 	return Querier{Keeper: k}
 }
 
-// EpochInfos provide running epochInfos.
+// EpochInfos provide running epochInfos, along with the progress of their current epoch.
 func (q Querier) EpochInfos(c context.Context, _ *types.QueryEpochsInfoRequest) (*types.QueryEpochsInfoResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	epochs := q.Keeper.AllEpochInfos(ctx)
+	epochsProgress := make([]types.EpochProgress, 0, len(epochs))
+	for _, epoch := range epochs {
+		epochsProgress = append(epochsProgress, epoch.Progress(ctx.BlockTime()))
+	}
+
 	return &types.QueryEpochsInfoResponse{
-		Epochs: q.Keeper.AllEpochInfos(ctx),
+		Epochs:         epochs,
+		EpochsProgress: epochsProgress,
 	}, nil
 }
 
-// CurrentEpoch provides current epoch of specified identifier.
+// CurrentEpoch provides current epoch of specified identifier, along with its progress.
 func (q Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -51,5 +58,24 @@ func (q Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochReq
 
 	return &types.QueryCurrentEpochResponse{
 		CurrentEpoch: info.CurrentEpoch,
+		Progress:     info.Progress(ctx.BlockTime()),
 	}, nil
 }
+
+// EpochTimings provides the time spent in the epoch hooks of the most recently ended epochs.
+func (q Querier) EpochTimings(_ context.Context, req *types.QueryEpochTimingsRequest) (*types.QueryEpochTimingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryEpochTimingsResponse{
+		Timings: q.Keeper.GetEpochTimings(req.Identifier, req.Limit),
+	}, nil
+}
+
+// Params returns the epochs module params.
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(ctx)}, nil
+}
@@ -2,7 +2,11 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochskeeper "github.com/osmosis-labs/osmosis/x/epochs/keeper"
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -21,4 +25,58 @@ func (s *KeeperTestSuite) TestQueryEpochInfos() {
 	}
 
 	s.Require().Equal(expectedEpochs, epochInfosResponse.Epochs)
+
+	// Epochs starting at the current block time have not started counting yet.
+	s.Require().Len(epochInfosResponse.EpochsProgress, 3)
+	for i, epoch := range expectedEpochs {
+		s.Require().Equal(types.EpochProgress{Identifier: epoch.Identifier, CurrentEpochStartTime: s.Ctx.BlockTime()}, epochInfosResponse.EpochsProgress[i])
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryCurrentEpochProgress() {
+	s.SetupTest()
+	querier := epochskeeper.NewQuerier(*s.EpochsKeeper)
+
+	startTime := s.Ctx.BlockTime().Add(time.Hour)
+	epochInfo := types.NewGenesisEpochInfo("monthly", time.Hour*24*30)
+	epochInfo.StartTime = startTime
+	s.Require().NoError(s.EpochsKeeper.AddEpochInfo(s.Ctx, epochInfo))
+
+	tests := map[string]struct {
+		blockTime        time.Time
+		expectedProgress types.EpochProgress
+	}{
+		"before the first epoch starts": {
+			blockTime:        s.Ctx.BlockTime(),
+			expectedProgress: types.EpochProgress{Identifier: "monthly", CurrentEpoch: 0, CurrentEpochStartTime: startTime, Remaining: time.Hour},
+		},
+		"during the first epoch": {
+			blockTime:        startTime.Add(time.Hour * 24 * 10),
+			expectedProgress: types.EpochProgress{Identifier: "monthly", CurrentEpoch: 1, CurrentEpochStartTime: startTime, Elapsed: time.Hour * 24 * 10, Remaining: time.Hour * 24 * 20},
+		},
+		"end of the first epoch is due": {
+			blockTime:        startTime.Add(time.Hour * 24 * 31),
+			expectedProgress: types.EpochProgress{Identifier: "monthly", CurrentEpoch: 1, CurrentEpochStartTime: startTime, Elapsed: time.Hour * 24 * 31, Remaining: 0},
+		},
+	}
+
+	// The first epoch starts in the first block after its start time, in a branch of the state so that
+	// it has not started in s.Ctx.
+	startedCtx, _ := s.Ctx.CacheContext()
+	s.EpochsKeeper.BeginBlocker(startedCtx.WithBlockTime(startTime.Add(time.Second)))
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			ctx := s.Ctx
+			if tc.expectedProgress.CurrentEpoch > 0 {
+				ctx = startedCtx
+			}
+			ctx = ctx.WithBlockTime(tc.blockTime)
+
+			response, err := querier.CurrentEpoch(sdk.WrapSDKContext(ctx), &types.QueryCurrentEpochRequest{Identifier: "monthly"})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedProgress.CurrentEpoch, response.CurrentEpoch)
+			s.Require().Equal(tc.expectedProgress, response.Progress)
+		})
+	}
 }
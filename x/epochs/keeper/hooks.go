@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // AfterEpochEnd gets called at the end of the epoch, end of epoch is the timestamp of first block produced after epoch duration.
@@ -15,3 +19,46 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber
 	// Error is not handled as BeforeEpochStart Hooks use osmoutils.ApplyFuncIfNoError()
 	_ = k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
 }
+
+// afterEpochEndTimed runs the AfterEpochEnd hook of every module like AfterEpochEnd,
+// adding the time spent in each module's hook to timings.
+func (k Keeper) afterEpochEndTimed(ctx sdk.Context, identifier string, epochNumber int64, timings *hookTimings) {
+	for _, hook := range k.hooksList() {
+		start := time.Now()
+		// Error is not handled as AfterEpochEnd Hooks use osmoutils.ApplyFuncIfNoError()
+		_ = types.NewMultiEpochHooks(hook).AfterEpochEnd(k.hookCtx(ctx), identifier, epochNumber)
+		timings.add(hook.GetModuleName(), time.Since(start))
+	}
+}
+
+// beforeEpochStartTimed runs the BeforeEpochStart hook of every module like BeforeEpochStart,
+// adding the time spent in each module's hook to timings.
+func (k Keeper) beforeEpochStartTimed(ctx sdk.Context, identifier string, epochNumber int64, timings *hookTimings) {
+	for _, hook := range k.hooksList() {
+		start := time.Now()
+		// Error is not handled as BeforeEpochStart Hooks use osmoutils.ApplyFuncIfNoError()
+		_ = types.NewMultiEpochHooks(hook).BeforeEpochStart(k.hookCtx(ctx), identifier, epochNumber)
+		timings.add(hook.GetModuleName(), time.Since(start))
+	}
+}
+
+// hookCtx returns the context to run a single module's epoch hook with.
+// If the HookGasLimit param is set, the hook gets its own gas meter with that limit.
+func (k Keeper) hookCtx(ctx sdk.Context) sdk.Context {
+	hookGasLimit := k.GetParams(ctx).HookGasLimit
+	if hookGasLimit == 0 {
+		return ctx
+	}
+	return ctx.WithGasMeter(sdk.NewGasMeter(hookGasLimit))
+}
+
+// hooksList returns the hooks set on the keeper as a list, so that the hooks of each module can be timed separately.
+func (k Keeper) hooksList() types.MultiEpochHooks {
+	if k.hooks == nil {
+		return types.MultiEpochHooks{}
+	}
+	if multiHooks, ok := k.hooks.(types.MultiEpochHooks); ok {
+		return multiHooks
+	}
+	return types.NewMultiEpochHooks(k.hooks)
+}
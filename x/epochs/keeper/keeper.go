@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,16 +17,34 @@ type (
 	Keeper struct {
 		storeKey storetypes.StoreKey
 		hooks    types.EpochHooks
+		// timings holds the time spent in the epoch hooks of the most recently ended epochs.
+		timings *epochTimingsTracker
+
+		// authority is the address allowed to update the epochs parameters, the governance module account.
+		authority string
 	}
 )
 
-// NewKeeper returns a new keeper by codec and storeKey inputs.
-func NewKeeper(storeKey storetypes.StoreKey) *Keeper {
+// NewKeeper returns a new keeper by storeKey and authority inputs.
+func NewKeeper(storeKey storetypes.StoreKey, authority string) *Keeper {
 	return &Keeper{
-		storeKey: storeKey,
+		storeKey:  storeKey,
+		timings:   newEpochTimingsTracker(),
+		authority: authority,
 	}
 }
 
+// GetParams returns the total set of epochs parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
+	return params
+}
+
+// SetParams sets the total set of epochs parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
+}
+
 // Set the gamm hooks.
 func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	if k.hooks != nil {
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/stretchr/testify/suite"
 
@@ -44,9 +46,7 @@ func TestKeeperTestSuite(t *testing.T) {
 }
 
 func Setup() (sdk.Context, *epochskeeper.Keeper) {
-	epochsStoreKey := sdk.NewKVStoreKey(types.StoreKey)
-	ctx := testutil.DefaultContext(epochsStoreKey, sdk.NewTransientStoreKey("transient_test"))
-	epochsKeeper := epochskeeper.NewKeeper(epochsStoreKey)
+	ctx, epochsKeeper := NewTestKeeper()
 	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx.WithBlockHeight(1).WithChainID("osmosis-1").WithBlockTime(time.Now().UTC())
 	epochsKeeper.InitGenesis(ctx, *types.DefaultGenesis())
@@ -54,6 +54,16 @@ func Setup() (sdk.Context, *epochskeeper.Keeper) {
 	return ctx, epochsKeeper
 }
 
+// NewTestKeeper returns an epochs keeper without hooks, along with a context
+// in which its store is mounted.
+func NewTestKeeper() (sdk.Context, *epochskeeper.Keeper) {
+	epochsStoreKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(epochsStoreKey, sdk.NewTransientStoreKey("transient_test"))
+	epochsKeeper := epochskeeper.NewKeeper(epochsStoreKey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	epochsKeeper.SetParams(ctx, types.DefaultParams())
+	return ctx, epochsKeeper
+}
+
 func SetEpochStartTime(ctx sdk.Context, epochsKeeper *epochskeeper.Keeper) {
 	for _, epoch := range epochsKeeper.AllEpochInfos(ctx) {
 		epoch.StartTime = ctx.BlockTime()
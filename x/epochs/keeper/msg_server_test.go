@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/keeper"
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := map[string]struct {
+		authority   string
+		params      types.Params
+		expectedErr string
+	}{
+		"governance limits the hook gas": {
+			authority: govAuthority,
+			params:    types.NewParams(1_000_000),
+		},
+		"governance removes the hook gas limit": {
+			authority: govAuthority,
+			params:    types.NewParams(0),
+		},
+		"invalid authority": {
+			authority:   authtypes.NewModuleAddress(types.ModuleName).String(),
+			params:      types.NewParams(1_000_000),
+			expectedErr: "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.EpochsKeeper.SetParams(s.Ctx, types.NewParams(500_000))
+			originalParams := s.EpochsKeeper.GetParams(s.Ctx)
+			msgServer := keeper.NewMsgServerImpl(s.EpochsKeeper)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(tc.authority, tc.params))
+
+			params := s.EpochsKeeper.GetParams(s.Ctx)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.params, params)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// MaxEpochTimingsKept is the number of most recently ended epochs whose timings are kept in memory.
+const MaxEpochTimingsKept = 100
+
+// epochTimingsTracker keeps the timings of the most recently ended epochs.
+// Timings are wall-clock measurements that differ between nodes, so they must never be written to state.
+// The tracker is shared between copies of the keeper and may be read by queries while blocks are processed.
+type epochTimingsTracker struct {
+	mu sync.RWMutex
+	// timings are ordered from oldest to most recent.
+	timings []types.EpochTimings
+}
+
+func newEpochTimingsTracker() *epochTimingsTracker {
+	return &epochTimingsTracker{timings: make([]types.EpochTimings, 0, MaxEpochTimingsKept)}
+}
+
+func (t *epochTimingsTracker) add(epochTimings types.EpochTimings) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.timings) == MaxEpochTimingsKept {
+		t.timings = append(t.timings[:0], t.timings[1:]...)
+	}
+	t.timings = append(t.timings, epochTimings)
+}
+
+// hookTimings accumulates the time spent in the epoch hooks of each module
+// while an epoch end is processed, preserving the order in which the hooks are run.
+type hookTimings []types.EpochHookTiming
+
+func (h *hookTimings) add(moduleName string, duration time.Duration) {
+	for i := range *h {
+		if (*h)[i].ModuleName == moduleName {
+			(*h)[i].Duration += duration
+			return
+		}
+	}
+	*h = append(*h, types.EpochHookTiming{ModuleName: moduleName, Duration: duration})
+}
+
+// recordEpochTimings stores the given hook timings of the ended epoch in memory and emits them in an event.
+func (k Keeper) recordEpochTimings(ctx sdk.Context, identifier string, epochNumber int64, timings hookTimings) {
+	totalDuration := time.Duration(0)
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeEpochIdentifier, identifier),
+		sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
+	}
+	for _, hookTiming := range timings {
+		totalDuration += hookTiming.Duration
+		attributes = append(attributes, sdk.NewAttribute(hookTiming.ModuleName, hookTiming.Duration.String()))
+	}
+	attributes = append(attributes, sdk.NewAttribute(types.AttributeTotalDuration, totalDuration.String()))
+
+	k.timings.add(types.EpochTimings{
+		Identifier:    identifier,
+		EpochNumber:   epochNumber,
+		BlockHeight:   ctx.BlockHeight(),
+		TotalDuration: totalDuration,
+		HookTimings:   timings,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEpochTimings, attributes...))
+}
+
+// GetEpochTimings returns the timings of the most recently ended epochs kept by this node, most recent first.
+// If identifier is not empty, only the timings of epochs with the given identifier are returned.
+// If limit is not zero, at most limit timings are returned.
+func (k Keeper) GetEpochTimings(identifier string, limit uint64) []types.EpochTimings {
+	k.timings.mu.RLock()
+	defer k.timings.mu.RUnlock()
+
+	epochTimings := []types.EpochTimings{}
+	for i := len(k.timings.timings) - 1; i >= 0; i-- {
+		if limit != 0 && uint64(len(epochTimings)) == limit {
+			break
+		}
+		if identifier != "" && k.timings.timings[i].Identifier != identifier {
+			continue
+		}
+		epochTimings = append(epochTimings, k.timings.timings[i])
+	}
+	return epochTimings
+}
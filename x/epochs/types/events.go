@@ -1,9 +1,18 @@
 package types
 
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd     = "epoch_end"
+	EventTypeEpochStart   = "epoch_start"
+	EventTypeEpochTimings = "epoch_timings"
+	// EventTypeEpochHookFailed is emitted when a module's epoch hook errors, panics or runs out of gas,
+	// in which case its state changes are reverted and the other hooks still run.
+	EventTypeEpochHookFailed = "epoch_hook_failed"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeTotalDuration   = "total_duration"
+	AttributeHook            = "hook"
+	AttributeModuleName      = "module_name"
+	AttributeError           = "error"
 )
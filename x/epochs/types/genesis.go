@@ -9,7 +9,7 @@ import (
 const DefaultIndex uint64 = 1
 
 func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+	return &GenesisState{Epochs: epochs, Params: DefaultParams()}
 }
 
 // DefaultGenesis returns the default Capability genesis state.
@@ -25,6 +25,9 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 	epochIdentifiers := map[string]bool{}
 	for _, epoch := range gs.Epochs {
 		if err := epoch.Validate(); err != nil {
@@ -55,6 +58,35 @@ func (epoch EpochInfo) Validate() error {
 	return nil
 }
 
+// Progress returns the progress of the current epoch at the given block time.
+// If epoch counting has not started yet, the progress is the time remaining until the first epoch starts.
+func (epoch EpochInfo) Progress(blockTime time.Time) EpochProgress {
+	if !epoch.EpochCountingStarted {
+		return EpochProgress{
+			Identifier:            epoch.Identifier,
+			CurrentEpoch:          0,
+			CurrentEpochStartTime: epoch.StartTime,
+			Remaining:             nonNegativeDuration(epoch.StartTime.Sub(blockTime)),
+		}
+	}
+
+	epochEndTime := epoch.CurrentEpochStartTime.Add(epoch.Duration)
+	return EpochProgress{
+		Identifier:            epoch.Identifier,
+		CurrentEpoch:          epoch.CurrentEpoch,
+		CurrentEpochStartTime: epoch.CurrentEpochStartTime,
+		Elapsed:               nonNegativeDuration(blockTime.Sub(epoch.CurrentEpochStartTime)),
+		Remaining:             nonNegativeDuration(epochEndTime.Sub(blockTime)),
+	}
+}
+
+func nonNegativeDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
 func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
 	return EpochInfo{
 		Identifier:              identifier,
@@ -156,15 +156,65 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 }
 
 // GenesisState defines the epochs module's genesis state.
+// Params holds the governance controlled parameters of the epochs module.
+type Params struct {
+	// hook_gas_limit is the maximum amount of gas each module's epoch hook may
+	// consume. A hook running out of gas is skipped, its state changes are
+	// reverted, and the epochs of the other modules are still processed.
+	// Zero means the hooks are not gas limited.
+	HookGasLimit uint64 `protobuf:"varint,1,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd2db84ad8300ca, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetHookGasLimit() uint64 {
+	if m != nil {
+		return m.HookGasLimit
+	}
+	return 0
+}
+
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Params Params      `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd2db84ad8300ca, []int{1}
+	return fileDescriptor_7dd2db84ad8300ca, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,8 +250,16 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "osmosis.epochs.v1beta1.EpochInfo")
+	proto.RegisterType((*Params)(nil), "osmosis.epochs.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.epochs.v1beta1.GenesisState")
 }
 
@@ -210,37 +268,41 @@ func init() {
 }
 
 var fileDescriptor_7dd2db84ad8300ca = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x6b, 0x5a, 0x4a, 0xea, 0x3b, 0x04, 0x44, 0xc7, 0x11, 0x2a, 0x91, 0x84, 0xc0, 0x10,
-	0x09, 0x70, 0xd4, 0x83, 0x09, 0x06, 0xa4, 0x02, 0xe2, 0x60, 0x41, 0x4a, 0x19, 0x10, 0x4b, 0x95,
-	0xb4, 0x6e, 0x62, 0xa9, 0x89, 0xa3, 0xf8, 0x05, 0xd1, 0x8d, 0x8f, 0xd0, 0x91, 0x8f, 0x74, 0xe3,
-	0x8d, 0x4c, 0x05, 0xb5, 0x1b, 0xe3, 0x7d, 0x02, 0x14, 0xdb, 0x29, 0x85, 0x3b, 0xc4, 0x16, 0xfb,
-	0xff, 0x7b, 0xff, 0xbf, 0xdf, 0xd3, 0x0b, 0xbe, 0xcf, 0x45, 0xc6, 0x05, 0x13, 0x01, 0x2d, 0xf8,
-	0x24, 0x15, 0xc1, 0xa7, 0x41, 0x4c, 0x21, 0x1a, 0x04, 0x09, 0xcd, 0xa9, 0x60, 0x82, 0x14, 0x25,
-	0x07, 0x6e, 0x1e, 0x6a, 0x8a, 0x28, 0x8a, 0x68, 0xaa, 0x7f, 0x90, 0xf0, 0x84, 0x4b, 0x24, 0xa8,
-	0xbf, 0x14, 0xdd, 0xb7, 0x13, 0xce, 0x93, 0x39, 0x0d, 0xe4, 0x29, 0xae, 0x66, 0xc1, 0xb4, 0x2a,
-	0x23, 0x60, 0x3c, 0xd7, 0xba, 0xf3, 0xb7, 0x0e, 0x2c, 0xa3, 0x02, 0xa2, 0xac, 0x50, 0x80, 0xb7,
-	0xec, 0xe0, 0xde, 0xab, 0x3a, 0xe9, 0x4d, 0x3e, 0xe3, 0xa6, 0x8d, 0x31, 0x9b, 0xd2, 0x1c, 0xd8,
-	0x8c, 0xd1, 0xd2, 0x42, 0x2e, 0xf2, 0x7b, 0xe1, 0xce, 0x8d, 0xf9, 0x01, 0x63, 0x01, 0x51, 0x09,
-	0xe3, 0xda, 0xc6, 0xba, 0xe4, 0x22, 0x7f, 0xef, 0xa8, 0x4f, 0x54, 0x06, 0x69, 0x32, 0xc8, 0xfb,
-	0x26, 0x63, 0x78, 0xe7, 0x64, 0xe5, 0xb4, 0xce, 0x56, 0xce, 0x8d, 0x45, 0x94, 0xcd, 0x9f, 0x7a,
-	0xbf, 0x6b, 0xbd, 0xe5, 0x77, 0x07, 0x85, 0x3d, 0x79, 0x51, 0xe3, 0x66, 0x8a, 0x8d, 0xe6, 0xe9,
-	0x56, 0x5b, 0xfa, 0xde, 0x3e, 0xe7, 0xfb, 0x52, 0x03, 0xc3, 0x41, 0x6d, 0xfb, 0x73, 0xe5, 0x98,
-	0x4d, 0xc9, 0x43, 0x9e, 0x31, 0xa0, 0x59, 0x01, 0x8b, 0xb3, 0x95, 0x73, 0x4d, 0x85, 0x35, 0x9a,
-	0xf7, 0xb5, 0x8e, 0xda, 0xba, 0x9b, 0xf7, 0xf0, 0xd5, 0x49, 0x55, 0x96, 0x34, 0x87, 0xb1, 0x1c,
-	0xb1, 0xd5, 0x71, 0x91, 0xdf, 0x0e, 0xf7, 0xf5, 0xa5, 0x1c, 0x86, 0xf9, 0x05, 0x61, 0xeb, 0x0f,
-	0x6a, 0xbc, 0xd3, 0xf7, 0xe5, 0xff, 0xf6, 0xfd, 0x40, 0xf7, 0xed, 0xa8, 0xa7, 0xfc, 0xcb, 0x49,
-	0x4d, 0xe1, 0xe6, 0x6e, 0xf2, 0x68, 0x3b, 0x91, 0x27, 0xf8, 0x50, 0xf1, 0x13, 0x5e, 0xe5, 0xc0,
-	0xf2, 0x44, 0x15, 0xd2, 0xa9, 0xd5, 0x75, 0x91, 0x6f, 0x84, 0x07, 0x52, 0x7d, 0xa1, 0xc5, 0x91,
-	0xd2, 0xcc, 0x67, 0xb8, 0x7f, 0x51, 0x5a, 0x4a, 0x59, 0x92, 0x82, 0x65, 0xc8, 0x56, 0x6f, 0x9d,
-	0x0b, 0x3c, 0x96, 0xf2, 0xdb, 0x8e, 0x71, 0xe5, 0xba, 0xe1, 0xbd, 0xc3, 0xfb, 0xaf, 0xd5, 0x4a,
-	0x8e, 0x20, 0x02, 0x6a, 0x3e, 0xc7, 0x5d, 0xb5, 0x8b, 0x16, 0x72, 0xdb, 0xfe, 0xde, 0xd1, 0x5d,
-	0x72, 0xf1, 0x8a, 0x92, 0xed, 0x1e, 0x0d, 0x3b, 0x75, 0xff, 0xa1, 0x2e, 0x1b, 0x1e, 0x9f, 0xac,
-	0x6d, 0x74, 0xba, 0xb6, 0xd1, 0x8f, 0xb5, 0x8d, 0x96, 0x1b, 0xbb, 0x75, 0xba, 0xb1, 0x5b, 0xdf,
-	0x36, 0x76, 0xeb, 0x23, 0x49, 0x18, 0xa4, 0x55, 0x4c, 0x26, 0x3c, 0x0b, 0xb4, 0xe9, 0xa3, 0x79,
-	0x14, 0x8b, 0xe6, 0x10, 0x7c, 0x6e, 0x7e, 0x16, 0x58, 0x14, 0x54, 0xc4, 0x5d, 0x39, 0xeb, 0xc7,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x53, 0xe7, 0xa2, 0xc2, 0x4b, 0x03, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x69, 0x29, 0xad, 0x57, 0x7e, 0x59, 0xdb, 0xc8, 0x2a, 0x91, 0x94, 0xc0, 0xa1, 0x12,
+	0xe0, 0xa8, 0x83, 0x13, 0x20, 0x4d, 0x2a, 0xa0, 0x6d, 0x88, 0x03, 0x4a, 0x39, 0x20, 0x2e, 0x95,
+	0xdb, 0xba, 0x89, 0x45, 0x13, 0x47, 0xb1, 0x8b, 0xe8, 0x8d, 0x7f, 0x00, 0xa9, 0x47, 0xfe, 0xa4,
+	0x1d, 0x77, 0xe4, 0x14, 0x50, 0x7b, 0xe3, 0xd8, 0xbf, 0x00, 0xc5, 0x76, 0x4a, 0xc7, 0x36, 0x71,
+	0xab, 0xdf, 0xf7, 0xbd, 0xef, 0x7b, 0xef, 0xf5, 0x0b, 0x7c, 0xc0, 0x45, 0xc4, 0x05, 0x13, 0x1e,
+	0x4d, 0xf8, 0x30, 0x14, 0xde, 0xe7, 0xce, 0x80, 0x4a, 0xd2, 0xf1, 0x02, 0x1a, 0x53, 0xc1, 0x04,
+	0x4e, 0x52, 0x2e, 0x39, 0xda, 0x35, 0x2c, 0xac, 0x59, 0xd8, 0xb0, 0x9a, 0xdb, 0x01, 0x0f, 0xb8,
+	0xa2, 0x78, 0xf9, 0x2f, 0xcd, 0x6e, 0xda, 0x01, 0xe7, 0xc1, 0x84, 0x7a, 0xea, 0x35, 0x98, 0x8e,
+	0xbd, 0xd1, 0x34, 0x25, 0x92, 0xf1, 0xd8, 0xe0, 0xce, 0xbf, 0xb8, 0x64, 0x11, 0x15, 0x92, 0x44,
+	0x89, 0x26, 0xb8, 0xf3, 0x0a, 0xac, 0xbf, 0xce, 0x9d, 0x8e, 0xe3, 0x31, 0x47, 0x36, 0x84, 0x6c,
+	0x44, 0x63, 0xc9, 0xc6, 0x8c, 0xa6, 0x16, 0x68, 0x81, 0x76, 0xdd, 0xdf, 0xa8, 0xa0, 0x0f, 0x10,
+	0x0a, 0x49, 0x52, 0xd9, 0xcf, 0x65, 0xac, 0x2b, 0x2d, 0xd0, 0xde, 0xda, 0x6f, 0x62, 0xed, 0x81,
+	0x0b, 0x0f, 0xfc, 0xbe, 0xf0, 0xe8, 0xde, 0x3d, 0xc9, 0x9c, 0xd2, 0x2a, 0x73, 0x6e, 0xcf, 0x48,
+	0x34, 0x79, 0xe6, 0xfe, 0xed, 0x75, 0xe7, 0x3f, 0x1d, 0xe0, 0xd7, 0x55, 0x21, 0xa7, 0xa3, 0x10,
+	0xd6, 0x8a, 0xd1, 0xad, 0xb2, 0xd2, 0xdd, 0x3b, 0xa7, 0xfb, 0xca, 0x10, 0xba, 0x9d, 0x5c, 0xf6,
+	0x77, 0xe6, 0xa0, 0xa2, 0xe5, 0x11, 0x8f, 0x98, 0xa4, 0x51, 0x22, 0x67, 0xab, 0xcc, 0xb9, 0xa9,
+	0xcd, 0x0a, 0xcc, 0xfd, 0x9e, 0x5b, 0xad, 0xd5, 0xd1, 0x7d, 0x78, 0x7d, 0x38, 0x4d, 0x53, 0x1a,
+	0xcb, 0xbe, 0x3a, 0xb1, 0x55, 0x69, 0x81, 0x76, 0xd9, 0x6f, 0x98, 0xa2, 0x3a, 0x06, 0xfa, 0x0a,
+	0xa0, 0x75, 0x86, 0xd5, 0xdf, 0xd8, 0xfb, 0xea, 0x7f, 0xf7, 0x7e, 0x68, 0xf6, 0x76, 0xf4, 0x28,
+	0x97, 0x29, 0xe9, 0x2b, 0xec, 0x6c, 0x3a, 0xf7, 0xd6, 0x17, 0x79, 0x0a, 0x77, 0x35, 0x7f, 0xc8,
+	0xa7, 0xb1, 0x64, 0x71, 0xa0, 0x1b, 0xe9, 0xc8, 0xaa, 0xb6, 0x40, 0xbb, 0xe6, 0x6f, 0x2b, 0xf4,
+	0xa5, 0x01, 0x7b, 0x1a, 0x43, 0xcf, 0x61, 0xf3, 0x22, 0xb7, 0x90, 0xb2, 0x20, 0x94, 0x56, 0x4d,
+	0xad, 0x7a, 0xe7, 0x9c, 0xe1, 0x91, 0x82, 0xdf, 0x54, 0x6a, 0xd7, 0x6e, 0xd5, 0xdc, 0x63, 0x58,
+	0x7d, 0x47, 0x52, 0x12, 0x09, 0x74, 0x00, 0x6f, 0x84, 0x9c, 0x7f, 0xea, 0x07, 0x44, 0xf4, 0x27,
+	0x2c, 0x62, 0x52, 0x45, 0xa2, 0xd2, 0xdd, 0x5b, 0x65, 0xce, 0x8e, 0x5e, 0xed, 0x2c, 0xee, 0xfa,
+	0x8d, 0xbc, 0x70, 0x48, 0xc4, 0x5b, 0xf5, 0xfc, 0x06, 0x60, 0xe3, 0x50, 0xc7, 0xbb, 0x27, 0x89,
+	0xa4, 0xe8, 0x00, 0x56, 0x75, 0xae, 0x2d, 0xd0, 0x2a, 0xb7, 0xb7, 0xf6, 0xef, 0xe1, 0x8b, 0xe3,
+	0x8e, 0xd7, 0x99, 0xec, 0x56, 0xf2, 0x5b, 0xfa, 0xa6, 0x0d, 0xbd, 0x80, 0xd5, 0x44, 0x0d, 0x67,
+	0xd2, 0x67, 0x5f, 0x26, 0xa0, 0x57, 0x28, 0xba, 0x75, 0x4f, 0xf7, 0xe8, 0x64, 0x61, 0x83, 0xd3,
+	0x85, 0x0d, 0x7e, 0x2d, 0x6c, 0x30, 0x5f, 0xda, 0xa5, 0xd3, 0xa5, 0x5d, 0xfa, 0xb1, 0xb4, 0x4b,
+	0x1f, 0x71, 0xc0, 0x64, 0x38, 0x1d, 0xe0, 0x21, 0x8f, 0x3c, 0xa3, 0xf8, 0x78, 0x42, 0x06, 0xa2,
+	0x78, 0x78, 0x5f, 0x8a, 0xcf, 0x56, 0xce, 0x12, 0x2a, 0x06, 0x55, 0xf5, 0xaf, 0x3f, 0xf9, 0x33,
+	0x00, 0x99, 0x5d, 0x24, 0xa8, 0xd5, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -317,6 +379,34 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HookGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HookGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -337,6 +427,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -393,6 +493,18 @@ func (m *EpochInfo) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HookGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.HookGasLimit))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -405,6 +517,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -653,6 +767,75 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimit", wireType)
+			}
+			m.HookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -716,6 +899,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -13,6 +13,8 @@ type EpochHooks interface {
 	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
+	// GetModuleName returns the name of the module the hooks belong to.
+	GetModuleName() string
 }
 
 var _ EpochHooks = MultiEpochHooks{}
@@ -27,7 +29,7 @@ func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the number of epoch that is ending.
 func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	for i := range h {
-		panicCatchingEpochHook(ctx, h[i].AfterEpochEnd, epochIdentifier, epochNumber)
+		panicCatchingEpochHook(ctx, h[i].AfterEpochEnd, h[i].GetModuleName(), "after_epoch_end", epochIdentifier, epochNumber)
 	}
 	return nil
 }
@@ -35,23 +37,57 @@ func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string,
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is the number of epoch that is starting.
 func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	for i := range h {
-		panicCatchingEpochHook(ctx, h[i].BeforeEpochStart, epochIdentifier, epochNumber)
+		panicCatchingEpochHook(ctx, h[i].BeforeEpochStart, h[i].GetModuleName(), "before_epoch_start", epochIdentifier, epochNumber)
 	}
 	return nil
 }
 
+// GetModuleName returns the epochs module name, since the combined hooks belong to several modules.
+func (h MultiEpochHooks) GetModuleName() string {
+	return ModuleName
+}
+
+// panicCatchingEpochHook runs the hook of the given module, reverting its state changes if it errors,
+// panics or runs out of the gas of ctx. A failed hook is logged and an event is emitted,
+// but it does not stop the hooks of the other modules.
 func panicCatchingEpochHook(
 	ctx sdk.Context,
 	hookFn func(ctx sdk.Context, epochIdentifier string, epochNumber int64) error,
+	moduleName string,
+	hookName string,
 	epochIdentifier string,
 	epochNumber int64,
 ) {
 	wrappedHookFn := func(ctx sdk.Context) error {
 		return hookFn(ctx, epochIdentifier, epochNumber)
 	}
-	// TODO: Thread info for which hook this is, may be dependent on larger hook system refactoring
-	err := osmoutils.ApplyFuncIfNoError(ctx, wrappedHookFn)
+	err := applyOutOfGasCatchingHook(ctx, wrappedHookFn)
 	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("error in epoch hook %v", err))
+		ctx.Logger().Error(fmt.Sprintf("error in %s epoch hook of module %s: %v", hookName, moduleName, err))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeEpochHookFailed,
+				sdk.NewAttribute(AttributeModuleName, moduleName),
+				sdk.NewAttribute(AttributeHook, hookName),
+				sdk.NewAttribute(AttributeEpochIdentifier, epochIdentifier),
+				sdk.NewAttribute(AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
+				sdk.NewAttribute(AttributeError, err.Error()),
+			),
+		)
 	}
 }
+
+// applyOutOfGasCatchingHook runs the hook like osmoutils.ApplyFuncIfNoError, except that running out of gas
+// is also returned as an error, as the gas of a hook is limited by the epochs params rather than by a tx.
+func applyOutOfGasCatchingHook(ctx sdk.Context, f func(ctx sdk.Context) error) (err error) {
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			isOutOfGas, descriptor := osmoutils.IsOutOfGasError(recoveryError)
+			if !isOutOfGas {
+				panic(recoveryError)
+			}
+			err = fmt.Errorf("out of gas in location: %s, gas limit: %d", descriptor, ctx.GasMeter().Limit())
+		}
+	}()
+	return osmoutils.ApplyFuncIfNoError(ctx, f)
+}
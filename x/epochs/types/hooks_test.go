@@ -76,6 +76,10 @@ func (hook *dummyEpochHook) BeforeEpochStart(ctx sdk.Context, epochIdentifier st
 	return nil
 }
 
+func (hook *dummyEpochHook) GetModuleName() string {
+	return "dummy"
+}
+
 func (hook *dummyEpochHook) Clone() *dummyEpochHook {
 	newHook := dummyEpochHook{shouldPanic: hook.shouldPanic, successCounter: hook.successCounter, shouldError: hook.shouldError}
 	return &newHook
@@ -94,11 +98,12 @@ func (s *KeeperTestSuite) TestHooksPanicRecovery() {
 		hooks                 []dummyEpochHook
 		expectedCounterValues []int
 		lenEvents             int
+		lenFailedEvents       int
 	}{
-		{[]dummyEpochHook{noPanicHook}, []int{1}, 2},
-		{[]dummyEpochHook{panicHook}, []int{0}, 0},
-		{[]dummyEpochHook{errorHook}, []int{0}, 0},
-		{simpleHooks, []int{0, 1, 0, 1}, 4},
+		{[]dummyEpochHook{noPanicHook}, []int{1}, 2, 0},
+		{[]dummyEpochHook{panicHook}, []int{0}, 0, 1},
+		{[]dummyEpochHook{errorHook}, []int{0}, 0, 1},
+		{simpleHooks, []int{0, 1, 0, 1}, 4, 2},
 	}
 
 	for tcIndex, tc := range tests {
@@ -123,14 +128,15 @@ func (s *KeeperTestSuite) TestHooksPanicRecovery() {
 			s.NotPanics(func() {
 				if epochActionSelector == 0 {
 					hooks.BeforeEpochStart(s.Ctx, "id", 0)
-					s.Require().Equal(events("id", 0, dummyBeforeEpochStartEvent), s.Ctx.EventManager().Events(),
+					s.Require().Equal(events("id", 0, dummyBeforeEpochStartEvent), hookEvents(s.Ctx.EventManager().Events()),
 						"test case index %d, before epoch event check", tcIndex)
 				} else if epochActionSelector == 1 {
 					hooks.AfterEpochEnd(s.Ctx, "id", 0)
-					s.Require().Equal(events("id", 0, dummyAfterEpochEndEvent), s.Ctx.EventManager().Events(),
+					s.Require().Equal(events("id", 0, dummyAfterEpochEndEvent), hookEvents(s.Ctx.EventManager().Events()),
 						"test case index %d, after epoch event check", tcIndex)
 				}
 			})
+			s.Require().Len(failedHookEvents(s.Ctx.EventManager().Events()), tc.lenFailedEvents, "test case index %d", tcIndex)
 
 			for i := 0; i < len(hooks); i++ {
 				epochHook := hookRefs[i].(*dummyEpochHook)
@@ -139,3 +145,60 @@ func (s *KeeperTestSuite) TestHooksPanicRecovery() {
 		}
 	}
 }
+
+// hookEvents returns the events emitted by the hooks themselves.
+func hookEvents(events sdk.Events) sdk.Events {
+	evts := sdk.Events{}
+	for _, event := range events {
+		if event.Type != types.EventTypeEpochHookFailed {
+			evts = append(evts, event)
+		}
+	}
+	return evts
+}
+
+// failedHookEvents returns the events emitted by the epochs module for the failed hooks.
+func failedHookEvents(events sdk.Events) sdk.Events {
+	evts := sdk.Events{}
+	for _, event := range events {
+		if event.Type == types.EventTypeEpochHookFailed {
+			evts = append(evts, event)
+		}
+	}
+	return evts
+}
+
+func (s *KeeperTestSuite) TestHooksOutOfGasRecovery() {
+	expensiveHook := dummyEpochHook{}
+	hooks := types.NewMultiEpochHooks(&outOfGasEpochHook{}, &expensiveHook)
+
+	ctx := s.Ctx.WithGasMeter(sdk.NewGasMeter(1000))
+	s.Require().NotPanics(func() {
+		hooks.AfterEpochEnd(ctx, "id", 0)
+	})
+
+	// The out of gas hook is reverted and reported, while the next hook still runs.
+	s.Require().Equal(1, expensiveHook.successCounter)
+	failedEvents := failedHookEvents(ctx.EventManager().Events())
+	s.Require().Len(failedEvents, 1)
+	moduleName, found := failedEvents[0].GetAttribute(types.AttributeModuleName)
+	s.Require().True(found)
+	s.Require().Equal("out_of_gas", moduleName.Value)
+}
+
+// outOfGasEpochHook is an epoch hook consuming more gas than any limit.
+type outOfGasEpochHook struct{}
+
+func (hook *outOfGasEpochHook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "expensive hook")
+	return nil
+}
+
+func (hook *outOfGasEpochHook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "expensive hook")
+	return nil
+}
+
+func (hook *outOfGasEpochHook) GetModuleName() string {
+	return "out_of_gas"
+}
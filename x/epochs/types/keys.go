@@ -14,8 +14,13 @@ const (
 	QuerierRoute = ModuleName
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs.
-var KeyPrefixEpoch = []byte{0x01}
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs.
+	KeyPrefixEpoch = []byte{0x01}
+
+	// KeyParams defines key to store the epochs module parameters.
+	KeyParams = []byte{0x02}
+)
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
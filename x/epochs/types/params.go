@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultHookGasLimit does not limit the gas of the epoch hooks.
+const DefaultHookGasLimit = uint64(0)
+
+func NewParams(hookGasLimit uint64) Params {
+	return Params{
+		HookGasLimit: hookGasLimit,
+	}
+}
+
+// default epochs module parameters.
+func DefaultParams() Params {
+	return Params{
+		HookGasLimit: DefaultHookGasLimit,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHookGasLimit only checks the type, as zero disables the limit.
+func validateHookGasLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -10,19 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -68,6 +73,9 @@ var xxx_messageInfo_QueryEpochsInfoRequest proto.InternalMessageInfo
 
 type QueryEpochsInfoResponse struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	// epochs_progress is the progress of the current epoch of each epoch info,
+	// in the same order as epochs.
+	EpochsProgress []EpochProgress `protobuf:"bytes,2,rep,name=epochs_progress,json=epochsProgress,proto3" json:"epochs_progress"`
 }
 
 func (m *QueryEpochsInfoResponse) Reset()         { *m = QueryEpochsInfoResponse{} }
@@ -110,6 +118,13 @@ func (m *QueryEpochsInfoResponse) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *QueryEpochsInfoResponse) GetEpochsProgress() []EpochProgress {
+	if m != nil {
+		return m.EpochsProgress
+	}
+	return nil
+}
+
 type QueryCurrentEpochRequest struct {
 	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 }
@@ -156,6 +171,8 @@ func (m *QueryCurrentEpochRequest) GetIdentifier() string {
 
 type QueryCurrentEpochResponse struct {
 	CurrentEpoch int64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// progress is the progress of the current epoch.
+	Progress EpochProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress"`
 }
 
 func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResponse{} }
@@ -198,11 +215,429 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+func (m *QueryCurrentEpochResponse) GetProgress() EpochProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return EpochProgress{}
+}
+
+// EpochProgress describes the progress of the current epoch of an epoch info
+// at the block time of the query.
+type EpochProgress struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// current_epoch is the current epoch number, zero if epoch counting has not
+	// started yet.
+	CurrentEpoch int64 `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// current_epoch_start_time is the start time of the current epoch, or the
+	// start time of the first epoch if epoch counting has not started yet.
+	CurrentEpochStartTime time.Time `protobuf:"bytes,3,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
+	// elapsed is the time elapsed since the start of the current epoch.
+	Elapsed time.Duration `protobuf:"bytes,4,opt,name=elapsed,proto3,stdduration" json:"elapsed"`
+	// remaining is the time remaining until the current epoch ends, or until the
+	// first epoch starts if epoch counting has not started yet. The epoch ends
+	// in the first block after that time, so it is zero once the end is due.
+	Remaining time.Duration `protobuf:"bytes,5,opt,name=remaining,proto3,stdduration" json:"remaining"`
+}
+
+func (m *EpochProgress) Reset()         { *m = EpochProgress{} }
+func (m *EpochProgress) String() string { return proto.CompactTextString(m) }
+func (*EpochProgress) ProtoMessage()    {}
+func (*EpochProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{4}
+}
+func (m *EpochProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProgress.Merge(m, src)
+}
+func (m *EpochProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProgress proto.InternalMessageInfo
+
+func (m *EpochProgress) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochProgress) GetCurrentEpoch() int64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *EpochProgress) GetCurrentEpochStartTime() time.Time {
+	if m != nil {
+		return m.CurrentEpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochProgress) GetElapsed() time.Duration {
+	if m != nil {
+		return m.Elapsed
+	}
+	return 0
+}
+
+func (m *EpochProgress) GetRemaining() time.Duration {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type QueryEpochTimingsRequest struct {
+	// identifier optionally restricts the timings to the given epoch identifier.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// limit is the maximum number of timings returned. Zero returns all the
+	// timings kept by the node.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryEpochTimingsRequest) Reset()         { *m = QueryEpochTimingsRequest{} }
+func (m *QueryEpochTimingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochTimingsRequest) ProtoMessage()    {}
+func (*QueryEpochTimingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{5}
+}
+func (m *QueryEpochTimingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochTimingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochTimingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochTimingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochTimingsRequest.Merge(m, src)
+}
+func (m *QueryEpochTimingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochTimingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochTimingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochTimingsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochTimingsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *QueryEpochTimingsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryEpochTimingsResponse struct {
+	Timings []EpochTimings `protobuf:"bytes,1,rep,name=timings,proto3" json:"timings"`
+}
+
+func (m *QueryEpochTimingsResponse) Reset()         { *m = QueryEpochTimingsResponse{} }
+func (m *QueryEpochTimingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochTimingsResponse) ProtoMessage()    {}
+func (*QueryEpochTimingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{6}
+}
+func (m *QueryEpochTimingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochTimingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochTimingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochTimingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochTimingsResponse.Merge(m, src)
+}
+func (m *QueryEpochTimingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochTimingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochTimingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochTimingsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochTimingsResponse) GetTimings() []EpochTimings {
+	if m != nil {
+		return m.Timings
+	}
+	return nil
+}
+
+// EpochTimings holds the time spent processing the end of an epoch.
+// Timings are wall-clock measurements of the queried node, so they are kept in
+// memory rather than in state and are lost on restart.
+type EpochTimings struct {
+	// identifier is the identifier of the ended epoch.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// epoch_number is the number of the ended epoch.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// block_height is the height of the block in which the epoch ended.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// total_duration is the time spent in the epoch hooks of all modules.
+	TotalDuration time.Duration `protobuf:"bytes,4,opt,name=total_duration,json=totalDuration,proto3,stdduration" json:"total_duration"`
+	// hook_timings are the times spent in the epoch hooks of each module, in
+	// the order the hooks are run.
+	HookTimings []EpochHookTiming `protobuf:"bytes,5,rep,name=hook_timings,json=hookTimings,proto3" json:"hook_timings"`
+}
+
+func (m *EpochTimings) Reset()         { *m = EpochTimings{} }
+func (m *EpochTimings) String() string { return proto.CompactTextString(m) }
+func (*EpochTimings) ProtoMessage()    {}
+func (*EpochTimings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{7}
+}
+func (m *EpochTimings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochTimings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochTimings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochTimings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochTimings.Merge(m, src)
+}
+func (m *EpochTimings) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochTimings) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochTimings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochTimings proto.InternalMessageInfo
+
+func (m *EpochTimings) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochTimings) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochTimings) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EpochTimings) GetTotalDuration() time.Duration {
+	if m != nil {
+		return m.TotalDuration
+	}
+	return 0
+}
+
+func (m *EpochTimings) GetHookTimings() []EpochHookTiming {
+	if m != nil {
+		return m.HookTimings
+	}
+	return nil
+}
+
+// EpochHookTiming holds the time spent in the AfterEpochEnd and
+// BeforeEpochStart hooks of a single module.
+type EpochHookTiming struct {
+	ModuleName string        `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Duration   time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *EpochHookTiming) Reset()         { *m = EpochHookTiming{} }
+func (m *EpochHookTiming) String() string { return proto.CompactTextString(m) }
+func (*EpochHookTiming) ProtoMessage()    {}
+func (*EpochHookTiming) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{8}
+}
+func (m *EpochHookTiming) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochHookTiming) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochHookTiming.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochHookTiming) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochHookTiming.Merge(m, src)
+}
+func (m *EpochHookTiming) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochHookTiming) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochHookTiming.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochHookTiming proto.InternalMessageInfo
+
+func (m *EpochHookTiming) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *EpochHookTiming) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{9}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{10}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "osmosis.epochs.v1beta1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "osmosis.epochs.v1beta1.QueryCurrentEpochResponse")
+	proto.RegisterType((*EpochProgress)(nil), "osmosis.epochs.v1beta1.EpochProgress")
+	proto.RegisterType((*QueryEpochTimingsRequest)(nil), "osmosis.epochs.v1beta1.QueryEpochTimingsRequest")
+	proto.RegisterType((*QueryEpochTimingsResponse)(nil), "osmosis.epochs.v1beta1.QueryEpochTimingsResponse")
+	proto.RegisterType((*EpochTimings)(nil), "osmosis.epochs.v1beta1.EpochTimings")
+	proto.RegisterType((*EpochHookTiming)(nil), "osmosis.epochs.v1beta1.EpochHookTiming")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.epochs.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.epochs.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -210,33 +645,61 @@ func init() {
 }
 
 var fileDescriptor_82bf2f47d6aaa9fa = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc7, 0x33, 0xad, 0x16, 0x1c, 0xeb, 0x65, 0x90, 0x1a, 0x83, 0x8c, 0x35, 0xfe, 0x2b, 0x42,
-	0x67, 0x6c, 0xbd, 0x79, 0x51, 0x2a, 0x82, 0x1e, 0xcd, 0xb1, 0x17, 0x49, 0xe2, 0x34, 0x1d, 0x68,
-	0x67, 0xd2, 0xcc, 0x44, 0xec, 0xd5, 0x27, 0x10, 0xc4, 0x17, 0xf0, 0x59, 0xf6, 0xd0, 0x63, 0x61,
-	0x2f, 0x7b, 0x5a, 0x96, 0x76, 0x1f, 0x64, 0xc9, 0x64, 0x5a, 0xba, 0x6c, 0xb2, 0xec, 0xde, 0x92,
-	0x99, 0xcf, 0xf7, 0xcf, 0xef, 0x97, 0x40, 0x5f, 0xaa, 0xb9, 0x54, 0x5c, 0x51, 0x96, 0xca, 0x78,
-	0xaa, 0xe8, 0xcf, 0x41, 0xc4, 0x74, 0x38, 0xa0, 0x8b, 0x9c, 0x65, 0x4b, 0x92, 0x66, 0x52, 0x4b,
-	0xd4, 0xb1, 0x0c, 0x29, 0x19, 0x62, 0x19, 0xef, 0x61, 0x22, 0x13, 0x69, 0x10, 0x5a, 0x3c, 0x95,
-	0xb4, 0xf7, 0x24, 0x91, 0x32, 0x99, 0x31, 0x1a, 0xa6, 0x9c, 0x86, 0x42, 0x48, 0x1d, 0x6a, 0x2e,
-	0x85, 0xb2, 0xb7, 0x6f, 0x62, 0x63, 0x46, 0xa3, 0x50, 0xb1, 0x32, 0x64, 0x1f, 0x99, 0x86, 0x09,
-	0x17, 0x06, 0xb6, 0xec, 0x8b, 0x9a, 0x6e, 0x09, 0x13, 0xac, 0xa8, 0x63, 0x28, 0xdf, 0x85, 0x9d,
-	0x6f, 0x85, 0xcf, 0x67, 0x03, 0x7d, 0x15, 0x13, 0x19, 0xb0, 0x45, 0xce, 0x94, 0xf6, 0xc7, 0xf0,
-	0xd1, 0x95, 0x1b, 0x95, 0x4a, 0xa1, 0x18, 0xfa, 0x00, 0x5b, 0xa5, 0xa9, 0x0b, 0xba, 0xcd, 0xde,
-	0xfd, 0xe1, 0x33, 0x52, 0x3d, 0x23, 0x31, 0xda, 0x42, 0x3a, 0xba, 0xb3, 0x3a, 0x7d, 0xea, 0x04,
-	0x56, 0xe6, 0xbf, 0x87, 0xae, 0xf1, 0xfe, 0x94, 0x67, 0x19, 0x13, 0xda, 0x60, 0x36, 0x17, 0x61,
-	0x08, 0xf9, 0x0f, 0x26, 0x34, 0x9f, 0x70, 0x96, 0xb9, 0xa0, 0x0b, 0x7a, 0xf7, 0x82, 0x83, 0x13,
-	0xff, 0x23, 0x7c, 0x5c, 0xa1, 0xb5, 0xcd, 0x9e, 0xc3, 0x07, 0x71, 0x79, 0xfe, 0xdd, 0x44, 0x19,
-	0x7d, 0x33, 0x68, 0xc7, 0x07, 0xf0, 0xf0, 0xa8, 0x01, 0xef, 0x1a, 0x0b, 0xf4, 0x0f, 0x40, 0xb8,
-	0xef, 0xa8, 0x10, 0xa9, 0x9b, 0xa3, 0x7a, 0x45, 0x1e, 0xbd, 0x31, 0x5f, 0xd6, 0xf3, 0x5f, 0xfd,
-	0x3e, 0x3e, 0xff, 0xdb, 0xe8, 0x22, 0x4c, 0x6b, 0x3e, 0x4e, 0xf9, 0x8a, 0xfe, 0x03, 0xd8, 0x3e,
-	0x9c, 0x0f, 0xbd, 0xbd, 0x36, 0xa9, 0x62, 0x8d, 0xde, 0xe0, 0x16, 0x0a, 0xdb, 0xae, 0x6f, 0xda,
-	0xbd, 0x46, 0x2f, 0xeb, 0xda, 0x5d, 0x5a, 0xed, 0xe8, 0xcb, 0x6a, 0x83, 0xc1, 0x7a, 0x83, 0xc1,
-	0xd9, 0x06, 0x83, 0x3f, 0x5b, 0xec, 0xac, 0xb7, 0xd8, 0x39, 0xd9, 0x62, 0x67, 0x4c, 0x12, 0xae,
-	0xa7, 0x79, 0x44, 0x62, 0x39, 0xdf, 0x59, 0xf5, 0x67, 0x61, 0xa4, 0xf6, 0xbe, 0xbf, 0x76, 0xce,
-	0x7a, 0x99, 0x32, 0x15, 0xb5, 0xcc, 0xbf, 0xf8, 0xee, 0x22, 0x00, 0x00, 0xff, 0xff, 0x94, 0xf9,
-	0xbd, 0x8a, 0x4f, 0x03, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xf3, 0x6b, 0xbb, 0x2f, 0xe9, 0xae, 0x34, 0x94, 0xc5, 0x8d, 0x90, 0xd3, 0x35, 0xbb,
+	0xec, 0x6a, 0x57, 0x6b, 0x6f, 0xca, 0x6d, 0x05, 0x42, 0x84, 0x22, 0x0a, 0x87, 0x2a, 0xa4, 0x3d,
+	0x71, 0xb1, 0xc6, 0xc9, 0xd4, 0x19, 0xd5, 0xf6, 0xb8, 0x9e, 0x31, 0xa2, 0x37, 0xc4, 0xad, 0xb7,
+	0x0a, 0x84, 0xc4, 0x99, 0xbf, 0x80, 0x3f, 0xa3, 0xc7, 0x4a, 0x5c, 0xe0, 0x52, 0x50, 0xcb, 0x5f,
+	0x80, 0xc4, 0x1d, 0x79, 0x66, 0x1c, 0x92, 0x36, 0x49, 0x93, 0x5b, 0xfc, 0xde, 0xf7, 0x7d, 0xf3,
+	0xbd, 0x37, 0x6f, 0x5e, 0xc0, 0x66, 0x3c, 0x62, 0x9c, 0x72, 0x97, 0x24, 0x6c, 0x30, 0xe2, 0xee,
+	0x37, 0x1d, 0x9f, 0x08, 0xdc, 0x71, 0x8f, 0x33, 0x92, 0x9e, 0x38, 0x49, 0xca, 0x04, 0x43, 0x8f,
+	0x34, 0xc6, 0x51, 0x18, 0x47, 0x63, 0x5a, 0x1b, 0x01, 0x0b, 0x98, 0x84, 0xb8, 0xf9, 0x2f, 0x85,
+	0x6e, 0xbd, 0x1b, 0x30, 0x16, 0x84, 0xc4, 0xc5, 0x09, 0x75, 0x71, 0x1c, 0x33, 0x81, 0x05, 0x65,
+	0x31, 0xd7, 0x59, 0x4b, 0x67, 0xe5, 0x97, 0x9f, 0x1d, 0xba, 0xc3, 0x2c, 0x95, 0x00, 0x9d, 0x6f,
+	0xdf, 0xcc, 0x0b, 0x1a, 0x11, 0x2e, 0x70, 0x94, 0x68, 0xc0, 0x8b, 0x81, 0x74, 0xe3, 0xfa, 0x98,
+	0x13, 0xe5, 0x72, 0xec, 0x39, 0xc1, 0x01, 0x8d, 0x27, 0xc5, 0x9e, 0xcc, 0x29, 0x2e, 0x20, 0x31,
+	0xc9, 0xeb, 0x91, 0x28, 0xdb, 0x84, 0x47, 0x5f, 0xe5, 0x3a, 0x9f, 0x49, 0xd0, 0x17, 0xf1, 0x21,
+	0xeb, 0x93, 0xe3, 0x8c, 0x70, 0x61, 0xff, 0x6a, 0xc0, 0x3b, 0xb7, 0x52, 0x3c, 0x61, 0x31, 0x27,
+	0xe8, 0x63, 0xa8, 0x2b, 0x55, 0xd3, 0xd8, 0xaa, 0x3c, 0x6f, 0x6c, 0x3f, 0x76, 0x66, 0x77, 0xc9,
+	0x91, 0xdc, 0x9c, 0xda, 0xad, 0x9e, 0x5f, 0xb6, 0x4b, 0x7d, 0x4d, 0x43, 0x07, 0xf0, 0x50, 0xfd,
+	0xf2, 0x92, 0x94, 0x05, 0x29, 0xe1, 0xdc, 0x2c, 0x4b, 0xa5, 0xa7, 0x0b, 0x95, 0x7a, 0x1a, 0xac,
+	0xd5, 0x1e, 0x28, 0x4c, 0x11, 0xb5, 0xdf, 0x80, 0x29, 0x1d, 0x7f, 0x9a, 0xa5, 0x29, 0x89, 0x85,
+	0xa4, 0xe8, 0x72, 0x90, 0x05, 0x40, 0x87, 0x24, 0x16, 0xf4, 0x90, 0x92, 0xd4, 0x34, 0xb6, 0x8c,
+	0xe7, 0xf7, 0xfb, 0x13, 0x11, 0xfb, 0xd4, 0x80, 0xcd, 0x19, 0x64, 0x5d, 0xf0, 0x7b, 0xb0, 0x3e,
+	0x50, 0x71, 0x4f, 0x9e, 0x29, 0x05, 0x2a, 0xfd, 0xe6, 0x60, 0x02, 0x8c, 0x3e, 0x87, 0xb5, 0x89,
+	0x6a, 0x8c, 0x55, 0xab, 0x19, 0x93, 0xed, 0x3f, 0xca, 0xb0, 0x3e, 0x85, 0xb8, 0xcb, 0xfd, 0x6d,
+	0x7f, 0xe5, 0x19, 0xfe, 0xbe, 0x33, 0xc0, 0x9c, 0x42, 0x79, 0x5c, 0xe0, 0x54, 0x78, 0xf9, 0x94,
+	0x99, 0x15, 0x69, 0xb8, 0xe5, 0xa8, 0x11, 0x74, 0x8a, 0x11, 0x74, 0x0e, 0x8a, 0x11, 0xec, 0xbe,
+	0xcc, 0x5d, 0xfe, 0x73, 0xd9, 0x6e, 0x9f, 0xe0, 0x28, 0x7c, 0x63, 0xcf, 0x53, 0xb2, 0xcf, 0xfe,
+	0x6c, 0x1b, 0xfd, 0xb7, 0x27, 0x4f, 0xde, 0xcf, 0x93, 0xb9, 0x10, 0xfa, 0x08, 0xee, 0x91, 0x10,
+	0x27, 0x9c, 0x0c, 0xcd, 0xaa, 0x3c, 0x70, 0xf3, 0xd6, 0x81, 0x3b, 0xfa, 0x4d, 0x74, 0xd7, 0xf2,
+	0xf3, 0x7e, 0xce, 0xc5, 0x0a, 0x0e, 0xfa, 0x04, 0xee, 0xa7, 0x24, 0xc2, 0x34, 0xa6, 0x71, 0x60,
+	0xd6, 0x96, 0x17, 0xf8, 0x9f, 0x65, 0xf7, 0xf4, 0x8c, 0x48, 0x63, 0x07, 0x34, 0xa2, 0x71, 0xc0,
+	0x97, 0x9c, 0x11, 0xb4, 0x01, 0xb5, 0x90, 0x46, 0x54, 0xc8, 0xee, 0x56, 0xfb, 0xea, 0xc3, 0xc6,
+	0xb0, 0x39, 0x43, 0x51, 0x0f, 0xce, 0x0e, 0xdc, 0x13, 0x2a, 0xa4, 0x9f, 0xca, 0x93, 0x85, 0x23,
+	0xa1, 0xe9, 0x7a, 0x22, 0x0a, 0xaa, 0xfd, 0x43, 0x19, 0x9a, 0x93, 0xf9, 0x3b, 0x9d, 0x3e, 0x86,
+	0xa6, 0xba, 0x97, 0x38, 0x8b, 0x7c, 0x92, 0xea, 0x71, 0x68, 0xc8, 0xd8, 0x9e, 0x0c, 0xe5, 0x10,
+	0x3f, 0x64, 0x83, 0x23, 0x6f, 0x44, 0x68, 0x30, 0x12, 0x72, 0x00, 0x2a, 0xfd, 0x86, 0x8c, 0xed,
+	0xca, 0x10, 0xfa, 0x12, 0x1e, 0x08, 0x26, 0x70, 0xe8, 0x15, 0x7b, 0x6a, 0x95, 0x4b, 0x5b, 0x97,
+	0xd4, 0x22, 0x81, 0x7a, 0xd0, 0x1c, 0x31, 0x76, 0xe4, 0x15, 0xdd, 0xa8, 0xc9, 0x6e, 0x3c, 0x5b,
+	0xd8, 0x8d, 0x5d, 0xc6, 0x8e, 0x54, 0xc5, 0xba, 0x21, 0x8d, 0xd1, 0x38, 0xc2, 0x6d, 0x0e, 0x0f,
+	0x6f, 0xa0, 0x50, 0x1b, 0x1a, 0x11, 0x1b, 0x66, 0x21, 0xf1, 0x62, 0x1c, 0x91, 0xa2, 0x2f, 0x2a,
+	0xb4, 0x87, 0xa3, 0x7c, 0x71, 0xad, 0x8d, 0x6b, 0x29, 0x2f, 0x5f, 0xcb, 0x98, 0x64, 0x6f, 0x00,
+	0x92, 0x97, 0xdd, 0xc3, 0x29, 0x8e, 0x8a, 0xc1, 0xb1, 0xf7, 0xe1, 0xad, 0xa9, 0xa8, 0xbe, 0xfc,
+	0x0f, 0xa1, 0x9e, 0xc8, 0x88, 0x74, 0xd2, 0xd8, 0xb6, 0xe6, 0x55, 0xab, 0x78, 0xc5, 0x8e, 0x54,
+	0x9c, 0xed, 0x7f, 0xab, 0x50, 0x93, 0xaa, 0xe8, 0x27, 0x03, 0x60, 0xbc, 0x49, 0x39, 0x72, 0xe6,
+	0xc9, 0xcc, 0xde, 0xe4, 0x2d, 0x77, 0x69, 0xbc, 0xf2, 0x6d, 0xbf, 0xff, 0xfd, 0x6f, 0x7f, 0xff,
+	0x58, 0xde, 0x42, 0x96, 0x3b, 0xe7, 0x3f, 0x44, 0x7d, 0xa2, 0x5f, 0x0c, 0x68, 0x4e, 0xae, 0x4b,
+	0xf4, 0x7a, 0xe1, 0x49, 0x33, 0xd6, 0x72, 0xab, 0xb3, 0x02, 0x43, 0xbb, 0x7b, 0x25, 0xdd, 0x3d,
+	0x43, 0x4f, 0xe7, 0xb9, 0x9b, 0xda, 0x4c, 0xd2, 0xe4, 0xd4, 0xdb, 0x79, 0x7d, 0x77, 0x3b, 0xa6,
+	0xf7, 0x42, 0xab, 0xb3, 0x02, 0x63, 0x59, 0x93, 0xea, 0x79, 0xea, 0xd7, 0x80, 0x4e, 0x0d, 0xa8,
+	0xab, 0x21, 0x40, 0x2f, 0x16, 0x1e, 0x36, 0x35, 0x77, 0xad, 0x97, 0x4b, 0x61, 0x97, 0xbd, 0x55,
+	0x35, 0x77, 0xdd, 0xdd, 0xf3, 0x2b, 0xcb, 0xb8, 0xb8, 0xb2, 0x8c, 0xbf, 0xae, 0x2c, 0xe3, 0xec,
+	0xda, 0x2a, 0x5d, 0x5c, 0x5b, 0xa5, 0xdf, 0xaf, 0xad, 0xd2, 0xd7, 0x4e, 0x40, 0xc5, 0x28, 0xf3,
+	0x9d, 0x01, 0x8b, 0x0a, 0x8d, 0x57, 0x21, 0xf6, 0xf9, 0x58, 0xf0, 0xdb, 0x42, 0x52, 0x9c, 0x24,
+	0x84, 0xfb, 0x75, 0xf9, 0xa6, 0x3e, 0xf8, 0x6f, 0x00, 0xcb, 0xb9, 0x76, 0xa8, 0x68, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -255,6 +718,11 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochTimings provides the time this node spent in the epoch hooks of the
+	// most recently ended epochs, most recent first.
+	EpochTimings(ctx context.Context, in *QueryEpochTimingsRequest, opts ...grpc.CallOption) (*QueryEpochTimingsResponse, error)
+	// Params returns the epochs module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -283,12 +751,35 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) EpochTimings(ctx context.Context, in *QueryEpochTimingsRequest, opts ...grpc.CallOption) (*QueryEpochTimingsResponse, error) {
+	out := new(QueryEpochTimingsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.epochs.v1beta1.Query/EpochTimings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.epochs.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochTimings provides the time this node spent in the epoch hooks of the
+	// most recently ended epochs, most recent first.
+	EpochTimings(context.Context, *QueryEpochTimingsRequest) (*QueryEpochTimingsResponse, error)
+	// Params returns the epochs module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -301,6 +792,12 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) EpochTimings(ctx context.Context, req *QueryEpochTimingsRequest) (*QueryEpochTimingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTimings not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -342,7 +839,43 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
+func _Query_EpochTimings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochTimingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTimings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.epochs.v1beta1.Query/EpochTimings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTimings(ctx, req.(*QueryEpochTimingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.epochs.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.epochs.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -354,6 +887,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "EpochTimings",
+			Handler:    _Query_EpochTimings_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/epochs/v1beta1/query.proto",
@@ -402,6 +943,20 @@ func (m *QueryEpochsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochsProgress) > 0 {
+		for iNdEx := len(m.EpochsProgress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochsProgress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -469,6 +1024,16 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.CurrentEpoch != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
 		i--
@@ -477,6 +1042,293 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Remaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Remaining):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Elapsed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Elapsed):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochTimingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochTimingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochTimingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochTimingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochTimingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochTimingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Timings) > 0 {
+		for iNdEx := len(m.Timings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Timings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochTimings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochTimings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochTimings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookTimings) > 0 {
+		for iNdEx := len(m.HookTimings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookTimings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TotalDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochHookTiming) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochHookTiming) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochHookTiming) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -509,6 +1361,12 @@ func (m *QueryEpochsInfoResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.EpochsProgress) > 0 {
+		for _, e := range m.EpochsProgress {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -534,16 +1392,786 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	if m.CurrentEpoch != 0 {
 		n += 1 + sovQuery(uint64(m.CurrentEpoch))
 	}
-	return n
-}
+	l = m.Progress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EpochProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Elapsed)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Remaining)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochTimingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryEpochTimingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Timings) > 0 {
+		for _, e := range m.Timings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochTimings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.HookTimings) > 0 {
+		for _, e := range m.HookTimings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochHookTiming) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochInfo{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochsProgress = append(m.EpochsProgress, EpochProgress{})
+			if err := m.EpochsProgress[len(m.EpochsProgress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CurrentEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Elapsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Remaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochTimingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochTimingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochTimingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochTimingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -566,12 +2194,46 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochTimingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochTimingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timings = append(m.Timings, EpochTimings{})
+			if err := m.Timings[len(m.Timings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -593,7 +2255,7 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *EpochTimings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,15 +2278,85 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochTimings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochTimings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -651,8 +2383,41 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Epochs = append(m.Epochs, EpochInfo{})
-			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TotalDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookTimings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookTimings = append(m.HookTimings, EpochHookTiming{})
+			if err := m.HookTimings[len(m.HookTimings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -677,7 +2442,7 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *EpochHookTiming) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,15 +2465,15 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochHookTiming: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochHookTiming: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -736,7 +2501,40 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identifier = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -759,7 +2557,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -782,17 +2580,67 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.CurrentEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -802,11 +2650,25 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
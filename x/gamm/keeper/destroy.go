@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+)
+
+// setPoolCreationRecord stores the creator of the pool and the creation fee they paid.
+func (k Keeper) setPoolCreationRecord(ctx sdk.Context, record types.PoolCreationRecord) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetKeyPrefixPoolCreationRecord(record.PoolId), &record)
+}
+
+// GetPoolCreationRecord returns the creator of the pool and the creation fee they paid.
+// Returns false if the pool has no creation record, which is the case for the pools created
+// before creation records were introduced.
+func (k Keeper) GetPoolCreationRecord(ctx sdk.Context, poolId uint64) (types.PoolCreationRecord, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	record := types.PoolCreationRecord{}
+	found, err := osmoutils.Get(store, types.GetKeyPrefixPoolCreationRecord(poolId), &record)
+	return record, found, err
+}
+
+// GetAllPoolCreationRecords returns the creation records of all pools.
+func (k Keeper) GetAllPoolCreationRecords(ctx sdk.Context) ([]types.PoolCreationRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixPoolCreationRecords, func(bz []byte) (types.PoolCreationRecord, error) {
+		record := types.PoolCreationRecord{}
+		err := record.Unmarshal(bz)
+		return record, err
+	})
+}
+
+// markPoolDestroyed marks the pool as destroyed.
+func (k Keeper) markPoolDestroyed(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPrefixDestroyedPool(poolId), []byte{1})
+}
+
+// IsPoolDestroyed returns true if the pool was destroyed after all of its shares were exited.
+func (k Keeper) IsPoolDestroyed(ctx sdk.Context, poolId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetKeyPrefixDestroyedPool(poolId))
+}
+
+// GetDestroyedPoolIds returns the ids of all the destroyed pools.
+func (k Keeper) GetDestroyedPoolIds(ctx sdk.Context) []uint64 {
+	iter := k.iterator(ctx, types.KeyPrefixDestroyedPools)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixDestroyedPools):]))
+	}
+	return poolIds
+}
+
+// destroyPool destroys a pool after all of its shares were exited. It:
+// - removes the pool from state and marks it as destroyed.
+// - removes the pool route from x/poolmanager, so that the pool can no longer be routed to.
+// - runs the AfterCFMMPoolDestroyed hook, which prunes the twap records of the pool.
+// - refunds the PoolCreationFeeRefundRatio share of the creation fee to the pool creator.
+// CONTRACT: the pool was checked to be destroyable with validatePoolDestroyable.
+func (k Keeper) destroyPool(ctx sdk.Context, poolId uint64) error {
+	if err := k.DeletePool(ctx, poolId); err != nil {
+		return err
+	}
+	k.markPoolDestroyed(ctx, poolId)
+	k.poolManager.DeletePoolRoute(ctx, poolId)
+
+	k.hooks.AfterCFMMPoolDestroyed(ctx, poolId)
+
+	refund, err := k.refundPoolCreationFee(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolDestroyed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyCreationFeeRefund, refund.String()),
+	))
+	return nil
+}
+
+// validatePoolDestroyable returns an error if the pool is linked to a concentrated liquidity pool for migrations,
+// or if it is internally incentivized by a distribution record, since the linked pool and the distribution records
+// would still reference the pool once destroyed. Governance must remove the link or the distribution record
+// before the last shares of the pool can be exited.
+func (k Keeper) validatePoolDestroyable(ctx sdk.Context, poolId uint64) error {
+	if clPoolId, err := k.GetLinkedConcentratedPoolID(ctx, poolId); err == nil {
+		return errorsmod.Wrapf(types.ErrDestroyLinkedPool, "pool %d is linked to concentrated liquidity pool %d", poolId, clPoolId)
+	}
+
+	isIncentivized, err := k.poolIncentivesKeeper.IsPoolIncentivized(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if isIncentivized {
+		return errorsmod.Wrapf(types.ErrDestroyIncentivizedPool, "pool %d", poolId)
+	}
+	return nil
+}
+
+// refundPoolCreationFee refunds the PoolCreationFeeRefundRatio share of the creation fee paid for the pool
+// from the community pool to the pool creator, and deletes the creation record of the pool.
+// The refund is skipped if the community pool cannot cover it, so that the last shares of a pool can always be exited.
+// Returns the refunded coins.
+func (k Keeper) refundPoolCreationFee(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	record, found, err := k.GetPoolCreationRecord(ctx, poolId)
+	if err != nil || !found {
+		return sdk.Coins{}, err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyPrefixPoolCreationRecord(poolId))
+
+	refundRatio := k.GetParams(ctx).PoolCreationFeeRefundRatio
+	if refundRatio.IsZero() {
+		return sdk.Coins{}, nil
+	}
+
+	refund := sdk.Coins{}
+	for _, coin := range record.CreationFee {
+		refund = refund.Add(sdk.NewCoin(coin.Denom, refundRatio.MulInt(coin.Amount).TruncateInt()))
+	}
+	if refund.IsZero() {
+		return sdk.Coins{}, nil
+	}
+
+	creator, err := sdk.AccAddressFromBech32(record.Creator)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		return k.communityPoolKeeper.DistributeFromFeePool(cacheCtx, refund, creator)
+	})
+	if err != nil {
+		// The error is logged by ApplyFuncIfNoError, the pool is destroyed without a refund.
+		return sdk.Coins{}, nil
+	}
+	return refund, nil
+}
@@ -8,11 +8,6 @@ import (
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
-// SetParams sets the total set of params.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.setParams(ctx, params)
-}
-
 // SetPool adds an existing pool to the keeper store.
 func (k Keeper) SetPool(ctx sdk.Context, pool poolmanagertypes.PoolI) error {
 	return k.setPool(ctx, pool)
@@ -38,3 +33,15 @@ func (k Keeper) UnmarshalPoolLegacy(bz []byte) (poolmanagertypes.PoolI, error) {
 func GetMaximalNoSwapLPAmount(ctx sdk.Context, pool types.CFMMPoolI, shareOutAmount osmomath.Int) (neededLpLiquidity sdk.Coins, err error) {
 	return getMaximalNoSwapLPAmount(ctx, pool, shareOutAmount)
 }
+
+// SetHooksUnsafe sets the gamm hooks. It is only meant to be used in tests.
+// As a result, it is called unsafe.
+func (k *Keeper) SetHooksUnsafe(gh types.GammHooks) {
+	k.hooks = gh
+}
+
+// GetHooksUnsafe returns the gamm hooks. It is only meant to be used in tests.
+// As a result, it is called unsafe.
+func (k Keeper) GetHooksUnsafe() types.GammHooks {
+	return k.hooks
+}
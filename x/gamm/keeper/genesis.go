@@ -11,7 +11,7 @@ import (
 // InitGenesis initializes the x/gamm module's state from a provided genesis
 // state, which includes the current live pools, global pool parameters (e.g. pool creation fee), next pool id etc.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpacker codectypes.AnyUnpacker) {
-	k.setParams(ctx, genState.Params)
+	k.SetParams(ctx, genState.Params)
 	k.setNextPoolId(ctx, genState.NextPoolNumber)
 
 	// Sums up the liquidity in all genesis state pools to find the total liquidity across all pools.
@@ -36,6 +36,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 
 	k.setTotalLiquidity(ctx, liquidity)
 
+	for _, record := range genState.PoolCreationRecords {
+		k.setPoolCreationRecord(ctx, record)
+	}
+	for _, poolId := range genState.DestroyedPoolIds {
+		k.markPoolDestroyed(ctx, poolId)
+	}
+
 	if genState.MigrationRecords == nil {
 		k.SetMigrationRecords(ctx, gammmigration.MigrationRecords{})
 	} else {
@@ -61,10 +68,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 		poolAnys = append(poolAnys, any)
 	}
+	poolCreationRecords, err := k.GetAllPoolCreationRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		NextPoolNumber:   k.GetNextPoolId(ctx),
-		Pools:            poolAnys,
-		Params:           k.GetParams(ctx),
-		MigrationRecords: &migrationInfo,
+		NextPoolNumber:      k.GetNextPoolId(ctx),
+		Pools:               poolAnys,
+		Params:              k.GetParams(ctx),
+		MigrationRecords:    &migrationInfo,
+		PoolCreationRecords: poolCreationRecords,
+		DestroyedPoolIds:    k.GetDestroyedPoolIds(ctx),
 	}
 }
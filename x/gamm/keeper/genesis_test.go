@@ -54,7 +54,8 @@ func (s *KeeperTestSuite) TestGammInitGenesis() {
 		Pools:          poolAnys,
 		NextPoolNumber: 7,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFee:            sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFeeRefundRatio: osmomath.ZeroDec(),
 		},
 		MigrationRecords: &DefaultMigrationRecords,
 	}, s.App.AppCodec())
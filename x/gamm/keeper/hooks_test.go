@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+)
+
+type joinExitCall struct {
+	sender sdk.AccAddress
+	poolId uint64
+	coins  sdk.Coins
+	shares osmomath.Int
+}
+
+type swapCall struct {
+	sender sdk.AccAddress
+	poolId uint64
+	input  sdk.Coins
+	output sdk.Coins
+}
+
+// gammHooksMock records the invocations of the gamm hooks.
+type gammHooksMock struct {
+	joinCalls []joinExitCall
+	exitCalls []joinExitCall
+	swapCalls []swapCall
+}
+
+var _ types.GammHooks = &gammHooksMock{}
+
+func (h *gammHooksMock) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+func (h *gammHooksMock) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
+	h.joinCalls = append(h.joinCalls, joinExitCall{sender, poolId, enterCoins, shareOutAmount})
+}
+
+func (h *gammHooksMock) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, exitCoins sdk.Coins) {
+	h.exitCalls = append(h.exitCalls, joinExitCall{sender, poolId, exitCoins, shareInAmount})
+}
+
+func (h *gammHooksMock) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	h.swapCalls = append(h.swapCalls, swapCall{sender, poolId, input, output})
+}
+
+func (h *gammHooksMock) AfterCFMMPoolDestroyed(ctx sdk.Context, poolId uint64) {
+}
+
+// TestGammHooks tests that every join, exit and swap of a CFMM pool invokes the gamm hooks
+// with the sender, the pool and the amounts of coins and shares actually moved.
+func (s *KeeperTestSuite) TestGammHooks() {
+	sharesAmount := types.OneShare.MulRaw(10)
+	tokenIn := sdk.NewCoin(apptesting.FOO, osmomath.NewInt(10000))
+
+	tests := map[string]struct {
+		// action performs the pool operation, returning the coins and shares expected to be
+		// passed to the join and exit hooks. Nil coins are not checked.
+		action            func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int)
+		expectedJoinCalls int
+		expectedExitCalls int
+		expectedSwapCalls int
+	}{
+		"JoinPoolNoSwap": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				tokensIn, sharesOut, err := s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, sender, poolId, sharesAmount, sdk.Coins{})
+				s.Require().NoError(err)
+				return tokensIn, sharesOut
+			},
+			expectedJoinCalls: 1,
+		},
+		"JoinSwapExactAmountIn": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				sharesOut, err := s.App.GAMMKeeper.JoinSwapExactAmountIn(s.Ctx, sender, poolId, sdk.NewCoins(tokenIn), osmomath.ZeroInt())
+				s.Require().NoError(err)
+				return sdk.NewCoins(tokenIn), sharesOut
+			},
+			expectedJoinCalls: 1,
+		},
+		"JoinSwapShareAmountOut": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				tokenInAmount, err := s.App.GAMMKeeper.JoinSwapShareAmountOut(s.Ctx, sender, poolId, apptesting.FOO, sharesAmount, tokenIn.Amount.MulRaw(1000))
+				s.Require().NoError(err)
+				return sdk.NewCoins(sdk.NewCoin(apptesting.FOO, tokenInAmount)), sharesAmount
+			},
+			expectedJoinCalls: 1,
+		},
+		"ExitPool": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				exitCoins, err := s.App.GAMMKeeper.ExitPool(s.Ctx, sender, poolId, sharesAmount, sdk.Coins{})
+				s.Require().NoError(err)
+				return exitCoins, sharesAmount
+			},
+			expectedExitCalls: 1,
+		},
+		"ExitSwapExactAmountOut": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				sharesIn, err := s.App.GAMMKeeper.ExitSwapExactAmountOut(s.Ctx, sender, poolId, tokenIn, sharesAmount.MulRaw(1000))
+				s.Require().NoError(err)
+				return sdk.NewCoins(tokenIn), sharesIn
+			},
+			expectedExitCalls: 1,
+		},
+		"ExitSwapShareAmountIn: exits and swaps the other tokens": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				_, err := s.App.GAMMKeeper.ExitSwapShareAmountIn(s.Ctx, sender, poolId, apptesting.FOO, sharesAmount, osmomath.ZeroInt())
+				s.Require().NoError(err)
+				return nil, sharesAmount
+			},
+			expectedExitCalls: 1,
+			// one swap of each of the other three tokens of the pool
+			expectedSwapCalls: 3,
+		},
+		"SwapExactAmountIn": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+				s.Require().NoError(err)
+				_, err = s.App.GAMMKeeper.SwapExactAmountIn(s.Ctx, sender, pool, tokenIn, apptesting.BAR, osmomath.ZeroInt(), osmomath.ZeroDec())
+				s.Require().NoError(err)
+				return nil, osmomath.Int{}
+			},
+			expectedSwapCalls: 1,
+		},
+		"SwapExactAmountOut": {
+			action: func(sender sdk.AccAddress, poolId uint64) (sdk.Coins, osmomath.Int) {
+				pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+				s.Require().NoError(err)
+				_, err = s.App.GAMMKeeper.SwapExactAmountOut(s.Ctx, sender, pool, apptesting.BAR, tokenIn.Amount.MulRaw(1000), tokenIn, osmomath.ZeroDec())
+				s.Require().NoError(err)
+				return nil, osmomath.Int{}
+			},
+			expectedSwapCalls: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.PrepareBalancerPool()
+			sender := s.TestAccs[0]
+			s.FundAcc(sender, apptesting.DefaultAcctFunds)
+
+			// The hooks of the app keep being called, so that the other modules see the operations.
+			mock := &gammHooksMock{}
+			s.App.GAMMKeeper.SetHooksUnsafe(types.NewMultiGammHooks(s.App.GAMMKeeper.GetHooksUnsafe(), mock))
+
+			expectedCoins, expectedShares := tc.action(sender, poolId)
+
+			s.Require().Len(mock.joinCalls, tc.expectedJoinCalls)
+			s.Require().Len(mock.exitCalls, tc.expectedExitCalls)
+			s.Require().Len(mock.swapCalls, tc.expectedSwapCalls)
+
+			for _, calls := range [][]joinExitCall{mock.joinCalls, mock.exitCalls} {
+				for _, call := range calls {
+					s.Require().Equal(sender, call.sender)
+					s.Require().Equal(poolId, call.poolId)
+					s.Require().Equal(expectedShares.String(), call.shares.String())
+					if expectedCoins != nil {
+						s.Require().Equal(expectedCoins.String(), call.coins.String())
+					}
+				}
+			}
+
+			for _, call := range mock.swapCalls {
+				s.Require().Equal(sender, call.sender)
+				s.Require().Equal(poolId, call.poolId)
+				s.Require().Len(call.input, 1)
+				s.Require().Len(call.output, 1)
+				s.Require().True(call.input[0].Amount.IsPositive())
+				s.Require().True(call.output[0].Amount.IsPositive())
+			}
+		})
+	}
+}
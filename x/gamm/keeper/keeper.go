@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -26,6 +27,7 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
+	// paramSpace is the legacy x/params subspace of the gamm parameters, only read to migrate them to the module store.
 	paramSpace paramtypes.Subspace
 	hooks      types.GammHooks
 
@@ -37,9 +39,12 @@ type Keeper struct {
 	concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper
 	poolIncentivesKeeper        types.PoolIncentivesKeeper
 	incentivesKeeper            types.IncentivesKeeper
+
+	// authority is the address allowed to update the module parameters, the governance module account.
+	authority string
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper, authority string) Keeper {
 	// Ensure that the module account are set.
 	moduleAddr, perms := accountKeeper.GetModuleAddressAndPermissions(types.ModuleName)
 	if moduleAddr == nil {
@@ -65,6 +70,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace p
 		concentratedLiquidityKeeper: concentratedLiquidityKeeper,
 		poolIncentivesKeeper:        poolIncentivesKeeper,
 		incentivesKeeper:            incentivesKeeper,
+		authority:                   authority,
 	}
 }
 
@@ -85,18 +91,13 @@ func (k *Keeper) SetPoolManager(poolManager types.PoolManager) {
 
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 	return params
 }
 
 // SetParams sets the total set of params.
-func (k Keeper) setParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
-}
-
-// SetParam sets a specific gamm module's parameter with the provided parameter.
-func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
-	k.paramSpace.Set(ctx, key, value)
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 }
 
 // Set the pool incentives keeper.
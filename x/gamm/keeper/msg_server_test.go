@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
@@ -358,3 +361,64 @@ func (s *KeeperTestSuite) TestExitPool_Events() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := map[string]struct {
+		authority    string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		"update pool creation fee and refund ratio": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.PoolCreationFee = sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 500_000_000))
+				params.PoolCreationFeeRefundRatio = osmomath.NewDecWithPrec(5, 1)
+			},
+		},
+		"error: not the governance authority": {
+			authority:    s.TestAccs[0].String(),
+			updateParams: func(params *types.Params) { params.PoolCreationFeeRefundRatio = osmomath.NewDecWithPrec(5, 1) },
+			expectedErr:  "invalid authority",
+		},
+		"error: negative pool creation fee": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.PoolCreationFee = sdk.Coins{{Denom: appparams.BaseCoinUnit, Amount: osmomath.NewInt(-1)}}
+			},
+			expectedErr: "invalid pool creation fee",
+		},
+		"error: refund ratio above one": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.PoolCreationFeeRefundRatio = osmomath.NewDec(2) },
+			expectedErr:  "pool creation fee refund ratio must be between 0 and 1",
+		},
+		"error: negative refund ratio": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.PoolCreationFeeRefundRatio = osmomath.NewDecWithPrec(-1, 1) },
+			expectedErr:  "pool creation fee refund ratio must be between 0 and 1",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			originalParams := s.App.GAMMKeeper.GetParams(s.Ctx)
+			newParams := s.App.GAMMKeeper.GetParams(s.Ctx)
+			tc.updateParams(&newParams)
+			msgServer := keeper.NewMsgServerImpl(s.App.GAMMKeeper)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: tc.authority, Params: newParams})
+
+			params := s.App.GAMMKeeper.GetParams(s.Ctx)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, params)
+		})
+	}
+}
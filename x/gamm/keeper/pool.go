@@ -53,6 +53,9 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 	store := ctx.KVStore(k.storeKey)
 	poolKey := types.GetKeyPrefixPools(poolId)
 	if !store.Has(poolKey) {
+		if k.IsPoolDestroyed(ctx, poolId) {
+			return nil, types.PoolDestroyedError{PoolId: poolId}
+		}
 		return nil, types.PoolDoesNotExistError{PoolId: poolId}
 	}
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -114,6 +115,14 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 		return err
 	}
 
+	// Record the creator and the creation fee charged by x/poolmanager,
+	// so that part of the fee can be refunded when the pool is destroyed.
+	k.setPoolCreationRecord(ctx, types.PoolCreationRecord{
+		PoolId:      pool.GetId(),
+		Creator:     sender.String(),
+		CreationFee: k.poolManager.GetParams(ctx).PoolCreationFee,
+	})
+
 	// N.B.: these hooks propagate to x/twap to create
 	// twap records at pool creation time.
 	// Additionally, these hooks are used in x/pool-incentives to
@@ -325,15 +334,40 @@ func (k Keeper) ExitPool(
 	}
 
 	totalSharesAmount := pool.GetTotalShares()
-	if shareInAmount.GTE(totalSharesAmount) {
-		return sdk.Coins{}, errorsmod.Wrapf(types.ErrInvalidMathApprox, "Trying to exit >= the number of shares contained in the pool.")
+	if shareInAmount.GT(totalSharesAmount) {
+		return sdk.Coins{}, errorsmod.Wrapf(types.ErrInvalidMathApprox, "Trying to exit > the number of shares contained in the pool.")
 	} else if shareInAmount.LTE(osmomath.ZeroInt()) {
 		return sdk.Coins{}, errorsmod.Wrapf(types.ErrInvalidMathApprox, "Trying to exit a negative amount of shares")
 	}
-	exitFee := pool.GetExitFee(ctx)
-	exitCoins, err = pool.ExitPool(ctx, shareInAmount, exitFee)
-	if err != nil {
-		return sdk.Coins{}, err
+
+	// Exiting all the shares of the pool returns all of its liquidity, after which the pool is destroyed.
+	isFullExit := shareInAmount.Equal(totalSharesAmount)
+	if isFullExit {
+		if err := k.validatePoolDestroyable(ctx, poolId); err != nil {
+			return sdk.Coins{}, err
+		}
+	}
+	exitFee := osmomath.ZeroDec()
+	exitFeeCoins := sdk.Coins{}
+	if isFullExit {
+		exitCoins = pool.GetTotalPoolLiquidity(ctx)
+	} else {
+		exitFee = pool.GetExitFee(ctx)
+		// New pools cannot have an exit fee, only the legacy pools charging one pay for computing the fee coins.
+		exitCoinsWithoutFee := sdk.Coins{}
+		if exitFee.IsPositive() {
+			exitCoinsWithoutFee, err = pool.CalcExitPoolCoinsFromShares(ctx, shareInAmount, osmomath.ZeroDec())
+			if err != nil {
+				return sdk.Coins{}, err
+			}
+		}
+		exitCoins, err = pool.ExitPool(ctx, shareInAmount, exitFee)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		if exitFee.IsPositive() {
+			exitFeeCoins = exitCoinsWithoutFee.Sub(exitCoins...)
+		}
 	}
 	if !tokenOutMins.DenomsSubsetOf(exitCoins) || tokenOutMins.IsAnyGT(exitCoins) {
 		return sdk.Coins{}, errorsmod.Wrapf(types.ErrLimitMinAmount,
@@ -346,6 +380,24 @@ func (k Keeper) ExitPool(
 		return sdk.Coins{}, err
 	}
 
+	// The exit fee coins are left in the pool, to the benefit of the remaining LPs.
+	if !exitFeeCoins.Empty() {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtExitFeeCharged,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExitFee, exitFee.String()),
+			sdk.NewAttribute(types.AttributeKeyExitFeeTokens, exitFeeCoins.String()),
+		))
+	}
+
+	if isFullExit {
+		if err := k.destroyPool(ctx, poolId); err != nil {
+			return sdk.Coins{}, err
+		}
+	}
+
 	return exitCoins, nil
 }
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/rand"
 	"time"
 
 	_ "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -14,6 +15,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	gammmigration "github.com/osmosis-labs/osmosis/v21/x/gamm/types/migration"
+	poolincentivestypes "github.com/osmosis-labs/osmosis/v21/x/pool-incentives/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
@@ -683,6 +686,149 @@ func (s *KeeperTestSuite) TestExitPool() {
 	}
 }
 
+// TestExitPool_FullExit tests that exiting all the shares of a pool returns all of its liquidity,
+// destroys the pool and refunds the configured share of the creation fee to the pool creator.
+func (s *KeeperTestSuite) TestExitPool_FullExit() {
+	tests := map[string]struct {
+		refundRatio osmomath.Dec
+	}{
+		"refunds disabled": {
+			refundRatio: osmomath.ZeroDec(),
+		},
+		"half of the creation fee refunded": {
+			refundRatio: osmomath.NewDecWithPrec(5, 1),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			creator := s.TestAccs[0]
+			params := s.App.GAMMKeeper.GetParams(s.Ctx)
+			params.PoolCreationFeeRefundRatio = tc.refundRatio
+			s.App.GAMMKeeper.SetParams(s.Ctx, params)
+
+			s.FundAcc(creator, defaultAcctFunds)
+			poolCreationFee := s.App.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee
+			msg := balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+				SwapFee: osmomath.NewDecWithPrec(1, 2),
+				ExitFee: osmomath.ZeroDec(),
+			}, defaultPoolAssets, defaultFutureGovernor)
+			poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+			s.Require().NoError(err)
+
+			record, found, err := s.App.GAMMKeeper.GetPoolCreationRecord(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(creator.String(), record.Creator)
+			s.Require().Equal(poolCreationFee, record.CreationFee)
+
+			// Mimic protorev storing the pool as the highest liquidity pool of its denom pair.
+			s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, "bar", "foo", poolId)
+
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, creator)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			exitCoins, err := s.App.GAMMKeeper.ExitPool(s.Ctx, creator, poolId, types.InitPoolSharesSupply, sdk.Coins{})
+			s.Require().NoError(err)
+
+			// All the liquidity of the pool is returned.
+			s.Require().Equal("10000bar,10000foo", exitCoins.String())
+			liquidity, err := s.App.GAMMKeeper.GetTotalLiquidity(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().True(liquidity.IsZero())
+
+			// The pool is destroyed and can no longer be routed to.
+			_, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().ErrorIs(err, types.PoolDestroyedError{PoolId: poolId})
+			s.Require().True(s.App.GAMMKeeper.IsPoolDestroyed(s.Ctx, poolId))
+			s.Require().Equal([]uint64{poolId}, s.App.GAMMKeeper.GetDestroyedPoolIds(s.Ctx))
+			_, err = s.App.PoolManagerKeeper.GetPoolModule(s.Ctx, poolId)
+			s.Require().Error(err)
+
+			// The twap records of the pool are pruned.
+			mostRecentRecords, err := s.App.TwapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Empty(mostRecentRecords)
+			historicalRecords, err := s.App.TwapKeeper.GetAllHistoricalPoolIndexedTWAPsForPoolId(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Empty(historicalRecords)
+
+			// The pool is removed from the protorev highest liquidity pools.
+			_, err = s.App.ProtoRevKeeper.GetPoolForDenomPair(s.Ctx, "bar", "foo")
+			s.Require().Error(err)
+
+			// The configured share of the creation fee is refunded and the creation record is removed.
+			expectedRefund := sdk.Coins{}
+			for _, coin := range poolCreationFee {
+				expectedRefund = expectedRefund.Add(sdk.NewCoin(coin.Denom, tc.refundRatio.MulInt(coin.Amount).TruncateInt()))
+			}
+			balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, creator)
+			expectedBalances := balancesBefore.Sub(sdk.NewCoin(types.GetPoolShareDenom(poolId), types.InitPoolSharesSupply)).Add(exitCoins...).Add(expectedRefund...)
+			s.Require().Equal(expectedBalances.String(), balancesAfter.String())
+			_, found, err = s.App.GAMMKeeper.GetPoolCreationRecord(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().False(found)
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolDestroyed, 1)
+		})
+	}
+}
+
+// TestExitPool_FullExit_NotDestroyable tests that the last shares of a pool cannot be exited
+// while the pool is linked to a concentrated liquidity pool or internally incentivized.
+func (s *KeeperTestSuite) TestExitPool_FullExit_NotDestroyable() {
+	tests := map[string]struct {
+		setup       func(poolId uint64)
+		expectedErr error
+	}{
+		"linked to a concentrated liquidity pool": {
+			setup: func(poolId uint64) {
+				clPool := s.PrepareConcentratedPoolWithCoins("bar", "foo")
+				err := s.App.GAMMKeeper.OverwriteMigrationRecords(s.Ctx, gammmigration.MigrationRecords{
+					BalancerToConcentratedPoolLinks: []gammmigration.BalancerToConcentratedPoolLink{{BalancerPoolId: poolId, ClPoolId: clPool.GetId()}},
+				})
+				s.Require().NoError(err)
+			},
+			expectedErr: types.ErrDestroyLinkedPool,
+		},
+		"internally incentivized": {
+			setup: func(poolId uint64) {
+				lockableDurations := s.App.PoolIncentivesKeeper.GetLockableDurations(s.Ctx)
+				gaugeId, err := s.App.PoolIncentivesKeeper.GetPoolGaugeId(s.Ctx, poolId, lockableDurations[len(lockableDurations)-1])
+				s.Require().NoError(err)
+				err = s.App.PoolIncentivesKeeper.ReplaceDistrRecords(s.Ctx, poolincentivestypes.DistrRecord{GaugeId: gaugeId, Weight: osmomath.OneInt()})
+				s.Require().NoError(err)
+			},
+			expectedErr: types.ErrDestroyIncentivizedPool,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			creator := s.TestAccs[0]
+			s.FundAcc(creator, defaultAcctFunds)
+			msg := balancer.NewMsgCreateBalancerPool(creator, balancer.PoolParams{
+				SwapFee: osmomath.NewDecWithPrec(1, 2),
+				ExitFee: osmomath.ZeroDec(),
+			}, defaultPoolAssets, defaultFutureGovernor)
+			poolId, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+			s.Require().NoError(err)
+
+			tc.setup(poolId)
+
+			_, err = s.App.GAMMKeeper.ExitPool(s.Ctx, creator, poolId, types.InitPoolSharesSupply, sdk.Coins{})
+			s.Require().ErrorIs(err, tc.expectedErr)
+			s.Require().False(s.App.GAMMKeeper.IsPoolDestroyed(s.Ctx, poolId))
+
+			// All but the last share can still be exited.
+			_, err = s.App.GAMMKeeper.ExitPool(s.Ctx, creator, poolId, types.InitPoolSharesSupply.Sub(osmomath.OneInt()), sdk.Coins{})
+			s.Require().NoError(err)
+		})
+	}
+}
+
 // TestJoinPoolExitPool_InverseRelationship tests that joining pool and exiting pool
 // guarantees same amount in and out
 func (s *KeeperTestSuite) TestJoinPoolExitPool_InverseRelationship() {
@@ -772,6 +918,65 @@ func (s *KeeperTestSuite) TestJoinPoolExitPool_InverseRelationship() {
 	}
 }
 
+// TestJoinPoolExitPool_RoundTripProperties tests, over randomized pools and share amounts, that exiting the
+// shares of a join never returns more than the coins joined, and that the exit fee coins charged by legacy pools
+// are exactly the coins withheld from the exit.
+func (s *KeeperTestSuite) TestJoinPoolExitPool_RoundTripProperties() {
+	const numIterations = 50
+	r := rand.New(rand.NewSource(1))
+
+	for _, exitFee := range []osmomath.Dec{osmomath.ZeroDec(), osmomath.NewDecWithPrec(1, 2)} {
+		for i := 0; i < numIterations; i++ {
+			s.SetupTest()
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			joinPoolAcc := s.TestAccs[0]
+			s.FundAcc(joinPoolAcc, sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(1_000_000_000)), sdk.NewCoin("bar", osmomath.NewInt(1_000_000_000))))
+
+			poolId := s.PrepareBalancerPoolWithCoins(
+				sdk.NewCoin("foo", osmomath.NewInt(r.Int63n(1_000_000_000)+1_000_000)),
+				sdk.NewCoin("bar", osmomath.NewInt(r.Int63n(1_000_000_000)+1_000_000)),
+			)
+			if exitFee.IsPositive() {
+				// New pools cannot have an exit fee, so the one of a legacy pool is set directly.
+				pool, err := s.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+				s.Require().NoError(err)
+				pool.(*balancer.Pool).PoolParams.ExitFee = exitFee
+				s.Require().NoError(s.App.GAMMKeeper.SetPool(ctx, pool))
+			}
+
+			// Join with up to 10% of the total shares of the pool.
+			shareAmount := types.OneShare.MulRaw(r.Int63n(10) + 1)
+			joinedCoins, _, err := s.App.GAMMKeeper.JoinPoolNoSwap(ctx, joinPoolAcc, poolId, shareAmount, sdk.Coins{})
+			s.Require().NoError(err)
+
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+			s.Require().NoError(err)
+			exitCoinsWithoutFee, err := pool.CalcExitPoolCoinsFromShares(ctx, shareAmount, osmomath.ZeroDec())
+			s.Require().NoError(err)
+
+			exitCoins, err := s.App.GAMMKeeper.ExitPool(ctx, joinPoolAcc, poolId, shareAmount, sdk.Coins{})
+			s.Require().NoError(err)
+
+			s.Require().True(joinedCoins.IsAllGTE(exitCoins), "exited %s for joined %s", exitCoins, joinedCoins)
+
+			if exitFee.IsZero() {
+				s.Require().Equal(exitCoinsWithoutFee, exitCoins)
+				s.AssertEventEmitted(ctx, types.TypeEvtExitFeeCharged, 0)
+				continue
+			}
+
+			s.AssertEventEmitted(ctx, types.TypeEvtExitFeeCharged, 1)
+			event := s.FindEvent(ctx.EventManager().Events(), types.TypeEvtExitFeeCharged)
+			attributes := s.ExtractAttributes(event)
+			s.Require().Equal(exitFee.String(), attributes[types.AttributeKeyExitFee])
+
+			exitFeeCoins, err := sdk.ParseCoinsNormalized(attributes[types.AttributeKeyExitFeeTokens])
+			s.Require().NoError(err)
+			s.Require().Equal(exitCoinsWithoutFee, exitCoins.Add(exitFeeCoins...))
+		}
+	}
+}
+
 func (s *KeeperTestSuite) TestActiveBalancerPool() {
 	type testCase struct {
 		blockTime  time.Time
@@ -16,6 +16,14 @@ func (e PoolDoesNotExistError) Error() string {
 	return fmt.Sprintf("pool with ID %d does not exist", e.PoolId)
 }
 
+type PoolDestroyedError struct {
+	PoolId uint64
+}
+
+func (e PoolDestroyedError) Error() string {
+	return fmt.Sprintf("pool with ID %d was destroyed after all of its shares were exited", e.PoolId)
+}
+
 type UnsortedPoolLiqError struct {
 	ActualLiquidity sdk.Coins
 }
@@ -108,4 +116,6 @@ var (
 	ErrHitMinScaledAssets         = errorsmod.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
 	ErrNoGaugeToRedirect          = errorsmod.Register(ModuleName, 67, "could not find gauge to redirect")
 	ErrMustHaveTwoDenoms          = errorsmod.Register(ModuleName, 68, "can only have 2 denoms in CL pool")
+	ErrDestroyLinkedPool          = errorsmod.Register(ModuleName, 69, "cannot destroy a pool linked to a concentrated liquidity pool")
+	ErrDestroyIncentivizedPool    = errorsmod.Register(ModuleName, 70, "cannot destroy an internally incentivized pool")
 )
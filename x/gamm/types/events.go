@@ -1,10 +1,12 @@
 package types
 
 const (
-	TypeEvtPoolJoined    = "pool_joined"
-	TypeEvtPoolExited    = "pool_exited"
-	TypeEvtTokenSwapped  = "token_swapped"
-	TypeEvtMigrateShares = "migrate_shares"
+	TypeEvtPoolJoined     = "pool_joined"
+	TypeEvtPoolExited     = "pool_exited"
+	TypeEvtTokenSwapped   = "token_swapped"
+	TypeEvtMigrateShares  = "migrate_shares"
+	TypeEvtPoolDestroyed  = "pool_destroyed"
+	TypeEvtExitFeeCharged = "exit_fee_charged"
 
 	AttributeValueCategory     = ModuleName
 	AttributeKeyPoolId         = "pool_id"
@@ -14,6 +16,10 @@ const (
 	AttributeKeyTokensIn       = "tokens_in"
 	AttributeKeyTokensOut      = "tokens_out"
 
+	AttributeKeyCreationFeeRefund = "creation_fee_refund"
+	AttributeKeyExitFee           = "exit_fee"
+	AttributeKeyExitFeeTokens     = "exit_fee_tokens"
+
 	AttributePositionId = "position_id"
 	AttributeAmount0    = "amount0"
 	AttributeAmount1    = "amount1"
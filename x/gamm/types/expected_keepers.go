@@ -50,6 +50,7 @@ type BankKeeper interface {
 // CommunityPoolKeeper defines the contract needed to be fulfilled for distribution keeper.
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 }
 
 // ConcentratedLiquidityKeeper defines the contract needed to be fulfilled for the concentrated liquidity keeper.
@@ -98,6 +99,10 @@ type PoolManager interface {
 	CreateConcentratedPoolAsPoolManager(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (poolmanagertypes.PoolI, error)
 
 	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
+
+	GetParams(ctx sdk.Context) (params poolmanagertypes.Params)
+
+	DeletePoolRoute(ctx sdk.Context, poolId uint64)
 }
 
 type PoolIncentivesKeeper interface {
@@ -105,6 +110,7 @@ type PoolIncentivesKeeper interface {
 	GetPoolGaugeId(ctx sdk.Context, poolId uint64, lockableDuration time.Duration) (uint64, error)
 	GetDistrInfo(ctx sdk.Context) types.DistrInfo
 	SetDistrInfo(ctx sdk.Context, distrInfo types.DistrInfo)
+	IsPoolIncentivized(ctx sdk.Context, poolId uint64) (bool, error)
 }
 
 type IncentivesKeeper interface {
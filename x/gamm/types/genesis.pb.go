@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
@@ -31,6 +32,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// pool_creation_fee_refund_ratio is the share of the creation fee paid for a
+	// pool that is refunded to its creator from the community pool when the pool
+	// is destroyed after all of its shares were exited.
+	PoolCreationFeeRefundRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=pool_creation_fee_refund_ratio,json=poolCreationFeeRefundRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pool_creation_fee_refund_ratio" yaml:"pool_creation_fee_refund_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -73,20 +78,86 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// PoolCreationRecord records who created a pool and the creation fee they
+// paid, so that part of it can be refunded when the pool is destroyed.
+type PoolCreationRecord struct {
+	PoolId      uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Creator     string                                   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=creation_fee,json=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_fee" yaml:"creation_fee"`
+}
+
+func (m *PoolCreationRecord) Reset()         { *m = PoolCreationRecord{} }
+func (m *PoolCreationRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolCreationRecord) ProtoMessage()    {}
+func (*PoolCreationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+}
+func (m *PoolCreationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCreationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCreationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolCreationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCreationRecord.Merge(m, src)
+}
+func (m *PoolCreationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCreationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCreationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCreationRecord proto.InternalMessageInfo
+
+func (m *PoolCreationRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolCreationRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PoolCreationRecord) GetCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationFee
+	}
+	return nil
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
 	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber   uint64                      `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params           Params                      `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
-	MigrationRecords *migration.MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	NextPoolNumber      uint64                      `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params              Params                      `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	MigrationRecords    *migration.MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	PoolCreationRecords []PoolCreationRecord        `protobuf:"bytes,5,rep,name=pool_creation_records,json=poolCreationRecords,proto3" json:"pool_creation_records"`
+	// destroyed_pool_ids are the ids of the pools destroyed after all of their
+	// shares were exited.
+	DestroyedPoolIds []uint64 `protobuf:"varint,6,rep,packed,name=destroyed_pool_ids,json=destroyedPoolIds,proto3" json:"destroyed_pool_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+	return fileDescriptor_5a324eb7f1dd793e, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,8 +214,23 @@ func (m *GenesisState) GetMigrationRecords() *migration.MigrationRecords {
 	return nil
 }
 
+func (m *GenesisState) GetPoolCreationRecords() []PoolCreationRecord {
+	if m != nil {
+		return m.PoolCreationRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDestroyedPoolIds() []uint64 {
+	if m != nil {
+		return m.DestroyedPoolIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
+	proto.RegisterType((*PoolCreationRecord)(nil), "osmosis.gamm.v1beta1.PoolCreationRecord")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
 }
 
@@ -153,35 +239,47 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x36, 0x8d, 0x84, 0x8b, 0xa0, 0xb5, 0x72, 0x70, 0x2b, 0xe4, 0x04, 0x1f, 0x90,
-	0x2f, 0xd9, 0x25, 0x41, 0x5c, 0x7a, 0x23, 0x95, 0x40, 0x20, 0x40, 0x95, 0x7b, 0xe3, 0x12, 0xad,
-	0x9d, 0xa9, 0x6b, 0xe1, 0xf5, 0x44, 0xbb, 0x9b, 0xaa, 0xbe, 0xf1, 0x08, 0x48, 0xdc, 0x79, 0x00,
-	0xce, 0x3c, 0x44, 0xc5, 0xa9, 0x47, 0x4e, 0x01, 0x25, 0x6f, 0xc0, 0x13, 0xa0, 0xfd, 0x63, 0x84,
-	0x20, 0x27, 0x7b, 0x66, 0x7e, 0xf3, 0xed, 0xcc, 0x37, 0x7e, 0x8c, 0x92, 0xa3, 0x2c, 0x25, 0x2d,
-	0x18, 0xe7, 0xf4, 0x6a, 0x9c, 0x81, 0x62, 0x63, 0x5a, 0x40, 0x0d, 0xb2, 0x94, 0x64, 0x21, 0x50,
-	0x61, 0xd0, 0x77, 0x0c, 0xd1, 0x0c, 0x71, 0xcc, 0x71, 0xbf, 0xc0, 0x02, 0x0d, 0x40, 0xf5, 0x9f,
-	0x65, 0x8f, 0x8f, 0x0a, 0xc4, 0xa2, 0x02, 0x6a, 0xa2, 0x6c, 0x79, 0x41, 0x59, 0xdd, 0xb4, 0xa5,
-	0xdc, 0xe8, 0xcc, 0x6c, 0x8f, 0x0d, 0x5c, 0x29, 0xb2, 0x11, 0xcd, 0x98, 0x84, 0x3f, 0x43, 0xe4,
-	0x58, 0xd6, 0xae, 0xfe, 0x70, 0xeb, 0x94, 0xf2, 0x92, 0x09, 0x98, 0x5b, 0x24, 0xfe, 0xec, 0xf9,
-	0xbd, 0x33, 0x26, 0x18, 0x97, 0xc1, 0x27, 0xcf, 0x3f, 0x5c, 0x20, 0x56, 0xb3, 0x5c, 0x00, 0x53,
-	0x25, 0xd6, 0xb3, 0x0b, 0x80, 0xd0, 0x1b, 0xee, 0x26, 0xfb, 0x93, 0x23, 0xe2, 0x1e, 0xd6, 0x4f,
-	0xb5, 0xbb, 0x90, 0x53, 0x2c, 0xeb, 0xe9, 0xeb, 0x9b, 0xd5, 0xa0, 0xf3, 0x6b, 0x35, 0x08, 0x1b,
-	0xc6, 0xab, 0x93, 0xf8, 0x3f, 0x85, 0xf8, 0xcb, 0x8f, 0x41, 0x52, 0x94, 0xea, 0x72, 0x99, 0x91,
-	0x1c, 0xb9, 0xdb, 0xc0, 0x7d, 0x46, 0x72, 0xfe, 0x9e, 0xaa, 0x66, 0x01, 0xd2, 0x88, 0xc9, 0xf4,
-	0xbe, 0xee, 0x3f, 0x75, 0xed, 0xcf, 0x01, 0xe2, 0x0f, 0x3b, 0xfe, 0xdd, 0x17, 0xd6, 0xd7, 0x73,
-	0xc5, 0x14, 0x04, 0x4f, 0xfd, 0x3d, 0xcd, 0x48, 0x37, 0x59, 0x9f, 0x58, 0xeb, 0x48, 0x6b, 0x1d,
-	0x79, 0x56, 0x37, 0xd3, 0x3b, 0xdf, 0xbe, 0x8e, 0xf6, 0xce, 0x10, 0xab, 0x97, 0xa9, 0xa5, 0x83,
-	0xc4, 0x3f, 0xa8, 0xe1, 0x5a, 0xcd, 0xcc, 0x7c, 0xf5, 0x92, 0x67, 0x20, 0xc2, 0x9d, 0xa1, 0x97,
-	0x74, 0xd3, 0x7b, 0x3a, 0xaf, 0xd9, 0xb7, 0x26, 0x1b, 0x9c, 0xf8, 0xbd, 0x85, 0x71, 0x24, 0xdc,
-	0x1d, 0x7a, 0xc9, 0xfe, 0xe4, 0x01, 0xd9, 0x76, 0x48, 0x62, 0x5d, 0x9b, 0x76, 0xf5, 0xfa, 0xa9,
-	0xeb, 0x08, 0xce, 0xfd, 0x43, 0x5e, 0x16, 0xc2, 0x2e, 0x2f, 0x20, 0x47, 0x31, 0x97, 0x61, 0xd7,
-	0xc8, 0x3c, 0xda, 0x2e, 0xf3, 0xa6, 0xc5, 0x53, 0x4b, 0xa7, 0x07, 0xfc, 0x9f, 0xcc, 0xf4, 0xd5,
-	0xcd, 0x3a, 0xf2, 0x6e, 0xd7, 0x91, 0xf7, 0x73, 0x1d, 0x79, 0x1f, 0x37, 0x51, 0xe7, 0x76, 0x13,
-	0x75, 0xbe, 0x6f, 0xa2, 0xce, 0xbb, 0xc7, 0x7f, 0xf9, 0xea, 0xd4, 0x47, 0x15, 0xcb, 0x64, 0x1b,
-	0xd0, 0xab, 0xc9, 0x98, 0x5e, 0xdb, 0xf3, 0x1b, 0x97, 0xb3, 0x9e, 0xb1, 0xe9, 0xc9, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xbf, 0x23, 0x6b, 0x34, 0xc1, 0x02, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x18, 0xdd, 0x61, 0x97, 0x25, 0x0c, 0x04, 0x61, 0xc0, 0xa4, 0xa0, 0x69, 0xd7, 0x26, 0x9a, 0x26,
+	0xc2, 0x54, 0x30, 0x5e, 0xb8, 0xb9, 0x18, 0x09, 0x06, 0x0d, 0x19, 0x6e, 0x5e, 0x9a, 0x69, 0x3b,
+	0x94, 0x86, 0x6d, 0x67, 0xd3, 0xe9, 0x12, 0xfa, 0x03, 0xbc, 0x13, 0xfd, 0x17, 0xde, 0x4c, 0xfc,
+	0x11, 0xc4, 0x13, 0x47, 0xe3, 0x61, 0x35, 0xf0, 0x0f, 0xf6, 0xe2, 0xd5, 0x74, 0x66, 0x8a, 0xbb,
+	0xec, 0x12, 0x13, 0x4f, 0x3b, 0x33, 0xdf, 0x9b, 0x37, 0xef, 0xfb, 0xde, 0xdb, 0x42, 0x9b, 0x8b,
+	0x84, 0x8b, 0x58, 0xb8, 0x11, 0x4d, 0x12, 0xf7, 0x74, 0xd3, 0x67, 0x39, 0xdd, 0x74, 0x23, 0x96,
+	0x32, 0x11, 0x0b, 0xdc, 0xcd, 0x78, 0xce, 0xd1, 0x8a, 0xc6, 0xe0, 0x12, 0x83, 0x35, 0x66, 0x6d,
+	0x25, 0xe2, 0x11, 0x97, 0x00, 0xb7, 0x5c, 0x29, 0xec, 0xda, 0x6a, 0xc4, 0x79, 0xd4, 0x61, 0xae,
+	0xdc, 0xf9, 0xbd, 0x23, 0x97, 0xa6, 0x45, 0x55, 0x0a, 0x24, 0x8f, 0xa7, 0xee, 0xa8, 0x8d, 0x2e,
+	0x99, 0x6a, 0xe7, 0xfa, 0x54, 0xb0, 0x1b, 0x11, 0x01, 0x8f, 0x53, 0x5d, 0x7f, 0x34, 0x51, 0xa5,
+	0x38, 0xa6, 0x19, 0x0b, 0x15, 0xc4, 0xfe, 0x32, 0x05, 0x9b, 0x07, 0x34, 0xa3, 0x89, 0x40, 0x9f,
+	0x00, 0x5c, 0xea, 0x72, 0xde, 0xf1, 0x82, 0x8c, 0xd1, 0x3c, 0xe6, 0xa9, 0x77, 0xc4, 0x98, 0x01,
+	0x5a, 0x75, 0x67, 0x6e, 0x6b, 0x15, 0xeb, 0x87, 0xcb, 0xa7, 0xaa, 0x5e, 0xf0, 0x0e, 0x8f, 0xd3,
+	0xf6, 0xfe, 0x45, 0xdf, 0xaa, 0x0d, 0xfa, 0x96, 0x51, 0xd0, 0xa4, 0xb3, 0x6d, 0x8f, 0x31, 0xd8,
+	0x9f, 0x7f, 0x5a, 0x4e, 0x14, 0xe7, 0xc7, 0x3d, 0x1f, 0x07, 0x3c, 0xd1, 0x1d, 0xe8, 0x9f, 0x0d,
+	0x11, 0x9e, 0xb8, 0x79, 0xd1, 0x65, 0x42, 0x92, 0x09, 0x72, 0xaf, 0xbc, 0xbf, 0xa3, 0xaf, 0xbf,
+	0x66, 0x0c, 0x9d, 0x03, 0x68, 0x8e, 0x71, 0x7a, 0x19, 0x3b, 0xea, 0xa5, 0xa1, 0x97, 0x95, 0x27,
+	0xc6, 0x54, 0x0b, 0x38, 0xb3, 0x4a, 0xc7, 0x8f, 0xbe, 0xf5, 0x40, 0x31, 0x8b, 0xf0, 0x04, 0xc7,
+	0xdc, 0x4d, 0x68, 0x7e, 0x8c, 0xf7, 0x59, 0x44, 0x83, 0xe2, 0x15, 0x0b, 0x06, 0x7d, 0xeb, 0xf1,
+	0x1d, 0x32, 0x47, 0x28, 0x6d, 0xb2, 0x76, 0x4b, 0x07, 0x91, 0x55, 0x22, 0x8b, 0xbf, 0x01, 0x44,
+	0x07, 0x43, 0x65, 0xc2, 0x02, 0x9e, 0x85, 0xe8, 0x29, 0x9c, 0x91, 0xac, 0x71, 0x68, 0x80, 0x16,
+	0x70, 0x1a, 0x6d, 0x34, 0xe8, 0x5b, 0x0b, 0x43, 0xcf, 0xc5, 0xa1, 0x4d, 0x9a, 0xe5, 0x6a, 0x2f,
+	0x44, 0xeb, 0x70, 0x46, 0xbe, 0xce, 0x33, 0x2d, 0x7f, 0x08, 0xac, 0x0b, 0x36, 0xa9, 0x20, 0xe8,
+	0x03, 0x80, 0xf3, 0x23, 0xae, 0xd4, 0xff, 0xe5, 0xca, 0xae, 0x76, 0x65, 0x79, 0x88, 0xf2, 0xbf,
+	0x0c, 0x99, 0x0b, 0xfe, 0x0e, 0xc1, 0xfe, 0x58, 0x87, 0xf3, 0xbb, 0x2a, 0xe4, 0x87, 0x39, 0xcd,
+	0x19, 0x7a, 0x01, 0xa7, 0xcb, 0x86, 0x84, 0x8e, 0xc9, 0x0a, 0x56, 0x39, 0xc6, 0x55, 0x8e, 0xf1,
+	0xcb, 0xb4, 0x68, 0xcf, 0x7e, 0xfb, 0xba, 0x31, 0x5d, 0x0e, 0x6c, 0x8f, 0x28, 0x34, 0x72, 0xe0,
+	0x62, 0xca, 0xce, 0x72, 0x4f, 0x8e, 0x25, 0xed, 0x25, 0x3e, 0x53, 0x63, 0x68, 0x90, 0x85, 0xf2,
+	0xbc, 0xc4, 0xbe, 0x93, 0xa7, 0x68, 0x1b, 0x36, 0xbb, 0x32, 0x9e, 0x46, 0xbd, 0x05, 0x9c, 0xb9,
+	0xad, 0x87, 0x78, 0xd2, 0xbf, 0x0a, 0xab, 0x08, 0xb7, 0x1b, 0x65, 0xd7, 0x44, 0xdf, 0x40, 0x87,
+	0x70, 0x29, 0x89, 0xa3, 0x4c, 0x35, 0x9e, 0x49, 0x93, 0x84, 0xd1, 0x90, 0x34, 0x4f, 0x26, 0xd3,
+	0xbc, 0xad, 0xe0, 0xca, 0x52, 0x41, 0x16, 0x93, 0x5b, 0x27, 0xc8, 0x87, 0xf7, 0x47, 0xb3, 0x53,
+	0x11, 0x4f, 0xcb, 0x09, 0x38, 0x77, 0xe8, 0x1b, 0x8b, 0x8b, 0xd6, 0xba, 0xdc, 0x1d, 0xab, 0x08,
+	0xb4, 0x0e, 0x51, 0xc8, 0x44, 0x9e, 0xf1, 0x82, 0x85, 0x9e, 0x8e, 0x8e, 0x30, 0x9a, 0xad, 0xba,
+	0xd3, 0x20, 0x8b, 0x37, 0x15, 0x39, 0xd1, 0x50, 0xb4, 0xdf, 0x5c, 0x5c, 0x99, 0xe0, 0xf2, 0xca,
+	0x04, 0xbf, 0xae, 0x4c, 0x70, 0x7e, 0x6d, 0xd6, 0x2e, 0xaf, 0xcd, 0xda, 0xf7, 0x6b, 0xb3, 0xf6,
+	0xfe, 0xd9, 0x90, 0xcb, 0x5a, 0xd6, 0x46, 0x87, 0xfa, 0xa2, 0xda, 0xb8, 0xa7, 0x5b, 0x9b, 0xee,
+	0x99, 0xfa, 0x3a, 0x48, 0xcf, 0xfd, 0xa6, 0x34, 0xee, 0xf9, 0x9f, 0x01, 0x00, 0xff, 0xeb, 0x7e,
+	0x74, 0xe0, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -204,6 +302,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PoolCreationFeeRefundRatio.Size()
+		i -= size
+		if _, err := m.PoolCreationFeeRefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -221,6 +329,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolCreationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCreationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCreationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -241,6 +398,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DestroyedPoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.DestroyedPoolIds)*10)
+		var j1 int
+		for _, num := range m.DestroyedPoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PoolCreationRecords) > 0 {
+		for iNdEx := len(m.PoolCreationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.MigrationRecords != nil {
 		{
 			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
@@ -308,6 +497,30 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.PoolCreationFeeRefundRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolCreationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -332,6 +545,19 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MigrationRecords.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PoolCreationRecords) > 0 {
+		for _, e := range m.PoolCreationRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DestroyedPoolIds) > 0 {
+		l = 0
+		for _, e := range m.DestroyedPoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -404,6 +630,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFeeRefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCreationFeeRefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCreationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCreationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCreationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -576,6 +971,116 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationRecords = append(m.PoolCreationRecords, PoolCreationRecord{})
+			if err := m.PoolCreationRecords[len(m.PoolCreationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DestroyedPoolIds = append(m.DestroyedPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DestroyedPoolIds) == 0 {
+					m.DestroyedPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DestroyedPoolIds = append(m.DestroyedPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestroyedPoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -18,6 +18,9 @@ type GammHooks interface {
 
 	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
 	AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+
+	// AfterCFMMPoolDestroyed is called after a CFMM pool is destroyed because all of its shares were exited.
+	AfterCFMMPoolDestroyed(ctx sdk.Context, poolId uint64)
 }
 
 var _ GammHooks = MultiGammHooks{}
@@ -53,3 +56,9 @@ func (h MultiGammHooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, po
 		h[i].AfterCFMMSwap(ctx, sender, poolId, input, output)
 	}
 }
+
+func (h MultiGammHooks) AfterCFMMPoolDestroyed(ctx sdk.Context, poolId uint64) {
+	for i := range h {
+		h[i].AfterCFMMPoolDestroyed(ctx, poolId)
+	}
+}
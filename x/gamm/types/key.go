@@ -30,6 +30,13 @@ var (
 
 	KeyPrefixMigrationInfoBalancerPool = []byte{0x04}
 	KeyPrefixMigrationInfoCLPool       = []byte{0x05}
+
+	// KeyPrefixPoolCreationRecords defines prefix to store the creator and creation fee of pools.
+	KeyPrefixPoolCreationRecords = []byte{0x06}
+	// KeyPrefixDestroyedPools defines prefix to mark pools destroyed after all of their shares were exited.
+	KeyPrefixDestroyedPools = []byte{0x07}
+	// KeyParams defines key to store the gamm module parameters.
+	KeyParams = []byte{0x08}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -68,3 +75,11 @@ func GetKeyPrefixMigrationInfoBalancerPool(balancerPoolId uint64) []byte {
 func GetKeyPrefixMigrationInfoPoolCLPool(concentratedPoolId uint64) []byte {
 	return append(KeyPrefixMigrationInfoCLPool, sdk.Uint64ToBigEndian(concentratedPoolId)...)
 }
+
+func GetKeyPrefixPoolCreationRecord(poolId uint64) []byte {
+	return append(KeyPrefixPoolCreationRecords, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixDestroyedPool(poolId uint64) []byte {
+	return append(KeyPrefixDestroyedPools, sdk.Uint64ToBigEndian(poolId)...)
+}
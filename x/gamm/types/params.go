@@ -7,11 +7,14 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee            = []byte("PoolCreationFee")
+	KeyPoolCreationFeeRefundRatio = []byte("PoolCreationFeeRefundRatio")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +22,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, poolCreationFeeRefundRatio osmomath.Dec) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:            poolCreationFee,
+		PoolCreationFeeRefundRatio: poolCreationFeeRefundRatio,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:            sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFeeRefundRatio: osmomath.ZeroDec(),
 	}
 }
 
@@ -37,6 +42,9 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validatePoolCreationFeeRefundRatio(p.PoolCreationFeeRefundRatio); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +53,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyPoolCreationFeeRefundRatio, &p.PoolCreationFeeRefundRatio, validatePoolCreationFeeRefundRatio),
 	}
 }
 
@@ -60,3 +69,16 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validatePoolCreationFeeRefundRatio(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(osmomath.OneDec()) {
+		return fmt.Errorf("pool creation fee refund ratio must be between 0 and 1, got %s", v)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -842,6 +843,97 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgUpdateParams
+type MsgUpdateParams struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// params are the new gamm module parameters, all of them must be set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
@@ -859,88 +951,98 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "osmosis.gamm.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "osmosis.gamm.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x35, 0x25, 0xc5, 0x76, 0xd6, 0xf5, 0x17, 0xe3, 0x0f, 0x99, 0x4e, 0x24, 0x7b, 0x5b, 0x24,
-	0x4e, 0x1c, 0x91, 0xb1, 0x03, 0x34, 0x81, 0x5b, 0xa0, 0xa8, 0xda, 0x1c, 0x14, 0x54, 0x50, 0xc0,
-	0x5c, 0x82, 0x5e, 0x04, 0xca, 0x22, 0x64, 0x26, 0xe6, 0xae, 0xa0, 0x5d, 0x3a, 0xca, 0xa9, 0x45,
-	0xd0, 0xb4, 0x40, 0x4f, 0xfd, 0x29, 0x45, 0x7b, 0xea, 0x3f, 0x70, 0x7b, 0xca, 0xb1, 0xe8, 0x41,
-	0x6d, 0xed, 0x43, 0xef, 0x02, 0x0a, 0xf4, 0x58, 0x2c, 0xb9, 0xa4, 0x48, 0x8a, 0x34, 0x25, 0x45,
-	0xf2, 0xc5, 0xb0, 0xb8, 0x33, 0xb3, 0x33, 0xf3, 0xde, 0xbc, 0x5d, 0x12, 0xdc, 0xc0, 0xc4, 0xc4,
-	0xc4, 0x20, 0x4a, 0x43, 0x33, 0x4d, 0xe5, 0x64, 0xaf, 0xa6, 0x53, 0x6d, 0x4f, 0xa1, 0x6d, 0xb9,
-	0xd9, 0xc2, 0x14, 0x8b, 0x2b, 0x7c, 0x59, 0x66, 0xcb, 0x32, 0x5f, 0x96, 0x56, 0x1a, 0xb8, 0x81,
-	0x6d, 0x03, 0x85, 0xfd, 0xe7, 0xd8, 0x4a, 0xcb, 0x9a, 0x69, 0x20, 0xac, 0xd8, 0x7f, 0xf9, 0xa3,
-	0xdc, 0xa1, 0xed, 0xaf, 0xd4, 0x34, 0xa2, 0x7b, 0xc1, 0x0f, 0xb1, 0x81, 0xf8, 0xfa, 0x5d, 0x77,
-	0xf7, 0x26, 0xc6, 0xc7, 0xa6, 0x86, 0xb4, 0x86, 0xde, 0xf2, 0xec, 0xc8, 0x4b, 0xad, 0x59, 0x6d,
-	0x61, 0x8b, 0xea, 0x8e, 0x35, 0xfc, 0x35, 0x05, 0xe6, 0xca, 0xa4, 0xf1, 0x18, 0x1b, 0xe8, 0x09,
-	0xc6, 0xc7, 0xe2, 0x6d, 0x30, 0x4d, 0x74, 0x54, 0xd7, 0x5b, 0x59, 0x61, 0x4b, 0xd8, 0xb9, 0x5a,
-	0x5c, 0xee, 0x76, 0xf2, 0xf3, 0xaf, 0x34, 0xf3, 0xf8, 0x00, 0x3a, 0xcf, 0xa1, 0xca, 0x0d, 0xc4,
-	0x5d, 0x30, 0xc3, 0xb6, 0xa8, 0x1a, 0xf5, 0x6c, 0x6a, 0x4b, 0xd8, 0xc9, 0x14, 0xc5, 0x6e, 0x27,
-	0xbf, 0xe0, 0xd8, 0xf2, 0x05, 0xa8, 0x4e, 0xb3, 0xff, 0x4a, 0x75, 0x51, 0x03, 0x4b, 0xe4, 0x48,
-	0x6b, 0xe9, 0x55, 0x6c, 0xd1, 0xaa, 0x66, 0x62, 0x0b, 0xd1, 0x6c, 0xda, 0xde, 0xe1, 0xc1, 0x69,
-	0x27, 0x3f, 0xf5, 0x47, 0x27, 0xbf, 0xea, 0xd4, 0x45, 0xea, 0x2f, 0x64, 0x03, 0x2b, 0xa6, 0x46,
-	0x8f, 0xe4, 0x12, 0xa2, 0xdd, 0x4e, 0x7e, 0xcd, 0x17, 0xd2, 0xf1, 0x64, 0x41, 0xa0, 0xba, 0x60,
-	0x07, 0xac, 0x58, 0xf4, 0x53, 0xfb, 0xa1, 0x58, 0x03, 0xf3, 0x14, 0xbf, 0xd0, 0x51, 0xd5, 0x40,
-	0x55, 0x53, 0x6b, 0x93, 0x6c, 0x66, 0x2b, 0xbd, 0x33, 0xb7, 0xbf, 0x21, 0x3b, 0x81, 0x65, 0xd6,
-	0x30, 0xb7, 0xdd, 0xf2, 0x67, 0xd8, 0x40, 0xc5, 0xf7, 0xd9, 0xd6, 0xdd, 0x4e, 0x7e, 0xd3, 0xd9,
-	0xc1, 0xef, 0xcd, 0x77, 0x22, 0x50, 0x9d, 0xb3, 0x1f, 0x97, 0x50, 0x59, 0x6b, 0x93, 0x83, 0xcd,
-	0xef, 0xff, 0xf9, 0xf1, 0xce, 0x5a, 0x00, 0xdf, 0xe7, 0xd8, 0x40, 0x05, 0x96, 0x1c, 0x3c, 0x15,
-	0xc0, 0x35, 0x5f, 0x2f, 0x55, 0x9d, 0x34, 0x31, 0x22, 0xba, 0x58, 0x8b, 0xa8, 0xdd, 0xe9, 0xee,
-	0xc3, 0xa4, 0xda, 0xd7, 0x79, 0xeb, 0x43, 0xee, 0xfd, 0xc5, 0x97, 0xc1, 0xac, 0x9b, 0x7e, 0x36,
-	0x95, 0x54, 0xf7, 0x3a, 0xaf, 0x7b, 0x31, 0x58, 0x37, 0x54, 0x67, 0x78, 0xad, 0xf0, 0x37, 0x87,
-	0x16, 0x8f, 0xda, 0x06, 0x9d, 0x28, 0x2d, 0xaa, 0x60, 0xd1, 0xa9, 0xcd, 0x40, 0xa3, 0xb1, 0x22,
-	0xe4, 0x0d, 0xd5, 0x79, 0xfb, 0x49, 0x09, 0xf1, 0xbe, 0xe8, 0x60, 0xc1, 0x29, 0x8f, 0x35, 0xcf,
-	0x34, 0xd0, 0x00, 0xac, 0xf8, 0x80, 0x77, 0xe7, 0xba, 0xbf, 0x3b, 0xdc, 0xbd, 0x47, 0x8b, 0xf7,
-	0xec, 0xe7, 0x15, 0x8b, 0x96, 0x0d, 0x14, 0xc9, 0x0b, 0xbd, 0x6d, 0x50, 0x87, 0x17, 0x0d, 0x9b,
-	0x16, 0x6e, 0x2f, 0x3d, 0x5a, 0x3c, 0x01, 0x57, 0xbd, 0xd8, 0x59, 0x21, 0x29, 0xab, 0x2c, 0xcf,
-	0x6a, 0x29, 0x94, 0x15, 0x54, 0x67, 0xdd, 0x4c, 0xe0, 0xbf, 0x29, 0xb0, 0x52, 0x26, 0x8d, 0xa7,
-	0x2f, 0xb5, 0xe6, 0xa3, 0xb6, 0x76, 0xc8, 0xb9, 0x51, 0x42, 0xc3, 0xc0, 0xf7, 0x05, 0x98, 0xb6,
-	0xf5, 0x81, 0x70, 0x1a, 0xc9, 0xb2, 0x2b, 0x57, 0x3e, 0x3d, 0xf1, 0x52, 0x63, 0x5b, 0xb9, 0xbb,
-	0xa8, 0xcc, 0xad, 0x98, 0x61, 0x79, 0xaa, 0x3c, 0x46, 0x80, 0x96, 0x0c, 0xd8, 0x77, 0xa3, 0xa5,
-	0x68, 0x82, 0x95, 0x28, 0x38, 0xb2, 0x19, 0xbb, 0xaa, 0x8f, 0x93, 0x38, 0xb3, 0x19, 0x8f, 0x28,
-	0x54, 0x97, 0x7d, 0x80, 0x3a, 0x25, 0x1d, 0xdc, 0x64, 0xa8, 0x6e, 0x07, 0x50, 0x65, 0x02, 0x5a,
-	0xd0, 0x59, 0x73, 0x0b, 0x8e, 0x63, 0xc1, 0x40, 0xf0, 0xb5, 0x00, 0xae, 0x47, 0xf5, 0xdd, 0xaf,
-	0x00, 0xbd, 0x4d, 0x47, 0x52, 0x80, 0xb0, 0x3b, 0x54, 0x17, 0xdc, 0x7c, 0x9d, 0xdd, 0xe0, 0x7f,
-	0x29, 0xb0, 0xda, 0x9f, 0x44, 0xc5, 0xa2, 0xc3, 0xa0, 0x5f, 0x0e, 0xa1, 0xaf, 0x0c, 0x88, 0x7e,
-	0xc5, 0xa2, 0x51, 0xf0, 0x3f, 0x07, 0xd7, 0x22, 0x44, 0x95, 0x8f, 0xf8, 0x47, 0x49, 0xa5, 0x4b,
-	0xb1, 0xb2, 0x0c, 0xd5, 0xa5, 0x9e, 0x2a, 0xf3, 0x49, 0x0f, 0x8c, 0x53, 0x26, 0x89, 0x6b, 0x03,
-	0x8c, 0xd3, 0xc1, 0x2d, 0x06, 0x3f, 0x4c, 0x80, 0x9f, 0xf9, 0x7c, 0x2d, 0x80, 0x1b, 0x91, 0xad,
-	0xf7, 0x08, 0x50, 0x05, 0x8b, 0x5e, 0x19, 0x01, 0xfc, 0x07, 0xd5, 0xb9, 0x90, 0x37, 0x54, 0xe7,
-	0x79, 0x03, 0x38, 0xfa, 0x7f, 0xa6, 0xc0, 0x06, 0x3f, 0x7b, 0x9c, 0x34, 0xa8, 0xde, 0x42, 0xa3,
-	0xcc, 0xff, 0x50, 0xf2, 0x3d, 0xfe, 0xf1, 0xee, 0x9d, 0x74, 0x23, 0x8f, 0x77, 0x54, 0x08, 0xa8,
-	0x2e, 0xbb, 0x07, 0x66, 0x6f, 0xbc, 0xef, 0x32, 0x7c, 0x6f, 0xf5, 0x1f, 0xe6, 0x1c, 0x64, 0xd6,
-	0x41, 0xdf, 0x90, 0x7f, 0x27, 0x80, 0xed, 0xd8, 0x0e, 0x5f, 0xe6, 0x59, 0x0f, 0x7f, 0x4a, 0x07,
-	0xb0, 0x7e, 0xca, 0x56, 0x47, 0x9a, 0xf6, 0xa1, 0xb0, 0xfe, 0xc4, 0x3d, 0x49, 0x0d, 0x54, 0xad,
-	0xeb, 0x08, 0x9b, 0x7c, 0x8c, 0x37, 0xba, 0x9d, 0xfc, 0x6a, 0x88, 0xa4, 0xf6, 0xba, 0x7b, 0x46,
-	0x96, 0xd0, 0xe7, 0xec, 0x67, 0x64, 0x6b, 0x32, 0x63, 0xbe, 0x06, 0xc5, 0x08, 0xce, 0x95, 0x09,
-	0x08, 0xce, 0xc5, 0xf4, 0xb1, 0xf3, 0xf2, 0x6b, 0xc4, 0x37, 0x41, 0xfa, 0x04, 0x41, 0xbb, 0x3c,
-	0x9d, 0xf8, 0x39, 0x0d, 0xb2, 0xfc, 0x32, 0x12, 0x4a, 0x63, 0x82, 0x32, 0x51, 0x74, 0xab, 0x62,
-	0xd0, 0xf9, 0xb9, 0x23, 0x85, 0x13, 0xf7, 0x0c, 0xdc, 0xc4, 0x2b, 0x16, 0x75, 0xd8, 0x13, 0x71,
-	0x53, 0xcc, 0x8c, 0xf5, 0xa6, 0x18, 0x77, 0xb7, 0xb8, 0x32, 0x99, 0xbb, 0xc5, 0x2e, 0x63, 0xcf,
-	0xcd, 0xfe, 0x1b, 0x63, 0x3f, 0x7b, 0x0c, 0x04, 0xbf, 0x15, 0xc0, 0x56, 0x1c, 0x6a, 0x97, 0x7a,
-	0xc9, 0xf8, 0x3b, 0x05, 0x24, 0x5f, 0x22, 0x7e, 0x11, 0x9c, 0xa4, 0xf6, 0x04, 0xce, 0xf6, 0xf4,
-	0x18, 0xce, 0x76, 0x26, 0x14, 0x1e, 0x21, 0x7c, 0x42, 0x91, 0x19, 0x4a, 0x28, 0x22, 0x22, 0x40,
-	0x75, 0x89, 0xd3, 0xaa, 0x27, 0x14, 0x05, 0x06, 0xf5, 0x4e, 0x0c, 0xd4, 0xc1, 0x73, 0x86, 0x65,
-	0xf9, 0x46, 0x00, 0x30, 0xbe, 0xc7, 0x7e, 0xa9, 0x08, 0x0f, 0x84, 0x30, 0xce, 0x81, 0xd8, 0xff,
-	0x65, 0x06, 0xa4, 0xcb, 0xa4, 0x21, 0x3e, 0x03, 0xb3, 0xde, 0xe7, 0x81, 0x6d, 0x39, 0xea, 0xe3,
-	0x85, 0xec, 0x7b, 0xeb, 0x95, 0x6e, 0x27, 0x9a, 0x78, 0x25, 0x3c, 0x03, 0xb3, 0xde, 0x1b, 0x66,
-	0x7c, 0x64, 0xd7, 0xe4, 0x82, 0xc8, 0x7d, 0xef, 0x56, 0x04, 0x2c, 0xf7, 0xbf, 0x05, 0xdd, 0x89,
-	0xf5, 0xef, 0xb3, 0x95, 0xf6, 0x07, 0xb7, 0xf5, 0x36, 0x3d, 0x01, 0x62, 0xc4, 0xed, 0x7b, 0x77,
-	0xd0, 0x48, 0x15, 0x8b, 0x4a, 0xf7, 0x87, 0x30, 0xf6, 0xf6, 0x7d, 0x2d, 0x80, 0xb5, 0x98, 0x8b,
-	0x9f, 0x72, 0x21, 0x18, 0xfd, 0x0e, 0xd2, 0x83, 0x21, 0x1d, 0x22, 0x93, 0x08, 0xdd, 0x48, 0x92,
-	0x93, 0x08, 0x3a, 0x0c, 0x90, 0x44, 0xcc, 0xf1, 0xf9, 0x46, 0x00, 0xeb, 0x71, 0xda, 0x74, 0xef,
-	0x42, 0xf6, 0x44, 0x78, 0x48, 0x0f, 0x87, 0xf5, 0xf0, 0xf2, 0xf8, 0x0a, 0xac, 0x46, 0x9f, 0xb0,
-	0x72, 0x62, 0xc8, 0x80, 0xbd, 0xf4, 0xe1, 0x70, 0xf6, 0x6e, 0x02, 0xc5, 0xc7, 0xa7, 0x67, 0x39,
-	0xe1, 0xed, 0x59, 0x4e, 0xf8, 0xeb, 0x2c, 0x27, 0xfc, 0x70, 0x9e, 0x9b, 0x7a, 0x7b, 0x9e, 0x9b,
-	0xfa, 0xfd, 0x3c, 0x37, 0xf5, 0xe5, 0xbd, 0x86, 0x41, 0x8f, 0xac, 0x9a, 0x7c, 0x88, 0x4d, 0x85,
-	0xc7, 0x2e, 0x1c, 0x6b, 0x35, 0xe2, 0xfe, 0x50, 0x4e, 0xf6, 0xf7, 0x94, 0xb6, 0xa3, 0x52, 0xf4,
-	0x55, 0x53, 0x27, 0xb5, 0x69, 0xfb, 0x4b, 0xe1, 0xfd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe0,
-	0xf8, 0x56, 0x50, 0xd7, 0x14, 0x00, 0x00,
+	// 1338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6f, 0xdb, 0x44,
+	0x1c, 0xaf, 0x93, 0xac, 0xeb, 0xae, 0xeb, 0x2f, 0xaf, 0x5d, 0x53, 0x6f, 0x4b, 0xda, 0x03, 0xb6,
+	0x76, 0x6d, 0xec, 0xb5, 0x93, 0xe8, 0x54, 0x90, 0xd0, 0x02, 0x7b, 0x48, 0x21, 0x4a, 0xe5, 0x0a,
+	0x69, 0xe2, 0x25, 0x72, 0x1a, 0xcb, 0xf5, 0x56, 0xdf, 0x45, 0xb9, 0x73, 0x97, 0x3e, 0x81, 0x26,
+	0x06, 0x12, 0x4f, 0xfc, 0x07, 0xfc, 0x0b, 0x08, 0xf8, 0x23, 0x0a, 0xbc, 0x4c, 0x3c, 0x21, 0x1e,
+	0x02, 0xb4, 0x0f, 0xbc, 0x57, 0x42, 0xe2, 0x11, 0x9d, 0x7d, 0x76, 0x6c, 0xc7, 0x6e, 0x92, 0xae,
+	0xed, 0x4b, 0x94, 0xf8, 0xbe, 0xbf, 0x3f, 0x9f, 0xfb, 0xdc, 0x39, 0xe0, 0x0e, 0x26, 0x16, 0x26,
+	0x26, 0x51, 0x0c, 0xcd, 0xb2, 0x94, 0xfd, 0xd5, 0x9a, 0x4e, 0xb5, 0x55, 0x85, 0xb6, 0xe4, 0x46,
+	0x13, 0x53, 0x2c, 0x4e, 0xf3, 0x65, 0x99, 0x2d, 0xcb, 0x7c, 0x59, 0x9a, 0x36, 0xb0, 0x81, 0x1d,
+	0x03, 0x85, 0x7d, 0x73, 0x6d, 0xa5, 0x29, 0xcd, 0x32, 0x11, 0x56, 0x9c, 0x4f, 0xfe, 0x28, 0xb7,
+	0xe3, 0xf8, 0x2b, 0x35, 0x8d, 0xe8, 0x7e, 0xf0, 0x1d, 0x6c, 0x22, 0xbe, 0xbe, 0xe2, 0x65, 0x6f,
+	0x60, 0xbc, 0x67, 0x69, 0x48, 0x33, 0xf4, 0xa6, 0x6f, 0x47, 0x5e, 0x68, 0x8d, 0x6a, 0x13, 0xdb,
+	0x54, 0xe7, 0xd6, 0x73, 0x6e, 0xb4, 0xaa, 0x9b, 0xd9, 0xfd, 0xc1, 0x97, 0x60, 0x6c, 0x1b, 0x86,
+	0x8e, 0x74, 0x62, 0x72, 0x1b, 0xf8, 0x73, 0x0a, 0x8c, 0x96, 0x89, 0xb1, 0x89, 0x4d, 0xb4, 0x85,
+	0xf1, 0x9e, 0xb8, 0x04, 0x86, 0x89, 0x8e, 0xea, 0x7a, 0x33, 0x2b, 0xcc, 0x0b, 0x8b, 0xd7, 0x8a,
+	0x53, 0x27, 0xed, 0xfc, 0xd8, 0x81, 0x66, 0xed, 0x6d, 0x40, 0xf7, 0x39, 0x54, 0xb9, 0x81, 0xb8,
+	0x0c, 0xae, 0xb2, 0x0a, 0xab, 0x66, 0x3d, 0x9b, 0x9a, 0x17, 0x16, 0x33, 0x45, 0xf1, 0xa4, 0x9d,
+	0x1f, 0x77, 0x6d, 0xf9, 0x02, 0x54, 0x87, 0xd9, 0xb7, 0x52, 0x5d, 0xd4, 0xc0, 0x24, 0xd9, 0xd5,
+	0x9a, 0x7a, 0x15, 0xdb, 0xb4, 0xaa, 0x59, 0xd8, 0x46, 0x34, 0x9b, 0x76, 0x32, 0xac, 0x1f, 0xb6,
+	0xf3, 0x43, 0x7f, 0xb4, 0xf3, 0x33, 0x6e, 0xed, 0xa4, 0xfe, 0x5c, 0x36, 0xb1, 0x62, 0x69, 0x74,
+	0x57, 0x2e, 0x21, 0x7a, 0xd2, 0xce, 0xdf, 0x0c, 0x84, 0x74, 0x3d, 0x59, 0x10, 0xa8, 0x8e, 0x3b,
+	0x01, 0x2b, 0x36, 0x7d, 0xec, 0x3c, 0x14, 0x6b, 0x60, 0x8c, 0xe2, 0xe7, 0x3a, 0xaa, 0x9a, 0xa8,
+	0x6a, 0x69, 0x2d, 0x92, 0xcd, 0xcc, 0xa7, 0x17, 0x47, 0xd7, 0xe6, 0x64, 0x3e, 0x14, 0x36, 0x6f,
+	0x0f, 0x2d, 0xf9, 0x43, 0x6c, 0xa2, 0xe2, 0x5b, 0x2c, 0xf5, 0x49, 0x3b, 0x7f, 0xcb, 0xcd, 0x10,
+	0xf4, 0xe6, 0x99, 0x08, 0x54, 0x47, 0x9d, 0xc7, 0x25, 0x54, 0xd6, 0x5a, 0x64, 0xe3, 0xd6, 0x37,
+	0xff, 0x7c, 0x7f, 0xff, 0x66, 0x68, 0xae, 0xcf, 0xb0, 0x89, 0x0a, 0xac, 0x38, 0x78, 0x28, 0x80,
+	0x1b, 0x81, 0x59, 0xaa, 0x3a, 0x69, 0x60, 0x44, 0x74, 0xb1, 0x16, 0xd3, 0xbb, 0x3b, 0xdd, 0x47,
+	0xbd, 0x7a, 0x9f, 0xe5, 0xa3, 0x8f, 0xb8, 0x77, 0x37, 0x5f, 0x06, 0x23, 0x5e, 0xf9, 0xd9, 0x54,
+	0xaf, 0xbe, 0x67, 0x79, 0xdf, 0x13, 0xe1, 0xbe, 0xa1, 0x7a, 0x95, 0xf7, 0x0a, 0x7f, 0x71, 0x69,
+	0xf1, 0xa4, 0x65, 0xd2, 0x0b, 0xa5, 0x45, 0x15, 0x4c, 0xb8, 0xbd, 0x99, 0xe8, 0x6c, 0xac, 0x88,
+	0x78, 0x43, 0x75, 0xcc, 0x79, 0x52, 0x42, 0x7c, 0x2e, 0x3a, 0x18, 0x77, 0xdb, 0x63, 0xc3, 0xb3,
+	0x4c, 0xd4, 0x07, 0x2b, 0xde, 0xe6, 0xd3, 0xb9, 0x1d, 0x9c, 0x0e, 0x77, 0xef, 0xd0, 0xe2, 0xba,
+	0xf3, 0xbc, 0x62, 0xd3, 0xb2, 0x89, 0x62, 0x79, 0xa1, 0xb7, 0x4c, 0xea, 0xf2, 0xc2, 0x00, 0x37,
+	0x02, 0xb3, 0xf4, 0x69, 0xb1, 0x05, 0xae, 0xf9, 0xb1, 0xb3, 0x42, 0xaf, 0xaa, 0xb2, 0xbc, 0xaa,
+	0xc9, 0x48, 0x55, 0x50, 0x1d, 0xf1, 0x2a, 0x81, 0xff, 0xa6, 0xc0, 0x74, 0x99, 0x18, 0xdb, 0x2f,
+	0xb4, 0xc6, 0x93, 0x96, 0xb6, 0xc3, 0xb9, 0x51, 0x42, 0x83, 0xc0, 0xf7, 0x09, 0x18, 0x76, 0xe4,
+	0x85, 0x70, 0x1a, 0xc9, 0xb2, 0xa7, 0x76, 0x01, 0x39, 0xf2, 0x4b, 0x63, 0xa9, 0xbc, 0x2c, 0x2a,
+	0x73, 0x2b, 0x66, 0x58, 0x9d, 0x2a, 0x8f, 0x11, 0xa2, 0x25, 0x03, 0xf6, 0xcd, 0x68, 0x29, 0x5a,
+	0x60, 0x3a, 0x0e, 0x8e, 0x6c, 0xc6, 0xe9, 0xea, 0xfd, 0x5e, 0x9c, 0xb9, 0x95, 0x8c, 0x28, 0x54,
+	0xa7, 0x02, 0x80, 0xba, 0x2d, 0x6d, 0xdc, 0x65, 0xa8, 0x2e, 0x84, 0x50, 0x65, 0xfa, 0x5b, 0xd0,
+	0xd9, 0x70, 0x0b, 0xae, 0x63, 0xc1, 0x44, 0xf0, 0xa5, 0x00, 0x6e, 0xc7, 0xcd, 0x3d, 0xa8, 0x00,
+	0x9d, 0xa4, 0x67, 0x52, 0x80, 0xa8, 0x3b, 0x54, 0xc7, 0xbd, 0x7a, 0xdd, 0x6c, 0xf0, 0xbf, 0x14,
+	0x98, 0xe9, 0x2e, 0xa2, 0x62, 0xd3, 0x41, 0xd0, 0x2f, 0x47, 0xd0, 0x57, 0xfa, 0x44, 0xbf, 0x62,
+	0xd3, 0x38, 0xf8, 0x9f, 0x81, 0x1b, 0x31, 0xa2, 0xca, 0xb7, 0xf8, 0x7b, 0xbd, 0x5a, 0x97, 0x12,
+	0x65, 0x19, 0xaa, 0x93, 0x1d, 0x55, 0xe6, 0x3b, 0x3d, 0xb4, 0x9d, 0x32, 0xf3, 0xc2, 0x1b, 0x6f,
+	0xa7, 0x8d, 0x7b, 0x0c, 0x7e, 0xd8, 0x03, 0x7e, 0xe6, 0xf3, 0x85, 0x00, 0xee, 0xc4, 0x8e, 0xde,
+	0x27, 0x40, 0x15, 0x4c, 0xf8, 0x6d, 0x84, 0xf0, 0xef, 0x57, 0xe7, 0x22, 0xde, 0x50, 0x1d, 0xe3,
+	0x03, 0xe0, 0xe8, 0xff, 0x99, 0x02, 0x73, 0xfc, 0xec, 0x71, 0xcb, 0xa0, 0x7a, 0x13, 0x9d, 0x65,
+	0xff, 0x0f, 0x24, 0xdf, 0xe7, 0xbf, 0xbd, 0x3b, 0x27, 0xdd, 0x99, 0xb7, 0x77, 0x5c, 0x08, 0xa8,
+	0x4e, 0x79, 0x07, 0x66, 0x67, 0x7b, 0xaf, 0x30, 0x7c, 0xef, 0x75, 0x1f, 0xe6, 0x1c, 0x64, 0x36,
+	0xc1, 0xc0, 0x26, 0xff, 0x5a, 0x00, 0x0b, 0x89, 0x13, 0xbe, 0xcc, 0xb3, 0x1e, 0xfe, 0x90, 0x0e,
+	0x61, 0xbd, 0xcd, 0x56, 0xcf, 0xb4, 0xdb, 0x07, 0xc2, 0xfa, 0x03, 0xef, 0x24, 0x35, 0x51, 0xb5,
+	0xae, 0x23, 0x6c, 0xf1, 0x6d, 0x3c, 0x77, 0xd2, 0xce, 0xcf, 0x44, 0x48, 0xea, 0xac, 0x7b, 0x67,
+	0x64, 0x09, 0x7d, 0xc4, 0x7e, 0xc6, 0x8e, 0x26, 0x73, 0xce, 0xd7, 0xa0, 0x04, 0xc1, 0xb9, 0x72,
+	0x01, 0x82, 0x73, 0x3a, 0x7d, 0x9c, 0xba, 0x82, 0x1a, 0xf1, 0x65, 0x98, 0x3e, 0x61, 0xd0, 0x2e,
+	0x4f, 0x27, 0x7e, 0x4c, 0x83, 0x2c, 0xbf, 0x8c, 0x44, 0xca, 0xb8, 0x40, 0x99, 0x28, 0x7a, 0x5d,
+	0x31, 0xe8, 0x82, 0xdc, 0x91, 0xa2, 0x85, 0xfb, 0x06, 0x5e, 0xe1, 0x15, 0x9b, 0xba, 0xec, 0x89,
+	0xb9, 0x29, 0x66, 0xce, 0xf5, 0xa6, 0x98, 0x74, 0xb7, 0xb8, 0x72, 0x31, 0x77, 0x8b, 0x65, 0xc6,
+	0x9e, 0xbb, 0xdd, 0x37, 0xc6, 0x6e, 0xf6, 0x98, 0x08, 0x7e, 0x25, 0x80, 0xf9, 0x24, 0xd4, 0x2e,
+	0xf5, 0x92, 0xf1, 0x77, 0x0a, 0x48, 0x81, 0x42, 0x82, 0x22, 0x78, 0x91, 0xda, 0x13, 0x3a, 0xdb,
+	0xd3, 0xe7, 0x70, 0xb6, 0x33, 0xa1, 0xf0, 0x09, 0x11, 0x10, 0x8a, 0xcc, 0x40, 0x42, 0x11, 0x13,
+	0x01, 0xaa, 0x93, 0x9c, 0x56, 0x1d, 0xa1, 0x28, 0x30, 0xa8, 0x17, 0x13, 0xa0, 0x0e, 0x9f, 0x33,
+	0xac, 0xca, 0x57, 0x02, 0x80, 0xc9, 0x33, 0x0e, 0x4a, 0x45, 0x74, 0x43, 0x08, 0xe7, 0xb9, 0x21,
+	0xe0, 0xaf, 0x02, 0x98, 0x28, 0x13, 0xe3, 0xd3, 0x46, 0x5d, 0xa3, 0xfa, 0x96, 0xd6, 0xd4, 0x2c,
+	0x22, 0x6e, 0x82, 0x6b, 0x9a, 0x4d, 0x77, 0x71, 0xd3, 0xa4, 0x07, 0x3c, 0xdd, 0x4a, 0x67, 0xd2,
+	0xfe, 0x12, 0xfc, 0xed, 0xa7, 0xc2, 0x34, 0xc7, 0xe7, 0x71, 0xbd, 0xde, 0xd4, 0x09, 0xd9, 0xa6,
+	0x4d, 0x13, 0x19, 0x6a, 0xc7, 0x5d, 0xfc, 0x18, 0x0c, 0x37, 0x9c, 0xa8, 0x0e, 0x01, 0x46, 0xd7,
+	0x6e, 0xcb, 0x71, 0xff, 0xab, 0xc8, 0x6e, 0xe6, 0xe2, 0x0c, 0x07, 0x95, 0xd3, 0xc9, 0xf5, 0x64,
+	0x0c, 0x71, 0xbe, 0x6c, 0xe4, 0xd9, 0x8c, 0xa5, 0xd0, 0x8c, 0x6d, 0xa7, 0xf0, 0x02, 0xb7, 0x9c,
+	0x03, 0xb3, 0x91, 0x66, 0xbc, 0x49, 0xae, 0x7d, 0x37, 0x02, 0xd2, 0x65, 0x62, 0x88, 0x4f, 0xc1,
+	0x88, 0xff, 0x3f, 0xc8, 0x42, 0x7c, 0x31, 0x81, 0xd7, 0x7b, 0x69, 0xa9, 0xa7, 0x89, 0x8f, 0xd5,
+	0x53, 0x30, 0xe2, 0xbf, 0x4a, 0x27, 0x47, 0xf6, 0x4c, 0xa4, 0xa5, 0x9e, 0x26, 0x7e, 0x64, 0x02,
+	0xa6, 0xba, 0x5f, 0xf7, 0xee, 0x27, 0xfa, 0x77, 0xd9, 0x4a, 0x6b, 0xfd, 0xdb, 0xfa, 0x49, 0xf7,
+	0x81, 0x18, 0xf3, 0x9a, 0xb1, 0xdc, 0x6f, 0xa4, 0x8a, 0x4d, 0xa5, 0x87, 0x03, 0x18, 0xfb, 0x79,
+	0x5f, 0x0a, 0xe0, 0x66, 0xc2, 0x0d, 0x57, 0x39, 0x15, 0x8c, 0x6e, 0x07, 0x69, 0x7d, 0x40, 0x87,
+	0xd8, 0x22, 0x22, 0x57, 0xaf, 0xde, 0x45, 0x84, 0x1d, 0xa4, 0xf5, 0x01, 0x1d, 0xfc, 0x22, 0x5e,
+	0x09, 0x60, 0x36, 0x49, 0x84, 0x1f, 0x9c, 0xca, 0x9e, 0x18, 0x0f, 0xe9, 0xd1, 0xa0, 0x1e, 0x7e,
+	0x1d, 0x9f, 0x83, 0x99, 0xf8, 0xab, 0x84, 0xdc, 0x33, 0x64, 0xc8, 0x5e, 0x7a, 0x77, 0x30, 0x7b,
+	0xbf, 0x80, 0x3a, 0xb8, 0x1e, 0x12, 0xa8, 0x77, 0x12, 0xe3, 0x04, 0xcd, 0xa4, 0x42, 0x5f, 0x66,
+	0x5e, 0x96, 0xe2, 0xe6, 0xe1, 0x51, 0x4e, 0x78, 0x7d, 0x94, 0x13, 0xfe, 0x3a, 0xca, 0x09, 0xdf,
+	0x1e, 0xe7, 0x86, 0x5e, 0x1f, 0xe7, 0x86, 0x7e, 0x3f, 0xce, 0x0d, 0x7d, 0xf6, 0xc0, 0x30, 0xe9,
+	0xae, 0x5d, 0x93, 0x77, 0xb0, 0xa5, 0xf0, 0x90, 0x85, 0x3d, 0xad, 0x46, 0xbc, 0x1f, 0xca, 0xfe,
+	0xda, 0xaa, 0xd2, 0x72, 0x05, 0x89, 0x1e, 0x34, 0x74, 0x52, 0x1b, 0x76, 0xfe, 0x78, 0x7d, 0xf8,
+	0xff, 0x00, 0x88, 0x15, 0x05, 0xb2, 0x65, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -963,6 +1065,9 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	// UpdateParams sets the gamm module parameters. It can only be executed by
+	// governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -1045,6 +1150,15 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1055,6 +1169,9 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	// UpdateParams sets the gamm module parameters. It can only be executed by
+	// governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1085,6 +1202,9 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1234,6 +1354,24 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1270,6 +1408,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -2037,6 +2179,69 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2328,6 +2533,30 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4482,6 +4711,171 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,7 @@ const (
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
 	FlagEndEpoch  = "end-epoch"
+	FlagWeights   = "weights"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
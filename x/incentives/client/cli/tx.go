@@ -2,6 +2,7 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
@@ -39,7 +41,7 @@ func GetTxCmd() *cobra.Command {
 func NewCreateGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-gauge [lockup_denom] [reward] [poolId] [flags]",
-		Short: "create a gauge to distribute rewards to users. For duration lock gauges set poolId = 0 and for all CL (no-lock) gauges set it to a CL poolId.",
+		Short: "create a gauge to distribute rewards to users. For duration lock gauges set poolId = 0 and for all CL (no-lock) gauges set it to a CL poolId. For CL gauges, --duration sets the minimum uptime of the incentives, which must be an authorized uptime.",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -113,6 +115,10 @@ func NewCreateGaugeCmd() *cobra.Command {
 				distributeTo = lockuptypes.QueryCondition{
 					LockQueryType: lockuptypes.NoLock,
 				}
+				// for no-lock gauges, the duration is the minimum uptime of the CL incentive records
+				if cmd.Flags().Changed(FlagDuration) {
+					distributeTo.Duration = duration
+				}
 			}
 
 			msg := types.NewMsgCreateGauge(
@@ -164,6 +170,10 @@ Group 1: Pool IDs 1, 2
 Group 2: Pool IDs 3, 4, 5
 Group 3: Pool IDs 6, 7
 
+Optionally, the --weights flag sets governance weights for the pools of every group, in the same format.
+Groups with weights split incentives by these weights instead of by volume.
+Ex) create-groups-proposal '1,2;3,4,5' --weights '1,3;2,1,1'
+
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
@@ -195,6 +205,7 @@ Group 3: Pool IDs 6, 7
 		},
 	}
 	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagWeights, "", "Governance weights of the pools of every group, in the same format as the pool IDs")
 
 	return cmd
 }
@@ -215,6 +226,17 @@ func parseCreateGroupArgToContent(cmd *cobra.Command, arg string) (govtypesv1bet
 		return nil, err
 	}
 
+	weightsArg, err := cmd.Flags().GetString(FlagWeights)
+	if err != nil {
+		return nil, err
+	}
+	if weightsArg != "" {
+		createGroupRecords, err = ParseCreateGroupWeights(createGroupRecords, weightsArg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	content := &types.CreateGroupsProposal{
 		Title:        title,
 		Description:  description,
@@ -240,3 +262,28 @@ func ParseCreateGroupRecords(arg string) ([]types.CreateGroup, error) {
 
 	return createGroupRecords, nil
 }
+
+// ParseCreateGroupWeights sets the weights parsed from the given argument on the given group records.
+// The argument must have one set of weights per group, and one weight per pool ID of the group.
+func ParseCreateGroupWeights(createGroupRecords []types.CreateGroup, arg string) ([]types.CreateGroup, error) {
+	weights2DArray, err := osmocli.ParseStringTo2DArray(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(weights2DArray) != len(createGroupRecords) {
+		return nil, fmt.Errorf("got weights for %d groups, expected %d", len(weights2DArray), len(createGroupRecords))
+	}
+
+	for i, weights := range weights2DArray {
+		if len(weights) != len(createGroupRecords[i].PoolIds) {
+			return nil, fmt.Errorf("got %d weights for group %d, expected %d", len(weights), i+1, len(createGroupRecords[i].PoolIds))
+		}
+		createGroupRecords[i].Weights = make([]osmomath.Int, 0, len(weights))
+		for _, weight := range weights {
+			createGroupRecords[i].Weights = append(createGroupRecords[i].Weights, osmomath.NewIntFromUint64(weight))
+		}
+	}
+
+	return createGroupRecords, nil
+}
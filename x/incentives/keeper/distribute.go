@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	db "github.com/cometbft/cometbft-db"
@@ -199,6 +200,8 @@ func (k Keeper) moveUpcomingGaugeToActiveGauge(ctx sdk.Context, gauge types.Gaug
 	if err := k.addGaugeRefByKey(ctx, combineKeys(types.KeyPrefixActiveGauges, timeKey), gauge.Id); err != nil {
 		return err
 	}
+	k.deleteGaugeDenomStartTimeRef(ctx, types.KeyPrefixUpcomingGaugesByDenom, &gauge)
+	k.addGaugeDenomStartTimeRef(ctx, types.KeyPrefixActiveGaugesByDenom, &gauge)
 	return nil
 }
 
@@ -214,6 +217,7 @@ func (k Keeper) moveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gaug
 	if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
 		return err
 	}
+	k.deleteGaugeDenomStartTimeRef(ctx, types.KeyPrefixActiveGaugesByDenom, &gauge)
 	k.hooks.AfterFinishDistribution(ctx, gauge.Id)
 	return nil
 }
@@ -421,6 +425,9 @@ func (k Keeper) syncGroupWeights(ctx sdk.Context, group types.Group) error {
 		if err != nil && !errors.As(err, &types.NoVolumeSinceLastSyncError{}) {
 			return err
 		}
+	} else if group.SplittingPolicy == types.ByGovernance {
+		// Weights are set by governance at group creation and are never synced.
+		return nil
 	} else {
 		return types.UnsupportedSplittingPolicyError{GroupGaugeId: group.GroupGaugeId, SplittingPolicy: group.SplittingPolicy}
 	}
@@ -571,12 +578,21 @@ func (k Keeper) distributeInternal(
 		return nil, fmt.Errorf("gauge with id of %d is not active", gauge.Id)
 	}
 
+	// Skip the coins whose distribution for this epoch is below their min value for distribution.
+	remainCoins, skippedCoins := k.filterCoinsBelowMinValueForDistribution(ctx, remainCoins, remainEpochs)
+
 	// This is a no lock distribution flow that assumes that we have a pool associated with the gauge.
 	// Currently, this flow is only used for CL pools. Fails if the pool is not found.
 	// Fails if the pool found is not a CL pool.
 	if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
 		ctx.Logger().Debug("distributeInternal NoLock gauge", "module", types.ModuleName, "gaugeId", gauge.Id, "height", ctx.BlockHeight())
-		pool, err := k.GetPoolFromGaugeId(ctx, gauge.Id, gauge.DistributeTo.Duration)
+		// External gauges are linked to their pool with a zero duration, since their duration is the minimum uptime
+		// of the incentive records they create rather than an incentivized duration.
+		linkedDuration := gauge.DistributeTo.Duration
+		if isExternalNoLockGauge(gauge) {
+			linkedDuration = 0
+		}
+		pool, err := k.GetPoolFromGaugeId(ctx, gauge.Id, linkedDuration)
 
 		if err != nil {
 			return nil, err
@@ -590,6 +606,9 @@ func (k Keeper) distributeInternal(
 		// Get distribution epoch duration. This is used to calculate the emission rate.
 		currentEpoch := k.GetEpochInfo(ctx)
 
+		// Get the minimum uptime of the incentive records created by this gauge.
+		minUptime := k.getNoLockGaugeUptime(ctx, gauge)
+
 		// For every coin in the gauge, calculate the remaining reward per epoch
 		// and create a concentrated liquidity incentive record for it that
 		// is supposed to distribute over that epoch.
@@ -613,8 +632,7 @@ func (k Keeper) distributeInternal(
 				// Gauge start time should be checked whenever moving between active
 				// and inactive gauges. By the time we get here, the gauge should be active.
 				ctx.BlockTime(),
-				// Only default uptime is supported at launch.
-				types.DefaultConcentratedUptime,
+				minUptime,
 			)
 
 			ctx.Logger().Info(fmt.Sprintf("distributeInternal CL for pool id %d finished", pool.GetId()))
@@ -633,7 +651,7 @@ func (k Keeper) distributeInternal(
 		// Namely: gauge empty OR gauge coins undistributable.
 		if remainCoins.Empty() {
 			ctx.Logger().Debug(fmt.Sprintf("gauge debug, this gauge is empty, why is it being ran %d. Balancer code", gauge.Id))
-			err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+			err := k.finishGaugeDistribution(ctx, gauge, totalDistrCoins, skippedCoins)
 			return totalDistrCoins, err
 		}
 
@@ -641,7 +659,7 @@ func (k Keeper) distributeInternal(
 		// If they're to pool 1 they can't distr at this small of a quantity.
 		if remainCoins.Len() == 1 && remainCoins[0].Amount.LTE(osmomath.NewInt(10)) && gauge.DistributeTo.Denom == "gamm/pool/1" && remainCoins[0].Denom != "uosmo" {
 			ctx.Logger().Debug(fmt.Sprintf("gauge debug, this gauge is perceived spam, skipping %d", gauge.Id))
-			err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+			err := k.finishGaugeDistribution(ctx, gauge, totalDistrCoins, skippedCoins)
 			return totalDistrCoins, err
 		}
 
@@ -685,10 +703,71 @@ func (k Keeper) distributeInternal(
 		}
 	}
 
-	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	err := k.finishGaugeDistribution(ctx, gauge, totalDistrCoins, skippedCoins)
 	return totalDistrCoins, err
 }
 
+// getNoLockGaugeUptime returns the minimum uptime of the incentive records that the given NoLock gauge distributes to.
+// External gauges distribute to the uptime set as their duration. Internal gauges, external gauges without a duration and
+// external gauges whose duration is no longer an authorized uptime distribute to types.DefaultConcentratedUptime.
+func (k Keeper) getNoLockGaugeUptime(ctx sdk.Context, gauge types.Gauge) time.Duration {
+	distrTo := gauge.DistributeTo
+	if !isExternalNoLockGauge(gauge) || distrTo.Duration == 0 || !k.isAuthorizedUptime(ctx, distrTo.Duration) {
+		return types.DefaultConcentratedUptime
+	}
+	return distrTo.Duration
+}
+
+// isExternalNoLockGauge returns true if the given gauge is a NoLock gauge created externally, rather than by pool-incentives.
+func isExternalNoLockGauge(gauge types.Gauge) bool {
+	return gauge.DistributeTo.LockQueryType == lockuptypes.NoLock && strings.HasPrefix(gauge.DistributeTo.Denom, types.NoLockExternalPrefix)
+}
+
+// isAuthorizedUptime returns true if the given uptime is one of the authorized uptimes of the concentrated liquidity module.
+func (k Keeper) isAuthorizedUptime(ctx sdk.Context, uptime time.Duration) bool {
+	for _, authorizedUptime := range k.clk.GetParams(ctx).AuthorizedUptimes {
+		if authorizedUptime == uptime {
+			return true
+		}
+	}
+	return false
+}
+
+// filterCoinsBelowMinValueForDistribution splits the remaining coins of a gauge into the coins to distribute
+// and the coins skipped because their distribution for this epoch is below the min value for distribution of their denom.
+// Denoms without a min value for distribution are never skipped.
+func (k Keeper) filterCoinsBelowMinValueForDistribution(ctx sdk.Context, remainCoins sdk.Coins, remainEpochs uint64) (toDistribute sdk.Coins, skipped sdk.Coins) {
+	minValueForDistribution := k.GetParams(ctx).MinValueForDistribution
+	if minValueForDistribution.Empty() {
+		return remainCoins, sdk.NewCoins()
+	}
+
+	toDistribute, skipped = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range remainCoins {
+		amountPerEpoch := coin.Amount.Quo(osmomath.NewIntFromUint64(remainEpochs))
+		if amountPerEpoch.LT(minValueForDistribution.AmountOf(coin.Denom)) {
+			skipped = skipped.Add(coin)
+			continue
+		}
+		toDistribute = toDistribute.Add(coin)
+	}
+	return toDistribute, skipped
+}
+
+// finishGaugeDistribution updates the gauge after distributing the given coins.
+// On the last distribution of a non perpetual gauge, the coins skipped for being below their min value for distribution
+// are returned to the community pool, since they would otherwise stay in the finished gauge, and are accounted as distributed.
+func (k Keeper) finishGaugeDistribution(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins, skippedCoins sdk.Coins) error {
+	if !skippedCoins.Empty() && gauge.IsLastNonPerpetualDistribution() {
+		err := k.ck.FundCommunityPool(ctx, skippedCoins, k.ak.GetModuleAddress(types.ModuleName))
+		if err != nil {
+			return err
+		}
+		newlyDistributedCoins = newlyDistributedCoins.Add(skippedCoins...)
+	}
+	return k.updateGaugePostDistribute(ctx, gauge, newlyDistributedCoins)
+}
+
 // updateGaugePostDistribute increments the gauge's filled epochs field.
 // Also adds the coins that were just distributed to the gauge's distributed coins field.
 func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
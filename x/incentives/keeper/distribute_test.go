@@ -440,6 +440,7 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 		expectErr                              bool
 		expectedDistributions                  sdk.Coins
 		expectedRemainingAmountIncentiveRecord []sdk.Dec
+		expectedMinUptime                      time.Duration
 	}
 
 	defaultTest := test{
@@ -454,6 +455,7 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 
 		expectedDistributions:                  sdk.NewCoins(fiveKRewardCoins),
 		expectedRemainingAmountIncentiveRecord: []osmomath.Dec{osmomath.NewDec(defaultAmount)},
+		expectedMinUptime:                      time.Nanosecond,
 	}
 
 	withIsPerpetual := func(tc test, isPerpetual bool) test {
@@ -507,6 +509,13 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 		return tc
 	}
 
+	withExternalUptime := func(tc test, uptime time.Duration, expectedMinUptime time.Duration) test {
+		tc.distrTo.Denom = types.NoLockExternalGaugeDenom(defaultCLPool)
+		tc.distrTo.Duration = uptime
+		tc.expectedMinUptime = expectedMinUptime
+		return tc
+	}
+
 	withError := func(tc test) test {
 		tc.expectErr = true
 		return tc
@@ -521,6 +530,9 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 		"non-perpetual, 2 coins, paid over 3 epochs": withNumEpochs(withGaugeCoins(defaultTest, defaultBothCoins), 3),
 		"error: balancer pool id":                    withError(withPoolId(defaultTest, defaultBalancerPool)),
 		"error: inactive gauge":                      withError(withNumEpochs(defaultTest, 0)),
+		"external gauge, authorized uptime":          withExternalUptime(defaultTest, time.Hour, time.Hour),
+		"external gauge, unauthorized uptime":        withExternalUptime(defaultTest, time.Minute, time.Nanosecond),
+		"external gauge, no uptime":                  withExternalUptime(defaultTest, 0, time.Nanosecond),
 	}
 
 	for name, tc := range tests {
@@ -535,6 +547,11 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 			s.PrepareConcentratedPool()
 			s.PrepareBalancerPool()
 
+			// Authorize the one hour uptime so that external gauges can target it.
+			clParams := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			clParams.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Hour}
+			s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, clParams)
+
 			// Set block time one hour after block creation so that incentives logic
 			// can function properly.
 			s.Ctx = s.Ctx.WithBlockTime(oneHourAfterDefault)
@@ -570,7 +587,7 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 
 				// Check that incentive records were created
 				for i, coin := range tc.expectedDistributions {
-					incentiveRecords, err := s.App.ConcentratedLiquidityKeeper.GetIncentiveRecord(s.Ctx, tc.poolId, time.Nanosecond, uint64(i+1))
+					incentiveRecords, err := s.App.ConcentratedLiquidityKeeper.GetIncentiveRecord(s.Ctx, tc.poolId, tc.expectedMinUptime, uint64(i+1))
 					s.Require().NoError(err)
 
 					expectedEmissionRatePerEpoch := coin.Amount.ToLegacyDec().QuoTruncate(incentivesEpochDurationSeconds)
@@ -579,7 +596,7 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 					s.Require().Equal(coin.Denom, incentiveRecords.IncentiveRecordBody.RemainingCoin.Denom)
 					s.Require().Equal(tc.expectedRemainingAmountIncentiveRecord[i], incentiveRecords.IncentiveRecordBody.RemainingCoin.Amount)
 					s.Require().Equal(expectedEmissionRatePerEpoch, incentiveRecords.IncentiveRecordBody.EmissionRate)
-					s.Require().Equal(time.Nanosecond, incentiveRecords.MinUptime)
+					s.Require().Equal(tc.expectedMinUptime, incentiveRecords.MinUptime)
 				}
 
 				// Check that the gauge's distribution state was updated
@@ -849,6 +866,57 @@ func (s *KeeperTestSuite) TestByDurationNonPerpetualGaugeDistribution_NoLockNoOp
 	s.ValidateNotDistributedGauge(gaugeID)
 }
 
+// TestDistribute_MinValueForDistribution tests that coins whose distribution for the epoch is below their
+// min value for distribution are skipped, and are returned to the community pool on the last distribution.
+func (s *KeeperTestSuite) TestDistribute_MinValueForDistribution() {
+	s.SetupTest()
+
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.MinValueForDistribution = sdk.NewCoins(sdk.NewInt64Coin("stake", 200))
+	s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+
+	// setup a lock and a non perpetual gauge paid over 2 epochs.
+	// stake distributes 75 per epoch, below its minimum, while foo has no minimum.
+	lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	s.LockTokens(lockOwner, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, time.Second)
+	gaugeCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 150), sdk.NewInt64Coin("foo", 1000))
+	gaugeID, _, _, startTime := s.SetupNewGauge(false, gaugeCoins)
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+	s.Require().NoError(err)
+	err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+	s.Require().NoError(err)
+
+	communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+
+	// first epoch: only foo is distributed.
+	distrCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 500)).String(), distrCoins.String())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 500)).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, lockOwner).String())
+
+	gauge, err = s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), gauge.FilledEpochs)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 500)).String(), gauge.DistributedCoins.String())
+
+	// last epoch: foo is distributed, and the skipped stake is returned to the community pool.
+	distrCoins, err = s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 500)).String(), distrCoins.String())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, lockOwner).String())
+
+	gauge, err = s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), gauge.FilledEpochs)
+	s.Require().Equal(gaugeCoins.String(), gauge.DistributedCoins.String())
+
+	communityPoolAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 150)).String(), communityPoolAfter.Sub(communityPoolBefore).String())
+	s.Require().True(s.App.IncentivesKeeper.GetModuleToDistributeCoins(s.Ctx).IsZero())
+}
+
 func (s *KeeperTestSuite) TestGetPoolFromGaugeId() {
 	const (
 		poolIdOne   = uint64(1)
@@ -31,6 +31,11 @@ func (k Keeper) GetAllGaugeIDsByDenom(ctx sdk.Context, denom string) []uint64 {
 	return k.getAllGaugeIDsByDenom(ctx, denom)
 }
 
+// DeleteGaugeDenomStartTimeRef deletes the reference to the provided gauge by its denom and start time under the provided key prefix.
+func (k Keeper) DeleteGaugeDenomStartTimeRef(ctx sdk.Context, keyPrefix []byte, gauge *types.Gauge) {
+	k.deleteGaugeDenomStartTimeRef(ctx, keyPrefix, gauge)
+}
+
 // MoveUpcomingGaugeToActiveGauge moves a gauge that has reached it's start time from an upcoming to an active status.
 func (k Keeper) MoveUpcomingGaugeToActiveGauge(ctx sdk.Context, gauge types.Gauge) error {
 	return k.moveUpcomingGaugeToActiveGauge(ctx, gauge)
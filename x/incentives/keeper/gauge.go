@@ -89,9 +89,11 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 
 	if gauge.IsUpcomingGauge(curTime) {
 		combinedKeys := combineKeys(types.KeyPrefixUpcomingGauges, timeKey)
+		k.addGaugeDenomStartTimeRef(ctx, types.KeyPrefixUpcomingGaugesByDenom, gauge)
 		return k.CreateGaugeRefKeys(ctx, gauge, combinedKeys)
 	} else if gauge.IsActiveGauge(curTime) {
 		combinedKeys := combineKeys(types.KeyPrefixActiveGauges, timeKey)
+		k.addGaugeDenomStartTimeRef(ctx, types.KeyPrefixActiveGaugesByDenom, gauge)
 		return k.CreateGaugeRefKeys(ctx, gauge, combinedKeys)
 	} else {
 		combinedKeys := combineKeys(types.KeyPrefixFinishedGauges, timeKey)
@@ -112,6 +114,9 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 // this is an external gauge, or be equal to types.NoLockInternalGaugeDenom(poolId).
 // If the denom is empty, it will get overwritten to types.NoLockExternalGaugeDenom(poolId).
 // This denom formatting is useful for querying internal vs external gauges associated with a pool.
+// For external gauges, lockuptypes.Duration is the minimum uptime of the incentive records
+// the gauge distributes to, and must be one of the authorized uptimes of the concentrated
+// liquidity module. If zero, types.DefaultConcentratedUptime is used.
 // * lockuptypes.Group - a gauge that incentivizes a group of internal pool gauges based on the splitting
 // policy created by a group data structure. It is expected to be created via CreateGroup keeper method.
 // This gauge is the only gauge type that does not have ref keys (active/upcoming/finished) created and
@@ -161,6 +166,10 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 				return 0, fmt.Errorf("'no lock' type external gauges must have an empty denom set, was %s", distrToDenom)
 			}
 			distrTo.Denom = types.NoLockExternalGaugeDenom(poolId)
+
+			if distrTo.Duration != 0 && !k.isAuthorizedUptime(ctx, distrTo.Duration) {
+				return 0, fmt.Errorf("'no lock' type external gauges must have an authorized uptime as duration, was %s", distrTo.Duration)
+			}
 		}
 
 		pool, err := k.pmk.GetPool(ctx, poolId)
@@ -228,6 +237,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		if err != nil {
 			return 0, err
 		}
+		k.addGaugeDenomStartTimeRef(ctx, types.KeyPrefixUpcomingGaugesByDenom, &gauge)
 	}
 
 	// TODO: We comment out AfterCreateGauge hook for two reasons:
@@ -424,3 +434,25 @@ func (k Keeper) chargeFeeIfSufficientFeeDenomBalance(ctx sdk.Context, address sd
 	}
 	return nil
 }
+
+// chargeGaugeCreationFeeIfNotWhitelisted charges the gauge creation fee param once per reward denom of the gauge,
+// in addition to the base gauge creation fee. The fee is sent to the community pool.
+// Does not charge fee if sender is the incentives module account or if sender is whitelisted.
+// Returns true if charged fee, false otherwise.
+func (k Keeper) chargeGaugeCreationFeeIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress, gaugeCoins sdk.Coins) (chargedFee bool, err error) {
+	params := k.GetParams(ctx)
+	if params.GaugeCreationFee.Empty() || gaugeCoins.Empty() {
+		return false, nil
+	}
+
+	isUnrestricted, err := k.isUnrestrictedCreator(ctx, sender, params)
+	if err != nil || isUnrestricted {
+		return false, err
+	}
+
+	gaugeCreationFee := params.GaugeCreationFee.MulInt(osmomath.NewInt(int64(gaugeCoins.Len())))
+	if err := k.ck.FundCommunityPool(ctx, gaugeCreationFee, sender); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -528,6 +528,30 @@ func (s *KeeperTestSuite) TestCreateGauge_NoLockGauges() {
 			expectedDenomSet: types.NoLockInternalGaugeDenom(concentratedPoolId),
 			expectErr:        false,
 		},
+		{
+			name: "create valid no lock gauge with CL pool (authorized uptime set)",
+			distrTo: lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.NoLock,
+				Denom:         "",
+				Duration:      time.Hour,
+			},
+			poolId: concentratedPoolId,
+
+			expectedGaugeId:  defaultExpectedGaugeId,
+			expectedDenomSet: types.NoLockExternalGaugeDenom(concentratedPoolId),
+			expectErr:        false,
+		},
+		{
+			name: "fail to create no lock gauge because unauthorized uptime is set",
+			distrTo: lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.NoLock,
+				Denom:         "",
+				Duration:      time.Minute,
+			},
+			poolId: concentratedPoolId,
+
+			expectErr: true,
+		},
 		{
 			name: "fail to create gauge because invalid denom is set",
 			distrTo: lockuptypes.QueryCondition{
@@ -576,6 +600,10 @@ func (s *KeeperTestSuite) TestCreateGauge_NoLockGauges() {
 			s.PrepareBalancerPool()
 			s.PrepareConcentratedPool()
 
+			clParams := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			clParams.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Hour}
+			s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, clParams)
+
 			s.FundAcc(s.TestAccs[0], defaultGaugeCreationCoins)
 
 			// System under test
@@ -19,7 +19,12 @@ func (k Keeper) HandleCreateGaugeProposal(ctx sdk.Context, p *types.CreateGroups
 		// then modify it here as well.
 		// Note: do not replace with CreateGroupAsIncentivesModuleAcc as that implementation does not attempt to sync weights
 		// We still want to sync the weights here to ensure that the pools are valid and have the associated volume at group creation time.
-		_, err := k.CreateGroup(ctx, sdk.Coins{}, types.PerpetualNumEpochsPaidOver, incentivesModuleAddress, group.PoolIds)
+		var err error
+		if len(group.Weights) > 0 {
+			_, err = k.CreateGroupWithGovernanceWeights(ctx, sdk.Coins{}, types.PerpetualNumEpochsPaidOver, incentivesModuleAddress, group.PoolIds, group.Weights)
+		} else {
+			_, err = k.CreateGroup(ctx, sdk.Coins{}, types.PerpetualNumEpochsPaidOver, incentivesModuleAddress, group.PoolIds)
+		}
 		if err != nil {
 			return err
 		}
@@ -84,6 +84,44 @@ func (k Keeper) CreateGroupAsIncentivesModuleAcc(ctx sdk.Context, numEpochPaidOv
 	return newGroup.GroupGaugeId, nil
 }
 
+// CreateGroupWithGovernanceWeights creates a group whose incentives are split across its internal pool gauges
+// according to the given weights, rather than by volume. The weights are set once at creation and are never synced.
+// Contrary to CreateGroup, the pools are not required to have volume at creation time.
+// Charges group creation fee, unless incentives module account.
+// See other details of group creation by reviewing createGroup() spec.
+// Returns group gauge ID on success.
+// Returns error if:
+// - the weights are not positive or do not match the pool IDs one to one
+// - fails to create Group
+func (k Keeper) CreateGroupWithGovernanceWeights(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64, weights []osmomath.Int) (uint64, error) {
+	if len(weights) != len(poolIDs) {
+		return 0, types.GroupWeightsMismatchError{PoolIDs: poolIDs, Weights: weights}
+	}
+	for _, weight := range weights {
+		if weight.IsNil() || !weight.IsPositive() {
+			return 0, types.GroupWeightsMismatchError{PoolIDs: poolIDs, Weights: weights}
+		}
+	}
+
+	newGroup, err := k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	// Gauge records are initialized in the same order as the pool IDs.
+	totalWeight := osmomath.ZeroInt()
+	for i := range newGroup.InternalGaugeInfo.GaugeRecords {
+		newGroup.InternalGaugeInfo.GaugeRecords[i].CurrentWeight = weights[i]
+		totalWeight = totalWeight.Add(weights[i])
+	}
+	newGroup.InternalGaugeInfo.TotalWeight = totalWeight
+	newGroup.SplittingPolicy = types.ByGovernance
+
+	k.SetGroup(ctx, newGroup)
+
+	return newGroup.GroupGaugeId, nil
+}
+
 // createGroup creates a new group. The group is 1:1 mapped to a group gauge that allocates rewards dynamically across its internal pool gauges based on
 // the volume splitting policy.
 // For each pool ID in the given slice, its main internal gauge is used to create gauge records to be associated with the Group.
@@ -137,9 +175,8 @@ func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver u
 	newGroup := types.Group{
 		GroupGaugeId:      groupGaugeID,
 		InternalGaugeInfo: initialInternalGaugeInfo,
-		// Note: only Volume splitting exists today.
-		// We allow for other splitting policies to be added in the future
-		// by extending the enum.
+		// Note: groups are split by volume by default.
+		// Governance weighted groups overwrite the policy after creation.
 		SplittingPolicy: types.ByVolume,
 	}
 
@@ -177,11 +214,26 @@ func (k Keeper) initGaugeInfo(ctx sdk.Context, poolIds []uint64) (types.Internal
 // - fails to send coins from sender to the community pool
 func (k Keeper) chargeGroupCreationFeeIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress) (chargedFee bool, err error) {
 	params := k.GetParams(ctx)
-	incentivesModuleAddress := k.ak.GetModuleAddress(types.ModuleName)
 
-	// don't charge fee if sender is the incentives module account
+	isUnrestricted, err := k.isUnrestrictedCreator(ctx, sender, params)
+	if err != nil || isUnrestricted {
+		return false, err
+	}
+
+	// Charge fee
+	groupCreationFee := params.GroupCreationFee
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, groupCreationFee); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// isUnrestrictedCreator returns true if the sender is the incentives module account or is in the unrestricted creator whitelist.
+// Returns error if one of the addresses in params is invalid.
+func (k Keeper) isUnrestrictedCreator(ctx sdk.Context, sender sdk.AccAddress, params types.Params) (bool, error) {
+	incentivesModuleAddress := k.ak.GetModuleAddress(types.ModuleName)
 	if sender.Equals(incentivesModuleAddress) {
-		return false, nil
+		return true, nil
 	}
 
 	for _, unrestrictedAddressStr := range params.UnrestrictedCreatorWhitelist {
@@ -190,18 +242,11 @@ func (k Keeper) chargeGroupCreationFeeIfNotWhitelisted(ctx sdk.Context, sender s
 			return false, err
 		}
 
-		// don't charge fee if sender is in the whitelist
 		if unrestrictedAddress.Equals(sender) {
-			return false, nil
+			return true, nil
 		}
 	}
-
-	// Charge fee
-	groupCreationFee := params.GroupCreationFee
-	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, groupCreationFee); err != nil {
-		return false, err
-	}
-	return true, nil
+	return false, nil
 }
 
 // GetPoolIdsAndDurationsFromGaugeRecords retrieves the pool IDs and their associated durations from a group's gauge records
@@ -221,7 +221,9 @@ func (s *KeeperTestSuite) TestCreateGroup() {
 
 			// Set a custom creation fee to avoid test balances having false positives
 			// due to having OSMO added during test setup
-			s.App.IncentivesKeeper.SetParam(s.Ctx, types.KeyGroupCreationFee, customGroupCreationFee)
+			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			params.GroupCreationFee = customGroupCreationFee
+			s.App.IncentivesKeeper.SetParams(s.Ctx, params)
 
 			// Fund fee once to a specific test account
 			s.FundAcc(s.TestAccs[oneTimeFeeFundedIndex], customGroupCreationFee)
@@ -543,7 +545,9 @@ func (s *KeeperTestSuite) TestChargeGroupCreationFeeIfNotWhitelisted() {
 	s.SetupTest()
 
 	// Configure group creation fee
-	s.App.IncentivesKeeper.SetParam(s.Ctx, types.KeyGroupCreationFee, customGroupCreationFee)
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.GroupCreationFee = customGroupCreationFee
+	s.App.IncentivesKeeper.SetParams(s.Ctx, params)
 
 	// Define accounts
 	var (
@@ -591,7 +595,9 @@ func (s *KeeperTestSuite) TestChargeGroupCreationFeeIfNotWhitelisted() {
 			incentivesKeeper := s.App.IncentivesKeeper
 
 			// Set up whitelist
-			s.App.IncentivesKeeper.SetParam(s.Ctx, types.KeyCreatorWhitelist, tc.whitelist)
+			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			params.UnrestrictedCreatorWhitelist = tc.whitelist
+			s.App.IncentivesKeeper.SetParams(s.Ctx, params)
 
 			// Keep original balances for final balance assertions
 			senderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, tc.sender)
@@ -798,3 +804,85 @@ func configureExpectedWeights(tc *createGroupTestCase) {
 	}
 	tc.expectedGaugeInfo.TotalWeight = expectedTotalVolume
 }
+
+// Validates that groups created with governance weights split incentives by these weights,
+// without requiring volume and without ever syncing them.
+func (s *KeeperTestSuite) TestCreateGroupWithGovernanceWeights() {
+	tests := []struct {
+		name    string
+		weights []osmomath.Int
+
+		expectErr bool
+	}{
+		{
+			name:    "valid weights",
+			weights: []osmomath.Int{osmomath.NewInt(1), osmomath.NewInt(3)},
+		},
+		{
+			name:      "error: fewer weights than pools",
+			weights:   []osmomath.Int{osmomath.NewInt(1)},
+			expectErr: true,
+		},
+		{
+			name:      "error: zero weight",
+			weights:   []osmomath.Int{osmomath.NewInt(1), osmomath.ZeroInt()},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(defaultTime)
+
+			poolInfo := s.PrepareAllSupportedPools()
+			poolIDs := []uint64{poolInfo.BalancerPoolID, poolInfo.ConcentratedPoolID}
+
+			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			params.GroupCreationFee = customGroupCreationFee
+			s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+			groupCoins := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 4000))
+			s.FundAcc(s.TestAccs[0], groupCoins.Add(customGroupCreationFee...))
+
+			// Note that no volume is set for the pools.
+			groupGaugeId, err := s.App.IncentivesKeeper.CreateGroupWithGovernanceWeights(s.Ctx, groupCoins, types.PerpetualNumEpochsPaidOver, s.TestAccs[0], poolIDs, tc.weights)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedGroup := types.Group{
+				GroupGaugeId: groupGaugeId,
+				InternalGaugeInfo: types.InternalGaugeInfo{
+					TotalWeight: osmomath.NewInt(4),
+					GaugeRecords: []types.InternalGaugeRecord{
+						{GaugeId: poolInfo.BalancerGaugeID, CurrentWeight: tc.weights[0], CumulativeWeight: osmomath.ZeroInt()},
+						{GaugeId: poolInfo.ConcentratedGaugeID, CurrentWeight: tc.weights[1], CumulativeWeight: osmomath.ZeroInt()},
+					},
+				},
+				SplittingPolicy: types.ByGovernance,
+			}
+			s.validateGroupInState(expectedGroup)
+
+			// Syncing leaves the governance weights unchanged.
+			group, err := s.App.IncentivesKeeper.GetGroupByGaugeID(s.Ctx, groupGaugeId)
+			s.Require().NoError(err)
+			s.Require().NoError(s.App.IncentivesKeeper.SyncGroupWeights(s.Ctx, group))
+			s.validateGroupInState(expectedGroup)
+
+			// Incentives are split by the governance weights.
+			s.Require().NoError(s.App.IncentivesKeeper.AllocateAcrossGauges(s.Ctx, []types.Group{group}))
+
+			balancerGauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, poolInfo.BalancerGaugeID)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)).String(), balancerGauge.Coins.String())
+
+			concentratedGauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, poolInfo.ConcentratedGaugeID)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 3000)).String(), concentratedGauge.Coins.String())
+		})
+	}
+}
@@ -90,7 +90,16 @@ func (q Querier) ActiveGaugesPerDenom(goCtx context.Context, req *types.ActiveGa
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	pageRes, gauges, err := q.filterByPrefixAndDenom(ctx, types.KeyPrefixActiveGauges, req.Denom, req.Pagination)
+	var (
+		pageRes *query.PageResponse
+		gauges  []types.Gauge
+		err     error
+	)
+	if req.Denom == "" {
+		pageRes, gauges, err = q.filterByPrefixAndDenom(ctx, types.KeyPrefixActiveGauges, "", req.Pagination)
+	} else {
+		pageRes, gauges, err = q.paginateGaugesByDenom(ctx, types.KeyPrefixActiveGaugesByDenom, req.Denom, req.Pagination)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -125,7 +134,7 @@ func (q Querier) UpcomingGaugesPerDenom(goCtx context.Context, req *types.Upcomi
 		return nil, status.Error(codes.InvalidArgument, "invalid denom")
 	}
 
-	pageRes, gauges, err := q.filterByPrefixAndDenom(ctx, types.KeyPrefixUpcomingGauges, req.Denom, req.Pagination)
+	pageRes, gauges, err := q.paginateGaugesByDenom(ctx, types.KeyPrefixUpcomingGaugesByDenom, req.Denom, req.Pagination)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -287,6 +296,24 @@ func (q Querier) filterByPrefixAndDenom(ctx sdk.Context, prefixType []byte, deno
 	return pageRes, gauges, err
 }
 
+// paginateGaugesByDenom paginates the gauges of the provided denom referenced under the provided key prefix
+// of upcoming or active gauges by denom, in ascending start time order.
+func (q Querier) paginateGaugesByDenom(ctx sdk.Context, keyPrefix []byte, denom string, pagination *query.PageRequest) (*query.PageResponse, []types.Gauge, error) {
+	gauges := []types.Gauge{}
+	store := ctx.KVStore(q.Keeper.storeKey)
+	denomStore := prefix.NewStore(store, gaugeDenomPrefix(keyPrefix, denom))
+
+	pageRes, err := query.Paginate(denomStore, pagination, func(_, value []byte) error {
+		gauge, err := q.Keeper.GetGaugeByID(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return err
+		}
+		gauges = append(gauges, *gauge)
+		return nil
+	})
+	return pageRes, gauges, err
+}
+
 // queryWeightSplitGroup calculates the ratio of volume for each gauge in a group since the last epoch.
 // It first updates the group weights based on the pool volumes.
 // Then, for each gauge in the updated group, it calculates the ratio of the gauge's current weight to the total weight of the group.
@@ -215,6 +215,69 @@ func (s *KeeperTestSuite) TestGRPCActiveGaugesPerDenom() {
 	s.Require().NoError(err)
 }
 
+// TestGRPCGaugesPerDenomOrdering tests that querying upcoming and active gauges by denom via gRPC
+// returns the gauges of the denom ordered by start time, and follows them through their lifecycle.
+func (s *KeeperTestSuite) TestGRPCGaugesPerDenomOrdering() {
+	s.SetupTest()
+
+	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "pool",
+		Duration:      time.Second,
+	}
+	s.FundAcc(addr, sdk.Coins{sdk.NewInt64Coin(distrTo.Denom, 200)})
+
+	// create three gauges of the pool denom starting in three, one and two hours,
+	// along with a gauge of another denom
+	now := s.Ctx.BlockTime()
+	gaugeIDs := []uint64{}
+	gauges := []*types.Gauge{}
+	for _, startTime := range []time.Time{now.Add(3 * time.Hour), now.Add(time.Hour), now.Add(2 * time.Hour)} {
+		gaugeID, gauge := s.CreateGauge(false, addr, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, distrTo, startTime, 1)
+		gaugeIDs = append(gaugeIDs, gaugeID)
+		gauges = append(gauges, gauge)
+	}
+	s.SetupNewGaugeWithDenom(false, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, "otherpool")
+
+	gaugeIDsOf := func(gauges []types.Gauge) []uint64 {
+		ids := []uint64{}
+		for _, gauge := range gauges {
+			ids = append(ids, gauge.Id)
+		}
+		return ids
+	}
+
+	// the upcoming gauges of the pool denom are paginated in start time order
+	res, err := s.querier.UpcomingGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.UpcomingGaugesPerDenomRequest{Denom: "pool", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{gaugeIDs[1], gaugeIDs[2]}, gaugeIDsOf(res.UpcomingGauges))
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = s.querier.UpcomingGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.UpcomingGaugesPerDenomRequest{Denom: "pool", Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{gaugeIDs[0]}, gaugeIDsOf(res.UpcomingGauges))
+	s.Require().Nil(res.Pagination.NextKey)
+
+	// move the gauges to an active status, the gauge starting in one hour is then finished
+	s.Ctx = s.Ctx.WithBlockTime(now.Add(4 * time.Hour))
+	for _, gauge := range gauges {
+		err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+		s.Require().NoError(err)
+	}
+	err = s.App.IncentivesKeeper.MoveActiveGaugeToFinishedGauge(s.Ctx, *gauges[1])
+	s.Require().NoError(err)
+
+	res, err = s.querier.UpcomingGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.UpcomingGaugesPerDenomRequest{Denom: "pool"})
+	s.Require().NoError(err)
+	s.Require().Len(res.UpcomingGauges, 0)
+
+	activeRes, err := s.querier.ActiveGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.ActiveGaugesPerDenomRequest{Denom: "pool"})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{gaugeIDs[2], gaugeIDs[0]}, gaugeIDsOf(activeRes.Data))
+}
+
 // TestGRPCUpcomingGauges tests querying upcoming gauges via gRPC returns the correct response.
 func (s *KeeperTestSuite) TestGRPCUpcomingGauges() {
 	s.SetupTest()
@@ -93,3 +93,8 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// GetModuleName implements EpochHooks.
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
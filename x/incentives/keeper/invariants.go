@@ -0,0 +1,58 @@
+package keeper
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+)
+
+const gaugeEscrowInvariantName = "gauge-escrow-equals-undistributed-coins"
+
+// RegisterInvariants registers all incentives invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, gaugeEscrowInvariantName, GaugeEscrowInvariant(keeper))
+}
+
+// GaugeEscrowInvariant ensures that the incentives module account balance equals the sum of
+// the coins that are yet to be distributed by all gauges, including group gauges and the
+// truncation dust left in finished gauges.
+func GaugeEscrowInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		gauges, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(keeper.storeKey), types.KeyPrefixPeriodGauge, func(bz []byte) (types.Gauge, error) {
+			gauge := types.Gauge{}
+			err := proto.Unmarshal(bz, &gauge)
+			return gauge, err
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, gaugeEscrowInvariantName,
+				fmt.Sprintf("\tgauge retrieval failed: %s\n", err)), true
+		}
+
+		undistributedCoins := sdk.NewCoins()
+		for _, gauge := range gauges {
+			remainingCoins, anyNegative := gauge.Coins.SafeSub(gauge.DistributedCoins...)
+			if anyNegative {
+				return sdk.FormatInvariant(types.ModuleName, gaugeEscrowInvariantName,
+					fmt.Sprintf("\tgauge %d distributed more than its coins: %s > %s\n",
+						gauge.Id, gauge.DistributedCoins, gauge.Coins)), true
+			}
+			undistributedCoins = undistributedCoins.Add(remainingCoins...)
+		}
+
+		moduleBalance := keeper.bk.GetAllBalances(ctx, keeper.ak.GetModuleAddress(types.ModuleName))
+		if !moduleBalance.IsAllGTE(undistributedCoins) || !undistributedCoins.IsAllGTE(moduleBalance) {
+			return sdk.FormatInvariant(types.ModuleName, gaugeEscrowInvariantName,
+				fmt.Sprintf("\tincentives module balance does not equal undistributed gauge coins: %s != %s\n",
+					moduleBalance, undistributedCoins)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, gaugeEscrowInvariantName,
+			"\tincentives module balance equals undistributed gauge coins\n"), false
+	}
+}
@@ -16,7 +16,9 @@ import (
 
 // Keeper provides a way to manage incentives module storage.
 type Keeper struct {
-	storeKey   storetypes.StoreKey
+	storeKey storetypes.StoreKey
+
+	// paramSpace is the legacy x/params subspace of the incentives parameters, only read to migrate them to the module store.
 	paramSpace paramtypes.Subspace
 	hooks      types.IncentiveHooks
 	ak         types.AccountKeeper
@@ -28,10 +30,13 @@ type Keeper struct {
 	clk        types.ConcentratedLiquidityKeeper
 	pmk        types.PoolManagerKeeper
 	pik        types.PoolIncentiveKeeper
+
+	// authority is the address allowed to update the incentives parameters, the governance module account.
+	authority string
 }
 
 // NewKeeper returns a new instance of the incentive module keeper struct.
-func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper, lk types.LockupKeeper, ek types.EpochKeeper, ck types.CommunityPoolKeeper, txfk types.TxFeesKeeper, clk types.ConcentratedLiquidityKeeper, pmk types.PoolManagerKeeper, pik types.PoolIncentiveKeeper) *Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper, lk types.LockupKeeper, ek types.EpochKeeper, ck types.CommunityPoolKeeper, txfk types.TxFeesKeeper, clk types.ConcentratedLiquidityKeeper, pmk types.PoolManagerKeeper, pik types.PoolIncentiveKeeper, authority string) *Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
@@ -48,6 +53,7 @@ func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, ak
 		tk:         txfk,
 		pmk:        pmk,
 		clk:        clk,
+		authority:  authority,
 	}
 }
 
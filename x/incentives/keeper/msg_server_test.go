@@ -7,8 +7,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/keeper"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
@@ -31,6 +33,8 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 		expectedEndBalance   sdk.Coins
 		isPerpetual          bool
 		isModuleAccount      bool
+		isWhitelisted        bool
+		gaugeCreationFee     sdk.Coins
 		expectErr            bool
 	}{
 		{
@@ -61,6 +65,26 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 			gaugeAddition:        tenTokens,
 			isPerpetual:          true,
 		},
+		{
+			name:                 "user with multiple denoms pays the gauge creation fee once per reward denom",
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(70000000)), sdk.NewCoin("foo", osmomath.NewInt(70000000))),
+			gaugeAddition:        sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000000)), sdk.NewCoin("foo", osmomath.NewInt(1000000))),
+			gaugeCreationFee:     sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(5000000))),
+		},
+		{
+			name:                 "whitelisted user does not pay the gauge creation fee",
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(70000000)), sdk.NewCoin("foo", osmomath.NewInt(70000000))),
+			gaugeAddition:        tenTokens,
+			gaugeCreationFee:     sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(5000000))),
+			isWhitelisted:        true,
+		},
+		{
+			name:                 "user tries to create a gauge but does not have enough funds to pay for the gauge creation fee",
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(70000000)), sdk.NewCoin("foo", osmomath.NewInt(1000000))),
+			gaugeAddition:        tenTokens,
+			gaugeCreationFee:     sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(5000000))),
+			expectErr:            true,
+		},
 		{
 			name:                 "user tries to create a non-perpetual gauge but does not have enough funds to pay for the create gauge fee",
 			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(40000000))),
@@ -102,6 +126,13 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 			accountKeeper.SetModuleAccount(ctx, modAcc)
 		}
 
+		params := s.App.IncentivesKeeper.GetParams(ctx)
+		params.GaugeCreationFee = tc.gaugeCreationFee
+		if tc.isWhitelisted {
+			params.UnrestrictedCreatorWhitelist = []string{testAccountAddress.String()}
+		}
+		s.App.IncentivesKeeper.SetParams(ctx, params)
+
 		s.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
 		distrTo := lockuptypes.QueryCondition{
 			LockQueryType: lockuptypes.ByDuration,
@@ -132,6 +163,9 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 			s.Require().Equal(tc.accountBalanceToFund.String(), balanceAmount.String(), "test: %v", tc.name)
 		} else {
 			fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.CreateGaugeFee))
+			if !tc.isWhitelisted {
+				fee = fee.Add(tc.gaugeCreationFee.MulInt(osmomath.NewInt(int64(tc.gaugeAddition.Len())))...)
+			}
 			accountBalance := tc.accountBalanceToFund.Sub(tc.gaugeAddition...)
 			finalAccountBalance := accountBalance.Sub(fee...)
 			s.Require().Equal(finalAccountBalance.String(), balanceAmount.String(), "test: %v", tc.name)
@@ -379,3 +413,72 @@ func (s *KeeperTestSuite) completeGauge(gauge *types.Gauge, sendingAddress sdk.A
 	s.Require().NoError(err)
 	s.Require().True(gauge2.IsFinishedGauge(s.Ctx.BlockTime()))
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	negativeFee := sdk.Coins{{Denom: appparams.BaseCoinUnit, Amount: osmomath.NewInt(-1)}}
+
+	tests := map[string]struct {
+		authority    string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		"update gauge creation fee and min value for distribution": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.GaugeCreationFee = sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 50_000_000))
+				params.MinValueForDistribution = sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000))
+			},
+		},
+		"update unrestricted creator whitelist": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.UnrestrictedCreatorWhitelist = []string{s.TestAccs[1].String()} },
+		},
+		"error: not the governance authority": {
+			authority:    s.TestAccs[0].String(),
+			updateParams: func(params *types.Params) { params.UnrestrictedCreatorWhitelist = []string{s.TestAccs[1].String()} },
+			expectedErr:  "invalid authority",
+		},
+		"error: empty distribution epoch identifier": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.DistrEpochIdentifier = "" },
+			expectedErr:  "empty distribution epoch identifier",
+		},
+		"error: negative group creation fee": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.GroupCreationFee = negativeFee },
+			expectedErr:  "amount is not positive",
+		},
+		"error: negative gauge creation fee": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.GaugeCreationFee = negativeFee },
+			expectedErr:  "amount is not positive",
+		},
+		"error: invalid unrestricted creator address": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.UnrestrictedCreatorWhitelist = []string{"invalid"} },
+			expectedErr:  "invalid address",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			originalParams := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			newParams := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			tc.updateParams(&newParams)
+			msgServer := keeper.NewMsgServerImpl(s.App.IncentivesKeeper)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: tc.authority, Params: newParams})
+
+			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, params)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,16 +9,17 @@ import (
 
 // GetParams returns all of the parameters in the incentive module.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 	return params
 }
 
 // SetParams sets all of the parameters in the incentive module.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 }
 
-// SetParam sets a specific incentives module's parameter with the provided parameter.
+// SetParam sets a specific incentives parameter in the legacy x/params subspace.
+// It is only used by the upgrade handlers that ran before the parameters were moved to the module store.
 func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 	k.paramSpace.Set(ctx, key, value)
 }
@@ -107,6 +107,43 @@ func (k Keeper) addGaugeIDForDenom(ctx sdk.Context, ID uint64, denom string) err
 	return k.addGaugeRefByKey(ctx, gaugeDenomStoreKey(denom), ID)
 }
 
+// gaugeDenomPrefix returns the prefix of the references to the gauges of the provided denom, under the
+// provided key prefix of upcoming or active gauges by denom.
+func gaugeDenomPrefix(keyPrefix []byte, denom string) []byte {
+	return combineKeys(keyPrefix, []byte(denom), []byte{})
+}
+
+// gaugeDenomStartTimeStoreKey returns the store key of the reference to the provided gauge by its denom and start time.
+// The references of a denom are ordered by gauge start time, then by gauge ID.
+func gaugeDenomStartTimeStoreKey(keyPrefix []byte, gauge *types.Gauge) []byte {
+	return append(gaugeDenomPrefix(keyPrefix, gauge.DistributeTo.Denom), combineKeys(getTimeKey(gauge.StartTime), sdk.Uint64ToBigEndian(gauge.Id))...)
+}
+
+// addGaugeDenomStartTimeRef adds a reference to the provided gauge by its denom and start time under the provided key prefix.
+func (k Keeper) addGaugeDenomStartTimeRef(ctx sdk.Context, keyPrefix []byte, gauge *types.Gauge) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(gaugeDenomStartTimeStoreKey(keyPrefix, gauge), sdk.Uint64ToBigEndian(gauge.Id))
+}
+
+// deleteGaugeDenomStartTimeRef deletes the reference to the provided gauge by its denom and start time under the provided key prefix.
+func (k Keeper) deleteGaugeDenomStartTimeRef(ctx sdk.Context, keyPrefix []byte, gauge *types.Gauge) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(gaugeDenomStartTimeStoreKey(keyPrefix, gauge))
+}
+
+// IndexGaugesByDenomAndStartTime adds the references to the upcoming and active gauges by denom and start time.
+// Used to index the gauges created before the references existed.
+func (k Keeper) IndexGaugesByDenomAndStartTime(ctx sdk.Context) {
+	for _, gauge := range k.GetUpcomingGauges(ctx) {
+		gauge := gauge
+		k.addGaugeDenomStartTimeRef(ctx, types.KeyPrefixUpcomingGaugesByDenom, &gauge)
+	}
+	for _, gauge := range k.GetActiveGauges(ctx) {
+		gauge := gauge
+		k.addGaugeDenomStartTimeRef(ctx, types.KeyPrefixActiveGaugesByDenom, &gauge)
+	}
+}
+
 // SetGroup sets groupGroup for a specific key.
 // TODO: explore if we can store this better, this has GroupGaugeId in key and value
 func (k Keeper) SetGroup(ctx sdk.Context, group types.Group) {
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
@@ -41,6 +43,39 @@ func (s *KeeperTestSuite) TestGaugeReferencesManagement() {
 	s.Require().Equal(len(gaugeRefs3), 2)
 }
 
+func (s *KeeperTestSuite) TestIndexGaugesByDenomAndStartTime() {
+	s.SetupTest()
+
+	// create two upcoming gauges and move the first one to an active status
+	_, activeGauge, _, startTime := s.SetupNewGaugeWithDenom(false, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, "pool")
+	_, upcomingGauge, _, _ := s.SetupNewGaugeWithDenom(false, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, "pool")
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Second))
+	err := s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *activeGauge)
+	s.Require().NoError(err)
+
+	// delete the references by denom and start time, as for gauges created before they existed
+	s.App.IncentivesKeeper.DeleteGaugeDenomStartTimeRef(s.Ctx, types.KeyPrefixActiveGaugesByDenom, activeGauge)
+	s.App.IncentivesKeeper.DeleteGaugeDenomStartTimeRef(s.Ctx, types.KeyPrefixUpcomingGaugesByDenom, upcomingGauge)
+
+	activeRes, err := s.querier.ActiveGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.ActiveGaugesPerDenomRequest{Denom: "pool"})
+	s.Require().NoError(err)
+	s.Require().Len(activeRes.Data, 0)
+	upcomingRes, err := s.querier.UpcomingGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.UpcomingGaugesPerDenomRequest{Denom: "pool"})
+	s.Require().NoError(err)
+	s.Require().Len(upcomingRes.UpcomingGauges, 0)
+
+	s.App.IncentivesKeeper.IndexGaugesByDenomAndStartTime(s.Ctx)
+
+	activeRes, err = s.querier.ActiveGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.ActiveGaugesPerDenomRequest{Denom: "pool"})
+	s.Require().NoError(err)
+	s.Require().Len(activeRes.Data, 1)
+	s.Require().Equal(activeGauge.Id, activeRes.Data[0].Id)
+	upcomingRes, err = s.querier.UpcomingGaugesPerDenom(sdk.WrapSDKContext(s.Ctx), &types.UpcomingGaugesPerDenomRequest{Denom: "pool"})
+	s.Require().NoError(err)
+	s.Require().Len(upcomingRes.UpcomingGauges, 1)
+	s.Require().Equal(upcomingGauge.Id, upcomingRes.UpcomingGauges[0].Id)
+}
+
 func (s *KeeperTestSuite) TestGetGroupByGaugeID() {
 	// TODO: Re-enable this once gauge creation refactor is complete in https://github.com/osmosis-labs/osmosis/issues/6404
 	s.T().Skip()
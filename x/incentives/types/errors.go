@@ -103,3 +103,12 @@ type DuplicatePoolIDError struct {
 func (e DuplicatePoolIDError) Error() string {
 	return fmt.Sprintf("one or more pool IDs provided in the pool ID array contains a duplicate: %d", e.PoolIDs)
 }
+
+type GroupWeightsMismatchError struct {
+	PoolIDs []uint64
+	Weights []osmomath.Int
+}
+
+func (e GroupWeightsMismatchError) Error() string {
+	return fmt.Sprintf("group weights %s must be positive and given one per pool ID %d", e.Weights, e.PoolIDs)
+}
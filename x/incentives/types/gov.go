@@ -51,6 +51,17 @@ func (p *CreateGroupsProposal) ValidateBasic() error {
 		if len(group.PoolIds) <= 1 {
 			return fmt.Errorf("each group much be comprised of at least two pool ids")
 		}
+		if len(group.Weights) == 0 {
+			continue
+		}
+		if len(group.Weights) != len(group.PoolIds) {
+			return fmt.Errorf("each group must have either no weights or one weight per pool id, got %d weights for %d pool ids", len(group.Weights), len(group.PoolIds))
+		}
+		for _, weight := range group.Weights {
+			if weight.IsNil() || !weight.IsPositive() {
+				return fmt.Errorf("group weights must be positive, got %s", weight)
+			}
+		}
 	}
 	return nil
 }
@@ -59,7 +70,11 @@ func (p *CreateGroupsProposal) ValidateBasic() error {
 func (p CreateGroupsProposal) String() string {
 	recordsStr := ""
 	for _, group := range p.CreateGroups {
-		recordsStr = recordsStr + fmt.Sprintf("(PoolIDs: %d) ", group.PoolIds)
+		if len(group.Weights) == 0 {
+			recordsStr = recordsStr + fmt.Sprintf("(PoolIDs: %d) ", group.PoolIds)
+		} else {
+			recordsStr = recordsStr + fmt.Sprintf("(PoolIDs: %d, Weights: %s) ", group.PoolIds, group.Weights)
+		}
 	}
 
 	var b strings.Builder
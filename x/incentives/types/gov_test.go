@@ -6,6 +6,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 )
 
@@ -59,6 +60,18 @@ func TestCreateGroupsProposal_ValidateBasic(t *testing.T) {
 
 	emptyCreateGroup := []types.CreateGroup{}
 
+	weightedGroup := []types.CreateGroup{
+		{PoolIds: []uint64{1, 2}, Weights: []osmomath.Int{osmomath.NewInt(1), osmomath.NewInt(3)}},
+	}
+
+	mismatchedWeightsGroup := []types.CreateGroup{
+		{PoolIds: []uint64{1, 2}, Weights: []osmomath.Int{osmomath.NewInt(1)}},
+	}
+
+	zeroWeightGroup := []types.CreateGroup{
+		{PoolIds: []uint64{1, 2}, Weights: []osmomath.Int{osmomath.NewInt(1), osmomath.ZeroInt()}},
+	}
+
 	tests := []struct {
 		name        string
 		createGroup []types.CreateGroup
@@ -79,6 +92,21 @@ func TestCreateGroupsProposal_ValidateBasic(t *testing.T) {
 			createGroup: emptyCreateGroup,
 			expectPass:  false,
 		},
+		{
+			name:        "group with governance weights",
+			createGroup: weightedGroup,
+			expectPass:  true,
+		},
+		{
+			name:        "group with fewer weights than PoolIds",
+			createGroup: mismatchedWeightsGroup,
+			expectPass:  false,
+		},
+		{
+			name:        "group with zero weight",
+			createGroup: zeroWeightGroup,
+			expectPass:  false,
+		},
 	}
 
 	for _, test := range tests {
@@ -33,14 +33,19 @@ type SplittingPolicy int32
 
 const (
 	ByVolume SplittingPolicy = 0
+	// ByGovernance splits incentives according to weights set by governance
+	// at group creation. These weights are never synced.
+	ByGovernance SplittingPolicy = 1
 )
 
 var SplittingPolicy_name = map[int32]string{
 	0: "ByVolume",
+	1: "ByGovernance",
 }
 
 var SplittingPolicy_value = map[string]int32{
-	"ByVolume": 0,
+	"ByVolume":     0,
+	"ByGovernance": 1,
 }
 
 func (x SplittingPolicy) String() string {
@@ -222,6 +227,9 @@ func (m *Group) GetSplittingPolicy() SplittingPolicy {
 // It takes an array of pool IDs to split the incentives across.
 type CreateGroup struct {
 	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// weights are the governance set weights of the pools, in the same order as
+	// pool_ids. If empty, the incentives are split by volume instead.
+	Weights []cosmossdk_io_math.Int `protobuf:"bytes,2,rep,name=weights,proto3,customtype=cosmossdk.io/math.Int" json:"weights"`
 }
 
 func (m *CreateGroup) Reset()         { *m = CreateGroup{} }
@@ -330,46 +338,47 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/group.proto", fileDescriptor_90cab10cb3a674f3) }
 
 var fileDescriptor_90cab10cb3a674f3 = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc7, 0xed, 0x36, 0x7d, 0xda, 0x67, 0x93, 0xe6, 0xc5, 0x01, 0x29, 0x89, 0x84, 0x1d, 0x19,
-	0x2a, 0x22, 0x24, 0xbc, 0x4a, 0x78, 0x39, 0xf4, 0x18, 0x90, 0xa2, 0x70, 0x40, 0x95, 0x91, 0x88,
-	0x04, 0x87, 0x68, 0x6d, 0x6f, 0x9c, 0x55, 0x6d, 0xaf, 0xe5, 0x5d, 0x07, 0x72, 0xe2, 0xca, 0x91,
-	0x8f, 0x80, 0xc4, 0x17, 0xe1, 0xd8, 0x63, 0x8f, 0xa8, 0x12, 0x11, 0x4a, 0x2e, 0x9c, 0xfb, 0x09,
-	0x90, 0xd7, 0x36, 0x69, 0xda, 0x08, 0x38, 0xd9, 0xb3, 0xf3, 0xff, 0xcf, 0xce, 0x6f, 0x34, 0x0b,
-	0x54, 0xca, 0x7c, 0xca, 0x08, 0x83, 0x24, 0xb0, 0x71, 0xc0, 0xc9, 0x0c, 0x33, 0xe8, 0x46, 0x34,
-	0x0e, 0x8d, 0x30, 0xa2, 0x9c, 0x2a, 0x4a, 0x96, 0x37, 0xd6, 0xf9, 0xd6, 0x2d, 0x97, 0xba, 0x54,
-	0xa4, 0x61, 0xf2, 0x97, 0x2a, 0x5b, 0xaa, 0x4b, 0xa9, 0xeb, 0x61, 0x28, 0x22, 0x2b, 0x9e, 0x40,
-	0x27, 0x8e, 0x10, 0x27, 0x34, 0xc8, 0xf2, 0xda, 0xf5, 0x3c, 0x27, 0x3e, 0x66, 0x1c, 0xf9, 0x61,
-	0x5e, 0xc0, 0x16, 0x77, 0x41, 0x0b, 0x31, 0x0c, 0x67, 0x5d, 0x0b, 0x73, 0xd4, 0x85, 0x36, 0x25,
-	0x79, 0x81, 0x66, 0xde, 0xaa, 0x47, 0xed, 0xd3, 0x38, 0x14, 0x9f, 0xdc, 0xba, 0x8d, 0x02, 0xc5,
-	0x2e, 0x4e, 0xf3, 0xfa, 0x57, 0x19, 0xd4, 0x86, 0x01, 0xc7, 0x51, 0x80, 0xbc, 0x41, 0x72, 0x3e,
-	0x0c, 0x26, 0x54, 0x19, 0x81, 0x12, 0xa7, 0x1c, 0x79, 0xe3, 0x77, 0x98, 0xb8, 0x53, 0xde, 0x90,
-	0xdb, 0x72, 0xe7, 0xff, 0xfe, 0xe3, 0xb3, 0x85, 0x26, 0x5d, 0x2c, 0xb4, 0xdb, 0x69, 0x3b, 0xcc,
-	0x39, 0x35, 0x08, 0x85, 0x3e, 0xe2, 0x53, 0x63, 0x18, 0xf0, 0xcb, 0x85, 0x56, 0x9f, 0x23, 0xdf,
-	0x3b, 0xd6, 0xaf, 0x5a, 0x75, 0xb3, 0x28, 0xc2, 0x91, 0x88, 0x14, 0x13, 0x1c, 0x8a, 0xdb, 0xc7,
-	0x11, 0xb6, 0x69, 0xe4, 0xb0, 0xc6, 0x4e, 0x7b, 0xb7, 0x53, 0xec, 0xdd, 0x37, 0x6e, 0x0e, 0xd3,
-	0xd8, 0x68, 0xcb, 0x14, 0xfa, 0x7e, 0x21, 0x69, 0xc1, 0x2c, 0xb9, 0xeb, 0x23, 0xa6, 0x7f, 0x97,
-	0x41, 0x7d, 0x8b, 0x56, 0x31, 0xc0, 0x41, 0x7a, 0x17, 0x71, 0x04, 0x40, 0xa1, 0x5f, 0xbf, 0x5c,
-	0x68, 0x95, 0xb4, 0xc7, 0x3c, 0xa3, 0x9b, 0xfb, 0xe2, 0x77, 0xe8, 0x28, 0xcf, 0x41, 0xd9, 0x8e,
-	0xa3, 0x08, 0x07, 0x3c, 0xc7, 0xde, 0x11, 0xd8, 0x77, 0xfe, 0x88, 0x6d, 0x1e, 0x66, 0xa6, 0x8c,
-	0xf0, 0x05, 0xa8, 0xd9, 0xb1, 0x1f, 0x7b, 0x28, 0x81, 0xc8, 0x0b, 0xed, 0xfe, 0x4b, 0xa1, 0xea,
-	0xda, 0x97, 0xd6, 0x3a, 0x2e, 0xfc, 0xfc, 0xac, 0xc9, 0xfa, 0x85, 0x0c, 0xf6, 0x06, 0xc9, 0xe2,
-	0x29, 0xf7, 0x40, 0x59, 0x6c, 0xe0, 0x78, 0x93, 0xcb, 0x2c, 0x89, 0xd3, 0x41, 0xc6, 0xf1, 0x16,
-	0xd4, 0x49, 0x36, 0x8e, 0x5c, 0x18, 0x4c, 0xa8, 0x80, 0x29, 0xf6, 0x8e, 0xfe, 0x3a, 0xe9, 0x64,
-	0x01, 0xb2, 0x39, 0xd7, 0xc8, 0x8d, 0xcd, 0x78, 0x09, 0xaa, 0x2c, 0xf4, 0x08, 0xe7, 0x24, 0x70,
-	0xc7, 0x21, 0xf5, 0x88, 0x3d, 0x17, 0x74, 0xe5, 0xde, 0xdd, 0x6d, 0x95, 0x5f, 0xe5, 0xda, 0x13,
-	0x21, 0x35, 0x2b, 0x6c, 0xf3, 0x40, 0xef, 0x80, 0xe2, 0xb3, 0x08, 0x23, 0x8e, 0x53, 0xc2, 0x26,
-	0x38, 0x08, 0x29, 0xf5, 0xc6, 0xc4, 0x61, 0x0d, 0xb9, 0xbd, 0xdb, 0x29, 0x98, 0xfb, 0x49, 0x3c,
-	0x74, 0x98, 0xfe, 0x01, 0x54, 0x84, 0x86, 0x8d, 0x08, 0x9f, 0x8a, 0x86, 0x94, 0x27, 0x60, 0x4f,
-	0x90, 0x8b, 0x31, 0x14, 0x7b, 0xcd, 0x6d, 0x1d, 0x08, 0x4f, 0xc6, 0x93, 0xaa, 0x85, 0x2d, 0xf1,
-	0x67, 0x23, 0xd9, 0x6e, 0x4b, 0x04, 0xbf, 0x6d, 0x49, 0xf0, 0xe0, 0x08, 0x54, 0xae, 0xe1, 0x28,
-	0x25, 0x70, 0xd0, 0x9f, 0xbf, 0xa6, 0x5e, 0xec, 0xe3, 0xaa, 0xd4, 0x2a, 0x7c, 0xfc, 0xa2, 0x4a,
-	0xfd, 0x93, 0xb3, 0xa5, 0x2a, 0x9f, 0x2f, 0x55, 0xf9, 0xc7, 0x52, 0x95, 0x3f, 0xad, 0x54, 0xe9,
-	0x7c, 0xa5, 0x4a, 0xdf, 0x56, 0xaa, 0xf4, 0xe6, 0xa9, 0x4b, 0xf8, 0x34, 0xb6, 0x0c, 0x9b, 0xfa,
-	0x30, 0xbb, 0xf2, 0xa1, 0x87, 0x2c, 0x96, 0x07, 0x70, 0xd6, 0xeb, 0xc2, 0xf7, 0x57, 0x5f, 0x2a,
-	0x9f, 0x87, 0x98, 0x59, 0xff, 0x89, 0xa7, 0xfa, 0xe8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45,
-	0x9c, 0x39, 0xb2, 0x92, 0x04, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0xb4, 0xdb, 0x94, 0x96, 0x4d, 0xda, 0xa4, 0x0e, 0x48, 0x69, 0x25, 0xec, 0xc8, 0x80, 0x88,
+	0x90, 0xf0, 0x2a, 0xe1, 0xa3, 0x52, 0x8f, 0x06, 0x29, 0x0a, 0x07, 0x54, 0x19, 0x89, 0x48, 0x70,
+	0x88, 0xd6, 0xf6, 0xc6, 0x59, 0xd5, 0xf6, 0x5a, 0xde, 0x75, 0x20, 0x27, 0xae, 0x1c, 0xf9, 0x09,
+	0x48, 0xfc, 0x11, 0x8e, 0x3d, 0xf6, 0x88, 0x2a, 0x11, 0xa1, 0xe4, 0xc2, 0xb9, 0xbf, 0x00, 0x79,
+	0x6d, 0x93, 0xa6, 0x8d, 0x5a, 0x4e, 0xf6, 0xdb, 0x37, 0xf3, 0x76, 0x66, 0xf4, 0x16, 0xa8, 0x94,
+	0x05, 0x94, 0x11, 0x06, 0x49, 0xe8, 0xe0, 0x90, 0x93, 0x31, 0x66, 0xd0, 0x8b, 0x69, 0x12, 0x19,
+	0x51, 0x4c, 0x39, 0x55, 0x94, 0xbc, 0x6f, 0x2c, 0xfa, 0xfb, 0x77, 0x3c, 0xea, 0x51, 0xd1, 0x86,
+	0xe9, 0x5f, 0x86, 0xdc, 0x57, 0x3d, 0x4a, 0x3d, 0x1f, 0x43, 0x51, 0xd9, 0xc9, 0x10, 0xba, 0x49,
+	0x8c, 0x38, 0xa1, 0x61, 0xde, 0xd7, 0x2e, 0xf7, 0x39, 0x09, 0x30, 0xe3, 0x28, 0x88, 0x8a, 0x01,
+	0x8e, 0xb8, 0x0b, 0xda, 0x88, 0x61, 0x38, 0x6e, 0xdb, 0x98, 0xa3, 0x36, 0x74, 0x28, 0x29, 0x06,
+	0xec, 0x15, 0x52, 0x7d, 0xea, 0x1c, 0x27, 0x91, 0xf8, 0x14, 0xd4, 0x55, 0x2e, 0x50, 0xe2, 0xe1,
+	0xac, 0xaf, 0xff, 0x90, 0xc1, 0x6e, 0x2f, 0xe4, 0x38, 0x0e, 0x91, 0xdf, 0x4d, 0xcf, 0x7b, 0xe1,
+	0x90, 0x2a, 0x7d, 0x50, 0xe1, 0x94, 0x23, 0x7f, 0xf0, 0x11, 0x13, 0x6f, 0xc4, 0x1b, 0x72, 0x53,
+	0x6e, 0xdd, 0x36, 0x9f, 0x9d, 0x4c, 0x35, 0xe9, 0x6c, 0xaa, 0xdd, 0xcd, 0xe4, 0x30, 0xf7, 0xd8,
+	0x20, 0x14, 0x06, 0x88, 0x8f, 0x8c, 0x5e, 0xc8, 0xcf, 0xa7, 0x5a, 0x7d, 0x82, 0x02, 0xff, 0x50,
+	0xbf, 0x48, 0xd5, 0xad, 0xb2, 0x28, 0xfb, 0xa2, 0x52, 0x2c, 0xb0, 0x2d, 0x6e, 0x1f, 0xc4, 0xd8,
+	0xa1, 0xb1, 0xcb, 0x1a, 0x6b, 0xcd, 0xf5, 0x56, 0xb9, 0xf3, 0xc8, 0xb8, 0x1a, 0xa6, 0xb1, 0x24,
+	0xcb, 0x12, 0x78, 0xb3, 0x94, 0x4a, 0xb0, 0x2a, 0xde, 0xe2, 0x88, 0xe9, 0xbf, 0x64, 0x50, 0x5f,
+	0x81, 0x55, 0x0c, 0xb0, 0x95, 0xdd, 0x45, 0x5c, 0x61, 0xa0, 0x64, 0xd6, 0xcf, 0xa7, 0x5a, 0x35,
+	0xd3, 0x58, 0x74, 0x74, 0x6b, 0x53, 0xfc, 0xf6, 0x5c, 0xe5, 0x15, 0xd8, 0x71, 0x92, 0x38, 0xc6,
+	0x21, 0x2f, 0x6c, 0xaf, 0x09, 0xdb, 0xf7, 0xae, 0xb5, 0x6d, 0x6d, 0xe7, 0xa4, 0xdc, 0xe1, 0x6b,
+	0xb0, 0xeb, 0x24, 0x41, 0xe2, 0xa3, 0xd4, 0x44, 0x31, 0x68, 0xfd, 0x7f, 0x06, 0xd5, 0x16, 0xbc,
+	0x6c, 0xd6, 0x61, 0xe9, 0xcf, 0x37, 0x4d, 0xd6, 0xcf, 0x64, 0xb0, 0xd1, 0x4d, 0x17, 0x4f, 0x79,
+	0x00, 0x76, 0xc4, 0x06, 0x0e, 0x96, 0x7d, 0x59, 0x15, 0x71, 0xda, 0xcd, 0x7d, 0x7c, 0x00, 0x75,
+	0x92, 0xc7, 0x51, 0x00, 0xc3, 0x21, 0x15, 0x66, 0xca, 0x9d, 0x87, 0x37, 0x26, 0x9d, 0x2e, 0x40,
+	0x9e, 0xf3, 0x2e, 0xb9, 0xb2, 0x19, 0x6f, 0x40, 0x8d, 0x45, 0x3e, 0xe1, 0x9c, 0x84, 0xde, 0x20,
+	0xa2, 0x3e, 0x71, 0x26, 0xc2, 0xdd, 0x4e, 0xe7, 0xfe, 0xaa, 0xc9, 0x6f, 0x0b, 0xec, 0x91, 0x80,
+	0x5a, 0x55, 0xb6, 0x7c, 0xa0, 0x23, 0x50, 0x7e, 0x19, 0x63, 0xc4, 0x71, 0xe6, 0x70, 0x0f, 0x6c,
+	0x45, 0x94, 0xfa, 0x03, 0xe2, 0xb2, 0x86, 0xdc, 0x5c, 0x6f, 0x95, 0xac, 0xcd, 0xb4, 0xee, 0xb9,
+	0x4c, 0x39, 0x00, 0x9b, 0x59, 0x9a, 0xd9, 0xd2, 0xdc, 0x18, 0x67, 0x81, 0xd6, 0x3f, 0x83, 0xaa,
+	0x18, 0xce, 0xfa, 0x84, 0x8f, 0x84, 0x13, 0xe5, 0x39, 0xd8, 0x10, 0x91, 0x89, 0xfc, 0xca, 0x9d,
+	0xbd, 0x55, 0xd2, 0x05, 0x27, 0x0f, 0x22, 0x43, 0x0b, 0x5a, 0xca, 0x6f, 0xac, 0x5d, 0x43, 0x4b,
+	0x01, 0xff, 0x68, 0x69, 0xf1, 0xf8, 0x00, 0x54, 0x2f, 0xe5, 0xa0, 0x54, 0xc0, 0x96, 0x39, 0x79,
+	0x47, 0xfd, 0x24, 0xc0, 0x35, 0x49, 0xa9, 0x81, 0x8a, 0x39, 0xe9, 0xd2, 0x71, 0x9a, 0x75, 0xe8,
+	0xe0, 0x9a, 0xbc, 0x5f, 0xfa, 0xf2, 0x5d, 0x95, 0xcc, 0xa3, 0x93, 0x99, 0x2a, 0x9f, 0xce, 0x54,
+	0xf9, 0xf7, 0x4c, 0x95, 0xbf, 0xce, 0x55, 0xe9, 0x74, 0xae, 0x4a, 0x3f, 0xe7, 0xaa, 0xf4, 0xfe,
+	0x85, 0x47, 0xf8, 0x28, 0xb1, 0x0d, 0x87, 0x06, 0x30, 0x17, 0xf1, 0xc4, 0x47, 0x36, 0x2b, 0x0a,
+	0x38, 0xee, 0xb4, 0xe1, 0xa7, 0x8b, 0x8f, 0x9e, 0x4f, 0x22, 0xcc, 0xec, 0x5b, 0xe2, 0xd5, 0x3f,
+	0xfd, 0x3b, 0x00, 0xd1, 0x10, 0xc0, 0xe5, 0xdd, 0x04, 0x00, 0x00,
 }
 
 func (this *InternalGaugeRecord) Equal(that interface{}) bool {
@@ -560,6 +569,20 @@ func (m *CreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Weights[iNdEx].Size()
+				i -= size
+				if _, err := m.Weights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGroup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.PoolIds) > 0 {
 		dAtA3 := make([]byte, len(m.PoolIds)*10)
 		var j2 int
@@ -698,6 +721,12 @@ func (m *CreateGroup) Size() (n int) {
 		}
 		n += 1 + sovGroup(uint64(l)) + l
 	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovGroup(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1201,6 +1230,42 @@ func (m *CreateGroup) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Weights = append(m.Weights, v)
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGroup(dAtA[iNdEx:])
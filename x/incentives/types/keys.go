@@ -48,6 +48,15 @@ var (
 	// KeyPrefixGroup defines prefix key for storing groups.
 	KeyPrefixGroup = []byte{0x08}
 
+	// KeyPrefixUpcomingGaugesByDenom defines prefix key for storing reference key for upcoming gauges by denom and start time.
+	KeyPrefixUpcomingGaugesByDenom = []byte{0x09, 0x00}
+
+	// KeyPrefixActiveGaugesByDenom defines prefix key for storing reference key for active gauges by denom and start time.
+	KeyPrefixActiveGaugesByDenom = []byte{0x09, 0x01}
+
+	// KeyParams defines key to store the incentives module parameters.
+	KeyParams = []byte{0x0A}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 
@@ -12,9 +12,10 @@ import (
 )
 
 const (
-	TypeMsgCreateGauge = "create_gauge"
-	TypeMsgAddToGauge  = "add_to_gauge"
-	TypeMsgCreateGroup = "create_group"
+	TypeMsgCreateGauge  = "create_gauge"
+	TypeMsgAddToGauge   = "add_to_gauge"
+	TypeMsgCreateGroup  = "create_group"
+	TypeMsgUpdateParams = "update_params"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -195,3 +196,39 @@ func (m MsgCreateGroup) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a message to set the incentives parameters.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route takes an update params message, then returns the RouterKey.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type takes an update params message, then returns the message type.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic checks that the update params message is valid.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes takes an update params message and turns it into a byte array.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes an update params message and returns the authority in a byte array.
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -13,9 +13,11 @@ import (
 
 // Incentives parameters key store.
 var (
-	KeyDistrEpochIdentifier = []byte("DistrEpochIdentifier")
-	KeyGroupCreationFee     = []byte("GroupCreationFee")
-	KeyCreatorWhitelist     = []byte("CreatorWhitelist")
+	KeyDistrEpochIdentifier    = []byte("DistrEpochIdentifier")
+	KeyGroupCreationFee        = []byte("GroupCreationFee")
+	KeyCreatorWhitelist        = []byte("CreatorWhitelist")
+	KeyGaugeCreationFee        = []byte("GaugeCreationFee")
+	KeyMinValueForDistribution = []byte("MinValueForDistribution")
 
 	// 100 OSMO
 	DefaultGroupCreationFee = sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100_000_000)))
@@ -32,6 +34,8 @@ func NewParams(distrEpochIdentifier string, groupCreationFee sdk.Coins) Params {
 		DistrEpochIdentifier:         distrEpochIdentifier,
 		GroupCreationFee:             groupCreationFee,
 		UnrestrictedCreatorWhitelist: []string{},
+		GaugeCreationFee:             sdk.Coins{},
+		MinValueForDistribution:      sdk.Coins{},
 	}
 }
 
@@ -41,6 +45,8 @@ func DefaultParams() Params {
 		DistrEpochIdentifier:         "week",
 		GroupCreationFee:             DefaultGroupCreationFee,
 		UnrestrictedCreatorWhitelist: []string{},
+		GaugeCreationFee:             sdk.Coins{},
+		MinValueForDistribution:      sdk.Coins{},
 	}
 }
 
@@ -58,6 +64,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateGaugeCreationFee(p.GaugeCreationFee); err != nil {
+		return err
+	}
+
+	if err := ValidateMinValueForDistribution(p.MinValueForDistribution); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -77,11 +91,29 @@ func ValidateGroupCreationFee(i interface{}) error {
 	return v.Validate()
 }
 
+func ValidateGaugeCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func ValidateMinValueForDistribution(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
 // ParamSetPairs takes the parameter struct and associates the paramsubspace key and field of the parameters as a KVStore.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistrEpochIdentifier, &p.DistrEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyGroupCreationFee, &p.GroupCreationFee, ValidateGroupCreaionFee),
 		paramtypes.NewParamSetPair(KeyCreatorWhitelist, &p.UnrestrictedCreatorWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyGaugeCreationFee, &p.GaugeCreationFee, ValidateGaugeCreationFee),
+		paramtypes.NewParamSetPair(KeyMinValueForDistribution, &p.MinValueForDistribution, ValidateMinValueForDistribution),
 	}
 }
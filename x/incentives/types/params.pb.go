@@ -43,6 +43,19 @@ type Params struct {
 	// At the same time, it prevents spam by having a fee for all
 	// other users.
 	UnrestrictedCreatorWhitelist []string `protobuf:"bytes,3,rep,name=unrestricted_creator_whitelist,json=unrestrictedCreatorWhitelist,proto3" json:"unrestricted_creator_whitelist,omitempty" yaml:"unrestricted_creator_whitelist"`
+	// gauge_creation_fee is the fee required to create a new gauge, charged
+	// once per reward denom of the gauge, in addition to the base gauge
+	// creation fee. It is sent to the community pool, and is not charged to the
+	// incentive module account or addresses in the
+	// unrestricted_creator_whitelist.
+	GaugeCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_creation_fee" yaml:"gauge_creation_fee"`
+	// min_value_for_distribution is the minimum amount of each listed denom a
+	// gauge must distribute in an epoch. Coins whose distribution for the epoch
+	// is below their minimum are skipped and stay in the gauge. On the last
+	// distribution epoch of a non perpetual gauge, the skipped coins are
+	// returned to the community pool. Denoms that are not listed have no
+	// minimum.
+	MinValueForDistribution github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_value_for_distribution,json=minValueForDistribution,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_value_for_distribution" yaml:"min_value_for_distribution"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -99,6 +112,20 @@ func (m *Params) GetUnrestrictedCreatorWhitelist() []string {
 	return nil
 }
 
+func (m *Params) GetGaugeCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMinValueForDistribution() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinValueForDistribution
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -106,30 +133,36 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x93, 0x16, 0x0a, 0xcd, 0xb7, 0xf9, 0x08, 0x45, 0x6a, 0xd1, 0x49, 0x0d, 0x08, 0x71,
-	0xd1, 0x8c, 0xad, 0xe0, 0xc2, 0x65, 0x8a, 0x82, 0xbb, 0xd2, 0x4d, 0xc1, 0x4d, 0xc8, 0x9f, 0xdb,
-	0x74, 0xb0, 0xc9, 0x84, 0x99, 0x69, 0xb5, 0x6f, 0xe1, 0x73, 0xf8, 0x24, 0xdd, 0x08, 0x5d, 0xba,
-	0xaa, 0xd2, 0xbe, 0x41, 0x9f, 0x40, 0x32, 0x93, 0x6a, 0x17, 0xe2, 0x6a, 0x66, 0xee, 0xf9, 0xcd,
-	0xbd, 0xe7, 0x70, 0x0d, 0x8b, 0xf2, 0x94, 0x72, 0xc2, 0x31, 0xc9, 0x22, 0xc8, 0x04, 0x99, 0x03,
-	0xc7, 0x79, 0xc0, 0x82, 0x94, 0xbb, 0x39, 0xa3, 0x82, 0x9a, 0x66, 0x09, 0xb8, 0x3f, 0x40, 0xab,
-	0x91, 0xd0, 0x84, 0x4a, 0x19, 0x17, 0x37, 0x45, 0xb6, 0x50, 0x24, 0x51, 0x1c, 0x06, 0x1c, 0xf0,
-	0xbc, 0x1b, 0x82, 0x08, 0xba, 0x38, 0xa2, 0x24, 0x53, 0xba, 0xfd, 0x56, 0x31, 0x6a, 0x03, 0xd9,
-	0xda, 0x1c, 0x19, 0x47, 0x31, 0xe1, 0x82, 0xf9, 0x90, 0xd3, 0x68, 0xe2, 0x93, 0xb8, 0xe8, 0x3c,
-	0x26, 0xc0, 0x9a, 0x7a, 0x5b, 0x77, 0xea, 0xde, 0xd9, 0x6e, 0x6d, 0x9d, 0x2e, 0x82, 0x74, 0x7a,
-	0x63, 0xff, 0xce, 0xd9, 0xc3, 0x86, 0x14, 0x6e, 0x8b, 0xfa, 0xfd, 0x77, 0xd9, 0x5c, 0x18, 0x66,
-	0xc2, 0xe8, 0x2c, 0xf7, 0x23, 0x06, 0x81, 0x20, 0x34, 0xf3, 0xc7, 0x00, 0xcd, 0x4a, 0xbb, 0xea,
-	0xfc, 0xeb, 0x1d, 0xbb, 0xca, 0xa0, 0x5b, 0x18, 0x74, 0x4b, 0x83, 0x6e, 0x9f, 0x92, 0xcc, 0xbb,
-	0x5c, 0xae, 0x2d, 0xed, 0xf5, 0xc3, 0x72, 0x12, 0x22, 0x26, 0xb3, 0xd0, 0x8d, 0x68, 0x8a, 0xcb,
-	0x34, 0xea, 0xe8, 0xf0, 0xf8, 0x11, 0x8b, 0x45, 0x0e, 0x5c, 0x7e, 0xe0, 0xc3, 0xff, 0x72, 0x4c,
-	0xbf, 0x9c, 0x72, 0x07, 0x60, 0x52, 0x03, 0xcd, 0x32, 0x06, 0x5c, 0x30, 0x12, 0x09, 0x88, 0x95,
-	0x03, 0xca, 0xfc, 0xa7, 0x09, 0x11, 0x30, 0x25, 0x5c, 0x34, 0xab, 0xed, 0xaa, 0x53, 0xf7, 0x2e,
-	0x76, 0x6b, 0xeb, 0x5c, 0x65, 0xfb, 0x9b, 0xb7, 0x87, 0x27, 0x87, 0x40, 0x5f, 0xe9, 0xa3, 0xbd,
-	0xec, 0x0d, 0x96, 0x1b, 0xa4, 0xaf, 0x36, 0x48, 0xff, 0xdc, 0x20, 0xfd, 0x65, 0x8b, 0xb4, 0xd5,
-	0x16, 0x69, 0xef, 0x5b, 0xa4, 0x3d, 0x5c, 0x1f, 0xc4, 0x28, 0xd7, 0xd7, 0x99, 0x06, 0x21, 0xdf,
-	0x3f, 0xf0, 0xbc, 0xd7, 0xc5, 0xcf, 0x87, 0x2b, 0x97, 0xd1, 0xc2, 0x9a, 0x5c, 0xd4, 0xd5, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xaa, 0xbf, 0x8b, 0x15, 0x02, 0x00, 0x00,
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0x42, 0x2b, 0xd5, 0x6c, 0x90, 0x55, 0x41, 0x1a, 0x81, 0x9d, 0x5a, 0x42, 0x0a,
+	0x8b, 0x7a, 0x48, 0x91, 0x58, 0xb0, 0x4c, 0xa0, 0x12, 0x0b, 0xa4, 0x2a, 0x12, 0x54, 0x62, 0x63,
+	0x8d, 0x9d, 0x1b, 0xe7, 0x8a, 0xd8, 0xd7, 0x9a, 0x19, 0x07, 0xf2, 0x16, 0xac, 0xfa, 0x10, 0x48,
+	0xbc, 0x47, 0x97, 0x5d, 0xb2, 0x0a, 0x28, 0x79, 0x83, 0x3c, 0x01, 0x9a, 0x19, 0x17, 0x8c, 0xf8,
+	0xa9, 0xba, 0xb2, 0x3d, 0xe7, 0xf8, 0xdc, 0xef, 0x8c, 0x3d, 0x6e, 0x40, 0x32, 0x27, 0x89, 0x92,
+	0x61, 0x91, 0x42, 0xa1, 0x70, 0x01, 0x92, 0x95, 0x5c, 0xf0, 0x5c, 0x46, 0xa5, 0x20, 0x45, 0x9e,
+	0x57, 0x1b, 0xa2, 0x5f, 0x86, 0xee, 0x7e, 0x46, 0x19, 0x19, 0x99, 0xe9, 0x3b, 0xeb, 0xec, 0xfa,
+	0xa9, 0xb1, 0xb2, 0x84, 0x4b, 0x60, 0x8b, 0x41, 0x02, 0x8a, 0x0f, 0x58, 0x4a, 0x58, 0x58, 0x3d,
+	0x3c, 0xdf, 0x71, 0x77, 0x4f, 0x4d, 0xb4, 0x77, 0xe6, 0xde, 0x9b, 0xa0, 0x54, 0x22, 0x86, 0x92,
+	0xd2, 0x59, 0x8c, 0x13, 0x9d, 0x3c, 0x45, 0x10, 0x1d, 0xa7, 0xe7, 0xf4, 0xf7, 0x86, 0x87, 0xdb,
+	0x55, 0xf0, 0x70, 0xc9, 0xf3, 0xf9, 0xf3, 0xf0, 0xef, 0xbe, 0x70, 0xbc, 0x6f, 0x84, 0x97, 0x7a,
+	0xfd, 0xd5, 0xcf, 0x65, 0x6f, 0xe9, 0x7a, 0x99, 0xa0, 0xaa, 0x8c, 0x53, 0x01, 0x5c, 0x21, 0x15,
+	0xf1, 0x14, 0xa0, 0x73, 0xab, 0xd7, 0xee, 0xdf, 0x39, 0x3e, 0x88, 0x2c, 0x60, 0xa4, 0x01, 0xa3,
+	0x1a, 0x30, 0x1a, 0x11, 0x16, 0xc3, 0x27, 0x17, 0xab, 0xa0, 0xf5, 0xf9, 0x5b, 0xd0, 0xcf, 0x50,
+	0xcd, 0xaa, 0x24, 0x4a, 0x29, 0x67, 0x75, 0x1b, 0x7b, 0x39, 0x92, 0x93, 0xf7, 0x4c, 0x2d, 0x4b,
+	0x90, 0xe6, 0x05, 0x39, 0xbe, 0x6b, 0xc6, 0x8c, 0xea, 0x29, 0x27, 0x00, 0x1e, 0xb9, 0x7e, 0x55,
+	0x08, 0x90, 0x4a, 0x60, 0xaa, 0x60, 0x62, 0x09, 0x48, 0xc4, 0x1f, 0x66, 0xa8, 0x60, 0x8e, 0x52,
+	0x75, 0xda, 0xbd, 0x76, 0x7f, 0x6f, 0xf8, 0x78, 0xbb, 0x0a, 0x1e, 0xd9, 0x6e, 0xff, 0xf7, 0x87,
+	0xe3, 0x07, 0x4d, 0xc3, 0xc8, 0xea, 0x67, 0x57, 0xb2, 0x77, 0xee, 0xb8, 0x5e, 0xc6, 0xab, 0x0c,
+	0x7e, 0x2f, 0x7b, 0xfb, 0xba, 0xb2, 0xaf, 0x75, 0xd9, 0xed, 0x2a, 0x38, 0xb0, 0x10, 0x7f, 0x46,
+	0x84, 0x37, 0xdc, 0x09, 0x1d, 0xd0, 0xdc, 0x89, 0x2f, 0x8e, 0xdb, 0xcd, 0xb1, 0x88, 0x17, 0x7c,
+	0x5e, 0x41, 0x3c, 0x25, 0x11, 0x9b, 0x6f, 0x85, 0x49, 0xa5, 0x1d, 0x9d, 0x9d, 0xeb, 0x00, 0xdf,
+	0xd4, 0x80, 0x87, 0x16, 0xf0, 0xdf, 0x51, 0x37, 0x03, 0xbd, 0x9f, 0x63, 0xf1, 0x56, 0xe7, 0x9c,
+	0x90, 0x78, 0xd1, 0x48, 0x19, 0x9e, 0x5e, 0xac, 0x7d, 0xe7, 0x72, 0xed, 0x3b, 0xdf, 0xd7, 0xbe,
+	0xf3, 0x69, 0xe3, 0xb7, 0x2e, 0x37, 0x7e, 0xeb, 0xeb, 0xc6, 0x6f, 0xbd, 0x7b, 0xd6, 0x08, 0xaf,
+	0xcf, 0xc1, 0xd1, 0x9c, 0x27, 0xf2, 0xea, 0x81, 0x2d, 0x8e, 0x07, 0xec, 0x63, 0xf3, 0xec, 0x98,
+	0x81, 0xc9, 0xae, 0xf9, 0xe3, 0x9f, 0xfe, 0x18, 0x00, 0x95, 0x71, 0x99, 0x9b, 0x5e, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -152,6 +185,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinValueForDistribution) > 0 {
+		for iNdEx := len(m.MinValueForDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinValueForDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for iNdEx := len(m.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.UnrestrictedCreatorWhitelist) > 0 {
 		for iNdEx := len(m.UnrestrictedCreatorWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.UnrestrictedCreatorWhitelist[iNdEx])
@@ -218,6 +279,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.GaugeCreationFee) > 0 {
+		for _, e := range m.GaugeCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MinValueForDistribution) > 0 {
+		for _, e := range m.MinValueForDistribution {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -354,6 +427,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.UnrestrictedCreatorWhitelist = append(m.UnrestrictedCreatorWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeCreationFee = append(m.GaugeCreationFee, types.Coin{})
+			if err := m.GaugeCreationFee[len(m.GaugeCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValueForDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinValueForDistribution = append(m.MinValueForDistribution, types.Coin{})
+			if err := m.MinValueForDistribution[len(m.MinValueForDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -403,6 +404,97 @@ func (m *MsgCreateGroupResponse) GetGroupId() uint64 {
 	return 0
 }
 
+// MsgUpdateParams sets all the module parameters, executed by governance.
+type MsgUpdateParams struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// params are the new incentives module parameters, all of them must be set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
@@ -410,58 +502,68 @@ func init() {
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
 	proto.RegisterType((*MsgCreateGroup)(nil), "osmosis.incentives.MsgCreateGroup")
 	proto.RegisterType((*MsgCreateGroupResponse)(nil), "osmosis.incentives.MsgCreateGroupResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "osmosis.incentives.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "osmosis.incentives.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc7, 0xe3, 0x24, 0x10, 0x98, 0xc0, 0x15, 0x58, 0xdc, 0x8b, 0xc9, 0xad, 0x9c, 0x60, 0x55,
-	0x55, 0x8a, 0x94, 0x99, 0x12, 0xa4, 0x2e, 0xd8, 0x35, 0xa8, 0xaa, 0xb2, 0x40, 0xa5, 0x16, 0x52,
-	0x25, 0xa4, 0x2a, 0x9a, 0x64, 0xa6, 0x66, 0x44, 0xec, 0xb1, 0x3c, 0xe3, 0x00, 0xaf, 0x50, 0xa9,
-	0x12, 0x6f, 0xd0, 0x7d, 0x57, 0x7d, 0x0c, 0x96, 0xa8, 0xab, 0xae, 0xa0, 0x82, 0x45, 0xf7, 0x3c,
-	0x41, 0x35, 0x63, 0x3b, 0x1f, 0x2a, 0x69, 0x36, 0xed, 0x26, 0xce, 0x9c, 0xaf, 0x9c, 0x73, 0x7e,
-	0xff, 0x8c, 0xc1, 0xff, 0x5c, 0xf8, 0x5c, 0x30, 0x81, 0x58, 0xd0, 0xa3, 0x81, 0x64, 0x03, 0x2a,
-	0x90, 0x3c, 0x83, 0x61, 0xc4, 0x25, 0x37, 0xcd, 0xd4, 0x09, 0x47, 0xce, 0xca, 0x9a, 0xc7, 0x3d,
-	0xae, 0xdd, 0x48, 0x7d, 0x4b, 0x22, 0x2b, 0xab, 0xd8, 0x67, 0x01, 0x47, 0xfa, 0x33, 0x35, 0x55,
-	0x3d, 0xce, 0xbd, 0x3e, 0x45, 0xfa, 0xd4, 0x8d, 0xdf, 0x23, 0xc9, 0x7c, 0x2a, 0x24, 0xf6, 0xc3,
-	0x34, 0xc0, 0xee, 0xe9, 0xf2, 0xa8, 0x8b, 0x05, 0x45, 0x83, 0xed, 0x2e, 0x95, 0x78, 0x1b, 0xf5,
-	0x38, 0x0b, 0x32, 0xff, 0x03, 0xad, 0x79, 0x38, 0xf6, 0x68, 0xea, 0xdf, 0xc8, 0xfc, 0x7d, 0xde,
-	0x3b, 0x89, 0x43, 0xfd, 0x48, 0x5c, 0xce, 0xd7, 0x02, 0xf8, 0x67, 0x5f, 0x78, 0x7b, 0x11, 0xc5,
-	0x92, 0xbe, 0x52, 0x39, 0xe6, 0x26, 0x58, 0x62, 0xa2, 0x13, 0xd2, 0x28, 0xa4, 0x32, 0xc6, 0x7d,
-	0xcb, 0xa8, 0x19, 0xf5, 0x05, 0xb7, 0xcc, 0xc4, 0x41, 0x66, 0x32, 0x9f, 0x80, 0x39, 0x7e, 0x1a,
-	0xd0, 0xc8, 0xca, 0xd7, 0x8c, 0xfa, 0x62, 0x6b, 0xe5, 0xfe, 0xba, 0xba, 0x74, 0x8e, 0xfd, 0xfe,
-	0xae, 0xa3, 0xcd, 0x8e, 0x9b, 0xb8, 0xcd, 0x36, 0x58, 0x26, 0x4c, 0xc8, 0x88, 0x75, 0x63, 0x49,
-	0x3b, 0x92, 0x5b, 0x85, 0x9a, 0x51, 0x2f, 0x37, 0x6d, 0x98, 0xad, 0x2b, 0x69, 0x08, 0xbe, 0x89,
-	0x69, 0x74, 0xbe, 0xc7, 0x03, 0xc2, 0x24, 0xe3, 0x41, 0xab, 0x78, 0x79, 0x5d, 0xcd, 0xb9, 0x4b,
-	0xa3, 0xd4, 0x43, 0x6e, 0x62, 0x30, 0xa7, 0x26, 0x16, 0x56, 0xb1, 0x56, 0xa8, 0x97, 0x9b, 0x1b,
-	0x30, 0xd9, 0x09, 0x54, 0x3b, 0x81, 0xe9, 0x4e, 0xe0, 0x1e, 0x67, 0x41, 0xeb, 0x99, 0xca, 0xfe,
-	0x7c, 0x53, 0xad, 0x7b, 0x4c, 0x1e, 0xc7, 0x5d, 0xd8, 0xe3, 0x3e, 0x4a, 0x17, 0x98, 0x3c, 0x1a,
-	0x82, 0x9c, 0x20, 0x79, 0x1e, 0x52, 0xa1, 0x13, 0x84, 0x9b, 0x54, 0x36, 0xdf, 0x02, 0x20, 0x24,
-	0x8e, 0x64, 0x47, 0xed, 0xdf, 0x9a, 0xd3, 0xad, 0x56, 0x60, 0x02, 0x07, 0x66, 0x70, 0xe0, 0x61,
-	0x06, 0xa7, 0xf5, 0x48, 0xfd, 0xd0, 0xfd, 0x75, 0x75, 0x25, 0x19, 0x7d, 0x48, 0xcd, 0xb9, 0xb8,
-	0xa9, 0x1a, 0xee, 0xa2, 0xae, 0xa5, 0xa2, 0x4d, 0x04, 0xd6, 0x82, 0xd8, 0xef, 0xd0, 0x90, 0xf7,
-	0x8e, 0x45, 0x27, 0xc4, 0x8c, 0x74, 0xf8, 0x80, 0x46, 0xd6, 0x7c, 0xcd, 0xa8, 0x17, 0xdd, 0xd5,
-	0x20, 0xf6, 0x5f, 0x6a, 0xd7, 0x01, 0x66, 0xe4, 0xf5, 0x80, 0x46, 0xe6, 0x3a, 0x28, 0x85, 0x9c,
-	0xf7, 0x3b, 0x8c, 0x58, 0x25, 0x1d, 0x33, 0xaf, 0x8e, 0x6d, 0xb2, 0xfb, 0xf8, 0xc3, 0x8f, 0x2f,
-	0x5b, 0xd5, 0x07, 0x70, 0xf7, 0x34, 0xc0, 0x86, 0xa6, 0xee, 0x58, 0xe0, 0xbf, 0x49, 0xa6, 0x2e,
-	0x15, 0x21, 0x0f, 0x04, 0x75, 0x6e, 0x0c, 0xb0, 0xbc, 0x2f, 0xbc, 0x17, 0x84, 0x1c, 0xf2, 0x84,
-	0xf6, 0x10, 0xa5, 0xf1, 0x7b, 0x94, 0x1b, 0x60, 0x41, 0x17, 0x57, 0x3d, 0xe5, 0x75, 0x4f, 0x25,
-	0x7d, 0x6e, 0x13, 0x93, 0x82, 0x52, 0x44, 0x4f, 0x71, 0x44, 0x84, 0x55, 0xf8, 0xf3, 0x70, 0xb2,
-	0xda, 0xd3, 0x67, 0xc7, 0x84, 0x34, 0x24, 0x4f, 0x67, 0x5f, 0x07, 0xff, 0x4e, 0x0c, 0x38, 0x1c,
-	0xfd, 0x63, 0x7e, 0x5c, 0xe9, 0x11, 0x8f, 0xc3, 0x91, 0xa6, 0x8c, 0xbf, 0xa6, 0xa9, 0x69, 0xe8,
-	0xf3, 0xd3, 0xd0, 0x0f, 0x79, 0x14, 0x66, 0xf2, 0x48, 0x25, 0x92, 0xfc, 0x25, 0x8a, 0x6e, 0x29,
-	0xd1, 0x88, 0x98, 0x2d, 0x12, 0x35, 0xbc, 0xb3, 0x33, 0x2e, 0x12, 0x65, 0xc9, 0x36, 0xa5, 0x51,
-	0x2b, 0x83, 0x42, 0x6d, 0xa4, 0xa8, 0xd5, 0xb9, 0x4d, 0x9a, 0x9f, 0xf2, 0xa0, 0xb0, 0x2f, 0x3c,
-	0xf3, 0x1d, 0x28, 0x8f, 0x5f, 0x19, 0x0e, 0xfc, 0xf5, 0xfe, 0x83, 0x93, 0x12, 0xac, 0x6c, 0xcd,
-	0x8e, 0x19, 0x76, 0x70, 0x04, 0xc0, 0x98, 0x44, 0x37, 0xa7, 0x64, 0x8e, 0x42, 0x2a, 0x4f, 0x67,
-	0x86, 0x0c, 0x6b, 0x8f, 0x5a, 0xd7, 0x1a, 0x98, 0xd1, 0xba, 0x8a, 0x99, 0xd5, 0xfa, 0xf8, 0xf2,
-	0x5a, 0x07, 0x97, 0xb7, 0xb6, 0x71, 0x75, 0x6b, 0x1b, 0xdf, 0x6f, 0x6d, 0xe3, 0xe2, 0xce, 0xce,
-	0x5d, 0xdd, 0xd9, 0xb9, 0x6f, 0x77, 0x76, 0xee, 0xe8, 0xf9, 0x98, 0x76, 0xd2, 0x7a, 0x8d, 0x3e,
-	0xee, 0x8a, 0xec, 0x80, 0x06, 0xcd, 0x6d, 0x74, 0x36, 0xf1, 0x7a, 0x51, 0x7a, 0xea, 0xce, 0xeb,
-	0xab, 0x67, 0xe7, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x8c, 0x74, 0x75, 0x81, 0x06, 0x00,
-	0x00,
+	// 853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x93, 0xb6, 0xd9, 0x4e, 0x5b, 0xd8, 0xb5, 0xba, 0xd4, 0x0d, 0xc8, 0xc9, 0x9a, 0x3f,
+	0x0a, 0x85, 0xd8, 0x34, 0x2b, 0x71, 0xe8, 0x6d, 0x53, 0x21, 0x14, 0xa4, 0x88, 0x60, 0x8a, 0x90,
+	0x56, 0x42, 0x66, 0x92, 0x19, 0xdc, 0xd1, 0xc6, 0x1e, 0x6b, 0x66, 0x9c, 0xdd, 0x1c, 0xb9, 0x22,
+	0x21, 0xed, 0xe7, 0xe0, 0xc4, 0x81, 0x0f, 0xb1, 0x37, 0x56, 0x7b, 0xe2, 0xd4, 0xa2, 0xf6, 0xc0,
+	0xbd, 0x9f, 0x00, 0xcd, 0x1f, 0x3b, 0x09, 0x24, 0x84, 0x03, 0x5c, 0xea, 0xbe, 0x79, 0xbf, 0xf7,
+	0xe6, 0xbd, 0xf7, 0xfb, 0xbd, 0x09, 0x78, 0x93, 0xf2, 0x84, 0x72, 0xc2, 0x03, 0x92, 0x8e, 0x70,
+	0x2a, 0xc8, 0x04, 0xf3, 0x40, 0x3c, 0xf3, 0x33, 0x46, 0x05, 0xb5, 0x6d, 0xe3, 0xf4, 0x67, 0xce,
+	0xfa, 0x41, 0x4c, 0x63, 0xaa, 0xdc, 0x81, 0xfc, 0x4f, 0x23, 0xeb, 0xf7, 0x60, 0x42, 0x52, 0x1a,
+	0xa8, 0xbf, 0xe6, 0xa8, 0x11, 0x53, 0x1a, 0x8f, 0x71, 0xa0, 0xac, 0x61, 0xfe, 0x5d, 0x20, 0x48,
+	0x82, 0xb9, 0x80, 0x49, 0x66, 0x00, 0xee, 0x48, 0xa5, 0x0f, 0x86, 0x90, 0xe3, 0x60, 0x72, 0x32,
+	0xc4, 0x02, 0x9e, 0x04, 0x23, 0x4a, 0xd2, 0xc2, 0xbf, 0xa4, 0xb4, 0x18, 0xe6, 0x31, 0x36, 0xfe,
+	0xa3, 0xc2, 0x3f, 0xa6, 0xa3, 0x27, 0x79, 0xa6, 0x3e, 0x85, 0x4b, 0xa7, 0x8e, 0x74, 0x9d, 0xda,
+	0x28, 0xca, 0x5a, 0x92, 0x35, 0x83, 0x0c, 0x26, 0x06, 0xe0, 0xbd, 0xaa, 0x82, 0xd7, 0xfa, 0x3c,
+	0x3e, 0x63, 0x18, 0x0a, 0xfc, 0xa9, 0xbc, 0xcf, 0x7e, 0x00, 0xf6, 0x08, 0x8f, 0x32, 0xcc, 0x32,
+	0x2c, 0x72, 0x38, 0x76, 0xac, 0xa6, 0xd5, 0xba, 0x13, 0xee, 0x12, 0x3e, 0x28, 0x8e, 0xec, 0xf7,
+	0xc0, 0x16, 0x7d, 0x9a, 0x62, 0xe6, 0x54, 0x9a, 0x56, 0x6b, 0xa7, 0x7b, 0xf7, 0xf6, 0xb2, 0xb1,
+	0x37, 0x85, 0xc9, 0xf8, 0xd4, 0x53, 0xc7, 0x5e, 0xa8, 0xdd, 0x76, 0x0f, 0xec, 0x23, 0xc2, 0x05,
+	0x23, 0xc3, 0x5c, 0xe0, 0x48, 0x50, 0xa7, 0xda, 0xb4, 0x5a, 0xbb, 0x1d, 0xd7, 0x2f, 0x46, 0xad,
+	0x9b, 0xf1, 0xbf, 0xc8, 0x31, 0x9b, 0x9e, 0xd1, 0x14, 0x11, 0x41, 0x68, 0xda, 0xdd, 0x7c, 0x71,
+	0xd9, 0xd8, 0x08, 0xf7, 0x66, 0xa1, 0xe7, 0xd4, 0x86, 0x60, 0x4b, 0x4e, 0x8b, 0x3b, 0x9b, 0xcd,
+	0x6a, 0x6b, 0xb7, 0x73, 0xe4, 0x9b, 0x3e, 0xe5, 0x3c, 0x7d, 0x33, 0x4f, 0xff, 0x8c, 0x92, 0xb4,
+	0xfb, 0x91, 0x8c, 0xfe, 0xe9, 0xaa, 0xd1, 0x8a, 0x89, 0xb8, 0xc8, 0x87, 0xfe, 0x88, 0x26, 0x66,
+	0x28, 0xe6, 0xd3, 0xe6, 0xe8, 0x49, 0x20, 0xa6, 0x19, 0xe6, 0x2a, 0x80, 0x87, 0x3a, 0xb3, 0xfd,
+	0x35, 0x00, 0x5c, 0x40, 0x26, 0x22, 0xc9, 0x9d, 0xb3, 0xa5, 0x4a, 0xad, 0xfb, 0x9a, 0x58, 0xbf,
+	0x20, 0xd6, 0x3f, 0x2f, 0x88, 0xed, 0xbe, 0x25, 0x2f, 0xba, 0xbd, 0x6c, 0xdc, 0xd5, 0xad, 0x97,
+	0x8c, 0x7b, 0xcf, 0xaf, 0x1a, 0x56, 0xb8, 0xa3, 0x72, 0x49, 0xb4, 0x1d, 0x80, 0x83, 0x34, 0x4f,
+	0x22, 0x9c, 0xd1, 0xd1, 0x05, 0x8f, 0x32, 0x48, 0x50, 0x44, 0x27, 0x98, 0x39, 0xdb, 0x4d, 0xab,
+	0xb5, 0x19, 0xde, 0x4b, 0xf3, 0xe4, 0x13, 0xe5, 0x1a, 0x40, 0x82, 0x3e, 0x9f, 0x60, 0x66, 0x1f,
+	0x82, 0x5a, 0x46, 0xe9, 0x38, 0x22, 0xc8, 0xa9, 0x29, 0xcc, 0xb6, 0x34, 0x7b, 0xe8, 0xf4, 0x9d,
+	0x1f, 0xfe, 0xf8, 0xf9, 0x78, 0x19, 0xa9, 0x23, 0x45, 0x60, 0x5b, 0x29, 0xc6, 0x73, 0xc0, 0x1b,
+	0x8b, 0x9c, 0x86, 0x98, 0x67, 0x34, 0xe5, 0xd8, 0xbb, 0xb2, 0xc0, 0x7e, 0x9f, 0xc7, 0x8f, 0x10,
+	0x3a, 0xa7, 0x9a, 0xed, 0x92, 0x4a, 0xeb, 0x9f, 0xa9, 0x3c, 0x02, 0x77, 0x54, 0x72, 0x59, 0x53,
+	0x45, 0xd5, 0x54, 0x53, 0x76, 0x0f, 0xd9, 0x18, 0xd4, 0x18, 0x7e, 0x0a, 0x19, 0xe2, 0x4e, 0xf5,
+	0xbf, 0x27, 0xa7, 0xc8, 0xbd, 0xba, 0x77, 0x88, 0x50, 0x5b, 0x50, 0xd3, 0xfb, 0x21, 0xb8, 0xbf,
+	0xd0, 0x60, 0xd9, 0xfa, 0x8f, 0x95, 0x79, 0xa5, 0x33, 0x9a, 0x67, 0x33, 0x4d, 0x59, 0xff, 0x9b,
+	0xa6, 0x56, 0x51, 0x5f, 0x59, 0x45, 0x7d, 0xc9, 0x47, 0x75, 0x2d, 0x1f, 0x46, 0x22, 0x7a, 0x25,
+	0x36, 0xc3, 0x9a, 0xd6, 0x08, 0x5f, 0x2f, 0x12, 0xd9, 0xbc, 0xf7, 0x70, 0x5e, 0x24, 0xf2, 0xa4,
+	0x98, 0x94, 0xa2, 0x5a, 0x1e, 0x48, 0xaa, 0x2d, 0x43, 0xb5, 0xb4, 0x7b, 0xc8, 0xfb, 0xd5, 0x02,
+	0xaf, 0xf7, 0x79, 0xfc, 0x55, 0x86, 0xa0, 0xc0, 0x03, 0xf5, 0x90, 0xd8, 0x9f, 0x81, 0x1d, 0x98,
+	0x8b, 0x0b, 0xca, 0x88, 0x98, 0x1a, 0x15, 0x7d, 0x38, 0xdb, 0x8a, 0xd2, 0xe5, 0xbd, 0xfa, 0xa5,
+	0x7d, 0x60, 0x06, 0xfc, 0x08, 0x21, 0x86, 0x39, 0xff, 0x52, 0x30, 0x92, 0xc6, 0xe1, 0x2c, 0xdc,
+	0xee, 0x81, 0x6d, 0xfd, 0x3c, 0x39, 0x15, 0xb3, 0x7e, 0x7f, 0x7f, 0x94, 0x7d, 0x7d, 0x6f, 0xf7,
+	0xbe, 0x59, 0xbf, 0x7d, 0x7d, 0x91, 0x8e, 0xf3, 0x42, 0x93, 0xe0, 0xf4, 0x5d, 0x39, 0x85, 0xe6,
+	0x92, 0x29, 0xe4, 0xaa, 0xf8, 0xb6, 0xc1, 0x1f, 0x81, 0xc3, 0xbf, 0x34, 0x54, 0xcc, 0xa1, 0xf3,
+	0x7d, 0x15, 0x54, 0xfb, 0x3c, 0xb6, 0xbf, 0x01, 0xbb, 0xf3, 0xef, 0xa3, 0xb7, 0xac, 0xa6, 0xc5,
+	0x7d, 0xab, 0x1f, 0xaf, 0xc7, 0x94, 0xe3, 0x7e, 0x0c, 0xc0, 0xdc, 0x3e, 0x3e, 0x58, 0x11, 0x39,
+	0x83, 0xd4, 0xdf, 0x5f, 0x0b, 0x29, 0x73, 0xcf, 0x4a, 0x57, 0x82, 0x5f, 0x53, 0xba, 0xc4, 0xd4,
+	0x8f, 0xd7, 0x63, 0xca, 0xf4, 0xdf, 0x82, 0xbd, 0x05, 0x29, 0xbc, 0xbd, 0x22, 0x76, 0x1e, 0x54,
+	0xff, 0xe0, 0x5f, 0x80, 0x8a, 0x1b, 0xba, 0x83, 0x17, 0xd7, 0xae, 0xf5, 0xf2, 0xda, 0xb5, 0x7e,
+	0xbf, 0x76, 0xad, 0xe7, 0x37, 0xee, 0xc6, 0xcb, 0x1b, 0x77, 0xe3, 0xb7, 0x1b, 0x77, 0xe3, 0xf1,
+	0xc7, 0x73, 0xab, 0x68, 0x12, 0xb6, 0xc7, 0x70, 0xc8, 0x0b, 0x23, 0x98, 0x74, 0x4e, 0x82, 0x67,
+	0x0b, 0xbf, 0xf4, 0x72, 0x3d, 0x87, 0xdb, 0xea, 0x25, 0x7f, 0xf8, 0xe7, 0x00, 0xff, 0x3d, 0x9b,
+	0x22, 0x0c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -479,6 +581,9 @@ type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
 	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
+	// UpdateParams sets the incentives module parameters. It can only be executed by
+	// governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -516,11 +621,23 @@ func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ..
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
 	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
+	// UpdateParams sets the incentives module parameters. It can only be executed by
+	// governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -536,6 +653,9 @@ func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGaug
 func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -595,6 +715,24 @@ func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -611,6 +749,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateGroup",
 			Handler:    _Msg_CreateGroup_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -888,6 +1030,69 @@ func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1012,6 +1217,30 @@ func (m *MsgCreateGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1773,6 +2002,171 @@ func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46,6 +46,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdSyntheticLockupByLockupID(),
 		GetCmdAccountLockedDuration(),
 		GetCmdNextLockID(),
+		GetCmdAccountLockSummary(),
 		osmocli.GetParams[*types.QueryParamsRequest](
 			types.ModuleName, types.NewQueryClient),
 	)
@@ -264,6 +265,15 @@ func GetCmdAccountLockedLongerDurationDenom() *cobra.Command {
 		`{{.Short}}`, types.ModuleName, types.NewQueryClient)
 }
 
+// GetCmdAccountLockSummary returns an overview of the locks of an account.
+func GetCmdAccountLockSummary() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.AccountLockSummaryRequest](
+		"account-lock-summary",
+		"Query account's locked, unlocking and unlockable coins along with its number of locks",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} account-lock-summary osmo1yl6hdjhmkf37639730gffanpzndzdpmhxy9ep3`, types.ModuleName, types.NewQueryClient)
+}
+
 func GetCmdTotalLockedByDenom() (*osmocli.QueryDescriptor, *types.LockedDenomRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "total-locked-of-denom",
@@ -16,6 +16,7 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
 	osmocli.AddTxCmd(cmd, NewSetRewardReceiverAddress)
+	osmocli.AddTxCmd(cmd, NewInstantUnlockByIdCmd)
 
 	return cmd
 }
@@ -72,3 +73,16 @@ func NewSetRewardReceiverAddress() (*osmocli.TxCliDesc, *types.MsgSetRewardRecei
 		Long:  "sets reward receiver address for the designated lock id",
 	}, &types.MsgSetRewardReceiverAddress{}
 }
+
+// NewInstantUnlockByIdCmd instantly unlocks individual period lock by ID for a penalty.
+func NewInstantUnlockByIdCmd() (*osmocli.TxCliDesc, *types.MsgInstantUnlock) {
+	return &osmocli.TxCliDesc{
+		Use:   "instant-unlock-by-id",
+		Short: "instantly unlocks individual period lock by ID for a penalty",
+		Long:  "instantly unlocks individual period lock by ID, skipping its remaining duration, for a penalty of the instant-unlock-penalty param of the unlocked amount. if no amount provided, entire lock is unlocked",
+		CustomFlagOverrides: map[string]string{
+			"coins": FlagAmount,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
+	}, &types.MsgInstantUnlock{}
+}
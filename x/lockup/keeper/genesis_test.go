@@ -51,6 +51,7 @@ var (
 		},
 		Params: &types.Params{
 			ForceUnlockAllowedAddresses: []string{acc1.String(), acc2.String()},
+			InstantUnlockPenalty:        osmomath.ZeroDec(),
 		},
 	}
 )
@@ -134,6 +135,7 @@ func TestExportGenesis(t *testing.T) {
 	})
 	require.Equal(t, genesisExported.Params, &types.Params{
 		ForceUnlockAllowedAddresses: []string{acc1.String(), acc2.String()},
+		InstantUnlockPenalty:        osmomath.ZeroDec(),
 	})
 }
 
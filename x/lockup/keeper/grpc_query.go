@@ -92,7 +92,14 @@ func (q Querier) AccountLockedCoins(goCtx context.Context, req *types.AccountLoc
 		return nil, err
 	}
 
-	return &types.AccountLockedCoinsResponse{Coins: q.Keeper.GetAccountLockedCoins(ctx, owner)}, nil
+	coins, pageRes, err := paginate(q.Keeper.GetAccountLockedCoins(ctx, owner), func(coin sdk.Coin) []byte {
+		return []byte(coin.Denom)
+	}, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountLockedCoinsResponse{Coins: coins, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTime returns the locks of an account whose unlock time is beyond provided timestamp.
@@ -205,7 +212,13 @@ func (q Querier) SyntheticLockupsByLockupID(goCtx context.Context, req *types.Sy
 	if found {
 		synthlocks = append(synthlocks, synthLock)
 	}
-	return &types.SyntheticLockupsByLockupIDResponse{SyntheticLocks: synthlocks}, nil
+	synthlocks, pageRes, err := paginate(synthlocks, func(synthLock types.SyntheticLock) []byte {
+		return []byte(synthLock.SynthDenom)
+	}, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SyntheticLockupsByLockupIDResponse{SyntheticLocks: synthlocks, Pagination: pageRes}, nil
 }
 
 // SyntheticLockupByLockupID returns synthetic lockup by native lockup id.
@@ -238,8 +251,11 @@ func (q Querier) AccountLockedLongerDuration(goCtx context.Context, req *types.A
 		return nil, err
 	}
 
-	locks := q.Keeper.GetAccountLockedLongerDuration(ctx, owner, req.Duration)
-	return &types.AccountLockedLongerDurationResponse{Locks: locks}, nil
+	locks, pageRes, err := paginateLocks(q.Keeper.GetAccountLockedLongerDuration(ctx, owner, req.Duration), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedLongerDurationResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationDenom returns locks of an account with duration longer than specified with specific denom.
@@ -278,8 +294,11 @@ func (q Querier) AccountLockedDuration(goCtx context.Context, req *types.Account
 		return nil, err
 	}
 
-	locks := q.Keeper.GetAccountLockedDuration(ctx, owner, req.Duration)
-	return &types.AccountLockedDurationResponse{Locks: locks}, nil
+	locks, pageRes, err := paginateLocks(q.Keeper.GetAccountLockedDuration(ctx, owner, req.Duration), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedDurationResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeNotUnlockingOnly returns locks of an account with unlock time beyond
@@ -335,6 +354,34 @@ func (q Querier) LockedDenom(goCtx context.Context, req *types.LockedDenomReques
 	return &types.LockedDenomResponse{Amount: q.Keeper.GetLockedDenom(ctx, req.Denom, req.Duration)}, nil
 }
 
+// AccountLockSummary returns an overview of the locks of an account, so that clients do not need to
+// query all the locks of accounts with many locks.
+func (q Querier) AccountLockSummary(goCtx context.Context, req *types.AccountLockSummaryRequest) (*types.AccountLockSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Owner) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty owner")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	numUnlockingLocks := q.Keeper.GetAccountNumLocks(ctx, owner, true)
+	return &types.AccountLockSummaryResponse{
+		LockedCoins:       q.Keeper.GetAccountLockedCoins(ctx, owner),
+		UnlockingCoins:    q.Keeper.GetAccountUnlockingCoins(ctx, owner),
+		UnlockableCoins:   q.Keeper.GetAccountUnlockableCoins(ctx, owner),
+		NumLocks:          q.Keeper.GetAccountNumLocks(ctx, owner, false) + numUnlockingLocks,
+		NumUnlockingLocks: numUnlockingLocks,
+		NumSyntheticLocks: uint64(len(q.Keeper.GetAllSyntheticLockupsByAddr(ctx, owner))),
+	}, nil
+}
+
 // Params returns module params
 func (q Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
@@ -192,6 +193,23 @@ func (s *KeeperTestSuite) TestAccountLockedCoins() {
 	s.Require().Equal(res.Coins, sdk.Coins{})
 }
 
+func (s *KeeperTestSuite) TestAccountLockedCoinsPagination() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("bar", 10), sdk.NewInt64Coin("baz", 20), sdk.NewInt64Coin("foo", 30)}
+	s.LockTokens(addr1, coins, time.Second)
+
+	res, err := s.querier.AccountLockedCoins(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedCoinsRequest{Owner: addr1.String(), Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Equal(coins[:2], res.Coins)
+	s.Require().Equal(&query.PageResponse{NextKey: []byte("foo"), Total: 3}, res.Pagination)
+
+	res, err = s.querier.AccountLockedCoins(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedCoinsRequest{Owner: addr1.String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	s.Require().NoError(err)
+	s.Require().Equal(coins[2:], res.Coins)
+	s.Require().Equal(&query.PageResponse{}, res.Pagination)
+}
+
 func (s *KeeperTestSuite) TestAccountLockedPastTime() {
 	s.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
@@ -444,6 +462,135 @@ func (s *KeeperTestSuite) TestAccountLockedLongerDuration() {
 	s.Require().Len(res.Locks, 0)
 }
 
+func (s *KeeperTestSuite) TestAccountLockedLongerDurationPagination() {
+	lockIds := func(locks []types.PeriodLock) []uint64 {
+		ids := []uint64{}
+		for _, lock := range locks {
+			ids = append(ids, lock.ID)
+		}
+		return ids
+	}
+
+	tests := map[string]struct {
+		pagination *query.PageRequest
+
+		expectedLockIds []uint64
+		expectedPageRes *query.PageResponse
+		expectErr       bool
+	}{
+		"no pagination": {
+			expectedLockIds: []uint64{1, 3, 5, 2, 4},
+		},
+		"first page": {
+			pagination:      &query.PageRequest{Limit: 2, CountTotal: true},
+			expectedLockIds: []uint64{1, 2},
+			expectedPageRes: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(3), Total: 5},
+		},
+		"page from key": {
+			pagination:      &query.PageRequest{Key: sdk.Uint64ToBigEndian(3), Limit: 2},
+			expectedLockIds: []uint64{3, 4},
+			expectedPageRes: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(5)},
+		},
+		"last page from offset": {
+			pagination:      &query.PageRequest{Offset: 4, Limit: 2},
+			expectedLockIds: []uint64{5},
+			expectedPageRes: &query.PageResponse{},
+		},
+		"offset past the last lock": {
+			pagination:      &query.PageRequest{Offset: 10},
+			expectedLockIds: []uint64{},
+			expectedPageRes: &query.PageResponse{},
+		},
+		"reverse page from key": {
+			pagination:      &query.PageRequest{Key: sdk.Uint64ToBigEndian(4), Limit: 2, Reverse: true},
+			expectedLockIds: []uint64{4, 3},
+			expectedPageRes: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(2)},
+		},
+		"error: both key and offset": {
+			pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(3), Offset: 1},
+			expectErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+			// lock coins five times, then begin unlocking locks 2 and 4 so that the locks
+			// are split between the not unlocking and the unlocking queues
+			coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+			for i := 0; i < 5; i++ {
+				s.LockTokens(addr1, coins, time.Second)
+			}
+			for _, lockId := range []uint64{2, 4} {
+				_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, lockId, nil)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.querier.AccountLockedLongerDuration(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Pagination: tc.pagination})
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedLockIds, lockIds(res.Locks))
+			s.Require().Equal(tc.expectedPageRes, res.Pagination)
+
+			durationRes, err := s.querier.AccountLockedDuration(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedDurationRequest{Owner: addr1.String(), Duration: time.Second, Pagination: tc.pagination})
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expectedLockIds, lockIds(durationRes.Locks))
+			s.Require().Equal(tc.expectedPageRes, durationRes.Pagination)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAccountLockSummary() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// empty address lock summary check
+	_, err := s.querier.AccountLockSummary(sdk.WrapSDKContext(s.Ctx), &types.AccountLockSummaryRequest{})
+	s.Require().Error(err)
+
+	// initial check
+	res, err := s.querier.AccountLockSummary(sdk.WrapSDKContext(s.Ctx), &types.AccountLockSummaryRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.AccountLockSummaryResponse{LockedCoins: sdk.Coins{}, UnlockingCoins: sdk.Coins{}, UnlockableCoins: sdk.Coins{}}, res)
+
+	// lock coins three times, begin unlocking the second lock and create a synthetic lock for the third one
+	for i := 0; i < 3; i++ {
+		s.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	}
+	_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, 2, nil)
+	s.Require().NoError(err)
+	err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, 3, "synthstakestakedtovalidator1", time.Second, false)
+	s.Require().NoError(err)
+
+	res, err = s.querier.AccountLockSummary(sdk.WrapSDKContext(s.Ctx), &types.AccountLockSummaryRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.AccountLockSummaryResponse{
+		LockedCoins:       sdk.Coins{sdk.NewInt64Coin("stake", 30)},
+		UnlockingCoins:    sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		UnlockableCoins:   sdk.Coins{},
+		NumLocks:          3,
+		NumUnlockingLocks: 1,
+		NumSyntheticLocks: 1,
+	}, res)
+
+	// the unlocking lock becomes unlockable once its unlock time is reached
+	res, err = s.querier.AccountLockSummary(sdk.WrapSDKContext(s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))), &types.AccountLockSummaryRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.AccountLockSummaryResponse{
+		LockedCoins:       sdk.Coins{sdk.NewInt64Coin("stake", 20)},
+		UnlockingCoins:    sdk.Coins{},
+		UnlockableCoins:   sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		NumLocks:          3,
+		NumUnlockingLocks: 1,
+		NumSyntheticLocks: 1,
+	}, res)
+}
+
 func (s *KeeperTestSuite) TestAccountLockedLongerDurationNotUnlockingOnly() {
 	s.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
@@ -572,7 +719,7 @@ func (s *KeeperTestSuite) TestParams() {
 	s.Require().Equal([]string(nil), res.Params.ForceUnlockAllowedAddresses)
 
 	// Set new params & query
-	s.App.LockupKeeper.SetParams(s.Ctx, types.NewParams([]string{s.TestAccs[0].String()}))
+	s.App.LockupKeeper.SetParams(s.Ctx, types.NewParams([]string{s.TestAccs[0].String()}, osmomath.ZeroDec(), false))
 	res, err = s.querier.Params(sdk.WrapSDKContext(s.Ctx), &types.QueryParamsRequest{})
 	s.Require().NoError(err)
 	s.Require().Equal([]string{s.TestAccs[0].String()}, res.Params.ForceUnlockAllowedAddresses)
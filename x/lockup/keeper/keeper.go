@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -18,15 +19,19 @@ type Keeper struct {
 
 	hooks types.LockupHooks
 
+	// paramSpace is the legacy x/params subspace of the lockup parameters, only read to migrate them to the module store.
 	paramSpace paramtypes.Subspace
 
 	ak types.AccountKeeper
 	bk types.BankKeeper
 	ck types.CommunityPoolKeeper
+
+	// authority is the address allowed to update the lockup parameters, the governance module account.
+	authority string
 }
 
 // NewKeeper returns an instance of Keeper.
-func NewKeeper(storeKey storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, ck types.CommunityPoolKeeper, paramSpace paramtypes.Subspace) *Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, ck types.CommunityPoolKeeper, paramSpace paramtypes.Subspace, authority string) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -38,23 +43,19 @@ func NewKeeper(storeKey storetypes.StoreKey, ak types.AccountKeeper, bk types.Ba
 		ak:         ak,
 		bk:         bk,
 		ck:         ck,
+		authority:  authority,
 	}
 }
 
 // GetParams returns the total set of lockup parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 	return params
 }
 
 // SetParams sets the total set of lockup parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
-}
-
-// SetParam sets a specific lockup module's parameter with the provided parameter.
-func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
-	k.paramSpace.Set(ctx, key, value)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 }
 
 func (k Keeper) GetForceUnlockAllowedAddresses(ctx sdk.Context) (forceUnlockAllowedAddresses []string) {
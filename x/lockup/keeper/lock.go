@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/sumtree"
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
@@ -461,6 +462,82 @@ func (k Keeper) ForceUnlock(ctx sdk.Context, lock types.PeriodLock) error {
 	return k.unlockMaturedLockInternalLogic(ctx, *lockPtr)
 }
 
+// InstantUnlock immediately unlocks the given coins of the lock, skipping its remaining duration,
+// and charges the owner the instant unlock penalty param of the unlocked coins.
+// The penalty is burned or sent to the community pool, depending on the burn instant unlock penalty param.
+// Unlocks the lock as a whole when provided coins are empty, or coin provided equals amount of coins in the lock.
+// The synthetic lockups of the lock, such as superfluid delegations, are broken by the BeforeInstantUnlock hook
+// of the module owning them, for the whole lock. Concentrated liquidity locks are not supported.
+// Returns the penalty paid.
+func (k Keeper) InstantUnlock(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, coins sdk.Coins) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !params.InstantUnlockPenalty.IsPositive() {
+		return nil, types.ErrInstantUnlockDisabled
+	}
+
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, errorsmod.Wrapf(types.ErrNotLockOwner, "sender (%s) does not match lock owner (%s)", owner, lock.Owner)
+	}
+	for _, coin := range lock.Coins {
+		if strings.HasPrefix(coin.Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
+			return nil, fmt.Errorf("cannot instantly unlock a concentrated liquidity lock")
+		}
+	}
+
+	if k.hooks != nil {
+		err = k.hooks.BeforeInstantUnlock(ctx, owner, lock.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return nil, fmt.Errorf("cannot instantly unlock a lock with synthetic lockup")
+	}
+
+	unlockedCoins := coins
+	if len(unlockedCoins) == 0 {
+		unlockedCoins = lock.Coins
+	}
+
+	err = k.PartialForceUnlock(ctx, *lock, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	// The penalty is rounded up so that small unlocks cannot avoid it.
+	penalty := sdk.NewCoins()
+	for _, coin := range unlockedCoins {
+		penalty = penalty.Add(sdk.NewCoin(coin.Denom, params.InstantUnlockPenalty.MulInt(coin.Amount).Ceil().TruncateInt()))
+	}
+
+	if params.BurnInstantUnlockPenalty {
+		err = k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, penalty)
+		if err != nil {
+			return nil, err
+		}
+		err = k.bk.BurnCoins(ctx, types.ModuleName, penalty)
+	} else {
+		err = k.ck.FundCommunityPool(ctx, penalty, owner)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtInstantUnlock,
+		sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+		sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+		sdk.NewAttribute(types.AttributeUnlockedCoins, unlockedCoins.String()),
+		sdk.NewAttribute(types.AttributeInstantUnlockPenalty, penalty.String()),
+	))
+
+	return penalty, nil
+}
+
 // unlockMaturedLockInternalLogic handles internal logic for finishing unlocking matured locks.
 func (k Keeper) unlockMaturedLockInternalLogic(ctx sdk.Context, lock types.PeriodLock) error {
 	owner, err := sdk.AccAddressFromBech32(lock.Owner)
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+// Migrator migrates the lockup module state between consensus versions.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the lockup parameters from the x/params subspace to the module store.
+// Parameters missing from the subspace are left at their zero value, for the upgrade handler to set.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var params types.Params
+	m.keeper.paramSpace.GetParamSetIfExists(ctx, &params)
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -228,6 +229,24 @@ func (server msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUn
 	return &types.MsgForceUnlockResponse{Success: true}, nil
 }
 
+// InstantUnlock immediately unlocks the lock, skipping its remaining duration, for a penalty defined by governance.
+// Locks that has been superfluid delegated is not supported.
+func (server msgServer) InstantUnlock(goCtx context.Context, msg *types.MsgInstantUnlock) (*types.MsgInstantUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	penalty, err := server.keeper.InstantUnlock(ctx, owner, msg.ID, msg.Coins)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgInstantUnlockResponse{Penalty: penalty}, nil
+}
+
 func (server msgServer) SetRewardReceiverAddress(goCtx context.Context, msg *types.MsgSetRewardReceiverAddress) (*types.MsgSetRewardReceiverAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -248,3 +267,35 @@ func (server msgServer) SetRewardReceiverAddress(goCtx context.Context, msg *typ
 
 	return &types.MsgSetRewardReceiverAddressResponse{Success: true}, nil
 }
+
+// UpdateParams sets the lockup module parameters, it can only be executed by governance.
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	server.keeper.SetParams(ctx, msg.Params)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateAuthority returns an error if the given address is not the authority of the lockup parameters.
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func (s *KeeperTestSuite) TestMsgLockTokens() {
@@ -355,14 +357,14 @@ func (s *KeeperTestSuite) TestMsgForceUnlock() {
 	}{
 		{
 			"happy path",
-			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}},
+			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}, InstantUnlockPenalty: osmomath.ZeroDec()},
 			func() {},
 			defaultLockAmount,
 			true,
 		},
 		{
 			"force unlock superfluid delegated lock",
-			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}},
+			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}, InstantUnlockPenalty: osmomath.ZeroDec()},
 			func() {
 				err := s.SuperfluidDelegateToDefaultVal(addr1, defaultPoolID, defaultLockID)
 				s.Require().NoError(err)
@@ -372,7 +374,7 @@ func (s *KeeperTestSuite) TestMsgForceUnlock() {
 		},
 		{
 			"superfluid undelegating lock",
-			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}},
+			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}, InstantUnlockPenalty: osmomath.ZeroDec()},
 			func() {
 				err := s.SuperfluidDelegateToDefaultVal(addr1, defaultPoolID, defaultLockID)
 				s.Require().NoError(err)
@@ -385,7 +387,7 @@ func (s *KeeperTestSuite) TestMsgForceUnlock() {
 		},
 		{
 			"partial unlock",
-			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}},
+			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}, InstantUnlockPenalty: osmomath.ZeroDec()},
 			func() {},
 			// try force unlocking half of locked amount
 			defaultLockAmount.Quo(osmomath.NewInt(2)),
@@ -393,7 +395,7 @@ func (s *KeeperTestSuite) TestMsgForceUnlock() {
 		},
 		{
 			"force unlock more than what we have locked",
-			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}},
+			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String()}, InstantUnlockPenalty: osmomath.ZeroDec()},
 			func() {},
 			// try force more than the locked amount
 			defaultLockAmount.Add(osmomath.NewInt(1)),
@@ -401,14 +403,14 @@ func (s *KeeperTestSuite) TestMsgForceUnlock() {
 		},
 		{
 			"params with different address",
-			types.Params{ForceUnlockAllowedAddresses: []string{addr2.String()}},
+			types.Params{ForceUnlockAllowedAddresses: []string{addr2.String()}, InstantUnlockPenalty: osmomath.ZeroDec()},
 			func() {},
 			defaultLockAmount,
 			false,
 		},
 		{
 			"param with multiple addresses ",
-			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String(), addr2.String()}},
+			types.Params{ForceUnlockAllowedAddresses: []string{addr1.String(), addr2.String()}, InstantUnlockPenalty: osmomath.ZeroDec()},
 			func() {},
 			defaultLockAmount,
 			true,
@@ -457,6 +459,159 @@ func (s *KeeperTestSuite) TestMsgForceUnlock() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgInstantUnlock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultPoolID, defaultLockID := uint64(1), uint64(1)
+	defaultLockAmount := osmomath.NewInt(1000000000)
+	defaultPenalty := osmomath.NewDecWithPrec(1, 1)
+
+	tests := []struct {
+		name          string
+		penalty       osmomath.Dec
+		burnPenalty   bool
+		sender        sdk.AccAddress
+		postLockSetup func()
+		unlockAmount  osmomath.Int
+		expectPass    bool
+	}{
+		{
+			name:          "happy path, penalty sent to community pool",
+			penalty:       defaultPenalty,
+			sender:        addr1,
+			postLockSetup: func() {},
+			unlockAmount:  defaultLockAmount,
+			expectPass:    true,
+		},
+		{
+			name:          "happy path, penalty burned",
+			penalty:       defaultPenalty,
+			burnPenalty:   true,
+			sender:        addr1,
+			postLockSetup: func() {},
+			unlockAmount:  defaultLockAmount,
+			expectPass:    true,
+		},
+		{
+			name:          "partial unlock",
+			penalty:       defaultPenalty,
+			sender:        addr1,
+			postLockSetup: func() {},
+			unlockAmount:  defaultLockAmount.Quo(osmomath.NewInt(3)),
+			expectPass:    true,
+		},
+		{
+			name:    "unlocking lock",
+			penalty: defaultPenalty,
+			sender:  addr1,
+			postLockSetup: func() {
+				_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, defaultLockID, nil)
+				s.Require().NoError(err)
+			},
+			unlockAmount: defaultLockAmount,
+			expectPass:   true,
+		},
+		{
+			name:          "instant unlocks disabled",
+			penalty:       osmomath.ZeroDec(),
+			sender:        addr1,
+			postLockSetup: func() {},
+			unlockAmount:  defaultLockAmount,
+			expectPass:    false,
+		},
+		{
+			name:    "superfluid delegated lock",
+			penalty: defaultPenalty,
+			sender:  addr1,
+			postLockSetup: func() {
+				err := s.SuperfluidDelegateToDefaultVal(addr1, defaultPoolID, defaultLockID)
+				s.Require().NoError(err)
+			},
+			unlockAmount: defaultLockAmount,
+			expectPass:   true,
+		},
+		{
+			name:          "sender is not the lock owner",
+			penalty:       defaultPenalty,
+			sender:        addr2,
+			postLockSetup: func() {},
+			unlockAmount:  defaultLockAmount,
+			expectPass:    false,
+		},
+		{
+			name:          "unlock more than what we have locked",
+			penalty:       defaultPenalty,
+			sender:        addr1,
+			postLockSetup: func() {},
+			unlockAmount:  defaultLockAmount.Add(osmomath.OneInt()),
+			expectPass:    false,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// set up test
+			s.SetupTest()
+			s.App.LockupKeeper.SetParams(s.Ctx, types.NewParams([]string{}, test.penalty, test.burnPenalty))
+
+			// prepare pool for superfluid staking cases
+			poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("stake", osmomath.NewInt(1000000000000)), sdk.NewCoin("foo", osmomath.NewInt(5000)))
+
+			// lock tokens
+			msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+			c := sdk.WrapSDKContext(s.Ctx)
+
+			poolDenom := gammtypes.GetPoolShareDenom(poolId)
+			coinsToLock := sdk.Coins{sdk.NewCoin(poolDenom, defaultLockAmount)}
+			s.FundAcc(addr1, coinsToLock)
+
+			unbondingDuration := s.App.StakingKeeper.GetParams(s.Ctx).UnbondingTime
+			resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(addr1, unbondingDuration, coinsToLock))
+			s.Require().NoError(err)
+
+			// setup env after lock tokens
+			test.postLockSetup()
+
+			communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(poolDenom)
+			supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, poolDenom).Amount
+
+			// test instant unlock
+			unlockCoins := sdk.Coins{sdk.NewCoin(poolDenom, test.unlockAmount)}
+			instantUnlockResp, err := msgServer.InstantUnlock(c, types.NewMsgInstantUnlock(test.sender, resp.ID, unlockCoins))
+			if !test.expectPass {
+				s.Require().Error(err)
+
+				// check that nothing was unlocked
+				s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, addr1, poolDenom).IsZero())
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedPenalty := test.penalty.MulInt(test.unlockAmount).Ceil().TruncateInt()
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(poolDenom, expectedPenalty)), instantUnlockResp.Penalty)
+
+			// check that the owner received the unlocked coins minus the penalty
+			balanceAfterInstantUnlock := s.App.BankKeeper.GetBalance(s.Ctx, addr1, poolDenom)
+			s.Require().Equal(test.unlockAmount.Sub(expectedPenalty).String(), balanceAfterInstantUnlock.Amount.String())
+
+			// check that the remainder is still locked
+			lockedAmount := s.App.LockupKeeper.GetModuleLockedCoins(s.Ctx).AmountOf(poolDenom)
+			s.Require().Equal(defaultLockAmount.Sub(test.unlockAmount).String(), lockedAmount.String())
+
+			// check where the penalty went
+			communityPoolAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(poolDenom)
+			supplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, poolDenom).Amount
+			if test.burnPenalty {
+				s.Require().Equal(communityPoolBefore.String(), communityPoolAfter.String())
+				s.Require().Equal(supplyBefore.Sub(expectedPenalty).String(), supplyAfter.String())
+			} else {
+				s.Require().Equal(communityPoolBefore.Add(expectedPenalty.ToLegacyDec()).String(), communityPoolAfter.String())
+				s.Require().Equal(supplyBefore.String(), supplyAfter.String())
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSetRewardReceiverAddress() {
 	type param struct {
 		isOwner                      bool
@@ -550,3 +705,66 @@ func (s *KeeperTestSuite) TestSetRewardReceiverAddress() {
 
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := map[string]struct {
+		authority    string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		"enable instant unlocks with a burned penalty": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.InstantUnlockPenalty = osmomath.NewDecWithPrec(2, 1)
+				params.BurnInstantUnlockPenalty = true
+			},
+		},
+		"update force unlock allowed addresses": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.ForceUnlockAllowedAddresses = []string{s.TestAccs[1].String()} },
+		},
+		"error: not the governance authority": {
+			authority:    s.TestAccs[0].String(),
+			updateParams: func(params *types.Params) { params.InstantUnlockPenalty = osmomath.NewDecWithPrec(2, 1) },
+			expectedErr:  "invalid authority",
+		},
+		"error: instant unlock penalty of one": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.InstantUnlockPenalty = osmomath.OneDec() },
+			expectedErr:  "instant unlock penalty must be in [0, 1)",
+		},
+		"error: negative instant unlock penalty": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.InstantUnlockPenalty = osmomath.NewDecWithPrec(-1, 1) },
+			expectedErr:  "instant unlock penalty must be in [0, 1)",
+		},
+		"error: invalid force unlock allowed address": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.ForceUnlockAllowedAddresses = []string{"invalid"} },
+			expectedErr:  "decoding bech32 failed",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			originalParams := s.App.LockupKeeper.GetParams(s.Ctx)
+			newParams := s.App.LockupKeeper.GetParams(s.Ctx)
+			tc.updateParams(&newParams)
+			msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: tc.authority, Params: newParams})
+
+			params := s.App.LockupKeeper.GetParams(s.Ctx)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, params)
+		})
+	}
+}
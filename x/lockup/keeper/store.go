@@ -119,6 +119,19 @@ func (k Keeper) GetAccountLockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.
 	return notUnlockingCoins.Add(unlockingCoins...)
 }
 
+// GetAccountNumLocks returns the number of locks of an account that started unlocking if isUnlocking
+// is true, or that did not start unlocking otherwise.
+func (k Keeper) GetAccountNumLocks(ctx sdk.Context, addr sdk.AccAddress, isUnlocking bool) uint64 {
+	iterator := k.AccountLockIterator(ctx, isUnlocking, addr)
+	defer iterator.Close()
+
+	numLocks := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		numLocks++
+	}
+	return numLocks
+}
+
 // GetAccountLockedPastTime Returns the total locks of an account whose unlock time is beyond timestamp.
 func (k Keeper) GetAccountLockedPastTime(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []types.PeriodLock {
 	// unlockings finish after specific time + not started locks that will finish after the time even though it start now
@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"bytes"
+	"sort"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // combineKeys combine bytes array into a single bytes.
@@ -110,3 +115,69 @@ func syntheticLockRefKeys(lock types.PeriodLock, synthLock types.SyntheticLock)
 func combineLocks(pl1 []types.PeriodLock, pl2 []types.PeriodLock) []types.PeriodLock {
 	return append(pl1, pl2...)
 }
+
+// paginateLocks returns the page of locks requested by pageReq, the locks are paginated by ascending lock id.
+func paginateLocks(locks []types.PeriodLock, pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+	if pageReq != nil {
+		sort.Slice(locks, func(i, j int) bool {
+			return locks[i].ID < locks[j].ID
+		})
+	}
+	return paginate(locks, func(lock types.PeriodLock) []byte {
+		return sdk.Uint64ToBigEndian(lock.ID)
+	}, pageReq)
+}
+
+// paginate returns the page of items requested by pageReq along with its page response,
+// following the semantics of query.Paginate. The items must be sorted by ascending key.
+// All the items are returned with a nil page response if pageReq is nil, so that the queries
+// that were not paginated keep returning everything to the clients not setting a page request.
+func paginate[T any](items []T, key func(T) []byte, pageReq *query.PageRequest) ([]T, *query.PageResponse, error) {
+	if pageReq == nil {
+		return items, nil, nil
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]T, len(items))
+		for i, item := range items {
+			reversed[len(items)-1-i] = item
+		}
+		items = reversed
+	}
+
+	start := uint64(0)
+	if len(pageReq.Key) != 0 {
+		start = uint64(sort.Search(len(items), func(i int) bool {
+			cmp := bytes.Compare(key(items[i]), pageReq.Key)
+			if pageReq.Reverse {
+				return cmp <= 0
+			}
+			return cmp >= 0
+		}))
+	} else if pageReq.Offset < uint64(len(items)) {
+		start = pageReq.Offset
+	} else {
+		start = uint64(len(items))
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	end := uint64(len(items))
+	if limit < end-start {
+		end = start + limit
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(items)) {
+		pageRes.NextKey = key(items[end])
+	}
+	if pageReq.CountTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = uint64(len(items))
+	}
+	return items[start:end], pageRes, nil
+}
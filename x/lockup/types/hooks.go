@@ -13,6 +13,8 @@ type LockupHooks interface {
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	// BeforeInstantUnlock is called before a lock is instantly unlocked, so that the modules owning its synthetic lockups can break them.
+	BeforeInstantUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64) error
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -59,3 +61,12 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) BeforeInstantUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64) error {
+	for i := range h {
+		if err := h[i].BeforeInstantUnlock(ctx, address, lockID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -61,6 +61,9 @@ var (
 	// KeyPrefixSyntheticLockTimestamp defines prefix for the iteration of synthetic lockups by timestamp.
 	KeyPrefixSyntheticLockTimestamp = []byte{0x10}
 
+	// KeyParams defines key to store the lockup module parameters.
+	KeyParams = []byte{0x14}
+
 	// KeyPrefixLockAccumulation defines prefix for the lock accumulation store.
 	KeyPrefixLockAccumulation = []byte{0x20}
 
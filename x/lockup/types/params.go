@@ -5,11 +5,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // Parameter store keys.
 var (
 	KeyForceUnlockAllowedAddresses = []byte("ForceUnlockAllowedAddresses")
+	KeyInstantUnlockPenalty        = []byte("InstantUnlockPenalty")
+	KeyBurnInstantUnlockPenalty    = []byte("BurnInstantUnlockPenalty")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -19,9 +23,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(forceUnlockAllowedAddresses []string) Params {
+func NewParams(forceUnlockAllowedAddresses []string, instantUnlockPenalty osmomath.Dec, burnInstantUnlockPenalty bool) Params {
 	return Params{
 		ForceUnlockAllowedAddresses: forceUnlockAllowedAddresses,
+		InstantUnlockPenalty:        instantUnlockPenalty,
+		BurnInstantUnlockPenalty:    burnInstantUnlockPenalty,
 	}
 }
 
@@ -29,6 +35,8 @@ func NewParams(forceUnlockAllowedAddresses []string) Params {
 func DefaultParams() Params {
 	return Params{
 		ForceUnlockAllowedAddresses: []string{},
+		InstantUnlockPenalty:        osmomath.ZeroDec(),
+		BurnInstantUnlockPenalty:    false,
 	}
 }
 
@@ -37,6 +45,12 @@ func (p Params) Validate() error {
 	if err := validateAddresses(p.ForceUnlockAllowedAddresses); err != nil {
 		return err
 	}
+	if err := validateInstantUnlockPenalty(p.InstantUnlockPenalty); err != nil {
+		return err
+	}
+	if err := validateBool(p.BurnInstantUnlockPenalty); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -44,6 +58,8 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyForceUnlockAllowedAddresses, &p.ForceUnlockAllowedAddresses, validateAddresses),
+		paramtypes.NewParamSetPair(KeyInstantUnlockPenalty, &p.InstantUnlockPenalty, validateInstantUnlockPenalty),
+		paramtypes.NewParamSetPair(KeyBurnInstantUnlockPenalty, &p.BurnInstantUnlockPenalty, validateBool),
 	}
 }
 
@@ -61,3 +77,25 @@ func validateAddresses(i interface{}) error {
 
 	return nil
 }
+
+func validateInstantUnlockPenalty(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("instant unlock penalty must be in [0, 1), got %s", v)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
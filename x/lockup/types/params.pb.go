@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,6 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
 	ForceUnlockAllowedAddresses []string `protobuf:"bytes,1,rep,name=force_unlock_allowed_addresses,json=forceUnlockAllowedAddresses,proto3" json:"force_unlock_allowed_addresses,omitempty" yaml:"force_unlock_allowed_address"`
+	// instant_unlock_penalty is the fraction of the unlocked coins a lock owner
+	// pays to unlock a lock instantly, skipping its remaining duration.
+	// Instant unlocks are disabled when zero.
+	InstantUnlockPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=instant_unlock_penalty,json=instantUnlockPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"instant_unlock_penalty" yaml:"instant_unlock_penalty"`
+	// burn_instant_unlock_penalty burns the instant unlock penalty when true,
+	// otherwise the penalty is sent to the community pool.
+	BurnInstantUnlockPenalty bool `protobuf:"varint,3,opt,name=burn_instant_unlock_penalty,json=burnInstantUnlockPenalty,proto3" json:"burn_instant_unlock_penalty,omitempty" yaml:"burn_instant_unlock_penalty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,6 +75,13 @@ func (m *Params) GetForceUnlockAllowedAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetBurnInstantUnlockPenalty() bool {
+	if m != nil {
+		return m.BurnInstantUnlockPenalty
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.lockup.Params")
 }
@@ -74,21 +89,28 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/params.proto", fileDescriptor_4595e58f5e17053c) }
 
 var fileDescriptor_4595e58f5e17053c = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x4a, 0xea, 0x41, 0x24, 0xa5, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x52, 0x19, 0x17, 0x5b, 0x00,
-	0x58, 0x97, 0x50, 0x0e, 0x97, 0x5c, 0x5a, 0x7e, 0x51, 0x72, 0x6a, 0x7c, 0x69, 0x1e, 0x48, 0x47,
-	0x7c, 0x62, 0x4e, 0x4e, 0x7e, 0x79, 0x6a, 0x4a, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71,
-	0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xa7, 0x93, 0xfa, 0xa7, 0x7b, 0xf2, 0xca, 0x95, 0x89,
-	0xb9, 0x39, 0x56, 0x4a, 0xf8, 0xd4, 0x2b, 0x05, 0x49, 0x83, 0xa5, 0x43, 0xc1, 0xb2, 0x8e, 0x10,
-	0x49, 0x47, 0x98, 0x59, 0x4e, 0x3e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x94, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf5, 0x82, 0x6e,
-	0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3, 0x5f, 0x66, 0x64, 0xa8, 0x5f, 0x01, 0xf3, 0x72, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xf3,
-	0xd8, 0x05, 0x11, 0x01, 0x00, 0x00,
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x00, 0x84, 0x93, 0x56, 0xaa, 0xfe, 0x66, 0xf8, 0x87, 0xa8, 0x42, 0x15, 0x11, 0x4e, 0x65, 0x24,
+	0xe8, 0x42, 0x2c, 0xca, 0xc6, 0xd6, 0xa8, 0x0b, 0x52, 0x87, 0xaa, 0x12, 0x0b, 0x4b, 0xe4, 0x38,
+	0x26, 0x8d, 0xea, 0xc4, 0x51, 0xec, 0x00, 0xe1, 0x29, 0x78, 0xac, 0x8e, 0x1d, 0x81, 0x21, 0x42,
+	0xed, 0x1b, 0xf4, 0x09, 0x50, 0xed, 0x66, 0x2b, 0xdd, 0x12, 0x7f, 0x77, 0xe7, 0xb3, 0xce, 0x72,
+	0xb8, 0x48, 0xb9, 0x48, 0x04, 0x62, 0x9c, 0x2c, 0xcb, 0x1c, 0xe5, 0xb8, 0xc0, 0xa9, 0xf0, 0xf2,
+	0x82, 0x4b, 0x6e, 0xff, 0x3f, 0x40, 0x4f, 0xc3, 0xf3, 0x5e, 0xcc, 0x63, 0xae, 0x10, 0xda, 0x7f,
+	0x69, 0x15, 0xfc, 0x6a, 0x59, 0x9d, 0x99, 0xb2, 0xd9, 0xcc, 0x02, 0xcf, 0xbc, 0x20, 0x34, 0x28,
+	0xb3, 0xbd, 0x25, 0xc0, 0x8c, 0xf1, 0x57, 0x1a, 0x05, 0x38, 0x8a, 0x0a, 0x2a, 0x04, 0x15, 0x7d,
+	0x73, 0xd0, 0x1e, 0x76, 0xfd, 0xeb, 0x5d, 0xed, 0x5e, 0x56, 0x38, 0x65, 0xf7, 0xf0, 0x94, 0x1e,
+	0xce, 0x1d, 0x85, 0x1f, 0x15, 0x1d, 0x6b, 0x38, 0x6e, 0xb2, 0xec, 0x77, 0xeb, 0x2c, 0xc9, 0x84,
+	0xc4, 0x99, 0x6c, 0xfc, 0x39, 0xcd, 0x30, 0x93, 0x55, 0xbf, 0x35, 0x30, 0x87, 0x5d, 0x7f, 0xb2,
+	0xaa, 0x5d, 0xe3, 0xbb, 0x76, 0x1d, 0xa2, 0xde, 0x21, 0xa2, 0xa5, 0x97, 0x70, 0x94, 0x62, 0xb9,
+	0xf0, 0xa6, 0x34, 0xc6, 0xa4, 0x9a, 0x50, 0xb2, 0xab, 0xdd, 0x0b, 0x5d, 0xe4, 0x78, 0x14, 0x9c,
+	0xf7, 0x0e, 0x40, 0x97, 0x98, 0xe9, 0x63, 0x9b, 0x5a, 0x4e, 0x58, 0x16, 0x59, 0xf0, 0x47, 0x81,
+	0xf6, 0xc0, 0x1c, 0xfe, 0xf3, 0xaf, 0x76, 0xb5, 0x0b, 0x75, 0xfa, 0x09, 0x31, 0x9c, 0xf7, 0xf7,
+	0xf4, 0xe1, 0xc8, 0x35, 0xfe, 0x74, 0xb5, 0x01, 0xe6, 0x7a, 0x03, 0xcc, 0x9f, 0x0d, 0x30, 0x3f,
+	0xb6, 0xc0, 0x58, 0x6f, 0x81, 0xf1, 0xb9, 0x05, 0xc6, 0xd3, 0x28, 0x4e, 0xe4, 0xa2, 0x0c, 0x3d,
+	0xc2, 0x53, 0x74, 0x98, 0xe9, 0x86, 0xe1, 0x50, 0x34, 0x3f, 0xe8, 0x65, 0x74, 0x8b, 0xde, 0x9a,
+	0x59, 0x65, 0x95, 0x53, 0x11, 0x76, 0xd4, 0x60, 0x77, 0xbf, 0x03, 0x00, 0x0c, 0x90, 0xdd, 0x34,
+	0xf5, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +133,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnInstantUnlockPenalty {
+		i--
+		if m.BurnInstantUnlockPenalty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.InstantUnlockPenalty.Size()
+		i -= size
+		if _, err := m.InstantUnlockPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.ForceUnlockAllowedAddresses) > 0 {
 		for iNdEx := len(m.ForceUnlockAllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ForceUnlockAllowedAddresses[iNdEx])
@@ -146,6 +188,11 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.InstantUnlockPenalty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BurnInstantUnlockPenalty {
+		n += 2
+	}
 	return n
 }
 
@@ -216,6 +263,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ForceUnlockAllowedAddresses = append(m.ForceUnlockAllowedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnlockPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnlockPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnInstantUnlockPenalty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnInstantUnlockPenalty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
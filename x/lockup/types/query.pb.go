@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -375,6 +376,9 @@ func (m *AccountUnlockingCoinsResponse) GetCoins() github_com_cosmos_cosmos_sdk_
 
 type AccountLockedCoinsRequest struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// pagination defines an optional pagination over the denoms of the locked
+	// coins, all of them are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedCoinsRequest) Reset()         { *m = AccountLockedCoinsRequest{} }
@@ -417,8 +421,16 @@ func (m *AccountLockedCoinsRequest) GetOwner() string {
 	return ""
 }
 
+func (m *AccountLockedCoinsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedCoinsResponse struct {
-	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	Coins      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	Pagination *query.PageResponse                      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedCoinsResponse) Reset()         { *m = AccountLockedCoinsResponse{} }
@@ -461,6 +473,13 @@ func (m *AccountLockedCoinsResponse) GetCoins() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *AccountLockedCoinsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
@@ -1208,7 +1227,8 @@ func (m *NextLockIDResponse) GetLockId() uint64 {
 
 // Deprecated: Do not use.
 type SyntheticLockupsByLockupIDRequest struct {
-	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	LockId     uint64             `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *SyntheticLockupsByLockupIDRequest) Reset()         { *m = SyntheticLockupsByLockupIDRequest{} }
@@ -1251,9 +1271,17 @@ func (m *SyntheticLockupsByLockupIDRequest) GetLockId() uint64 {
 	return 0
 }
 
+func (m *SyntheticLockupsByLockupIDRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // Deprecated: Do not use.
 type SyntheticLockupsByLockupIDResponse struct {
-	SyntheticLocks []SyntheticLock `protobuf:"bytes,1,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	SyntheticLocks []SyntheticLock     `protobuf:"bytes,1,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *SyntheticLockupsByLockupIDResponse) Reset()         { *m = SyntheticLockupsByLockupIDResponse{} }
@@ -1296,6 +1324,13 @@ func (m *SyntheticLockupsByLockupIDResponse) GetSyntheticLocks() []SyntheticLock
 	return nil
 }
 
+func (m *SyntheticLockupsByLockupIDResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type SyntheticLockupByLockupIDRequest struct {
 	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 }
@@ -1387,6 +1422,9 @@ func (m *SyntheticLockupByLockupIDResponse) GetSyntheticLock() SyntheticLock {
 type AccountLockedLongerDurationRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// pagination defines an optional pagination over the locks, ordered by lock
+	// id, all of them are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationRequest) Reset()         { *m = AccountLockedLongerDurationRequest{} }
@@ -1436,8 +1474,16 @@ func (m *AccountLockedLongerDurationRequest) GetDuration() time.Duration {
 	return 0
 }
 
+func (m *AccountLockedLongerDurationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationResponse struct {
-	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	Locks      []PeriodLock        `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationResponse) Reset()         { *m = AccountLockedLongerDurationResponse{} }
@@ -1480,9 +1526,19 @@ func (m *AccountLockedLongerDurationResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedLongerDurationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedDurationRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// pagination defines an optional pagination over the locks, ordered by lock
+	// id, all of them are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedDurationRequest) Reset()         { *m = AccountLockedDurationRequest{} }
@@ -1532,8 +1588,16 @@ func (m *AccountLockedDurationRequest) GetDuration() time.Duration {
 	return 0
 }
 
+func (m *AccountLockedDurationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedDurationResponse struct {
-	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	Locks      []PeriodLock        `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedDurationResponse) Reset()         { *m = AccountLockedDurationResponse{} }
@@ -1576,6 +1640,13 @@ func (m *AccountLockedDurationResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedDurationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationNotUnlockingOnlyRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
@@ -1788,6 +1859,140 @@ func (m *AccountLockedLongerDurationDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+type AccountLockSummaryRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *AccountLockSummaryRequest) Reset()         { *m = AccountLockSummaryRequest{} }
+func (m *AccountLockSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountLockSummaryRequest) ProtoMessage()    {}
+func (*AccountLockSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{38}
+}
+func (m *AccountLockSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLockSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLockSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountLockSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLockSummaryRequest.Merge(m, src)
+}
+func (m *AccountLockSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLockSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLockSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLockSummaryRequest proto.InternalMessageInfo
+
+func (m *AccountLockSummaryRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type AccountLockSummaryResponse struct {
+	// locked_coins are the coins not unlocking and the coins not finished
+	// unlocking, as returned by AccountLockedCoins.
+	LockedCoins     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=locked_coins,json=lockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_coins" yaml:"locked_coins"`
+	UnlockingCoins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unlocking_coins,json=unlockingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocking_coins" yaml:"unlocking_coins"`
+	UnlockableCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unlockable_coins,json=unlockableCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlockable_coins" yaml:"unlockable_coins"`
+	// num_locks is the number of locks of the account, including the unlocking
+	// ones.
+	NumLocks          uint64 `protobuf:"varint,4,opt,name=num_locks,json=numLocks,proto3" json:"num_locks,omitempty" yaml:"num_locks"`
+	NumUnlockingLocks uint64 `protobuf:"varint,5,opt,name=num_unlocking_locks,json=numUnlockingLocks,proto3" json:"num_unlocking_locks,omitempty" yaml:"num_unlocking_locks"`
+	// num_synthetic_locks is the number of locks of the account with a synthetic
+	// lock, that is superfluid delegated or undelegating.
+	NumSyntheticLocks uint64 `protobuf:"varint,6,opt,name=num_synthetic_locks,json=numSyntheticLocks,proto3" json:"num_synthetic_locks,omitempty" yaml:"num_synthetic_locks"`
+}
+
+func (m *AccountLockSummaryResponse) Reset()         { *m = AccountLockSummaryResponse{} }
+func (m *AccountLockSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountLockSummaryResponse) ProtoMessage()    {}
+func (*AccountLockSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{39}
+}
+func (m *AccountLockSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLockSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLockSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountLockSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLockSummaryResponse.Merge(m, src)
+}
+func (m *AccountLockSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLockSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLockSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLockSummaryResponse proto.InternalMessageInfo
+
+func (m *AccountLockSummaryResponse) GetLockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedCoins
+	}
+	return nil
+}
+
+func (m *AccountLockSummaryResponse) GetUnlockingCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockingCoins
+	}
+	return nil
+}
+
+func (m *AccountLockSummaryResponse) GetUnlockableCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockableCoins
+	}
+	return nil
+}
+
+func (m *AccountLockSummaryResponse) GetNumLocks() uint64 {
+	if m != nil {
+		return m.NumLocks
+	}
+	return 0
+}
+
+func (m *AccountLockSummaryResponse) GetNumUnlockingLocks() uint64 {
+	if m != nil {
+		return m.NumUnlockingLocks
+	}
+	return 0
+}
+
+func (m *AccountLockSummaryResponse) GetNumSyntheticLocks() uint64 {
+	if m != nil {
+		return m.NumSyntheticLocks
+	}
+	return 0
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -1795,7 +2000,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{38}
+	return fileDescriptor_e906fda01cffd91a, []int{40}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1832,7 +2037,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{39}
+	return fileDescriptor_e906fda01cffd91a, []int{41}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1907,6 +2112,8 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationNotUnlockingOnlyResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationNotUnlockingOnlyResponse")
 	proto.RegisterType((*AccountLockedLongerDurationDenomRequest)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomRequest")
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
+	proto.RegisterType((*AccountLockSummaryRequest)(nil), "osmosis.lockup.AccountLockSummaryRequest")
+	proto.RegisterType((*AccountLockSummaryResponse)(nil), "osmosis.lockup.AccountLockSummaryResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.lockup.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.lockup.QueryParamsResponse")
 }
@@ -1914,112 +2121,129 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x14, 0x55,
-	0x1c, 0xef, 0x2b, 0xb4, 0xca, 0x17, 0x5b, 0xf0, 0x51, 0xb0, 0x9d, 0x96, 0xdd, 0xf2, 0x80, 0x52,
-	0xb5, 0x9d, 0xa1, 0xa5, 0x29, 0x88, 0xfc, 0x5c, 0x4a, 0x4d, 0xb1, 0x22, 0x2c, 0xa8, 0xf1, 0x57,
-	0x36, 0xb3, 0xbb, 0x8f, 0x65, 0xd2, 0xdd, 0x99, 0x65, 0x67, 0xb6, 0xb0, 0x12, 0x24, 0x82, 0x07,
-	0x0f, 0x1e, 0x30, 0x5e, 0x8c, 0x07, 0xa3, 0xde, 0xd4, 0xc4, 0x78, 0xf1, 0x40, 0xbc, 0x2b, 0xf1,
-	0x60, 0x48, 0xbc, 0x18, 0x0f, 0xc5, 0x50, 0xff, 0x02, 0x4e, 0x26, 0x5e, 0xcc, 0xbc, 0xf7, 0x66,
-	0xbb, 0xf3, 0x73, 0x67, 0xbb, 0xd2, 0xf4, 0xd4, 0xce, 0x7c, 0x7f, 0x7d, 0x3e, 0xdf, 0xf7, 0xf3,
-	0x33, 0x0b, 0x92, 0x61, 0x96, 0x0c, 0x53, 0x33, 0x95, 0xa2, 0x91, 0x5b, 0xa8, 0x96, 0x95, 0x2b,
-	0x55, 0x5a, 0xa9, 0xc9, 0xe5, 0x8a, 0x61, 0x19, 0xb8, 0x57, 0xd8, 0x64, 0x6e, 0x93, 0xfa, 0x0a,
-	0x46, 0xc1, 0x60, 0x26, 0xc5, 0xfe, 0x8f, 0x7b, 0x49, 0x89, 0x1c, 0x73, 0x53, 0xb2, 0xaa, 0x49,
-	0x95, 0xc5, 0x89, 0x2c, 0xb5, 0xd4, 0x09, 0x25, 0x67, 0x68, 0xba, 0xb0, 0x0f, 0x15, 0x0c, 0xa3,
-	0x50, 0xa4, 0x8a, 0x5a, 0xd6, 0x14, 0x55, 0xd7, 0x0d, 0x4b, 0xb5, 0x34, 0x43, 0x37, 0x85, 0x35,
-	0x29, 0xac, 0xec, 0x29, 0x5b, 0xbd, 0xa4, 0x58, 0x5a, 0x89, 0x9a, 0x96, 0x5a, 0x2a, 0x3b, 0xe9,
-	0xbd, 0x0e, 0xf9, 0x6a, 0x85, 0x65, 0x10, 0xf6, 0x01, 0x0f, 0x01, 0xfb, 0x8f, 0x30, 0x0d, 0x7a,
-	0x4c, 0x65, 0xb5, 0xa2, 0x96, 0x44, 0x61, 0xb2, 0x03, 0xfa, 0x5e, 0x31, 0xf2, 0xd5, 0x22, 0x4d,
-	0xa9, 0x45, 0x55, 0xcf, 0xd1, 0x34, 0xbd, 0x52, 0xa5, 0xa6, 0x45, 0xde, 0x83, 0xed, 0x9e, 0xf7,
-	0x66, 0xd9, 0xd0, 0x4d, 0x8a, 0x55, 0xe8, 0xb2, 0x59, 0x99, 0xfd, 0x68, 0x78, 0xc3, 0xe8, 0xe6,
-	0xc9, 0x01, 0x99, 0xf3, 0x96, 0x6d, 0xde, 0xb2, 0xe0, 0x2d, 0x9f, 0x32, 0x34, 0x3d, 0xb5, 0xff,
-	0xde, 0x52, 0xb2, 0xe3, 0xdb, 0x07, 0xc9, 0xd1, 0x82, 0x66, 0x5d, 0xae, 0x66, 0xe5, 0x9c, 0x51,
-	0x52, 0x44, 0x93, 0xf8, 0x9f, 0x71, 0x33, 0xbf, 0xa0, 0x58, 0xb5, 0x32, 0x35, 0x59, 0x80, 0x99,
-	0xe6, 0x99, 0xc9, 0x20, 0x0c, 0xf0, 0xda, 0xf3, 0x46, 0x6e, 0x81, 0xe6, 0x4f, 0x96, 0x8c, 0xaa,
-	0x6e, 0x39, 0xc0, 0x6e, 0x82, 0x14, 0x64, 0x5c, 0x3b, 0x74, 0x2f, 0xc1, 0xce, 0x93, 0xb9, 0x9c,
-	0x5d, 0xf5, 0x35, 0xdd, 0xee, 0xa8, 0x9a, 0x2d, 0x52, 0xee, 0xc0, 0x11, 0xe2, 0x11, 0xe8, 0x32,
-	0xae, 0xea, 0xb4, 0xd2, 0x8f, 0x86, 0xd1, 0xe8, 0xa6, 0xd4, 0xd6, 0x47, 0x4b, 0xc9, 0xa7, 0x6a,
-	0x6a, 0xa9, 0x78, 0x98, 0xb0, 0xd7, 0x24, 0xcd, 0xcd, 0xe4, 0x36, 0x82, 0x44, 0x58, 0xa6, 0xb5,
-	0xa3, 0x33, 0x0b, 0x43, 0x2e, 0x10, 0x9a, 0x5e, 0x58, 0x15, 0x9b, 0x5b, 0xc8, 0xd3, 0x97, 0x95,
-	0x44, 0x6b, 0x47, 0xe6, 0x14, 0x0c, 0x08, 0x0c, 0x7c, 0x76, 0xac, 0x8a, 0xc9, 0x4d, 0x90, 0x82,
-	0x92, 0xac, 0x1d, 0x8b, 0x2f, 0x50, 0x7d, 0x4c, 0x38, 0x82, 0x73, 0xaa, 0x69, 0x5d, 0xd4, 0x4a,
-	0xb4, 0x45, 0x26, 0xf8, 0x75, 0xd8, 0x54, 0xdf, 0x47, 0xfa, 0x3b, 0x87, 0xd1, 0xe8, 0xe6, 0x49,
-	0x49, 0xe6, 0x1b, 0x89, 0xec, 0x6c, 0x24, 0xf2, 0x45, 0xc7, 0x23, 0x35, 0x64, 0x03, 0x7e, 0xb4,
-	0x94, 0xdc, 0xca, 0x73, 0xd5, 0x43, 0xc9, 0x9d, 0x07, 0x49, 0x94, 0x5e, 0x49, 0x45, 0xde, 0xa8,
-	0x0f, 0xb5, 0x17, 0x9f, 0x68, 0xd2, 0x34, 0x74, 0xd9, 0x53, 0xc0, 0x69, 0x92, 0x24, 0xbb, 0xb7,
-	0x50, 0xf9, 0x1c, 0xad, 0x68, 0x46, 0xde, 0x0e, 0x4e, 0x6d, 0xb4, 0x8b, 0xa6, 0xb9, 0x3b, 0xf9,
-	0x1e, 0xc1, 0x58, 0x60, 0xe6, 0xb3, 0xc6, 0xca, 0xac, 0x7a, 0x55, 0x2f, 0xd6, 0xd6, 0x4b, 0x27,
-	0x0a, 0x30, 0x1e, 0x13, 0x6f, 0x9b, 0x9d, 0xf9, 0x1a, 0xc1, 0xb0, 0x6b, 0x79, 0xd1, 0x7c, 0x8a,
-	0x5e, 0x32, 0x2a, 0x74, 0x3d, 0xcd, 0x8b, 0xb7, 0x61, 0x57, 0x04, 0xc6, 0x36, 0x3b, 0x70, 0x17,
-	0xd5, 0xb3, 0xbb, 0x7b, 0x3d, 0x43, 0x75, 0xa3, 0xb4, 0x4e, 0x5a, 0x80, 0xfb, 0xa0, 0x2b, 0x6f,
-	0xe3, 0xe9, 0xdf, 0x60, 0xd7, 0x4f, 0xf3, 0x07, 0xf2, 0x0e, 0x90, 0x28, 0xe8, 0x6d, 0x76, 0xe6,
-	0x7d, 0xc0, 0x3c, 0xad, 0xab, 0x13, 0x75, 0x24, 0xa8, 0x01, 0x09, 0x4e, 0xc3, 0x93, 0xce, 0xcd,
-	0x41, 0xd0, 0x1e, 0xf0, 0xd1, 0x9e, 0x11, 0x0e, 0xa9, 0x41, 0xc1, 0x7a, 0x0b, 0x67, 0xed, 0x04,
-	0x92, 0xcf, 0x6c, 0xd2, 0xf5, 0x3c, 0xe4, 0x5d, 0xd8, 0xe6, 0xaa, 0x2f, 0xe8, 0xcc, 0x42, 0xb7,
-	0xca, 0x4e, 0x67, 0x31, 0x16, 0xb2, 0x9d, 0xed, 0xcf, 0xa5, 0xe4, 0x76, 0xbe, 0xfb, 0x99, 0xf9,
-	0x05, 0x59, 0x33, 0x94, 0x92, 0x6a, 0x5d, 0x96, 0xe7, 0x74, 0xeb, 0xd1, 0x52, 0xb2, 0x87, 0x97,
-	0xe1, 0x41, 0x24, 0x2d, 0xa2, 0xc9, 0x28, 0xf4, 0xf0, 0xf4, 0x0e, 0xb3, 0x67, 0xe0, 0x09, 0x9b,
-	0x78, 0x46, 0xcb, 0xb3, 0xcc, 0x1b, 0xd3, 0xdd, 0xf6, 0xe3, 0x5c, 0x9e, 0x9c, 0x80, 0x5e, 0xc7,
-	0x53, 0x60, 0x90, 0x61, 0xa3, 0x6d, 0x63, 0x7e, 0x91, 0x1d, 0x4d, 0x33, 0x3f, 0x32, 0x05, 0x03,
-	0xec, 0x89, 0x5e, 0x55, 0x2b, 0xf9, 0x34, 0xcd, 0x51, 0x6d, 0x91, 0x56, 0x9a, 0xd6, 0x3d, 0x0d,
-	0x52, 0x50, 0x94, 0xc0, 0xb0, 0x0f, 0xb6, 0x54, 0x98, 0x25, 0x53, 0x11, 0x26, 0x31, 0x24, 0xbd,
-	0x15, 0x57, 0x00, 0xd9, 0x06, 0x4f, 0x9f, 0xa5, 0xd7, 0xd8, 0x14, 0x99, 0x9b, 0x71, 0xee, 0x3b,
-	0xe3, 0x80, 0x1b, 0x5f, 0x8a, 0x9c, 0x11, 0x2d, 0xd8, 0x75, 0xa1, 0xa6, 0x5b, 0x97, 0xa9, 0xa5,
-	0xe5, 0xe6, 0x19, 0x49, 0x33, 0x55, 0xe3, 0xff, 0xd4, 0x73, 0x86, 0x46, 0x1f, 0xee, 0xec, 0x47,
-	0x64, 0x11, 0x48, 0x54, 0x06, 0x01, 0x60, 0x1e, 0xb6, 0x98, 0x8e, 0x57, 0xa6, 0x71, 0xd6, 0xee,
-	0xf4, 0xf6, 0xd8, 0x95, 0x4c, 0x4c, 0xdc, 0x5e, 0xb3, 0xf1, 0xa5, 0xc9, 0xea, 0xbe, 0x08, 0xc3,
-	0x9e, 0xba, 0xf1, 0x81, 0x13, 0xc3, 0x47, 0x3b, 0x00, 0xf3, 0x19, 0xe8, 0x75, 0x63, 0x16, 0xd3,
-	0x22, 0x16, 0xe4, 0x1e, 0x17, 0x64, 0xf2, 0x25, 0xf2, 0x2c, 0xe9, 0x79, 0x43, 0x2f, 0xd0, 0x8a,
-	0xb3, 0x74, 0x5a, 0xdd, 0x8e, 0x1e, 0xcf, 0xb2, 0xdc, 0x1d, 0x89, 0xb0, 0xcd, 0x5d, 0xe7, 0x73,
-	0xef, 0x2d, 0x65, 0x3d, 0x71, 0xf7, 0xde, 0x50, 0xfe, 0x37, 0xd6, 0x3f, 0x20, 0x98, 0x8c, 0xe8,
-	0x6a, 0xbb, 0xf7, 0x94, 0xc7, 0xd1, 0x8b, 0x12, 0x1c, 0x68, 0x09, 0x71, 0x9b, 0x1d, 0xfa, 0x09,
-	0xc1, 0xbe, 0x88, 0x7a, 0xab, 0x3a, 0xad, 0x1f, 0x43, 0x5b, 0x42, 0x4e, 0xea, 0x2c, 0x8c, 0x36,
-	0x07, 0xdf, 0x66, 0x87, 0xfa, 0x00, 0x9f, 0xaf, 0xd2, 0x4a, 0xed, 0x1c, 0x13, 0xe2, 0xce, 0x46,
-	0xff, 0x32, 0x6c, 0x73, 0xbd, 0x15, 0x45, 0xa6, 0xa0, 0x9b, 0x0b, 0x76, 0xb1, 0x59, 0xed, 0xf0,
-	0x55, 0x61, 0x56, 0x51, 0x41, 0xf8, 0x4e, 0xfe, 0x3b, 0x04, 0x5d, 0x2c, 0x1b, 0xfe, 0x18, 0x41,
-	0x8f, 0x4b, 0xc9, 0xe3, 0x3d, 0xde, 0x0c, 0x41, 0x1f, 0x00, 0xa4, 0xbd, 0x4d, 0xbc, 0x38, 0x3c,
-	0x22, 0xdf, 0xfa, 0xfd, 0xef, 0x4f, 0x3b, 0x47, 0xf1, 0x88, 0xe2, 0xf9, 0xca, 0xe0, 0x7c, 0x02,
-	0x29, 0xb1, 0xb0, 0x4c, 0x56, 0x14, 0xff, 0x0a, 0x01, 0xf6, 0xeb, 0x77, 0xfc, 0x6c, 0x70, 0xb5,
-	0x80, 0x0f, 0x00, 0xd2, 0x73, 0x71, 0x5c, 0x05, 0xba, 0x29, 0x86, 0x4e, 0xc6, 0x63, 0x4d, 0xd0,
-	0xf1, 0xcb, 0x6a, 0x86, 0x5f, 0x38, 0xf0, 0x5d, 0x04, 0x3b, 0x82, 0x85, 0x39, 0x1e, 0xf7, 0x16,
-	0x8f, 0xfc, 0x14, 0x20, 0xc9, 0x71, 0xdd, 0x05, 0xde, 0x13, 0x0c, 0xef, 0x61, 0x7c, 0x28, 0x0c,
-	0xaf, 0xca, 0xe3, 0x33, 0xd5, 0x7a, 0x82, 0x0c, 0xd3, 0x8c, 0xca, 0x75, 0xb6, 0x50, 0x6e, 0xe0,
-	0x1f, 0x11, 0x6c, 0x0f, 0x94, 0xe1, 0x78, 0x2c, 0x12, 0x8b, 0x47, 0xf6, 0x4b, 0xe3, 0x31, 0xbd,
-	0x05, 0xf0, 0xe3, 0x0c, 0xf8, 0x0b, 0xf8, 0x60, 0x3c, 0xe0, 0x9a, 0x5e, 0xf0, 0xe0, 0xfe, 0x06,
-	0x01, 0xf6, 0xab, 0x6e, 0xff, 0xbc, 0x08, 0x95, 0xf7, 0xfe, 0x79, 0x11, 0x2e, 0xe2, 0xc9, 0x11,
-	0x06, 0x77, 0x1a, 0x4f, 0x35, 0x83, 0x2b, 0x26, 0x46, 0x68, 0x8f, 0xdd, 0xd7, 0xf9, 0xd0, 0x1e,
-	0x07, 0xca, 0xf8, 0xd0, 0x1e, 0x07, 0x8b, 0xea, 0xf8, 0x3d, 0x16, 0xa0, 0xcb, 0xaa, 0x69, 0xd9,
-	0xc2, 0xa4, 0x8e, 0xfb, 0x1f, 0x04, 0x7b, 0x63, 0xa9, 0x55, 0x7c, 0x24, 0x16, 0xb2, 0x90, 0xc3,
-	0x4e, 0x3a, 0xba, 0xca, 0x68, 0xc1, 0x33, 0xcd, 0x78, 0xce, 0xe3, 0x33, 0x2d, 0xf2, 0xcc, 0xe8,
-	0x46, 0xe3, 0xfc, 0x32, 0xf4, 0x62, 0xad, 0x4e, 0xfd, 0x67, 0x54, 0xff, 0x32, 0xe4, 0x97, 0xa6,
-	0x78, 0x7f, 0xe4, 0x64, 0x0f, 0x50, 0xda, 0xd2, 0x44, 0x0b, 0x11, 0x82, 0xd6, 0x0c, 0xa3, 0x75,
-	0x0c, 0x1f, 0x89, 0xb7, 0x44, 0x68, 0x3e, 0x93, 0x65, 0x49, 0x32, 0xae, 0x31, 0xfc, 0x15, 0x79,
-	0xbe, 0x4e, 0xb9, 0xa4, 0x24, 0x9e, 0x88, 0xd5, 0xfa, 0xc6, 0x33, 0x58, 0x9a, 0x6c, 0x25, 0x44,
-	0x70, 0x39, 0xcd, 0xb8, 0x1c, 0xc7, 0x47, 0x5b, 0x1d, 0x22, 0x76, 0xc8, 0xd6, 0xc9, 0x7c, 0x88,
-	0x60, 0x73, 0x83, 0x72, 0xc4, 0xc4, 0x0b, 0xc5, 0x2f, 0x6b, 0xa5, 0xdd, 0x91, 0x3e, 0x02, 0xdf,
-	0x18, 0xc3, 0x37, 0x82, 0xf7, 0x84, 0xe1, 0x13, 0xb8, 0xb8, 0x26, 0xbe, 0x8d, 0x00, 0x78, 0x96,
-	0x54, 0x6d, 0x6e, 0x06, 0xef, 0x0c, 0xae, 0xe0, 0x00, 0x48, 0x84, 0x99, 0x45, 0xed, 0x69, 0x56,
-	0x7b, 0x3f, 0x96, 0x9b, 0xd4, 0xce, 0xd6, 0x32, 0x5a, 0x5e, 0xb9, 0x2e, 0xf4, 0xcc, 0x0d, 0xfc,
-	0x1d, 0xe2, 0x32, 0xde, 0xad, 0x22, 0xfd, 0x3b, 0x60, 0xa8, 0x3e, 0xf5, 0xef, 0x80, 0xe1, 0xa2,
-	0x94, 0x1c, 0x63, 0x28, 0x0f, 0xe1, 0xe9, 0x28, 0x94, 0x19, 0x8f, 0x6e, 0x6d, 0x40, 0xfb, 0x01,
-	0x02, 0x58, 0xd1, 0xa5, 0x78, 0x97, 0xb7, 0xb4, 0x4f, 0xc8, 0x4a, 0x24, 0xca, 0x25, 0xee, 0xb8,
-	0xe9, 0xf4, 0x1a, 0x9f, 0x54, 0x19, 0x2d, 0x8f, 0x7f, 0x43, 0x20, 0x85, 0x4b, 0x55, 0xff, 0x5a,
-	0x68, 0x2a, 0x8c, 0xfd, 0x6b, 0xa1, 0xb9, 0x12, 0x26, 0x73, 0x0c, 0xf3, 0x09, 0x7c, 0x2c, 0x0c,
-	0xb3, 0x5b, 0x73, 0x56, 0xcb, 0xa6, 0x3d, 0xf4, 0x82, 0xc3, 0x4a, 0x47, 0x3f, 0xea, 0x44, 0xf8,
-	0x17, 0x04, 0x03, 0xa1, 0x32, 0xd6, 0xbf, 0x4b, 0x35, 0x93, 0xcb, 0xd2, 0x44, 0x0b, 0x11, 0x71,
-	0x57, 0xb6, 0x97, 0x4d, 0x20, 0x19, 0x7b, 0x68, 0x06, 0x23, 0xee, 0xd1, 0x38, 0x7a, 0xd3, 0x09,
-	0xd4, 0xd2, 0xd2, 0x81, 0x96, 0x62, 0x04, 0x9f, 0xd9, 0x66, 0xa3, 0xe3, 0xd9, 0xa9, 0x8a, 0x2c,
-	0x4d, 0xc6, 0x51, 0x09, 0xe1, 0x67, 0x7e, 0x9d, 0x4a, 0xf4, 0x99, 0xef, 0x25, 0x31, 0x1e, 0xd3,
-	0x7b, 0x95, 0x67, 0xbe, 0x0f, 0xf7, 0x27, 0x9d, 0xf0, 0x7c, 0x0b, 0xea, 0x0f, 0xa7, 0x5a, 0x68,
-	0x72, 0xd8, 0xf9, 0x7f, 0xaa, 0xad, 0x1c, 0x82, 0xf9, 0x9b, 0x8c, 0xf9, 0x05, 0x7c, 0x7e, 0x75,
-	0x03, 0x17, 0x75, 0x19, 0x58, 0x5e, 0xf9, 0x96, 0x1e, 0x2a, 0xf2, 0xf0, 0xc1, 0x16, 0x48, 0xb8,
-	0x0e, 0xa8, 0x43, 0xad, 0x07, 0x0a, 0xca, 0xf3, 0x8c, 0xf2, 0x2c, 0x9e, 0x59, 0x25, 0x65, 0xf7,
-	0xe1, 0x5a, 0x83, 0x6e, 0x2e, 0x0d, 0xfd, 0xc7, 0xaa, 0x5f, 0x7d, 0xfa, 0x8f, 0xd5, 0x00, 0x2d,
-	0x4a, 0x46, 0x18, 0xc0, 0x61, 0x9c, 0x08, 0x03, 0xc8, 0xd5, 0x67, 0x6a, 0xfe, 0xde, 0xc3, 0x04,
-	0xba, 0xff, 0x30, 0x81, 0xfe, 0x7a, 0x98, 0x40, 0x77, 0x96, 0x13, 0x1d, 0xf7, 0x97, 0x13, 0x1d,
-	0x7f, 0x2c, 0x27, 0x3a, 0xde, 0x9a, 0x6c, 0xf8, 0x2d, 0x4c, 0xe4, 0x18, 0x2f, 0xaa, 0x59, 0xb3,
-	0x9e, 0x70, 0x71, 0x72, 0x42, 0xb9, 0xe6, 0xa4, 0x65, 0xbf, 0x8d, 0x65, 0xbb, 0x99, 0xc4, 0x3f,
-	0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xa4, 0x1a, 0x7b, 0xa4, 0x1f, 0x00, 0x00,
+	// 1950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4d, 0x6c, 0xdc, 0x58,
+	0x1d, 0xcf, 0xcb, 0x17, 0xdb, 0x7f, 0x36, 0x49, 0xf7, 0x25, 0xcd, 0x26, 0x4e, 0x32, 0x93, 0xb8,
+	0xbb, 0x69, 0x08, 0x19, 0xbb, 0x99, 0x46, 0xd9, 0x52, 0xba, 0xdd, 0xee, 0x34, 0x9b, 0x2a, 0xdd,
+	0x50, 0xb2, 0xd3, 0x05, 0xc4, 0x97, 0x46, 0x9e, 0x19, 0xef, 0xd4, 0xca, 0xd8, 0x9e, 0x1d, 0xdb,
+	0xdd, 0x0e, 0xab, 0x05, 0xd1, 0x82, 0x54, 0x09, 0x84, 0x5a, 0x71, 0xe1, 0x80, 0x10, 0x70, 0x00,
+	0x01, 0x12, 0xe2, 0xc2, 0xa1, 0xe2, 0xc0, 0x0d, 0x2a, 0x0e, 0xa8, 0x12, 0x17, 0xc4, 0x21, 0x45,
+	0x09, 0x07, 0xce, 0x39, 0x71, 0x44, 0x7e, 0xef, 0xd9, 0x33, 0xf6, 0xd8, 0x1e, 0x7b, 0xa6, 0x8d,
+	0x22, 0x4e, 0xcd, 0xf8, 0xfd, 0x3f, 0x7e, 0xbf, 0xff, 0xfb, 0xfa, 0xbf, 0x5f, 0x81, 0xd3, 0x0d,
+	0x55, 0x37, 0x14, 0x43, 0xac, 0xea, 0xa5, 0x3d, 0xab, 0x26, 0x7e, 0x68, 0xc9, 0xf5, 0x86, 0x50,
+	0xab, 0xeb, 0xa6, 0x8e, 0xc7, 0xd8, 0x98, 0x40, 0xc7, 0xb8, 0xc9, 0x8a, 0x5e, 0xd1, 0xc9, 0x90,
+	0x68, 0xff, 0x45, 0xad, 0xb8, 0x54, 0x89, 0x98, 0x89, 0x45, 0xc9, 0x90, 0xc5, 0x3b, 0x6b, 0x45,
+	0xd9, 0x94, 0xd6, 0xc4, 0x92, 0xae, 0x68, 0x6c, 0x7c, 0xa5, 0x75, 0x9c, 0x84, 0x77, 0xad, 0x6a,
+	0x52, 0x45, 0xd1, 0x24, 0x53, 0xd1, 0x1d, 0xdb, 0xb9, 0x8a, 0xae, 0x57, 0xaa, 0xb2, 0x28, 0xd5,
+	0x14, 0x51, 0xd2, 0x34, 0xdd, 0x24, 0x83, 0x06, 0x1b, 0x4d, 0xb3, 0x51, 0xf2, 0xab, 0x68, 0x7d,
+	0x20, 0x9a, 0x8a, 0x2a, 0x1b, 0xa6, 0xa4, 0xd6, 0x1c, 0x28, 0x7e, 0x83, 0xb2, 0x55, 0x6f, 0x0d,
+	0x3f, 0xe3, 0x23, 0x6b, 0xff, 0xc3, 0x86, 0x66, 0x7d, 0x43, 0x35, 0xa9, 0x2e, 0xa9, 0x2c, 0x31,
+	0x3f, 0x05, 0x93, 0x9f, 0xd7, 0xcb, 0x56, 0x55, 0xce, 0x49, 0x55, 0x49, 0x2b, 0xc9, 0x79, 0xf9,
+	0x43, 0x4b, 0x36, 0x4c, 0xfe, 0x9b, 0x70, 0xc6, 0xf7, 0xdd, 0xa8, 0xe9, 0x9a, 0x21, 0x63, 0x09,
+	0x86, 0xec, 0x0a, 0x18, 0xd3, 0x68, 0x61, 0x60, 0x79, 0x24, 0x3b, 0x23, 0xd0, 0x1a, 0x08, 0x76,
+	0x0d, 0x04, 0xc6, 0x5e, 0xb8, 0xa6, 0x2b, 0x5a, 0xee, 0xfc, 0x93, 0xfd, 0x74, 0xdf, 0x6f, 0x9e,
+	0xa5, 0x97, 0x2b, 0x8a, 0x79, 0xdb, 0x2a, 0x0a, 0x25, 0x5d, 0x15, 0x59, 0xc1, 0xe8, 0x3f, 0x19,
+	0xa3, 0xbc, 0x27, 0x9a, 0x8d, 0x9a, 0x6c, 0x10, 0x07, 0x23, 0x4f, 0x23, 0xf3, 0xb3, 0x30, 0x43,
+	0x73, 0xef, 0xe8, 0xa5, 0x3d, 0xb9, 0xfc, 0xb6, 0xaa, 0x5b, 0x9a, 0xe9, 0x00, 0xfb, 0x36, 0x70,
+	0x41, 0x83, 0xc7, 0x87, 0xee, 0x3a, 0xcc, 0xbf, 0x5d, 0x2a, 0xd9, 0x59, 0xbf, 0xa8, 0xd9, 0x15,
+	0x95, 0x8a, 0x55, 0x99, 0x1a, 0x50, 0x84, 0x78, 0x09, 0x86, 0xf4, 0x8f, 0x34, 0xb9, 0x3e, 0x8d,
+	0x16, 0xd0, 0xf2, 0xa9, 0xdc, 0xe9, 0xa3, 0xfd, 0xf4, 0xcb, 0x0d, 0x49, 0xad, 0x5e, 0xe2, 0xc9,
+	0x67, 0x3e, 0x4f, 0x87, 0xf9, 0xfb, 0x08, 0x52, 0x61, 0x91, 0x8e, 0x8f, 0xce, 0x16, 0xcc, 0x79,
+	0x40, 0x28, 0x5a, 0xa5, 0x2b, 0x36, 0xf7, 0x10, 0xcc, 0x87, 0x04, 0x3a, 0x3e, 0x32, 0xdf, 0x47,
+	0x30, 0xc3, 0x40, 0xd0, 0xe5, 0xd1, 0x0d, 0x15, 0xbc, 0x05, 0xd0, 0xdc, 0xbe, 0xd3, 0xfd, 0x0b,
+	0x68, 0x79, 0x24, 0xbb, 0xe4, 0x41, 0x4b, 0x8f, 0x12, 0x07, 0xf3, 0xae, 0x54, 0x71, 0xf6, 0x4d,
+	0xbe, 0xc5, 0x93, 0x7f, 0x82, 0x80, 0x0b, 0x42, 0x73, 0x6c, 0xf5, 0xc0, 0xd7, 0x03, 0x98, 0x9c,
+	0xeb, 0xc8, 0x84, 0xe2, 0xf3, 0x50, 0xf9, 0x29, 0x82, 0x39, 0x0f, 0x95, 0x5d, 0xc9, 0x30, 0xdf,
+	0x57, 0x54, 0x39, 0x69, 0x6d, 0xbf, 0x04, 0xa7, 0xdc, 0xa3, 0x8d, 0x01, 0xe2, 0x04, 0x7a, 0xb6,
+	0x09, 0xce, 0xd9, 0x26, 0xbc, 0xef, 0x58, 0xe4, 0xe6, 0x6c, 0xe6, 0x47, 0xfb, 0xe9, 0xd3, 0x34,
+	0x96, 0xeb, 0xca, 0x3f, 0x7c, 0x96, 0x46, 0xf9, 0x66, 0x28, 0xfe, 0xcb, 0x30, 0x1f, 0x82, 0x8f,
+	0x55, 0x7b, 0x03, 0x86, 0xec, 0x55, 0xe9, 0x54, 0x9b, 0x13, 0xbc, 0x37, 0x80, 0xb0, 0x2b, 0xd7,
+	0x15, 0xbd, 0x6c, 0x3b, 0xe7, 0x06, 0xed, 0xa4, 0x79, 0x6a, 0xce, 0xff, 0x0e, 0xc1, 0x6a, 0x60,
+	0xe4, 0x9b, 0x7a, 0x73, 0xa1, 0x7f, 0x41, 0xab, 0x36, 0x4e, 0x4a, 0x25, 0x2a, 0x90, 0x89, 0x89,
+	0xb7, 0xc7, 0xca, 0xfc, 0x02, 0xc1, 0x82, 0x67, 0xc7, 0xcb, 0xe5, 0x9c, 0xfc, 0x81, 0x5e, 0x97,
+	0x4f, 0xd2, 0xba, 0xf8, 0x1a, 0x2c, 0x46, 0x60, 0xec, 0xb1, 0x02, 0x8f, 0x91, 0x1b, 0xdd, 0x5b,
+	0xeb, 0x4d, 0x59, 0xd3, 0xd5, 0x13, 0x52, 0x02, 0x3c, 0x09, 0x43, 0x65, 0x1b, 0xcf, 0xf4, 0x80,
+	0x9d, 0x3f, 0x4f, 0x7f, 0xf0, 0x5f, 0x07, 0x3e, 0x0a, 0x7a, 0x8f, 0x95, 0xf9, 0x16, 0x60, 0x1a,
+	0xd6, 0x53, 0x09, 0x17, 0x09, 0x6a, 0x41, 0x82, 0xf3, 0xf0, 0x92, 0xd3, 0xcc, 0x30, 0xda, 0x33,
+	0x6d, 0xb4, 0x37, 0x99, 0x41, 0x6e, 0x96, 0xb1, 0x1e, 0xa7, 0xac, 0x1d, 0x47, 0xfe, 0xc7, 0x36,
+	0x69, 0x37, 0x0e, 0xff, 0x0d, 0x98, 0xf0, 0xe4, 0x67, 0x74, 0xb6, 0x60, 0x58, 0x22, 0x0d, 0x03,
+	0x9b, 0x0b, 0xc1, 0x8e, 0xf6, 0xcf, 0xfd, 0xf4, 0x19, 0x7a, 0x24, 0x1a, 0xe5, 0x3d, 0x41, 0xd1,
+	0x45, 0x55, 0x32, 0x6f, 0x0b, 0xdb, 0x9a, 0x79, 0xb4, 0x9f, 0x1e, 0xa5, 0x69, 0xa8, 0x13, 0x9f,
+	0x67, 0xde, 0xfc, 0x32, 0x8c, 0xd2, 0xf0, 0x0e, 0xb3, 0x57, 0xe1, 0x53, 0x36, 0xf1, 0x82, 0x52,
+	0x26, 0x91, 0x07, 0xf3, 0xc3, 0xf6, 0xcf, 0xed, 0x32, 0x7f, 0x15, 0xc6, 0x1c, 0x4b, 0x86, 0x41,
+	0x80, 0x41, 0x7b, 0x8c, 0xd8, 0x45, 0x56, 0x34, 0x4f, 0xec, 0xf8, 0x75, 0x98, 0x21, 0xbf, 0xe4,
+	0x8f, 0xa4, 0x7a, 0x39, 0x2f, 0x97, 0x64, 0xe5, 0x8e, 0x5c, 0xef, 0x98, 0xf7, 0x1d, 0xe0, 0x82,
+	0xbc, 0x18, 0x86, 0x73, 0x30, 0x5e, 0x27, 0x23, 0x85, 0x3a, 0x1b, 0x62, 0x53, 0x32, 0x56, 0xf7,
+	0x38, 0xf0, 0x13, 0xf0, 0xca, 0x4d, 0xf9, 0x2e, 0x59, 0x22, 0xdb, 0x9b, 0x4e, 0x0b, 0x96, 0x01,
+	0xdc, 0xfa, 0x91, 0xc5, 0x0c, 0x85, 0xf2, 0x00, 0xc1, 0xe2, 0xad, 0x86, 0x66, 0xde, 0x96, 0x4d,
+	0xa5, 0xb4, 0x43, 0x58, 0x1a, 0xb9, 0x06, 0xfd, 0xc3, 0x0d, 0x1a, 0xea, 0xfe, 0xbc, 0x6e, 0xe3,
+	0x4b, 0xfd, 0xd3, 0x88, 0xff, 0x13, 0x02, 0x3e, 0x0a, 0x0a, 0xa3, 0xb2, 0x03, 0xe3, 0x86, 0x63,
+	0x55, 0x68, 0x5d, 0xff, 0xf3, 0xfe, 0xd9, 0xf2, 0x04, 0x63, 0x5b, 0x60, 0xcc, 0x68, 0xfd, 0xf8,
+	0xfc, 0x2e, 0x61, 0xc2, 0xe0, 0x73, 0xb0, 0xe0, 0x23, 0x10, 0xbf, 0x94, 0xbc, 0x0e, 0x8b, 0x11,
+	0xce, 0x8c, 0xfc, 0x0d, 0x18, 0xf3, 0x92, 0x67, 0x2b, 0x35, 0x16, 0xf7, 0x51, 0x0f, 0x77, 0xfe,
+	0x3f, 0xc8, 0x77, 0xca, 0xec, 0xe8, 0x5a, 0x45, 0xae, 0x3b, 0xbb, 0x39, 0xe9, 0x09, 0xf9, 0x02,
+	0x4e, 0x0a, 0xdf, 0xf2, 0x1a, 0xe8, 0xba, 0xd9, 0xfb, 0x25, 0x82, 0xb3, 0x91, 0x54, 0x7b, 0x3b,
+	0x51, 0x9f, 0x5f, 0x2b, 0x77, 0xe0, 0x6f, 0xe5, 0xfe, 0x1f, 0x67, 0xe3, 0x67, 0xc8, 0xd7, 0x0f,
+	0x9e, 0xbc, 0x79, 0xf8, 0x3d, 0x82, 0x6c, 0xc4, 0x82, 0xe9, 0xb5, 0xbd, 0x7c, 0x11, 0xb7, 0xaa,
+	0x0a, 0x17, 0x12, 0x21, 0xee, 0xb1, 0x89, 0xf8, 0x23, 0x82, 0x73, 0x11, 0xf9, 0xba, 0x6a, 0xb2,
+	0x5e, 0xc4, 0xa2, 0x0d, 0x6e, 0xb0, 0x8a, 0xb0, 0xdc, 0x19, 0x7c, 0x8f, 0x15, 0xba, 0xe6, 0x79,
+	0xee, 0xde, 0xb2, 0x54, 0x55, 0xaa, 0x27, 0x5d, 0x29, 0xfc, 0x4f, 0x86, 0x80, 0x0b, 0x8a, 0xc2,
+	0xb0, 0x7d, 0x0f, 0xc1, 0xcb, 0xb4, 0x73, 0x2e, 0xc4, 0x7c, 0xae, 0x5e, 0x67, 0x65, 0x9b, 0xa0,
+	0xd9, 0x5a, 0x9d, 0xf9, 0x44, 0xaf, 0xd8, 0x91, 0x6a, 0xf3, 0xd9, 0x8c, 0x7f, 0x88, 0x60, 0xdc,
+	0x72, 0xd6, 0x17, 0x83, 0xd2, 0xdf, 0x09, 0xca, 0x0d, 0x06, 0x65, 0x8a, 0x42, 0xf1, 0xf9, 0x27,
+	0x43, 0x33, 0x66, 0x79, 0x74, 0x0d, 0xfc, 0x08, 0xc1, 0x69, 0xcb, 0x15, 0x6e, 0x18, 0xa2, 0x81,
+	0x4e, 0x88, 0xde, 0x65, 0x88, 0x5e, 0x6d, 0x45, 0xd4, 0x0c, 0x90, 0x0c, 0xd2, 0xb8, 0xe5, 0x15,
+	0x8e, 0xf0, 0x1a, 0x9c, 0xd2, 0x2c, 0x95, 0xf5, 0x2c, 0x83, 0xf6, 0xe5, 0x9f, 0x9b, 0x6c, 0xbe,
+	0x11, 0xdc, 0x21, 0x3e, 0xff, 0x92, 0x66, 0xa9, 0xb4, 0x3d, 0xb9, 0x09, 0x13, 0xf6, 0xf7, 0x66,
+	0x69, 0xa8, 0xf3, 0x10, 0x71, 0x4e, 0x1d, 0xed, 0xa7, 0xb9, 0xa6, 0xb3, 0xcf, 0x88, 0xcf, 0xbf,
+	0xa2, 0x59, 0xaa, 0xbb, 0xe9, 0x3d, 0xf1, 0xfc, 0x0d, 0xd4, 0x70, 0x50, 0x3c, 0x9f, 0x11, 0x8d,
+	0xe7, 0xe9, 0x2b, 0x0c, 0x7e, 0x12, 0xf0, 0x7b, 0xf6, 0x89, 0xba, 0x4b, 0x64, 0x4b, 0xa7, 0x07,
+	0x7d, 0x17, 0x26, 0x3c, 0x5f, 0xd9, 0x62, 0x5d, 0x87, 0x61, 0x2a, 0x6f, 0xb2, 0xa6, 0x65, 0xaa,
+	0x6d, 0x27, 0x91, 0x51, 0xb6, 0x8b, 0x98, 0x6d, 0xf6, 0x57, 0x29, 0x18, 0x22, 0xd1, 0xf0, 0x0f,
+	0x10, 0x8c, 0x7a, 0x74, 0x4f, 0xfc, 0x9a, 0x3f, 0x42, 0x90, 0x5c, 0xca, 0xbd, 0xde, 0xc1, 0x8a,
+	0xc2, 0xe3, 0x85, 0x7b, 0x7f, 0xff, 0xf7, 0x8f, 0xfa, 0x97, 0xf1, 0x92, 0xe8, 0xd3, 0x64, 0x1d,
+	0xd9, 0x58, 0x25, 0x6e, 0x85, 0x22, 0x4b, 0xfe, 0x73, 0x04, 0xb8, 0x5d, 0xed, 0xc4, 0x9f, 0x0e,
+	0xce, 0x16, 0x20, 0x97, 0x72, 0x2b, 0x71, 0x4c, 0x19, 0xba, 0x75, 0x82, 0x4e, 0xc0, 0xab, 0x1d,
+	0xd0, 0xb1, 0x0d, 0x4d, 0xdf, 0x42, 0xf8, 0x31, 0x82, 0xa9, 0x60, 0x19, 0x13, 0x67, 0xfc, 0xc9,
+	0x23, 0x85, 0x53, 0x4e, 0x88, 0x6b, 0xce, 0xf0, 0x5e, 0x25, 0x78, 0x2f, 0xe1, 0x8b, 0x61, 0x78,
+	0x25, 0xea, 0x5f, 0xf0, 0x6f, 0x32, 0xf1, 0x63, 0x72, 0xf2, 0x7d, 0x82, 0xff, 0x80, 0xe0, 0x4c,
+	0xa0, 0x68, 0x89, 0x57, 0x23, 0xb1, 0xf8, 0x44, 0x52, 0x2e, 0x13, 0xd3, 0x9a, 0x01, 0x7f, 0x8b,
+	0x00, 0xff, 0x2c, 0x7e, 0x23, 0x1e, 0x70, 0xf7, 0xbc, 0x72, 0x71, 0xff, 0x1a, 0x01, 0x6e, 0x57,
+	0x16, 0xdb, 0xd7, 0x45, 0xa8, 0x16, 0xca, 0xad, 0xc4, 0x31, 0x65, 0x70, 0x2f, 0x13, 0xb8, 0x1b,
+	0x78, 0xbd, 0x13, 0xdc, 0xd6, 0x93, 0x3e, 0xa8, 0xc6, 0x5e, 0xa5, 0x21, 0xb4, 0xc6, 0x81, 0x0a,
+	0x23, 0x97, 0x89, 0x69, 0x9d, 0xb4, 0xc6, 0x0c, 0x74, 0x4d, 0x32, 0x4c, 0x5b, 0x33, 0x71, 0x71,
+	0xff, 0x17, 0xc1, 0xeb, 0xb1, 0x84, 0x34, 0x7c, 0x39, 0x16, 0xb2, 0x90, 0x86, 0x8e, 0x7b, 0xb3,
+	0x4b, 0x6f, 0xc6, 0x33, 0x4f, 0x78, 0xee, 0xe0, 0x1b, 0x09, 0x79, 0x16, 0x34, 0xbd, 0x75, 0x7d,
+	0xe9, 0x5a, 0xb5, 0xe1, 0x52, 0xff, 0x73, 0x53, 0x46, 0x6f, 0x57, 0xcd, 0xf0, 0xf9, 0xc8, 0xc5,
+	0x1e, 0x20, 0x02, 0x72, 0x6b, 0x09, 0x3c, 0x18, 0xad, 0x4d, 0x42, 0xeb, 0x0a, 0xbe, 0x1c, 0x6f,
+	0x8b, 0xc8, 0xe5, 0x42, 0x91, 0x04, 0x29, 0x78, 0xe6, 0xf0, 0xaf, 0x7e, 0x05, 0xde, 0xa3, 0x72,
+	0xe1, 0xb5, 0x58, 0xa5, 0x6f, 0xed, 0x33, 0xb9, 0x6c, 0x12, 0x17, 0xc6, 0xe5, 0x1d, 0xc2, 0xe5,
+	0x2d, 0xfc, 0x66, 0xd2, 0x29, 0x22, 0x8d, 0xa4, 0x4b, 0xe6, 0xbb, 0x08, 0x46, 0x5a, 0x44, 0x2d,
+	0xcc, 0xfb, 0xa1, 0xb4, 0x2b, 0x6e, 0xdc, 0xd9, 0x48, 0x1b, 0x86, 0x6f, 0x95, 0xe0, 0x5b, 0xc2,
+	0xaf, 0x85, 0xe1, 0x63, 0xb8, 0xa8, 0x5c, 0x77, 0x1f, 0x01, 0xd0, 0x28, 0xb9, 0xc6, 0xf6, 0x26,
+	0x9e, 0x0f, 0xce, 0xe0, 0x00, 0x48, 0x85, 0x0d, 0xb3, 0xdc, 0x1b, 0x24, 0xf7, 0x79, 0x2c, 0x74,
+	0xc8, 0x5d, 0x6c, 0x14, 0x94, 0xb2, 0xf8, 0x31, 0xd3, 0x35, 0x3e, 0xc1, 0xbf, 0x45, 0x80, 0xdb,
+	0x05, 0xae, 0xf6, 0x13, 0x30, 0x54, 0x3a, 0xe3, 0x56, 0xe2, 0x98, 0x32, 0x94, 0x57, 0x08, 0xca,
+	0x8b, 0x78, 0x23, 0x0a, 0x65, 0xc1, 0x27, 0xa9, 0xb5, 0xa0, 0xfd, 0x0e, 0x02, 0x68, 0x4a, 0x66,
+	0x78, 0xd1, 0x9f, 0xba, 0x4d, 0x63, 0xe3, 0xf8, 0x28, 0x93, 0xb8, 0xf3, 0xa6, 0xc9, 0x77, 0xe9,
+	0xa2, 0x2a, 0x28, 0x65, 0xfc, 0x37, 0x04, 0x5c, 0xb8, 0xf6, 0xd5, 0xbe, 0x17, 0x3a, 0x4a, 0x76,
+	0x5c, 0x36, 0x89, 0x0b, 0xc3, 0xbc, 0x4d, 0x30, 0x5f, 0xc5, 0x57, 0xc2, 0x30, 0x7b, 0x5b, 0x42,
+	0xab, 0x66, 0xd8, 0x53, 0xcf, 0x38, 0x34, 0x2b, 0xfa, 0xa0, 0x1f, 0xe1, 0xbf, 0x20, 0x98, 0x09,
+	0x95, 0xb3, 0xda, 0x4f, 0xa9, 0x4e, 0xb2, 0x19, 0xb7, 0x96, 0xc0, 0x23, 0xee, 0xce, 0xf6, 0xb3,
+	0x09, 0x24, 0x63, 0x4f, 0xcd, 0x6c, 0xc4, 0x5b, 0x11, 0x47, 0x1f, 0x3a, 0x81, 0x9a, 0x1a, 0x77,
+	0x21, 0x91, 0x0f, 0xe3, 0xb3, 0xd5, 0x69, 0x76, 0x7c, 0x27, 0x55, 0x95, 0x84, 0x29, 0x38, 0x2f,
+	0xe1, 0xf0, 0x3b, 0xdf, 0xa5, 0x12, 0x7d, 0xe7, 0xfb, 0x49, 0x64, 0x62, 0x5a, 0x77, 0x79, 0xe7,
+	0xb7, 0xe1, 0x7e, 0xd4, 0x0f, 0x9f, 0x49, 0xa0, 0x70, 0xe0, 0x5c, 0x82, 0x22, 0x87, 0xdd, 0xff,
+	0xd7, 0x7a, 0x8a, 0xc1, 0x98, 0x7f, 0x85, 0x30, 0xbf, 0x85, 0xdf, 0xeb, 0x6e, 0xe2, 0xa2, 0x9a,
+	0x81, 0xc3, 0xe6, 0x7f, 0xf3, 0x85, 0x0a, 0x19, 0xf8, 0x8d, 0x04, 0x24, 0x3c, 0x17, 0xd4, 0xc5,
+	0xe4, 0x8e, 0x8c, 0xf2, 0x0e, 0xa1, 0xbc, 0x85, 0x37, 0xbb, 0xa4, 0xec, 0xbd, 0x5c, 0x7d, 0x1d,
+	0x35, 0x13, 0x41, 0x22, 0x3b, 0x6a, 0xaf, 0xdc, 0xc2, 0xad, 0xc4, 0x31, 0xed, 0xa6, 0xa3, 0x2e,
+	0x18, 0xd4, 0xdb, 0xc5, 0xda, 0x80, 0x61, 0xfa, 0x8c, 0x6d, 0x6f, 0x01, 0xda, 0x5f, 0xca, 0xdc,
+	0xd9, 0x48, 0x1b, 0x06, 0x68, 0x89, 0x00, 0x5a, 0xc0, 0xa9, 0x30, 0x40, 0xf4, 0xa5, 0x9c, 0xdb,
+	0x79, 0x72, 0x90, 0x42, 0x4f, 0x0f, 0x52, 0xe8, 0x5f, 0x07, 0x29, 0xf4, 0xf0, 0x30, 0xd5, 0xf7,
+	0xf4, 0x30, 0xd5, 0xf7, 0x8f, 0xc3, 0x54, 0xdf, 0x57, 0xb3, 0x2d, 0xa2, 0x05, 0x8b, 0x91, 0xa9,
+	0x4a, 0x45, 0xc3, 0x0d, 0x78, 0x27, 0xbb, 0x26, 0xde, 0x75, 0xc2, 0x12, 0x11, 0xa3, 0x38, 0x4c,
+	0x24, 0xb7, 0x0b, 0xff, 0x1b, 0x00, 0x48, 0xe1, 0x7b, 0x6f, 0xaa, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2075,6 +2299,9 @@ type QueryClient interface {
 	AccountLockedLongerDurationNotUnlockingOnly(ctx context.Context, in *AccountLockedLongerDurationNotUnlockingOnlyRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns an overview of the locks of an account: the locked, unlocking and
+	// unlockable coins as well as the number of locks and synthetic locks
+	AccountLockSummary(ctx context.Context, in *AccountLockSummaryRequest, opts ...grpc.CallOption) (*AccountLockSummaryResponse, error)
 	// Params returns lockup params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -2259,6 +2486,15 @@ func (c *queryClient) AccountLockedLongerDurationDenom(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryClient) AccountLockSummary(ctx context.Context, in *AccountLockSummaryRequest, opts ...grpc.CallOption) (*AccountLockSummaryResponse, error) {
+	out := new(AccountLockSummaryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/AccountLockSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/Params", in, out, opts...)
@@ -2311,6 +2547,9 @@ type QueryServer interface {
 	AccountLockedLongerDurationNotUnlockingOnly(context.Context, *AccountLockedLongerDurationNotUnlockingOnlyRequest) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns an overview of the locks of an account: the locked, unlocking and
+	// unlockable coins as well as the number of locks and synthetic locks
+	AccountLockSummary(context.Context, *AccountLockSummaryRequest) (*AccountLockSummaryResponse, error)
 	// Params returns lockup params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -2376,6 +2615,9 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationNotUnlockingOnly(ctx
 func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Context, req *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountLockedLongerDurationDenom not implemented")
 }
+func (*UnimplementedQueryServer) AccountLockSummary(ctx context.Context, req *AccountLockSummaryRequest) (*AccountLockSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLockSummary not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -2726,6 +2968,24 @@ func _Query_AccountLockedLongerDurationDenom_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountLockSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountLockSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLockSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/AccountLockSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLockSummary(ctx, req.(*AccountLockSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -2824,6 +3084,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountLockedLongerDurationDenom",
 			Handler:    _Query_AccountLockedLongerDurationDenom_Handler,
 		},
+		{
+			MethodName: "AccountLockSummary",
+			Handler:    _Query_AccountLockSummary_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -3107,6 +3371,18 @@ func (m *AccountLockedCoinsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -3137,6 +3413,18 @@ func (m *AccountLockedCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3174,12 +3462,12 @@ func (m *AccountLockedPastTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3249,12 +3537,12 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) MarshalToSizedBuffer(dAtA
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3324,12 +3612,12 @@ func (m *AccountUnlockedBeforeTimeRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3406,12 +3694,12 @@ func (m *AccountLockedPastTimeDenomRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x1a
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3481,12 +3769,12 @@ func (m *LockedDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Denom) > 0 {
@@ -3724,6 +4012,18 @@ func (m *SyntheticLockupsByLockupIDRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.LockId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
 		i--
@@ -3752,6 +4052,18 @@ func (m *SyntheticLockupsByLockupIDResponse) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.SyntheticLocks) > 0 {
 		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3850,12 +4162,24 @@ func (m *AccountLockedLongerDurationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err8 != nil {
-		return 0, err8
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3888,6 +4212,18 @@ func (m *AccountLockedLongerDurationResponse) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3925,12 +4261,24 @@ func (m *AccountLockedDurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err9 != nil {
-		return 0, err9
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n9
-	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3963,6 +4311,18 @@ func (m *AccountLockedDurationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4000,12 +4360,12 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) MarshalToSizedBuffe
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err10 != nil {
-		return 0, err10
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n10
-	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -4082,12 +4442,12 @@ func (m *AccountLockedLongerDurationDenomRequest) MarshalToSizedBuffer(dAtA []by
 		i--
 		dAtA[i] = 0x1a
 	}
-	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err11 != nil {
-		return 0, err11
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n11
-	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -4137,7 +4497,7 @@ func (m *AccountLockedLongerDurationDenomResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *AccountLockSummaryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4147,20 +4507,130 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountLockSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccountLockSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountLockSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLockSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountLockSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumSyntheticLocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSyntheticLocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumUnlockingLocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumUnlockingLocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumLocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumLocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnlockableCoins) > 0 {
+		for iNdEx := len(m.UnlockableCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockableCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UnlockingCoins) > 0 {
+		for iNdEx := len(m.UnlockingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4318,6 +4788,10 @@ func (m *AccountLockedCoinsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4333,6 +4807,10 @@ func (m *AccountLockedCoinsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4566,6 +5044,10 @@ func (m *SyntheticLockupsByLockupIDRequest) Size() (n int) {
 	if m.LockId != 0 {
 		n += 1 + sovQuery(uint64(m.LockId))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4581,6 +5063,10 @@ func (m *SyntheticLockupsByLockupIDResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4619,6 +5105,10 @@ func (m *AccountLockedLongerDurationRequest) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4634,6 +5124,10 @@ func (m *AccountLockedLongerDurationResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4649,6 +5143,10 @@ func (m *AccountLockedDurationRequest) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4664,6 +5162,10 @@ func (m *AccountLockedDurationResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4731,6 +5233,55 @@ func (m *AccountLockedLongerDurationDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *AccountLockSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountLockSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockingCoins) > 0 {
+		for _, e := range m.UnlockingCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockableCoins) > 0 {
+		for _, e := range m.UnlockableCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NumLocks != 0 {
+		n += 1 + sovQuery(uint64(m.NumLocks))
+	}
+	if m.NumUnlockingLocks != 0 {
+		n += 1 + sovQuery(uint64(m.NumUnlockingLocks))
+	}
+	if m.NumSyntheticLocks != 0 {
+		n += 1 + sovQuery(uint64(m.NumSyntheticLocks))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5418,6 +5969,42 @@ func (m *AccountLockedCoinsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5502,6 +6089,42 @@ func (m *AccountLockedCoinsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7023,6 +7646,42 @@ func (m *SyntheticLockupsByLockupIDRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7107,23 +7766,59 @@ func (m *SyntheticLockupsByLockupIDResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -7374,6 +8069,42 @@ func (m *AccountLockedLongerDurationRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7458,6 +8189,42 @@ func (m *AccountLockedLongerDurationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7573,6 +8340,42 @@ func (m *AccountLockedDurationRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7657,6 +8460,42 @@ func (m *AccountLockedDurationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8108,6 +8947,297 @@ func (m *AccountLockedLongerDurationDenomResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *AccountLockSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLockSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLockSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountLockSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLockSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLockSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedCoins = append(m.LockedCoins, types.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockingCoins = append(m.UnlockingCoins, types.Coin{})
+			if err := m.UnlockingCoins[len(m.UnlockingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockableCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockableCoins = append(m.UnlockableCoins, types.Coin{})
+			if err := m.UnlockableCoins[len(m.UnlockableCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLocks", wireType)
+			}
+			m.NumLocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUnlockingLocks", wireType)
+			}
+			m.NumUnlockingLocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUnlockingLocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSyntheticLocks", wireType)
+			}
+			m.NumSyntheticLocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSyntheticLocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
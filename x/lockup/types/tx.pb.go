@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -659,6 +660,205 @@ func (m *MsgSetRewardReceiverAddressResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgInstantUnlock unlocks a lock immediately, skipping its remaining
+// duration, for a penalty of instant_unlock_penalty of the unlocked coins.
+type MsgInstantUnlock struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID    uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Amount of unlocking coins. Unlock all if not set.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgInstantUnlock) Reset()         { *m = MsgInstantUnlock{} }
+func (m *MsgInstantUnlock) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantUnlock) ProtoMessage()    {}
+func (*MsgInstantUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{12}
+}
+func (m *MsgInstantUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantUnlock.Merge(m, src)
+}
+func (m *MsgInstantUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantUnlock proto.InternalMessageInfo
+
+func (m *MsgInstantUnlock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgInstantUnlock) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgInstantUnlock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type MsgInstantUnlockResponse struct {
+	// Penalty paid for the instant unlock.
+	Penalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=penalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"penalty"`
+}
+
+func (m *MsgInstantUnlockResponse) Reset()         { *m = MsgInstantUnlockResponse{} }
+func (m *MsgInstantUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantUnlockResponse) ProtoMessage()    {}
+func (*MsgInstantUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{13}
+}
+func (m *MsgInstantUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantUnlockResponse.Merge(m, src)
+}
+func (m *MsgInstantUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantUnlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantUnlockResponse proto.InternalMessageInfo
+
+func (m *MsgInstantUnlockResponse) GetPenalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Penalty
+	}
+	return nil
+}
+
+// MsgUpdateParams sets all the module parameters, executed by governance.
+type MsgUpdateParams struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// params are the new lockup module parameters, all of them must be set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
 	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
@@ -672,64 +872,80 @@ func init() {
 	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
 	proto.RegisterType((*MsgSetRewardReceiverAddress)(nil), "osmosis.lockup.MsgSetRewardReceiverAddress")
 	proto.RegisterType((*MsgSetRewardReceiverAddressResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverAddressResponse")
+	proto.RegisterType((*MsgInstantUnlock)(nil), "osmosis.lockup.MsgInstantUnlock")
+	proto.RegisterType((*MsgInstantUnlockResponse)(nil), "osmosis.lockup.MsgInstantUnlockResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "osmosis.lockup.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "osmosis.lockup.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 827 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x4f, 0xe3, 0x46,
-	0x18, 0x8e, 0x13, 0x3e, 0x07, 0x9a, 0x14, 0x8b, 0x42, 0x70, 0xa9, 0x0d, 0x6e, 0x4b, 0x52, 0x8a,
-	0xed, 0x26, 0xf4, 0x94, 0x4b, 0x45, 0xa0, 0x95, 0x90, 0x88, 0x54, 0xb9, 0x20, 0x55, 0x3d, 0x14,
-	0x39, 0xce, 0x60, 0xac, 0x24, 0x9e, 0xc8, 0x63, 0xf3, 0x21, 0xf5, 0x17, 0xf4, 0xd4, 0x63, 0xff,
-	0xc0, 0x5e, 0xf6, 0xb4, 0x3f, 0x83, 0x23, 0xab, 0x5d, 0xad, 0xf6, 0xb0, 0x0a, 0x2b, 0x38, 0xac,
-	0xb4, 0x47, 0x7e, 0xc1, 0x6a, 0x66, 0x6c, 0xcb, 0x71, 0x42, 0x92, 0x5d, 0x69, 0x57, 0x7b, 0x89,
-	0x3d, 0xf3, 0x3e, 0xef, 0x33, 0xef, 0xf3, 0xe4, 0x9d, 0x37, 0x01, 0xcb, 0x08, 0xb7, 0x11, 0xb6,
-	0xb1, 0xd6, 0x42, 0x66, 0xd3, 0xef, 0x68, 0xde, 0x85, 0xda, 0x71, 0x91, 0x87, 0xf8, 0x6c, 0x10,
-	0x50, 0x59, 0x40, 0x58, 0xb4, 0x90, 0x85, 0x68, 0x48, 0x23, 0x6f, 0x0c, 0x25, 0x2c, 0x18, 0x6d,
-	0xdb, 0x41, 0x1a, 0xfd, 0x0c, 0xb6, 0x44, 0x0b, 0x21, 0xab, 0x05, 0x35, 0xba, 0xaa, 0xfb, 0x27,
-	0x5a, 0xc3, 0x77, 0x0d, 0xcf, 0x46, 0x4e, 0x18, 0x37, 0x29, 0xb3, 0x56, 0x37, 0x30, 0xd4, 0xce,
-	0x4a, 0x75, 0xe8, 0x19, 0x25, 0xcd, 0x44, 0x76, 0x18, 0x5f, 0x49, 0x54, 0x44, 0x1e, 0x2c, 0x24,
-	0x3f, 0x4a, 0x83, 0x2f, 0x6a, 0xd8, 0x3a, 0x40, 0x66, 0xf3, 0x10, 0x35, 0xa1, 0x83, 0xf9, 0x0d,
-	0x30, 0x89, 0xce, 0x1d, 0xe8, 0xe6, 0xb9, 0x35, 0xae, 0x38, 0x5b, 0xfd, 0xf2, 0xbe, 0x2b, 0xcd,
-	0x5f, 0x1a, 0xed, 0x56, 0x45, 0xa6, 0xdb, 0xb2, 0xce, 0xc2, 0xfc, 0x29, 0x98, 0x09, 0xcb, 0xc8,
-	0xa7, 0xd7, 0xb8, 0xe2, 0x5c, 0x79, 0x45, 0x65, 0x75, 0xaa, 0x61, 0x9d, 0xea, 0x5e, 0x00, 0xa8,
-	0x96, 0xae, 0xba, 0x52, 0xea, 0x6d, 0x57, 0xe2, 0xc3, 0x94, 0x2d, 0xd4, 0xb6, 0x3d, 0xd8, 0xee,
-	0x78, 0x97, 0xf7, 0x5d, 0x29, 0xc7, 0xf8, 0xc3, 0x98, 0xfc, 0xff, 0x8d, 0xc4, 0xe9, 0x11, 0x3b,
-	0x6f, 0x80, 0x49, 0x22, 0x06, 0xe7, 0x33, 0x6b, 0x19, 0x7a, 0x0c, 0x93, 0xab, 0x12, 0xb9, 0x6a,
-	0x20, 0x57, 0xdd, 0x45, 0xb6, 0x53, 0xfd, 0x89, 0x1c, 0xf3, 0xf8, 0x46, 0x2a, 0x5a, 0xb6, 0x77,
-	0xea, 0xd7, 0x55, 0x13, 0xb5, 0xb5, 0xc0, 0x1b, 0xf6, 0x50, 0x70, 0xa3, 0xa9, 0x79, 0x97, 0x1d,
-	0x88, 0x69, 0x02, 0xd6, 0x19, 0x73, 0x45, 0xfa, 0xf7, 0xcd, 0x93, 0x4d, 0x61, 0x80, 0x4d, 0x8a,
-	0x47, 0x5d, 0x91, 0x0b, 0xe0, 0xab, 0x1e, 0x9b, 0x74, 0x88, 0x3b, 0xc8, 0xc1, 0x90, 0xcf, 0x82,
-	0xf4, 0xfe, 0x1e, 0xf5, 0x6a, 0x42, 0x4f, 0xef, 0xef, 0xc9, 0x16, 0x58, 0xac, 0x61, 0xab, 0x0a,
-	0x2d, 0xdb, 0x39, 0x72, 0x08, 0x83, 0xed, 0x58, 0x3b, 0xad, 0xd6, 0xb8, 0xb6, 0x56, 0x0a, 0xa4,
-	0x12, 0x39, 0x51, 0x49, 0x9d, 0xd0, 0x29, 0xbe, 0x13, 0xaf, 0xe8, 0x10, 0xac, 0x0e, 0x3a, 0x28,
-	0x2a, 0xec, 0x67, 0x30, 0xcd, 0x12, 0x70, 0x9e, 0xa3, 0xbe, 0x09, 0x6a, 0x6f, 0xff, 0xa9, 0xbf,
-	0x43, 0xd7, 0x46, 0x0d, 0xa2, 0x49, 0x0f, 0xa1, 0xf2, 0x2b, 0x0e, 0x2c, 0xf4, 0xd1, 0x8e, 0xdd,
-	0x13, 0xcc, 0x8c, 0x74, 0x68, 0xc6, 0xa7, 0xf8, 0xe6, 0xb6, 0x88, 0x5f, 0x85, 0x61, 0x7e, 0x75,
-	0xa8, 0x4c, 0x85, 0xbc, 0xcb, 0xc7, 0x60, 0xa5, 0x4f, 0x5d, 0xe4, 0x58, 0x1e, 0x4c, 0x63, 0xdf,
-	0x34, 0x21, 0xc6, 0x54, 0xe7, 0x8c, 0x1e, 0x2e, 0xf9, 0x22, 0xc8, 0xf9, 0x21, 0x9c, 0xf8, 0x15,
-	0x89, 0x4c, 0x6e, 0xcb, 0x2f, 0x38, 0x90, 0xab, 0x61, 0xeb, 0xd7, 0x0b, 0x0f, 0x3a, 0xd4, 0x5a,
-	0xbf, 0xf3, 0xc1, 0xee, 0xc5, 0x6f, 0x58, 0xe6, 0x63, 0xde, 0xb0, 0xca, 0x3a, 0x31, 0x71, 0x35,
-	0x61, 0x22, 0xa4, 0x1a, 0x14, 0xb6, 0x92, 0xb7, 0xc1, 0x72, 0x42, 0xd7, 0x68, 0xdf, 0xe4, 0xe7,
-	0x1c, 0xc8, 0xd6, 0xb0, 0xf5, 0x1b, 0x72, 0x4d, 0xc8, 0xfc, 0xfe, 0x9c, 0x5b, 0x69, 0xe0, 0xd5,
-	0x3b, 0x21, 0xb5, 0x27, 0xae, 0x5e, 0x19, 0x2c, 0xf5, 0xaa, 0x1a, 0xc3, 0x8a, 0x67, 0x1c, 0xf8,
-	0xba, 0x86, 0xad, 0x3f, 0xa0, 0xa7, 0xc3, 0x73, 0xc3, 0x6d, 0xe8, 0xd0, 0x84, 0xf6, 0x19, 0x74,
-	0x77, 0x1a, 0x0d, 0x97, 0xb4, 0xd8, 0xb8, 0xbe, 0x2c, 0x81, 0xa9, 0x56, 0xbc, 0x03, 0x83, 0x15,
-	0xbf, 0x0b, 0x72, 0x2e, 0x25, 0x3e, 0x76, 0x03, 0x66, 0xda, 0x33, 0xb3, 0x55, 0xe1, 0xbe, 0x2b,
-	0x2d, 0x31, 0xa6, 0x04, 0x40, 0xd6, 0xb3, 0x6e, 0x4f, 0x2d, 0x15, 0x8d, 0x38, 0xb0, 0x99, 0x70,
-	0x00, 0x43, 0x4f, 0x61, 0x38, 0x25, 0xcc, 0x54, 0x0c, 0x56, 0xb5, 0xfc, 0x0b, 0xf8, 0x76, 0x88,
-	0xa8, 0xd1, 0xb6, 0x94, 0x9f, 0x4e, 0x80, 0x4c, 0x0d, 0x5b, 0xbc, 0x0e, 0x40, 0xec, 0x37, 0xe8,
-	0x9b, 0xe4, 0xa8, 0xea, 0x99, 0xbd, 0xc2, 0xf7, 0x43, 0xc3, 0xd1, 0xa9, 0x16, 0x58, 0xe8, 0x9f,
-	0xc3, 0xdf, 0x0d, 0xc8, 0xed, 0x43, 0x09, 0x5b, 0xe3, 0xa0, 0xa2, 0x83, 0xfe, 0x06, 0xd9, 0xc4,
-	0xc0, 0x5c, 0x1f, 0x99, 0x2f, 0xfc, 0x30, 0x12, 0x12, 0xf1, 0xff, 0x09, 0xe6, 0x7b, 0x06, 0x8a,
-	0x34, 0x20, 0x35, 0x0e, 0x10, 0x0a, 0x23, 0x00, 0x11, 0xf3, 0x11, 0x98, 0x8b, 0x5f, 0x4e, 0x71,
-	0x40, 0x5e, 0x2c, 0x2e, 0x6c, 0x0c, 0x8f, 0x47, 0xb4, 0xff, 0x80, 0xfc, 0x83, 0x8d, 0xfe, 0xe3,
-	0x00, 0x8e, 0x87, 0xc0, 0xc2, 0xf6, 0x7b, 0x80, 0xc3, 0xd3, 0xab, 0x07, 0x57, 0xb7, 0x22, 0x77,
-	0x7d, 0x2b, 0x72, 0xaf, 0x6f, 0x45, 0xee, 0xbf, 0x3b, 0x31, 0x75, 0x7d, 0x27, 0xa6, 0x5e, 0xde,
-	0x89, 0xa9, 0xbf, 0xca, 0xb1, 0x91, 0x10, 0x10, 0x2b, 0x2d, 0xa3, 0x8e, 0xc3, 0x85, 0x76, 0x56,
-	0x2e, 0x69, 0x17, 0xd1, 0x1f, 0x37, 0x32, 0x22, 0xea, 0x53, 0x74, 0xd6, 0x6e, 0xbf, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0xef, 0x64, 0xae, 0x60, 0xd7, 0x09, 0x00, 0x00,
+	// 1015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xdc, 0xc4,
+	0x1b, 0x8e, 0x93, 0x5f, 0x93, 0x66, 0xda, 0x6c, 0x12, 0x2b, 0x4d, 0x36, 0x6e, 0xbb, 0x4e, 0xe7,
+	0x07, 0xdd, 0xa5, 0xc4, 0x36, 0xd9, 0x70, 0xda, 0x0b, 0xea, 0x36, 0x45, 0x0a, 0xea, 0x4a, 0x95,
+	0xdb, 0x48, 0x08, 0x24, 0x22, 0xef, 0xee, 0xd4, 0xb1, 0xb2, 0xeb, 0xb1, 0x3c, 0xe3, 0x34, 0x2b,
+	0x71, 0xe1, 0x86, 0x38, 0x71, 0xe4, 0x0b, 0x70, 0xe1, 0xc4, 0x81, 0xef, 0x40, 0x8f, 0xe5, 0x8f,
+	0x10, 0x07, 0xb4, 0x45, 0xc9, 0x01, 0x89, 0xe3, 0x7e, 0x02, 0x34, 0x7f, 0x6c, 0x6c, 0xaf, 0x9b,
+	0x5d, 0x10, 0xa0, 0x5e, 0xb2, 0x1e, 0x3f, 0xcf, 0xfb, 0xcc, 0xfb, 0x3e, 0x33, 0xf3, 0x8e, 0x03,
+	0x36, 0x30, 0xe9, 0x63, 0xe2, 0x11, 0xab, 0x87, 0x3b, 0xc7, 0x51, 0x60, 0xd1, 0x53, 0x33, 0x08,
+	0x31, 0xc5, 0x6a, 0x49, 0x02, 0xa6, 0x00, 0xb4, 0x35, 0x17, 0xbb, 0x98, 0x43, 0x16, 0x7b, 0x12,
+	0x2c, 0x6d, 0xd5, 0xe9, 0x7b, 0x3e, 0xb6, 0xf8, 0x5f, 0xf9, 0xaa, 0xe2, 0x62, 0xec, 0xf6, 0x90,
+	0xc5, 0x47, 0xed, 0xe8, 0x89, 0xd5, 0x8d, 0x42, 0x87, 0x7a, 0xd8, 0x8f, 0xf1, 0x0e, 0x57, 0xb6,
+	0xda, 0x0e, 0x41, 0xd6, 0xc9, 0x4e, 0x1b, 0x51, 0x67, 0xc7, 0xea, 0x60, 0x2f, 0xc6, 0x37, 0x73,
+	0x19, 0xb1, 0x9f, 0x18, 0x12, 0xa1, 0x87, 0x22, 0x0d, 0x31, 0x90, 0xd0, 0xf5, 0x5c, 0x54, 0xe0,
+	0x84, 0x4e, 0x5f, 0x82, 0xf0, 0xcb, 0x59, 0xb0, 0xd4, 0x22, 0xee, 0x03, 0xdc, 0x39, 0x7e, 0x8c,
+	0x8f, 0x91, 0x4f, 0xd4, 0xdb, 0xe0, 0x12, 0x7e, 0xea, 0xa3, 0xb0, 0xac, 0x6c, 0x29, 0xb5, 0xc5,
+	0xe6, 0xca, 0x68, 0xa8, 0x5f, 0x1d, 0x38, 0xfd, 0x5e, 0x03, 0xf2, 0xd7, 0xd0, 0x16, 0xb0, 0x7a,
+	0x04, 0x2e, 0xc7, 0xe9, 0x97, 0x67, 0xb7, 0x94, 0xda, 0x95, 0xfa, 0xa6, 0x29, 0xea, 0x33, 0xe3,
+	0xfa, 0xcc, 0x3d, 0x49, 0x68, 0xee, 0x3c, 0x1b, 0xea, 0x33, 0xbf, 0x0f, 0x75, 0x35, 0x0e, 0xd9,
+	0xc6, 0x7d, 0x8f, 0xa2, 0x7e, 0x40, 0x07, 0xa3, 0xa1, 0xbe, 0x2c, 0xf4, 0x63, 0x0c, 0x7e, 0xf1,
+	0x42, 0x57, 0xec, 0x44, 0x5d, 0x75, 0xc0, 0x25, 0x66, 0x02, 0x29, 0xcf, 0x6d, 0xcd, 0xf1, 0x69,
+	0x64, 0x79, 0xcc, 0x26, 0x53, 0xda, 0x64, 0xde, 0xc3, 0x9e, 0xdf, 0x7c, 0x8b, 0x4d, 0xf3, 0xd5,
+	0x0b, 0xbd, 0xe6, 0x7a, 0xf4, 0x28, 0x6a, 0x9b, 0x1d, 0xdc, 0x97, 0x5e, 0xc8, 0x1f, 0x83, 0x74,
+	0x8f, 0x2d, 0x3a, 0x08, 0x10, 0xe1, 0x01, 0xc4, 0x16, 0xca, 0x0d, 0xfd, 0xb3, 0xdf, 0xbe, 0xbe,
+	0xa3, 0x15, 0xd8, 0x6b, 0x50, 0xee, 0x0a, 0xac, 0x82, 0x6b, 0x19, 0x9b, 0x6c, 0x44, 0x02, 0xec,
+	0x13, 0xa4, 0x96, 0xc0, 0xec, 0xfe, 0x1e, 0xf7, 0xea, 0x7f, 0xf6, 0xec, 0xfe, 0x1e, 0x74, 0xc1,
+	0x5a, 0x8b, 0xb8, 0x4d, 0xe4, 0x7a, 0xfe, 0x81, 0xcf, 0x14, 0x3c, 0xdf, 0xbd, 0xdb, 0xeb, 0x4d,
+	0x6b, 0x6b, 0xa3, 0xca, 0x32, 0x81, 0xb9, 0x4c, 0xda, 0x4c, 0xce, 0x88, 0xfc, 0x74, 0x46, 0x8f,
+	0xc1, 0x8d, 0xa2, 0x89, 0x92, 0xc4, 0xde, 0x06, 0x0b, 0x22, 0x80, 0x94, 0x15, 0xee, 0x9b, 0x66,
+	0x66, 0xf7, 0xad, 0xf9, 0x10, 0x85, 0x1e, 0xee, 0xb2, 0x9a, 0xec, 0x98, 0x0a, 0x7f, 0x51, 0xc0,
+	0xea, 0x98, 0xec, 0xd4, 0x7b, 0x42, 0x98, 0x31, 0x1b, 0x9b, 0xf1, 0x5f, 0xac, 0xdc, 0x36, 0xf3,
+	0xab, 0x7a, 0x91, 0x5f, 0x01, 0x2f, 0xd3, 0x60, 0xcf, 0xf0, 0x10, 0x6c, 0x8e, 0x55, 0x97, 0x38,
+	0x56, 0x06, 0x0b, 0x24, 0xea, 0x74, 0x10, 0x21, 0xbc, 0xce, 0xcb, 0x76, 0x3c, 0x54, 0x6b, 0x60,
+	0x39, 0x8a, 0xe9, 0xcc, 0xaf, 0xa4, 0xc8, 0xfc, 0x6b, 0xf8, 0x93, 0x02, 0x96, 0x5b, 0xc4, 0xbd,
+	0x7f, 0x4a, 0x91, 0xcf, 0xad, 0x8d, 0x82, 0xbf, 0xed, 0x5e, 0xfa, 0x84, 0xcd, 0xfd, 0x9b, 0x27,
+	0xac, 0x71, 0x8b, 0x99, 0x78, 0x23, 0x67, 0x22, 0xe2, 0x35, 0x18, 0x62, 0x04, 0x77, 0xc1, 0x46,
+	0xae, 0xae, 0xc9, 0xbe, 0xc1, 0x1f, 0x15, 0x50, 0x6a, 0x11, 0xf7, 0x5d, 0x1c, 0x76, 0x90, 0xf0,
+	0xfb, 0x55, 0xde, 0x4a, 0x85, 0x47, 0xef, 0x09, 0xcb, 0x3d, 0x77, 0xf4, 0xea, 0x60, 0x3d, 0x5b,
+	0xd5, 0x14, 0x56, 0xfc, 0xa0, 0x80, 0xeb, 0x2d, 0xe2, 0x3e, 0x42, 0xd4, 0x46, 0x4f, 0x9d, 0xb0,
+	0x6b, 0xa3, 0x0e, 0xf2, 0x4e, 0x50, 0x78, 0xb7, 0xdb, 0x0d, 0xd9, 0x16, 0x9b, 0xd6, 0x97, 0x75,
+	0x30, 0xdf, 0x4b, 0xef, 0x40, 0x39, 0x52, 0xef, 0x81, 0xe5, 0x90, 0x0b, 0x1f, 0x86, 0x52, 0x99,
+	0xef, 0x99, 0xc5, 0xa6, 0x36, 0x1a, 0xea, 0xeb, 0x42, 0x29, 0x47, 0x80, 0x76, 0x29, 0xcc, 0xe4,
+	0xd2, 0xb0, 0x98, 0x03, 0x77, 0x72, 0x0e, 0x10, 0x44, 0x0d, 0xc1, 0x33, 0xe2, 0x48, 0xc3, 0x11,
+	0x59, 0xc3, 0x77, 0xc0, 0xff, 0x2f, 0x28, 0x6a, 0x0a, 0x5b, 0xbe, 0x53, 0xc0, 0x4a, 0x8b, 0xb8,
+	0xfb, 0x3e, 0xa1, 0x8e, 0x4f, 0x5f, 0xfd, 0x3d, 0x02, 0x99, 0x43, 0x37, 0x73, 0x0e, 0x79, 0x22,
+	0x7b, 0xb9, 0x4b, 0xe0, 0x27, 0x0a, 0x28, 0xe7, 0x6b, 0x4a, 0xac, 0x40, 0x60, 0x21, 0x40, 0xbe,
+	0xd3, 0xa3, 0x83, 0xb2, 0xf2, 0xcf, 0x67, 0x19, 0x6b, 0xc3, 0x6f, 0x45, 0x1f, 0x3a, 0x08, 0xba,
+	0x0e, 0x45, 0x0f, 0xf9, 0x8d, 0xaf, 0xbe, 0x07, 0x16, 0x9d, 0x88, 0x1e, 0xe1, 0xd0, 0xa3, 0x03,
+	0x69, 0xed, 0xf6, 0x68, 0xa8, 0xaf, 0x08, 0x6b, 0x13, 0x08, 0x7e, 0xff, 0x8d, 0xb1, 0x26, 0x73,
+	0x92, 0x0b, 0xf9, 0x88, 0x86, 0xac, 0x51, 0xfe, 0x19, 0xae, 0xde, 0x07, 0xf3, 0xe2, 0x3b, 0x42,
+	0xde, 0xfd, 0xeb, 0x63, 0x97, 0x0b, 0x47, 0x9b, 0xd7, 0x58, 0x09, 0xa3, 0xa1, 0xbe, 0x24, 0x26,
+	0x11, 0x31, 0xd0, 0x96, 0xc1, 0xc5, 0x8d, 0x27, 0xe2, 0x49, 0x1b, 0x92, 0xbb, 0x09, 0x36, 0x72,
+	0x85, 0xc4, 0x5e, 0xd6, 0x3f, 0x9d, 0x07, 0x73, 0x2d, 0xe2, 0xaa, 0x36, 0x00, 0xa9, 0x0f, 0x98,
+	0x9b, 0xf9, 0x54, 0x32, 0x17, 0xb7, 0xf6, 0xfa, 0x85, 0x70, 0xb2, 0x4e, 0x2e, 0x58, 0x1d, 0xbf,
+	0xc4, 0x5f, 0x2b, 0x88, 0x1d, 0x63, 0x69, 0xdb, 0xd3, 0xb0, 0x92, 0x89, 0x3e, 0x02, 0xa5, 0x2c,
+	0xa8, 0xde, 0x9a, 0x18, 0xaf, 0xbd, 0x31, 0x91, 0x92, 0xe8, 0xbf, 0x0f, 0xae, 0x66, 0x6e, 0x23,
+	0xbd, 0x20, 0x34, 0x4d, 0xd0, 0xaa, 0x13, 0x08, 0x89, 0xf2, 0x01, 0xb8, 0x92, 0xee, 0xec, 0x95,
+	0x82, 0xb8, 0x14, 0xae, 0xdd, 0xbe, 0x18, 0x4f, 0x64, 0x3f, 0x06, 0xe5, 0x97, 0x76, 0xc9, 0x37,
+	0x0b, 0x34, 0x5e, 0x46, 0xd6, 0x76, 0xff, 0x02, 0x39, 0x99, 0xfd, 0x43, 0xb0, 0x94, 0x6d, 0x46,
+	0x5b, 0x05, 0x2a, 0x19, 0x86, 0x56, 0x9b, 0xc4, 0x48, 0xaf, 0x45, 0xe6, 0x44, 0x16, 0xad, 0x45,
+	0x9a, 0xa0, 0x55, 0x27, 0x10, 0x62, 0xe5, 0xe6, 0x83, 0x67, 0x67, 0x15, 0xe5, 0xf9, 0x59, 0x45,
+	0xf9, 0xf5, 0xac, 0xa2, 0x7c, 0x7e, 0x5e, 0x99, 0x79, 0x7e, 0x5e, 0x99, 0xf9, 0xf9, 0xbc, 0x32,
+	0xf3, 0x41, 0x3d, 0xd5, 0x3c, 0xa4, 0x98, 0xd1, 0x73, 0xda, 0x24, 0x1e, 0x58, 0x27, 0xf5, 0x1d,
+	0xeb, 0x34, 0xf9, 0x27, 0x87, 0x35, 0x93, 0xf6, 0x3c, 0xff, 0xbe, 0xd8, 0xfd, 0x63, 0x00, 0xf3,
+	0x0e, 0xd1, 0xdd, 0x03, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -755,6 +971,12 @@ type MsgClient interface {
 	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error)
+	// InstantUnlock immediately unlocks the lock by ID, skipping its remaining
+	// duration, for a penalty defined by governance.
+	InstantUnlock(ctx context.Context, in *MsgInstantUnlock, opts ...grpc.CallOption) (*MsgInstantUnlockResponse, error)
+	// UpdateParams sets the lockup module parameters. It can only be executed by
+	// governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -819,6 +1041,24 @@ func (c *msgClient) SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewa
 	return out, nil
 }
 
+func (c *msgClient) InstantUnlock(ctx context.Context, in *MsgInstantUnlock, opts ...grpc.CallOption) (*MsgInstantUnlockResponse, error) {
+	out := new(MsgInstantUnlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/InstantUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -832,6 +1072,12 @@ type MsgServer interface {
 	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(context.Context, *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error)
+	// InstantUnlock immediately unlocks the lock by ID, skipping its remaining
+	// duration, for a penalty defined by governance.
+	InstantUnlock(context.Context, *MsgInstantUnlock) (*MsgInstantUnlockResponse, error)
+	// UpdateParams sets the lockup module parameters. It can only be executed by
+	// governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -856,6 +1102,12 @@ func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnl
 func (*UnimplementedMsgServer) SetRewardReceiverAddress(ctx context.Context, req *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiverAddress not implemented")
 }
+func (*UnimplementedMsgServer) InstantUnlock(ctx context.Context, req *MsgInstantUnlock) (*MsgInstantUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantUnlock not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -969,6 +1221,42 @@ func _Msg_SetRewardReceiverAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstantUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/InstantUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantUnlock(ctx, req.(*MsgInstantUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -997,6 +1285,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRewardReceiverAddress",
 			Handler:    _Msg_SetRewardReceiverAddress_Handler,
 		},
+		{
+			MethodName: "InstantUnlock",
+			Handler:    _Msg_InstantUnlock_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -1469,51 +1765,200 @@ func (m *MsgSetRewardReceiverAddressResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgInstantUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLockTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgInstantUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLockTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgInstantUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
+	if len(m.Penalty) > 0 {
+		for iNdEx := len(m.Penalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBeginUnlockingAll) Size() (n int) {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLockTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgBeginUnlockingAll) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1674,6 +2119,67 @@ func (m *MsgSetRewardReceiverAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInstantUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInstantUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Penalty) > 0 {
+		for _, e := range m.Penalty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1860,9 +2366,399 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, &PeriodLock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
 			}
-			m.ID = 0
+			m.UnlockingLockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1872,7 +2768,7 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.UnlockingLockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1898,7 +2794,7 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1921,10 +2817,10 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1959,6 +2855,58 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1980,7 +2928,7 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,17 +2951,17 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2023,26 +2971,12 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unlocks = append(m.Unlocks, &PeriodLock{})
-			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2064,7 +2998,7 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2087,10 +3021,10 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2199,7 +3133,7 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,10 +3156,10 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2248,25 +3182,6 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
-			}
-			m.UnlockingLockID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockingLockID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2288,7 +3203,7 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2311,10 +3226,10 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2351,9 +3266,9 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
 			}
-			m.ID = 0
+			m.LockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2363,16 +3278,16 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.LockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2382,24 +3297,23 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2422,7 +3336,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,10 +3359,10 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2492,7 +3406,7 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
+func (m *MsgInstantUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,10 +3429,10 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInstantUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInstantUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2627,7 +3541,7 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInstantUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2650,17 +3564,17 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInstantUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInstantUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2670,12 +3584,26 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = append(m.Penalty, types.Coin{})
+			if err := m.Penalty[len(m.Penalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2697,7 +3625,7 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2720,15 +3648,15 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2756,32 +3684,13 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
-			}
-			m.LockID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2791,23 +3700,24 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2830,7 +3740,7 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2853,32 +3763,12 @@ func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19,6 +19,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryEpochProvisions(),
+		GetCmdQueryDeveloperRewardsDistributions(),
 	)
 
 	return cmd
@@ -81,3 +82,31 @@ func GetCmdQueryEpochProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryDeveloperRewardsDistributions implements a command to return the
+// developer rewards distributed, in total and to each receiver.
+func GetCmdQueryDeveloperRewardsDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-rewards-distributions",
+		Short: "Query the developer rewards distributed, in total and to each receiver",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeveloperRewardsDistributions(context.Background(), &types.QueryDeveloperRewardsDistributionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/mint/types"
+)
+
+// GetTotalDeveloperRewardsDistributed returns the total amount distributed from the
+// developer vesting module account, to the developer rewards receivers or the community pool.
+func (k Keeper) GetTotalDeveloperRewardsDistributed(ctx sdk.Context) osmomath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalDeveloperRewardsDistributedKey)
+	if bz == nil {
+		return osmomath.ZeroInt()
+	}
+
+	total := osmomath.Int{}
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+// setTotalDeveloperRewardsDistributed sets the total amount distributed from the developer vesting module account.
+func (k Keeper) setTotalDeveloperRewardsDistributed(ctx sdk.Context, total osmomath.Int) {
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.TotalDeveloperRewardsDistributedKey, bz)
+}
+
+// InitTotalDeveloperRewardsDistributed sets the total developer rewards distributed to the amount
+// the developer vesting module account was created with minus its current balance, for the chains
+// that distributed developer rewards before the total was tracked.
+func (k Keeper) InitTotalDeveloperRewardsDistributed(ctx sdk.Context) {
+	mintDenom := k.GetParams(ctx).MintDenom
+	balance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName), mintDenom)
+	k.setTotalDeveloperRewardsDistributed(ctx, osmomath.NewInt(developerVestingAmount).Sub(balance.Amount))
+}
+
+// GetDeveloperRewardsDistributed returns the developer rewards distributed to the given
+// receiver address, where the empty address is the community pool.
+func (k Keeper) GetDeveloperRewardsDistributed(ctx sdk.Context, address string) osmomath.Int {
+	distribution := types.DeveloperRewardsDistribution{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetDeveloperRewardsDistributionKey(address), &distribution)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return osmomath.ZeroInt()
+	}
+	return distribution.Amount
+}
+
+// GetAllDeveloperRewardsDistributions returns the developer rewards distributed to every
+// receiver address, ordered by address.
+func (k Keeper) GetAllDeveloperRewardsDistributions(ctx sdk.Context) []types.DeveloperRewardsDistribution {
+	distributions, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DeveloperRewardsDistributionPrefix, func(bz []byte) (types.DeveloperRewardsDistribution, error) {
+		distribution := types.DeveloperRewardsDistribution{}
+		err := proto.Unmarshal(bz, &distribution)
+		return distribution, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return distributions
+}
+
+// setDeveloperRewardsDistributed sets the developer rewards distributed to the given receiver address,
+// deleting the entry if the amount is zero.
+func (k Keeper) setDeveloperRewardsDistributed(ctx sdk.Context, address string, amount osmomath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDeveloperRewardsDistributionKey(address)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	osmoutils.MustSet(store, key, &types.DeveloperRewardsDistribution{Address: address, Amount: amount})
+}
+
+// addDeveloperRewardsDistribution adds amount to the developer rewards distributed to the given receiver address.
+func (k Keeper) addDeveloperRewardsDistribution(ctx sdk.Context, address string, amount osmomath.Int) {
+	if amount.IsZero() {
+		return
+	}
+	k.setDeveloperRewardsDistributed(ctx, address, k.GetDeveloperRewardsDistributed(ctx, address).Add(amount))
+}
+
+// MigrateDeveloperRewardsReceiver moves weight from the developer rewards weight of fromAddress
+// to toAddress, adding toAddress to the receivers if needed and removing fromAddress once all its
+// weight is migrated. The developer rewards distributed to fromAddress so far are moved pro-rata,
+// in the proportion of its weight that is migrated, so that the distributions of an address keep
+// reflecting the share of the developer rewards stream it holds.
+// Returns an error if fromAddress is not a receiver or weight exceeds its weight.
+func (k Keeper) MigrateDeveloperRewardsReceiver(ctx sdk.Context, fromAddress, toAddress string, weight osmomath.Dec) error {
+	params := k.GetParams(ctx)
+
+	fromIndex := -1
+	for i, receiver := range params.WeightedDeveloperRewardsReceivers {
+		if receiver.Address == fromAddress {
+			fromIndex = i
+			break
+		}
+	}
+	if fromIndex == -1 {
+		return errorsmod.Wrapf(types.ErrReceiverNotFound, "address %q", fromAddress)
+	}
+
+	fromWeight := params.WeightedDeveloperRewardsReceivers[fromIndex].Weight
+	if weight.GT(fromWeight) {
+		return errorsmod.Wrapf(types.ErrInvalidMigrationWeight, "weight %s exceeds the weight %s of %q", weight, fromWeight, fromAddress)
+	}
+
+	receivers := make([]types.WeightedAddress, 0, len(params.WeightedDeveloperRewardsReceivers)+1)
+	isToAddressReceiver := false
+	for i, receiver := range params.WeightedDeveloperRewardsReceivers {
+		// Receivers may be repeated, only their first occurrence is migrated.
+		if i == fromIndex {
+			receiver.Weight = receiver.Weight.Sub(weight)
+		} else if receiver.Address == toAddress && !isToAddressReceiver {
+			receiver.Weight = receiver.Weight.Add(weight)
+			isToAddressReceiver = true
+		}
+		if receiver.Weight.IsPositive() {
+			receivers = append(receivers, receiver)
+		}
+	}
+	if !isToAddressReceiver {
+		receivers = append(receivers, types.WeightedAddress{Address: toAddress, Weight: weight})
+	}
+
+	params.WeightedDeveloperRewardsReceivers = receivers
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+
+	fromDistributed := k.GetDeveloperRewardsDistributed(ctx, fromAddress)
+	movedDistributed := fromDistributed.ToLegacyDec().Mul(weight).Quo(fromWeight).TruncateInt()
+	if weight.Equal(fromWeight) {
+		movedDistributed = fromDistributed
+	}
+	k.setDeveloperRewardsDistributed(ctx, fromAddress, fromDistributed.Sub(movedDistributed))
+	k.addDeveloperRewardsDistribution(ctx, toAddress, movedDistributed)
+
+	return nil
+}
@@ -36,6 +36,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 
 	k.setLastReductionEpochNum(ctx, data.ReductionStartedEpoch)
+
+	if !data.TotalDeveloperRewardsDistributed.IsNil() {
+		k.setTotalDeveloperRewardsDistributed(ctx, data.TotalDeveloperRewardsDistributed)
+	}
+	for _, distribution := range data.DeveloperRewardsDistributions {
+		k.setDeveloperRewardsDistributed(ctx, distribution.Address, distribution.Amount)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -48,5 +55,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	lastHalvenEpoch := k.getLastReductionEpochNum(ctx)
-	return types.NewGenesisState(minter, params, lastHalvenEpoch)
+	genesis := types.NewGenesisState(minter, params, lastHalvenEpoch)
+	genesis.TotalDeveloperRewardsDistributed = k.GetTotalDeveloperRewardsDistributed(ctx)
+	if distributions := k.GetAllDeveloperRewardsDistributions(ctx); len(distributions) > 0 {
+		genesis.DeveloperRewardsDistributions = distributions
+	}
+	return genesis
 }
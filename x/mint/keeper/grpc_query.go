@@ -35,3 +35,13 @@ func (q Querier) EpochProvisions(c context.Context, _ *types.QueryEpochProvision
 
 	return &types.QueryEpochProvisionsResponse{EpochProvisions: minter.EpochProvisions}, nil
 }
+
+// DeveloperRewardsDistributions returns the developer rewards distributed, in total and to each receiver.
+func (q Querier) DeveloperRewardsDistributions(c context.Context, _ *types.QueryDeveloperRewardsDistributionsRequest) (*types.QueryDeveloperRewardsDistributionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDeveloperRewardsDistributionsResponse{
+		TotalDistributed: q.Keeper.GetTotalDeveloperRewardsDistributed(ctx),
+		Distributions:    q.Keeper.GetAllDeveloperRewardsDistributions(ctx),
+	}, nil
+}
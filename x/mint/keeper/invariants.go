@@ -0,0 +1,69 @@
+package keeper
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/mint/types"
+)
+
+const (
+	developerVestingBalanceInvariantName       = "developer-vesting-balance"
+	developerRewardsDistributionsInvariantName = "developer-rewards-distributions"
+)
+
+// RegisterInvariants registers all mint invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, developerVestingBalanceInvariantName, DeveloperVestingBalanceInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, developerRewardsDistributionsInvariantName, DeveloperRewardsDistributionsInvariant(keeper))
+}
+
+// DeveloperVestingBalanceInvariant ensures that the balance of the developer vesting module account
+// plus the total developer rewards distributed from it equals the amount it was created with.
+func DeveloperVestingBalanceInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		mintDenom := keeper.GetParams(ctx).MintDenom
+		balance := keeper.bankKeeper.GetBalance(ctx, keeper.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName), mintDenom)
+		totalDistributed := keeper.GetTotalDeveloperRewardsDistributed(ctx)
+
+		if !balance.Amount.Add(totalDistributed).Equal(osmomath.NewInt(developerVestingAmount)) {
+			return sdk.FormatInvariant(types.ModuleName, developerVestingBalanceInvariantName,
+				fmt.Sprintf("\tdeveloper vesting balance (%s) plus total distributed (%s) does not equal the developer vesting amount (%d)\n",
+					balance.Amount, totalDistributed, developerVestingAmount)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, developerVestingBalanceInvariantName,
+			"\tdeveloper vesting balance plus total distributed equals the developer vesting amount\n"), false
+	}
+}
+
+// DeveloperRewardsDistributionsInvariant ensures that the developer rewards distributed to the
+// receivers do not exceed the total developer rewards distributed. They may be smaller, as the
+// distributions to each receiver are only tracked since the v22 upgrade.
+func DeveloperRewardsDistributionsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalDistributed := keeper.GetTotalDeveloperRewardsDistributed(ctx)
+
+		distributed := osmomath.ZeroInt()
+		for _, distribution := range keeper.GetAllDeveloperRewardsDistributions(ctx) {
+			if !distribution.Amount.IsPositive() {
+				return sdk.FormatInvariant(types.ModuleName, developerRewardsDistributionsInvariantName,
+					fmt.Sprintf("\tnon positive developer rewards distributed to %q: %s\n", distribution.Address, distribution.Amount)), true
+			}
+			distributed = distributed.Add(distribution.Amount)
+		}
+
+		if distributed.GT(totalDistributed) {
+			return sdk.FormatInvariant(types.ModuleName, developerRewardsDistributionsInvariantName,
+				fmt.Sprintf("\tdeveloper rewards distributed to receivers (%s) exceed the total distributed (%s)\n",
+					distributed, totalDistributed)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, developerRewardsDistributionsInvariantName,
+			"\tdeveloper rewards distributed to receivers do not exceed the total distributed\n"), false
+	}
+}
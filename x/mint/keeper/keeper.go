@@ -28,6 +28,8 @@ type Keeper struct {
 	epochKeeper         types.EpochKeeper
 	hooks               types.MintHooks
 	feeCollectorName    string
+	// authority is the address allowed to execute the mint messages, the governance module account.
+	authority string
 }
 
 type invalidRatioError struct {
@@ -53,7 +55,7 @@ const emptyWeightedAddressReceiver = ""
 func NewKeeper(
 	key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, ck types.CommunityPoolKeeper, epochKeeper types.EpochKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -73,6 +75,7 @@ func NewKeeper(
 		communityPoolKeeper: ck,
 		epochKeeper:         epochKeeper,
 		feeCollectorName:    feeCollectorName,
+		authority:           authority,
 	}
 }
 
@@ -242,6 +245,7 @@ func (k Keeper) distributeDeveloperRewards(ctx sdk.Context, totalMintedCoin sdk.
 		if err != nil {
 			return osmomath.Int{}, err
 		}
+		k.addDeveloperRewardsDistribution(ctx, emptyWeightedAddressReceiver, devRewardCoin.Amount)
 	} else {
 		// allocate developer rewards to addresses by weight
 		for _, w := range developerRewardsReceivers {
@@ -269,12 +273,17 @@ func (k Keeper) distributeDeveloperRewards(ctx sdk.Context, totalMintedCoin sdk.
 					return osmomath.Int{}, err
 				}
 			}
+			k.addDeveloperRewardsDistribution(ctx, w.Address, devPortionCoin.Amount)
 		}
 	}
 
 	// Take the new balance of the developer rewards pool and add it back to the supply offset deduction
-	developerAccountBalance = k.bankKeeper.GetBalance(ctx, developerRewardsModuleAccountAddress, totalMintedCoin.Denom)
-	k.bankKeeper.AddSupplyOffset(ctx, totalMintedCoin.Denom, developerAccountBalance.Amount.Neg())
+	newDeveloperAccountBalance := k.bankKeeper.GetBalance(ctx, developerRewardsModuleAccountAddress, totalMintedCoin.Denom)
+	k.bankKeeper.AddSupplyOffset(ctx, totalMintedCoin.Denom, newDeveloperAccountBalance.Amount.Neg())
+
+	// The truncation dust of the receivers' portions stays in the developer vesting module account,
+	// so the total distributed is the decrease of its balance rather than devRewardCoin.
+	k.setTotalDeveloperRewardsDistributed(ctx, k.GetTotalDeveloperRewardsDistributed(ctx).Add(developerAccountBalance.Amount.Sub(newDeveloperAccountBalance.Amount)))
 
 	return devRewardCoin.Amount, nil
 }
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/mint/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the mint MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateDistributionProportions sets the distribution proportions of the minted coins.
+func (server msgServer) UpdateDistributionProportions(goCtx context.Context, msg *types.MsgUpdateDistributionProportions) (*types.MsgUpdateDistributionProportionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	params := server.keeper.GetParams(ctx)
+	params.DistributionProportions = msg.DistributionProportions
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	server.keeper.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtUpdateDistributionProportions,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgUpdateDistributionProportionsResponse{}, nil
+}
+
+// MigrateDeveloperRewardsReceiver migrates a part of the developer rewards weight of a receiver to another address.
+func (server msgServer) MigrateDeveloperRewardsReceiver(goCtx context.Context, msg *types.MsgMigrateDeveloperRewardsReceiver) (*types.MsgMigrateDeveloperRewardsReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.MigrateDeveloperRewardsReceiver(ctx, msg.FromAddress, msg.ToAddress, msg.Weight); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMigrateDeveloperRewardsReceiver,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyToAddress, msg.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyWeight, msg.Weight.String()),
+		),
+	})
+
+	return &types.MsgMigrateDeveloperRewardsReceiverResponse{}, nil
+}
+
+// validateAuthority returns an error if the given address is not the authority of the mint messages.
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/mint/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/mint/types"
+)
+
+var govAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func (s *KeeperTestSuite) TestMsgUpdateDistributionProportions() {
+	validProportions := types.DistributionProportions{
+		Staking:          osmomath.NewDecWithPrec(5, 1),
+		PoolIncentives:   osmomath.NewDecWithPrec(2, 1),
+		DeveloperRewards: osmomath.NewDecWithPrec(1, 1),
+		CommunityPool:    osmomath.NewDecWithPrec(2, 1),
+	}
+	invalidProportions := validProportions
+	invalidProportions.CommunityPool = osmomath.NewDecWithPrec(3, 1)
+
+	tests := map[string]struct {
+		authority     string
+		proportions   types.DistributionProportions
+		expectedError bool
+	}{
+		"valid update": {
+			authority:   govAuthority,
+			proportions: validProportions,
+		},
+		"invalid authority": {
+			authority:     testAddressOne.String(),
+			proportions:   validProportions,
+			expectedError: true,
+		},
+		"proportions do not sum to one": {
+			authority:     govAuthority,
+			proportions:   invalidProportions,
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			originalParams := s.App.MintKeeper.GetParams(s.Ctx)
+			msgServer := keeper.NewMsgServerImpl(s.App.MintKeeper)
+
+			_, err := msgServer.UpdateDistributionProportions(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateDistributionProportions(tc.authority, tc.proportions))
+
+			params := s.App.MintKeeper.GetParams(s.Ctx)
+			if tc.expectedError {
+				s.Require().Error(err)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.proportions, params.DistributionProportions)
+			originalParams.DistributionProportions = tc.proportions
+			s.Require().Equal(originalParams, params)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgMigrateDeveloperRewardsReceiver() {
+	tests := map[string]struct {
+		authority   string
+		fromAddress string
+		toAddress   string
+		weight      osmomath.Dec
+
+		expectedError        error
+		expectedReceivers    []types.WeightedAddress
+		expectedFromReceived osmomath.Int
+		expectedToReceived   osmomath.Int
+	}{
+		"migrate part of the weight to a new receiver": {
+			authority:   govAuthority,
+			fromAddress: testAddressOne.String(),
+			toAddress:   testAddressThree.String(),
+			weight:      osmomath.NewDecWithPrec(15, 2),
+
+			expectedReceivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(45, 2)},
+				{Address: testAddressTwo.String(), Weight: osmomath.NewDecWithPrec(4, 1)},
+				{Address: testAddressThree.String(), Weight: osmomath.NewDecWithPrec(15, 2)},
+			},
+			// 600 received with a weight of .6, a quarter of which is migrated.
+			expectedFromReceived: osmomath.NewInt(450),
+			expectedToReceived:   osmomath.NewInt(150),
+		},
+		"migrate all the weight to an existing receiver": {
+			authority:   govAuthority,
+			fromAddress: testAddressOne.String(),
+			toAddress:   testAddressTwo.String(),
+			weight:      osmomath.NewDecWithPrec(6, 1),
+
+			expectedReceivers: []types.WeightedAddress{
+				{Address: testAddressTwo.String(), Weight: osmomath.OneDec()},
+			},
+			expectedFromReceived: osmomath.ZeroInt(),
+			expectedToReceived:   osmomath.NewInt(1000),
+		},
+		"migrate to the community pool": {
+			authority:   govAuthority,
+			fromAddress: testAddressTwo.String(),
+			toAddress:   keeper.EmptyWeightedAddressReceiver,
+			weight:      osmomath.NewDecWithPrec(1, 1),
+
+			expectedReceivers: []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(6, 1)},
+				{Address: testAddressTwo.String(), Weight: osmomath.NewDecWithPrec(3, 1)},
+				{Address: keeper.EmptyWeightedAddressReceiver, Weight: osmomath.NewDecWithPrec(1, 1)},
+			},
+			expectedFromReceived: osmomath.NewInt(300),
+			expectedToReceived:   osmomath.NewInt(100),
+		},
+		"invalid authority": {
+			authority:     testAddressOne.String(),
+			fromAddress:   testAddressOne.String(),
+			toAddress:     testAddressThree.String(),
+			weight:        osmomath.NewDecWithPrec(1, 1),
+			expectedError: govtypes.ErrInvalidSigner,
+		},
+		"from address is not a receiver": {
+			authority:     govAuthority,
+			fromAddress:   testAddressFour.String(),
+			toAddress:     testAddressThree.String(),
+			weight:        osmomath.NewDecWithPrec(1, 1),
+			expectedError: types.ErrReceiverNotFound,
+		},
+		"weight exceeds the receiver weight": {
+			authority:     govAuthority,
+			fromAddress:   testAddressTwo.String(),
+			toAddress:     testAddressThree.String(),
+			weight:        osmomath.NewDecWithPrec(5, 1),
+			expectedError: types.ErrInvalidMigrationWeight,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			mintKeeper := s.App.MintKeeper
+			msgServer := keeper.NewMsgServerImpl(mintKeeper)
+
+			receivers := []types.WeightedAddress{
+				{Address: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(6, 1)},
+				{Address: testAddressTwo.String(), Weight: osmomath.NewDecWithPrec(4, 1)},
+			}
+			params := mintKeeper.GetParams(s.Ctx)
+			params.WeightedDeveloperRewardsReceivers = receivers
+			mintKeeper.SetParams(s.Ctx, params)
+
+			// Distribute 1000 of developer rewards, 600 to the first receiver and 400 to the second.
+			mintedCoin := sdk.NewCoin(params.MintDenom, osmomath.NewInt(1000))
+			s.Require().NoError(mintKeeper.MintCoins(s.Ctx, sdk.NewCoins(mintedCoin)))
+			_, err := mintKeeper.DistributeDeveloperRewards(s.Ctx, mintedCoin, osmomath.OneDec(), receivers)
+			s.Require().NoError(err)
+			s.Require().Equal(osmomath.NewInt(1000), mintKeeper.GetTotalDeveloperRewardsDistributed(s.Ctx))
+
+			msg := types.NewMsgMigrateDeveloperRewardsReceiver(tc.authority, tc.fromAddress, tc.toAddress, tc.weight)
+			s.Require().NoError(msg.ValidateBasic())
+			_, err = msgServer.MigrateDeveloperRewardsReceiver(sdk.WrapSDKContext(s.Ctx), msg)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(receivers, mintKeeper.GetParams(s.Ctx).WeightedDeveloperRewardsReceivers)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedReceivers, mintKeeper.GetParams(s.Ctx).WeightedDeveloperRewardsReceivers)
+			s.Require().Equal(tc.expectedFromReceived, mintKeeper.GetDeveloperRewardsDistributed(s.Ctx, tc.fromAddress))
+			s.Require().Equal(tc.expectedToReceived, mintKeeper.GetDeveloperRewardsDistributed(s.Ctx, tc.toAddress))
+
+			// The migration does not change the total distributed, so the invariants still hold.
+			s.Require().Equal(osmomath.NewInt(1000), mintKeeper.GetTotalDeveloperRewardsDistributed(s.Ctx))
+			_, broken := keeper.DeveloperVestingBalanceInvariant(*mintKeeper)(s.Ctx)
+			s.Require().False(broken)
+			_, broken = keeper.DeveloperRewardsDistributionsInvariant(*mintKeeper)(s.Ctx)
+			s.Require().False(broken)
+		})
+	}
+}
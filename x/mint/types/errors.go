@@ -8,4 +8,6 @@ var (
 	ErrAmountNilOrZero           = errorsmod.Register(ModuleName, 2, "amount cannot be nil or zero")
 	ErrModuleAccountAlreadyExist = errorsmod.Register(ModuleName, 3, "module account already exists")
 	ErrModuleDoesnotExist        = errorsmod.Register(ModuleName, 4, "module account does not exist")
+	ErrReceiverNotFound          = errorsmod.Register(ModuleName, 5, "developer rewards receiver not found")
+	ErrInvalidMigrationWeight    = errorsmod.Register(ModuleName, 6, "invalid developer rewards migration weight")
 )
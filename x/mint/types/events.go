@@ -2,10 +2,19 @@ package types
 
 // Minting module event constants.
 const (
+	TypeEvtUpdateDistributionProportions   = "update_distribution_proportions"
+	TypeEvtMigrateDeveloperRewardsReceiver = "migrate_developer_rewards_receiver"
+
 	// AttributeKeyEpochProvisions is the string representation of the
 	// epoch provisions event attribute.
 	AttributeKeyEpochProvisions = "epoch_provisions"
 	// AttributeEpochNumber is the string representation of the
 	// epoch number event attribute.
 	AttributeEpochNumber = "epoch_number"
+	// AttributeKeyFromAddress is the developer rewards receiver a weight is migrated from.
+	AttributeKeyFromAddress = "from_address"
+	// AttributeKeyToAddress is the developer rewards receiver a weight is migrated to.
+	AttributeKeyToAddress = "to_address"
+	// AttributeKeyWeight is the migrated developer rewards weight.
+	AttributeKeyWeight = "weight"
 )
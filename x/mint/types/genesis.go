@@ -1,20 +1,29 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
 // NewGenesisState creates a new GenesisState object.
 func NewGenesisState(minter Minter, params Params, reductionStartedEpoch int64) *GenesisState {
 	return &GenesisState{
-		Minter:                minter,
-		Params:                params,
-		ReductionStartedEpoch: reductionStartedEpoch,
+		Minter:                           minter,
+		Params:                           params,
+		ReductionStartedEpoch:            reductionStartedEpoch,
+		TotalDeveloperRewardsDistributed: osmomath.ZeroInt(),
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Minter:                DefaultInitialMinter(),
-		Params:                DefaultParams(),
-		ReductionStartedEpoch: 0,
+		Minter:                           DefaultInitialMinter(),
+		Params:                           DefaultParams(),
+		ReductionStartedEpoch:            0,
+		TotalDeveloperRewardsDistributed: osmomath.ZeroInt(),
 	}
 }
 
@@ -25,5 +34,40 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := validateDeveloperRewardsDistributions(data.TotalDeveloperRewardsDistributed, data.DeveloperRewardsDistributions); err != nil {
+		return err
+	}
+
 	return data.Minter.Validate()
 }
+
+// validateDeveloperRewardsDistributions validates that the developer rewards distributed
+// to each receiver are positive, that no receiver is repeated and that they do not exceed
+// the total developer rewards distributed.
+// A nil total is allowed for the genesis files exported before the distributions were tracked.
+func validateDeveloperRewardsDistributions(total osmomath.Int, distributions []DeveloperRewardsDistribution) error {
+	if total.IsNil() {
+		total = osmomath.ZeroInt()
+	}
+	if total.IsNegative() {
+		return errors.New("total developer rewards distributed should not be negative")
+	}
+
+	sum := osmomath.ZeroInt()
+	seen := make(map[string]bool, len(distributions))
+	for _, distribution := range distributions {
+		if seen[distribution.Address] {
+			return fmt.Errorf("duplicate developer rewards distribution for address %q", distribution.Address)
+		}
+		seen[distribution.Address] = true
+		if distribution.Amount.IsNil() || !distribution.Amount.IsPositive() {
+			return fmt.Errorf("developer rewards distributed to address %q should be positive", distribution.Address)
+		}
+		sum = sum.Add(distribution.Amount)
+	}
+
+	if sum.GT(total) {
+		return fmt.Errorf("developer rewards distributed to receivers (%s) exceed the total distributed (%s)", sum, total)
+	}
+	return nil
+}
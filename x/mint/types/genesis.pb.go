@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +33,13 @@ type GenesisState struct {
 	// reduction_started_epoch is the first epoch in which the reduction of mint
 	// begins.
 	ReductionStartedEpoch int64 `protobuf:"varint,3,opt,name=reduction_started_epoch,json=reductionStartedEpoch,proto3" json:"reduction_started_epoch,omitempty" yaml:"reduction_started_epoch"`
+	// total_developer_rewards_distributed is the total amount of mint_denom
+	// distributed from the developer vesting module account, to the developer
+	// rewards receivers or the community pool.
+	TotalDeveloperRewardsDistributed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_developer_rewards_distributed,json=totalDeveloperRewardsDistributed,proto3,customtype=cosmossdk.io/math.Int" json:"total_developer_rewards_distributed" yaml:"total_developer_rewards_distributed"`
+	// developer_rewards_distributions are the developer rewards distributed to
+	// each developer rewards receiver address.
+	DeveloperRewardsDistributions []DeveloperRewardsDistribution `protobuf:"bytes,5,rep,name=developer_rewards_distributions,json=developerRewardsDistributions,proto3" json:"developer_rewards_distributions" yaml:"developer_rewards_distributions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +96,13 @@ func (m *GenesisState) GetReductionStartedEpoch() int64 {
 	return 0
 }
 
+func (m *GenesisState) GetDeveloperRewardsDistributions() []DeveloperRewardsDistribution {
+	if m != nil {
+		return m.DeveloperRewardsDistributions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.mint.v1beta1.GenesisState")
 }
@@ -97,25 +112,33 @@ func init() {
 }
 
 var fileDescriptor_12e6a5511ad3feeb = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xaa, 0xd1, 0x03, 0xa9, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0xe4, 0xb1, 0x9a, 0x07, 0xd6, 0x08, 0x56, 0xa0, 0xf4, 0x88,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x7c, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x1b, 0x48,
-	0x3a, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x46, 0x0f, 0x9b, 0x75, 0x7a, 0xbe,
-	0x60, 0x35, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x80, 0xf4, 0x16, 0x24, 0x16,
-	0x25, 0xe6, 0x16, 0x4b, 0x30, 0xe1, 0xd3, 0x1b, 0x00, 0x56, 0x03, 0xd3, 0x0b, 0xd1, 0x21, 0x14,
-	0xc5, 0x25, 0x5e, 0x94, 0x9a, 0x52, 0x9a, 0x5c, 0x92, 0x99, 0x9f, 0x17, 0x5f, 0x5c, 0x92, 0x58,
-	0x54, 0x92, 0x9a, 0x12, 0x9f, 0x5a, 0x90, 0x9f, 0x9c, 0x21, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0xec,
-	0xa4, 0xf4, 0xe9, 0x9e, 0xbc, 0x5c, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x0e, 0x85, 0x4a, 0x41,
-	0xa2, 0x70, 0x99, 0x60, 0x88, 0x84, 0x2b, 0x48, 0xdc, 0xc9, 0xeb, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0xa1, 0x6e, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0x0c, 0xf5, 0x2b,
-	0x20, 0xa1, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x37, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb1, 0xbe, 0xae, 0xb8, 0xaa, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x1c, 0xc6, 0x3b, 0xb6, 0x2e, 0x98, 0xf5, 0x14, 0x76, 0x31, 0x2c, 0x9a, 0x84, 0x08, 0x52, 0x04,
+	0x67, 0x6c, 0xbc, 0xed, 0x31, 0xac, 0x88, 0x8a, 0x20, 0xd9, 0xdb, 0x5e, 0xc2, 0x24, 0x33, 0xa4,
+	0x83, 0x49, 0x26, 0xcc, 0xfc, 0x5b, 0xdd, 0xb7, 0xf0, 0xec, 0x23, 0x88, 0x0f, 0xb2, 0xc7, 0x3d,
+	0x8a, 0x87, 0x20, 0xed, 0x1b, 0xf4, 0x09, 0x24, 0x33, 0xa9, 0x5e, 0xda, 0xba, 0xb7, 0x64, 0xbe,
+	0xdf, 0xf7, 0xfd, 0xbf, 0x19, 0xfe, 0x4e, 0x24, 0x75, 0x2d, 0xb5, 0xd0, 0xa4, 0x16, 0x0d, 0x90,
+	0xe5, 0x2c, 0xe7, 0x40, 0x67, 0xa4, 0xe4, 0x0d, 0xd7, 0x42, 0xe3, 0x56, 0x49, 0x90, 0xee, 0xc9,
+	0xc0, 0xe0, 0x9e, 0xc1, 0x03, 0x73, 0x76, 0x52, 0xca, 0x52, 0x1a, 0x80, 0xf4, 0x5f, 0x96, 0x3d,
+	0x0b, 0x76, 0xe6, 0x19, 0xa3, 0x01, 0xa2, 0x1f, 0x13, 0xe7, 0xe1, 0x1b, 0x1b, 0x7f, 0x09, 0x14,
+	0xb8, 0x7b, 0xee, 0x1c, 0xf5, 0x32, 0x57, 0x1e, 0x0a, 0xd1, 0xf4, 0x38, 0x7e, 0x8c, 0x77, 0x8d,
+	0xc3, 0x1f, 0x0c, 0x93, 0x4c, 0x6e, 0xba, 0x60, 0x94, 0x0e, 0x8e, 0xde, 0xdb, 0x52, 0x45, 0x6b,
+	0xed, 0xdd, 0x3b, 0xe4, 0xfd, 0x68, 0x98, 0xad, 0xd7, 0x3a, 0xdc, 0x2b, 0xe7, 0x91, 0xe2, 0x6c,
+	0x51, 0x80, 0x90, 0x4d, 0xa6, 0x81, 0x2a, 0xe0, 0x2c, 0xe3, 0xad, 0x2c, 0xe6, 0xde, 0x38, 0x44,
+	0xd3, 0x71, 0x12, 0x6d, 0xba, 0xc0, 0xbf, 0xa6, 0x75, 0x75, 0x1e, 0xed, 0x01, 0xa3, 0xf4, 0xf4,
+	0xaf, 0x72, 0x69, 0x85, 0xd7, 0xfd, 0xb9, 0xfb, 0x0d, 0x39, 0x4f, 0x41, 0x02, 0xad, 0x32, 0xc6,
+	0x97, 0xbc, 0x92, 0x2d, 0x57, 0x99, 0xe2, 0x9f, 0xa9, 0x62, 0x3a, 0x63, 0x42, 0x83, 0x12, 0xf9,
+	0x02, 0x38, 0xf3, 0x26, 0x21, 0x9a, 0x3e, 0x48, 0xde, 0xf7, 0xbd, 0x7e, 0x75, 0xc1, 0x69, 0x61,
+	0xda, 0x6b, 0xf6, 0x09, 0x0b, 0x49, 0x6a, 0x0a, 0x73, 0xfc, 0xb6, 0x81, 0x4d, 0x17, 0x3c, 0xb7,
+	0x2d, 0xee, 0x90, 0x18, 0xa5, 0xa1, 0xa1, 0x2e, 0xb6, 0x50, 0x6a, 0x99, 0x8b, 0x7f, 0x88, 0xfb,
+	0x1d, 0x39, 0xc1, 0x81, 0x10, 0x21, 0x1b, 0xed, 0xdd, 0x0f, 0xc7, 0xd3, 0xe3, 0x38, 0xde, 0xfd,
+	0x9c, 0x7b, 0xc3, 0x85, 0x6c, 0x12, 0xdc, 0x5f, 0x66, 0xd3, 0x05, 0xcf, 0x6c, 0xe7, 0xff, 0x0c,
+	0x8a, 0xd2, 0x27, 0xec, 0x40, 0x9a, 0x4e, 0xde, 0xdd, 0xac, 0x7c, 0x74, 0xbb, 0xf2, 0xd1, 0xef,
+	0x95, 0x8f, 0xbe, 0xae, 0xfd, 0xd1, 0xed, 0xda, 0x1f, 0xfd, 0x5c, 0xfb, 0xa3, 0xab, 0x97, 0xa5,
+	0x80, 0xf9, 0x22, 0xc7, 0x85, 0xac, 0xc9, 0x50, 0xf3, 0x45, 0x45, 0x73, 0xbd, 0xfd, 0x21, 0xcb,
+	0x78, 0x46, 0xbe, 0xd8, 0x3d, 0x84, 0xeb, 0x96, 0xeb, 0xfc, 0xc8, 0x6c, 0xe0, 0xab, 0x3f, 0x03,
+	0x00, 0xb9, 0x11, 0xfa, 0xbe, 0xf4, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +161,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeveloperRewardsDistributions) > 0 {
+		for iNdEx := len(m.DeveloperRewardsDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperRewardsDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.TotalDeveloperRewardsDistributed.Size()
+		i -= size
+		if _, err := m.TotalDeveloperRewardsDistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.ReductionStartedEpoch != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ReductionStartedEpoch))
 		i--
@@ -190,6 +237,14 @@ func (m *GenesisState) Size() (n int) {
 	if m.ReductionStartedEpoch != 0 {
 		n += 1 + sovGenesis(uint64(m.ReductionStartedEpoch))
 	}
+	l = m.TotalDeveloperRewardsDistributed.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DeveloperRewardsDistributions) > 0 {
+		for _, e := range m.DeveloperRewardsDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -313,6 +368,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeveloperRewardsDistributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeveloperRewardsDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewardsDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperRewardsDistributions = append(m.DeveloperRewardsDistributions, DeveloperRewardsDistribution{})
+			if err := m.DeveloperRewardsDistributions[len(m.DeveloperRewardsDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
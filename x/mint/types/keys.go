@@ -8,6 +8,14 @@ var MinterKey = []byte{0x00}
 // for storing the last epoch at which reduction occurred.
 var LastReductionEpochKey = []byte{0x03}
 
+// TotalDeveloperRewardsDistributedKey is the key to use for the keeper store
+// for storing the total amount distributed from the developer vesting module account.
+var TotalDeveloperRewardsDistributedKey = []byte{0x04}
+
+// DeveloperRewardsDistributionPrefix is the prefix of the keeper store keys
+// for storing the developer rewards distributed to each receiver address.
+var DeveloperRewardsDistributionPrefix = []byte{0x05}
+
 const (
 	// ModuleName is the module name.
 	ModuleName = "mint"
@@ -17,6 +25,9 @@ const (
 	// StoreKey is the default store key for mint.
 	StoreKey = ModuleName
 
+	// RouterKey is the message route for mint.
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
@@ -26,3 +37,9 @@ const (
 	// QueryEpochProvisions is an endpoint path for querying mint epoch provisions.
 	QueryEpochProvisions = "epoch_provisions"
 )
+
+// GetDeveloperRewardsDistributionKey returns the key for storing the developer rewards
+// distributed to the given receiver address. The empty address is the community pool.
+func GetDeveloperRewardsDistributionKey(address string) []byte {
+	return append(DeveloperRewardsDistributionPrefix, []byte(address)...)
+}
@@ -114,6 +114,53 @@ func (m *WeightedAddress) GetAddress() string {
 	return ""
 }
 
+// DeveloperRewardsDistribution is the total amount of developer rewards
+// distributed to an address, from the developer vesting module account.
+type DeveloperRewardsDistribution struct {
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *DeveloperRewardsDistribution) Reset()         { *m = DeveloperRewardsDistribution{} }
+func (m *DeveloperRewardsDistribution) String() string { return proto.CompactTextString(m) }
+func (*DeveloperRewardsDistribution) ProtoMessage()    {}
+func (*DeveloperRewardsDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{2}
+}
+func (m *DeveloperRewardsDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeveloperRewardsDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeveloperRewardsDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeveloperRewardsDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeveloperRewardsDistribution.Merge(m, src)
+}
+func (m *DeveloperRewardsDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeveloperRewardsDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeveloperRewardsDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeveloperRewardsDistribution proto.InternalMessageInfo
+
+func (m *DeveloperRewardsDistribution) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // DistributionProportions defines the distribution proportions of the minted
 // denom. In other words, defines which stakeholders will receive the minted
 // denoms and how much.
@@ -136,7 +183,7 @@ func (m *DistributionProportions) Reset()         { *m = DistributionProportions
 func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
 func (*DistributionProportions) ProtoMessage()    {}
 func (*DistributionProportions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccb38f8335e0f45b, []int{2}
+	return fileDescriptor_ccb38f8335e0f45b, []int{3}
 }
 func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +243,7 @@ type Params struct {
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccb38f8335e0f45b, []int{3}
+	return fileDescriptor_ccb38f8335e0f45b, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,6 +317,7 @@ func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 func init() {
 	proto.RegisterType((*Minter)(nil), "osmosis.mint.v1beta1.Minter")
 	proto.RegisterType((*WeightedAddress)(nil), "osmosis.mint.v1beta1.WeightedAddress")
+	proto.RegisterType((*DeveloperRewardsDistribution)(nil), "osmosis.mint.v1beta1.DeveloperRewardsDistribution")
 	proto.RegisterType((*DistributionProportions)(nil), "osmosis.mint.v1beta1.DistributionProportions")
 	proto.RegisterType((*Params)(nil), "osmosis.mint.v1beta1.Params")
 }
@@ -277,56 +325,58 @@ func init() {
 func init() { proto.RegisterFile("osmosis/mint/v1beta1/mint.proto", fileDescriptor_ccb38f8335e0f45b) }
 
 var fileDescriptor_ccb38f8335e0f45b = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0xdb, 0x48,
-	0x18, 0x8d, 0x37, 0x6c, 0x58, 0x06, 0x41, 0x58, 0x8b, 0x25, 0x5e, 0xd0, 0xc6, 0x60, 0x81, 0x94,
-	0x95, 0x96, 0x78, 0x81, 0xdd, 0x0b, 0xda, 0x1f, 0xda, 0x28, 0x8b, 0x44, 0x45, 0xd5, 0x68, 0x7a,
-	0xa8, 0xd4, 0x8b, 0xe5, 0xd8, 0x13, 0x67, 0x44, 0xec, 0xb1, 0x66, 0x26, 0xa1, 0xb9, 0xf5, 0x5e,
-	0x55, 0xea, 0xb1, 0xc7, 0xf6, 0xd0, 0xff, 0x85, 0x23, 0xc7, 0xaa, 0x87, 0xa8, 0x82, 0x53, 0xaf,
-	0xf9, 0x0b, 0xaa, 0xf9, 0xe1, 0x04, 0x0c, 0x91, 0xa2, 0xde, 0x3c, 0xef, 0x7b, 0xf3, 0xde, 0x97,
-	0x6f, 0xe6, 0x4d, 0x80, 0x4d, 0x58, 0x4c, 0x18, 0x66, 0x6e, 0x8c, 0x13, 0xee, 0x0e, 0x0e, 0xda,
-	0x88, 0xfb, 0x07, 0x72, 0x51, 0x4f, 0x29, 0xe1, 0xc4, 0x5c, 0xd7, 0x84, 0xba, 0xc4, 0x34, 0x61,
-	0x73, 0x3d, 0x22, 0x11, 0x91, 0x04, 0x57, 0x7c, 0x29, 0xee, 0xa6, 0x1d, 0x11, 0x12, 0xf5, 0x90,
-	0x2b, 0x57, 0xed, 0x7e, 0xc7, 0xe5, 0x38, 0x46, 0x8c, 0xfb, 0x71, 0xaa, 0x09, 0x3f, 0xe7, 0x09,
-	0x7e, 0x32, 0xd4, 0xa5, 0x6a, 0xbe, 0x14, 0xf6, 0xa9, 0xcf, 0x31, 0x49, 0x54, 0xdd, 0x61, 0xa0,
-	0xf4, 0x18, 0x27, 0x1c, 0x51, 0x13, 0x83, 0x35, 0x94, 0x92, 0xa0, 0xeb, 0xa5, 0x94, 0x0c, 0x30,
-	0xc3, 0x24, 0x61, 0x96, 0xb1, 0x6d, 0xd4, 0x96, 0x1a, 0xff, 0x5c, 0x8e, 0xec, 0xc2, 0xa7, 0x91,
-	0xbd, 0x15, 0xc8, 0xa6, 0x59, 0x78, 0x5e, 0xc7, 0xc4, 0x8d, 0x7d, 0xde, 0xad, 0x9f, 0xa1, 0xc8,
-	0x0f, 0x86, 0x4d, 0x14, 0x8c, 0x47, 0x76, 0x65, 0xe8, 0xc7, 0xbd, 0x63, 0x27, 0x2f, 0xe2, 0xc0,
-	0xb2, 0x84, 0x5a, 0x53, 0xe4, 0xb5, 0x01, 0xca, 0xcf, 0x10, 0x8e, 0xba, 0x1c, 0x85, 0xff, 0x85,
-	0x21, 0x45, 0x8c, 0x99, 0xbf, 0x81, 0x45, 0x5f, 0x7d, 0x6a, 0x57, 0x73, 0x3c, 0xb2, 0x57, 0x95,
-	0xa4, 0x2e, 0x38, 0x30, 0xa3, 0x98, 0x67, 0xa0, 0x74, 0x21, 0x05, 0xac, 0xef, 0x24, 0xf9, 0x8f,
-	0xf9, 0x5a, 0x5c, 0x51, 0x7a, 0x6a, 0xab, 0x03, 0xb5, 0x86, 0xf3, 0xa1, 0x08, 0x2a, 0x4d, 0xcc,
-	0x38, 0xc5, 0xed, 0xbe, 0x98, 0x4d, 0x8b, 0x92, 0x94, 0x50, 0xf1, 0xc5, 0xcc, 0x27, 0x60, 0x91,
-	0x71, 0xff, 0x1c, 0x27, 0x91, 0xee, 0xeb, 0xcf, 0xf9, 0xac, 0x74, 0xeb, 0x7a, 0xaf, 0x03, 0x33,
-	0x15, 0xb3, 0x03, 0xca, 0x29, 0x21, 0x3d, 0x0f, 0x27, 0x01, 0x4a, 0x38, 0x1e, 0x20, 0xa6, 0x7f,
-	0xc3, 0xdf, 0xf3, 0x09, 0x6f, 0x28, 0xe1, 0x9c, 0x86, 0x03, 0x57, 0x05, 0x72, 0x3a, 0x01, 0xcc,
-	0x1e, 0xf8, 0x31, 0x44, 0x03, 0xd4, 0x23, 0x29, 0xa2, 0x1e, 0x45, 0x17, 0x3e, 0x0d, 0x99, 0x55,
-	0x94, 0x4e, 0xff, 0xce, 0xe7, 0x64, 0x29, 0xa7, 0x7b, 0x2a, 0x0e, 0x5c, 0x9b, 0x60, 0x50, 0x41,
-	0x66, 0x00, 0x56, 0x03, 0x12, 0xc7, 0xfd, 0x04, 0xf3, 0xa1, 0x27, 0x3a, 0xb1, 0x16, 0xa4, 0xd5,
-	0x5f, 0xf3, 0x59, 0xfd, 0xa4, 0xac, 0xee, 0x4a, 0x38, 0x70, 0x65, 0x02, 0xb4, 0xc4, 0xfa, 0x4b,
-	0x09, 0x94, 0x5a, 0x3e, 0xf5, 0x63, 0x66, 0xfe, 0x02, 0x80, 0x48, 0x8e, 0x17, 0xa2, 0x84, 0xc4,
-	0xea, 0x64, 0xe0, 0x92, 0x40, 0x9a, 0x02, 0x30, 0x5f, 0x1a, 0xc0, 0x8a, 0x50, 0x82, 0x18, 0x66,
-	0xde, 0xbd, 0x5b, 0xad, 0xc6, 0x7d, 0x32, 0x5f, 0x67, 0xb6, 0xea, 0x6c, 0x96, 0x98, 0x03, 0x37,
-	0x74, 0xe9, 0xff, 0xbb, 0x97, 0xdc, 0x3c, 0xc9, 0xf2, 0x84, 0x43, 0x71, 0x24, 0x1d, 0x8c, 0xa8,
-	0x1e, 0xff, 0x56, 0x3e, 0x2c, 0x53, 0x46, 0x16, 0x96, 0xd3, 0x09, 0x62, 0xb6, 0xc1, 0x26, 0x45,
-	0x61, 0x3f, 0x10, 0xd7, 0xd1, 0x4b, 0x11, 0xc5, 0x24, 0xf4, 0x70, 0xa2, 0x1a, 0x61, 0x72, 0xca,
-	0xc5, 0xc6, 0xde, 0x78, 0x64, 0xef, 0x28, 0xc5, 0xd9, 0x5c, 0x07, 0x56, 0x26, 0xc5, 0x96, 0xac,
-	0x9d, 0x26, 0xb2, 0x69, 0x26, 0xb2, 0x3f, 0xdd, 0xd7, 0xf1, 0x03, 0x4e, 0xa8, 0xf5, 0xfd, 0x37,
-	0x64, 0x3f, 0x2f, 0xe2, 0xc0, 0xf2, 0x04, 0x3a, 0x91, 0x88, 0x99, 0x00, 0x2b, 0xbc, 0x15, 0x35,
-	0x31, 0xca, 0x2c, 0x6b, 0x56, 0x69, 0xdb, 0xa8, 0x2d, 0x1f, 0xee, 0xd7, 0x1f, 0x7a, 0x1b, 0xeb,
-	0x33, 0x02, 0xda, 0x58, 0x10, 0x1d, 0xc2, 0x4a, 0x38, 0x23, 0xbf, 0xef, 0x0d, 0xb0, 0x7b, 0xa1,
-	0xdf, 0x1a, 0xef, 0xde, 0x55, 0xf6, 0x28, 0x0a, 0x10, 0x1e, 0x20, 0xca, 0xac, 0xc5, 0xed, 0x62,
-	0x6d, 0xf9, 0x70, 0xef, 0x61, 0xf3, 0xdc, 0x6b, 0xd5, 0xf8, 0x55, 0x98, 0x4e, 0x87, 0x3e, 0x5b,
-	0xd7, 0x81, 0x3b, 0x99, 0x7b, 0x33, 0x97, 0x19, 0x98, 0x59, 0x9b, 0xaf, 0x0c, 0x50, 0x13, 0x76,
-	0x38, 0x89, 0x26, 0x02, 0x77, 0x86, 0xc4, 0xb8, 0x4f, 0xb9, 0x3a, 0x46, 0xeb, 0x07, 0x79, 0xe2,
-	0x47, 0xe3, 0x91, 0xed, 0x2a, 0xf3, 0x79, 0x77, 0x3a, 0x70, 0x57, 0x53, 0x75, 0x03, 0xb7, 0x27,
-	0xfa, 0x54, 0xf0, 0xe4, 0x6d, 0x38, 0x5e, 0x78, 0xfb, 0xce, 0x2e, 0x34, 0x1e, 0x5d, 0x5e, 0x57,
-	0x8d, 0xab, 0xeb, 0xaa, 0xf1, 0xf9, 0xba, 0x6a, 0xbc, 0xb9, 0xa9, 0x16, 0xae, 0x6e, 0xaa, 0x85,
-	0x8f, 0x37, 0xd5, 0xc2, 0xf3, 0xdf, 0x23, 0xcc, 0xbb, 0xfd, 0x76, 0x3d, 0x20, 0xb1, 0xab, 0x87,
-	0xb5, 0xdf, 0xf3, 0xdb, 0x2c, 0x5b, 0xb8, 0x83, 0xc3, 0x03, 0xf7, 0x85, 0xfa, 0xe7, 0xe3, 0xc3,
-	0x14, 0xb1, 0x76, 0x49, 0xfe, 0xd7, 0x1c, 0x7d, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x11, 0x85, 0x4a,
-	0x5d, 0x16, 0x07, 0x00, 0x00,
+	// 807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x92, 0xe0, 0xd0, 0xa9, 0x9a, 0x94, 0x55, 0xdb, 0x2c, 0x29, 0x78, 0xd3, 0x51, 0x2b,
+	0x05, 0x89, 0xee, 0x92, 0x14, 0x2e, 0x15, 0x3f, 0x84, 0x65, 0x2c, 0x19, 0x15, 0x61, 0x0d, 0x07,
+	0x24, 0x2e, 0xab, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0x77, 0x66, 0x35, 0x33, 0x76, 0xf0, 0x8d, 0x3b,
+	0x42, 0xe2, 0xc0, 0x81, 0x23, 0x1c, 0xf8, 0x5f, 0x7a, 0xec, 0x11, 0x71, 0xb0, 0x50, 0x72, 0xe2,
+	0xea, 0xbf, 0x00, 0xcd, 0x8f, 0xb5, 0x9b, 0x75, 0x2c, 0x99, 0xde, 0x76, 0xde, 0xfb, 0xde, 0xf7,
+	0x7d, 0x7a, 0x33, 0xef, 0x2d, 0x08, 0xb9, 0x2c, 0xb8, 0xa4, 0x32, 0x2e, 0x28, 0x53, 0xf1, 0xf4,
+	0x74, 0x40, 0x14, 0x3e, 0x35, 0x87, 0xa8, 0x14, 0x5c, 0x71, 0xff, 0x8e, 0x03, 0x44, 0x26, 0xe6,
+	0x00, 0x47, 0x77, 0x72, 0x9e, 0x73, 0x03, 0x88, 0xf5, 0x97, 0xc5, 0x1e, 0x85, 0x39, 0xe7, 0xf9,
+	0x98, 0xc4, 0xe6, 0x34, 0x98, 0x0c, 0x63, 0x45, 0x0b, 0x22, 0x15, 0x2e, 0x4a, 0x07, 0x78, 0xa7,
+	0x0e, 0xc0, 0x6c, 0xe6, 0x52, 0xad, 0x7a, 0x2a, 0x9b, 0x08, 0xac, 0x28, 0x67, 0x36, 0x0f, 0x25,
+	0x68, 0x7e, 0x4d, 0x99, 0x22, 0xc2, 0xa7, 0xe0, 0x36, 0x29, 0x79, 0x3a, 0x4a, 0x4a, 0xc1, 0xa7,
+	0x54, 0x52, 0xce, 0x64, 0xe0, 0x1d, 0x7b, 0x27, 0x37, 0xda, 0x9f, 0xbd, 0x98, 0x87, 0x8d, 0xbf,
+	0xe7, 0xe1, 0xfd, 0xd4, 0x98, 0x96, 0xd9, 0xf3, 0x88, 0xf2, 0xb8, 0xc0, 0x6a, 0x14, 0x3d, 0x23,
+	0x39, 0x4e, 0x67, 0x1d, 0x92, 0x2e, 0xe6, 0xe1, 0xe1, 0x0c, 0x17, 0xe3, 0xa7, 0xb0, 0x4e, 0x02,
+	0xd1, 0x81, 0x09, 0xf5, 0x57, 0x91, 0x9f, 0x3d, 0x70, 0xf0, 0x1d, 0xa1, 0xf9, 0x48, 0x91, 0xec,
+	0x8b, 0x2c, 0x13, 0x44, 0x4a, 0xff, 0x03, 0xb0, 0x87, 0xed, 0xa7, 0x53, 0xf5, 0x17, 0xf3, 0x70,
+	0xdf, 0x52, 0xba, 0x04, 0x44, 0x15, 0xc4, 0x7f, 0x06, 0x9a, 0xe7, 0x86, 0x20, 0x78, 0xc3, 0x80,
+	0x3f, 0xda, 0xce, 0xe2, 0x2d, 0xcb, 0x67, 0x4b, 0x21, 0x72, 0x1c, 0xf0, 0x57, 0x0f, 0xbc, 0xdb,
+	0x21, 0x53, 0x32, 0xe6, 0x25, 0x11, 0x88, 0x9c, 0x63, 0x91, 0xc9, 0x0e, 0x95, 0x4a, 0xd0, 0xc1,
+	0x44, 0xf7, 0xea, 0x7f, 0x9a, 0xeb, 0x82, 0x26, 0x2e, 0xf8, 0x84, 0x55, 0xe6, 0x22, 0x67, 0xee,
+	0xee, 0xba, 0xb9, 0x1e, 0x53, 0x2b, 0x5b, 0xb6, 0x08, 0x22, 0x57, 0x0d, 0xff, 0xdc, 0x01, 0x87,
+	0xaf, 0xda, 0xe8, 0x0b, 0x5e, 0x72, 0xa1, 0xbf, 0xa4, 0xff, 0x0d, 0xd8, 0x93, 0x0a, 0x3f, 0xa7,
+	0x2c, 0x77, 0x8e, 0x3e, 0xde, 0xae, 0x03, 0xce, 0xb4, 0xab, 0x85, 0xa8, 0x62, 0xf1, 0x87, 0xe0,
+	0xa0, 0xe4, 0x7c, 0x9c, 0x50, 0x96, 0x12, 0xa6, 0xe8, 0x94, 0x48, 0xe7, 0xfe, 0xd3, 0xed, 0x88,
+	0xef, 0x59, 0xe2, 0x1a, 0x07, 0x44, 0xfb, 0x3a, 0xd2, 0x5b, 0x06, 0xfc, 0x31, 0x78, 0x3b, 0xab,
+	0x5a, 0x9d, 0x08, 0xdb, 0xeb, 0x60, 0xc7, 0x28, 0x7d, 0xbe, 0x9d, 0x52, 0x60, 0x95, 0xd6, 0x58,
+	0x20, 0xba, 0x9d, 0xd5, 0x2e, 0xd1, 0x4f, 0xc1, 0x7e, 0xca, 0x8b, 0x62, 0xc2, 0xa8, 0x9a, 0x25,
+	0xda, 0x49, 0xb0, 0x6b, 0xa4, 0x3e, 0xd9, 0x4e, 0xea, 0xae, 0x95, 0xba, 0x4a, 0x01, 0xd1, 0xad,
+	0x65, 0xa0, 0xaf, 0xcf, 0xff, 0x36, 0x41, 0xb3, 0x8f, 0x05, 0x2e, 0xa4, 0xff, 0x1e, 0x00, 0x7a,
+	0xa0, 0x93, 0x8c, 0x30, 0x5e, 0xd8, 0x9b, 0x41, 0x37, 0x74, 0xa4, 0xa3, 0x03, 0xfe, 0x8f, 0x1e,
+	0x08, 0x72, 0xc2, 0x88, 0xa4, 0x32, 0x59, 0x1b, 0x36, 0xdb, 0xee, 0xee, 0x76, 0xce, 0x42, 0xeb,
+	0x6c, 0x13, 0x19, 0x44, 0xf7, 0x5c, 0xea, 0xcb, 0xab, 0xb3, 0xe7, 0x77, 0xab, 0x31, 0xa7, 0x99,
+	0xbe, 0x92, 0x21, 0x25, 0xc2, 0xb5, 0xff, 0x7e, 0x7d, 0x86, 0x57, 0x88, 0x6a, 0x86, 0x7b, 0xcb,
+	0x88, 0x3f, 0x00, 0x47, 0x82, 0x64, 0x93, 0x54, 0x3f, 0xc7, 0xa4, 0x24, 0x82, 0xf2, 0x2c, 0xa1,
+	0xcc, 0x1a, 0x91, 0xa6, 0xcb, 0x3b, 0xed, 0x47, 0x8b, 0x79, 0xf8, 0xc0, 0x32, 0x6e, 0xc6, 0x42,
+	0x74, 0xb8, 0x4c, 0xf6, 0x4d, 0xae, 0xc7, 0x8c, 0x69, 0xa9, 0x57, 0xd2, 0xaa, 0x6e, 0x88, 0x53,
+	0xc5, 0x45, 0xf0, 0xe6, 0x6b, 0xac, 0xa4, 0x3a, 0x09, 0x44, 0x07, 0xcb, 0x50, 0xd7, 0x44, 0x7c,
+	0x06, 0x82, 0xec, 0x95, 0x51, 0x4b, 0xca, 0xd5, 0xac, 0x05, 0xcd, 0x63, 0xef, 0xe4, 0xe6, 0xd9,
+	0xe3, 0xe8, 0xba, 0x95, 0x1d, 0x6d, 0x18, 0xd0, 0xf6, 0xae, 0x76, 0x88, 0x0e, 0xb3, 0x0d, 0xf3,
+	0xfb, 0x87, 0x07, 0x1e, 0x9e, 0xbb, 0x15, 0x98, 0xac, 0x3d, 0xe5, 0x44, 0x90, 0x94, 0xd0, 0x29,
+	0x11, 0x32, 0xd8, 0x3b, 0xde, 0x39, 0xb9, 0x79, 0xf6, 0xe8, 0x7a, 0xf1, 0xda, 0x12, 0x6d, 0xbf,
+	0xaf, 0x45, 0x57, 0x4d, 0xdf, 0xcc, 0x0b, 0xd1, 0x83, 0x4a, 0xbd, 0xbe, 0xf8, 0x50, 0x25, 0xed,
+	0xff, 0xe4, 0x81, 0x13, 0x2d, 0x47, 0x59, 0xbe, 0x24, 0xb8, 0xd2, 0x24, 0xa9, 0xb0, 0x50, 0xf6,
+	0x1a, 0x83, 0xb7, 0xcc, 0x8d, 0x3f, 0x59, 0xcc, 0xc3, 0xd8, 0x8a, 0x6f, 0x5b, 0x09, 0xd1, 0x43,
+	0x07, 0xbd, 0x66, 0xf3, 0x7e, 0xab, 0x71, 0xe6, 0x35, 0x3c, 0xdd, 0xfd, 0xed, 0xf7, 0xb0, 0xd1,
+	0xfe, 0xea, 0xc5, 0x45, 0xcb, 0x7b, 0x79, 0xd1, 0xf2, 0xfe, 0xb9, 0x68, 0x79, 0xbf, 0x5c, 0xb6,
+	0x1a, 0x2f, 0x2f, 0x5b, 0x8d, 0xbf, 0x2e, 0x5b, 0x8d, 0xef, 0x3f, 0xcc, 0xa9, 0x1a, 0x4d, 0x06,
+	0x51, 0xca, 0x8b, 0xd8, 0x35, 0xeb, 0xf1, 0x18, 0x0f, 0x64, 0x75, 0x88, 0xa7, 0x67, 0xa7, 0xf1,
+	0x0f, 0xf6, 0x87, 0xac, 0x66, 0x25, 0x91, 0x83, 0xa6, 0xf9, 0x05, 0x3e, 0xf9, 0x6f, 0x00, 0x41,
+	0x2a, 0x91, 0xbe, 0xad, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -402,6 +452,46 @@ func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeveloperRewardsDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeveloperRewardsDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeveloperRewardsDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -593,6 +683,21 @@ func (m *WeightedAddress) Size() (n int) {
 	return n
 }
 
+func (m *DeveloperRewardsDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func (m *DistributionProportions) Size() (n int) {
 	if m == nil {
 		return 0
@@ -851,6 +956,122 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeveloperRewardsDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeveloperRewardsDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeveloperRewardsDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
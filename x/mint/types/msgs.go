@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	TypeMsgUpdateDistributionProportions   = "update_distribution_proportions"
+	TypeMsgMigrateDeveloperRewardsReceiver = "migrate_developer_rewards_receiver"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateDistributionProportions{}
+	_ sdk.Msg = &MsgMigrateDeveloperRewardsReceiver{}
+)
+
+// NewMsgUpdateDistributionProportions creates a message to update the distribution proportions.
+func NewMsgUpdateDistributionProportions(authority string, proportions DistributionProportions) *MsgUpdateDistributionProportions {
+	return &MsgUpdateDistributionProportions{
+		Authority:               authority,
+		DistributionProportions: proportions,
+	}
+}
+
+func (m MsgUpdateDistributionProportions) Route() string { return RouterKey }
+func (m MsgUpdateDistributionProportions) Type() string  { return TypeMsgUpdateDistributionProportions }
+func (m MsgUpdateDistributionProportions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateDistributionProportions(m.DistributionProportions)
+}
+
+func (m MsgUpdateDistributionProportions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateDistributionProportions) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgMigrateDeveloperRewardsReceiver creates a message to migrate the given weight
+// of the developer rewards of fromAddress to toAddress.
+func NewMsgMigrateDeveloperRewardsReceiver(authority, fromAddress, toAddress string, weight osmomath.Dec) *MsgMigrateDeveloperRewardsReceiver {
+	return &MsgMigrateDeveloperRewardsReceiver{
+		Authority:   authority,
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Weight:      weight,
+	}
+}
+
+func (m MsgMigrateDeveloperRewardsReceiver) Route() string { return RouterKey }
+func (m MsgMigrateDeveloperRewardsReceiver) Type() string {
+	return TypeMsgMigrateDeveloperRewardsReceiver
+}
+
+func (m MsgMigrateDeveloperRewardsReceiver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	// The empty address is the community pool, which can be migrated from and to.
+	if m.FromAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid from address")
+		}
+	}
+	if m.ToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.ToAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid to address")
+		}
+	}
+	if m.FromAddress == m.ToAddress {
+		return fmt.Errorf("from and to addresses must differ, got %q", m.FromAddress)
+	}
+
+	if m.Weight.IsNil() || !m.Weight.IsPositive() || m.Weight.GT(osmomath.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidMigrationWeight, "weight must be in (0, 1], got %s", m.Weight)
+	}
+
+	return nil
+}
+
+func (m MsgMigrateDeveloperRewardsReceiver) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMigrateDeveloperRewardsReceiver) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
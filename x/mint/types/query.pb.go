@@ -191,42 +191,147 @@ func (m *QueryEpochProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEpochProvisionsResponse proto.InternalMessageInfo
 
+// QueryDeveloperRewardsDistributionsRequest is the request type for the
+// Query/DeveloperRewardsDistributions RPC method.
+type QueryDeveloperRewardsDistributionsRequest struct {
+}
+
+func (m *QueryDeveloperRewardsDistributionsRequest) Reset() {
+	*m = QueryDeveloperRewardsDistributionsRequest{}
+}
+func (m *QueryDeveloperRewardsDistributionsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDeveloperRewardsDistributionsRequest) ProtoMessage() {}
+func (*QueryDeveloperRewardsDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd2f42111e753fbb, []int{4}
+}
+func (m *QueryDeveloperRewardsDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperRewardsDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperRewardsDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperRewardsDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperRewardsDistributionsRequest.Merge(m, src)
+}
+func (m *QueryDeveloperRewardsDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperRewardsDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperRewardsDistributionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperRewardsDistributionsRequest proto.InternalMessageInfo
+
+// QueryDeveloperRewardsDistributionsResponse is the response type for the
+// Query/DeveloperRewardsDistributions RPC method.
+type QueryDeveloperRewardsDistributionsResponse struct {
+	// total_distributed is the total amount of developer rewards distributed.
+	TotalDistributed cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_distributed,json=totalDistributed,proto3,customtype=cosmossdk.io/math.Int" json:"total_distributed"`
+	// distributions are the developer rewards distributed to each receiver.
+	Distributions []DeveloperRewardsDistribution `protobuf:"bytes,2,rep,name=distributions,proto3" json:"distributions"`
+}
+
+func (m *QueryDeveloperRewardsDistributionsResponse) Reset() {
+	*m = QueryDeveloperRewardsDistributionsResponse{}
+}
+func (m *QueryDeveloperRewardsDistributionsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDeveloperRewardsDistributionsResponse) ProtoMessage() {}
+func (*QueryDeveloperRewardsDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd2f42111e753fbb, []int{5}
+}
+func (m *QueryDeveloperRewardsDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperRewardsDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperRewardsDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperRewardsDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperRewardsDistributionsResponse.Merge(m, src)
+}
+func (m *QueryDeveloperRewardsDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperRewardsDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperRewardsDistributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperRewardsDistributionsResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperRewardsDistributionsResponse) GetDistributions() []DeveloperRewardsDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.mint.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryEpochProvisionsRequest)(nil), "osmosis.mint.v1beta1.QueryEpochProvisionsRequest")
 	proto.RegisterType((*QueryEpochProvisionsResponse)(nil), "osmosis.mint.v1beta1.QueryEpochProvisionsResponse")
+	proto.RegisterType((*QueryDeveloperRewardsDistributionsRequest)(nil), "osmosis.mint.v1beta1.QueryDeveloperRewardsDistributionsRequest")
+	proto.RegisterType((*QueryDeveloperRewardsDistributionsResponse)(nil), "osmosis.mint.v1beta1.QueryDeveloperRewardsDistributionsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/mint/v1beta1/query.proto", fileDescriptor_cd2f42111e753fbb) }
 
 var fileDescriptor_cd2f42111e753fbb = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x4a, 0xc3, 0x40,
-	0x1c, 0xc6, 0x93, 0xa2, 0x1d, 0x4e, 0xa1, 0x72, 0x76, 0x90, 0x34, 0xa6, 0x25, 0x8a, 0xd4, 0xc1,
-	0x3b, 0x13, 0x37, 0xc7, 0xa2, 0x8b, 0x88, 0xb4, 0x1d, 0x5d, 0xe4, 0x12, 0x8f, 0x34, 0xd8, 0xe4,
-	0xd2, 0xdc, 0xb5, 0xd8, 0x55, 0x5f, 0x40, 0xf0, 0x25, 0x7c, 0x04, 0x1f, 0xa1, 0x63, 0xc1, 0x45,
-	0x1c, 0x8a, 0xb4, 0x3e, 0x88, 0xe4, 0x92, 0x16, 0x5a, 0x83, 0xe8, 0x16, 0xee, 0xff, 0xfd, 0xbf,
-	0xef, 0x77, 0x5f, 0x0e, 0xd4, 0x18, 0x0f, 0x18, 0xf7, 0x39, 0x0e, 0xfc, 0x50, 0xe0, 0x81, 0xe5,
-	0x50, 0x41, 0x2c, 0xdc, 0xeb, 0xd3, 0x78, 0x88, 0xa2, 0x98, 0x09, 0x06, 0xcb, 0x99, 0x02, 0x25,
-	0x0a, 0x94, 0x29, 0xb4, 0xb2, 0xc7, 0x3c, 0x26, 0x05, 0x38, 0xf9, 0x4a, 0xb5, 0x9a, 0xee, 0x31,
-	0xe6, 0x75, 0x29, 0x26, 0x91, 0x8f, 0x49, 0x18, 0x32, 0x41, 0x84, 0xcf, 0x42, 0x9e, 0x4d, 0xab,
-	0xb9, 0x59, 0xd2, 0x56, 0x0a, 0xcc, 0x32, 0x80, 0xad, 0x24, 0xb9, 0x49, 0x62, 0x12, 0xf0, 0x36,
-	0xed, 0xf5, 0x29, 0x17, 0x66, 0x0b, 0x6c, 0x2f, 0x9d, 0xf2, 0x88, 0x85, 0x9c, 0xc2, 0x53, 0x50,
-	0x8c, 0xe4, 0xc9, 0x8e, 0x5a, 0x53, 0xeb, 0x1b, 0xb6, 0x8e, 0xf2, 0x40, 0x51, 0xba, 0xd5, 0x58,
-	0x1b, 0x4d, 0xaa, 0x4a, 0x3b, 0xdb, 0x30, 0x77, 0x41, 0x45, 0x5a, 0x9e, 0x47, 0xcc, 0xed, 0x34,
-	0x63, 0x36, 0xf0, 0x79, 0xc2, 0x39, 0x4f, 0x0c, 0x81, 0x9e, 0x3f, 0xce, 0xa2, 0xaf, 0xc0, 0x16,
-	0x4d, 0x46, 0x37, 0xd1, 0x62, 0x26, 0x21, 0x36, 0x1b, 0x7b, 0x49, 0xcc, 0xc7, 0xa4, 0x5a, 0x71,
-	0x25, 0x0c, 0xbf, 0xbd, 0x43, 0x3e, 0xc3, 0x01, 0x11, 0x1d, 0x74, 0x49, 0x3d, 0xe2, 0x0e, 0xcf,
-	0xa8, 0xdb, 0x2e, 0xd1, 0x65, 0x5f, 0xfb, 0xb5, 0x00, 0xd6, 0x65, 0x20, 0x7c, 0x54, 0x41, 0x31,
-	0x25, 0x86, 0xf5, 0xfc, 0xfb, 0xfc, 0x2c, 0x48, 0x3b, 0xfc, 0x83, 0x32, 0x25, 0x37, 0xf7, 0x1f,
-	0xde, 0xbe, 0x9e, 0x0b, 0x06, 0xd4, 0x71, 0xee, 0xbf, 0x48, 0xeb, 0x81, 0x2f, 0x2a, 0x28, 0xad,
-	0xdc, 0x1d, 0x5a, 0xbf, 0x84, 0xe4, 0xd7, 0xa8, 0xd9, 0xff, 0x59, 0xc9, 0x00, 0x91, 0x04, 0xac,
-	0xc3, 0x83, 0x7c, 0xc0, 0xd5, 0xda, 0x1b, 0x17, 0xa3, 0xa9, 0xa1, 0x8e, 0xa7, 0x86, 0xfa, 0x39,
-	0x35, 0xd4, 0xa7, 0x99, 0xa1, 0x8c, 0x67, 0x86, 0xf2, 0x3e, 0x33, 0x94, 0xeb, 0x63, 0xcf, 0x17,
-	0x9d, 0xbe, 0x83, 0x5c, 0x16, 0xcc, 0xbd, 0x8e, 0xba, 0xc4, 0xe1, 0x0b, 0xe3, 0x81, 0x6d, 0xe1,
-	0xfb, 0xd4, 0x5e, 0x0c, 0x23, 0xca, 0x9d, 0xa2, 0x7c, 0x85, 0x27, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x0a, 0x30, 0xd6, 0x1a, 0x14, 0x03, 0x00, 0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6a, 0x13, 0x41,
+	0x1c, 0xc6, 0xb3, 0xb5, 0x16, 0x9c, 0x2a, 0xad, 0x63, 0x84, 0xb2, 0x4d, 0x36, 0x61, 0x15, 0x49,
+	0x15, 0x67, 0xcc, 0x8a, 0x08, 0x82, 0x28, 0x21, 0x1e, 0x2c, 0x22, 0xed, 0x1e, 0x3d, 0x18, 0x66,
+	0x77, 0x87, 0xcd, 0x60, 0x76, 0x67, 0xbb, 0x33, 0x89, 0xe6, 0xaa, 0x2f, 0x20, 0x78, 0xf5, 0x01,
+	0x7c, 0x94, 0x1e, 0x0b, 0x5e, 0x8a, 0x87, 0xa2, 0x89, 0x0f, 0x22, 0x3b, 0x3b, 0xa9, 0x4d, 0x3b,
+	0x84, 0xe8, 0x2d, 0xec, 0xff, 0xfb, 0x7f, 0xdf, 0xef, 0xbf, 0xfb, 0x11, 0xd0, 0xe4, 0x22, 0xe1,
+	0x82, 0x09, 0x9c, 0xb0, 0x54, 0xe2, 0x51, 0x3b, 0xa0, 0x92, 0xb4, 0xf1, 0xc1, 0x90, 0xe6, 0x63,
+	0x94, 0xe5, 0x5c, 0x72, 0x58, 0xd5, 0x0a, 0x54, 0x28, 0x90, 0x56, 0xd8, 0xd5, 0x98, 0xc7, 0x5c,
+	0x09, 0x70, 0xf1, 0xab, 0xd4, 0xda, 0xb5, 0x98, 0xf3, 0x78, 0x40, 0x31, 0xc9, 0x18, 0x26, 0x69,
+	0xca, 0x25, 0x91, 0x8c, 0xa7, 0x42, 0x4f, 0x1b, 0xc6, 0x2c, 0x65, 0xab, 0x04, 0x6e, 0x15, 0xc0,
+	0xfd, 0x22, 0x79, 0x8f, 0xe4, 0x24, 0x11, 0x3e, 0x3d, 0x18, 0x52, 0x21, 0xdd, 0x7d, 0x70, 0x63,
+	0xee, 0xa9, 0xc8, 0x78, 0x2a, 0x28, 0x7c, 0x02, 0xd6, 0x32, 0xf5, 0x64, 0xcb, 0x6a, 0x5a, 0xad,
+	0x75, 0xaf, 0x86, 0x4c, 0xa0, 0xa8, 0xdc, 0xea, 0xac, 0x1e, 0x9e, 0x34, 0x2a, 0xbe, 0xde, 0x70,
+	0xeb, 0x60, 0x5b, 0x59, 0xbe, 0xc8, 0x78, 0xd8, 0xdf, 0xcb, 0xf9, 0x88, 0x89, 0x82, 0x73, 0x96,
+	0x98, 0x82, 0x9a, 0x79, 0xac, 0xa3, 0x5f, 0x83, 0x4d, 0x5a, 0x8c, 0x7a, 0xd9, 0xe9, 0x4c, 0x41,
+	0x5c, 0xed, 0xdc, 0x2a, 0x62, 0x7e, 0x9c, 0x34, 0xb6, 0x43, 0x05, 0x23, 0xa2, 0x77, 0x88, 0x71,
+	0x9c, 0x10, 0xd9, 0x47, 0xaf, 0x68, 0x4c, 0xc2, 0x71, 0x97, 0x86, 0xfe, 0x06, 0x9d, 0xf7, 0x75,
+	0xef, 0x81, 0x1d, 0x95, 0xd7, 0xa5, 0x23, 0x3a, 0xe0, 0x19, 0xcd, 0x7d, 0xfa, 0x9e, 0xe4, 0x91,
+	0xe8, 0x32, 0x21, 0x73, 0x16, 0x0c, 0xe5, 0x59, 0xb8, 0x63, 0x0b, 0xdc, 0x5d, 0x46, 0xad, 0x59,
+	0x77, 0xc1, 0x75, 0xc9, 0x25, 0x19, 0xf4, 0xa2, 0xd9, 0x98, 0x46, 0x0a, 0xf6, 0x4a, 0xa7, 0xae,
+	0x61, 0x6f, 0x5e, 0x84, 0x7d, 0x99, 0x4a, 0x7f, 0x53, 0xed, 0x75, 0xff, 0xae, 0xc1, 0xb7, 0xe0,
+	0x5a, 0x74, 0x36, 0x64, 0x6b, 0xa5, 0x79, 0xa9, 0xb5, 0xee, 0x79, 0xe6, 0x37, 0xbf, 0x88, 0x4f,
+	0x7f, 0x8f, 0x79, 0x3b, 0xef, 0xeb, 0x2a, 0xb8, 0xac, 0x4e, 0x83, 0x9f, 0x2c, 0xb0, 0x56, 0x7e,
+	0x39, 0xd8, 0x32, 0xbb, 0x5f, 0x2c, 0x8a, 0xbd, 0xb3, 0x84, 0xb2, 0x7c, 0x2b, 0xee, 0xed, 0x8f,
+	0xdf, 0x7f, 0x7f, 0x59, 0x71, 0x60, 0x0d, 0x1b, 0x3b, 0x59, 0xd6, 0x04, 0x7e, 0xb3, 0xc0, 0xc6,
+	0xb9, 0x0e, 0xc0, 0xf6, 0x82, 0x10, 0x73, 0x9d, 0x6c, 0xef, 0x5f, 0x56, 0x34, 0x20, 0x52, 0x80,
+	0x2d, 0x78, 0xc7, 0x0c, 0x78, 0xbe, 0x7e, 0xf0, 0x97, 0x05, 0xea, 0x0b, 0x0b, 0x01, 0x9f, 0x2d,
+	0xa0, 0x58, 0xa6, 0x78, 0xf6, 0xf3, 0xff, 0x37, 0xd0, 0x47, 0x3d, 0x55, 0x47, 0x3d, 0x86, 0x8f,
+	0xcc, 0x47, 0x45, 0x33, 0x93, 0x5e, 0x5e, 0xba, 0xf4, 0xe6, 0xea, 0xd1, 0xd9, 0x3d, 0x9c, 0x38,
+	0xd6, 0xd1, 0xc4, 0xb1, 0x7e, 0x4e, 0x1c, 0xeb, 0xf3, 0xd4, 0xa9, 0x1c, 0x4d, 0x9d, 0xca, 0xf1,
+	0xd4, 0xa9, 0xbc, 0x79, 0x10, 0x33, 0xd9, 0x1f, 0x06, 0x28, 0xe4, 0xc9, 0xcc, 0xfa, 0xfe, 0x80,
+	0x04, 0xe2, 0x34, 0x67, 0xe4, 0xb5, 0xf1, 0x87, 0x32, 0x4d, 0x8e, 0x33, 0x2a, 0x82, 0x35, 0xf5,
+	0x8f, 0xf3, 0xf0, 0xcf, 0x00, 0xee, 0xde, 0xa3, 0x2d, 0x00, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -245,6 +350,9 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EpochProvisions returns the current minting epoch provisions value.
 	EpochProvisions(ctx context.Context, in *QueryEpochProvisionsRequest, opts ...grpc.CallOption) (*QueryEpochProvisionsResponse, error)
+	// DeveloperRewardsDistributions returns the developer rewards distributed
+	// from the developer vesting module account, in total and to each receiver.
+	DeveloperRewardsDistributions(ctx context.Context, in *QueryDeveloperRewardsDistributionsRequest, opts ...grpc.CallOption) (*QueryDeveloperRewardsDistributionsResponse, error)
 }
 
 type queryClient struct {
@@ -273,12 +381,24 @@ func (c *queryClient) EpochProvisions(ctx context.Context, in *QueryEpochProvisi
 	return out, nil
 }
 
+func (c *queryClient) DeveloperRewardsDistributions(ctx context.Context, in *QueryDeveloperRewardsDistributionsRequest, opts ...grpc.CallOption) (*QueryDeveloperRewardsDistributionsResponse, error) {
+	out := new(QueryDeveloperRewardsDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.mint.v1beta1.Query/DeveloperRewardsDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EpochProvisions returns the current minting epoch provisions value.
 	EpochProvisions(context.Context, *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error)
+	// DeveloperRewardsDistributions returns the developer rewards distributed
+	// from the developer vesting module account, in total and to each receiver.
+	DeveloperRewardsDistributions(context.Context, *QueryDeveloperRewardsDistributionsRequest) (*QueryDeveloperRewardsDistributionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -291,6 +411,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) EpochProvisions(ctx context.Context, req *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochProvisions not implemented")
 }
+func (*UnimplementedQueryServer) DeveloperRewardsDistributions(ctx context.Context, req *QueryDeveloperRewardsDistributionsRequest) (*QueryDeveloperRewardsDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperRewardsDistributions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -332,6 +455,24 @@ func _Query_EpochProvisions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeveloperRewardsDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperRewardsDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperRewardsDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.mint.v1beta1.Query/DeveloperRewardsDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperRewardsDistributions(ctx, req.(*QueryDeveloperRewardsDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -344,6 +485,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochProvisions",
 			Handler:    _Query_EpochProvisions_Handler,
 		},
+		{
+			MethodName: "DeveloperRewardsDistributions",
+			Handler:    _Query_DeveloperRewardsDistributions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/mint/v1beta1/query.proto",
@@ -461,6 +606,76 @@ func (m *QueryEpochProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDeveloperRewardsDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperRewardsDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperRewardsDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperRewardsDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperRewardsDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperRewardsDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TotalDistributed.Size()
+		i -= size
+		if _, err := m.TotalDistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -512,6 +727,32 @@ func (m *QueryEpochProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDeveloperRewardsDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeveloperRewardsDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalDistributed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -784,6 +1025,174 @@ func (m *QueryEpochProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDeveloperRewardsDistributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsDistributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperRewardsDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperRewardsDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, DeveloperRewardsDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,1108 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/mint/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateDistributionProportions defines the
+// Msg/UpdateDistributionProportions request type.
+type MsgUpdateDistributionProportions struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// distribution_proportions are the new distribution proportions of the
+	// minted mint_denom.
+	DistributionProportions DistributionProportions `protobuf:"bytes,2,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions" yaml:"distribution_proportions"`
+}
+
+func (m *MsgUpdateDistributionProportions) Reset()         { *m = MsgUpdateDistributionProportions{} }
+func (m *MsgUpdateDistributionProportions) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDistributionProportions) ProtoMessage()    {}
+func (*MsgUpdateDistributionProportions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{0}
+}
+func (m *MsgUpdateDistributionProportions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDistributionProportions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDistributionProportions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDistributionProportions.Merge(m, src)
+}
+func (m *MsgUpdateDistributionProportions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDistributionProportions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDistributionProportions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDistributionProportions proto.InternalMessageInfo
+
+func (m *MsgUpdateDistributionProportions) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateDistributionProportions) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+// MsgUpdateDistributionProportionsResponse defines the
+// Msg/UpdateDistributionProportions response type.
+type MsgUpdateDistributionProportionsResponse struct {
+}
+
+func (m *MsgUpdateDistributionProportionsResponse) Reset() {
+	*m = MsgUpdateDistributionProportionsResponse{}
+}
+func (m *MsgUpdateDistributionProportionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDistributionProportionsResponse) ProtoMessage()    {}
+func (*MsgUpdateDistributionProportionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{1}
+}
+func (m *MsgUpdateDistributionProportionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDistributionProportionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDistributionProportionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDistributionProportionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDistributionProportionsResponse.Merge(m, src)
+}
+func (m *MsgUpdateDistributionProportionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDistributionProportionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDistributionProportionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDistributionProportionsResponse proto.InternalMessageInfo
+
+// MsgMigrateDeveloperRewardsReceiver defines the
+// Msg/MigrateDeveloperRewardsReceiver request type.
+type MsgMigrateDeveloperRewardsReceiver struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// from_address is the developer rewards receiver the weight is migrated
+	// from. It is removed from the receivers if all its weight is migrated.
+	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	// to_address is the address the weight is migrated to. It is added to the
+	// receivers if it is not one of them yet.
+	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
+	// weight is the part of the developer rewards weight of from_address that is
+	// migrated. It must be positive and at most the weight of from_address.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight" yaml:"weight"`
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiver) Reset()         { *m = MsgMigrateDeveloperRewardsReceiver{} }
+func (m *MsgMigrateDeveloperRewardsReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateDeveloperRewardsReceiver) ProtoMessage()    {}
+func (*MsgMigrateDeveloperRewardsReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{2}
+}
+func (m *MsgMigrateDeveloperRewardsReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateDeveloperRewardsReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateDeveloperRewardsReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateDeveloperRewardsReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateDeveloperRewardsReceiver.Merge(m, src)
+}
+func (m *MsgMigrateDeveloperRewardsReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateDeveloperRewardsReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateDeveloperRewardsReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateDeveloperRewardsReceiver proto.InternalMessageInfo
+
+func (m *MsgMigrateDeveloperRewardsReceiver) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiver) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiver) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// MsgMigrateDeveloperRewardsReceiverResponse defines the
+// Msg/MigrateDeveloperRewardsReceiver response type.
+type MsgMigrateDeveloperRewardsReceiverResponse struct {
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) Reset() {
+	*m = MsgMigrateDeveloperRewardsReceiverResponse{}
+}
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgMigrateDeveloperRewardsReceiverResponse) ProtoMessage() {}
+func (*MsgMigrateDeveloperRewardsReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b4540c28787105, []int{3}
+}
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateDeveloperRewardsReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateDeveloperRewardsReceiverResponse.Merge(m, src)
+}
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateDeveloperRewardsReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateDeveloperRewardsReceiverResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateDistributionProportions)(nil), "osmosis.mint.v1beta1.MsgUpdateDistributionProportions")
+	proto.RegisterType((*MsgUpdateDistributionProportionsResponse)(nil), "osmosis.mint.v1beta1.MsgUpdateDistributionProportionsResponse")
+	proto.RegisterType((*MsgMigrateDeveloperRewardsReceiver)(nil), "osmosis.mint.v1beta1.MsgMigrateDeveloperRewardsReceiver")
+	proto.RegisterType((*MsgMigrateDeveloperRewardsReceiverResponse)(nil), "osmosis.mint.v1beta1.MsgMigrateDeveloperRewardsReceiverResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/mint/v1beta1/tx.proto", fileDescriptor_c9b4540c28787105) }
+
+var fileDescriptor_c9b4540c28787105 = []byte{
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0xa6, 0x52, 0xc8, 0x54, 0xc1, 0xae, 0x91, 0xc6, 0x48, 0x77, 0xe3, 0x80, 0x1a, 0x42,
+	0x77, 0xd7, 0xc4, 0x22, 0x92, 0x83, 0x68, 0xe8, 0xa9, 0x34, 0x20, 0x2b, 0x5e, 0xbc, 0x84, 0x49,
+	0x76, 0x9c, 0x0c, 0x66, 0x33, 0xcb, 0xcc, 0x24, 0x6d, 0xfe, 0x82, 0x27, 0x3d, 0x79, 0xf2, 0x2f,
+	0x88, 0x07, 0xef, 0x5e, 0x7b, 0x2c, 0x9e, 0x8a, 0x87, 0x45, 0x92, 0x83, 0xf7, 0xfc, 0x02, 0xd9,
+	0x99, 0x4d, 0x93, 0x42, 0x62, 0xa0, 0xf4, 0x12, 0x66, 0xf2, 0xbe, 0xf7, 0xde, 0xc7, 0x7b, 0xc3,
+	0x82, 0x5d, 0x26, 0x42, 0x26, 0xa8, 0xf0, 0x42, 0xda, 0x97, 0xde, 0xb0, 0xda, 0xc6, 0x12, 0x55,
+	0x3d, 0x79, 0xe2, 0x46, 0x9c, 0x49, 0x66, 0xe6, 0x53, 0xd8, 0x4d, 0x60, 0x37, 0x85, 0x8b, 0x79,
+	0xc2, 0x08, 0x53, 0x03, 0x5e, 0x72, 0xd2, 0xb3, 0xc5, 0x6d, 0x14, 0xd2, 0x3e, 0xf3, 0xd4, 0x6f,
+	0xfa, 0xd7, 0xbd, 0x8e, 0xe2, 0xb7, 0xf4, 0xac, 0xbe, 0xa4, 0x90, 0xbd, 0xd4, 0x58, 0xd9, 0xa8,
+	0x01, 0xf8, 0x2d, 0x0b, 0x4a, 0x4d, 0x41, 0xde, 0x46, 0x01, 0x92, 0xf8, 0x80, 0x0a, 0xc9, 0x69,
+	0x7b, 0x20, 0x29, 0xeb, 0xbf, 0xe6, 0x2c, 0x62, 0x3c, 0x39, 0x09, 0xf3, 0x10, 0xe4, 0xd0, 0x40,
+	0x76, 0x19, 0xa7, 0x72, 0x54, 0x30, 0x4a, 0x46, 0x39, 0xd7, 0xd8, 0x9b, 0xc6, 0xf6, 0xed, 0x11,
+	0x0a, 0x7b, 0x75, 0x78, 0x01, 0xc1, 0x5f, 0x3f, 0x9c, 0x7c, 0x6a, 0xff, 0x2a, 0x08, 0x38, 0x16,
+	0xe2, 0x8d, 0xe4, 0xb4, 0x4f, 0xfc, 0x39, 0xdd, 0xfc, 0x6c, 0x80, 0x42, 0xb0, 0xe0, 0xd3, 0x8a,
+	0xe6, 0x46, 0x85, 0x6c, 0xc9, 0x28, 0x6f, 0xd5, 0x1c, 0x77, 0x59, 0x1e, 0xee, 0x8a, 0xed, 0x1a,
+	0x8f, 0x4f, 0x63, 0x3b, 0x33, 0x8d, 0x6d, 0x5b, 0xaf, 0xb3, 0x4a, 0x1c, 0xfa, 0x3b, 0xc1, 0x72,
+	0x85, 0x7a, 0xe5, 0xe3, 0xdf, 0xef, 0x95, 0x87, 0x97, 0xa2, 0x1a, 0xa8, 0x40, 0x1c, 0xc5, 0x70,
+	0x16, 0x55, 0x2a, 0xa0, 0xbc, 0x2e, 0x2f, 0x1f, 0x8b, 0x88, 0xf5, 0x05, 0x86, 0xe7, 0x59, 0x00,
+	0x9b, 0x82, 0x34, 0x29, 0xe1, 0xc9, 0x34, 0x1e, 0xe2, 0x1e, 0x8b, 0x30, 0xf7, 0xf1, 0x31, 0xe2,
+	0x81, 0xf0, 0x71, 0x07, 0xd3, 0x21, 0xe6, 0xd7, 0x1a, 0x6f, 0x1d, 0xdc, 0x7c, 0xcf, 0x59, 0xd8,
+	0x42, 0x7a, 0x40, 0x25, 0x9a, 0x6b, 0xec, 0x4c, 0x63, 0xfb, 0x8e, 0x96, 0x5b, 0x44, 0xa1, 0xbf,
+	0x95, 0x5c, 0x53, 0x31, 0x73, 0x1f, 0x00, 0xc9, 0x2e, 0x98, 0x1b, 0x8a, 0x79, 0x77, 0x1a, 0xdb,
+	0xdb, 0x9a, 0x39, 0xc7, 0xa0, 0x9f, 0x93, 0x6c, 0xc6, 0x3a, 0x02, 0x9b, 0xc7, 0x98, 0x92, 0xae,
+	0x2c, 0xdc, 0x50, 0x8c, 0xfd, 0xa4, 0x8e, 0xdf, 0xb1, 0x7d, 0x5f, 0xaf, 0x2a, 0x82, 0x0f, 0x2e,
+	0x65, 0x5e, 0x88, 0x64, 0xd7, 0x3d, 0xc2, 0x04, 0x75, 0x46, 0x07, 0xb8, 0x33, 0x8d, 0xed, 0x5b,
+	0x5a, 0x54, 0x53, 0xa1, 0x9f, 0x6a, 0xd4, 0x1f, 0x25, 0x55, 0x3c, 0xb8, 0x54, 0x45, 0xa8, 0xe3,
+	0x73, 0x02, 0x3c, 0x74, 0x78, 0x9a, 0x19, 0xdc, 0x03, 0x95, 0xf5, 0xc9, 0xce, 0x8a, 0xa8, 0xfd,
+	0xcc, 0x82, 0x8d, 0xa6, 0x20, 0xe6, 0x17, 0x03, 0xec, 0xfe, 0xff, 0xa9, 0x3f, 0x5b, 0xfe, 0xf6,
+	0xd6, 0x55, 0x5e, 0x7c, 0x71, 0x35, 0xde, 0x6c, 0x43, 0xf3, 0xab, 0x01, 0xec, 0x75, 0xef, 0xe4,
+	0xf9, 0x4a, 0x8f, 0x35, 0xcc, 0xe2, 0xcb, 0xab, 0x32, 0x67, 0xfb, 0x35, 0x0e, 0x4f, 0xc7, 0x96,
+	0x71, 0x36, 0xb6, 0x8c, 0x3f, 0x63, 0xcb, 0xf8, 0x34, 0xb1, 0x32, 0x67, 0x13, 0x2b, 0x73, 0x3e,
+	0xb1, 0x32, 0xef, 0x9e, 0x10, 0x2a, 0xbb, 0x83, 0xb6, 0xdb, 0x61, 0xa1, 0x97, 0xba, 0x38, 0x3d,
+	0xd4, 0x16, 0xb3, 0x8b, 0x37, 0xac, 0x55, 0xbd, 0x13, 0x5d, 0xa5, 0x1c, 0x45, 0x58, 0xb4, 0x37,
+	0xd5, 0xa7, 0xe7, 0xe9, 0xbf, 0x01, 0x00, 0x8e, 0x50, 0x04, 0x04, 0x16, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateDistributionProportions sets the proportions of the minted
+	// mint_denom distributed to each stakeholder.
+	UpdateDistributionProportions(ctx context.Context, in *MsgUpdateDistributionProportions, opts ...grpc.CallOption) (*MsgUpdateDistributionProportionsResponse, error)
+	// MigrateDeveloperRewardsReceiver moves a part of the developer rewards
+	// weight of a receiver to another address, along with the same share of the
+	// developer rewards distributed to the receiver so far.
+	MigrateDeveloperRewardsReceiver(ctx context.Context, in *MsgMigrateDeveloperRewardsReceiver, opts ...grpc.CallOption) (*MsgMigrateDeveloperRewardsReceiverResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateDistributionProportions(ctx context.Context, in *MsgUpdateDistributionProportions, opts ...grpc.CallOption) (*MsgUpdateDistributionProportionsResponse, error) {
+	out := new(MsgUpdateDistributionProportionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.mint.v1beta1.Msg/UpdateDistributionProportions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateDeveloperRewardsReceiver(ctx context.Context, in *MsgMigrateDeveloperRewardsReceiver, opts ...grpc.CallOption) (*MsgMigrateDeveloperRewardsReceiverResponse, error) {
+	out := new(MsgMigrateDeveloperRewardsReceiverResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.mint.v1beta1.Msg/MigrateDeveloperRewardsReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateDistributionProportions sets the proportions of the minted
+	// mint_denom distributed to each stakeholder.
+	UpdateDistributionProportions(context.Context, *MsgUpdateDistributionProportions) (*MsgUpdateDistributionProportionsResponse, error)
+	// MigrateDeveloperRewardsReceiver moves a part of the developer rewards
+	// weight of a receiver to another address, along with the same share of the
+	// developer rewards distributed to the receiver so far.
+	MigrateDeveloperRewardsReceiver(context.Context, *MsgMigrateDeveloperRewardsReceiver) (*MsgMigrateDeveloperRewardsReceiverResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateDistributionProportions(ctx context.Context, req *MsgUpdateDistributionProportions) (*MsgUpdateDistributionProportionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDistributionProportions not implemented")
+}
+func (*UnimplementedMsgServer) MigrateDeveloperRewardsReceiver(ctx context.Context, req *MsgMigrateDeveloperRewardsReceiver) (*MsgMigrateDeveloperRewardsReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateDeveloperRewardsReceiver not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateDistributionProportions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDistributionProportions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDistributionProportions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.mint.v1beta1.Msg/UpdateDistributionProportions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDistributionProportions(ctx, req.(*MsgUpdateDistributionProportions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateDeveloperRewardsReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateDeveloperRewardsReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateDeveloperRewardsReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.mint.v1beta1.Msg/MigrateDeveloperRewardsReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateDeveloperRewardsReceiver(ctx, req.(*MsgMigrateDeveloperRewardsReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.mint.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateDistributionProportions",
+			Handler:    _Msg_UpdateDistributionProportions_Handler,
+		},
+		{
+			MethodName: "MigrateDeveloperRewardsReceiver",
+			Handler:    _Msg_MigrateDeveloperRewardsReceiver_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/mint/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateDistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDistributionProportionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDistributionProportionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDistributionProportionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateDistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDistributionProportionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateDistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDistributionProportionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDistributionProportionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDistributionProportionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateDeveloperRewardsReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateDeveloperRewardsReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateDeveloperRewardsReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateDeveloperRewardsReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateDeveloperRewardsReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateDeveloperRewardsReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -18,6 +18,7 @@ func GetQueryCmd() *cobra.Command {
 			types.ModuleName, types.NewQueryClient),
 		GetCmdLockableDurations(),
 		GetCmdIncentivizedPools(),
+		GetCmdIncentivizedPoolsWithGauges(),
 		GetCmdExternalIncentiveGauges(),
 	)
 
@@ -67,6 +68,15 @@ Example:
 `, types.ModuleName, types.NewQueryClient)
 }
 
+func GetCmdIncentivizedPoolsWithGauges() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryIncentivizedPoolsWithGaugesRequest](
+		"incentivized-pools-with-gauges",
+		"Query incentivized pools with their distribution record weight, gauge and current epoch emission",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} incentivized-pools-with-gauges
+`, types.ModuleName, types.NewQueryClient)
+}
+
 func GetCmdExternalIncentiveGauges() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.QueryExternalIncentiveGaugesRequest](
 		"external-incentivized-gauges",
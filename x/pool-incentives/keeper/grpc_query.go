@@ -217,6 +217,66 @@ func (q Querier) IncentivizedPools(ctx context.Context, _ *types.QueryIncentiviz
 	}, nil
 }
 
+// IncentivizedPoolsWithGauges returns the incentivized pools as IncentivizedPools does, joined with the weight of
+// their distribution record and the state of the internal gauge of every pool, with its remaining epochs and the
+// coins it distributes at the end of the current epoch.
+func (q Querier) IncentivizedPoolsWithGauges(ctx context.Context, req *types.QueryIncentivizedPoolsWithGaugesRequest) (*types.QueryIncentivizedPoolsWithGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	incentivizedPoolsRes, err := q.IncentivizedPools(ctx, &types.QueryIncentivizedPoolsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	distrInfo := q.Keeper.GetDistrInfo(sdkCtx)
+	recordWeights := make(map[uint64]osmomath.Int, len(distrInfo.Records))
+	for _, record := range distrInfo.Records {
+		recordWeights[record.GaugeId] = record.Weight
+	}
+
+	incentivizedPools := make([]types.IncentivizedPoolWithGauge, 0, len(incentivizedPoolsRes.IncentivizedPools))
+	for _, incentivizedPool := range incentivizedPoolsRes.IncentivizedPools {
+		gaugeId, err := q.Keeper.GetPoolGaugeId(sdkCtx, incentivizedPool.PoolId, incentivizedPool.LockableDuration)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		gauge, err := q.incentivesKeeper.GetGaugeByID(sdkCtx, gaugeId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// Perpetual gauges distribute all their undistributed coins every epoch.
+		remainingEpochs := uint64(1)
+		if !gauge.IsPerpetual {
+			remainingEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+		}
+
+		currentEpochEmission := sdk.NewCoins()
+		if remainingEpochs > 0 {
+			for _, coin := range gauge.Coins.Sub(gauge.DistributedCoins...) {
+				currentEpochEmission = currentEpochEmission.Add(sdk.NewCoin(coin.Denom, coin.Amount.Quo(osmomath.NewIntFromUint64(remainingEpochs))))
+			}
+		}
+
+		incentivizedPools = append(incentivizedPools, types.IncentivizedPoolWithGauge{
+			IncentivizedPool:     incentivizedPool,
+			RecordWeight:         recordWeights[incentivizedPool.GaugeId],
+			Gauge:                *gauge,
+			RemainingEpochs:      remainingEpochs,
+			CurrentEpochEmission: currentEpochEmission,
+		})
+	}
+
+	return &types.QueryIncentivizedPoolsWithGaugesResponse{
+		IncentivizedPools: incentivizedPools,
+		TotalWeight:       distrInfo.TotalWeight,
+	}, nil
+}
+
 // ExternalIncentiveGauges iterates over all gauges and returns gauges externally incentivized by excluding default (internal) gauges.
 func (q Querier) ExternalIncentiveGauges(ctx context.Context, req *types.QueryExternalIncentiveGaugesRequest) (*types.QueryExternalIncentiveGaugesResponse, error) {
 	if req == nil {
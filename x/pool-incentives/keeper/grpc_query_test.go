@@ -255,6 +255,70 @@ func (s *KeeperTestSuite) TestIncentivizedPools() {
 	}
 }
 
+func (s *KeeperTestSuite) TestIncentivizedPoolsWithGauges() {
+	s.SetupTest()
+	keeper := s.App.PoolIncentivesKeeper
+
+	balancerPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("eth", osmomath.NewInt(100000000000)), sdk.NewCoin("usdc", osmomath.NewInt(100000000000)))
+	clPool := s.PrepareConcentratedPool()
+	epochDuration := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).Duration
+	longestLockableDuration, err := keeper.GetLongestLockableDuration(s.Ctx)
+	s.Require().NoError(err)
+
+	balancerGaugeId, err := keeper.GetPoolGaugeId(s.Ctx, balancerPoolId, longestLockableDuration)
+	s.Require().NoError(err)
+	clGaugeId, err := keeper.GetPoolGaugeId(s.Ctx, clPool.GetId(), epochDuration)
+	s.Require().NoError(err)
+
+	// Create a perpetual group of both pools.
+	groupPoolIDs := []uint64{balancerPoolId, clPool.GetId()}
+	s.SetupVolumeForPools(groupPoolIDs, []osmomath.Int{osmomath.NewInt(3000000), osmomath.NewInt(3000000)}, map[uint64]osmomath.Int{})
+	groupGaugeId, err := s.App.IncentivesKeeper.CreateGroup(s.Ctx, sdk.Coins{}, incentivestypes.PerpetualNumEpochsPaidOver, s.TestAccs[0], groupPoolIDs)
+	s.Require().NoError(err)
+
+	err = keeper.UpdateDistrRecords(s.Ctx,
+		types.DistrRecord{GaugeId: balancerGaugeId, Weight: osmomath.NewInt(100)},
+		types.DistrRecord{GaugeId: clGaugeId, Weight: osmomath.NewInt(200)},
+		types.DistrRecord{GaugeId: groupGaugeId, Weight: osmomath.NewInt(300)},
+	)
+	s.Require().NoError(err)
+
+	// Add rewards to the balancer pool gauge.
+	gaugeCoins := sdk.NewCoins(sdk.NewCoin("uosmo", osmomath.NewInt(1000)))
+	s.FundAcc(s.TestAccs[0], gaugeCoins)
+	err = s.App.IncentivesKeeper.AddToGaugeRewards(s.Ctx, s.TestAccs[0], gaugeCoins, balancerGaugeId)
+	s.Require().NoError(err)
+
+	// System under test.
+	res, err := s.queryClient.IncentivizedPoolsWithGauges(context.Background(), &types.QueryIncentivizedPoolsWithGaugesRequest{})
+	s.Require().NoError(err)
+
+	// One record for every gauge of the distribution records, and one for every pool of the group.
+	s.Require().Len(res.IncentivizedPools, 4)
+	s.Require().Equal(osmomath.NewInt(600).String(), res.TotalWeight.String())
+
+	expectedPoolGauges := []struct {
+		recordGaugeId uint64
+		poolGaugeId   uint64
+		recordWeight  osmomath.Int
+		emission      sdk.Coins
+	}{
+		{recordGaugeId: balancerGaugeId, poolGaugeId: balancerGaugeId, recordWeight: osmomath.NewInt(100), emission: gaugeCoins},
+		{recordGaugeId: clGaugeId, poolGaugeId: clGaugeId, recordWeight: osmomath.NewInt(200), emission: sdk.NewCoins()},
+		{recordGaugeId: groupGaugeId, poolGaugeId: balancerGaugeId, recordWeight: osmomath.NewInt(300), emission: gaugeCoins},
+		{recordGaugeId: groupGaugeId, poolGaugeId: clGaugeId, recordWeight: osmomath.NewInt(300), emission: sdk.NewCoins()},
+	}
+	for i, expected := range expectedPoolGauges {
+		actual := res.IncentivizedPools[i]
+		s.Require().Equal(expected.recordGaugeId, actual.IncentivizedPool.GaugeId)
+		s.Require().Equal(expected.poolGaugeId, actual.Gauge.Id)
+		s.Require().Equal(expected.recordWeight.String(), actual.RecordWeight.String())
+		// Internal gauges are perpetual.
+		s.Require().Equal(uint64(1), actual.RemainingEpochs)
+		s.Require().Equal(expected.emission.String(), actual.CurrentEpochEmission.String())
+	}
+}
+
 func (s *KeeperTestSuite) TestExternalIncentiveGauges() {
 	type externalGauge struct {
 		isPerpetual bool
@@ -40,6 +40,11 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
 
+// AfterCFMMPoolDestroyed hook is a noop. x/gamm does not destroy internally incentivized pools,
+// and the gauges of a destroyed pool are no longer distributed to, since its shares can no longer be locked.
+func (h Hooks) AfterCFMMPoolDestroyed(ctx sdk.Context, poolId uint64) {
+}
+
 // Distribute coins after minter module allocate assets to pool-incentives module.
 func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context) {
 	// @Sunny, @Tony, @Dev, what comments should we keep after modifying own BeginBlocker to hooks?
@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -566,6 +569,172 @@ func (m *QueryIncentivizedPoolsResponse) GetIncentivizedPools() []IncentivizedPo
 	return nil
 }
 
+type QueryIncentivizedPoolsWithGaugesRequest struct {
+}
+
+func (m *QueryIncentivizedPoolsWithGaugesRequest) Reset() {
+	*m = QueryIncentivizedPoolsWithGaugesRequest{}
+}
+func (m *QueryIncentivizedPoolsWithGaugesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedPoolsWithGaugesRequest) ProtoMessage()    {}
+func (*QueryIncentivizedPoolsWithGaugesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9aea78c6f643155, []int{11}
+}
+func (m *QueryIncentivizedPoolsWithGaugesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedPoolsWithGaugesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedPoolsWithGaugesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedPoolsWithGaugesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedPoolsWithGaugesRequest.Merge(m, src)
+}
+func (m *QueryIncentivizedPoolsWithGaugesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedPoolsWithGaugesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedPoolsWithGaugesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedPoolsWithGaugesRequest proto.InternalMessageInfo
+
+type IncentivizedPoolWithGauge struct {
+	// incentivized_pool is the pool as returned by IncentivizedPools. Its gauge
+	// id is the gauge of the distribution record, which is a group gauge for
+	// pools incentivized through a group.
+	IncentivizedPool IncentivizedPool `protobuf:"bytes,1,opt,name=incentivized_pool,json=incentivizedPool,proto3" json:"incentivized_pool" yaml:"incentivized_pool"`
+	// record_weight is the weight of the distribution record of the gauge. For
+	// pools incentivized through a group, it is the weight of the whole group.
+	RecordWeight cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=record_weight,json=recordWeight,proto3,customtype=cosmossdk.io/math.Int" json:"record_weight" yaml:"record_weight"`
+	// gauge is the internal gauge of the pool for the lockable duration, which
+	// distributes the rewards to the pool.
+	Gauge types.Gauge `protobuf:"bytes,3,opt,name=gauge,proto3" json:"gauge"`
+	// remaining_epochs is the number of epochs the undistributed coins of the
+	// gauge are distributed over. It is 1 for perpetual gauges.
+	RemainingEpochs uint64 `protobuf:"varint,4,opt,name=remaining_epochs,json=remainingEpochs,proto3" json:"remaining_epochs,omitempty" yaml:"remaining_epochs"`
+	// current_epoch_emission is the amount of the undistributed coins of the
+	// gauge that it distributes at the end of the current epoch.
+	CurrentEpochEmission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=current_epoch_emission,json=currentEpochEmission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_epoch_emission" yaml:"current_epoch_emission"`
+}
+
+func (m *IncentivizedPoolWithGauge) Reset()         { *m = IncentivizedPoolWithGauge{} }
+func (m *IncentivizedPoolWithGauge) String() string { return proto.CompactTextString(m) }
+func (*IncentivizedPoolWithGauge) ProtoMessage()    {}
+func (*IncentivizedPoolWithGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9aea78c6f643155, []int{12}
+}
+func (m *IncentivizedPoolWithGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivizedPoolWithGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivizedPoolWithGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivizedPoolWithGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivizedPoolWithGauge.Merge(m, src)
+}
+func (m *IncentivizedPoolWithGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivizedPoolWithGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivizedPoolWithGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivizedPoolWithGauge proto.InternalMessageInfo
+
+func (m *IncentivizedPoolWithGauge) GetIncentivizedPool() IncentivizedPool {
+	if m != nil {
+		return m.IncentivizedPool
+	}
+	return IncentivizedPool{}
+}
+
+func (m *IncentivizedPoolWithGauge) GetGauge() types.Gauge {
+	if m != nil {
+		return m.Gauge
+	}
+	return types.Gauge{}
+}
+
+func (m *IncentivizedPoolWithGauge) GetRemainingEpochs() uint64 {
+	if m != nil {
+		return m.RemainingEpochs
+	}
+	return 0
+}
+
+func (m *IncentivizedPoolWithGauge) GetCurrentEpochEmission() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CurrentEpochEmission
+	}
+	return nil
+}
+
+type QueryIncentivizedPoolsWithGaugesResponse struct {
+	IncentivizedPools []IncentivizedPoolWithGauge `protobuf:"bytes,1,rep,name=incentivized_pools,json=incentivizedPools,proto3" json:"incentivized_pools" yaml:"incentivized_pools"`
+	// total_weight is the total weight of the distribution records.
+	TotalWeight cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3,customtype=cosmossdk.io/math.Int" json:"total_weight" yaml:"total_weight"`
+}
+
+func (m *QueryIncentivizedPoolsWithGaugesResponse) Reset() {
+	*m = QueryIncentivizedPoolsWithGaugesResponse{}
+}
+func (m *QueryIncentivizedPoolsWithGaugesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedPoolsWithGaugesResponse) ProtoMessage()    {}
+func (*QueryIncentivizedPoolsWithGaugesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9aea78c6f643155, []int{13}
+}
+func (m *QueryIncentivizedPoolsWithGaugesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedPoolsWithGaugesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedPoolsWithGaugesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedPoolsWithGaugesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedPoolsWithGaugesResponse.Merge(m, src)
+}
+func (m *QueryIncentivizedPoolsWithGaugesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedPoolsWithGaugesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedPoolsWithGaugesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedPoolsWithGaugesResponse proto.InternalMessageInfo
+
+func (m *QueryIncentivizedPoolsWithGaugesResponse) GetIncentivizedPools() []IncentivizedPoolWithGauge {
+	if m != nil {
+		return m.IncentivizedPools
+	}
+	return nil
+}
+
 type QueryExternalIncentiveGaugesRequest struct {
 }
 
@@ -573,7 +742,7 @@ func (m *QueryExternalIncentiveGaugesRequest) Reset()         { *m = QueryExtern
 func (m *QueryExternalIncentiveGaugesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExternalIncentiveGaugesRequest) ProtoMessage()    {}
 func (*QueryExternalIncentiveGaugesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9aea78c6f643155, []int{11}
+	return fileDescriptor_c9aea78c6f643155, []int{14}
 }
 func (m *QueryExternalIncentiveGaugesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +779,7 @@ func (m *QueryExternalIncentiveGaugesResponse) Reset()         { *m = QueryExter
 func (m *QueryExternalIncentiveGaugesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExternalIncentiveGaugesResponse) ProtoMessage()    {}
 func (*QueryExternalIncentiveGaugesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9aea78c6f643155, []int{12}
+	return fileDescriptor_c9aea78c6f643155, []int{15}
 }
 func (m *QueryExternalIncentiveGaugesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,6 +828,9 @@ func init() {
 	proto.RegisterType((*QueryIncentivizedPoolsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsRequest")
 	proto.RegisterType((*IncentivizedPool)(nil), "osmosis.poolincentives.v1beta1.IncentivizedPool")
 	proto.RegisterType((*QueryIncentivizedPoolsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsResponse")
+	proto.RegisterType((*QueryIncentivizedPoolsWithGaugesRequest)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsWithGaugesRequest")
+	proto.RegisterType((*IncentivizedPoolWithGauge)(nil), "osmosis.poolincentives.v1beta1.IncentivizedPoolWithGauge")
+	proto.RegisterType((*QueryIncentivizedPoolsWithGaugesResponse)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsWithGaugesResponse")
 	proto.RegisterType((*QueryExternalIncentiveGaugesRequest)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesRequest")
 	proto.RegisterType((*QueryExternalIncentiveGaugesResponse)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesResponse")
 }
@@ -668,65 +840,85 @@ func init() {
 }
 
 var fileDescriptor_c9aea78c6f643155 = []byte{
-	// 924 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x34, 0x21, 0x4d, 0x26, 0x12, 0xd4, 0x93, 0x40, 0x9c, 0x15, 0xac, 0xc3, 0xd0, 0x42,
-	0x4a, 0x95, 0x5d, 0x62, 0xb7, 0x3d, 0xb4, 0x01, 0x24, 0x37, 0x08, 0x45, 0xe2, 0x10, 0x56, 0x42,
-	0x48, 0x70, 0xb0, 0xd6, 0xde, 0xc9, 0x66, 0xc4, 0x7a, 0xc7, 0xf5, 0xac, 0x43, 0x03, 0xea, 0xa5,
-	0x12, 0x77, 0x10, 0x17, 0xce, 0x88, 0x9e, 0x39, 0xf1, 0x0f, 0x38, 0xf4, 0x46, 0x25, 0x2e, 0x5c,
-	0x30, 0x28, 0xe1, 0xc0, 0xd9, 0xbf, 0x00, 0xed, 0xec, 0x9b, 0xad, 0xbd, 0x6b, 0x7b, 0xed, 0x72,
-	0x5b, 0xef, 0x7b, 0xef, 0x7b, 0xdf, 0x37, 0xef, 0xcd, 0xb7, 0xc6, 0x6f, 0x0b, 0xd9, 0x16, 0x92,
-	0x4b, 0xbb, 0x23, 0x44, 0xc0, 0xc3, 0x16, 0x0b, 0x23, 0x7e, 0xca, 0xa4, 0x7d, 0xba, 0xd7, 0x64,
-	0x91, 0xbb, 0x67, 0xdf, 0xef, 0xb1, 0xee, 0x99, 0xd5, 0xe9, 0x8a, 0x48, 0x10, 0x13, 0x72, 0xad,
-	0xd1, 0x5c, 0x0b, 0x72, 0x8d, 0x0d, 0x5f, 0xf8, 0x42, 0xa5, 0xda, 0xf1, 0x53, 0x52, 0x65, 0xbc,
-	0xea, 0x0b, 0xe1, 0x07, 0xcc, 0x76, 0x3b, 0xdc, 0x76, 0xc3, 0x50, 0x44, 0x6e, 0xc4, 0x45, 0x28,
-	0x21, 0x6a, 0x42, 0x54, 0xfd, 0x6a, 0xf6, 0x8e, 0x6d, 0xaf, 0xd7, 0x55, 0x09, 0x3a, 0xae, 0xf9,
-	0x0d, 0x71, 0xf3, 0xdd, 0x9e, 0xcf, 0x20, 0x6e, 0x17, 0xf0, 0x1f, 0xa2, 0xa9, 0x0a, 0xe8, 0x3d,
-	0xbc, 0xf1, 0x71, 0xac, 0xe9, 0xc3, 0x18, 0xe4, 0xd0, 0x93, 0x0e, 0xbb, 0xdf, 0x63, 0x32, 0x22,
-	0x37, 0xf0, 0xe5, 0x18, 0xa2, 0xc1, 0xbd, 0x32, 0xda, 0x46, 0x3b, 0x4b, 0x75, 0x32, 0xe8, 0x57,
-	0x5e, 0x3c, 0x73, 0xdb, 0xc1, 0x1d, 0x0a, 0x01, 0xea, 0x2c, 0xc7, 0x4f, 0x87, 0x1e, 0xfd, 0x66,
-	0x11, 0xbf, 0x9c, 0x41, 0x91, 0x1d, 0x11, 0x4a, 0x46, 0x7e, 0x42, 0x78, 0x53, 0xf1, 0x6b, 0x70,
-	0x4f, 0x36, 0xbe, 0xe4, 0xd1, 0x49, 0x43, 0x2b, 0x2a, 0xa3, 0xed, 0xc5, 0x9d, 0xb5, 0xea, 0xa1,
-	0x35, 0xfd, 0x18, 0xad, 0xb1, 0xc0, 0x16, 0xbc, 0xf8, 0x94, 0x47, 0x27, 0x07, 0x00, 0x58, 0xa7,
-	0x83, 0x7e, 0xc5, 0x4c, 0x28, 0x4e, 0xe8, 0x49, 0x9d, 0x0d, 0x1f, 0x90, 0x86, 0x2b, 0x8d, 0x5f,
-	0x11, 0x5e, 0x1f, 0x83, 0x48, 0x2c, 0xbc, 0xa2, 0x91, 0xe0, 0x18, 0xd6, 0x07, 0xfd, 0xca, 0x4b,
-	0xa3, 0x3d, 0xa8, 0x73, 0x19, 0x40, 0xc9, 0xfb, 0x78, 0x25, 0x95, 0x77, 0x69, 0x1b, 0xed, 0xac,
-	0x55, 0xb7, 0xac, 0x64, 0xa2, 0x96, 0x9e, 0xa8, 0x95, 0xd2, 0x5d, 0x79, 0xd2, 0xaf, 0x2c, 0xfc,
-	0xf0, 0x57, 0x05, 0x39, 0x69, 0x11, 0xd9, 0xc7, 0x06, 0xc0, 0xea, 0x83, 0x68, 0x74, 0x58, 0x37,
-	0x7e, 0x74, 0x7d, 0x56, 0x5e, 0xdc, 0x46, 0x3b, 0xab, 0x4e, 0x39, 0xe9, 0xa6, 0x13, 0x8e, 0xd2,
-	0x38, 0xdd, 0x84, 0x31, 0x1c, 0x70, 0x19, 0x75, 0x0f, 0xc3, 0x63, 0x01, 0xd3, 0xa4, 0x0f, 0xf1,
-	0x2b, 0xd9, 0x00, 0x0c, 0xa8, 0x85, 0xb1, 0x17, 0xbf, 0x6c, 0xf0, 0xf0, 0x58, 0x28, 0x8d, 0x6b,
-	0xd5, 0xeb, 0x45, 0x23, 0x49, 0x61, 0xea, 0x5b, 0xb1, 0x86, 0x41, 0xbf, 0x52, 0x4a, 0x8e, 0xe4,
-	0x19, 0x14, 0x75, 0x56, 0x3d, 0x9d, 0x45, 0x37, 0x30, 0x51, 0xed, 0x8f, 0xdc, 0xae, 0xdb, 0xd6,
-	0x2b, 0x46, 0x3f, 0xc7, 0xeb, 0x23, 0x6f, 0x81, 0xd1, 0x01, 0x5e, 0xee, 0xa8, 0x37, 0xc0, 0xe6,
-	0xcd, 0x22, 0x36, 0x49, 0x7d, 0x7d, 0x29, 0xa6, 0xe2, 0x40, 0x2d, 0xad, 0xe0, 0xd7, 0x14, 0xf8,
-	0x47, 0xa2, 0xf5, 0x85, 0xdb, 0x0c, 0x98, 0x3e, 0xf5, 0xb4, 0xfb, 0x77, 0x08, 0x9b, 0x93, 0x32,
-	0x80, 0x89, 0xc0, 0x24, 0x80, 0x60, 0xba, 0x41, 0x12, 0xd6, 0x76, 0xca, 0x5c, 0xaf, 0xc1, 0x99,
-	0x6c, 0x25, 0x67, 0x92, 0x87, 0xa0, 0x6a, 0xe8, 0xa5, 0x20, 0xdb, 0x38, 0x25, 0xad, 0x67, 0xcb,
-	0xbf, 0x62, 0xde, 0x91, 0x10, 0x41, 0x4a, 0xfa, 0x4f, 0x84, 0xaf, 0x64, 0x83, 0x73, 0x5d, 0x55,
-	0x12, 0xe0, 0x52, 0x8e, 0x50, 0xf1, 0xaa, 0x5e, 0x05, 0x49, 0xe5, 0x09, 0x92, 0x12, 0x45, 0x57,
-	0xb2, 0x8a, 0x46, 0xee, 0xcf, 0x62, 0xf1, 0xfd, 0xa1, 0x8f, 0xf5, 0x50, 0xc6, 0x9c, 0x00, 0x0c,
-	0xe5, 0x11, 0xc2, 0x84, 0x0f, 0x45, 0x1b, 0xb1, 0x30, 0x3d, 0x95, 0x77, 0x8a, 0x76, 0x25, 0x8b,
-	0x5b, 0x7f, 0x7d, 0x74, 0x58, 0x79, 0x64, 0xea, 0x94, 0x78, 0x96, 0x0c, 0xbd, 0x86, 0xdf, 0x50,
-	0x34, 0x3f, 0x78, 0x10, 0xb1, 0x6e, 0xe8, 0x06, 0xe9, 0x65, 0x54, 0x26, 0x32, 0xb4, 0xe1, 0x57,
-	0xa7, 0xa7, 0x81, 0xa6, 0x1a, 0x5e, 0xf2, 0xdc, 0xc8, 0x4d, 0x57, 0x4b, 0x8b, 0x18, 0x12, 0xa0,
-	0x2a, 0x60, 0xc7, 0x55, 0x72, 0xf5, 0xf1, 0x2a, 0x7e, 0x41, 0xa1, 0x93, 0x5f, 0x10, 0x5e, 0xd1,
-	0x06, 0x49, 0x6e, 0xce, 0xe9, 0xa7, 0x8a, 0xa9, 0x71, 0xeb, 0xb9, 0x5c, 0x98, 0xee, 0x3f, 0xfa,
-	0xfd, 0x9f, 0xef, 0x2f, 0xdd, 0x26, 0x37, 0x47, 0xbe, 0x3b, 0xbb, 0x63, 0x3e, 0x3c, 0x6a, 0xc2,
-	0xbb, 0xdc, 0x93, 0xf6, 0xd7, 0xb0, 0x93, 0x0f, 0xc9, 0xcf, 0x08, 0xaf, 0xa6, 0x56, 0x42, 0x66,
-	0xa3, 0x90, 0xb5, 0x36, 0xe3, 0xf6, 0xbc, 0x65, 0x40, 0xbd, 0xa6, 0xa8, 0xef, 0x92, 0x1b, 0x85,
-	0xd4, 0x9f, 0x99, 0x1a, 0xf9, 0x11, 0xe1, 0xe5, 0xc4, 0x6e, 0x48, 0x75, 0xa6, 0xbe, 0x23, 0x8e,
-	0x67, 0xd4, 0xe6, 0xaa, 0x01, 0xa2, 0xb6, 0x22, 0x7a, 0x9d, 0xbc, 0x55, 0x48, 0x34, 0xb1, 0x3e,
-	0xf2, 0x1b, 0xc2, 0xa5, 0x9c, 0xa9, 0x91, 0x77, 0x67, 0xea, 0x3d, 0xc9, 0x2e, 0x8d, 0xf7, 0x9e,
-	0xb7, 0x1c, 0x54, 0xdc, 0x55, 0x2a, 0x6e, 0x91, 0x5a, 0xa1, 0x8a, 0xbc, 0x5f, 0x2a, 0x45, 0x39,
-	0x47, 0x98, 0x51, 0xd1, 0x24, 0x2f, 0x9d, 0x51, 0xd1, 0x44, 0x23, 0x9a, 0x43, 0x51, 0xde, 0x54,
-	0xc8, 0xbf, 0x08, 0x6f, 0x4e, 0x70, 0x05, 0x72, 0x6f, 0x26, 0x62, 0xd3, 0xad, 0xc7, 0x38, 0xf8,
-	0x7f, 0x20, 0xa0, 0xb1, 0xae, 0x34, 0xee, 0x93, 0x3b, 0x85, 0x1a, 0x19, 0x20, 0x0d, 0xfd, 0x71,
-	0x51, 0x57, 0x5e, 0xd6, 0x3f, 0x79, 0x72, 0x6e, 0xa2, 0xa7, 0xe7, 0x26, 0xfa, 0xfb, 0xdc, 0x44,
-	0xdf, 0x5e, 0x98, 0x0b, 0x4f, 0x2f, 0xcc, 0x85, 0x3f, 0x2e, 0xcc, 0x85, 0xcf, 0xee, 0xfa, 0x3c,
-	0x3a, 0xe9, 0x35, 0xad, 0x96, 0x68, 0x6b, 0xfc, 0xdd, 0xc0, 0x6d, 0xca, 0xb4, 0xd9, 0x69, 0x75,
-	0xcf, 0x7e, 0x90, 0x6b, 0x19, 0x9d, 0x75, 0x98, 0x6c, 0x2e, 0xab, 0xaf, 0x54, 0xed, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0x5f, 0x22, 0x84, 0xb2, 0x0b, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x3f, 0x9a, 0x4c, 0x0a, 0x8d, 0x27, 0x6e, 0xe3, 0xb8, 0xd4, 0x0e, 0x43, 0x4b,
+	0x1d, 0xaa, 0xec, 0x12, 0x27, 0xa9, 0x44, 0x1b, 0x5a, 0xe1, 0x24, 0x84, 0x48, 0x1c, 0xd2, 0x95,
+	0x50, 0xa4, 0xf6, 0x60, 0xad, 0xbd, 0x93, 0xf5, 0x28, 0xf6, 0x8e, 0xbb, 0xb3, 0x4e, 0x1b, 0x50,
+	0x85, 0x54, 0x89, 0x13, 0x17, 0x2a, 0x2e, 0x1c, 0xb8, 0x80, 0xe0, 0xc2, 0x85, 0x13, 0xff, 0x01,
+	0x87, 0xde, 0xa8, 0xc4, 0x05, 0x21, 0xe1, 0xa2, 0x84, 0x03, 0x67, 0xdf, 0xb8, 0xa1, 0x9d, 0x99,
+	0xdd, 0x7a, 0xbd, 0xb6, 0x37, 0x76, 0x4f, 0xf1, 0xce, 0xbc, 0xf7, 0xbd, 0xef, 0x7b, 0xef, 0xcd,
+	0xcc, 0x0b, 0x78, 0x87, 0xb2, 0x1a, 0x65, 0x84, 0x69, 0x75, 0x4a, 0xab, 0xc4, 0x2e, 0x63, 0xdb,
+	0x25, 0x87, 0x98, 0x69, 0x87, 0xcb, 0x25, 0xec, 0x1a, 0xcb, 0xda, 0x83, 0x06, 0x76, 0x8e, 0xd4,
+	0xba, 0x43, 0x5d, 0x0a, 0x33, 0xd2, 0x56, 0x0d, 0xdb, 0xaa, 0xd2, 0x36, 0x9d, 0xb4, 0xa8, 0x45,
+	0xb9, 0xa9, 0xe6, 0xfd, 0x12, 0x5e, 0xe9, 0x4c, 0x99, 0xbb, 0x69, 0x25, 0x83, 0xe1, 0x00, 0xb6,
+	0x4c, 0x89, 0x2d, 0xf7, 0xdf, 0xb0, 0x28, 0xb5, 0xaa, 0x58, 0x33, 0xea, 0x44, 0x33, 0x6c, 0x9b,
+	0xba, 0x86, 0x4b, 0xa8, 0xcd, 0x7c, 0x6f, 0xb9, 0xcb, 0xbf, 0x4a, 0x8d, 0x7d, 0xcd, 0x6c, 0x38,
+	0xdc, 0xc0, 0xdf, 0xf7, 0xf9, 0xb7, 0x71, 0xb7, 0x8c, 0x86, 0x85, 0xe5, 0xbe, 0x16, 0xa3, 0xaf,
+	0x4d, 0x06, 0x77, 0x40, 0x1b, 0x20, 0x79, 0xd7, 0xd3, 0xbc, 0xed, 0x81, 0xec, 0x98, 0x4c, 0xc7,
+	0x0f, 0x1a, 0x98, 0xb9, 0xf0, 0x3a, 0x38, 0xeb, 0x41, 0x14, 0x89, 0x99, 0x52, 0x16, 0x94, 0xdc,
+	0x58, 0x01, 0xb6, 0x9a, 0xd9, 0xd7, 0x8f, 0x8c, 0x5a, 0xf5, 0x26, 0x92, 0x1b, 0x48, 0x9f, 0xf0,
+	0x7e, 0xed, 0x98, 0xe8, 0x8b, 0x51, 0x70, 0xa1, 0x03, 0x85, 0xd5, 0xa9, 0xcd, 0x30, 0xfc, 0x41,
+	0x01, 0x73, 0x9c, 0x5f, 0x91, 0x98, 0xac, 0xf8, 0x90, 0xb8, 0x95, 0xa2, 0xaf, 0x28, 0xa5, 0x2c,
+	0x8c, 0xe6, 0xa6, 0xf3, 0x3b, 0x6a, 0xff, 0x34, 0xab, 0x5d, 0x81, 0x55, 0xb9, 0xb0, 0x47, 0xdc,
+	0xca, 0xa6, 0x04, 0x2c, 0xa0, 0x56, 0x33, 0x9b, 0x11, 0x14, 0x7b, 0xc4, 0x44, 0x7a, 0xd2, 0x92,
+	0x48, 0xed, 0x9e, 0xe9, 0x5f, 0x15, 0x30, 0xdb, 0x05, 0x11, 0xaa, 0x60, 0xd2, 0x47, 0x92, 0x69,
+	0x98, 0x6d, 0x35, 0xb3, 0xe7, 0xc3, 0x31, 0x90, 0x7e, 0x56, 0x82, 0xc2, 0x3b, 0x60, 0x32, 0x90,
+	0x77, 0x66, 0x41, 0xc9, 0x4d, 0xe7, 0xe7, 0x55, 0x51, 0x51, 0xd5, 0xaf, 0xa8, 0x1a, 0xd0, 0x9d,
+	0x7c, 0xd6, 0xcc, 0x8e, 0x7c, 0xf3, 0x22, 0xab, 0xe8, 0x81, 0x13, 0x5c, 0x07, 0x69, 0x09, 0xeb,
+	0x27, 0xa2, 0x58, 0xc7, 0x8e, 0xf7, 0xd3, 0xb0, 0x70, 0x6a, 0x74, 0x41, 0xc9, 0x4d, 0xe9, 0x29,
+	0x11, 0xcd, 0x37, 0xd8, 0x0d, 0xf6, 0xd1, 0x9c, 0x2c, 0xc3, 0x26, 0x61, 0xae, 0xb3, 0x63, 0xef,
+	0x53, 0x59, 0x4d, 0xf4, 0x18, 0x5c, 0xec, 0xdc, 0x90, 0x05, 0x2a, 0x03, 0x60, 0x7a, 0x8b, 0x45,
+	0x62, 0xef, 0x53, 0xae, 0x71, 0x3a, 0xbf, 0x18, 0x57, 0x92, 0x00, 0xa6, 0x30, 0xef, 0x69, 0x68,
+	0x35, 0xb3, 0x09, 0x91, 0x92, 0x97, 0x50, 0x48, 0x9f, 0x32, 0x7d, 0x2b, 0x94, 0x04, 0x90, 0x87,
+	0xdf, 0x35, 0x1c, 0xa3, 0xe6, 0xb7, 0x18, 0xba, 0x0f, 0x66, 0x43, 0xab, 0x92, 0xd1, 0x26, 0x98,
+	0xa8, 0xf3, 0x15, 0xc9, 0xe6, 0xed, 0x38, 0x36, 0xc2, 0xbf, 0x30, 0xe6, 0x51, 0xd1, 0xa5, 0x2f,
+	0xca, 0x82, 0xcb, 0x1c, 0xfc, 0x63, 0x5a, 0x3e, 0x30, 0x4a, 0x55, 0xec, 0x67, 0x3d, 0x88, 0xfe,
+	0x54, 0x01, 0x99, 0x5e, 0x16, 0x92, 0x09, 0x05, 0xb0, 0x2a, 0x37, 0x83, 0x0e, 0x62, 0xb2, 0x6d,
+	0xfb, 0xd4, 0xf5, 0xaa, 0xcc, 0xc9, 0xbc, 0xc8, 0x49, 0x14, 0x02, 0xf1, 0xa2, 0x27, 0xaa, 0x9d,
+	0x81, 0x03, 0xd2, 0x7e, 0x6d, 0xc9, 0xa7, 0xd8, 0xdc, 0xa5, 0xb4, 0x1a, 0x90, 0xfe, 0x4b, 0x01,
+	0x33, 0x9d, 0x9b, 0x03, 0x1d, 0x55, 0x58, 0x05, 0x89, 0x08, 0xa1, 0xf8, 0x56, 0xbd, 0x22, 0x25,
+	0xa5, 0x7a, 0x48, 0x12, 0x8a, 0x66, 0x3a, 0x15, 0x85, 0xce, 0xcf, 0x68, 0xfc, 0xf9, 0x41, 0x3f,
+	0xfa, 0x45, 0xe9, 0x92, 0x01, 0x59, 0x94, 0x27, 0x0a, 0x80, 0xa4, 0x6d, 0xb7, 0xe8, 0x09, 0xf3,
+	0xab, 0xf2, 0x6e, 0x5c, 0xaf, 0x74, 0xe2, 0x16, 0xde, 0x0c, 0x17, 0x2b, 0x8a, 0x8c, 0xf4, 0x04,
+	0xe9, 0x24, 0x83, 0x16, 0xc1, 0xb5, 0xee, 0x34, 0xbd, 0xdb, 0x83, 0x5f, 0x24, 0x41, 0xc9, 0xbe,
+	0x1d, 0x03, 0xf3, 0x9d, 0x66, 0x81, 0x15, 0xfc, 0x1c, 0x24, 0x22, 0x21, 0x65, 0xdf, 0x0f, 0xae,
+	0x65, 0x21, 0x5c, 0xa5, 0x08, 0x30, 0xd2, 0x67, 0x3a, 0xa5, 0xc0, 0x7b, 0xe0, 0x35, 0x07, 0x97,
+	0xa9, 0x63, 0x16, 0x1f, 0x62, 0x62, 0x55, 0x5c, 0xde, 0x0b, 0x53, 0x85, 0x35, 0x0f, 0xea, 0xcf,
+	0x66, 0xf6, 0x82, 0x78, 0xcd, 0x98, 0x79, 0xa0, 0x12, 0xaa, 0xd5, 0x0c, 0xb7, 0xa2, 0xee, 0xd8,
+	0x6e, 0xab, 0x99, 0x4d, 0x8a, 0x18, 0x21, 0x5f, 0xa4, 0x9f, 0x13, 0xdf, 0x7b, 0xfc, 0x13, 0xae,
+	0x81, 0x71, 0x5e, 0xd8, 0xd4, 0xa8, 0xec, 0x2f, 0x5f, 0x50, 0x9b, 0x18, 0x9e, 0x06, 0x79, 0x76,
+	0x85, 0x35, 0xfc, 0x10, 0xcc, 0x38, 0xb8, 0x66, 0x10, 0x9b, 0xd8, 0x56, 0x11, 0xd7, 0x69, 0xb9,
+	0xc2, 0x52, 0x63, 0xbc, 0x79, 0x2e, 0xb5, 0x9a, 0xd9, 0x39, 0x3f, 0x70, 0xd8, 0x02, 0xe9, 0xe7,
+	0x83, 0xa5, 0x2d, 0xbe, 0x02, 0xbf, 0x57, 0xc0, 0xc5, 0x72, 0xc3, 0x71, 0xb0, 0xed, 0x0a, 0xa3,
+	0x22, 0xae, 0x11, 0xc6, 0xbc, 0x86, 0x1f, 0x97, 0x67, 0x58, 0xa8, 0x53, 0xbd, 0xb7, 0x3a, 0x48,
+	0xeb, 0x06, 0x25, 0x76, 0xe1, 0xae, 0x4c, 0xe5, 0x65, 0x11, 0xad, 0x3b, 0x0c, 0xfa, 0xe9, 0x45,
+	0x36, 0x67, 0x11, 0xb7, 0xd2, 0x28, 0xa9, 0x65, 0x5a, 0xd3, 0xe4, 0xcb, 0x2f, 0xfe, 0x2c, 0x31,
+	0xf3, 0x40, 0x73, 0x8f, 0xea, 0x98, 0x71, 0x44, 0xa6, 0x27, 0x25, 0x08, 0x67, 0xb7, 0xe5, 0x43,
+	0x3c, 0x3d, 0x03, 0x72, 0xf1, 0xad, 0x24, 0x7b, 0xff, 0xcb, 0x7e, 0xbd, 0xff, 0xde, 0xa0, 0xfd,
+	0x12, 0x04, 0x18, 0xee, 0x10, 0xc0, 0x3d, 0x70, 0xce, 0xa5, 0xae, 0x51, 0x0d, 0x77, 0xce, 0x6a,
+	0x5c, 0xe7, 0xcc, 0x8a, 0x20, 0xed, 0xae, 0x48, 0x9f, 0xe6, 0x9f, 0xa2, 0x6f, 0xd0, 0x55, 0xf0,
+	0x16, 0x4f, 0xc9, 0xd6, 0x23, 0x17, 0x3b, 0xb6, 0x51, 0x0d, 0x9e, 0xba, 0xf0, 0xc9, 0xba, 0x0f,
+	0xae, 0xf4, 0x37, 0x93, 0x59, 0x5b, 0x01, 0x63, 0xa6, 0xe1, 0x1a, 0xc1, 0xc5, 0x1d, 0xd3, 0x85,
+	0xdc, 0x38, 0xff, 0xdd, 0x34, 0x18, 0xe7, 0xe8, 0xf0, 0x17, 0x05, 0x4c, 0xfa, 0xe3, 0x07, 0x5c,
+	0x1d, 0x70, 0x5a, 0xe1, 0x4c, 0xd3, 0x6b, 0x43, 0xcd, 0x38, 0x68, 0xfd, 0xc9, 0xef, 0xff, 0x7c,
+	0x7d, 0xe6, 0x06, 0x5c, 0x0d, 0x4d, 0x75, 0x4b, 0x5d, 0xc6, 0x3a, 0x7e, 0x70, 0x96, 0x88, 0xc9,
+	0xb4, 0xcf, 0xe4, 0x8d, 0xff, 0x18, 0xfe, 0xac, 0x80, 0xa9, 0xe0, 0xa1, 0x86, 0xa7, 0xa3, 0xd0,
+	0x39, 0x38, 0xa4, 0x6f, 0x0c, 0xea, 0x26, 0xa9, 0xaf, 0x70, 0xea, 0x4b, 0xf0, 0x7a, 0x2c, 0xf5,
+	0x97, 0x23, 0x83, 0x77, 0x60, 0x27, 0xc4, 0x63, 0x0e, 0xf3, 0xa7, 0x8a, 0x1b, 0x9a, 0x27, 0xd2,
+	0x2b, 0x03, 0xf9, 0x48, 0xa2, 0x1a, 0x27, 0xba, 0x08, 0xaf, 0xc5, 0x12, 0x15, 0x83, 0x05, 0xfc,
+	0x4d, 0x01, 0x89, 0xc8, 0xc8, 0x00, 0xdf, 0x3f, 0x55, 0xec, 0x5e, 0xc3, 0x48, 0xfa, 0xf6, 0xb0,
+	0xee, 0x52, 0xc5, 0x2d, 0xae, 0x62, 0x0d, 0xae, 0xc4, 0xaa, 0x88, 0x4e, 0x23, 0x5c, 0x51, 0xe4,
+	0xf6, 0x39, 0xa5, 0xa2, 0x5e, 0x93, 0x4a, 0xfa, 0xf6, 0xb0, 0xee, 0x03, 0x2b, 0x8a, 0xde, 0x56,
+	0xf0, 0x3f, 0x05, 0x5c, 0xea, 0x73, 0x9f, 0xc2, 0xed, 0xe1, 0xc8, 0x45, 0x1e, 0xf7, 0xf4, 0x47,
+	0xaf, 0x0e, 0x24, 0xf5, 0x6e, 0x73, 0xbd, 0x1f, 0xc0, 0x3b, 0x43, 0xe8, 0x15, 0xff, 0xe3, 0x58,
+	0x42, 0xdb, 0xbf, 0x0a, 0x98, 0xeb, 0x71, 0x23, 0xc2, 0x8d, 0x53, 0xd1, 0xed, 0x7f, 0xed, 0xa6,
+	0x37, 0x5f, 0x0d, 0x44, 0xea, 0x2d, 0x70, 0xbd, 0xeb, 0xf0, 0x66, 0xac, 0x5e, 0x2c, 0x91, 0xda,
+	0xfe, 0x25, 0x12, 0x52, 0x0b, 0x9f, 0x3c, 0x3b, 0xce, 0x28, 0xcf, 0x8f, 0x33, 0xca, 0xdf, 0xc7,
+	0x19, 0xe5, 0xab, 0x93, 0xcc, 0xc8, 0xf3, 0x93, 0xcc, 0xc8, 0x1f, 0x27, 0x99, 0x91, 0x7b, 0xb7,
+	0xda, 0x5e, 0x65, 0x89, 0xbf, 0x54, 0x35, 0x4a, 0x2c, 0x08, 0x76, 0x98, 0x5f, 0xd6, 0x1e, 0x45,
+	0x42, 0xf2, 0xe7, 0xba, 0x34, 0xc1, 0xe7, 0xdf, 0x95, 0xff, 0x07, 0x00, 0x50, 0x62, 0x12, 0xab,
+	0x2c, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -751,6 +943,10 @@ type QueryClient interface {
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
 	// IncentivizedPools returns currently incentivized pools
 	IncentivizedPools(ctx context.Context, in *QueryIncentivizedPoolsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsResponse, error)
+	// IncentivizedPoolsWithGauges returns currently incentivized pools, together
+	// with their distribution record weight and the state and emissions of the
+	// gauges incentivizing them.
+	IncentivizedPoolsWithGauges(ctx context.Context, in *QueryIncentivizedPoolsWithGaugesRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsWithGaugesResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(ctx context.Context, in *QueryExternalIncentiveGaugesRequest, opts ...grpc.CallOption) (*QueryExternalIncentiveGaugesResponse, error)
 }
@@ -808,6 +1004,15 @@ func (c *queryClient) IncentivizedPools(ctx context.Context, in *QueryIncentiviz
 	return out, nil
 }
 
+func (c *queryClient) IncentivizedPoolsWithGauges(ctx context.Context, in *QueryIncentivizedPoolsWithGaugesRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsWithGaugesResponse, error) {
+	out := new(QueryIncentivizedPoolsWithGaugesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/IncentivizedPoolsWithGauges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ExternalIncentiveGauges(ctx context.Context, in *QueryExternalIncentiveGaugesRequest, opts ...grpc.CallOption) (*QueryExternalIncentiveGaugesResponse, error) {
 	out := new(QueryExternalIncentiveGaugesResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/ExternalIncentiveGauges", in, out, opts...)
@@ -829,6 +1034,10 @@ type QueryServer interface {
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
 	// IncentivizedPools returns currently incentivized pools
 	IncentivizedPools(context.Context, *QueryIncentivizedPoolsRequest) (*QueryIncentivizedPoolsResponse, error)
+	// IncentivizedPoolsWithGauges returns currently incentivized pools, together
+	// with their distribution record weight and the state and emissions of the
+	// gauges incentivizing them.
+	IncentivizedPoolsWithGauges(context.Context, *QueryIncentivizedPoolsWithGaugesRequest) (*QueryIncentivizedPoolsWithGaugesResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(context.Context, *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error)
 }
@@ -852,6 +1061,9 @@ func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) IncentivizedPools(ctx context.Context, req *QueryIncentivizedPoolsRequest) (*QueryIncentivizedPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPools not implemented")
 }
+func (*UnimplementedQueryServer) IncentivizedPoolsWithGauges(ctx context.Context, req *QueryIncentivizedPoolsWithGaugesRequest) (*QueryIncentivizedPoolsWithGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPoolsWithGauges not implemented")
+}
 func (*UnimplementedQueryServer) ExternalIncentiveGauges(ctx context.Context, req *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExternalIncentiveGauges not implemented")
 }
@@ -950,6 +1162,24 @@ func _Query_IncentivizedPools_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IncentivizedPoolsWithGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivizedPoolsWithGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivizedPoolsWithGauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolincentives.v1beta1.Query/IncentivizedPoolsWithGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivizedPoolsWithGauges(ctx, req.(*QueryIncentivizedPoolsWithGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ExternalIncentiveGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryExternalIncentiveGaugesRequest)
 	if err := dec(in); err != nil {
@@ -992,6 +1222,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IncentivizedPools",
 			Handler:    _Query_IncentivizedPools_Handler,
 		},
+		{
+			MethodName: "IncentivizedPoolsWithGauges",
+			Handler:    _Query_IncentivizedPoolsWithGauges_Handler,
+		},
 		{
 			MethodName: "ExternalIncentiveGauges",
 			Handler:    _Query_ExternalIncentiveGauges_Handler,
@@ -1380,6 +1614,148 @@ func (m *QueryIncentivizedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryIncentivizedPoolsWithGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPoolsWithGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPoolsWithGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivizedPoolWithGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivizedPoolWithGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivizedPoolWithGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentEpochEmission) > 0 {
+		for iNdEx := len(m.CurrentEpochEmission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentEpochEmission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RemainingEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RecordWeight.Size()
+		i -= size
+		if _, err := m.RecordWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.IncentivizedPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedPoolsWithGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPoolsWithGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPoolsWithGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.IncentivizedPools) > 0 {
+		for iNdEx := len(m.IncentivizedPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivizedPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryExternalIncentiveGaugesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1601,7 +1977,7 @@ func (m *QueryIncentivizedPoolsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryExternalIncentiveGaugesRequest) Size() (n int) {
+func (m *QueryIncentivizedPoolsWithGaugesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1610,14 +1986,23 @@ func (m *QueryExternalIncentiveGaugesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryExternalIncentiveGaugesResponse) Size() (n int) {
+func (m *IncentivizedPoolWithGauge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
+	l = m.IncentivizedPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecordWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Gauge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingEpochs))
+	}
+	if len(m.CurrentEpochEmission) > 0 {
+		for _, e := range m.CurrentEpochEmission {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -1625,13 +2010,54 @@ func (m *QueryExternalIncentiveGaugesResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryIncentivizedPoolsWithGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPools) > 0 {
+		for _, e := range m.IncentivizedPools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryGaugeIdsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *QueryExternalIncentiveGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExternalIncentiveGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGaugeIdsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2573,6 +2999,377 @@ func (m *QueryIncentivizedPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIncentivizedPoolsWithGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPoolsWithGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPoolsWithGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivizedPoolWithGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivizedPoolWithGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivizedPoolWithGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivizedPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingEpochs", wireType)
+			}
+			m.RemainingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochEmission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochEmission = append(m.CurrentEpochEmission, types1.Coin{})
+			if err := m.CurrentEpochEmission[len(m.CurrentEpochEmission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPoolsWithGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPoolsWithGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPoolsWithGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPools = append(m.IncentivizedPools, IncentivizedPoolWithGauge{})
+			if err := m.IncentivizedPools[len(m.IncentivizedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryExternalIncentiveGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
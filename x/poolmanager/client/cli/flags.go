@@ -27,6 +27,8 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to string.
+	FlagSwapsFile = "swaps-file"
 )
 
 type createBalancerPoolInputs struct {
@@ -70,6 +72,16 @@ type SwapAmountOutSplitRoute struct {
 	TokenOutAmount int64                      `json:"token_out_amount"`
 }
 
+type BatchSwapsIn struct {
+	Swaps []BatchSwapExactAmountIn `json:"swaps"`
+}
+
+type BatchSwapExactAmountIn struct {
+	Routes            []types.SwapAmountInRoute `json:"routes"`
+	TokenIn           string                    `json:"token_in"`
+	TokenOutMinAmount string                    `json:"token_out_min_amount"`
+}
+
 func FlagSetMultihopSwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(FlagSwapRoutePoolIds, "", "swap route pool id")
@@ -106,3 +118,10 @@ func FlagSetCreateRoutes() *flag.FlagSet {
 	fs.String(FlagRoutesFile, "", "Routes json file path (if this path is given, other routes flags should not be used)")
 	return fs
 }
+
+func FlagSetBatchSwaps() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSwapsFile, "", "Swaps json file path")
+	return fs
+}
@@ -33,6 +33,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdChainStatistics)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRoutesFromDenoms)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdNormalizedSpotPrice)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -116,6 +119,35 @@ func GetCmdListPoolsByDenom() (*osmocli.QueryDescriptor, *queryproto.ListPoolsBy
 	}, &queryproto.ListPoolsByDenomRequest{}
 }
 
+// GetCmdChainStatistics returns the chain-wide pool and OSMO supply statistics.
+func GetCmdChainStatistics() (*osmocli.QueryDescriptor, *queryproto.ChainStatisticsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "chain-statistics",
+		Short: "Query the total value locked in pools and the OSMO supply distribution, as of the last statistics epoch",
+		Long:  "{{.Short}}",
+	}, &queryproto.ChainStatisticsRequest{}
+}
+
+// GetCmdRoutesFromDenoms returns the routes swapping a token in denom for a token out denom, ranked by liquidity.
+func GetCmdRoutesFromDenoms() (*osmocli.QueryDescriptor, *queryproto.RoutesFromDenomsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "routes-from-denoms",
+		Short: "Query the routes of up to max hops pools swapping a token in denom for a token out denom, ranked by liquidity",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} routes-from-denoms uosmo uatom 3`,
+	}, &queryproto.RoutesFromDenomsRequest{}
+}
+
+// GetCmdNormalizedSpotPrice returns the spot price of a pool, scaled by the exponents of the display denoms of its assets.
+func GetCmdNormalizedSpotPrice() (*osmocli.QueryDescriptor, *queryproto.NormalizedSpotPriceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "normalized-spot-price",
+		Short: "Query the spot price of a base denom in a quote denom, scaled by the exponents of their display denoms",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} normalized-spot-price 1 uosmo ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`,
+	}, &queryproto.NormalizedSpotPriceRequest{}
+}
+
 func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
@@ -37,6 +37,7 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewBatchSwapExactAmountInCmd)
 	txCmd.AddCommand(NewSetDenomPairTakerFeeCmd())
 
 	txCmd.AddCommand(
@@ -162,6 +163,89 @@ func NewSplitRouteSwapExactAmountOut() (*osmocli.TxCliDesc, *types.MsgSplitRoute
 	}, &types.MsgSplitRouteSwapExactAmountOut{}
 }
 
+func NewBatchSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgBatchSwapExactAmountIn) {
+	return &osmocli.TxCliDesc{
+		Use:   "batch-swap-exact-amount-in",
+		Short: "execute multiple independent swap exact amount in atomically",
+		Example: `osmosisd tx poolmanager batch-swap-exact-amount-in --swaps-file="./swaps.json" --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo
+		- swaps.json
+		{
+			"swaps": [
+				{
+				"routes": [
+					{
+					"pool_id": 1,
+					"token_out_denom": "uion"
+					}
+				],
+				"token_in": "1000uosmo",
+				"token_out_min_amount": "1"
+				},
+				{
+				"routes": [
+					{
+					"pool_id": 3,
+					"token_out_denom": "bar"
+					},
+					{
+					"pool_id": 4,
+					"token_out_denom": "uosmo"
+					}
+				],
+				"token_in": "999uion",
+				"token_out_min_amount": "1"
+				}
+			]
+		}
+		`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Swaps": osmocli.FlagOnlyParser(NewMsgBatchSwapExactAmountInSwaps),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetBatchSwaps()},
+		},
+	}, &types.MsgBatchSwapExactAmountIn{}
+}
+
+func NewMsgBatchSwapExactAmountInSwaps(fs *flag.FlagSet) ([]types.BatchSwapExactAmountIn, error) {
+	swapsFile, _ := fs.GetString(FlagSwapsFile)
+	if swapsFile == "" {
+		return nil, fmt.Errorf("must pass in a swaps json using the --%s flag", FlagSwapsFile)
+	}
+
+	contents, err := os.ReadFile(swapsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchSwapsJSONdata BatchSwapsIn
+	err = json.Unmarshal(contents, &batchSwapsJSONdata)
+	if err != nil {
+		return nil, err
+	}
+
+	swaps := make([]types.BatchSwapExactAmountIn, 0, len(batchSwapsJSONdata.Swaps))
+	for _, swap := range batchSwapsJSONdata.Swaps {
+		tokenIn, err := sdk.ParseCoinNormalized(swap.TokenIn)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenOutMinAmount, ok := osmomath.NewIntFromString(swap.TokenOutMinAmount)
+		if !ok {
+			return nil, fmt.Errorf("invalid token out min amount: %s", swap.TokenOutMinAmount)
+		}
+
+		swaps = append(swaps, types.BatchSwapExactAmountIn{
+			Routes:            swap.Routes,
+			TokenIn:           tokenIn,
+			TokenOutMinAmount: tokenOutMinAmount,
+		})
+	}
+
+	return swaps, nil
+}
+
 func NewMsgNewSplitRouteSwapExactAmountOut(fs *flag.FlagSet) ([]types.SwapAmountOutSplitRoute, error) {
 	routesFile, _ := fs.GetString(FlagRoutesFile)
 	if routesFile == "" {
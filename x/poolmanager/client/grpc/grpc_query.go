@@ -71,6 +71,26 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) NormalizedSpotPrice(grpcCtx context.Context,
+	req *queryproto.NormalizedSpotPriceRequest,
+) (*queryproto.NormalizedSpotPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.NormalizedSpotPrice(ctx, *req)
+}
+
+func (q Querier) RoutesFromDenoms(grpcCtx context.Context,
+	req *queryproto.RoutesFromDenomsRequest,
+) (*queryproto.RoutesFromDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RoutesFromDenoms(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -181,6 +201,16 @@ func (q Querier) EstimateSinglePoolSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSinglePoolSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) ChainStatistics(grpcCtx context.Context,
+	req *queryproto.ChainStatisticsRequest,
+) (*queryproto.ChainStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ChainStatistics(ctx, *req)
+}
+
 func (q Querier) AllPools(grpcCtx context.Context,
 	req *queryproto.AllPoolsRequest,
 ) (*queryproto.AllPoolsResponse, error) {
@@ -236,6 +236,30 @@ func (q Querier) ListPoolsByDenom(ctx sdk.Context, req queryproto.ListPoolsByDen
 	}, nil
 }
 
+// RoutesFromDenoms returns the routes swapping the token in denom for the token out denom, ranked by liquidity.
+func (q Querier) RoutesFromDenoms(ctx sdk.Context, req queryproto.RoutesFromDenomsRequest) (*queryproto.RoutesFromDenomsResponse, error) {
+	if req.TokenInDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in denom")
+	}
+
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	if req.TokenInDenom == req.TokenOutDenom {
+		return nil, status.Error(codes.InvalidArgument, "token in denom and token out denom must be different")
+	}
+
+	routes, err := q.K.RoutesFromDenoms(ctx, req.TokenInDenom, req.TokenOutDenom, req.MaxHops)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.RoutesFromDenomsResponse{
+		Routes: routes,
+	}, nil
+}
+
 // SpotPrice returns the spot price of the pool with the given quote and base asset denoms. 18 decimals.
 func (q Querier) SpotPrice(ctx sdk.Context, req queryproto.SpotPriceRequest) (*queryproto.SpotPriceResponse, error) {
 	if req.BaseAssetDenom == "" {
@@ -279,6 +303,29 @@ func (q QuerierV2) SpotPriceV2(ctx sdk.Context, req queryprotov2.SpotPriceReques
 	}, err
 }
 
+// NormalizedSpotPrice returns the spot price of the pool with the given quote and base asset denoms, scaled by the
+// exponents of their display denoms.
+func (q Querier) NormalizedSpotPrice(ctx sdk.Context, req queryproto.NormalizedSpotPriceRequest) (*queryproto.NormalizedSpotPriceResponse, error) {
+	if req.BaseAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid base asset denom")
+	}
+
+	if req.QuoteAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote asset denom")
+	}
+
+	sp, baseAssetExponent, quoteAssetExponent, err := q.K.RouteCalculateNormalizedSpotPrice(ctx, req.PoolId, req.QuoteAssetDenom, req.BaseAssetDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.NormalizedSpotPriceResponse{
+		SpotPrice:          sp,
+		BaseAssetExponent:  baseAssetExponent,
+		QuoteAssetExponent: quoteAssetExponent,
+	}, nil
+}
+
 // TotalPoolLiquidity returns the total liquidity of the pool.
 func (q Querier) TotalPoolLiquidity(ctx sdk.Context, req queryproto.TotalPoolLiquidityRequest) (*queryproto.TotalPoolLiquidityResponse, error) {
 	if req.PoolId == 0 {
@@ -311,6 +358,18 @@ func (q Querier) TotalLiquidity(ctx sdk.Context, req queryproto.TotalLiquidityRe
 	}, nil
 }
 
+// ChainStatistics returns the chain statistics computed at the end of the last statistics epoch.
+func (q Querier) ChainStatistics(ctx sdk.Context, req queryproto.ChainStatisticsRequest) (*queryproto.ChainStatisticsResponse, error) {
+	chainStatistics, err := q.K.GetChainStatistics(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &queryproto.ChainStatisticsResponse{
+		Statistics: chainStatistics,
+	}, nil
+}
+
 // TotalVolumeForPool returns the total volume of the pool.
 func (q Querier) TotalVolumeForPool(ctx sdk.Context, req queryproto.TotalVolumeForPoolRequest) (*queryproto.TotalVolumeForPoolResponse, error) {
 	totalVolume := q.K.GetTotalVolumeForPool(ctx, req.PoolId)
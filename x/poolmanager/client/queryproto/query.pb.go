@@ -15,6 +15,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_osmosis_labs_osmosis_osmomath "github.com/osmosis-labs/osmosis/osmomath"
 	types "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -931,6 +932,114 @@ func (m *ListPoolsByDenomResponse) GetPools() []*types1.Any {
 	return nil
 }
 
+// =============================== RoutesFromDenoms
+type RoutesFromDenomsRequest struct {
+	TokenInDenom  string `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools of the returned routes, between 1
+	// and 3.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+}
+
+func (m *RoutesFromDenomsRequest) Reset()         { *m = RoutesFromDenomsRequest{} }
+func (m *RoutesFromDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*RoutesFromDenomsRequest) ProtoMessage()    {}
+func (*RoutesFromDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{18}
+}
+func (m *RoutesFromDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutesFromDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutesFromDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoutesFromDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutesFromDenomsRequest.Merge(m, src)
+}
+func (m *RoutesFromDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutesFromDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutesFromDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutesFromDenomsRequest proto.InternalMessageInfo
+
+func (m *RoutesFromDenomsRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *RoutesFromDenomsRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *RoutesFromDenomsRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+type RoutesFromDenomsResponse struct {
+	// routes are ranked by liquidity in descending order.
+	Routes []types.RouteWithLiquidity `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *RoutesFromDenomsResponse) Reset()         { *m = RoutesFromDenomsResponse{} }
+func (m *RoutesFromDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*RoutesFromDenomsResponse) ProtoMessage()    {}
+func (*RoutesFromDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{19}
+}
+func (m *RoutesFromDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutesFromDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutesFromDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoutesFromDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutesFromDenomsResponse.Merge(m, src)
+}
+func (m *RoutesFromDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutesFromDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutesFromDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutesFromDenomsResponse proto.InternalMessageInfo
+
+func (m *RoutesFromDenomsResponse) GetRoutes() []types.RouteWithLiquidity {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 // ==========================================================
 // SpotPriceRequest defines the gRPC request structure for a SpotPrice
 // query.
@@ -944,7 +1053,7 @@ func (m *SpotPriceRequest) Reset()         { *m = SpotPriceRequest{} }
 func (m *SpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*SpotPriceRequest) ProtoMessage()    {}
 func (*SpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{18}
+	return fileDescriptor_6256a4106f701b7d, []int{20}
 }
 func (m *SpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,7 +1114,7 @@ func (m *SpotPriceResponse) Reset()         { *m = SpotPriceResponse{} }
 func (m *SpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*SpotPriceResponse) ProtoMessage()    {}
 func (*SpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{19}
+	return fileDescriptor_6256a4106f701b7d, []int{21}
 }
 func (m *SpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,6 +1150,125 @@ func (m *SpotPriceResponse) GetSpotPrice() string {
 	return ""
 }
 
+// =============================== NormalizedSpotPrice
+type NormalizedSpotPriceRequest struct {
+	PoolId          uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	BaseAssetDenom  string `protobuf:"bytes,2,opt,name=base_asset_denom,json=baseAssetDenom,proto3" json:"base_asset_denom,omitempty" yaml:"base_asset_denom"`
+	QuoteAssetDenom string `protobuf:"bytes,3,opt,name=quote_asset_denom,json=quoteAssetDenom,proto3" json:"quote_asset_denom,omitempty" yaml:"quote_asset_denom"`
+}
+
+func (m *NormalizedSpotPriceRequest) Reset()         { *m = NormalizedSpotPriceRequest{} }
+func (m *NormalizedSpotPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*NormalizedSpotPriceRequest) ProtoMessage()    {}
+func (*NormalizedSpotPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{22}
+}
+func (m *NormalizedSpotPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NormalizedSpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NormalizedSpotPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NormalizedSpotPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NormalizedSpotPriceRequest.Merge(m, src)
+}
+func (m *NormalizedSpotPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NormalizedSpotPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NormalizedSpotPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NormalizedSpotPriceRequest proto.InternalMessageInfo
+
+func (m *NormalizedSpotPriceRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *NormalizedSpotPriceRequest) GetBaseAssetDenom() string {
+	if m != nil {
+		return m.BaseAssetDenom
+	}
+	return ""
+}
+
+func (m *NormalizedSpotPriceRequest) GetQuoteAssetDenom() string {
+	if m != nil {
+		return m.QuoteAssetDenom
+	}
+	return ""
+}
+
+type NormalizedSpotPriceResponse struct {
+	// spot_price is the price of one display unit of the base asset in display
+	// units of the quote asset.
+	SpotPrice github_com_osmosis_labs_osmosis_osmomath.BigDec `protobuf:"bytes,1,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/osmosis-labs/osmosis/osmomath.BigDec" json:"spot_price" yaml:"spot_price"`
+	// base_asset_exponent and quote_asset_exponent are the exponents of the
+	// display denoms of the base and quote assets, zero if they have no bank
+	// metadata.
+	BaseAssetExponent  uint32 `protobuf:"varint,2,opt,name=base_asset_exponent,json=baseAssetExponent,proto3" json:"base_asset_exponent,omitempty" yaml:"base_asset_exponent"`
+	QuoteAssetExponent uint32 `protobuf:"varint,3,opt,name=quote_asset_exponent,json=quoteAssetExponent,proto3" json:"quote_asset_exponent,omitempty" yaml:"quote_asset_exponent"`
+}
+
+func (m *NormalizedSpotPriceResponse) Reset()         { *m = NormalizedSpotPriceResponse{} }
+func (m *NormalizedSpotPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*NormalizedSpotPriceResponse) ProtoMessage()    {}
+func (*NormalizedSpotPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{23}
+}
+func (m *NormalizedSpotPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NormalizedSpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NormalizedSpotPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NormalizedSpotPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NormalizedSpotPriceResponse.Merge(m, src)
+}
+func (m *NormalizedSpotPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NormalizedSpotPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NormalizedSpotPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NormalizedSpotPriceResponse proto.InternalMessageInfo
+
+func (m *NormalizedSpotPriceResponse) GetBaseAssetExponent() uint32 {
+	if m != nil {
+		return m.BaseAssetExponent
+	}
+	return 0
+}
+
+func (m *NormalizedSpotPriceResponse) GetQuoteAssetExponent() uint32 {
+	if m != nil {
+		return m.QuoteAssetExponent
+	}
+	return 0
+}
+
 // =============================== TotalPoolLiquidity
 type TotalPoolLiquidityRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -1050,7 +1278,7 @@ func (m *TotalPoolLiquidityRequest) Reset()         { *m = TotalPoolLiquidityReq
 func (m *TotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalPoolLiquidityRequest) ProtoMessage()    {}
 func (*TotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{20}
+	return fileDescriptor_6256a4106f701b7d, []int{24}
 }
 func (m *TotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,7 +1322,7 @@ func (m *TotalPoolLiquidityResponse) Reset()         { *m = TotalPoolLiquidityRe
 func (m *TotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalPoolLiquidityResponse) ProtoMessage()    {}
 func (*TotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{21}
+	return fileDescriptor_6256a4106f701b7d, []int{25}
 }
 func (m *TotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1366,7 @@ func (m *TotalLiquidityRequest) Reset()         { *m = TotalLiquidityRequest{} }
 func (m *TotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalLiquidityRequest) ProtoMessage()    {}
 func (*TotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{22}
+	return fileDescriptor_6256a4106f701b7d, []int{26}
 }
 func (m *TotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1175,7 +1403,7 @@ func (m *TotalLiquidityResponse) Reset()         { *m = TotalLiquidityResponse{}
 func (m *TotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalLiquidityResponse) ProtoMessage()    {}
 func (*TotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{23}
+	return fileDescriptor_6256a4106f701b7d, []int{27}
 }
 func (m *TotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1211,6 +1439,87 @@ func (m *TotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+// =============================== ChainStatistics
+type ChainStatisticsRequest struct {
+}
+
+func (m *ChainStatisticsRequest) Reset()         { *m = ChainStatisticsRequest{} }
+func (m *ChainStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*ChainStatisticsRequest) ProtoMessage()    {}
+func (*ChainStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{28}
+}
+func (m *ChainStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStatisticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStatisticsRequest.Merge(m, src)
+}
+func (m *ChainStatisticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStatisticsRequest proto.InternalMessageInfo
+
+type ChainStatisticsResponse struct {
+	Statistics types.ChainStatistics `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics" yaml:"statistics"`
+}
+
+func (m *ChainStatisticsResponse) Reset()         { *m = ChainStatisticsResponse{} }
+func (m *ChainStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainStatisticsResponse) ProtoMessage()    {}
+func (*ChainStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{29}
+}
+func (m *ChainStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStatisticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStatisticsResponse.Merge(m, src)
+}
+func (m *ChainStatisticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStatisticsResponse proto.InternalMessageInfo
+
+func (m *ChainStatisticsResponse) GetStatistics() types.ChainStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return types.ChainStatistics{}
+}
+
 // =============================== TotalVolumeForPool
 type TotalVolumeForPoolRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -1220,7 +1529,7 @@ func (m *TotalVolumeForPoolRequest) Reset()         { *m = TotalVolumeForPoolReq
 func (m *TotalVolumeForPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalVolumeForPoolRequest) ProtoMessage()    {}
 func (*TotalVolumeForPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{24}
+	return fileDescriptor_6256a4106f701b7d, []int{30}
 }
 func (m *TotalVolumeForPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1264,7 +1573,7 @@ func (m *TotalVolumeForPoolResponse) Reset()         { *m = TotalVolumeForPoolRe
 func (m *TotalVolumeForPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalVolumeForPoolResponse) ProtoMessage()    {}
 func (*TotalVolumeForPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{25}
+	return fileDescriptor_6256a4106f701b7d, []int{31}
 }
 func (m *TotalVolumeForPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1619,7 @@ func (m *TradingPairTakerFeeRequest) Reset()         { *m = TradingPairTakerFeeR
 func (m *TradingPairTakerFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeRequest) ProtoMessage()    {}
 func (*TradingPairTakerFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{26}
+	return fileDescriptor_6256a4106f701b7d, []int{32}
 }
 func (m *TradingPairTakerFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1670,7 @@ func (m *TradingPairTakerFeeResponse) Reset()         { *m = TradingPairTakerFee
 func (m *TradingPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeResponse) ProtoMessage()    {}
 func (*TradingPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{27}
+	return fileDescriptor_6256a4106f701b7d, []int{33}
 }
 func (m *TradingPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1417,7 +1726,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactRequest) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{28}
+	return fileDescriptor_6256a4106f701b7d, []int{34}
 }
 func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,7 +1795,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactResponse) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{29}
+	return fileDescriptor_6256a4106f701b7d, []int{35}
 }
 func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,12 +1857,18 @@ func init() {
 	proto.RegisterType((*AllPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllPoolsResponse")
 	proto.RegisterType((*ListPoolsByDenomRequest)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomRequest")
 	proto.RegisterType((*ListPoolsByDenomResponse)(nil), "osmosis.poolmanager.v1beta1.ListPoolsByDenomResponse")
+	proto.RegisterType((*RoutesFromDenomsRequest)(nil), "osmosis.poolmanager.v1beta1.RoutesFromDenomsRequest")
+	proto.RegisterType((*RoutesFromDenomsResponse)(nil), "osmosis.poolmanager.v1beta1.RoutesFromDenomsResponse")
 	proto.RegisterType((*SpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.SpotPriceRequest")
 	proto.RegisterType((*SpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.SpotPriceResponse")
+	proto.RegisterType((*NormalizedSpotPriceRequest)(nil), "osmosis.poolmanager.v1beta1.NormalizedSpotPriceRequest")
+	proto.RegisterType((*NormalizedSpotPriceResponse)(nil), "osmosis.poolmanager.v1beta1.NormalizedSpotPriceResponse")
 	proto.RegisterType((*TotalPoolLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityRequest")
 	proto.RegisterType((*TotalPoolLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalPoolLiquidityResponse")
 	proto.RegisterType((*TotalLiquidityRequest)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityRequest")
 	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
+	proto.RegisterType((*ChainStatisticsRequest)(nil), "osmosis.poolmanager.v1beta1.ChainStatisticsRequest")
+	proto.RegisterType((*ChainStatisticsResponse)(nil), "osmosis.poolmanager.v1beta1.ChainStatisticsResponse")
 	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
 	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
 	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
@@ -1567,135 +1882,156 @@ func init() {
 }
 
 var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x73, 0x1b, 0x49,
-	0xf5, 0xcf, 0xc8, 0x3f, 0xd6, 0x7a, 0x8e, 0x65, 0xa5, 0x13, 0x27, 0xb6, 0x92, 0xaf, 0xe5, 0xed,
-	0xec, 0x37, 0xeb, 0x8d, 0xa3, 0x51, 0x6c, 0x27, 0x24, 0x04, 0x76, 0x83, 0x64, 0x3b, 0x1b, 0x41,
-	0x20, 0xde, 0x89, 0xf7, 0x07, 0x0b, 0x61, 0x6a, 0x6c, 0x75, 0x94, 0x21, 0x9a, 0x19, 0x45, 0xd3,
-	0xe3, 0x58, 0x45, 0xed, 0x85, 0x2a, 0x0a, 0x2e, 0x50, 0x0b, 0x1c, 0xf6, 0xc0, 0x81, 0xe2, 0xc0,
-	0x85, 0x1f, 0x37, 0x38, 0x70, 0xe7, 0x90, 0xa2, 0x0a, 0x2a, 0x55, 0x70, 0xa0, 0x38, 0x08, 0x2a,
-	0xe1, 0x40, 0x15, 0x14, 0x07, 0xf1, 0x0f, 0x50, 0xfd, 0x63, 0x46, 0xd2, 0x58, 0x1a, 0x8d, 0xe4,
-	0x1c, 0x38, 0x45, 0xee, 0x7e, 0xef, 0xf5, 0xfb, 0x7c, 0xfa, 0x7d, 0xba, 0xfb, 0x4d, 0xe0, 0x75,
-	0xc7, 0xb5, 0x1c, 0xd7, 0x74, 0xf3, 0x35, 0xc7, 0xa9, 0x5a, 0x86, 0x6d, 0x54, 0x48, 0x3d, 0xbf,
-	0xbf, 0xba, 0x4b, 0xa8, 0xb1, 0x9a, 0x7f, 0xec, 0x91, 0x7a, 0x43, 0xad, 0xd5, 0x1d, 0xea, 0xa0,
-	0xb3, 0xd2, 0x50, 0xed, 0x30, 0x54, 0xa5, 0x61, 0xe6, 0x54, 0xc5, 0xa9, 0x38, 0xdc, 0x2e, 0xcf,
-	0x7e, 0x09, 0x97, 0xcc, 0x1b, 0x51, 0xb1, 0x2b, 0xc4, 0x26, 0x3c, 0x1c, 0x37, 0x7d, 0x2d, 0xca,
-	0x94, 0x1e, 0x48, 0xab, 0x4b, 0x51, 0x56, 0xee, 0x13, 0xa3, 0xa6, 0xd7, 0x1d, 0x8f, 0x12, 0x69,
-	0xbd, 0xb8, 0xc7, 0xcd, 0xf3, 0xbb, 0x86, 0x4b, 0x02, 0xab, 0x3d, 0xc7, 0xb4, 0xe5, 0xfc, 0xc5,
-	0xce, 0x79, 0x0e, 0x35, 0xb0, 0xaa, 0x19, 0x15, 0xd3, 0x36, 0xa8, 0xe9, 0xf8, 0xb6, 0xe7, 0x2a,
-	0x8e, 0x53, 0xa9, 0x92, 0xbc, 0x51, 0x33, 0xf3, 0x86, 0x6d, 0x3b, 0x94, 0x4f, 0xfa, 0xd9, 0x2f,
-	0xc8, 0x59, 0xfe, 0xd7, 0xae, 0xf7, 0x20, 0x6f, 0xd8, 0x0d, 0x7f, 0x4a, 0x2c, 0xa2, 0x0b, 0x72,
-	0xc4, 0x1f, 0x72, 0x2a, 0x1b, 0xf6, 0xa2, 0xa6, 0x45, 0x5c, 0x6a, 0x58, 0x35, 0x61, 0x80, 0x67,
-	0x61, 0x66, 0xdb, 0xa8, 0x1b, 0x96, 0xab, 0x91, 0xc7, 0x1e, 0x71, 0x29, 0xbe, 0x07, 0x29, 0x7f,
-	0xc0, 0xad, 0x39, 0xb6, 0x4b, 0x50, 0x01, 0x26, 0x6b, 0x7c, 0x64, 0x5e, 0x59, 0x52, 0x96, 0xa7,
-	0xd7, 0xce, 0xab, 0x11, 0xdb, 0xa4, 0x0a, 0xe7, 0xe2, 0xf8, 0xd3, 0x66, 0xf6, 0x98, 0x26, 0x1d,
-	0xf1, 0xbf, 0x15, 0x58, 0xda, 0x72, 0xa9, 0x69, 0x19, 0x94, 0xdc, 0x7b, 0x62, 0xd4, 0xb6, 0x0e,
-	0x8c, 0x3d, 0x5a, 0xb0, 0x1c, 0xcf, 0xa6, 0x25, 0x5b, 0xae, 0x8c, 0x72, 0xf0, 0x0a, 0x0b, 0xa8,
-	0x9b, 0xe5, 0xf9, 0xc4, 0x92, 0xb2, 0x3c, 0x5e, 0x3c, 0xd5, 0x6a, 0x66, 0x53, 0x0d, 0xc3, 0xaa,
-	0xde, 0xc0, 0x72, 0x02, 0xcf, 0x2b, 0xda, 0x24, 0xfb, 0x5d, 0x2a, 0x23, 0x15, 0xa6, 0xa8, 0xf3,
-	0x88, 0xd8, 0xba, 0x69, 0xcf, 0x8f, 0x2d, 0x29, 0xcb, 0xc9, 0xe2, 0xc9, 0x56, 0x33, 0x3b, 0x2b,
-	0xec, 0xfd, 0x19, 0xac, 0xbd, 0xc2, 0x7f, 0x96, 0x6c, 0x74, 0x1f, 0x26, 0xf9, 0xce, 0xb9, 0xf3,
-	0xe3, 0x4b, 0x63, 0xcb, 0xd3, 0x6b, 0x6a, 0x24, 0x0c, 0x96, 0x65, 0x90, 0x20, 0x73, 0x2b, 0xce,
-	0x31, 0x44, 0xad, 0x66, 0x76, 0x46, 0xac, 0x20, 0x62, 0x61, 0x4d, 0x06, 0xfd, 0xfc, 0xf8, 0x94,
-	0x92, 0x4e, 0x68, 0x93, 0x2e, 0xb1, 0xcb, 0xa4, 0x8e, 0x7f, 0x91, 0x80, 0xb5, 0xbe, 0x80, 0xdf,
-	0x37, 0xe9, 0xc3, 0xed, 0xba, 0x69, 0x99, 0xd4, 0xdc, 0x27, 0x3b, 0x8d, 0x1a, 0x71, 0x7b, 0x50,
-	0xa0, 0x0c, 0x49, 0x41, 0x22, 0x06, 0x05, 0x37, 0x21, 0x25, 0xb2, 0xd5, 0xfd, 0x55, 0xc6, 0x96,
-	0xc6, 0x96, 0xc7, 0x8b, 0x0b, 0xad, 0x66, 0x76, 0xae, 0x13, 0x96, 0x3f, 0x8f, 0xb5, 0xe3, 0x62,
-	0x60, 0x5b, 0x2c, 0xf8, 0x1e, 0x9c, 0x96, 0x06, 0x22, 0xba, 0xe3, 0x51, 0xbd, 0x4c, 0x6c, 0xc7,
-	0xe2, 0x9c, 0x26, 0x8b, 0xaf, 0xb6, 0x9a, 0xd9, 0xff, 0xeb, 0x0a, 0x14, 0xb2, 0xc3, 0xda, 0x49,
-	0x31, 0xb1, 0xc3, 0xc6, 0xef, 0x7a, 0x74, 0x93, 0x8f, 0xfe, 0x5e, 0x81, 0x8b, 0x01, 0x5d, 0xa6,
-	0x5d, 0xa9, 0x12, 0xb6, 0x60, 0xdf, 0x4a, 0x59, 0x09, 0xd3, 0x84, 0x0e, 0xd3, 0x34, 0x32, 0x49,
-	0x45, 0x98, 0x0d, 0x83, 0x13, 0xe5, 0x95, 0x69, 0x35, 0xb3, 0xa7, 0x3b, 0xdd, 0x3a, 0x50, 0xcd,
-	0xd0, 0x2e, 0x3c, 0xdf, 0x56, 0xe0, 0xd5, 0x88, 0x7a, 0x97, 0xc2, 0xda, 0x85, 0x74, 0x3b, 0x90,
-	0xc1, 0x67, 0x39, 0x9e, 0x64, 0xf1, 0x3a, 0xab, 0xb5, 0xbf, 0x34, 0xb3, 0x73, 0x42, 0xcc, 0x6e,
-	0xf9, 0x91, 0x6a, 0x3a, 0x79, 0xcb, 0xa0, 0x0f, 0xd5, 0x92, 0x4d, 0x5b, 0xcd, 0xec, 0x99, 0x70,
-	0x1e, 0xc2, 0x1d, 0x6b, 0x29, 0x3f, 0x11, 0xb1, 0x1a, 0xfe, 0x4f, 0xff, 0x4c, 0xee, 0x7a, 0x74,
-	0x44, 0xe9, 0x7d, 0x2d, 0x90, 0xd2, 0x18, 0x97, 0x52, 0x3e, 0xa6, 0x94, 0xd8, 0x8a, 0x31, 0xb4,
-	0x84, 0x56, 0x21, 0x19, 0x20, 0x9b, 0x1f, 0xe7, 0x8c, 0xb0, 0x84, 0xd2, 0x21, 0xd0, 0x58, 0x9b,
-	0xf2, 0xd1, 0x86, 0xe4, 0xf7, 0xcb, 0x04, 0xac, 0xf7, 0x47, 0xfd, 0xd2, 0xf4, 0x77, 0x58, 0x4f,
-	0x89, 0xe1, 0xf4, 0x74, 0x0f, 0xe6, 0xba, 0x74, 0x62, 0xda, 0x41, 0xc5, 0x31, 0x39, 0x2d, 0xb5,
-	0x9a, 0xd9, 0x73, 0x3d, 0xe4, 0xe4, 0x9b, 0x61, 0x0d, 0x75, 0xa8, 0xa9, 0x64, 0xf3, 0xe2, 0x1b,
-	0x81, 0x3d, 0xfc, 0x07, 0x05, 0x56, 0x06, 0xea, 0xaf, 0xa3, 0x5e, 0x86, 0x12, 0xe0, 0x4d, 0x48,
-	0x85, 0xd0, 0x09, 0x19, 0x76, 0xb0, 0x14, 0x86, 0x75, 0x9c, 0xf6, 0x05, 0x34, 0x16, 0x0b, 0xd0,
-	0xb7, 0x14, 0xc0, 0x51, 0x65, 0x2f, 0x15, 0xa8, 0xfb, 0x5a, 0x37, 0xed, 0x6e, 0x01, 0x5e, 0x1b,
-	0x24, 0xc0, 0xd3, 0xa1, 0xc4, 0x7d, 0xfd, 0xcd, 0xc8, 0xcc, 0xa5, 0xfc, 0x4e, 0xc0, 0xec, 0x97,
-	0x3c, 0x8b, 0x91, 0x19, 0x5c, 0xb0, 0x5b, 0x90, 0x6e, 0x0f, 0xc9, 0x3c, 0x56, 0x21, 0x69, 0x7b,
-	0x16, 0xaf, 0x12, 0xb7, 0xa3, 0xf2, 0x24, 0xc2, 0x60, 0x0a, 0x6b, 0x53, 0xb6, 0x74, 0xc5, 0x37,
-	0x60, 0x9a, 0xfd, 0x18, 0x65, 0x47, 0xf0, 0x06, 0x1c, 0x17, 0xbe, 0x72, 0xf9, 0x75, 0x18, 0x67,
-	0x33, 0xf2, 0x7e, 0x3f, 0xa5, 0x8a, 0x47, 0x83, 0xea, 0x3f, 0x1a, 0xd4, 0x82, 0xdd, 0x28, 0x26,
-	0x7f, 0xf7, 0xab, 0xdc, 0x04, 0x2f, 0x5b, 0x8d, 0x1b, 0x33, 0x68, 0x85, 0x6a, 0xb5, 0x0b, 0x5a,
-	0x09, 0xd2, 0xed, 0x21, 0x19, 0xfb, 0x2a, 0x4c, 0xf8, 0xb0, 0xc6, 0xe2, 0x04, 0x17, 0xd6, 0xb8,
-	0x00, 0x67, 0xee, 0x98, 0x2e, 0xe5, 0xb1, 0x8a, 0x0d, 0x5e, 0x07, 0x3e, 0xd4, 0x0b, 0x30, 0x21,
-	0xca, 0x48, 0x6c, 0x55, 0xba, 0xd5, 0xcc, 0x1e, 0x17, 0x40, 0x65, 0xf5, 0x88, 0x69, 0xfc, 0x0e,
-	0xcc, 0x1f, 0x0e, 0x71, 0xb4, 0xac, 0x9e, 0x29, 0x90, 0xbe, 0x57, 0x73, 0xe8, 0x76, 0xdd, 0xdc,
-	0x23, 0x23, 0x89, 0x61, 0x0b, 0xd2, 0xec, 0x2d, 0xa8, 0x1b, 0xae, 0x4b, 0x68, 0x97, 0x1c, 0xce,
-	0xb6, 0x8f, 0xf5, 0xb0, 0x05, 0xd6, 0x52, 0x6c, 0xa8, 0xc0, 0x46, 0x84, 0x24, 0x6e, 0xc3, 0x89,
-	0xc7, 0x9e, 0x43, 0xbb, 0xe3, 0x08, 0x69, 0x9c, 0x6b, 0x35, 0xb3, 0xf3, 0x22, 0xce, 0x21, 0x13,
-	0xac, 0xcd, 0xf2, 0xb1, 0x76, 0x24, 0x5c, 0x82, 0x13, 0x1d, 0x88, 0x24, 0x3d, 0x57, 0x00, 0xdc,
-	0x9a, 0x43, 0xf5, 0x1a, 0x1b, 0x95, 0x3c, 0xcf, 0xb5, 0x9a, 0xd9, 0x13, 0x22, 0x6e, 0x7b, 0x0e,
-	0x6b, 0x49, 0xd7, 0xf7, 0xc6, 0xb7, 0x61, 0x61, 0xc7, 0xa1, 0x06, 0x2f, 0x80, 0x3b, 0xe6, 0x63,
-	0xcf, 0x2c, 0x9b, 0xb4, 0x31, 0x52, 0x81, 0xfe, 0x48, 0x81, 0x4c, 0xaf, 0x50, 0x32, 0xbd, 0x8f,
-	0x20, 0x59, 0xf5, 0x07, 0xe5, 0x0e, 0x2e, 0xa8, 0xf2, 0xdd, 0xcb, 0x88, 0x0a, 0xae, 0x9e, 0x0d,
-	0xc7, 0xb4, 0x8b, 0x9b, 0xf2, 0xb2, 0x91, 0x6a, 0x0a, 0x3c, 0xf1, 0xcf, 0xfe, 0x9a, 0x5d, 0xae,
-	0x98, 0xf4, 0xa1, 0xb7, 0xab, 0xee, 0x39, 0x96, 0x7c, 0x38, 0xcb, 0x7f, 0x72, 0x6e, 0xf9, 0x51,
-	0x9e, 0xb2, 0xbb, 0x81, 0x07, 0x71, 0xb5, 0xf6, 0x8a, 0xf8, 0x0c, 0xcc, 0xf1, 0xe4, 0xc2, 0x18,
-	0xf1, 0x27, 0x0a, 0x9c, 0x0e, 0xcf, 0xfc, 0x6f, 0xa4, 0xec, 0x6f, 0xcd, 0x7b, 0x4e, 0xd5, 0xb3,
-	0xc8, 0x2d, 0xa7, 0x3e, 0xf2, 0xd9, 0xf1, 0x03, 0x7f, 0x6b, 0x42, 0xa1, 0x24, 0x4e, 0x0a, 0x93,
-	0xfb, 0x7c, 0x62, 0x30, 0xc8, 0x42, 0xf7, 0x23, 0x40, 0xb8, 0x0d, 0x87, 0x50, 0xae, 0x85, 0xf7,
-	0x21, 0xb3, 0x53, 0x37, 0xca, 0xa6, 0x5d, 0xd9, 0x36, 0xcc, 0xfa, 0x8e, 0xf1, 0x88, 0xd4, 0x6f,
-	0x91, 0x4e, 0x81, 0xf2, 0xea, 0xd7, 0x2f, 0xcb, 0x52, 0xee, 0xc0, 0x27, 0x27, 0xb0, 0x36, 0xc9,
-	0x7f, 0x5d, 0x6e, 0x1b, 0xaf, 0x4a, 0x5d, 0x1e, 0x32, 0x5e, 0xf5, 0x8d, 0x57, 0xf1, 0xd7, 0xe1,
-	0x6c, 0xcf, 0x75, 0x25, 0x19, 0x5f, 0x80, 0x24, 0x65, 0x63, 0xfa, 0x03, 0xe2, 0xab, 0x48, 0x95,
-	0x17, 0xcb, 0x85, 0x18, 0x18, 0x37, 0xc9, 0x9e, 0x36, 0x45, 0x65, 0x50, 0xfc, 0xa7, 0x04, 0x5c,
-	0xf0, 0xaf, 0x34, 0xb6, 0x28, 0x29, 0x1a, 0x2e, 0x29, 0xdf, 0xb5, 0xb9, 0xf6, 0x4a, 0x56, 0xcd,
-	0xd8, 0x0b, 0xae, 0xe7, 0xcf, 0x42, 0xf2, 0x41, 0xdd, 0xb1, 0x74, 0xd6, 0x88, 0xca, 0x43, 0x3d,
-	0x62, 0x1f, 0x44, 0xab, 0x36, 0xc5, 0x3c, 0xd8, 0xdf, 0x08, 0xc3, 0x0c, 0x75, 0xb8, 0x6f, 0xe7,
-	0xf9, 0xa4, 0x4d, 0x53, 0x87, 0x4d, 0x8b, 0xf3, 0xe7, 0x4c, 0xbb, 0x64, 0xd8, 0xa9, 0x33, 0x1e,
-	0x9c, 0x6f, 0x1f, 0x40, 0xda, 0x32, 0x0e, 0xc4, 0xe1, 0xa0, 0x9b, 0x3c, 0x2b, 0xf9, 0x06, 0x19,
-	0x16, 0x79, 0xca, 0x32, 0x0e, 0x3a, 0xb0, 0xa1, 0x77, 0x21, 0x45, 0x0e, 0x28, 0xa9, 0xdb, 0x46,
-	0x55, 0x9e, 0x4b, 0x13, 0x23, 0xc5, 0x9d, 0xf1, 0xa3, 0x88, 0x43, 0xeb, 0xe7, 0x0a, 0xbc, 0x3e,
-	0x90, 0x56, 0xb9, 0x9f, 0x6f, 0x01, 0x98, 0x76, 0xcd, 0xa3, 0x43, 0x11, 0x9b, 0xe4, 0x2e, 0x9c,
-	0xd9, 0xcf, 0xc1, 0xb4, 0xe3, 0xd1, 0x20, 0x40, 0x22, 0x5e, 0x00, 0x10, 0x3e, 0x6c, 0x64, 0xed,
-	0xbb, 0xe7, 0x60, 0xe2, 0x1d, 0x8f, 0xd4, 0x1b, 0xe8, 0x7b, 0x0a, 0x4c, 0x8a, 0x5e, 0x1b, 0x5d,
-	0x8c, 0xd1, 0x90, 0xcb, 0xd2, 0xc8, 0xac, 0xc4, 0xb2, 0x15, 0x78, 0xf1, 0xca, 0x37, 0xff, 0xf8,
-	0xf7, 0x1f, 0x26, 0xfe, 0x1f, 0x9d, 0xcf, 0x47, 0x7d, 0x14, 0x91, 0x59, 0xfc, 0x43, 0x81, 0x85,
-	0xbe, 0x3d, 0x0f, 0x7a, 0x33, 0x72, 0xdd, 0x41, 0xdf, 0x06, 0x32, 0x6f, 0x8d, 0xea, 0x2e, 0x91,
-	0xdc, 0xe1, 0x48, 0x6e, 0xa1, 0xcd, 0x48, 0x24, 0xdf, 0x90, 0x35, 0xfd, 0x51, 0x9e, 0xc8, 0x88,
-	0xe2, 0x8b, 0x0f, 0x61, 0x31, 0xe5, 0x13, 0x4f, 0x37, 0x6d, 0xf4, 0x93, 0x44, 0xc7, 0x73, 0x79,
-	0x70, 0x77, 0x8f, 0xee, 0x8e, 0x96, 0x7d, 0xdf, 0x3e, 0xe5, 0xc8, 0x74, 0x18, 0x9c, 0x8e, 0xaf,
-	0xa0, 0x2f, 0xbf, 0x0c, 0x3a, 0xf4, 0x27, 0x26, 0x7d, 0xc8, 0x24, 0x29, 0x12, 0xd5, 0xb9, 0xd4,
-	0xd0, 0x77, 0x12, 0x70, 0x3e, 0x46, 0x4b, 0x8f, 0xde, 0x8e, 0x07, 0x65, 0xe0, 0x47, 0x81, 0x23,
-	0x73, 0xf2, 0x01, 0xe7, 0x44, 0x43, 0xdb, 0x43, 0x73, 0xc2, 0x73, 0x13, 0x2d, 0x5e, 0xcf, 0x72,
-	0xf9, 0x97, 0x02, 0x99, 0xfe, 0xcd, 0x08, 0x1a, 0x29, 0xf1, 0x76, 0x33, 0x96, 0xb9, 0x39, 0xb2,
-	0xbf, 0x44, 0xfe, 0x45, 0x8e, 0xfc, 0x6d, 0xb4, 0x75, 0xf4, 0x6a, 0x70, 0x3c, 0x8a, 0x7e, 0x9a,
-	0x80, 0x4b, 0xc3, 0x34, 0xdf, 0x68, 0x7b, 0x44, 0x00, 0xfd, 0xf5, 0x71, 0x64, 0x4a, 0x76, 0x39,
-	0x25, 0x5f, 0x45, 0x1f, 0xbe, 0x14, 0x4a, 0x7a, 0x2b, 0xe4, 0xe3, 0x04, 0xbc, 0x16, 0xa7, 0xe9,
-	0x46, 0xb7, 0x8f, 0x26, 0x91, 0x97, 0x59, 0x2a, 0xf7, 0x39, 0x2f, 0xef, 0xa3, 0x77, 0x87, 0xe4,
-	0x85, 0xb1, 0x30, 0x40, 0x28, 0xac, 0x74, 0x3e, 0x51, 0x60, 0xca, 0x6f, 0x8e, 0xd1, 0xa5, 0xc8,
-	0x64, 0x43, 0x6d, 0x75, 0x26, 0x17, 0xd3, 0x5a, 0x02, 0x51, 0x39, 0x90, 0x65, 0x74, 0x21, 0x12,
-	0x48, 0xd0, 0x79, 0xa3, 0xef, 0x2b, 0x30, 0xce, 0x22, 0xa0, 0xe5, 0xe8, 0x0b, 0xb4, 0xfd, 0xac,
-	0xce, 0xbc, 0x11, 0xc3, 0x52, 0x66, 0x73, 0x85, 0x67, 0xa3, 0xa2, 0x4b, 0x91, 0xd9, 0xf0, 0x4c,
-	0xda, 0xe4, 0x72, 0xb6, 0xfc, 0x7e, 0x7b, 0x00, 0x5b, 0xa1, 0x4e, 0x7d, 0x00, 0x5b, 0xe1, 0x26,
-	0x3e, 0x26, 0x5b, 0x46, 0xb5, 0x9a, 0x13, 0x6c, 0xfd, 0x46, 0x81, 0x74, 0xb8, 0xf7, 0x46, 0x57,
-	0x22, 0xd7, 0xec, 0xd3, 0xed, 0x67, 0xae, 0x0e, 0xe9, 0x25, 0x33, 0xbe, 0xce, 0x33, 0x5e, 0x43,
-	0x97, 0x23, 0x33, 0xae, 0x9a, 0x2e, 0x15, 0x29, 0xe7, 0x76, 0x1b, 0x39, 0xfe, 0xda, 0x45, 0x3f,
-	0x56, 0x20, 0x19, 0x74, 0xc4, 0x28, 0x9a, 0xa8, 0xf0, 0xb7, 0x80, 0x8c, 0x1a, 0xd7, 0x5c, 0xa6,
-	0xb9, 0xce, 0xd3, 0xcc, 0xa1, 0x95, 0x9e, 0x69, 0x86, 0x36, 0x3c, 0xcf, 0x9f, 0xbd, 0x2e, 0x7a,
-	0xa6, 0x00, 0x3a, 0xdc, 0x1d, 0xa3, 0x4f, 0x45, 0xae, 0xdd, 0xb7, 0x33, 0xcf, 0x5c, 0x1b, 0xda,
-	0x4f, 0x26, 0x5f, 0xe2, 0xc9, 0x6f, 0xa0, 0xc2, 0x30, 0x55, 0x9b, 0xa7, 0x2c, 0xa0, 0x38, 0x04,
-	0x82, 0xfe, 0x14, 0xfd, 0x5a, 0x81, 0x54, 0x77, 0xe7, 0x8c, 0xd6, 0x06, 0xa7, 0x75, 0x08, 0xca,
-	0xfa, 0x50, 0x3e, 0x12, 0xc6, 0x0d, 0x0e, 0xe3, 0x0a, 0x5a, 0x8b, 0x01, 0x43, 0x24, 0xdf, 0xce,
-	0xfb, 0xa9, 0xbf, 0x15, 0x5d, 0xdd, 0x70, 0x9c, 0xad, 0xe8, 0xd5, 0x89, 0xc7, 0xd9, 0x8a, 0x9e,
-	0x6d, 0x37, 0x2e, 0x70, 0x0c, 0x9f, 0x41, 0x9f, 0x1e, 0x61, 0x2b, 0x44, 0x0f, 0x8d, 0x7e, 0xab,
-	0xc0, 0xc9, 0x1e, 0xcd, 0x2c, 0x1a, 0x90, 0x53, 0xdf, 0xb6, 0x3b, 0x73, 0x7d, 0x78, 0xc7, 0xa1,
-	0x76, 0x84, 0x8a, 0x08, 0x7a, 0xcd, 0x30, 0xeb, 0x3a, 0x6f, 0x93, 0x1f, 0x10, 0x82, 0xfe, 0xa9,
-	0x40, 0x76, 0x40, 0x3f, 0x87, 0x36, 0x62, 0x5d, 0x83, 0xd1, 0x4d, 0x76, 0x66, 0xf3, 0x68, 0x41,
-	0x24, 0xd4, 0x37, 0x39, 0xd4, 0x6b, 0xe8, 0xea, 0xb0, 0x17, 0x2a, 0xe5, 0x81, 0xef, 0x3f, 0x7d,
-	0xbe, 0xa8, 0x3c, 0x7b, 0xbe, 0xa8, 0xfc, 0xed, 0xf9, 0xa2, 0xf2, 0xf1, 0x8b, 0xc5, 0x63, 0xcf,
-	0x5e, 0x2c, 0x1e, 0xfb, 0xf3, 0x8b, 0xc5, 0x63, 0x1f, 0x6e, 0x74, 0xb4, 0xc3, 0x32, 0x74, 0xae,
-	0x6a, 0xec, 0xba, 0xc1, 0x3a, 0xfb, 0x6b, 0xab, 0xf9, 0x83, 0xae, 0xd5, 0xf6, 0xaa, 0x26, 0xb1,
-	0xa9, 0xf8, 0x6f, 0x6a, 0xf1, 0x21, 0x74, 0x92, 0xff, 0xb3, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb3, 0xf8, 0xe5, 0xf8, 0xc2, 0x1f, 0x00, 0x00,
+	// 2369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x73, 0x1b, 0x49,
+	0x15, 0xce, 0xc8, 0x8e, 0x63, 0xbd, 0xc4, 0xb6, 0xd2, 0x89, 0x63, 0x79, 0x12, 0x2c, 0x6f, 0x67,
+	0xc9, 0x7a, 0xe3, 0x48, 0x8a, 0xed, 0x04, 0x87, 0xc0, 0x6e, 0xb0, 0x6c, 0x67, 0xe3, 0x25, 0x24,
+	0xce, 0x24, 0xfb, 0x83, 0x85, 0x30, 0x35, 0x96, 0x3b, 0xf2, 0x10, 0xcd, 0x8c, 0xa2, 0x69, 0x25,
+	0xf6, 0x52, 0x7b, 0xd9, 0x2a, 0x0a, 0x4e, 0xd4, 0x02, 0x87, 0x3d, 0x70, 0xa0, 0x38, 0x70, 0x01,
+	0xf6, 0x04, 0x54, 0xc1, 0x9d, 0x43, 0x8a, 0x2a, 0xb6, 0x52, 0xc5, 0x52, 0x45, 0xed, 0x41, 0x50,
+	0x09, 0x07, 0xaa, 0xa0, 0x38, 0x88, 0x7f, 0x80, 0xea, 0x1f, 0x33, 0x92, 0x46, 0xd2, 0x68, 0x46,
+	0xce, 0x81, 0xda, 0x93, 0xa5, 0xee, 0xf7, 0x5e, 0xbf, 0xef, 0xeb, 0xf7, 0xba, 0x5b, 0x5f, 0x02,
+	0x2f, 0x39, 0xae, 0xe5, 0xb8, 0xa6, 0x9b, 0xaf, 0x38, 0x4e, 0xd9, 0x32, 0x6c, 0xa3, 0x44, 0xaa,
+	0xf9, 0x87, 0x0b, 0x5b, 0x84, 0x1a, 0x0b, 0xf9, 0x07, 0x35, 0x52, 0xdd, 0xcb, 0x55, 0xaa, 0x0e,
+	0x75, 0xd0, 0x49, 0x69, 0x98, 0x6b, 0x31, 0xcc, 0x49, 0x43, 0xf5, 0x78, 0xc9, 0x29, 0x39, 0xdc,
+	0x2e, 0xcf, 0x3e, 0x09, 0x17, 0xf5, 0xe5, 0xb0, 0xd8, 0x25, 0x62, 0x13, 0x1e, 0x8e, 0x9b, 0xbe,
+	0x18, 0x66, 0x4a, 0x77, 0xa5, 0xd5, 0xb9, 0x30, 0x2b, 0xf7, 0x91, 0x51, 0xd1, 0xab, 0x4e, 0x8d,
+	0x92, 0x48, 0xd6, 0xd4, 0xa0, 0xa6, 0x4b, 0xcd, 0xa2, 0x97, 0xc1, 0x4c, 0x91, 0x9b, 0xe7, 0xb7,
+	0x0c, 0x97, 0xf8, 0x56, 0x45, 0xc7, 0xb4, 0xe5, 0xfc, 0xd9, 0xd6, 0x79, 0x4e, 0x8c, 0x6f, 0x55,
+	0x31, 0x4a, 0xa6, 0x6d, 0x50, 0xd3, 0xf1, 0x6c, 0x4f, 0x95, 0x1c, 0xa7, 0x54, 0x26, 0x79, 0xa3,
+	0x62, 0xe6, 0x0d, 0xdb, 0x76, 0x28, 0x9f, 0xf4, 0x56, 0x9a, 0x96, 0xb3, 0xfc, 0xdb, 0x56, 0xed,
+	0x5e, 0xde, 0xb0, 0xf7, 0xbc, 0x29, 0xb1, 0x88, 0x2e, 0xa8, 0x14, 0x5f, 0xe4, 0x54, 0x26, 0xe8,
+	0x45, 0x4d, 0x8b, 0xb8, 0xd4, 0xb0, 0x2a, 0xc2, 0x00, 0x4f, 0xc0, 0xd8, 0xa6, 0x51, 0x35, 0x2c,
+	0x57, 0x23, 0x0f, 0x6a, 0xc4, 0xa5, 0xf8, 0x36, 0x8c, 0x7b, 0x03, 0x6e, 0xc5, 0xb1, 0x5d, 0x82,
+	0x56, 0x60, 0xa4, 0xc2, 0x47, 0xd2, 0xca, 0xac, 0x32, 0x77, 0x78, 0xf1, 0x74, 0x2e, 0x64, 0x53,
+	0x73, 0xc2, 0xb9, 0x30, 0xfc, 0xb8, 0x9e, 0x39, 0xa0, 0x49, 0x47, 0xfc, 0x1f, 0x05, 0x66, 0xd7,
+	0x5d, 0x6a, 0x5a, 0x06, 0x25, 0xb7, 0x1f, 0x19, 0x95, 0xf5, 0x5d, 0xa3, 0x48, 0x57, 0x2c, 0xa7,
+	0x66, 0xd3, 0x0d, 0x5b, 0xae, 0x8c, 0xb2, 0x70, 0x88, 0x05, 0xd4, 0xcd, 0xed, 0x74, 0x62, 0x56,
+	0x99, 0x1b, 0x2e, 0x1c, 0x6f, 0xd4, 0x33, 0xe3, 0x7b, 0x86, 0x55, 0xbe, 0x8c, 0xe5, 0x04, 0x4e,
+	0x2b, 0xda, 0x08, 0xfb, 0xbc, 0xb1, 0x8d, 0x72, 0x30, 0x4a, 0x9d, 0xfb, 0xc4, 0xd6, 0x4d, 0x3b,
+	0x3d, 0x34, 0xab, 0xcc, 0x25, 0x0b, 0xc7, 0x1a, 0xf5, 0xcc, 0x84, 0xb0, 0xf7, 0x66, 0xb0, 0x76,
+	0x88, 0x7f, 0xdc, 0xb0, 0xd1, 0x5d, 0x18, 0xe1, 0xfb, 0xec, 0xa6, 0x87, 0x67, 0x87, 0xe6, 0x0e,
+	0x2f, 0xe6, 0x42, 0x61, 0xb0, 0x2c, 0xfd, 0x04, 0x99, 0x5b, 0x61, 0x92, 0x21, 0x6a, 0xd4, 0x33,
+	0x63, 0x62, 0x05, 0x11, 0x0b, 0x6b, 0x32, 0xe8, 0xeb, 0xc3, 0xa3, 0x4a, 0x2a, 0xa1, 0x8d, 0xb8,
+	0xc4, 0xde, 0x26, 0x55, 0xfc, 0xab, 0x04, 0x2c, 0xf6, 0x04, 0xfc, 0x96, 0x49, 0x77, 0x36, 0xab,
+	0xa6, 0x65, 0x52, 0xf3, 0x21, 0xb9, 0xb3, 0x57, 0x21, 0x6e, 0x17, 0x0a, 0x94, 0x98, 0x14, 0x24,
+	0x22, 0x50, 0x70, 0x05, 0xc6, 0x45, 0xb6, 0xba, 0xb7, 0xca, 0xd0, 0xec, 0xd0, 0xdc, 0x70, 0x61,
+	0xba, 0x51, 0xcf, 0x4c, 0xb6, 0xc2, 0xf2, 0xe6, 0xb1, 0x76, 0x44, 0x0c, 0x6c, 0x8a, 0x05, 0xdf,
+	0x84, 0x13, 0xd2, 0x40, 0x44, 0x77, 0x6a, 0x54, 0xdf, 0x26, 0xb6, 0x63, 0x71, 0x4e, 0x93, 0x85,
+	0x17, 0x1a, 0xf5, 0xcc, 0xe7, 0xda, 0x02, 0x05, 0xec, 0xb0, 0x76, 0x4c, 0x4c, 0xdc, 0x61, 0xe3,
+	0x37, 0x6b, 0x74, 0x8d, 0x8f, 0xfe, 0x49, 0x81, 0xb3, 0x3e, 0x5d, 0xa6, 0x5d, 0x2a, 0x13, 0xb6,
+	0x60, 0xcf, 0x4a, 0x99, 0x0f, 0xd2, 0x84, 0x3a, 0x69, 0x1a, 0x98, 0xa4, 0x02, 0x4c, 0x04, 0xc1,
+	0x89, 0xf2, 0x52, 0x1b, 0xf5, 0xcc, 0x89, 0x56, 0xb7, 0x16, 0x54, 0x63, 0xb4, 0x0d, 0xcf, 0xf7,
+	0x14, 0x78, 0x21, 0xa4, 0xde, 0x65, 0x63, 0x6d, 0x41, 0xaa, 0x19, 0xc8, 0xe0, 0xb3, 0x1c, 0x4f,
+	0xb2, 0x70, 0x89, 0xd5, 0xda, 0xa7, 0xf5, 0xcc, 0xa4, 0x68, 0x66, 0x77, 0xfb, 0x7e, 0xce, 0x74,
+	0xf2, 0x96, 0x41, 0x77, 0x72, 0x1b, 0x36, 0x6d, 0xd4, 0x33, 0x53, 0xc1, 0x3c, 0x84, 0x3b, 0xd6,
+	0xc6, 0xbd, 0x44, 0xc4, 0x6a, 0xf8, 0xbf, 0xbd, 0x33, 0xb9, 0x59, 0xa3, 0x03, 0xb6, 0xde, 0xb7,
+	0xfc, 0x56, 0x1a, 0xe2, 0xad, 0x94, 0x8f, 0xd8, 0x4a, 0x6c, 0xc5, 0x08, 0xbd, 0x84, 0x16, 0x20,
+	0xe9, 0x23, 0x4b, 0x0f, 0x73, 0x46, 0x58, 0x42, 0xa9, 0x00, 0x68, 0xac, 0x8d, 0x7a, 0x68, 0x03,
+	0xed, 0xf7, 0x51, 0x02, 0x96, 0x7a, 0xa3, 0x7e, 0x6e, 0xfd, 0xd7, 0xd9, 0x4f, 0x89, 0x78, 0xfd,
+	0x74, 0x1b, 0x26, 0xdb, 0xfa, 0xc4, 0xb4, 0xfd, 0x8a, 0x63, 0xed, 0x34, 0xdb, 0xa8, 0x67, 0x4e,
+	0x75, 0x69, 0x27, 0xcf, 0x0c, 0x6b, 0xa8, 0xa5, 0x9b, 0x36, 0x6c, 0x5e, 0x7c, 0x03, 0xb0, 0x87,
+	0x3f, 0x56, 0x60, 0xbe, 0x6f, 0xff, 0xb5, 0xd4, 0x4b, 0xac, 0x06, 0xbc, 0x02, 0xe3, 0x01, 0x74,
+	0xa2, 0x0d, 0x5b, 0x58, 0x0a, 0xc2, 0x3a, 0x42, 0x7b, 0x02, 0x1a, 0x8a, 0x04, 0xe8, 0xbb, 0x0a,
+	0xe0, 0xb0, 0xb2, 0x97, 0x1d, 0xa8, 0x7b, 0xbd, 0x6e, 0xda, 0xed, 0x0d, 0xb8, 0xdc, 0xaf, 0x01,
+	0x4f, 0x04, 0x12, 0xf7, 0xfa, 0x6f, 0x4c, 0x66, 0x2e, 0xdb, 0xef, 0x28, 0x4c, 0xdc, 0xa8, 0x59,
+	0x8c, 0x4c, 0xff, 0x82, 0x5d, 0x87, 0x54, 0x73, 0x48, 0xe6, 0xb1, 0x00, 0x49, 0xbb, 0x66, 0xf1,
+	0x2a, 0x71, 0x5b, 0x2a, 0x4f, 0x22, 0xf4, 0xa7, 0xb0, 0x36, 0x6a, 0x4b, 0x57, 0x7c, 0x19, 0x0e,
+	0xb3, 0x0f, 0x83, 0xec, 0x08, 0x5e, 0x85, 0x23, 0xc2, 0x57, 0x2e, 0xbf, 0x04, 0xc3, 0x6c, 0x46,
+	0xde, 0xef, 0xc7, 0x73, 0xe2, 0xd1, 0x90, 0xf3, 0x1e, 0x0d, 0xb9, 0x15, 0x7b, 0xaf, 0x90, 0xfc,
+	0xe3, 0x6f, 0xb2, 0x07, 0x79, 0xd9, 0x6a, 0xdc, 0x98, 0x41, 0x5b, 0x29, 0x97, 0xdb, 0xa0, 0x6d,
+	0x40, 0xaa, 0x39, 0x24, 0x63, 0x5f, 0x84, 0x83, 0x1e, 0xac, 0xa1, 0x28, 0xc1, 0x85, 0x35, 0x5e,
+	0x81, 0xa9, 0xeb, 0xa6, 0x4b, 0x79, 0xac, 0xc2, 0x1e, 0xaf, 0x03, 0x0f, 0xea, 0x19, 0x38, 0x28,
+	0xca, 0x48, 0x6c, 0x55, 0xaa, 0x51, 0xcf, 0x1c, 0x11, 0x40, 0x65, 0xf5, 0x88, 0x69, 0x7c, 0x0b,
+	0xd2, 0x9d, 0x21, 0xf6, 0x97, 0xd5, 0xc7, 0x0a, 0x4c, 0xf1, 0x13, 0xcc, 0xbd, 0x5a, 0x75, 0x2c,
+	0x1e, 0xd2, 0x3f, 0x3b, 0x3a, 0xcb, 0x5c, 0x89, 0x57, 0xe6, 0x5d, 0x2e, 0x9e, 0x44, 0xcc, 0x8b,
+	0x87, 0x5d, 0x76, 0x96, 0xb1, 0xab, 0xef, 0x38, 0x15, 0x97, 0x77, 0xca, 0x70, 0xeb, 0x65, 0xe7,
+	0xcd, 0x60, 0xed, 0x90, 0x65, 0xec, 0x5e, 0x63, 0x9f, 0xde, 0x85, 0x74, 0x27, 0x1e, 0xc9, 0x51,
+	0xf3, 0x94, 0x57, 0x22, 0x9c, 0xf2, 0x3c, 0x0c, 0x3b, 0x59, 0xaf, 0x9b, 0x0f, 0x6a, 0xe6, 0xb6,
+	0x49, 0xf7, 0xfa, 0x9c, 0xf2, 0xf8, 0x89, 0x02, 0xa9, 0xdb, 0x15, 0x87, 0x6e, 0x56, 0xcd, 0x22,
+	0x19, 0xe8, 0x64, 0x59, 0x87, 0x14, 0x7b, 0x58, 0xeb, 0x86, 0xeb, 0x92, 0x76, 0xca, 0x4e, 0x36,
+	0xef, 0xc8, 0xa0, 0x05, 0xd6, 0xc6, 0xd9, 0xd0, 0x0a, 0x1b, 0x11, 0xa4, 0x5d, 0x83, 0xa3, 0x0f,
+	0x6a, 0x0e, 0x6d, 0x8f, 0x23, 0xce, 0x99, 0x53, 0x8d, 0x7a, 0x26, 0x2d, 0xe2, 0x74, 0x98, 0x60,
+	0x6d, 0x82, 0x8f, 0x35, 0x23, 0xe1, 0x0d, 0x38, 0xda, 0x82, 0x48, 0xf2, 0x78, 0x01, 0xc0, 0xad,
+	0x38, 0x54, 0xaf, 0xb0, 0x51, 0x59, 0x14, 0x93, 0x8d, 0x7a, 0xe6, 0xa8, 0x88, 0xdb, 0x9c, 0xc3,
+	0x5a, 0xd2, 0xf5, 0xbc, 0xf1, 0xa7, 0x0a, 0xa8, 0x37, 0x9c, 0xaa, 0x65, 0x94, 0xcd, 0x77, 0xc9,
+	0xf6, 0x67, 0x8c, 0xa7, 0x8f, 0x12, 0x70, 0xb2, 0x2b, 0x38, 0x49, 0xd9, 0xfd, 0x2e, 0x94, 0x5d,
+	0x97, 0x47, 0x72, 0xbe, 0x64, 0xd2, 0x9d, 0xda, 0x56, 0xae, 0xe8, 0x58, 0x79, 0x59, 0x90, 0xd9,
+	0xb2, 0xb1, 0xe5, 0x7a, 0x5f, 0xf8, 0x5f, 0x7e, 0x52, 0x17, 0xcc, 0xd2, 0x1a, 0x29, 0xf6, 0x63,
+	0x1a, 0xdd, 0x80, 0x63, 0x2d, 0xd8, 0xc9, 0x6e, 0xc5, 0xb1, 0x89, 0x4d, 0x39, 0x41, 0x63, 0x85,
+	0x99, 0x46, 0x3d, 0xa3, 0x76, 0x10, 0xe4, 0x19, 0x61, 0xed, 0xa8, 0xcf, 0xd1, 0xba, 0x1c, 0x43,
+	0xb7, 0xe0, 0x78, 0x2b, 0x07, 0x7e, 0xc0, 0x21, 0x1e, 0x30, 0xd3, 0xa8, 0x67, 0x4e, 0x76, 0x32,
+	0xd5, 0x8c, 0x88, 0x9a, 0x64, 0x79, 0x21, 0xf1, 0x35, 0x98, 0xbe, 0xe3, 0x50, 0x83, 0x1f, 0xad,
+	0x7e, 0x7f, 0x0d, 0x74, 0xf4, 0xff, 0x44, 0x01, 0xb5, 0x5b, 0x28, 0x49, 0xfc, 0x7b, 0x90, 0x2c,
+	0x7b, 0x83, 0xb2, 0xed, 0xa7, 0x73, 0xf2, 0x17, 0x25, 0x43, 0xea, 0xb7, 0xfb, 0xaa, 0x63, 0xda,
+	0x85, 0x35, 0xd9, 0xe0, 0xf2, 0x9e, 0xf2, 0x3d, 0xf1, 0x2f, 0xfe, 0x96, 0x99, 0x6b, 0xd9, 0x26,
+	0xf9, 0x23, 0x58, 0xfc, 0xc9, 0xba, 0xdb, 0xf7, 0xf3, 0x94, 0xbd, 0xba, 0x78, 0x10, 0x57, 0x6b,
+	0xae, 0x88, 0xa7, 0x60, 0x92, 0x27, 0x17, 0xc4, 0x88, 0x3f, 0x54, 0xe0, 0x44, 0x70, 0xe6, 0xff,
+	0x23, 0xe5, 0x34, 0x9c, 0x58, 0xdd, 0x31, 0x4c, 0xfb, 0xb6, 0x2f, 0x0d, 0x78, 0x39, 0xbf, 0xaf,
+	0xc0, 0x54, 0xc7, 0x94, 0x4c, 0xba, 0x04, 0xd0, 0xd4, 0x12, 0xe4, 0xbd, 0x7b, 0x2e, 0xf4, 0x7c,
+	0x0d, 0x44, 0x2a, 0x4c, 0x4b, 0x20, 0x5e, 0x6d, 0xfb, 0x33, 0x58, 0x6b, 0x09, 0xed, 0x57, 0xce,
+	0x9b, 0x4e, 0xb9, 0x66, 0x91, 0xab, 0x4e, 0x75, 0xe0, 0x47, 0xc3, 0x8f, 0xbc, 0xca, 0x09, 0x84,
+	0x92, 0x88, 0x28, 0x8c, 0x3c, 0xe4, 0x13, 0xfd, 0xf7, 0x60, 0xa5, 0xfd, 0x5e, 0x10, 0x6e, 0xf1,
+	0x36, 0x40, 0xae, 0x85, 0x1f, 0x82, 0x7a, 0xa7, 0x6a, 0x6c, 0x9b, 0x76, 0x69, 0xd3, 0x30, 0xab,
+	0x77, 0x8c, 0xfb, 0xa4, 0x7a, 0x95, 0xb4, 0x1e, 0x92, 0xfc, 0x04, 0xd2, 0xcf, 0xcb, 0x33, 0xa4,
+	0x05, 0x9f, 0x9c, 0xc0, 0xda, 0x08, 0xff, 0x74, 0xbe, 0x69, 0xbc, 0x90, 0x4e, 0x74, 0x37, 0x5e,
+	0xf0, 0x8c, 0x17, 0xf0, 0xb7, 0xe1, 0x64, 0xd7, 0x75, 0x25, 0x19, 0x5f, 0x85, 0x24, 0x65, 0x63,
+	0xfa, 0x3d, 0xe2, 0x1d, 0x5f, 0x39, 0x79, 0x7c, 0x9d, 0x89, 0x80, 0x71, 0x8d, 0x14, 0xb5, 0x51,
+	0x2a, 0x83, 0xe2, 0x4f, 0x12, 0x70, 0xc6, 0x7b, 0xcb, 0xb2, 0x45, 0x49, 0xc1, 0x70, 0xc9, 0xf6,
+	0x4d, 0x9b, 0x9f, 0x5e, 0x1b, 0x56, 0xc5, 0x28, 0xfa, 0xef, 0xf2, 0x2f, 0x43, 0xf2, 0x5e, 0xd5,
+	0xb1, 0x74, 0xa6, 0x40, 0xc9, 0xaa, 0x0a, 0xd9, 0x07, 0xa1, 0xd1, 0x8c, 0x32, 0x0f, 0xf6, 0x1d,
+	0x61, 0x18, 0xa3, 0x0e, 0xf7, 0x6d, 0xbd, 0x23, 0xb4, 0xc3, 0xd4, 0x61, 0xd3, 0xe2, 0x0e, 0x98,
+	0x6a, 0x96, 0x0c, 0x7f, 0x5f, 0xf8, 0x77, 0xcc, 0xdb, 0x90, 0x62, 0xef, 0x0b, 0x7e, 0xbc, 0xea,
+	0x26, 0xcf, 0x2a, 0x3d, 0x3c, 0x10, 0xf2, 0x71, 0xcb, 0xd8, 0x6d, 0xc1, 0x86, 0xde, 0x80, 0x71,
+	0xb2, 0x4b, 0x49, 0xd5, 0x36, 0xca, 0xf2, 0x42, 0x38, 0x38, 0x50, 0xdc, 0x31, 0x2f, 0x8a, 0xb8,
+	0x60, 0x7f, 0xa9, 0xc0, 0x4b, 0x7d, 0x69, 0x95, 0xfb, 0xf9, 0x2a, 0x80, 0x69, 0x57, 0x6a, 0x34,
+	0x16, 0xb1, 0x49, 0xee, 0xc2, 0x99, 0xfd, 0x0a, 0x1c, 0x76, 0x6a, 0xd4, 0x0f, 0x90, 0x88, 0x16,
+	0x00, 0x84, 0x0f, 0x1b, 0x59, 0xfc, 0xcb, 0x2c, 0x1c, 0xbc, 0xc5, 0xf4, 0x43, 0xf4, 0x03, 0x05,
+	0x46, 0x84, 0xc8, 0x86, 0xce, 0x46, 0x50, 0xe2, 0x64, 0x69, 0xa8, 0xf3, 0x91, 0x6c, 0x05, 0x5e,
+	0x3c, 0xff, 0xfe, 0x9f, 0xff, 0xf1, 0xe3, 0xc4, 0xe7, 0xd1, 0xe9, 0x7c, 0x98, 0x1a, 0x2a, 0xb3,
+	0xf8, 0xa7, 0x02, 0xd3, 0x3d, 0xc5, 0x0e, 0xf4, 0x4a, 0xe8, 0xba, 0xfd, 0x44, 0x41, 0xf5, 0xd5,
+	0x41, 0xdd, 0x25, 0x92, 0xeb, 0x1c, 0xc9, 0x55, 0xb4, 0x16, 0x8a, 0xe4, 0x3b, 0xb2, 0xa6, 0xdf,
+	0xcb, 0x13, 0x19, 0x51, 0x08, 0xc3, 0x84, 0xc5, 0x94, 0xbf, 0xed, 0x74, 0xd3, 0x46, 0x3f, 0x4b,
+	0xc0, 0x7c, 0xcf, 0x35, 0x3b, 0x65, 0x05, 0x74, 0x73, 0xb0, 0xec, 0x7b, 0x0a, 0x14, 0xfb, 0xa6,
+	0xc3, 0xe0, 0x74, 0x7c, 0x03, 0x7d, 0xfd, 0x79, 0xd0, 0xa1, 0x3f, 0x32, 0xe9, 0x8e, 0x5e, 0xf1,
+	0x12, 0xd5, 0x79, 0xab, 0xa1, 0xef, 0x27, 0xe0, 0x74, 0x04, 0x2d, 0x0f, 0xbd, 0x16, 0x0d, 0x4a,
+	0x5f, 0x35, 0x70, 0xdf, 0x9c, 0xbc, 0xcd, 0x39, 0xd1, 0xd0, 0x66, 0x6c, 0x4e, 0x78, 0x6e, 0x42,
+	0xdb, 0xe9, 0x5a, 0x2e, 0xff, 0x56, 0x40, 0xed, 0xad, 0x42, 0xa0, 0x81, 0x12, 0x6f, 0xaa, 0x30,
+	0xea, 0x95, 0x81, 0xfd, 0x25, 0xf2, 0xaf, 0x71, 0xe4, 0xaf, 0xa1, 0xf5, 0xfd, 0x57, 0x83, 0x53,
+	0xa3, 0xe8, 0xe7, 0x09, 0x38, 0x17, 0x47, 0x75, 0x43, 0x9b, 0x03, 0x02, 0xe8, 0xdd, 0x1f, 0xfb,
+	0xa6, 0x64, 0x8b, 0x53, 0xf2, 0x4d, 0xf4, 0xce, 0x73, 0xa1, 0xa4, 0x7b, 0x87, 0x7c, 0x90, 0x80,
+	0x17, 0xa3, 0xa8, 0x6d, 0xe8, 0xda, 0xfe, 0x5a, 0xe4, 0x79, 0x96, 0xca, 0x5d, 0xce, 0xcb, 0x5b,
+	0xe8, 0x8d, 0x98, 0xbc, 0x30, 0x16, 0xfa, 0x34, 0x0a, 0x2b, 0x9d, 0x0f, 0x15, 0x18, 0xf5, 0x54,
+	0x31, 0x14, 0xfe, 0x10, 0x0e, 0xe8, 0x69, 0x6a, 0x36, 0xa2, 0xb5, 0x04, 0x92, 0xe3, 0x40, 0xe6,
+	0xd0, 0x99, 0x50, 0x20, 0xbe, 0xe4, 0x86, 0x7e, 0xa8, 0xc0, 0x30, 0x8b, 0x80, 0xe6, 0xc2, 0x2f,
+	0xd0, 0xe6, 0xb3, 0x5a, 0x7d, 0x39, 0x82, 0xa5, 0xcc, 0xe6, 0x02, 0xcf, 0x26, 0x87, 0xce, 0x85,
+	0x66, 0xc3, 0x33, 0x69, 0x92, 0xcb, 0xd9, 0xf2, 0x84, 0xb6, 0x3e, 0x6c, 0x05, 0x24, 0x3a, 0x35,
+	0x1b, 0xd1, 0x3a, 0x16, 0x5b, 0x46, 0xb9, 0x9c, 0x15, 0x6c, 0xfd, 0x5e, 0x81, 0x54, 0x50, 0x74,
+	0x43, 0x17, 0x42, 0xd7, 0xec, 0x21, 0xf3, 0xa9, 0x17, 0x63, 0x7a, 0xc9, 0x8c, 0x2f, 0xf1, 0x8c,
+	0x17, 0xd1, 0xf9, 0xd0, 0x8c, 0xcb, 0xa6, 0x4b, 0x45, 0xca, 0xd9, 0xad, 0xbd, 0x2c, 0x7f, 0xed,
+	0xa2, 0xdf, 0x29, 0x90, 0x0a, 0x8a, 0x61, 0x7d, 0x72, 0xef, 0xa1, 0x05, 0xaa, 0x17, 0x63, 0x7a,
+	0xc9, 0xdc, 0x97, 0x79, 0xee, 0x0b, 0x28, 0x1f, 0x9a, 0xbb, 0x90, 0xcf, 0xb2, 0xec, 0xd9, 0x2e,
+	0x32, 0x77, 0xd1, 0x4f, 0x15, 0x48, 0xfa, 0x2a, 0x0a, 0x0a, 0xdf, 0xe3, 0xa0, 0x94, 0xa4, 0xe6,
+	0xa2, 0x9a, 0xcb, 0x2c, 0x97, 0x78, 0x96, 0x59, 0x34, 0xdf, 0x35, 0xcb, 0x40, 0xad, 0xe6, 0xf9,
+	0x8b, 0xdd, 0x45, 0x9f, 0x28, 0x70, 0xac, 0x8b, 0xe2, 0x83, 0x96, 0xc3, 0xbb, 0xb7, 0xa7, 0x00,
+	0xa6, 0x5e, 0x8a, 0xef, 0x28, 0xf3, 0x7f, 0x9d, 0xe7, 0xbf, 0x86, 0x0a, 0x71, 0x7a, 0x2e, 0x6f,
+	0xfb, 0x11, 0xf5, 0xa6, 0x8e, 0x84, 0x9e, 0x28, 0x80, 0x3a, 0xe5, 0x14, 0xf4, 0x85, 0xd0, 0xe4,
+	0x7a, 0x4a, 0x39, 0xea, 0x72, 0x6c, 0x3f, 0x89, 0x69, 0x83, 0x63, 0x5a, 0x45, 0x2b, 0xb1, 0x30,
+	0x51, 0x16, 0x50, 0x1c, 0xcb, 0xbe, 0xa0, 0x81, 0x7e, 0xab, 0xc0, 0x78, 0xbb, 0xd4, 0x82, 0x16,
+	0xfb, 0xa7, 0xd5, 0x01, 0x65, 0x29, 0x96, 0x8f, 0x84, 0x71, 0x99, 0xc3, 0xb8, 0x80, 0x16, 0x23,
+	0xc0, 0x10, 0xc9, 0x37, 0xf3, 0x7e, 0xec, 0x6d, 0x45, 0x9b, 0x3e, 0x11, 0x65, 0x2b, 0xba, 0x69,
+	0x23, 0xea, 0x72, 0x6c, 0x3f, 0x89, 0x61, 0x85, 0x63, 0xf8, 0x12, 0xfa, 0xe2, 0x00, 0x5b, 0x21,
+	0x54, 0x0d, 0xf4, 0x6b, 0x05, 0x26, 0x02, 0x7a, 0x0f, 0x5a, 0x8a, 0xa3, 0x0e, 0x79, 0x20, 0x2e,
+	0xc4, 0x73, 0x92, 0x08, 0x2e, 0x72, 0x04, 0x79, 0x94, 0x0d, 0x45, 0x50, 0x64, 0xde, 0x7a, 0x53,
+	0x6a, 0x42, 0x7f, 0x50, 0xe0, 0x58, 0x17, 0x51, 0xa4, 0x4f, 0x8b, 0xf7, 0x96, 0x6f, 0xd4, 0x4b,
+	0xf1, 0x1d, 0x63, 0xd5, 0x11, 0x15, 0x11, 0xf4, 0x8a, 0x61, 0x56, 0x75, 0x2e, 0xb7, 0xdc, 0x23,
+	0x04, 0xfd, 0x4b, 0x81, 0x4c, 0x1f, 0x5d, 0x00, 0xad, 0x46, 0x7a, 0x4e, 0x85, 0x8b, 0x35, 0xea,
+	0xda, 0xfe, 0x82, 0x48, 0xa8, 0xaf, 0x70, 0xa8, 0xcb, 0xe8, 0x62, 0xdc, 0x87, 0x19, 0xe5, 0x81,
+	0xef, 0x3e, 0x7e, 0x3a, 0xa3, 0x3c, 0x79, 0x3a, 0xa3, 0xfc, 0xfd, 0xe9, 0x8c, 0xf2, 0xc1, 0xb3,
+	0x99, 0x03, 0x4f, 0x9e, 0xcd, 0x1c, 0xf8, 0xeb, 0xb3, 0x99, 0x03, 0xef, 0xac, 0xf6, 0xd3, 0xd9,
+	0x1f, 0x2e, 0x2e, 0xe4, 0x77, 0xdb, 0x56, 0x2b, 0x96, 0x4d, 0x62, 0x53, 0xf1, 0xff, 0x9c, 0xc4,
+	0xbf, 0xa4, 0x8d, 0xf0, 0x3f, 0x4b, 0xff, 0x1b, 0x00, 0xbb, 0xaf, 0x46, 0x59, 0x31, 0x26, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1738,15 +2074,25 @@ type QueryClient interface {
 	AllPools(ctx context.Context, in *AllPoolsRequest, opts ...grpc.CallOption) (*AllPoolsResponse, error)
 	// ListPoolsByDenom return all pools by denom
 	ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenomRequest, opts ...grpc.CallOption) (*ListPoolsByDenomResponse, error)
+	// RoutesFromDenoms returns the routes of up to max_hops pools swapping
+	// token_in_denom for token_out_denom, ranked by liquidity.
+	RoutesFromDenoms(ctx context.Context, in *RoutesFromDenomsRequest, opts ...grpc.CallOption) (*RoutesFromDenomsResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error)
+	// NormalizedSpotPrice returns the spot price of the base denom in the quote
+	// denom, scaled by the exponents of their display denoms in the bank
+	// metadata, as displayed by front-ends.
+	NormalizedSpotPrice(ctx context.Context, in *NormalizedSpotPriceRequest, opts ...grpc.CallOption) (*NormalizedSpotPriceResponse, error)
 	// TotalPoolLiquidity returns the total liquidity of the specified pool.
 	TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error)
 	// TotalLiquidity returns the total liquidity across all pools.
 	TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error)
 	// TotalVolumeForPool returns the total volume of the specified pool.
 	TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error)
+	// ChainStatistics returns the chain-wide pool and OSMO supply statistics
+	// computed at the end of the last statistics epoch.
+	ChainStatistics(ctx context.Context, in *ChainStatisticsRequest, opts ...grpc.CallOption) (*ChainStatisticsResponse, error)
 	// TradingPairTakerFee returns the taker fee for a given set of denoms
 	TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error)
 	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
@@ -1862,6 +2208,15 @@ func (c *queryClient) ListPoolsByDenom(ctx context.Context, in *ListPoolsByDenom
 	return out, nil
 }
 
+func (c *queryClient) RoutesFromDenoms(ctx context.Context, in *RoutesFromDenomsRequest, opts ...grpc.CallOption) (*RoutesFromDenomsResponse, error) {
+	out := new(RoutesFromDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/RoutesFromDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts ...grpc.CallOption) (*SpotPriceResponse, error) {
 	out := new(SpotPriceResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SpotPrice", in, out, opts...)
@@ -1871,6 +2226,15 @@ func (c *queryClient) SpotPrice(ctx context.Context, in *SpotPriceRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) NormalizedSpotPrice(ctx context.Context, in *NormalizedSpotPriceRequest, opts ...grpc.CallOption) (*NormalizedSpotPriceResponse, error) {
+	out := new(NormalizedSpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/NormalizedSpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalPoolLiquidity(ctx context.Context, in *TotalPoolLiquidityRequest, opts ...grpc.CallOption) (*TotalPoolLiquidityResponse, error) {
 	out := new(TotalPoolLiquidityResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity", in, out, opts...)
@@ -1898,6 +2262,15 @@ func (c *queryClient) TotalVolumeForPool(ctx context.Context, in *TotalVolumeFor
 	return out, nil
 }
 
+func (c *queryClient) ChainStatistics(ctx context.Context, in *ChainStatisticsRequest, opts ...grpc.CallOption) (*ChainStatisticsResponse, error) {
+	out := new(ChainStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/ChainStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
 	out := new(TradingPairTakerFeeResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
@@ -1946,15 +2319,25 @@ type QueryServer interface {
 	AllPools(context.Context, *AllPoolsRequest) (*AllPoolsResponse, error)
 	// ListPoolsByDenom return all pools by denom
 	ListPoolsByDenom(context.Context, *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error)
+	// RoutesFromDenoms returns the routes of up to max_hops pools swapping
+	// token_in_denom for token_out_denom, ranked by liquidity.
+	RoutesFromDenoms(context.Context, *RoutesFromDenomsRequest) (*RoutesFromDenomsResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(context.Context, *SpotPriceRequest) (*SpotPriceResponse, error)
+	// NormalizedSpotPrice returns the spot price of the base denom in the quote
+	// denom, scaled by the exponents of their display denoms in the bank
+	// metadata, as displayed by front-ends.
+	NormalizedSpotPrice(context.Context, *NormalizedSpotPriceRequest) (*NormalizedSpotPriceResponse, error)
 	// TotalPoolLiquidity returns the total liquidity of the specified pool.
 	TotalPoolLiquidity(context.Context, *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error)
 	// TotalLiquidity returns the total liquidity across all pools.
 	TotalLiquidity(context.Context, *TotalLiquidityRequest) (*TotalLiquidityResponse, error)
 	// TotalVolumeForPool returns the total volume of the specified pool.
 	TotalVolumeForPool(context.Context, *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error)
+	// ChainStatistics returns the chain-wide pool and OSMO supply statistics
+	// computed at the end of the last statistics epoch.
+	ChainStatistics(context.Context, *ChainStatisticsRequest) (*ChainStatisticsResponse, error)
 	// TradingPairTakerFee returns the taker fee for a given set of denoms
 	TradingPairTakerFee(context.Context, *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error)
 	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
@@ -2000,9 +2383,15 @@ func (*UnimplementedQueryServer) AllPools(ctx context.Context, req *AllPoolsRequ
 func (*UnimplementedQueryServer) ListPoolsByDenom(ctx context.Context, req *ListPoolsByDenomRequest) (*ListPoolsByDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByDenom not implemented")
 }
+func (*UnimplementedQueryServer) RoutesFromDenoms(ctx context.Context, req *RoutesFromDenomsRequest) (*RoutesFromDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoutesFromDenoms not implemented")
+}
 func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *SpotPriceRequest) (*SpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
+func (*UnimplementedQueryServer) NormalizedSpotPrice(ctx context.Context, req *NormalizedSpotPriceRequest) (*NormalizedSpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NormalizedSpotPrice not implemented")
+}
 func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *TotalPoolLiquidityRequest) (*TotalPoolLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalPoolLiquidity not implemented")
 }
@@ -2012,6 +2401,9 @@ func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *TotalL
 func (*UnimplementedQueryServer) TotalVolumeForPool(ctx context.Context, req *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalVolumeForPool not implemented")
 }
+func (*UnimplementedQueryServer) ChainStatistics(ctx context.Context, req *ChainStatisticsRequest) (*ChainStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainStatistics not implemented")
+}
 func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TradingPairTakerFee not implemented")
 }
@@ -2221,6 +2613,24 @@ func _Query_ListPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoutesFromDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoutesFromDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoutesFromDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/RoutesFromDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoutesFromDenoms(ctx, req.(*RoutesFromDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SpotPriceRequest)
 	if err := dec(in); err != nil {
@@ -2239,6 +2649,24 @@ func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NormalizedSpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NormalizedSpotPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NormalizedSpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/NormalizedSpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NormalizedSpotPrice(ctx, req.(*NormalizedSpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalPoolLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TotalPoolLiquidityRequest)
 	if err := dec(in); err != nil {
@@ -2293,7 +2721,25 @@ func _Query_TotalVolumeForPool_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Query_ChainStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/ChainStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainStatistics(ctx, req.(*ChainStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TradingPairTakerFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -2377,10 +2823,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPoolsByDenom",
 			Handler:    _Query_ListPoolsByDenom_Handler,
 		},
+		{
+			MethodName: "RoutesFromDenoms",
+			Handler:    _Query_RoutesFromDenoms_Handler,
+		},
 		{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
 		},
+		{
+			MethodName: "NormalizedSpotPrice",
+			Handler:    _Query_NormalizedSpotPrice_Handler,
+		},
 		{
 			MethodName: "TotalPoolLiquidity",
 			Handler:    _Query_TotalPoolLiquidity_Handler,
@@ -2393,6 +2847,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalVolumeForPool",
 			Handler:    _Query_TotalVolumeForPool_Handler,
 		},
+		{
+			MethodName: "ChainStatistics",
+			Handler:    _Query_ChainStatistics_Handler,
+		},
 		{
 			MethodName: "TradingPairTakerFee",
 			Handler:    _Query_TradingPairTakerFee_Handler,
@@ -3075,6 +3533,85 @@ func (m *ListPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *RoutesFromDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutesFromDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutesFromDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoutesFromDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutesFromDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutesFromDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3147,6 +3684,91 @@ func (m *SpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NormalizedSpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NormalizedSpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NormalizedSpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteAssetDenom) > 0 {
+		i -= len(m.QuoteAssetDenom)
+		copy(dAtA[i:], m.QuoteAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAssetDenom) > 0 {
+		i -= len(m.BaseAssetDenom)
+		copy(dAtA[i:], m.BaseAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NormalizedSpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NormalizedSpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NormalizedSpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuoteAssetExponent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuoteAssetExponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseAssetExponent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BaseAssetExponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3272,6 +3894,62 @@ func (m *TotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TotalVolumeForPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3808,6 +4486,41 @@ func (m *ListPoolsByDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *RoutesFromDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	return n
+}
+
+func (m *RoutesFromDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SpotPriceRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3841,7 +4554,7 @@ func (m *SpotPriceResponse) Size() (n int) {
 	return n
 }
 
-func (m *TotalPoolLiquidityRequest) Size() (n int) {
+func (m *NormalizedSpotPriceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3850,17 +4563,54 @@ func (m *TotalPoolLiquidityRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *TotalPoolLiquidityResponse) Size() (n int) {
+func (m *NormalizedSpotPriceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BaseAssetExponent != 0 {
+		n += 1 + sovQuery(uint64(m.BaseAssetExponent))
+	}
+	if m.QuoteAssetExponent != 0 {
+		n += 1 + sovQuery(uint64(m.QuoteAssetExponent))
+	}
+	return n
+}
+
+func (m *TotalPoolLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *TotalPoolLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3892,6 +4642,26 @@ func (m *TotalLiquidityResponse) Size() (n int) {
 	return n
 }
 
+func (m *ChainStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChainStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statistics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *TotalVolumeForPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5817,7 +6587,7 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *RoutesFromDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5840,17 +6610,17 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoutesFromDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoutesFromDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5860,14 +6630,27 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5895,13 +6678,13 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
 			}
-			var stringLen uint64
+			m.MaxHops = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5911,24 +6694,11 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxHops |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5950,7 +6720,7 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *RoutesFromDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5973,17 +6743,17 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoutesFromDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoutesFromDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5993,23 +6763,25 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, types.RouteWithLiquidity{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6032,7 +6804,7 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6055,10 +6827,10 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6080,61 +6852,43 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6144,25 +6898,23 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6185,7 +6937,7 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6208,12 +6960,44 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6235,7 +7019,7 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *NormalizedSpotPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6258,17 +7042,17 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NormalizedSpotPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NormalizedSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6278,15 +7062,473 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
 			}
-			postIndex := iNdEx + msglen
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NormalizedSpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NormalizedSpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NormalizedSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetExponent", wireType)
+			}
+			m.BaseAssetExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseAssetExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetExponent", wireType)
+			}
+			m.QuoteAssetExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteAssetExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
@@ -6319,6 +7561,139 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChainStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -158,6 +158,13 @@ func (k Keeper) SetPoolRoute(ctx sdk.Context, poolId uint64, poolType types.Pool
 	osmoutils.MustSet(store, types.FormatModuleRouteKey(poolId), &types.ModuleRoute{PoolType: poolType})
 }
 
+// DeletePoolRoute removes the pool ID to pool type mapping from state.
+// It is called by the pool modules when a pool is destroyed, so that the pool can no longer be routed to.
+func (k Keeper) DeletePoolRoute(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatModuleRouteKey(poolId))
+}
+
 // GetPoolModule returns the swap module for the given pool ID.
 // Returns error if:
 // - any database error occurs.
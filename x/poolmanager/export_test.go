@@ -42,7 +42,11 @@ func (k Keeper) CreateMultihopExpectedSwapOuts(
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) ([]osmomath.Int, error) {
-	return k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	return k.createMultihopExpectedSwapOuts(ctx, route, tokenOut, osmomath.Dec{}, osmomath.Dec{})
+}
+
+func (k Keeper) IsOsmoRoutedMultihop(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) bool {
+	return k.isOsmoRoutedMultihop(ctx, route, inDenom, outDenom)
 }
 
 func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
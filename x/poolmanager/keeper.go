@@ -23,6 +23,7 @@ type Keeper struct {
 	communityPoolKeeper  types.CommunityPoolI
 	stakingKeeper        types.StakingKeeper
 	protorevKeeper       types.ProtorevKeeper
+	twapKeeper           types.TwapKeeper
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -33,10 +34,14 @@ type Keeper struct {
 	// use this list to ensure deterministic iteration.
 	poolModules []types.PoolModuleI
 
+	// paramSpace is the legacy x/params subspace of the poolmanager parameters, read to migrate them to the module store.
 	paramSpace paramtypes.Subspace
+
+	// authority is the address allowed to update the poolmanager parameters, the governance module account.
+	authority string
 }
 
-func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, gammKeeper types.PoolModuleI, concentratedKeeper types.PoolModuleI, cosmwasmpoolKeeper types.PoolModuleI, bankKeeper types.BankI, accountKeeper types.AccountI, communityPoolKeeper types.CommunityPoolI, stakingKeeper types.StakingKeeper, protorevKeeper types.ProtorevKeeper) *Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, gammKeeper types.PoolModuleI, concentratedKeeper types.PoolModuleI, cosmwasmpoolKeeper types.PoolModuleI, bankKeeper types.BankI, accountKeeper types.AccountI, communityPoolKeeper types.CommunityPoolI, stakingKeeper types.StakingKeeper, protorevKeeper types.ProtorevKeeper, authority string) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -66,21 +71,23 @@ func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, gam
 		poolModules:         routesList,
 		stakingKeeper:       stakingKeeper,
 		protorevKeeper:      protorevKeeper,
+		authority:           authority,
 	}
 }
 
 // GetParams returns the total set of poolmanager parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 	return params
 }
 
 // SetParams sets the total set of poolmanager parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
 }
 
-// SetParam sets a specific poolmanger module's parameter with the provided parameter.
+// SetParam sets a specific poolmanager parameter in the legacy x/params subspace.
+// It is only used by the upgrade handlers that ran before the parameters were moved to the module store.
 func (k Keeper) SetParam(ctx sdk.Context, key []byte, value interface{}) {
 	k.paramSpace.Set(ctx, key, value)
 }
@@ -112,6 +119,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	} else {
 		k.SetTakerFeeTrackerForCommunityPool(ctx, sdk.NewCoins())
 	}
+	if !genState.TakerFeesTracker.TakerFeesBurned.Empty() {
+		k.SetTakerFeeTrackerForBurn(ctx, genState.TakerFeesTracker.TakerFeesBurned)
+	} else {
+		k.SetTakerFeeTrackerForBurn(ctx, sdk.NewCoins())
+	}
 	if genState.TakerFeesTracker.HeightAccountingStartsFrom != 0 {
 		k.SetTakerFeeTrackerStartHeight(ctx, genState.TakerFeesTracker.HeightAccountingStartsFrom)
 	} else {
@@ -157,6 +169,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
 		TakerFeesToCommunityPool:   k.GetTakerFeeTrackerForCommunityPool(ctx),
 		HeightAccountingStartsFrom: k.GetTakerFeeTrackerStartHeight(ctx),
+		TakerFeesBurned:            k.GetTakerFeeTrackerForBurn(ctx),
 	}
 	return &types.GenesisState{
 		Params:                 k.GetParams(ctx),
@@ -196,3 +209,8 @@ func (k *Keeper) SetStakingKeeper(stakingKeeper types.StakingKeeper) {
 func (k *Keeper) SetProtorevKeeper(protorevKeeper types.ProtorevKeeper) {
 	k.protorevKeeper = protorevKeeper
 }
+
+// SetTwapKeeper sets twap keeper
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
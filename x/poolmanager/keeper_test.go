@@ -23,15 +23,19 @@ var (
 	testDefaultTakerFee          = osmomath.MustNewDecFromStr("0.0015")
 	testOsmoTakerFeeDistribution = types.TakerFeeDistributionPercentage{
 		StakingRewards: osmomath.MustNewDecFromStr("0.3"),
-		CommunityPool:  osmomath.MustNewDecFromStr("0.7"),
+		CommunityPool:  osmomath.MustNewDecFromStr("0.6"),
+		Burn:           osmomath.MustNewDecFromStr("0.1"),
 	}
 	testNonOsmoTakerFeeDistribution = types.TakerFeeDistributionPercentage{
 		StakingRewards: osmomath.MustNewDecFromStr("0.2"),
 		CommunityPool:  osmomath.MustNewDecFromStr("0.8"),
+		Burn:           osmomath.ZeroDec(),
 	}
 	testAdminAddresses                                 = []string{"osmo106x8q2nv7xsg7qrec2zgdf3vvq0t3gn49zvaha", "osmo105l5r3rjtynn7lg362r2m9hkpfvmgmjtkglsn9"}
 	testCommunityPoolDenomToSwapNonWhitelistedAssetsTo = "uusdc"
 	testAuthorizedQuoteDenoms                          = []string{"uosmo", "uion", "uatom"}
+	testStatisticsQuoteDenom                           = "uusdc"
+	testStatisticsEpochIdentifier                      = "week"
 
 	testPoolRoute = []types.ModuleRoute{
 		{
@@ -129,7 +133,9 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 				AdminAddresses:                                 testAdminAddresses,
 				CommunityPoolDenomToSwapNonWhitelistedAssetsTo: testCommunityPoolDenomToSwapNonWhitelistedAssetsTo,
 			},
-			AuthorizedQuoteDenoms: testAuthorizedQuoteDenoms,
+			AuthorizedQuoteDenoms:     testAuthorizedQuoteDenoms,
+			StatisticsQuoteDenom:      testStatisticsQuoteDenom,
+			StatisticsEpochIdentifier: testStatisticsEpochIdentifier,
 		},
 		NextPoolId:             testExpectedPoolId,
 		PoolRoutes:             testPoolRoute,
@@ -147,6 +153,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(testAdminAddresses, params.TakerFeeParams.AdminAddresses)
 	s.Require().Equal(testCommunityPoolDenomToSwapNonWhitelistedAssetsTo, params.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
 	s.Require().Equal(testAuthorizedQuoteDenoms, params.AuthorizedQuoteDenoms)
+	s.Require().Equal(testStatisticsQuoteDenom, params.StatisticsQuoteDenom)
+	s.Require().Equal(testStatisticsEpochIdentifier, params.StatisticsEpochIdentifier)
 	s.Require().Equal(testPoolRoute, s.App.PoolManagerKeeper.GetAllPoolRoutes(s.Ctx))
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToStakers, s.App.PoolManagerKeeper.GetTakerFeeTrackerForStakers(s.Ctx))
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToCommunityPool, s.App.PoolManagerKeeper.GetTakerFeeTrackerForCommunityPool(s.Ctx))
@@ -177,7 +185,9 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 				AdminAddresses:                                 testAdminAddresses,
 				CommunityPoolDenomToSwapNonWhitelistedAssetsTo: testCommunityPoolDenomToSwapNonWhitelistedAssetsTo,
 			},
-			AuthorizedQuoteDenoms: testAuthorizedQuoteDenoms,
+			AuthorizedQuoteDenoms:     testAuthorizedQuoteDenoms,
+			StatisticsQuoteDenom:      testStatisticsQuoteDenom,
+			StatisticsEpochIdentifier: testStatisticsEpochIdentifier,
 		},
 		NextPoolId:             testExpectedPoolId,
 		PoolRoutes:             testPoolRoute,
@@ -195,6 +205,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testAdminAddresses, genesis.Params.TakerFeeParams.AdminAddresses)
 	s.Require().Equal(testCommunityPoolDenomToSwapNonWhitelistedAssetsTo, genesis.Params.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
 	s.Require().Equal(testAuthorizedQuoteDenoms, genesis.Params.AuthorizedQuoteDenoms)
+	s.Require().Equal(testStatisticsQuoteDenom, genesis.Params.StatisticsQuoteDenom)
+	s.Require().Equal(testStatisticsEpochIdentifier, genesis.Params.StatisticsEpochIdentifier)
 	s.Require().Equal(testPoolRoute, genesis.PoolRoutes)
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToStakers, genesis.TakerFeesTracker.TakerFeesToStakers)
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToCommunityPool, genesis.TakerFeesTracker.TakerFeesToCommunityPool)
@@ -3,8 +3,11 @@ package poolmanager
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
@@ -120,6 +123,38 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
 
+// BatchSwapExactAmountIn executes each of the provided swaps in order. Since the swaps are
+// independent, every swap is subject to its own token out minimum. If any swap fails, the
+// whole message fails and none of the swaps are committed.
+func (server msgServer) BatchSwapExactAmountIn(goCtx context.Context, msg *types.MsgBatchSwapExactAmountIn) (*types.MsgBatchSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmounts := make([]osmomath.Int, 0, len(msg.Swaps))
+	for i, swap := range msg.Swaps {
+		tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, swap.Routes, swap.TokenIn, swap.TokenOutMinAmount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute swap at index %d", i)
+		}
+		tokenOutAmounts = append(tokenOutAmounts, tokenOutAmount)
+	}
+
+	// Swap event is handled in each pool module's SwapExactAmountIn
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgBatchSwapExactAmountInResponse{TokenOutAmounts: tokenOutAmounts}, nil
+}
+
 func (server msgServer) SetDenomPairTakerFee(goCtx context.Context, msg *types.MsgSetDenomPairTakerFee) (*types.MsgSetDenomPairTakerFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -141,3 +176,35 @@ func (server msgServer) SetDenomPairTakerFee(goCtx context.Context, msg *types.M
 
 	return &types.MsgSetDenomPairTakerFeeResponse{Success: true}, nil
 }
+
+// UpdateParams sets the poolmanager module parameters, it can only be executed by governance.
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	server.keeper.SetParams(ctx, msg.Params)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateAuthority returns an error if the given address is not the authority of the poolmanager parameters.
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
@@ -2,8 +2,12 @@ package poolmanager_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	poolmanagerKeeper "github.com/osmosis-labs/osmosis/v21/x/poolmanager"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
@@ -198,6 +202,113 @@ func (s *KeeperTestSuite) TestSplitRouteSwapExactAmountOut() {
 	}
 }
 
+func (s *KeeperTestSuite) TestBatchSwapExactAmountIn() {
+	testcases := map[string]struct {
+		swaps []types.BatchSwapExactAmountIn
+
+		expectedSwapEvents int
+		expectedError      bool
+	}{
+		"valid case: two independent swaps": {
+			swaps: []types.BatchSwapExactAmountIn{
+				{
+					Routes:            []types.SwapAmountInRoute{pool1_in, pool2_in},
+					TokenIn:           sdk.NewCoin("foo", amount),
+					TokenOutMinAmount: osmomath.OneInt(),
+				},
+				{
+					Routes:            []types.SwapAmountInRoute{pool3_in},
+					TokenIn:           sdk.NewCoin("bar", amount),
+					TokenOutMinAmount: osmomath.OneInt(),
+				},
+			},
+			expectedSwapEvents: 3,
+		},
+		"valid case: same route twice": {
+			swaps: []types.BatchSwapExactAmountIn{
+				{
+					Routes:            []types.SwapAmountInRoute{pool1_in},
+					TokenIn:           sdk.NewCoin("foo", amount),
+					TokenOutMinAmount: osmomath.OneInt(),
+				},
+				{
+					Routes:            []types.SwapAmountInRoute{pool1_in},
+					TokenIn:           sdk.NewCoin("foo", amount),
+					TokenOutMinAmount: osmomath.OneInt(),
+				},
+			},
+			expectedSwapEvents: 2,
+		},
+		"error: second swap does not meet its token out min amount": {
+			swaps: []types.BatchSwapExactAmountIn{
+				{
+					Routes:            []types.SwapAmountInRoute{pool1_in},
+					TokenIn:           sdk.NewCoin("foo", amount),
+					TokenOutMinAmount: osmomath.OneInt(),
+				},
+				{
+					Routes:            []types.SwapAmountInRoute{pool3_in},
+					TokenIn:           sdk.NewCoin("bar", amount),
+					TokenOutMinAmount: max_amount,
+				},
+			},
+			expectedError: true,
+		},
+		"error: pool does not exist": {
+			swaps: []types.BatchSwapExactAmountIn{
+				{
+					Routes:            []types.SwapAmountInRoute{{PoolId: 5, TokenOutDenom: "bar"}},
+					TokenIn:           sdk.NewCoin("foo", amount),
+					TokenOutMinAmount: osmomath.OneInt(),
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.Setup()
+			ctx := s.Ctx
+
+			s.PrepareBalancerPool()
+			s.PrepareBalancerPool()
+			s.PrepareBalancerPool()
+			s.PrepareBalancerPool()
+
+			msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+
+			// Compute the expected amounts by executing the swaps one by one on a cached context.
+			expectedTokenOutAmounts := make([]osmomath.Int, 0, len(tc.swaps))
+			if !tc.expectedError {
+				cacheCtx, _ := ctx.CacheContext()
+				for _, swap := range tc.swaps {
+					tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(cacheCtx, s.TestAccs[0], swap.Routes, swap.TokenIn, swap.TokenOutMinAmount)
+					s.Require().NoError(err)
+					expectedTokenOutAmounts = append(expectedTokenOutAmounts, tokenOutAmount)
+				}
+			}
+
+			// Reset event counts to 0 by creating a new manager.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			s.Equal(0, len(ctx.EventManager().Events()))
+
+			response, err := msgServer.BatchSwapExactAmountIn(sdk.WrapSDKContext(ctx), &types.MsgBatchSwapExactAmountIn{
+				Sender: s.TestAccs[0].String(),
+				Swaps:  tc.swaps,
+			})
+			if tc.expectedError {
+				s.Require().Error(err)
+				s.Require().Nil(response)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(expectedTokenOutAmounts, response.TokenOutAmounts)
+				s.AssertEventEmitted(ctx, gammtypes.TypeEvtTokenSwapped, tc.expectedSwapEvents)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSetDenomPairTakerFee() {
 	adminAcc := s.TestAccs[0].String()
 	nonAdminAcc := s.TestAccs[1].String()
@@ -286,3 +397,90 @@ func (s *KeeperTestSuite) TestSetDenomPairTakerFee() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := map[string]struct {
+		authority    string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		"update default taker fee and its distribution": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.TakerFeeParams.DefaultTakerFee = osmomath.NewDecWithPrec(2, 3)
+				params.TakerFeeParams.OsmoTakerFeeDistribution = types.TakerFeeDistributionPercentage{
+					StakingRewards: osmomath.NewDecWithPrec(5, 1),
+					CommunityPool:  osmomath.NewDecWithPrec(2, 1),
+					Burn:           osmomath.NewDecWithPrec(3, 1),
+				}
+			},
+		},
+		"update chain statistics and enable the OSMO-routed multihop discount": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.StatisticsQuoteDenom = apptesting.USDC
+				params.OsmoRoutedMultihopDiscountEnabled = true
+			},
+		},
+		"error: not the governance authority": {
+			authority: s.TestAccs[0].String(),
+			updateParams: func(params *types.Params) {
+				params.TakerFeeParams.ReducedFeeWhitelist = []string{s.TestAccs[1].String()}
+			},
+			expectedErr: "invalid authority",
+		},
+		"error: default taker fee above one": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.TakerFeeParams.DefaultTakerFee = osmomath.NewDec(2) },
+			expectedErr:  "invalid default taker fee",
+		},
+		"error: OSMO taker fee community pool and burn distributions above one": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.TakerFeeParams.OsmoTakerFeeDistribution.CommunityPool = osmomath.NewDecWithPrec(6, 1)
+				params.TakerFeeParams.OsmoTakerFeeDistribution.Burn = osmomath.NewDecWithPrec(6, 1)
+			},
+			expectedErr: "distributions must not exceed 1",
+		},
+		"error: burned non-OSMO taker fees": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.TakerFeeParams.NonOsmoTakerFeeDistribution.Burn = osmomath.NewDecWithPrec(1, 1)
+			},
+			expectedErr: "burn distribution must be zero for non-OSMO taker fees",
+		},
+		"error: no authorized quote denoms": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.AuthorizedQuoteDenoms = []string{} },
+			expectedErr:  "authorized quote denoms cannot be empty",
+		},
+		"error: invalid reduced taker fee whitelist address": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.TakerFeeParams.ReducedFeeWhitelist = []string{"invalid"} },
+			expectedErr:  "invalid address",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			originalParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			newParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			tc.updateParams(&newParams)
+			msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: tc.authority, Params: newParams})
+
+			params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, params)
+		})
+	}
+}
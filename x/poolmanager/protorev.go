@@ -32,6 +32,18 @@ func (k Keeper) IncreaseTakerFeeTrackerForCommunityPool(ctx sdk.Context, takerFe
 	}
 }
 
+// IncreaseTakerFeeTrackerForBurn gets the current value of the burned taker fee tracker, adds the given amount to it, and sets the new value.
+func (k Keeper) IncreaseTakerFeeTrackerForBurn(ctx sdk.Context, takerFeeBurned sdk.Coin) {
+	currentTakerFeeBurned := k.GetTakerFeeTrackerForBurn(ctx)
+	if !takerFeeBurned.IsZero() {
+		newTakerFeeBurnedCoins := currentTakerFeeBurned.Add(takerFeeBurned)
+		newTakerFeeBurned := types.TrackedVolume{
+			Amount: newTakerFeeBurnedCoins,
+		}
+		osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyTakerFeeBurnedProtoRev, &newTakerFeeBurned)
+	}
+}
+
 func (k Keeper) SetTakerFeeTrackerForStakers(ctx sdk.Context, takerFeeForStakers sdk.Coins) {
 	newTakerFeeForStakers := types.TrackedVolume{
 		Amount: takerFeeForStakers,
@@ -88,6 +100,30 @@ func (k Keeper) GetTakerFeeTrackerForCommunityPool(ctx sdk.Context) (currentTake
 	return currentTakerFeeForCommunityPool
 }
 
+func (k Keeper) SetTakerFeeTrackerForBurn(ctx sdk.Context, takerFeeBurned sdk.Coins) {
+	newTakerFeeBurned := types.TrackedVolume{
+		Amount: takerFeeBurned,
+	}
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyTakerFeeBurnedProtoRev, &newTakerFeeBurned)
+}
+
+func (k Keeper) GetTakerFeeTrackerForBurn(ctx sdk.Context) (currentTakerFeeBurned sdk.Coins) {
+	var takerFeeBurned types.TrackedVolume
+	takerFeeFound, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyTakerFeeBurnedProtoRev, &takerFeeBurned)
+	if err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+
+	// If nothing was burned yet, we treat the burned amount as 0.
+	currentTakerFeeBurned = sdk.Coins(nil)
+	if takerFeeFound {
+		currentTakerFeeBurned = takerFeeBurned.Amount
+	}
+
+	return currentTakerFeeBurned
+}
+
 // GetTakerFeeTrackerStartHeight gets the height from which we started accounting for taker fees.
 func (k Keeper) GetTakerFeeTrackerStartHeight(ctx sdk.Context) int64 {
 	startHeight := gogotypes.Int64Value{}
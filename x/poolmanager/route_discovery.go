@@ -0,0 +1,130 @@
+package poolmanager
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// MaxRouteDiscoveryHops is the maximum number of pools of the routes returned by RoutesFromDenoms.
+// The number of candidate routes grows exponentially with the number of hops, as most pools are paired with OSMO.
+const MaxRouteDiscoveryHops = 3
+
+// routeSearch holds the state of the depth first search of the routes between two denoms.
+type routeSearch struct {
+	tokenOutDenom string
+	maxHops       int
+	poolsByDenom  map[string][]types.PoolI
+
+	// Route being built, the pools and denoms it uses cannot be reused within the route.
+	route         []types.SwapAmountInRoute
+	visitedPools  map[uint64]bool
+	visitedDenoms map[string]bool
+
+	routes []types.RouteWithLiquidity
+}
+
+// RoutesFromDenoms returns the routes of up to maxHops pools swapping tokenInDenom for tokenOutDenom.
+// A route never goes through the same pool or denom twice, and only goes through active pools
+// holding both denoms of their hop.
+//
+// The routes are ranked by liquidity in descending order, then by number of hops in ascending order.
+// The liquidity of a route is the liquidity of its least liquid pool, which is the amount of the token in
+// denom of its hop held by the pool, priced in tokenInDenom with the spot prices of the previous hops.
+// Routes whose liquidity cannot be computed, because a pool does not have a spot price, are left out.
+//
+// Returns error if maxHops is zero or greater than MaxRouteDiscoveryHops.
+func (k Keeper) RoutesFromDenoms(ctx sdk.Context, tokenInDenom, tokenOutDenom string, maxHops uint64) ([]types.RouteWithLiquidity, error) {
+	if maxHops == 0 || maxHops > MaxRouteDiscoveryHops {
+		return nil, types.InvalidMaxHopsError{MaxHops: maxHops, MaxAllowedHops: MaxRouteDiscoveryHops}
+	}
+
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Index the active pools by denom, the pools of every denom are sorted by id since all pools are.
+	poolsByDenom := make(map[string][]types.PoolI)
+	for _, pool := range pools {
+		if !pool.IsActive(ctx) {
+			continue
+		}
+		for _, denom := range pool.GetPoolDenoms(ctx) {
+			poolsByDenom[denom] = append(poolsByDenom[denom], pool)
+		}
+	}
+
+	search := routeSearch{
+		tokenOutDenom: tokenOutDenom,
+		maxHops:       int(maxHops),
+		poolsByDenom:  poolsByDenom,
+		visitedPools:  map[uint64]bool{},
+		visitedDenoms: map[string]bool{tokenInDenom: true},
+		routes:        []types.RouteWithLiquidity{},
+	}
+	k.searchRoutes(ctx, &search, tokenInDenom, osmomath.OneBigDec(), osmomath.Int{})
+
+	sort.SliceStable(search.routes, func(i, j int) bool {
+		if !search.routes[i].Liquidity.Equal(search.routes[j].Liquidity) {
+			return search.routes[i].Liquidity.GT(search.routes[j].Liquidity)
+		}
+		return len(search.routes[i].Pools) < len(search.routes[j].Pools)
+	})
+
+	return search.routes, nil
+}
+
+// searchRoutes extends the route of the search with every pool holding denom, and records the routes reaching
+// the token out denom of the search.
+// denomPrice is the spot price of denom in units of the token in denom of the search, and liquidity is the
+// liquidity of the least liquid pool of the route so far, which is nil for the first hop.
+func (k Keeper) searchRoutes(ctx sdk.Context, search *routeSearch, denom string, denomPrice osmomath.BigDec, liquidity osmomath.Int) {
+	isLastHop := len(search.route)+1 == search.maxHops
+	for _, pool := range search.poolsByDenom[denom] {
+		poolId := pool.GetId()
+		if search.visitedPools[poolId] {
+			continue
+		}
+
+		poolLiquidity, err := k.GetTotalPoolLiquidity(ctx, poolId)
+		if err != nil || !poolLiquidity.AmountOf(denom).IsPositive() {
+			continue
+		}
+		hopLiquidity := osmomath.BigDecFromSDKInt(poolLiquidity.AmountOf(denom)).Mul(denomPrice).Dec().TruncateInt()
+		routeLiquidity := hopLiquidity
+		if !liquidity.IsNil() && liquidity.LT(hopLiquidity) {
+			routeLiquidity = liquidity
+		}
+
+		for _, nextDenom := range pool.GetPoolDenoms(ctx) {
+			if search.visitedDenoms[nextDenom] || !poolLiquidity.AmountOf(nextDenom).IsPositive() {
+				continue
+			}
+			if nextDenom != search.tokenOutDenom && isLastHop {
+				continue
+			}
+
+			search.route = append(search.route, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: nextDenom})
+			if nextDenom == search.tokenOutDenom {
+				route := make([]types.SwapAmountInRoute, len(search.route))
+				copy(route, search.route)
+				search.routes = append(search.routes, types.RouteWithLiquidity{Pools: route, Liquidity: routeLiquidity})
+			} else {
+				// The price of the next denom in units of the current one, used to price the liquidity of the next hops.
+				spotPrice, err := k.RouteCalculateSpotPrice(ctx, poolId, denom, nextDenom)
+				if err == nil {
+					search.visitedPools[poolId] = true
+					search.visitedDenoms[nextDenom] = true
+					k.searchRoutes(ctx, search, nextDenom, denomPrice.Mul(spotPrice), routeLiquidity)
+					delete(search.visitedPools, poolId)
+					delete(search.visitedDenoms, nextDenom)
+				}
+			}
+			search.route = search.route[:len(search.route)-1]
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestRoutesFromDenoms() {
+	const noPoolsDenom = "nopools"
+
+	tests := map[string]struct {
+		tokenInDenom  string
+		tokenOutDenom string
+		maxHops       uint64
+
+		expectedRoutes []types.RouteWithLiquidity
+		expectedErr    error
+	}{
+		"one hop": {
+			tokenInDenom:  FOO,
+			tokenOutDenom: BAR,
+			maxHops:       1,
+			expectedRoutes: []types.RouteWithLiquidity{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: BAR}}, Liquidity: osmomath.NewInt(500_000)},
+			},
+		},
+		"two hops, ranked by liquidity": {
+			tokenInDenom:  FOO,
+			tokenOutDenom: BAR,
+			maxHops:       2,
+			expectedRoutes: []types.RouteWithLiquidity{
+				// The least liquid pool is pool 1 with 1_000_000 foo, pool 2 holds 4_000_000 uosmo worth 2_000_000 foo.
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 2, TokenOutDenom: BAR}}, Liquidity: osmomath.NewInt(1_000_000)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: BAR}}, Liquidity: osmomath.NewInt(500_000)},
+			},
+		},
+		"three hops, ties ranked by number of hops": {
+			tokenInDenom:  FOO,
+			tokenOutDenom: BAR,
+			maxHops:       3,
+			expectedRoutes: []types.RouteWithLiquidity{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 2, TokenOutDenom: BAR}}, Liquidity: osmomath.NewInt(1_000_000)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: BAR}}, Liquidity: osmomath.NewInt(500_000)},
+				// Pools 4 and 5 hold 1_000_000 uosmo and 1_000_000 baz, both worth 500_000 foo.
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 4, TokenOutDenom: BAZ}, {PoolId: 5, TokenOutDenom: BAR}}, Liquidity: osmomath.NewInt(500_000)},
+			},
+		},
+		"reverse direction": {
+			tokenInDenom:  BAR,
+			tokenOutDenom: FOO,
+			maxHops:       1,
+			expectedRoutes: []types.RouteWithLiquidity{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: FOO}}, Liquidity: osmomath.NewInt(2_500_000)},
+			},
+		},
+		"no routes": {
+			tokenInDenom:   FOO,
+			tokenOutDenom:  noPoolsDenom,
+			maxHops:        3,
+			expectedRoutes: []types.RouteWithLiquidity{},
+		},
+		"error: zero max hops": {
+			tokenInDenom:  FOO,
+			tokenOutDenom: BAR,
+			maxHops:       0,
+			expectedErr:   types.InvalidMaxHopsError{MaxHops: 0, MaxAllowedHops: poolmanager.MaxRouteDiscoveryHops},
+		},
+		"error: too many max hops": {
+			tokenInDenom:  FOO,
+			tokenOutDenom: BAR,
+			maxHops:       poolmanager.MaxRouteDiscoveryHops + 1,
+			expectedErr:   types.InvalidMaxHopsError{MaxHops: poolmanager.MaxRouteDiscoveryHops + 1, MaxAllowedHops: poolmanager.MaxRouteDiscoveryHops},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			// Pool 1: 1 foo = 2 uosmo.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, osmomath.NewInt(1_000_000)), sdk.NewCoin(UOSMO, osmomath.NewInt(2_000_000)))
+			// Pool 2: 1 uosmo = 3 bar.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(4_000_000)), sdk.NewCoin(BAR, osmomath.NewInt(12_000_000)))
+			// Pool 3: 1 foo = 5 bar.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, osmomath.NewInt(500_000)), sdk.NewCoin(BAR, osmomath.NewInt(2_500_000)))
+			// Pool 4: 1 uosmo = 1 baz.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)), sdk.NewCoin(BAZ, osmomath.NewInt(1_000_000)))
+			// Pool 5: 1 baz = 1 bar.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(BAZ, osmomath.NewInt(1_000_000)), sdk.NewCoin(BAR, osmomath.NewInt(1_000_000)))
+
+			routes, err := s.App.PoolManagerKeeper.RoutesFromDenoms(s.Ctx, tc.tokenInDenom, tc.tokenOutDenom, tc.maxHops)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRoutes, routes)
+		})
+	}
+}
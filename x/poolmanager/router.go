@@ -13,6 +13,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
 	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
@@ -45,6 +46,12 @@ func (k Keeper) RouteExactAmountIn(
 		return osmomath.Int{}, err
 	}
 
+	// If the route is an OSMO-routed multihop, both pools are charged a share of the spread factor of a single pool.
+	routeSpreadFactor, sumOfSpreadFactors, err := k.getOsmoRoutedMultihopSpreadFactors(ctx, types.SwapAmountInRoutes(route), tokenIn.Denom, route[len(route)-1].TokenOutDenom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Iterate through the route and execute a series of swaps through each pool.
 	for i, routeStep := range route {
 		// To prevent the multihop swap from being interrupted prematurely, we keep
@@ -54,7 +61,7 @@ func (k Keeper) RouteExactAmountIn(
 			_outMinAmount = tokenOutMinAmount
 		}
 
-		tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount)
+		tokenOutAmount, err = k.swapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount, routeSpreadFactor, sumOfSpreadFactors)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -145,6 +152,20 @@ func (k Keeper) SwapExactAmountIn(
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, err error) {
+	return k.swapExactAmountIn(ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount, osmomath.Dec{}, osmomath.Dec{})
+}
+
+// swapExactAmountIn is SwapExactAmountIn, charging the pool its share of the given OSMO-routed multihop
+// spread factor if sumOfSpreadFactors is not nil, or its own spread factor otherwise.
+func (k Keeper) swapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount osmomath.Int,
+	routeSpreadFactor, sumOfSpreadFactors osmomath.Dec,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Get the pool-specific module implementation to ensure that
 	// swaps are routed to the pool type corresponding to pool ID's pool.
@@ -170,8 +191,10 @@ func (k Keeper) SwapExactAmountIn(
 		return osmomath.Int{}, err
 	}
 
+	spreadFactor := osmoRoutedMultihopPoolSpreadFactor(pool.GetSpreadFactor(ctx), routeSpreadFactor, sumOfSpreadFactors)
+
 	// routeStep to the pool-specific SwapExactAmountIn implementation.
-	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenInAfterSubTakerFee, tokenOutDenom, tokenOutMinAmount, pool.GetSpreadFactor(ctx))
+	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenInAfterSubTakerFee, tokenOutDenom, tokenOutMinAmount, spreadFactor)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -243,6 +266,11 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 		return osmomath.Int{}, err
 	}
 
+	routeSpreadFactor, sumOfSpreadFactors, err := k.getOsmoRoutedMultihopSpreadFactors(ctx, types.SwapAmountInRoutes(route), tokenIn.Denom, route[len(route)-1].TokenOutDenom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	for _, routeStep := range route {
 		swapModule, err := k.GetPoolModule(ctx, routeStep.PoolId)
 		if err != nil {
@@ -255,7 +283,7 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 			return osmomath.Int{}, poolErr
 		}
 
-		spreadFactor := poolI.GetSpreadFactor(ctx)
+		spreadFactor := osmoRoutedMultihopPoolSpreadFactor(poolI.GetSpreadFactor(ctx), routeSpreadFactor, sumOfSpreadFactors)
 
 		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenOutDenom, tokenIn.Denom)
 		if err != nil {
@@ -292,7 +320,6 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	tokenInMaxAmount osmomath.Int,
 	tokenOut sdk.Coin,
 ) (tokenInAmount osmomath.Int, err error) {
-	isMultiHopRouted, routeSpreadFactor, sumOfSpreadFactors := false, osmomath.Dec{}, osmomath.Dec{}
 	// Ensure that provided route is not empty and has valid denom format.
 	if err := types.SwapAmountOutRoutes(route).Validate(); err != nil {
 		return osmomath.Int{}, err
@@ -305,8 +332,14 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 	}()
 
+	// If the route is an OSMO-routed multihop, both pools are charged a share of the spread factor of a single pool.
+	routeSpreadFactor, sumOfSpreadFactors, err := k.getOsmoRoutedMultihopSpreadFactors(ctx, types.SwapAmountOutRoutes(route), route[0].TokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	var insExpected []osmomath.Int
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut, routeSpreadFactor, sumOfSpreadFactors)
 
 	if err != nil {
 		return osmomath.Int{}, err
@@ -345,12 +378,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			return osmomath.Int{}, types.InactivePoolError{PoolId: pool.GetId()}
 		}
 
-		spreadFactor := pool.GetSpreadFactor(ctx)
-		// If we determined the routeStep is an osmo multi-hop and both route are incentivized,
-		// we modify the swap fee accordingly.
-		if isMultiHopRouted {
-			spreadFactor = routeSpreadFactor.Mul((spreadFactor.Quo(sumOfSpreadFactors)))
-		}
+		spreadFactor := osmoRoutedMultihopPoolSpreadFactor(pool.GetSpreadFactor(ctx), routeSpreadFactor, sumOfSpreadFactors)
 
 		curTokenInAmount, swapErr := swapModule.SwapExactAmountOut(ctx, sender, pool, routeStep.TokenInDenom, insExpected[i], _tokenOut, spreadFactor)
 		if swapErr != nil {
@@ -482,6 +510,48 @@ func (k Keeper) RouteCalculateSpotPrice(
 	return price, nil
 }
 
+// RouteCalculateNormalizedSpotPrice returns the spot price of the base asset in the quote asset of the given pool,
+// scaled by the exponents of their display denoms, so that it is the price of one display unit of the base asset
+// in display units of the quote asset. E.g. the spot price of uatom in uosmo, which have 6 decimals each, is left
+// as is, while the spot price of an 18 decimals asset in uosmo is scaled by 10^12.
+// Returns the exponents of the display denoms of the base and quote assets, zero if they have no bank metadata.
+func (k Keeper) RouteCalculateNormalizedSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	quoteAssetDenom string,
+	baseAssetDenom string,
+) (price osmomath.BigDec, baseAssetExponent, quoteAssetExponent uint32, err error) {
+	price, err = k.RouteCalculateSpotPrice(ctx, poolId, quoteAssetDenom, baseAssetDenom)
+	if err != nil {
+		return osmomath.BigDec{}, 0, 0, err
+	}
+
+	baseAssetExponent = k.getDisplayExponent(ctx, baseAssetDenom)
+	quoteAssetExponent = k.getDisplayExponent(ctx, quoteAssetDenom)
+	if baseAssetExponent > quoteAssetExponent {
+		price = price.Mul(osmomath.NewBigDec(10).PowerInteger(uint64(baseAssetExponent - quoteAssetExponent)))
+	} else if quoteAssetExponent > baseAssetExponent {
+		price = price.Quo(osmomath.NewBigDec(10).PowerInteger(uint64(quoteAssetExponent - baseAssetExponent)))
+	}
+
+	return price, baseAssetExponent, quoteAssetExponent, nil
+}
+
+// getDisplayExponent returns the exponent of the display denom unit of the given denom in its bank metadata.
+// Returns zero if the denom has no metadata or if its display denom unit is not found, i.e. the denom is displayed as is.
+func (k Keeper) getDisplayExponent(ctx sdk.Context, denom string) uint32 {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0
+	}
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display {
+			return denomUnit.Exponent
+		}
+	}
+	return 0
+}
+
 func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
@@ -502,8 +572,13 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 		return osmomath.Int{}, err
 	}
 
+	routeSpreadFactor, sumOfSpreadFactors, err := k.getOsmoRoutedMultihopSpreadFactors(ctx, routeStep, route[0].TokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Determine what the estimated input would be for each pool along the multi-hop route
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut, routeSpreadFactor, sumOfSpreadFactors)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -578,10 +653,13 @@ func (k Keeper) ListPoolsByDenom(
 // amount for this last pool and then chains that input as the output of the previous pool in the routeStep, repeating
 // until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
 // routeStep of pools for the original multihop transaction.
+// Each pool is charged its share of the given OSMO-routed multihop spread factor if sumOfSpreadFactors is not nil,
+// or its own spread factor otherwise.
 func (k Keeper) createMultihopExpectedSwapOuts(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+	routeSpreadFactor, sumOfSpreadFactors osmomath.Dec,
 ) ([]osmomath.Int, error) {
 	insExpected := make([]osmomath.Int, len(route))
 	for i := len(route) - 1; i >= 0; i-- {
@@ -597,7 +675,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
-		spreadFactor := poolI.GetSpreadFactor(ctx)
+		spreadFactor := osmoRoutedMultihopPoolSpreadFactor(poolI.GetSpreadFactor(ctx), routeSpreadFactor, sumOfSpreadFactors)
 
 		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
 		if err != nil {
@@ -618,6 +696,70 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 	return insExpected, nil
 }
 
+// isOsmoRoutedMultihop returns true if the OSMO-routed multihop discount is enabled and the given route:
+//   - has exactly two pools, which are different
+//   - swaps through OSMO as the only intermediate denom, between two different denoms
+//   - only goes through pools that are internally incentivized
+func (k Keeper) isOsmoRoutedMultihop(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) bool {
+	if route.Length() != 2 || inDenom == outDenom {
+		return false
+	}
+	if !k.GetParams(ctx).OsmoRoutedMultihopDiscountEnabled {
+		return false
+	}
+	intermediateDenoms := route.IntermediateDenoms()
+	if len(intermediateDenoms) != 1 || intermediateDenoms[0] != appparams.BaseCoinUnit {
+		return false
+	}
+	poolIds := route.PoolIds()
+	if poolIds[0] == poolIds[1] {
+		return false
+	}
+
+	for _, poolId := range poolIds {
+		isIncentivized, err := k.poolIncentivesKeeper.IsPoolIncentivized(ctx, poolId)
+		if err != nil || !isIncentivized {
+			return false
+		}
+	}
+	return true
+}
+
+// getOsmoRoutedMultihopSpreadFactors returns the spread factor charged for the whole route and the sum of the spread factors
+// of its pools if the given route is an OSMO-routed multihop, so that the route is charged the spread factor of a single pool
+// rather than the spread factors of both pools, matching a swap against a direct pool.
+// The route spread factor is the highest spread factor of its pools, as a lower bound on the fee of the whole route.
+// Returns nil decimals if the route is not an OSMO-routed multihop, in which case each pool charges its own spread factor.
+func (k Keeper) getOsmoRoutedMultihopSpreadFactors(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) (routeSpreadFactor, sumOfSpreadFactors osmomath.Dec, err error) {
+	if !k.isOsmoRoutedMultihop(ctx, route, inDenom, outDenom) {
+		return osmomath.Dec{}, osmomath.Dec{}, nil
+	}
+
+	routeSpreadFactor, sumOfSpreadFactors = osmomath.ZeroDec(), osmomath.ZeroDec()
+	for _, poolId := range route.PoolIds() {
+		pool, err := k.GetPool(ctx, poolId)
+		if err != nil {
+			return osmomath.Dec{}, osmomath.Dec{}, err
+		}
+
+		spreadFactor := pool.GetSpreadFactor(ctx)
+		routeSpreadFactor = osmomath.MaxDec(routeSpreadFactor, spreadFactor)
+		sumOfSpreadFactors = sumOfSpreadFactors.Add(spreadFactor)
+	}
+	return routeSpreadFactor, sumOfSpreadFactors, nil
+}
+
+// osmoRoutedMultihopPoolSpreadFactor returns the spread factor charged by a pool of an OSMO-routed multihop route,
+// its share of the route spread factor pro-rata to its own spread factor. For two pools with the same spread factor,
+// each pool charges half of it.
+// Returns the pool spread factor if sumOfSpreadFactors is nil, i.e. the route is not an OSMO-routed multihop, or zero.
+func osmoRoutedMultihopPoolSpreadFactor(poolSpreadFactor, routeSpreadFactor, sumOfSpreadFactors osmomath.Dec) osmomath.Dec {
+	if sumOfSpreadFactors.IsNil() || sumOfSpreadFactors.IsZero() {
+		return poolSpreadFactor
+	}
+	return routeSpreadFactor.MulRoundUp(poolSpreadFactor.QuoRoundUp(sumOfSpreadFactors))
+}
+
 // GetTotalPoolLiquidity gets the total liquidity for a given poolId.
 func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
 	swapModule, err := k.GetPoolModule(ctx, poolId)
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/golang/mock/gomock"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -431,6 +432,108 @@ func (s *KeeperTestSuite) TestRouteCalculateSpotPrice() {
 	}
 }
 
+// TestRouteCalculateNormalizedSpotPrice tests that the spot price is scaled by the exponents of the display denoms
+// of the base and quote assets.
+func (s *KeeperTestSuite) TestRouteCalculateNormalizedSpotPrice() {
+	tests := map[string]struct {
+		poolId                     uint64
+		baseAssetMetadataExponent  *uint32
+		quoteAssetMetadataExponent *uint32
+		displayUnitNotFound        bool
+
+		expectedSpotPrice          osmomath.BigDec
+		expectedBaseAssetExponent  uint32
+		expectedQuoteAssetExponent uint32
+		expectError                error
+	}{
+		"no metadata, spot price is not scaled": {
+			poolId:            1,
+			expectedSpotPrice: osmomath.MustNewBigDecFromStr("1.5"),
+		},
+		"same exponents, spot price is not scaled": {
+			poolId:                     1,
+			baseAssetMetadataExponent:  uint32Ptr(6),
+			quoteAssetMetadataExponent: uint32Ptr(6),
+			expectedSpotPrice:          osmomath.MustNewBigDecFromStr("1.5"),
+			expectedBaseAssetExponent:  6,
+			expectedQuoteAssetExponent: 6,
+		},
+		"base exponent greater than quote exponent": {
+			poolId:                     1,
+			baseAssetMetadataExponent:  uint32Ptr(18),
+			quoteAssetMetadataExponent: uint32Ptr(6),
+			expectedSpotPrice:          osmomath.MustNewBigDecFromStr("1500000000000"),
+			expectedBaseAssetExponent:  18,
+			expectedQuoteAssetExponent: 6,
+		},
+		"quote exponent greater than base exponent": {
+			poolId:                     1,
+			baseAssetMetadataExponent:  uint32Ptr(6),
+			quoteAssetMetadataExponent: uint32Ptr(18),
+			expectedSpotPrice:          osmomath.MustNewBigDecFromStr("0.0000000000015"),
+			expectedBaseAssetExponent:  6,
+			expectedQuoteAssetExponent: 18,
+		},
+		"only base asset metadata": {
+			poolId:                    1,
+			baseAssetMetadataExponent: uint32Ptr(8),
+			expectedSpotPrice:         osmomath.MustNewBigDecFromStr("150000000"),
+			expectedBaseAssetExponent: 8,
+		},
+		"display denom unit not found, spot price is not scaled": {
+			poolId:                     1,
+			baseAssetMetadataExponent:  uint32Ptr(18),
+			quoteAssetMetadataExponent: uint32Ptr(6),
+			displayUnitNotFound:        true,
+			expectedSpotPrice:          osmomath.MustNewBigDecFromStr("1.5"),
+		},
+		"non-existent pool": {
+			poolId:      2,
+			expectError: types.FailedToFindRouteError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			s.CreatePoolFromType(types.Balancer)
+
+			setMetadata := func(denom string, exponent *uint32) {
+				if exponent == nil {
+					return
+				}
+				display := "display" + denom
+				metadata := banktypes.Metadata{
+					Base:       denom,
+					Display:    display,
+					DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: display, Exponent: *exponent}},
+				}
+				if tc.displayUnitNotFound {
+					metadata.Display = "unknown" + denom
+				}
+				s.App.BankKeeper.SetDenomMetaData(s.Ctx, metadata)
+			}
+			setMetadata("baz", tc.baseAssetMetadataExponent)
+			setMetadata("bar", tc.quoteAssetMetadataExponent)
+
+			spotPrice, baseAssetExponent, quoteAssetExponent, err := s.App.PoolManagerKeeper.RouteCalculateNormalizedSpotPrice(s.Ctx, tc.poolId, "bar", "baz")
+			if tc.expectError != nil {
+				s.Require().ErrorContains(err, tc.expectError.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSpotPrice, spotPrice)
+			s.Require().Equal(tc.expectedBaseAssetExponent, baseAssetExponent)
+			s.Require().Equal(tc.expectedQuoteAssetExponent, quoteAssetExponent)
+		})
+	}
+}
+
+func uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
 // TestMultihopSwapExactAmountIn tests that the swaps are routed correctly.
 // That is:
 // - to the correct module (concentrated-liquidity or gamm)
@@ -1309,6 +1412,194 @@ func (s *KeeperTestSuite) TestEstimateMultihopSwapExactAmountOut() {
 	}
 }
 
+// TestOsmoRoutedMultihopDiscount tests that two-hop routes through OSMO between two incentivized pools
+// are charged the spread factor of a single pool when the discount is enabled, split between both pools
+// pro-rata to their spread factors, for both exact in and exact out swaps and their estimates.
+func (s *KeeperTestSuite) TestOsmoRoutedMultihopDiscount() {
+	const (
+		fooUosmoPoolId = uint64(1)
+		uosmoBazPoolId = uint64(2)
+		fooBarPoolId   = uint64(3)
+		barBazPoolId   = uint64(4)
+	)
+	var (
+		osmoRoutedInRoute = []types.SwapAmountInRoute{{PoolId: fooUosmoPoolId, TokenOutDenom: UOSMO}, {PoolId: uosmoBazPoolId, TokenOutDenom: BAZ}}
+		barRoutedInRoute  = []types.SwapAmountInRoute{{PoolId: fooBarPoolId, TokenOutDenom: BAR}, {PoolId: barBazPoolId, TokenOutDenom: BAZ}}
+		// Balancer pools have a gauge for each of the three lockable durations.
+		allGauges = []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	)
+
+	tests := map[string]struct {
+		discountEnabled    bool
+		spreadFactors      []osmomath.Dec
+		routes             []types.SwapAmountInRoute
+		incentivizedGauges []uint64
+		// expectedSpreadFactors are the spread factors charged by each pool along the routes.
+		expectedSpreadFactors []osmomath.Dec
+	}{
+		"OSMO-routed, same spread factors, each pool charges half": {
+			discountEnabled:       true,
+			spreadFactors:         []osmomath.Dec{osmomath.NewDecWithPrec(2, 2), osmomath.NewDecWithPrec(2, 2)},
+			routes:                osmoRoutedInRoute,
+			incentivizedGauges:    allGauges,
+			expectedSpreadFactors: []osmomath.Dec{osmomath.NewDecWithPrec(1, 2), osmomath.NewDecWithPrec(1, 2)},
+		},
+		"OSMO-routed, different spread factors, the highest is split pro-rata": {
+			discountEnabled:    true,
+			spreadFactors:      []osmomath.Dec{osmomath.NewDecWithPrec(1, 2), osmomath.NewDecWithPrec(3, 2)},
+			routes:             osmoRoutedInRoute,
+			incentivizedGauges: allGauges,
+			// 0.03 * 0.01 / 0.04 and 0.03 * 0.03 / 0.04
+			expectedSpreadFactors: []osmomath.Dec{osmomath.MustNewDecFromStr("0.0075"), osmomath.MustNewDecFromStr("0.0225")},
+		},
+		"OSMO-routed, discount disabled": {
+			spreadFactors:         []osmomath.Dec{osmomath.NewDecWithPrec(2, 2), osmomath.NewDecWithPrec(2, 2)},
+			routes:                osmoRoutedInRoute,
+			incentivizedGauges:    allGauges,
+			expectedSpreadFactors: []osmomath.Dec{osmomath.NewDecWithPrec(2, 2), osmomath.NewDecWithPrec(2, 2)},
+		},
+		"OSMO-routed, second pool not incentivized": {
+			discountEnabled:       true,
+			spreadFactors:         []osmomath.Dec{osmomath.NewDecWithPrec(2, 2), osmomath.NewDecWithPrec(2, 2)},
+			routes:                osmoRoutedInRoute,
+			incentivizedGauges:    []uint64{1, 2, 3},
+			expectedSpreadFactors: []osmomath.Dec{osmomath.NewDecWithPrec(2, 2), osmomath.NewDecWithPrec(2, 2)},
+		},
+		"not routed through OSMO": {
+			discountEnabled:       true,
+			spreadFactors:         []osmomath.Dec{osmomath.NewDecWithPrec(2, 2), osmomath.NewDecWithPrec(2, 2)},
+			routes:                barRoutedInRoute,
+			incentivizedGauges:    allGauges,
+			expectedSpreadFactors: []osmomath.Dec{osmomath.NewDecWithPrec(2, 2), osmomath.NewDecWithPrec(2, 2)},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+
+			params := poolmanagerKeeper.GetParams(s.Ctx)
+			params.OsmoRoutedMultihopDiscountEnabled = tc.discountEnabled
+			poolmanagerKeeper.SetParams(s.Ctx, params)
+
+			for _, poolCoins := range []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(FOO, defaultInitPoolAmount), sdk.NewCoin(UOSMO, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount), sdk.NewCoin(BAZ, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(FOO, defaultInitPoolAmount), sdk.NewCoin(BAR, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(BAR, defaultInitPoolAmount), sdk.NewCoin(BAZ, defaultInitPoolAmount)),
+			} {
+				s.FundAcc(s.TestAccs[0], poolCoins)
+				spreadFactor := tc.spreadFactors[0]
+				if poolCoins.AmountOf(BAZ).IsPositive() {
+					spreadFactor = tc.spreadFactors[1]
+				}
+				s.CreatePoolFromTypeWithCoinsAndSpreadFactor(types.Balancer, poolCoins, spreadFactor)
+			}
+			s.makeGaugesIncentivized(tc.incentivizedGauges)
+
+			tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(100000))
+			tokenOut := sdk.NewCoin(BAZ, osmomath.NewInt(100000))
+			outRoutes := []types.SwapAmountOutRoute{
+				{PoolId: tc.routes[0].PoolId, TokenInDenom: FOO},
+				{PoolId: tc.routes[1].PoolId, TokenInDenom: tc.routes[0].TokenOutDenom},
+			}
+
+			// Exact amount in.
+			expectedTokenOut := s.calcOutGivenInAmountAsSeparatePoolSwaps(tc.routes, tokenIn, tc.expectedSpreadFactors...)
+			estimatedTokenOutAmount, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, tc.routes, tokenIn)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut.Amount, estimatedTokenOutAmount)
+
+			cacheCtx, _ := s.Ctx.CacheContext()
+			tokenOutAmount, err := poolmanagerKeeper.RouteExactAmountIn(cacheCtx, s.TestAccs[0], tc.routes, tokenIn, osmomath.OneInt())
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut.Amount, tokenOutAmount)
+
+			// Exact amount out.
+			expectedTokenIn := s.calcInGivenOutAmountAsSeparateSwaps(outRoutes, tokenOut, tc.expectedSpreadFactors...)
+			estimatedTokenInAmount, err := poolmanagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, outRoutes, tokenOut)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenIn.Amount, estimatedTokenInAmount)
+
+			cacheCtx, _ = s.Ctx.CacheContext()
+			tokenInAmount, err := poolmanagerKeeper.RouteExactAmountOut(cacheCtx, s.TestAccs[0], outRoutes, expectedTokenIn.Amount, tokenOut)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenIn.Amount, tokenInAmount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestIsOsmoRoutedMultihop() {
+	tests := map[string]struct {
+		route           types.SwapAmountInRoutes
+		inDenom         string
+		discountEnabled bool
+		expected        bool
+	}{
+		"two hops through OSMO": {
+			route:           types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 2, TokenOutDenom: BAZ}},
+			inDenom:         FOO,
+			discountEnabled: true,
+			expected:        true,
+		},
+		"discount disabled": {
+			route:   types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 2, TokenOutDenom: BAZ}},
+			inDenom: FOO,
+		},
+		"single hop": {
+			route:           types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: UOSMO}},
+			inDenom:         FOO,
+			discountEnabled: true,
+		},
+		"three hops through OSMO": {
+			route:           types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 2, TokenOutDenom: BAZ}, {PoolId: 3, TokenOutDenom: BAR}},
+			inDenom:         FOO,
+			discountEnabled: true,
+		},
+		"intermediate denom is not OSMO": {
+			route:           types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: BAR}, {PoolId: 2, TokenOutDenom: BAZ}},
+			inDenom:         FOO,
+			discountEnabled: true,
+		},
+		"same in and out denoms": {
+			route:           types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 2, TokenOutDenom: FOO}},
+			inDenom:         FOO,
+			discountEnabled: true,
+		},
+		"same pool twice": {
+			route:           types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 1, TokenOutDenom: BAZ}},
+			inDenom:         FOO,
+			discountEnabled: true,
+		},
+		"pool not incentivized": {
+			route:           types.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: UOSMO}, {PoolId: 3, TokenOutDenom: BAZ}},
+			inDenom:         FOO,
+			discountEnabled: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			params.OsmoRoutedMultihopDiscountEnabled = tc.discountEnabled
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, params)
+
+			// Pools 1 and 2 are incentivized, pool 3 is not.
+			s.PrepareBalancerPool()
+			s.PrepareBalancerPool()
+			s.PrepareBalancerPool()
+			s.makeGaugesIncentivized([]uint64{1, 2, 3, 4, 5, 6})
+
+			// System under test.
+			isOsmoRouted := s.App.PoolManagerKeeper.IsOsmoRoutedMultihop(s.Ctx, tc.route, tc.inDenom, tc.route[len(tc.route)-1].TokenOutDenom)
+
+			s.Require().Equal(tc.expected, isOsmoRouted)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) makeGaugesIncentivized(incentivizedGauges []uint64) {
 	var records []poolincentivestypes.DistrRecord
 	totalWeight := osmomath.NewInt(int64(len(incentivizedGauges)))
@@ -1322,7 +1613,9 @@ func (s *KeeperTestSuite) makeGaugesIncentivized(incentivizedGauges []uint64) {
 	s.App.PoolIncentivesKeeper.SetDistrInfo(s.Ctx, distInfo)
 }
 
-func (s *KeeperTestSuite) calcInGivenOutAmountAsSeparateSwaps(routes []types.SwapAmountOutRoute, tokenOut sdk.Coin) sdk.Coin {
+// calcInGivenOutAmountAsSeparateSwaps calculates the input amount of a series of swaps on PoolManager pools.
+// If given, spreadFactors override the spread factors of the pools along the routes.
+func (s *KeeperTestSuite) calcInGivenOutAmountAsSeparateSwaps(routes []types.SwapAmountOutRoute, tokenOut sdk.Coin, spreadFactors ...osmomath.Dec) sdk.Coin {
 	cacheCtx, _ := s.Ctx.CacheContext()
 	nextTokenOut := tokenOut
 	for i := len(routes) - 1; i >= 0; i-- {
@@ -1330,6 +1623,9 @@ func (s *KeeperTestSuite) calcInGivenOutAmountAsSeparateSwaps(routes []types.Swa
 		hopPool, err := s.App.PoolManagerKeeper.GetPool(cacheCtx, hop.PoolId)
 		s.Require().NoError(err)
 		updatedPoolSpreadFactor := hopPool.GetSpreadFactor(cacheCtx)
+		if len(spreadFactors) > 0 {
+			updatedPoolSpreadFactor = spreadFactors[i]
+		}
 
 		takerFee, err := s.App.PoolManagerKeeper.GetTradingPairTakerFee(cacheCtx, hop.TokenInDenom, nextTokenOut.Denom)
 		s.Require().NoError(err)
@@ -1351,10 +1647,11 @@ func (s *KeeperTestSuite) calcInGivenOutAmountAsSeparateSwaps(routes []types.Swa
 // calcOutGivenInAmountAsSeparatePoolSwaps calculates the output amount of a series of swaps on PoolManager pools.
 // If its GAMM pool functions directly to ensure the poolmanager functions route to the correct modules. It it's CL pool functions directly to ensure the
 // poolmanager functions route to the correct modules.
-func (s *KeeperTestSuite) calcOutGivenInAmountAsSeparatePoolSwaps(routes []types.SwapAmountInRoute, tokenIn sdk.Coin) sdk.Coin {
+// If given, spreadFactors override the spread factors of the pools along the routes.
+func (s *KeeperTestSuite) calcOutGivenInAmountAsSeparatePoolSwaps(routes []types.SwapAmountInRoute, tokenIn sdk.Coin, spreadFactors ...osmomath.Dec) sdk.Coin {
 	cacheCtx, _ := s.Ctx.CacheContext()
 	nextTokenIn := tokenIn
-	for _, hop := range routes {
+	for i, hop := range routes {
 		swapModule, err := s.App.PoolManagerKeeper.GetPoolModule(cacheCtx, hop.PoolId)
 		s.Require().NoError(err)
 
@@ -1362,6 +1659,9 @@ func (s *KeeperTestSuite) calcOutGivenInAmountAsSeparatePoolSwaps(routes []types
 		s.Require().NoError(err)
 
 		spreadFactor := pool.GetSpreadFactor(cacheCtx)
+		if len(spreadFactors) > 0 {
+			spreadFactor = spreadFactors[i]
+		}
 
 		takerFee, err := s.App.PoolManagerKeeper.GetTradingPairTakerFee(cacheCtx, hop.TokenOutDenom, nextTokenIn.Denom)
 		s.Require().NoError(err)
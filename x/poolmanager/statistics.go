@@ -0,0 +1,119 @@
+package poolmanager
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// statisticsTwapWindow is the window over which the arithmetic twaps used to price
+// the total value locked are computed, ending at the current block time.
+const statisticsTwapWindow = time.Hour
+
+// UpdateChainStatistics computes the chain-wide pool and OSMO supply statistics and
+// caches them in state.
+//
+// The total value locked is the liquidity across all pools priced in the statistics quote denom.
+// Each denom is priced using the arithmetic twap of the most liquid pool pairing it with the quote denom,
+// as tracked by protorev. If there is no such pool, the price is routed through OSMO.
+// Denoms that cannot be priced this way are left out of the total value locked.
+func (k Keeper) UpdateChainStatistics(ctx sdk.Context, epochNumber int64) error {
+	quoteDenom := k.GetParams(ctx).StatisticsQuoteDenom
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	totalLiquidity, err := k.TotalLiquidity(ctx)
+	if err != nil {
+		return err
+	}
+
+	startTime := ctx.BlockTime().Add(-statisticsTwapWindow)
+	totalValueLocked := osmomath.ZeroDec()
+	for _, coin := range totalLiquidity {
+		price, err := k.getRoutedTwapPrice(ctx, coin.Denom, quoteDenom, bondDenom, startTime)
+		if err != nil {
+			ctx.Logger().Debug("skipping denom in total value locked", "denom", coin.Denom, "error", err)
+			continue
+		}
+		totalValueLocked = totalValueLocked.Add(price.MulInt(coin.Amount))
+	}
+
+	osmoSupply := k.bankKeeper.GetSupply(ctx, bondDenom).Amount
+	osmoInPools := totalLiquidity.AmountOf(bondDenom)
+	osmoStaked := k.stakingKeeper.TotalBondedTokens(ctx)
+	osmoLiquid := osmoSupply.Sub(osmoInPools).Sub(osmoStaked)
+	if osmoLiquid.IsNegative() {
+		osmoLiquid = osmomath.ZeroInt()
+	}
+
+	k.setChainStatistics(ctx, types.ChainStatistics{
+		QuoteDenom:       quoteDenom,
+		TotalValueLocked: totalValueLocked.TruncateInt(),
+		OsmoSupply:       osmoSupply,
+		OsmoInPools:      osmoInPools,
+		OsmoStaked:       osmoStaked,
+		OsmoLiquid:       osmoLiquid,
+		EpochNumber:      epochNumber,
+		UpdatedAt:        ctx.BlockTime(),
+	})
+	return nil
+}
+
+// GetChainStatistics returns the chain statistics computed at the end of the last statistics epoch.
+// Returns error if the statistics have not been computed yet.
+func (k Keeper) GetChainStatistics(ctx sdk.Context) (types.ChainStatistics, error) {
+	chainStatistics := types.ChainStatistics{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyChainStatistics, &chainStatistics)
+	if err != nil {
+		return types.ChainStatistics{}, err
+	}
+	if !found {
+		return types.ChainStatistics{}, types.ErrChainStatisticsNotFound
+	}
+	return chainStatistics, nil
+}
+
+func (k Keeper) setChainStatistics(ctx sdk.Context, chainStatistics types.ChainStatistics) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyChainStatistics, &chainStatistics)
+}
+
+// getRoutedTwapPrice returns the arithmetic twap price of baseDenom in units of quoteDenom from startTime until now.
+// If there is no pool pairing the two denoms directly, the price is routed through routeDenom.
+func (k Keeper) getRoutedTwapPrice(ctx sdk.Context, baseDenom, quoteDenom, routeDenom string, startTime time.Time) (osmomath.Dec, error) {
+	if baseDenom == quoteDenom {
+		return osmomath.OneDec(), nil
+	}
+
+	price, err := k.getDirectTwapPrice(ctx, baseDenom, quoteDenom, startTime)
+	if err == nil || baseDenom == routeDenom || quoteDenom == routeDenom {
+		return price, err
+	}
+
+	baseInRouteDenom, err := k.getDirectTwapPrice(ctx, baseDenom, routeDenom, startTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	routeInQuoteDenom, err := k.getDirectTwapPrice(ctx, routeDenom, quoteDenom, startTime)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return baseInRouteDenom.Mul(routeInQuoteDenom), nil
+}
+
+// getDirectTwapPrice returns the arithmetic twap price of baseDenom in units of quoteDenom from startTime until now,
+// as determined by the highest liquidity pool pairing the two denoms that is tracked by protorev.
+func (k Keeper) getDirectTwapPrice(ctx sdk.Context, baseDenom, quoteDenom string, startTime time.Time) (osmomath.Dec, error) {
+	// Protorev only tracks pools paired with one of its base denoms, which may be either of the two denoms.
+	poolId, err := k.protorevKeeper.GetPoolForDenomPair(ctx, quoteDenom, baseDenom)
+	if err != nil {
+		poolId, err = k.protorevKeeper.GetPoolForDenomPair(ctx, baseDenom, quoteDenom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+	}
+
+	return k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, baseDenom, quoteDenom, startTime)
+}
@@ -0,0 +1,151 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateChainStatistics() {
+	const (
+		epochNumber = int64(5)
+		unpricedA   = "unpriceda"
+		unpricedB   = "unpricedb"
+	)
+
+	var (
+		// 1 foo = 2 uosmo
+		fooUosmoPoolCoins = sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(1_000_000)), sdk.NewCoin(UOSMO, osmomath.NewInt(2_000_000)))
+		// 1 uosmo = 3 bar
+		uosmoBarPoolCoins = sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)), sdk.NewCoin(BAR, osmomath.NewInt(3_000_000)))
+		// 1 foo = 5 bar
+		fooBarPoolCoins = sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(1_000_000)), sdk.NewCoin(BAR, osmomath.NewInt(5_000_000)))
+		// Neither denom can be routed to the quote denom.
+		unpricedPoolCoins = sdk.NewCoins(sdk.NewCoin(unpricedA, osmomath.NewInt(1_000_000)), sdk.NewCoin(unpricedB, osmomath.NewInt(1_000_000)))
+	)
+
+	tests := map[string]struct {
+		quoteDenom     string
+		withFooBarPool bool
+		timeElapsed    time.Duration
+
+		expectedTotalValueLocked osmomath.Int
+	}{
+		"foo routed through uosmo": {
+			quoteDenom:  BAR,
+			timeElapsed: 2 * time.Hour,
+
+			// foo: 1_000_000 * 2 * 3, uosmo: 3_000_000 * 3, bar: 3_000_000
+			expectedTotalValueLocked: osmomath.NewInt(6_000_000 + 9_000_000 + 3_000_000),
+		},
+		"foo priced with direct pool": {
+			quoteDenom:     BAR,
+			withFooBarPool: true,
+			timeElapsed:    2 * time.Hour,
+
+			// foo: 2_000_000 * 5, uosmo: 3_000_000 * 3, bar: 8_000_000
+			expectedTotalValueLocked: osmomath.NewInt(10_000_000 + 9_000_000 + 8_000_000),
+		},
+		"quote denom is uosmo": {
+			quoteDenom:  UOSMO,
+			timeElapsed: 2 * time.Hour,
+
+			// foo: 1_000_000 * 2, uosmo: 3_000_000, bar: 3_000_000 / 3
+			// The bar price of 1/3 is truncated, so the total is rounded down.
+			expectedTotalValueLocked: osmomath.NewInt(2_000_000 + 3_000_000 + 1_000_000 - 1),
+		},
+		"twap window starts before pool creation, only quote denom is priced": {
+			quoteDenom:  BAR,
+			timeElapsed: time.Minute,
+
+			expectedTotalValueLocked: osmomath.NewInt(3_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManagerKeeper := s.App.PoolManagerKeeper
+
+			params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			params.StatisticsQuoteDenom = tc.quoteDenom
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, params)
+
+			fooUosmoPoolId := s.CreatePoolFromTypeWithCoins(types.Balancer, fooUosmoPoolCoins)
+			s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, UOSMO, FOO, fooUosmoPoolId)
+			uosmoBarPoolId := s.CreatePoolFromTypeWithCoins(types.Balancer, uosmoBarPoolCoins)
+			s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, UOSMO, BAR, uosmoBarPoolId)
+			if tc.withFooBarPool {
+				fooBarPoolId := s.CreatePoolFromTypeWithCoins(types.Balancer, fooBarPoolCoins)
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, BAR, FOO, fooBarPoolId)
+			}
+			s.CreatePoolFromTypeWithCoins(types.Balancer, unpricedPoolCoins)
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(tc.timeElapsed))
+
+			// Statistics are not available before they are computed for the first time.
+			_, err := poolManagerKeeper.GetChainStatistics(s.Ctx)
+			s.Require().ErrorIs(err, types.ErrChainStatisticsNotFound)
+
+			// System under test.
+			err = poolManagerKeeper.UpdateChainStatistics(s.Ctx, epochNumber)
+			s.Require().NoError(err)
+
+			chainStatistics, err := poolManagerKeeper.GetChainStatistics(s.Ctx)
+			s.Require().NoError(err)
+
+			totalLiquidity, err := poolManagerKeeper.TotalLiquidity(s.Ctx)
+			s.Require().NoError(err)
+			osmoSupply := s.App.BankKeeper.GetSupply(s.Ctx, UOSMO).Amount
+			osmoStaked := s.App.StakingKeeper.TotalBondedTokens(s.Ctx)
+			osmoInPools := totalLiquidity.AmountOf(UOSMO)
+
+			s.Require().Equal(types.ChainStatistics{
+				QuoteDenom:       tc.quoteDenom,
+				TotalValueLocked: tc.expectedTotalValueLocked,
+				OsmoSupply:       osmoSupply,
+				OsmoInPools:      osmoInPools,
+				OsmoStaked:       osmoStaked,
+				OsmoLiquid:       osmoSupply.Sub(osmoInPools).Sub(osmoStaked),
+				EpochNumber:      epochNumber,
+				UpdatedAt:        s.Ctx.BlockTime(),
+			}, chainStatistics)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestChainStatisticsEpochHook() {
+	tests := map[string]struct {
+		epochIdentifier string
+		expectUpdated   bool
+	}{
+		"statistics epoch": {
+			epochIdentifier: types.DefaultParams().StatisticsEpochIdentifier,
+			expectUpdated:   true,
+		},
+		"other epoch": {
+			epochIdentifier: "hour",
+			expectUpdated:   false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			err := s.App.PoolManagerKeeper.EpochHooks().AfterEpochEnd(s.Ctx, tc.epochIdentifier, 3)
+			s.Require().NoError(err)
+
+			chainStatistics, err := s.App.PoolManagerKeeper.GetChainStatistics(s.Ctx)
+			if tc.expectUpdated {
+				s.Require().NoError(err)
+				s.Require().Equal(int64(3), chainStatistics.EpochNumber)
+			} else {
+				s.Require().ErrorIs(err, types.ErrChainStatisticsNotFound)
+			}
+		})
+	}
+}
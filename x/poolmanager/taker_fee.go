@@ -148,6 +148,24 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 			k.IncreaseTakerFeeTrackerForCommunityPool(ctx, osmoTakerFeeToCommunityPoolCoin)
 			takerFeeAmtRemaining = takerFeeAmtRemaining.Sub(osmoTakerFeeToCommunityPoolCoin.Amount)
 		}
+		// Burn:
+		if poolManagerParams.TakerFeeParams.OsmoTakerFeeDistribution.Burn.GT(osmomath.ZeroDec()) {
+			// Osmo to burn is sent to the poolmanager module account and burned from there
+			osmoTakerFeeToBurnDec := takerFeeCoin.Amount.ToLegacyDec().Mul(poolManagerParams.TakerFeeParams.OsmoTakerFeeDistribution.Burn)
+			osmoTakerFeeToBurnCoin := sdk.NewCoin(defaultTakerFeeDenom, osmoTakerFeeToBurnDec.TruncateInt())
+			if osmoTakerFeeToBurnCoin.IsPositive() {
+				err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(osmoTakerFeeToBurnCoin))
+				if err != nil {
+					return sdk.Coin{}, err
+				}
+				err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(osmoTakerFeeToBurnCoin))
+				if err != nil {
+					return sdk.Coin{}, err
+				}
+				k.IncreaseTakerFeeTrackerForBurn(ctx, osmoTakerFeeToBurnCoin)
+				takerFeeAmtRemaining = takerFeeAmtRemaining.Sub(osmoTakerFeeToBurnCoin.Amount)
+			}
+		}
 		// Staking Rewards:
 		if poolManagerParams.TakerFeeParams.OsmoTakerFeeDistribution.StakingRewards.GT(osmomath.ZeroDec()) {
 			// Osmo staking rewards funds are sent to the non native fee pool module account (even though its native, we want to distribute at the same time as the non native fee tokens)
@@ -8,6 +8,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 // validates that the pool manager keeper can charge taker fees correctly.
@@ -175,3 +177,42 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 		})
 	}
 }
+
+// validates that OSMO taker fees are split between the community pool, burn and staking rewards
+// according to the OSMO taker fee distribution, and that the burned amount is removed from supply.
+func (s *KeeperTestSuite) TestChargeTakerFee_OsmoBurn() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+
+	var (
+		takerFee = osmomath.MustNewDecFromStr("0.01")
+		tokenIn  = sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(10_000_000))
+		sender   = s.TestAccs[0]
+	)
+
+	params := poolManager.GetParams(s.Ctx)
+	params.TakerFeeParams.OsmoTakerFeeDistribution = types.TakerFeeDistributionPercentage{
+		StakingRewards: osmomath.MustNewDecFromStr("0.5"),
+		CommunityPool:  osmomath.MustNewDecFromStr("0.2"),
+		Burn:           osmomath.MustNewDecFromStr("0.3"),
+	}
+	poolManager.SetParams(s.Ctx, params)
+	poolManager.SetDenomPairTakerFee(s.Ctx, tokenIn.Denom, apptesting.USDC, takerFee)
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, appparams.BaseCoinUnit)
+
+	// System under test.
+	tokenInAfterTakerFee, err := poolManager.ChargeTakerFee(s.Ctx, tokenIn, apptesting.USDC, sender, true)
+	s.Require().NoError(err)
+
+	// Total taker fee is 100_000uosmo: 20_000 to the community pool, 30_000 burned and the remaining 50_000 to stakers.
+	s.Require().Equal(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(9_900_000)), tokenInAfterTakerFee)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(20_000))), poolManager.GetTakerFeeTrackerForCommunityPool(s.Ctx))
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(30_000))), poolManager.GetTakerFeeTrackerForBurn(s.Ctx))
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(50_000))), poolManager.GetTakerFeeTrackerForStakers(s.Ctx))
+
+	supplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, appparams.BaseCoinUnit)
+	s.Require().Equal(osmomath.NewInt(30_000), supplyBefore.Amount.Sub(supplyAfter.Amount))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
@@ -17,6 +17,8 @@ var (
 	ErrTooFewPoolAssets          = errors.New("pool should have at least 2 assets, as they must be swapping between at least two assets")
 	ErrTooManyPoolAssets         = errors.New("pool has too many assets (currently capped at 8 assets per pool)")
 	ErrDuplicateRoutesNotAllowed = errors.New("duplicate multihop routes are not allowed")
+	ErrEmptySwaps                = errors.New("provided empty swaps")
+	ErrChainStatisticsNotFound   = errors.New("chain statistics have not been computed yet")
 )
 
 type nonPositiveAmountError struct {
@@ -133,3 +135,12 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type InvalidMaxHopsError struct {
+	MaxHops        uint64
+	MaxAllowedHops uint64
+}
+
+func (e InvalidMaxHopsError) Error() string {
+	return fmt.Sprintf("max hops must be between 1 and %d, was (%d)", e.MaxAllowedHops, e.MaxHops)
+}
@@ -43,6 +43,17 @@ type Params struct {
 	// orders at prices in terms of token1 (quote asset) that are easy to reason
 	// about.
 	AuthorizedQuoteDenoms []string `protobuf:"bytes,3,rep,name=authorized_quote_denoms,json=authorizedQuoteDenoms,proto3" json:"authorized_quote_denoms,omitempty" yaml:"authorized_quote_denoms"`
+	// statistics_quote_denom is the denom the total value locked in the chain
+	// statistics is denominated in.
+	StatisticsQuoteDenom string `protobuf:"bytes,4,opt,name=statistics_quote_denom,json=statisticsQuoteDenom,proto3" json:"statistics_quote_denom,omitempty" yaml:"statistics_quote_denom"`
+	// statistics_epoch_identifier is the identifier of the epoch at the end of
+	// which the chain statistics are refreshed.
+	StatisticsEpochIdentifier string `protobuf:"bytes,5,opt,name=statistics_epoch_identifier,json=statisticsEpochIdentifier,proto3" json:"statistics_epoch_identifier,omitempty" yaml:"statistics_epoch_identifier"`
+	// osmo_routed_multihop_discount_enabled is whether two-hop swaps routed
+	// through OSMO between two incentivized pools are charged the spread factor
+	// of a single pool, split between both pools pro-rata to their own spread
+	// factors, instead of the spread factors of both pools.
+	OsmoRoutedMultihopDiscountEnabled bool `protobuf:"varint,6,opt,name=osmo_routed_multihop_discount_enabled,json=osmoRoutedMultihopDiscountEnabled,proto3" json:"osmo_routed_multihop_discount_enabled,omitempty" yaml:"osmo_routed_multihop_discount_enabled"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -99,6 +110,27 @@ func (m *Params) GetAuthorizedQuoteDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetStatisticsQuoteDenom() string {
+	if m != nil {
+		return m.StatisticsQuoteDenom
+	}
+	return ""
+}
+
+func (m *Params) GetStatisticsEpochIdentifier() string {
+	if m != nil {
+		return m.StatisticsEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetOsmoRoutedMultihopDiscountEnabled() bool {
+	if m != nil {
+		return m.OsmoRoutedMultihopDiscountEnabled
+	}
+	return false
+}
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -194,11 +226,12 @@ type TakerFeeParams struct {
 	// fall under a custom pool taker fee or stableswap taker fee category.
 	DefaultTakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=default_taker_fee,json=defaultTakerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"default_taker_fee"`
 	// osmo_taker_fee_distribution defines the distribution of taker fees
-	// generated in OSMO. As of this writing, it has two catagories:
+	// generated in OSMO. As of this writing, it has three catagories:
 	// - staking_rewards: the percent of the taker fee that gets distributed to
 	//   stakers.
 	// - community_pool: the percent of the taker fee that gets sent to the
 	//   community pool.
+	// - burn: the percent of the taker fee that gets burned.
 	OsmoTakerFeeDistribution TakerFeeDistributionPercentage `protobuf:"bytes,2,opt,name=osmo_taker_fee_distribution,json=osmoTakerFeeDistribution,proto3" json:"osmo_taker_fee_distribution"`
 	// non_osmo_taker_fee_distribution defines the distribution of taker fees
 	// generated in non-OSMO. As of this writing, it has two categories:
@@ -209,6 +242,8 @@ type TakerFeeParams struct {
 	//   that denom is sent directly to the community pool. Otherwise, it is
 	//   swapped to the community_pool_denom_to_swap_non_whitelisted_assets_to and
 	//   then sent to the community pool as that denom.
+	// Burning is only supported for taker fees generated in OSMO, so the burn
+	// percentage of this distribution must be zero.
 	NonOsmoTakerFeeDistribution TakerFeeDistributionPercentage `protobuf:"bytes,3,opt,name=non_osmo_taker_fee_distribution,json=nonOsmoTakerFeeDistribution,proto3" json:"non_osmo_taker_fee_distribution"`
 	// admin_addresses is a list of addresses that are allowed to set and remove
 	// custom taker fees for denom pairs. Governance also has the ability to set
@@ -302,6 +337,7 @@ func (m *TakerFeeParams) GetReducedFeeWhitelist() []string {
 type TakerFeeDistributionPercentage struct {
 	StakingRewards cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_rewards" yaml:"staking_rewards"`
 	CommunityPool  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool" yaml:"community_pool"`
+	Burn           cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=burn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn" yaml:"burn"`
 }
 
 func (m *TakerFeeDistributionPercentage) Reset()         { *m = TakerFeeDistributionPercentage{} }
@@ -341,6 +377,7 @@ type TakerFeesTracker struct {
 	TakerFeesToStakers         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=taker_fees_to_stakers,json=takerFeesToStakers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_to_stakers"`
 	TakerFeesToCommunityPool   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=taker_fees_to_community_pool,json=takerFeesToCommunityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_to_community_pool"`
 	HeightAccountingStartsFrom int64                                    `protobuf:"varint,3,opt,name=height_accounting_starts_from,json=heightAccountingStartsFrom,proto3" json:"height_accounting_starts_from,omitempty" yaml:"height_accounting_starts_from"`
+	TakerFeesBurned            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=taker_fees_burned,json=takerFeesBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_burned"`
 }
 
 func (m *TakerFeesTracker) Reset()         { *m = TakerFeesTracker{} }
@@ -397,6 +434,13 @@ func (m *TakerFeesTracker) GetHeightAccountingStartsFrom() int64 {
 	return 0
 }
 
+func (m *TakerFeesTracker) GetTakerFeesBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFeesBurned
+	}
+	return nil
+}
+
 // PoolVolume stores the KVStore entries for each pool's volume, which
 // is used in export/import genesis.
 type PoolVolume struct {
@@ -453,6 +497,61 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+type DenomPairTakerFee struct {
+	// denom0 and denom1 get automatically lexigographically sorted
+	// when being stored, so the order of input here does not matter.
+	Denom0   string                      `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1   string                      `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	TakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
+func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
+func (*DenomPairTakerFee) ProtoMessage()    {}
+func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
+}
+func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairTakerFee.Merge(m, src)
+}
+func (m *DenomPairTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairTakerFee proto.InternalMessageInfo
+
+func (m *DenomPairTakerFee) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *DenomPairTakerFee) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
@@ -460,6 +559,7 @@ func init() {
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
 }
 
 func init() {
@@ -467,75 +567,89 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1085 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xbb, 0xe9, 0xa2, 0x4c, 0x42, 0x92, 0x0e, 0xa4, 0x71, 0x93, 0xb2, 0x5e, 0xb9, 0x95,
-	0x58, 0x84, 0x62, 0x93, 0x20, 0x15, 0x09, 0xe8, 0x21, 0x9b, 0x28, 0x08, 0x54, 0xda, 0xd4, 0x89,
-	0x40, 0x2a, 0x07, 0x6b, 0xd6, 0x7e, 0xf1, 0x5a, 0x6b, 0x7b, 0x96, 0x99, 0x71, 0xfe, 0x70, 0xe0,
-	0x0b, 0x54, 0x48, 0x48, 0xbd, 0x72, 0xe6, 0xc0, 0x8d, 0x6f, 0xd1, 0x63, 0x8f, 0x88, 0xc3, 0x16,
-	0x6d, 0xce, 0x5c, 0xf6, 0x13, 0x20, 0xcf, 0xcc, 0xfe, 0x6d, 0xb2, 0x84, 0x3f, 0x3d, 0xed, 0xfa,
-	0xbd, 0xdf, 0xfb, 0xf9, 0xf7, 0xde, 0x9b, 0x37, 0xcf, 0xe8, 0x3d, 0xca, 0x53, 0xca, 0x63, 0xee,
-	0xb6, 0x29, 0x4d, 0x52, 0x92, 0x91, 0x08, 0x98, 0x7b, 0xbc, 0xd9, 0x00, 0x41, 0x36, 0xdd, 0x08,
-	0x32, 0xe0, 0x31, 0x77, 0xda, 0x8c, 0x0a, 0x8a, 0xd7, 0x35, 0xd4, 0x19, 0x81, 0x3a, 0x1a, 0xba,
-	0xf6, 0x76, 0x44, 0x23, 0x2a, 0x71, 0x6e, 0xf1, 0x4f, 0x85, 0xac, 0xdd, 0x8a, 0x28, 0x8d, 0x12,
-	0x70, 0xe5, 0x53, 0x23, 0x3f, 0x72, 0x49, 0x76, 0xd6, 0x77, 0x05, 0x92, 0xce, 0x57, 0x31, 0xea,
-	0x41, 0xbb, 0x2a, 0x93, 0x51, 0x61, 0xce, 0x88, 0x88, 0x69, 0xd6, 0xf7, 0x2b, 0xb4, 0xdb, 0x20,
-	0x1c, 0x06, 0x5a, 0x03, 0x1a, 0xf7, 0xfd, 0xce, 0xb4, 0x9c, 0x52, 0x1a, 0xe6, 0x09, 0xf8, 0x8c,
-	0xe6, 0x02, 0x34, 0xfe, 0xee, 0x34, 0xbc, 0x38, 0x55, 0x28, 0xbb, 0x77, 0x0d, 0x95, 0xf7, 0x09,
-	0x23, 0x29, 0xc7, 0xcf, 0x0c, 0x74, 0xa3, 0xc0, 0xfa, 0x01, 0x03, 0x29, 0xcc, 0x3f, 0x02, 0x30,
-	0x8d, 0x6a, 0xa9, 0x36, 0xbf, 0x75, 0xcb, 0xd1, 0xb9, 0x14, 0xea, 0xfa, 0xe5, 0x71, 0x76, 0x68,
-	0x9c, 0xd5, 0x1f, 0x3c, 0xef, 0x58, 0x33, 0xbd, 0x8e, 0x65, 0x9e, 0x91, 0x34, 0xf9, 0xd8, 0x7e,
-	0x85, 0xc1, 0xfe, 0xe5, 0xa5, 0x55, 0x8b, 0x62, 0xd1, 0xcc, 0x1b, 0x4e, 0x40, 0x53, 0x5d, 0x14,
-	0xfd, 0xb3, 0xc1, 0xc3, 0x96, 0x2b, 0xce, 0xda, 0xc0, 0x25, 0x19, 0xf7, 0x96, 0x8a, 0xf8, 0x1d,
-	0x1d, 0xbe, 0x07, 0x80, 0x8f, 0xd1, 0xb2, 0x20, 0x2d, 0x60, 0x05, 0x95, 0xdf, 0x96, 0x4a, 0xcd,
-	0x6b, 0x55, 0xa3, 0x36, 0xbf, 0xf5, 0xbe, 0x33, 0xa5, 0x75, 0xce, 0x61, 0x11, 0xb4, 0x07, 0xa0,
-	0x92, 0xab, 0x5b, 0x5a, 0xe5, 0xaa, 0x52, 0x39, 0x49, 0x69, 0x7b, 0x8b, 0x62, 0x2c, 0x00, 0x3f,
-	0x41, 0xab, 0x24, 0x17, 0x4d, 0xca, 0xe2, 0xef, 0x20, 0xf4, 0xbf, 0xcd, 0xa9, 0x00, 0x3f, 0x84,
-	0x8c, 0xa6, 0xdc, 0x2c, 0x55, 0x4b, 0xb5, 0xb9, 0xba, 0xdd, 0xeb, 0x58, 0x15, 0xc5, 0x76, 0x09,
-	0xd0, 0xf6, 0x56, 0x86, 0x9e, 0xc7, 0x85, 0x63, 0x57, 0xd9, 0x5f, 0x96, 0xd0, 0xc2, 0x67, 0xea,
-	0x14, 0x1e, 0x08, 0x22, 0x00, 0x57, 0xd1, 0x42, 0x06, 0xa7, 0xc2, 0x97, 0xc5, 0x8b, 0x43, 0xd3,
-	0xa8, 0x1a, 0xb5, 0x59, 0x0f, 0x15, 0xb6, 0x7d, 0x4a, 0x93, 0xcf, 0x43, 0xbc, 0x8d, 0xca, 0x63,
-	0xc9, 0xdf, 0x99, 0x9a, 0xbc, 0x4e, 0x7a, 0xb6, 0x48, 0xda, 0xd3, 0x81, 0xf8, 0x11, 0x9a, 0x97,
-	0xfc, 0xf2, 0x90, 0xa8, 0x2c, 0xe6, 0xb7, 0x6a, 0x53, 0x79, 0xbe, 0x94, 0xc7, 0xca, 0x2b, 0x02,
-	0x34, 0x19, 0x2a, 0x60, 0xd2, 0xc0, 0xf1, 0x37, 0x08, 0x0f, 0xea, 0xc8, 0x7d, 0xc1, 0x48, 0xd0,
-	0x02, 0x66, 0xce, 0x4a, 0x7d, 0x1b, 0x57, 0x6a, 0x0e, 0x3f, 0x54, 0x41, 0xde, 0xb2, 0x98, 0xb0,
-	0xe0, 0x2f, 0xd0, 0x82, 0x54, 0x7b, 0x4c, 0x93, 0x3c, 0x05, 0x6e, 0x5e, 0x97, 0x72, 0xdf, 0x9d,
-	0x9e, 0x36, 0xa5, 0xc9, 0x57, 0x12, 0xef, 0xc9, 0x54, 0xd5, 0x7f, 0x8e, 0xdb, 0x68, 0x4d, 0x76,
-	0xc4, 0x6f, 0x93, 0x98, 0xf9, 0xc3, 0xde, 0x73, 0x41, 0x19, 0x98, 0x65, 0xc9, 0xec, 0x4c, 0x65,
-	0x96, 0x8d, 0xdb, 0x27, 0x31, 0xeb, 0x2b, 0xd7, 0xe5, 0xb8, 0x19, 0x4e, 0x3a, 0x0e, 0x0a, 0x4e,
-	0xfb, 0x69, 0x19, 0x2d, 0x8e, 0x9f, 0x40, 0xdc, 0x40, 0x37, 0x42, 0x38, 0x22, 0x79, 0x22, 0x86,
-	0x0a, 0x64, 0xa3, 0xe7, 0xea, 0xf7, 0x0a, 0xae, 0xdf, 0x3b, 0xd6, 0xba, 0x1a, 0x0a, 0x1e, 0xb6,
-	0x9c, 0x98, 0xba, 0x29, 0x11, 0x4d, 0xe7, 0x01, 0x44, 0x24, 0x38, 0xdb, 0x85, 0xa0, 0xdb, 0xb1,
-	0x96, 0x76, 0x55, 0x7c, 0x9f, 0xd8, 0x5b, 0x0a, 0xc7, 0x0d, 0xf8, 0x27, 0x03, 0xc9, 0xfb, 0x6c,
-	0x24, 0xc7, 0x30, 0xe6, 0x82, 0xc5, 0x8d, 0xbc, 0x98, 0x27, 0x7d, 0x76, 0x3e, 0xb9, 0x52, 0x6f,
-	0x76, 0x47, 0x02, 0xf7, 0x81, 0x05, 0x90, 0x09, 0x12, 0x41, 0xbd, 0x5a, 0x68, 0xed, 0x76, 0x2c,
-	0xf3, 0x11, 0x4f, 0xe9, 0x45, 0x58, 0xcf, 0xa4, 0x97, 0x78, 0xf0, 0xcf, 0x06, 0xb2, 0x32, 0x9a,
-	0xf9, 0xd3, 0x24, 0x96, 0xfe, 0xbb, 0xc4, 0x3b, 0x5a, 0xe2, 0xfa, 0x43, 0x9a, 0x5d, 0xaa, 0x72,
-	0x3d, 0xbb, 0xdc, 0x89, 0x77, 0xd0, 0x12, 0x09, 0xd3, 0x38, 0xf3, 0x49, 0x18, 0x32, 0xe0, 0x1c,
-	0xb8, 0x39, 0x2b, 0x87, 0x7e, 0xad, 0xd7, 0xb1, 0x6e, 0xea, 0xa1, 0x1f, 0x07, 0xd8, 0xde, 0xa2,
-	0xb4, 0x6c, 0xf7, 0x0d, 0xf8, 0x57, 0x03, 0xdd, 0x0b, 0x68, 0x9a, 0xe6, 0x59, 0x2c, 0xce, 0xd4,
-	0x68, 0xab, 0x53, 0x28, 0xa8, 0xcf, 0x4f, 0x48, 0xdb, 0x2f, 0x4a, 0x71, 0xd2, 0x8c, 0x05, 0x24,
-	0x31, 0x17, 0x10, 0xfa, 0x84, 0x73, 0x10, 0xdc, 0x17, 0xd4, 0xbc, 0x2e, 0x8f, 0xc5, 0x76, 0xaf,
-	0x63, 0xdd, 0x57, 0x2f, 0xfb, 0x77, 0x3c, 0xb6, 0xe7, 0x0c, 0x02, 0x8b, 0xd9, 0x90, 0xa7, 0xf8,
-	0x90, 0x1e, 0x9c, 0x90, 0xf6, 0x43, 0x9a, 0x7d, 0x3d, 0x0c, 0xd9, 0x96, 0x11, 0x87, 0x14, 0x1f,
-	0xa2, 0x15, 0x06, 0x61, 0x1e, 0x40, 0x28, 0x3b, 0x33, 0x60, 0x95, 0x43, 0x32, 0x57, 0xaf, 0xf6,
-	0x3a, 0xd6, 0x6d, 0xa5, 0xe8, 0x42, 0x98, 0xed, 0xbd, 0xa5, 0xed, 0x7b, 0x00, 0x03, 0x7e, 0xfb,
-	0x4f, 0x03, 0x55, 0xa6, 0xf7, 0x0c, 0x1f, 0xa1, 0x25, 0x2e, 0x48, 0x2b, 0xce, 0x22, 0x9f, 0xc1,
-	0x09, 0x61, 0x21, 0xd7, 0xb3, 0x71, 0xff, 0x0a, 0xb3, 0x31, 0x6c, 0xca, 0x04, 0x87, 0xed, 0x2d,
-	0x6a, 0x8b, 0xa7, 0x0c, 0x38, 0x40, 0x8b, 0xe3, 0xb5, 0x94, 0x33, 0x31, 0x57, 0xff, 0xf4, 0x6a,
-	0xaf, 0x59, 0xb9, 0xa8, 0x1d, 0xb6, 0xf7, 0xe6, 0x58, 0x99, 0xed, 0x1f, 0x4a, 0x68, 0x79, 0xf2,
-	0x8a, 0xc3, 0xdf, 0xa3, 0x95, 0xd1, 0xdb, 0x92, 0xfa, 0x5c, 0x3e, 0xf2, 0xbf, 0xdf, 0xb0, 0x1f,
-	0x14, 0xda, 0xfe, 0xd1, 0x16, 0xc5, 0xc3, 0xeb, 0x94, 0x1e, 0xa8, 0xd7, 0xe0, 0xa7, 0x06, 0xba,
-	0x3d, 0x2e, 0xe0, 0x95, 0x42, 0xfc, 0xef, 0x3a, 0xcc, 0x11, 0x1d, 0x3b, 0xa3, 0x25, 0xc2, 0x2d,
-	0xf4, 0x4e, 0x13, 0xe2, 0xa8, 0x29, 0x7c, 0x12, 0x04, 0x34, 0xcf, 0x44, 0xd1, 0x35, 0x2e, 0x08,
-	0x13, 0xdc, 0x3f, 0x62, 0x34, 0x95, 0xf7, 0x40, 0xa9, 0x5e, 0xeb, 0x75, 0xac, 0xbb, 0xaa, 0xe6,
-	0x53, 0xe1, 0xb6, 0xb7, 0xa6, 0xfc, 0xdb, 0x03, 0xf7, 0x81, 0xf4, 0xee, 0x15, 0xce, 0x67, 0x06,
-	0x42, 0xc3, 0xdd, 0x80, 0x57, 0xd1, 0x1b, 0xe3, 0x8b, 0xb6, 0xdc, 0x56, 0x4b, 0x36, 0xd1, 0x1b,
-	0x52, 0xed, 0x9c, 0xd7, 0x51, 0x10, 0x34, 0x5c, 0x4b, 0xf5, 0xc7, 0xcf, 0xbb, 0x15, 0xe3, 0x45,
-	0xb7, 0x62, 0xfc, 0xd1, 0xad, 0x18, 0x3f, 0x9e, 0x57, 0x66, 0x5e, 0x9c, 0x57, 0x66, 0x7e, 0x3b,
-	0xaf, 0xcc, 0x3c, 0xf9, 0x68, 0x84, 0x4f, 0xdf, 0x83, 0x1b, 0x09, 0x69, 0xf0, 0xfe, 0x83, 0x7b,
-	0xbc, 0xb5, 0xe9, 0x9e, 0x8e, 0x7d, 0xd8, 0xc9, 0x97, 0x34, 0xca, 0xf2, 0xa3, 0xee, 0xc3, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xc5, 0xf0, 0xa5, 0x00, 0x0b, 0x00, 0x00,
+	// 1309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbb, 0x6f, 0xdb, 0x46,
+	0x18, 0x37, 0x63, 0x45, 0x8d, 0x4e, 0x89, 0x1f, 0xd7, 0x38, 0x61, 0xec, 0x44, 0x54, 0x98, 0x3e,
+	0x14, 0xb4, 0xa1, 0x62, 0x17, 0x48, 0x80, 0xb6, 0x19, 0x4c, 0x3b, 0x2e, 0x52, 0xe4, 0xe1, 0xd0,
+	0x46, 0x03, 0xa4, 0x03, 0x71, 0x22, 0x4f, 0xd2, 0xc1, 0x24, 0x4f, 0xe5, 0x1d, 0xed, 0xb8, 0x43,
+	0x87, 0x0e, 0x5d, 0xb2, 0x14, 0xc8, 0xda, 0xb9, 0x43, 0xb7, 0x0e, 0x05, 0xba, 0x75, 0xcd, 0x98,
+	0xb1, 0xe8, 0xc0, 0x14, 0xf6, 0x7f, 0xa0, 0xbf, 0xa0, 0xe0, 0xdd, 0x49, 0x94, 0x64, 0x5b, 0x55,
+	0xdb, 0x74, 0xb2, 0xf9, 0x7d, 0xbf, 0xef, 0x77, 0xdf, 0xfb, 0x4e, 0xe0, 0x3a, 0x65, 0x21, 0x65,
+	0x84, 0xd5, 0x3b, 0x94, 0x06, 0x21, 0x8a, 0x50, 0x0b, 0xc7, 0xf5, 0xdd, 0xe5, 0x06, 0xe6, 0x68,
+	0xb9, 0xde, 0xc2, 0x11, 0x66, 0x84, 0x59, 0x9d, 0x98, 0x72, 0x0a, 0x97, 0x14, 0xd4, 0x1a, 0x80,
+	0x5a, 0x0a, 0xba, 0x78, 0xbe, 0x45, 0x5b, 0x54, 0xe0, 0xea, 0xd9, 0x7f, 0xd2, 0x64, 0xf1, 0x52,
+	0x8b, 0xd2, 0x56, 0x80, 0xeb, 0xe2, 0xab, 0x91, 0x34, 0xeb, 0x28, 0xda, 0xef, 0xa9, 0x3c, 0x41,
+	0xe7, 0x4a, 0x1b, 0xf9, 0xa1, 0x54, 0x95, 0x51, 0x2b, 0x3f, 0x89, 0x11, 0x27, 0x34, 0xea, 0xe9,
+	0x25, 0xba, 0xde, 0x40, 0x0c, 0xf7, 0x7d, 0xf5, 0x28, 0xe9, 0xe9, 0xad, 0x71, 0x31, 0x85, 0xd4,
+	0x4f, 0x02, 0xec, 0xc6, 0x34, 0xe1, 0x58, 0xe2, 0xcd, 0x5f, 0x4f, 0x83, 0xe2, 0x26, 0x8a, 0x51,
+	0xc8, 0xe0, 0x0b, 0x0d, 0xcc, 0x67, 0x56, 0xae, 0x17, 0x63, 0x71, 0xa4, 0xdb, 0xc4, 0x58, 0xd7,
+	0xaa, 0xd3, 0xb5, 0xf2, 0xca, 0x25, 0x4b, 0x79, 0x99, 0x9d, 0xdb, 0x0b, 0xdc, 0x5a, 0xa3, 0x24,
+	0xb2, 0xef, 0xbf, 0x4c, 0x8d, 0xa9, 0x6e, 0x6a, 0xe8, 0xfb, 0x28, 0x0c, 0x3e, 0x36, 0x8f, 0x30,
+	0x98, 0x3f, 0xbd, 0x36, 0x6a, 0x2d, 0xc2, 0xdb, 0x49, 0xc3, 0xf2, 0x68, 0xa8, 0xc2, 0x55, 0x7f,
+	0x6e, 0x30, 0x7f, 0xa7, 0xce, 0xf7, 0x3b, 0x98, 0x09, 0x32, 0xe6, 0xcc, 0x66, 0xf6, 0x6b, 0xca,
+	0x7c, 0x03, 0x63, 0xb8, 0x0b, 0xe6, 0x38, 0xda, 0xc1, 0x71, 0x46, 0xe5, 0x76, 0x84, 0xa7, 0xfa,
+	0xa9, 0xaa, 0x56, 0x2b, 0xaf, 0x7c, 0x60, 0x8d, 0x29, 0x8a, 0xb5, 0x9d, 0x19, 0x6d, 0x60, 0x2c,
+	0x83, 0xb3, 0x0d, 0xe5, 0xe5, 0x45, 0xe9, 0xe5, 0x28, 0xa5, 0xe9, 0xcc, 0xf0, 0x21, 0x03, 0xf8,
+	0x14, 0x5c, 0x44, 0x09, 0x6f, 0xd3, 0x98, 0x7c, 0x8d, 0x7d, 0xf7, 0xab, 0x84, 0x72, 0xec, 0xfa,
+	0x38, 0xa2, 0x21, 0xd3, 0xa7, 0xab, 0xd3, 0xb5, 0x92, 0x6d, 0x76, 0x53, 0xa3, 0x22, 0xd9, 0x4e,
+	0x00, 0x9a, 0xce, 0x42, 0xae, 0x79, 0x9c, 0x29, 0xd6, 0x85, 0x1c, 0x3e, 0x01, 0x17, 0x18, 0x47,
+	0x9c, 0x30, 0x4e, 0x3c, 0x36, 0x68, 0xa2, 0x17, 0xaa, 0x5a, 0xad, 0x64, 0x5f, 0xed, 0xa6, 0xc6,
+	0x15, 0x49, 0x7d, 0x3c, 0xce, 0x74, 0xce, 0xe7, 0x8a, 0x9c, 0x19, 0x36, 0xc1, 0xd2, 0x80, 0x01,
+	0xee, 0x50, 0xaf, 0xed, 0x12, 0x1f, 0x47, 0x9c, 0x34, 0x09, 0x8e, 0xf5, 0xd3, 0x82, 0xfd, 0xbd,
+	0x6e, 0x6a, 0x98, 0x47, 0xd8, 0x47, 0xc1, 0xa6, 0x73, 0x29, 0xd7, 0xde, 0xcd, 0x94, 0xf7, 0xfa,
+	0x3a, 0xf8, 0xad, 0x06, 0xde, 0xcd, 0x92, 0x2f, 0x5b, 0xc9, 0x77, 0xc3, 0x24, 0xe0, 0xa4, 0x4d,
+	0x3b, 0xae, 0x4f, 0x98, 0x47, 0x93, 0x88, 0xbb, 0x38, 0x42, 0x8d, 0x00, 0xfb, 0x7a, 0xb1, 0xaa,
+	0xd5, 0xce, 0xd8, 0x37, 0xbb, 0xa9, 0xf1, 0xa1, 0x3c, 0x72, 0x22, 0x33, 0xd3, 0xb9, 0x9a, 0xe1,
+	0x1c, 0x01, 0x7b, 0xa0, 0x50, 0xeb, 0x0a, 0x74, 0x57, 0x61, 0x5e, 0x4f, 0x83, 0xb3, 0x9f, 0xc9,
+	0x29, 0xdd, 0xe2, 0x88, 0x63, 0x58, 0x05, 0x67, 0x23, 0xfc, 0x8c, 0xbb, 0xa2, 0x05, 0x89, 0xaf,
+	0x6b, 0x55, 0xad, 0x56, 0x70, 0x40, 0x26, 0xdb, 0xa4, 0x34, 0xb8, 0xe7, 0xc3, 0x55, 0x50, 0x1c,
+	0x6a, 0xa1, 0x6b, 0x63, 0x5b, 0x48, 0xb5, 0x4e, 0x21, 0x6b, 0x1d, 0x47, 0x19, 0xc2, 0x47, 0xa0,
+	0x2c, 0xf8, 0x45, 0x08, 0xb2, 0x17, 0xca, 0x2b, 0xb5, 0xb1, 0x3c, 0x0f, 0xc4, 0xd8, 0x89, 0x60,
+	0x14, 0x19, 0xc8, 0x60, 0x42, 0xc0, 0xe0, 0x97, 0x00, 0xf6, 0xbb, 0x91, 0xb9, 0x3c, 0x46, 0xde,
+	0x0e, 0x8e, 0x45, 0x23, 0x94, 0x57, 0x6e, 0x4c, 0xd4, 0xe2, 0x6c, 0x5b, 0x1a, 0x39, 0x73, 0x7c,
+	0x44, 0x02, 0x3f, 0x07, 0x67, 0x85, 0xb7, 0xbb, 0x34, 0x48, 0x42, 0xcc, 0xf4, 0xd3, 0xc2, 0xdd,
+	0xf7, 0xc7, 0x87, 0x4d, 0x69, 0xf0, 0x85, 0xc0, 0x3b, 0xe5, 0x4e, 0xff, 0x7f, 0x06, 0x3b, 0x60,
+	0x51, 0x34, 0x9f, 0xdb, 0x41, 0x24, 0x76, 0xf3, 0x09, 0x62, 0x9c, 0xc6, 0x58, 0x2f, 0x0a, 0x66,
+	0x6b, 0x2c, 0xb3, 0x68, 0xd2, 0x4d, 0x44, 0xe2, 0x9e, 0xe7, 0x2a, 0x1d, 0x17, 0xfc, 0x51, 0xc5,
+	0x56, 0xc6, 0x69, 0x3e, 0x2f, 0x82, 0x99, 0xe1, 0x39, 0x86, 0x0d, 0x30, 0xef, 0xe3, 0x26, 0x4a,
+	0x02, 0x9e, 0x7b, 0x20, 0x0a, 0x5d, 0xb2, 0x6f, 0x65, 0x5c, 0x7f, 0xa4, 0xc6, 0x92, 0x5c, 0x2d,
+	0xcc, 0xdf, 0xb1, 0x08, 0xad, 0x87, 0x88, 0xb7, 0xad, 0xfb, 0xb8, 0x85, 0xbc, 0xfd, 0x75, 0xec,
+	0x1d, 0xa4, 0xc6, 0xec, 0xba, 0xb4, 0xef, 0x11, 0x3b, 0xb3, 0xfe, 0xb0, 0x00, 0xfe, 0xa0, 0x01,
+	0xb1, 0xef, 0x07, 0x62, 0xf4, 0x09, 0xe3, 0x31, 0x69, 0x24, 0xd9, 0x56, 0x52, 0xbd, 0xf3, 0xc9,
+	0x44, 0xb5, 0x59, 0x1f, 0x30, 0xdc, 0xc4, 0xb1, 0x87, 0x23, 0x8e, 0x5a, 0xd8, 0xae, 0x66, 0xbe,
+	0x1e, 0xa4, 0x86, 0xfe, 0x88, 0x85, 0xf4, 0x38, 0xac, 0xa3, 0xd3, 0x13, 0x34, 0xf0, 0x47, 0x0d,
+	0x18, 0x11, 0x8d, 0xdc, 0x71, 0x2e, 0x4e, 0xff, 0x77, 0x17, 0xaf, 0x29, 0x17, 0x97, 0x1e, 0xd2,
+	0xe8, 0x44, 0x2f, 0x97, 0xa2, 0x93, 0x95, 0x70, 0x0d, 0xcc, 0x22, 0x3f, 0x24, 0x91, 0x8b, 0x7c,
+	0x3f, 0xc6, 0x8c, 0x61, 0xa6, 0x17, 0xc4, 0xea, 0x5c, 0xec, 0xa6, 0xc6, 0x05, 0xb5, 0x3a, 0x87,
+	0x01, 0xa6, 0x33, 0x23, 0x24, 0xab, 0x3d, 0x01, 0xfc, 0x59, 0x03, 0xb7, 0x3c, 0x1a, 0x86, 0x49,
+	0x44, 0xf8, 0xbe, 0x1c, 0x6d, 0xd9, 0x85, 0x9c, 0xba, 0x6c, 0x0f, 0x75, 0xdc, 0x2c, 0x15, 0x7b,
+	0x6d, 0xc2, 0x71, 0x40, 0x58, 0xb6, 0x54, 0x10, 0x63, 0x98, 0x33, 0x97, 0x53, 0xb5, 0xee, 0x56,
+	0xbb, 0xa9, 0x71, 0x47, 0x1e, 0xf6, 0xef, 0x78, 0x4c, 0xc7, 0xea, 0x1b, 0x66, 0xb3, 0x21, 0xba,
+	0x78, 0x9b, 0x6e, 0xed, 0xa1, 0xce, 0x43, 0x1a, 0x3d, 0xc9, 0x4d, 0x56, 0x85, 0xc5, 0x36, 0x85,
+	0xdb, 0x60, 0x21, 0xc6, 0x7e, 0xe2, 0x61, 0x5f, 0x54, 0xa6, 0xcf, 0x2a, 0x86, 0xa4, 0x64, 0x57,
+	0xbb, 0xa9, 0x71, 0x59, 0x7a, 0x74, 0x2c, 0xcc, 0x74, 0xde, 0x56, 0xf2, 0x0d, 0x8c, 0xfb, 0xfc,
+	0xe6, 0x2f, 0xa7, 0x40, 0x65, 0x7c, 0xcd, 0x60, 0x13, 0xcc, 0x32, 0x8e, 0x76, 0x48, 0xd4, 0x72,
+	0x63, 0xbc, 0x87, 0x62, 0x9f, 0xa9, 0xd9, 0xb8, 0x33, 0xc1, 0x6c, 0xe4, 0x45, 0x19, 0xe1, 0x30,
+	0x9d, 0x19, 0x25, 0x71, 0xa4, 0x00, 0x7a, 0x60, 0x66, 0x38, 0x97, 0x62, 0x26, 0x4a, 0xf6, 0xa7,
+	0x93, 0x1d, 0xb3, 0x70, 0x5c, 0x39, 0x4c, 0xe7, 0xdc, 0x50, 0x9a, 0xe1, 0x06, 0x28, 0x34, 0x92,
+	0x58, 0xf6, 0x72, 0xc9, 0x5e, 0x99, 0x8c, 0xba, 0x2c, 0xa9, 0x33, 0x43, 0xd3, 0x11, 0xf6, 0xe6,
+	0x77, 0x05, 0x30, 0x37, 0xba, 0x2a, 0xe1, 0x37, 0x60, 0x61, 0x70, 0xeb, 0x52, 0x97, 0x89, 0x4f,
+	0xf6, 0xf7, 0xef, 0x9d, 0x9b, 0x99, 0x23, 0xff, 0xe8, 0x4d, 0x03, 0xf3, 0xb5, 0x4c, 0xb7, 0xe4,
+	0x31, 0xf0, 0xb9, 0x06, 0x2e, 0x0f, 0x3b, 0x70, 0x24, 0xa1, 0x6f, 0xdc, 0x0f, 0x7d, 0xc0, 0x8f,
+	0xb5, 0xa1, 0x54, 0xef, 0x80, 0x2b, 0x6d, 0x4c, 0x5a, 0x6d, 0xee, 0x22, 0x4f, 0xdc, 0xb1, 0x59,
+	0xf5, 0x19, 0x47, 0x31, 0x67, 0x6e, 0x33, 0xa6, 0xa1, 0xa8, 0xc1, 0xb4, 0x5d, 0xeb, 0xa6, 0xc6,
+	0x3b, 0x32, 0xc1, 0x63, 0xe1, 0xa6, 0xb3, 0x28, 0xf5, 0xab, 0x7d, 0xf5, 0x96, 0xd0, 0x6e, 0xc4,
+	0x34, 0x84, 0x7b, 0x60, 0x7e, 0x20, 0xf2, 0xac, 0x44, 0xd8, 0xd7, 0x0b, 0x6f, 0x3e, 0xdc, 0xd9,
+	0x7e, 0xb8, 0xb6, 0x38, 0xc3, 0x7c, 0xa1, 0x01, 0x90, 0x5f, 0x6e, 0xf0, 0x22, 0x78, 0x6b, 0xf8,
+	0xa5, 0x50, 0xec, 0xc8, 0x57, 0x42, 0x00, 0xca, 0x03, 0x97, 0xe6, 0xff, 0x51, 0x09, 0x90, 0xdf,
+	0xab, 0xe6, 0x6f, 0x1a, 0x98, 0x3f, 0x72, 0x31, 0xc2, 0xeb, 0xa0, 0x28, 0xd6, 0xd3, 0x4d, 0x35,
+	0xc0, 0xf3, 0xdd, 0xd4, 0x38, 0x27, 0x53, 0x2f, 0xe5, 0xa6, 0xa3, 0x00, 0x7d, 0xe8, 0xb2, 0x7e,
+	0xea, 0x58, 0xe8, 0x72, 0x0f, 0xba, 0x0c, 0xb7, 0x41, 0x29, 0xbf, 0x35, 0xe5, 0x5c, 0xdd, 0x9e,
+	0x6c, 0xae, 0xe6, 0x46, 0xde, 0xcd, 0xa6, 0x73, 0xa6, 0x97, 0x5e, 0xfb, 0xf1, 0xcb, 0x83, 0x8a,
+	0xf6, 0xea, 0xa0, 0xa2, 0xfd, 0x79, 0x50, 0xd1, 0xbe, 0x3f, 0xac, 0x4c, 0xbd, 0x3a, 0xac, 0x4c,
+	0xfd, 0x7e, 0x58, 0x99, 0x7a, 0x7a, 0x7b, 0x20, 0x23, 0xea, 0x2a, 0xba, 0x11, 0xa0, 0x06, 0xeb,
+	0x7d, 0xd4, 0x77, 0x57, 0x96, 0xeb, 0xcf, 0x86, 0x7e, 0xab, 0x88, 0x34, 0x35, 0x8a, 0xe2, 0xd7,
+	0xc9, 0x47, 0x7f, 0x0d, 0x00, 0xe3, 0x89, 0x02, 0x4a, 0xa3, 0x0d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -558,6 +672,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OsmoRoutedMultihopDiscountEnabled {
+		i--
+		if m.OsmoRoutedMultihopDiscountEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StatisticsEpochIdentifier) > 0 {
+		i -= len(m.StatisticsEpochIdentifier)
+		copy(dAtA[i:], m.StatisticsEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StatisticsEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StatisticsQuoteDenom) > 0 {
+		i -= len(m.StatisticsQuoteDenom)
+		copy(dAtA[i:], m.StatisticsQuoteDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StatisticsQuoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.AuthorizedQuoteDenoms) > 0 {
 		for iNdEx := len(m.AuthorizedQuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AuthorizedQuoteDenoms[iNdEx])
@@ -784,6 +922,16 @@ func (m *TakerFeeDistributionPercentage) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.CommunityPool.Size()
 		i -= size
@@ -827,6 +975,20 @@ func (m *TakerFeesTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TakerFeesBurned) > 0 {
+		for iNdEx := len(m.TakerFeesBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeesBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.HeightAccountingStartsFrom != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.HeightAccountingStartsFrom))
 		i--
@@ -905,6 +1067,53 @@ func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -936,6 +1145,17 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.StatisticsQuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.StatisticsEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.OsmoRoutedMultihopDiscountEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1016,6 +1236,8 @@ func (m *TakerFeeDistributionPercentage) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.CommunityPool.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1040,6 +1262,12 @@ func (m *TakerFeesTracker) Size() (n int) {
 	if m.HeightAccountingStartsFrom != 0 {
 		n += 1 + sovGenesis(uint64(m.HeightAccountingStartsFrom))
 	}
+	if len(m.TakerFeesBurned) > 0 {
+		for _, e := range m.TakerFeesBurned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1061,6 +1289,25 @@ func (m *PoolVolume) Size() (n int) {
 	return n
 }
 
+func (m *DenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1195,6 +1442,90 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorizedQuoteDenoms = append(m.AuthorizedQuoteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatisticsQuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatisticsQuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatisticsEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatisticsEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoRoutedMultihopDiscountEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OsmoRoutedMultihopDiscountEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1799,6 +2130,40 @@ func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1936,6 +2301,40 @@ func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesBurned = append(m.TakerFeesBurned, types.Coin{})
+			if err := m.TakerFeesBurned[len(m.TakerFeesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2060,6 +2459,154 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPairTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
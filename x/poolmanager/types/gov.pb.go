@@ -77,20 +77,20 @@ var fileDescriptor_c95b3c1cda2a8632 = []byte{
 	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
 	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x92, 0x86, 0x2a, 0xd3, 0x43, 0x52, 0xa6, 0x07, 0x55, 0x26, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0xa9, 0xe0, 0x33, 0xb9, 0xa4, 0x02, 0xa2,
-	0x4a, 0xe9, 0x08, 0x23, 0x97, 0xa4, 0x4b, 0x6a, 0x5e, 0x7e, 0x6e, 0x40, 0x62, 0x66, 0x51, 0x48,
-	0x62, 0x76, 0x6a, 0x91, 0x5b, 0x6a, 0x6a, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71, 0x62, 0x8e, 0x90,
-	0x08, 0x17, 0x6b, 0x49, 0x66, 0x49, 0x4e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x84,
-	0x23, 0xa4, 0xc0, 0xc5, 0x9d, 0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x92, 0x99, 0x9f, 0x27,
-	0xc1, 0x04, 0x96, 0x43, 0x16, 0x12, 0x4a, 0xe5, 0x12, 0x49, 0x01, 0x19, 0x1a, 0x5f, 0x90, 0x98,
-	0x59, 0x14, 0x5f, 0x02, 0x32, 0x36, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x59, 0x81, 0x59, 0x83, 0xdb,
-	0x48, 0x4f, 0x0f, 0x8f, 0x6f, 0xf4, 0x30, 0x5c, 0xe3, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x90,
-	0x60, 0x0a, 0xba, 0x84, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x02,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6a, 0xad, 0x6e, 0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3,
-	0x5f, 0x66, 0x64, 0xa8, 0x5f, 0x81, 0x12, 0x48, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0,
-	0x00, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x12, 0xb5, 0x3f, 0xa2, 0x01, 0x00, 0x00,
+	0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0x69, 0xe2, 0x35, 0x39, 0x35, 0x2f, 0x15,
+	0x6c, 0x1c, 0x48, 0xa9, 0xd2, 0x11, 0x46, 0x2e, 0x49, 0x97, 0xd4, 0xbc, 0xfc, 0xdc, 0x80, 0xc4,
+	0xcc, 0xa2, 0x90, 0xc4, 0xec, 0xd4, 0x22, 0xb7, 0xd4, 0xd4, 0x80, 0xa2, 0xfc, 0x82, 0xfc, 0xe2,
+	0xc4, 0x1c, 0x21, 0x11, 0x2e, 0xd6, 0x92, 0xcc, 0x92, 0x9c, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
+	0xce, 0x20, 0x08, 0x47, 0x48, 0x81, 0x8b, 0x3b, 0x25, 0xb5, 0x38, 0xb9, 0x28, 0xb3, 0xa0, 0x24,
+	0x33, 0x3f, 0x4f, 0x82, 0x09, 0x2c, 0x87, 0x2c, 0x24, 0x94, 0xca, 0x25, 0x92, 0x02, 0x32, 0x34,
+	0xbe, 0x20, 0x31, 0xb3, 0x28, 0xbe, 0x04, 0x64, 0x6c, 0x7c, 0x5a, 0x6a, 0xaa, 0x04, 0xb3, 0x02,
+	0xb3, 0x06, 0xb7, 0x91, 0x9e, 0x1e, 0x1e, 0x2f, 0xe9, 0x61, 0xb8, 0xc6, 0x89, 0xe5, 0xc4, 0x3d,
+	0x79, 0x86, 0x20, 0xc1, 0x14, 0x74, 0x09, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x33, 0x16, 0xc8,
+	0x33, 0x38, 0x05, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
+	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x79, 0x7a,
+	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x5a, 0xdd, 0x9c, 0xc4, 0xa4,
+	0x62, 0x18, 0x47, 0xbf, 0xcc, 0xc8, 0x50, 0xbf, 0x02, 0x25, 0xa4, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
+	0x93, 0xd8, 0xc0, 0x01, 0x64, 0x0c, 0x18, 0x00, 0xc8, 0x9b, 0xda, 0x90, 0xa7, 0x01, 0x00, 0x00,
 }
 
 func (m *DenomPairTakerFeeProposal) Marshal() (dAtA []byte, err error) {
@@ -39,6 +39,15 @@ var (
 
 	// KeyTakerFeeProtoRevAccountingHeight defines key to store the accounting height for the above taker fee trackers.
 	KeyTakerFeeProtoRevAccountingHeight = []byte{0x07}
+
+	// KeyTakerFeeBurnedProtoRev defines key to store the burned taker fee tracker.
+	KeyTakerFeeBurnedProtoRev = []byte{0x08}
+
+	// KeyChainStatistics defines key to store the chain statistics computed at the end of the statistics epoch.
+	KeyChainStatistics = []byte{0x09}
+
+	// KeyParams defines key to store the poolmanager module parameters.
+	KeyParams = []byte{0x0A}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
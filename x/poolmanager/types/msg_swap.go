@@ -27,6 +27,14 @@ func (msg MsgSplitRouteSwapExactAmountOut) GetSwapMsgs() []SwapMsgRoute {
 	return routes
 }
 
+func (msg MsgBatchSwapExactAmountIn) GetSwapMsgs() []SwapMsgRoute {
+	routes := make([]SwapMsgRoute, len(msg.Swaps))
+	for i := 0; i < len(msg.Swaps); i++ {
+		routes[i] = msg.Swaps[i]
+	}
+	return routes
+}
+
 type SwapAmountInSplitRouteWrapper struct {
 	Pools   []SwapAmountInRoute `json:"pools"`
 	InDenom string              `json:"in_denom"`
@@ -40,6 +48,7 @@ type SwapAmountOutSplitRouteWrapper struct {
 var (
 	_ SwapMsgRoute = MsgSwapExactAmountIn{}
 	_ SwapMsgRoute = MsgSwapExactAmountOut{}
+	_ SwapMsgRoute = BatchSwapExactAmountIn{}
 	_ SwapMsgRoute = SwapAmountInSplitRouteWrapper{}
 	_ SwapMsgRoute = SwapAmountOutSplitRouteWrapper{}
 )
@@ -108,3 +117,21 @@ func (msg MsgSwapExactAmountIn) TokenDenomsOnPath() []string {
 	}
 	return denoms
 }
+
+func (swap BatchSwapExactAmountIn) TokenInDenom() string {
+	return swap.TokenIn.Denom
+}
+
+func (swap BatchSwapExactAmountIn) TokenOutDenom() string {
+	lastRouteIndex := len(swap.Routes) - 1
+	return swap.Routes[lastRouteIndex].GetTokenOutDenom()
+}
+
+func (swap BatchSwapExactAmountIn) TokenDenomsOnPath() []string {
+	denoms := make([]string, 0, len(swap.Routes)+1)
+	denoms = append(denoms, swap.TokenInDenom())
+	for i := 0; i < len(swap.Routes); i++ {
+		denoms = append(denoms, swap.Routes[i].TokenOutDenom)
+	}
+	return denoms
+}
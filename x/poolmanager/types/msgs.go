@@ -13,6 +13,8 @@ const (
 	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
 	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
 	TypeMsgSetDenomPairTakerFee         = "set_denom_pair_taker_fee"
+	TypeMsgBatchSwapExactAmountIn       = "batch_swap_exact_amount_in"
+	TypeMsgUpdateParams                 = "update_params"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -193,3 +195,82 @@ func (msg MsgSetDenomPairTakerFee) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgBatchSwapExactAmountIn{}
+
+func (msg MsgBatchSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgBatchSwapExactAmountIn) Type() string  { return TypeMsgBatchSwapExactAmountIn }
+
+func (msg MsgBatchSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if len(msg.Swaps) == 0 {
+		return ErrEmptySwaps
+	}
+
+	for i, swap := range msg.Swaps {
+		if err := swap.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid swap at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgBatchSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgBatchSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Validate performs the same stateless checks on a single batched swap
+// as MsgSwapExactAmountIn.ValidateBasic does on a standalone swap.
+func (swap BatchSwapExactAmountIn) Validate() error {
+	if err := SwapAmountInRoutes(swap.Routes).Validate(); err != nil {
+		return err
+	}
+
+	if !swap.TokenIn.IsValid() || !swap.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, swap.TokenIn.String())
+	}
+
+	if !swap.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{swap.TokenOutMinAmount.String()}
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -333,6 +333,27 @@ func TestAuthzMsg(t *testing.T) {
 				TokenInMaxAmount: osmomath.NewInt(1),
 			},
 		},
+		{
+			name: "MsgBatchSwapExactAmountIn",
+			msg: &types.MsgBatchSwapExactAmountIn{
+				Sender: addr1,
+				Swaps: []types.BatchSwapExactAmountIn{{
+					Routes: []types.SwapAmountInRoute{{
+						PoolId:        0,
+						TokenOutDenom: "test",
+					}},
+					TokenIn:           coin,
+					TokenOutMinAmount: osmomath.NewInt(1),
+				}, {
+					Routes: []types.SwapAmountInRoute{{
+						PoolId:        1,
+						TokenOutDenom: "test2",
+					}},
+					TokenIn:           coin,
+					TokenOutMinAmount: osmomath.NewInt(1),
+				}},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -654,3 +675,132 @@ func TestMsgSetDenomPairTakerFee(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgBatchSwapExactAmountIn(t *testing.T) {
+	properMsg := types.MsgBatchSwapExactAmountIn{
+		Sender: addr1,
+		Swaps: []types.BatchSwapExactAmountIn{
+			{
+				Routes:            validSwapExactAmountInRoutes,
+				TokenIn:           sdk.NewCoin("test", osmomath.NewInt(100)),
+				TokenOutMinAmount: osmomath.NewInt(200),
+			},
+			{
+				Routes:            []types.SwapAmountInRoute{validSwapRoutePoolThreeAmountIn},
+				TokenIn:           sdk.NewCoin("test", osmomath.NewInt(300)),
+				TokenOutMinAmount: osmomath.NewInt(400),
+			},
+		},
+	}
+
+	msg := createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "batch_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	// copySwaps returns a deep copy of the proper message's swaps so that
+	// test cases can modify them without affecting each other.
+	copySwaps := func() []types.BatchSwapExactAmountIn {
+		swaps := make([]types.BatchSwapExactAmountIn, len(properMsg.Swaps))
+		copy(swaps, properMsg.Swaps)
+		return swaps
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgBatchSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "single swap",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Swaps = copySwaps()[:1]
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty swaps",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Swaps = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes in second swap",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Swaps = copySwaps()
+				msg.Swaps[1].Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Swaps = copySwaps()
+				msg.Swaps[0].TokenIn.Denom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Swaps = copySwaps()
+				msg.Swaps[1].TokenIn.Amount = osmomath.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Swaps = copySwaps()
+				msg.Swaps[1].TokenOutMinAmount = osmomath.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative amount criteria",
+			msg: createMsg(properMsg, func(msg types.MsgBatchSwapExactAmountIn) types.MsgBatchSwapExactAmountIn {
+				msg.Swaps = copySwaps()
+				msg.Swaps[0].TokenOutMinAmount = osmomath.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
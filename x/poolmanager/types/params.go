@@ -6,6 +6,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -21,6 +22,9 @@ var (
 	KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo = []byte("CommunityPoolDenomToSwapNonWhitelistedAssetsTo")
 	KeyAuthorizedQuoteDenoms                          = []byte("AuthorizedQuoteDenoms")
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
+	KeyStatisticsQuoteDenom                           = []byte("StatisticsQuoteDenom")
+	KeyStatisticsEpochIdentifier                      = []byte("StatisticsEpochIdentifier")
+	KeyOsmoRoutedMultihopDiscountEnabled              = []byte("OsmoRoutedMultihopDiscountEnabled")
 )
 
 // ParamTable for gamm module.
@@ -55,10 +59,12 @@ func DefaultParams() Params {
 			OsmoTakerFeeDistribution: TakerFeeDistributionPercentage{
 				StakingRewards: osmomath.MustNewDecFromStr("1"), // 100%
 				CommunityPool:  osmomath.MustNewDecFromStr("0"), // 0%
+				Burn:           osmomath.MustNewDecFromStr("0"), // 0%
 			},
 			NonOsmoTakerFeeDistribution: TakerFeeDistributionPercentage{
 				StakingRewards: osmomath.MustNewDecFromStr("0.67"), // 67%
 				CommunityPool:  osmomath.MustNewDecFromStr("0.33"), // 33%
+				Burn:           osmomath.MustNewDecFromStr("0"),    // 0%
 			},
 			AdminAddresses: []string{},
 			CommunityPoolDenomToSwapNonWhitelistedAssetsTo: "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
@@ -70,6 +76,9 @@ func DefaultParams() Params {
 			"ibc/0CD3A0285E1341859B5E86B6AB7682F023D03E97607CCC1DC95706411D866DF7", // DAI
 			"ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 		},
+		StatisticsQuoteDenom:              "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
+		StatisticsEpochIdentifier:         "day",
+		OsmoRoutedMultihopDiscountEnabled: false,
 	}
 }
 
@@ -84,7 +93,7 @@ func (p Params) Validate() error {
 	if err := validateTakerFeeDistribution(p.TakerFeeParams.OsmoTakerFeeDistribution); err != nil {
 		return err
 	}
-	if err := validateTakerFeeDistribution(p.TakerFeeParams.NonOsmoTakerFeeDistribution); err != nil {
+	if err := validateNonOsmoTakerFeeDistribution(p.TakerFeeParams.NonOsmoTakerFeeDistribution); err != nil {
 		return err
 	}
 	if err := validateAdminAddresses(p.TakerFeeParams.AdminAddresses); err != nil {
@@ -99,6 +108,15 @@ func (p Params) Validate() error {
 	if err := validateAuthorizedQuoteDenoms(p.AuthorizedQuoteDenoms); err != nil {
 		return err
 	}
+	if err := validateStatisticsQuoteDenom(p.StatisticsQuoteDenom); err != nil {
+		return err
+	}
+	if err := epochtypes.ValidateEpochIdentifierInterface(p.StatisticsEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateOsmoRoutedMultihopDiscountEnabled(p.OsmoRoutedMultihopDiscountEnabled); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -109,11 +127,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
 		paramtypes.NewParamSetPair(KeyDefaultTakerFee, &p.TakerFeeParams.DefaultTakerFee, validateDefaultTakerFee),
 		paramtypes.NewParamSetPair(KeyOsmoTakerFeeDistribution, &p.TakerFeeParams.OsmoTakerFeeDistribution, validateTakerFeeDistribution),
-		paramtypes.NewParamSetPair(KeyNonOsmoTakerFeeDistribution, &p.TakerFeeParams.NonOsmoTakerFeeDistribution, validateTakerFeeDistribution),
+		paramtypes.NewParamSetPair(KeyNonOsmoTakerFeeDistribution, &p.TakerFeeParams.NonOsmoTakerFeeDistribution, validateNonOsmoTakerFeeDistribution),
 		paramtypes.NewParamSetPair(KeyAdminAddresses, &p.TakerFeeParams.AdminAddresses, validateAdminAddresses),
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo, &p.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo, validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo),
 		paramtypes.NewParamSetPair(KeyAuthorizedQuoteDenoms, &p.AuthorizedQuoteDenoms, validateAuthorizedQuoteDenoms),
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyStatisticsQuoteDenom, &p.StatisticsQuoteDenom, validateStatisticsQuoteDenom),
+		paramtypes.NewParamSetPair(KeyStatisticsEpochIdentifier, &p.StatisticsEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyOsmoRoutedMultihopDiscountEnabled, &p.OsmoRoutedMultihopDiscountEnabled, validateOsmoRoutedMultihopDiscountEnabled),
 	}
 }
 
@@ -160,6 +181,27 @@ func validateTakerFeeDistribution(i interface{}) error {
 	if takerFeeDistribution.CommunityPool.IsNegative() || takerFeeDistribution.CommunityPool.GT(osmomath.OneDec()) {
 		return fmt.Errorf("invalid community pool distribution: %s", takerFeeDistribution.CommunityPool)
 	}
+	if takerFeeDistribution.Burn.IsNil() || takerFeeDistribution.Burn.IsNegative() || takerFeeDistribution.Burn.GT(osmomath.OneDec()) {
+		return fmt.Errorf("invalid burn distribution: %s", takerFeeDistribution.Burn)
+	}
+	if takerFeeDistribution.CommunityPool.Add(takerFeeDistribution.Burn).GT(osmomath.OneDec()) {
+		return fmt.Errorf("community pool (%s) and burn (%s) distributions must not exceed 1", takerFeeDistribution.CommunityPool, takerFeeDistribution.Burn)
+	}
+
+	return nil
+}
+
+// validateNonOsmoTakerFeeDistribution validates the distribution of non-OSMO taker fees.
+// Since only OSMO can be burned, the burn distribution must be zero.
+func validateNonOsmoTakerFeeDistribution(i interface{}) error {
+	if err := validateTakerFeeDistribution(i); err != nil {
+		return err
+	}
+
+	takerFeeDistribution := i.(TakerFeeDistributionPercentage)
+	if !takerFeeDistribution.Burn.IsZero() {
+		return fmt.Errorf("burn distribution must be zero for non-OSMO taker fees, got: %s", takerFeeDistribution.Burn)
+	}
 
 	return nil
 }
@@ -224,6 +266,20 @@ func validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo(i interface{}) error
 	return nil
 }
 
+func validateStatisticsQuoteDenom(i interface{}) error {
+	statisticsQuoteDenom, ok := i.(string)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(statisticsQuoteDenom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	if len(pairs) == 0 {
 		return fmt.Errorf("Empty denom pair taker fee")
@@ -249,3 +305,11 @@ func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	}
 	return nil
 }
+
+func validateOsmoRoutedMultihopDiscountEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
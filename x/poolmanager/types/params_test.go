@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+func TestValidateTakerFeeDistribution(t *testing.T) {
+	distribution := func(stakingRewards, communityPool, burn string) types.TakerFeeDistributionPercentage {
+		return types.TakerFeeDistributionPercentage{
+			StakingRewards: osmomath.MustNewDecFromStr(stakingRewards),
+			CommunityPool:  osmomath.MustNewDecFromStr(communityPool),
+			Burn:           osmomath.MustNewDecFromStr(burn),
+		}
+	}
+
+	tests := map[string]struct {
+		osmoDistribution    types.TakerFeeDistributionPercentage
+		nonOsmoDistribution types.TakerFeeDistributionPercentage
+		expectErr           bool
+	}{
+		"default distributions": {
+			osmoDistribution:    types.DefaultParams().TakerFeeParams.OsmoTakerFeeDistribution,
+			nonOsmoDistribution: types.DefaultParams().TakerFeeParams.NonOsmoTakerFeeDistribution,
+		},
+		"osmo burn": {
+			osmoDistribution:    distribution("0.5", "0.2", "0.3"),
+			nonOsmoDistribution: distribution("0.5", "0.5", "0"),
+		},
+		"osmo burn everything": {
+			osmoDistribution:    distribution("0", "0", "1"),
+			nonOsmoDistribution: distribution("0.5", "0.5", "0"),
+		},
+		"osmo negative burn": {
+			osmoDistribution:    distribution("0.5", "0.5", "-0.1"),
+			nonOsmoDistribution: distribution("0.5", "0.5", "0"),
+			expectErr:           true,
+		},
+		"osmo community pool and burn exceed one": {
+			osmoDistribution:    distribution("0", "0.7", "0.4"),
+			nonOsmoDistribution: distribution("0.5", "0.5", "0"),
+			expectErr:           true,
+		},
+		"osmo nil burn": {
+			osmoDistribution: types.TakerFeeDistributionPercentage{
+				StakingRewards: osmomath.OneDec(),
+				CommunityPool:  osmomath.ZeroDec(),
+			},
+			nonOsmoDistribution: distribution("0.5", "0.5", "0"),
+			expectErr:           true,
+		},
+		"non-osmo burn": {
+			osmoDistribution:    distribution("0.5", "0.5", "0"),
+			nonOsmoDistribution: distribution("0.5", "0.4", "0.1"),
+			expectErr:           true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.TakerFeeParams.OsmoTakerFeeDistribution = tc.osmoDistribution
+			params.TakerFeeParams.NonOsmoTakerFeeDistribution = tc.nonOsmoDistribution
+
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,677 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/poolmanager/v1beta1/statistics.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChainStatistics are chain-wide pool and OSMO supply statistics. They are
+// computed at the end of every statistics epoch and cached in state.
+type ChainStatistics struct {
+	// quote_denom is the denom total_value_locked is denominated in.
+	QuoteDenom string `protobuf:"bytes,1,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// total_value_locked is the value of the liquidity across all pools,
+	// priced in quote_denom using arithmetic twaps. Denoms that cannot be
+	// routed to quote_denom are not included.
+	TotalValueLocked cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_value_locked,json=totalValueLocked,proto3,customtype=cosmossdk.io/math.Int" json:"total_value_locked" yaml:"total_value_locked"`
+	// osmo_supply is the total supply of OSMO.
+	OsmoSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=osmo_supply,json=osmoSupply,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_supply" yaml:"osmo_supply"`
+	// osmo_in_pools is the amount of OSMO held as liquidity across all pools.
+	OsmoInPools cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=osmo_in_pools,json=osmoInPools,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_in_pools" yaml:"osmo_in_pools"`
+	// osmo_staked is the amount of OSMO bonded to validators.
+	OsmoStaked cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=osmo_staked,json=osmoStaked,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_staked" yaml:"osmo_staked"`
+	// osmo_liquid is the amount of OSMO that is neither in pools nor staked.
+	OsmoLiquid cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=osmo_liquid,json=osmoLiquid,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_liquid" yaml:"osmo_liquid"`
+	// epoch_number is the number of the epoch at the end of which the
+	// statistics were computed.
+	EpochNumber int64 `protobuf:"varint,7,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// updated_at is the block time at which the statistics were computed.
+	UpdatedAt time.Time `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at" yaml:"updated_at"`
+}
+
+func (m *ChainStatistics) Reset()         { *m = ChainStatistics{} }
+func (m *ChainStatistics) String() string { return proto.CompactTextString(m) }
+func (*ChainStatistics) ProtoMessage()    {}
+func (*ChainStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed8df6ce6557d7bc, []int{0}
+}
+func (m *ChainStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStatistics.Merge(m, src)
+}
+func (m *ChainStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStatistics proto.InternalMessageInfo
+
+func (m *ChainStatistics) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *ChainStatistics) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *ChainStatistics) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*ChainStatistics)(nil), "osmosis.poolmanager.v1beta1.ChainStatistics")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/statistics.proto", fileDescriptor_ed8df6ce6557d7bc)
+}
+
+var fileDescriptor_ed8df6ce6557d7bc = []byte{
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xe3, 0x7f, 0xff, 0x2d, 0x74, 0x03, 0x02, 0x4c, 0x01, 0x13, 0x84, 0x1d, 0xf9, 0x94,
+	0x03, 0x78, 0x95, 0x56, 0xa8, 0x52, 0x6e, 0x04, 0x2e, 0x95, 0x2a, 0x04, 0x6e, 0x85, 0x80, 0x8b,
+	0xb5, 0xb6, 0x17, 0x7b, 0x55, 0xdb, 0xeb, 0x66, 0xc7, 0x11, 0x79, 0x8b, 0xbe, 0x05, 0xaf, 0xd2,
+	0x63, 0x8f, 0x88, 0x83, 0x41, 0xc9, 0x1b, 0xe4, 0x09, 0x90, 0xc7, 0x76, 0x6b, 0xc4, 0xa1, 0x82,
+	0x9b, 0x7f, 0xb3, 0xf3, 0x7d, 0xf3, 0x79, 0x35, 0x4b, 0x9e, 0x49, 0x95, 0x4a, 0x25, 0x14, 0xcd,
+	0xa5, 0x4c, 0x52, 0x96, 0xb1, 0x88, 0xcf, 0xe8, 0x7c, 0xec, 0x73, 0x60, 0x63, 0xaa, 0x80, 0x81,
+	0x50, 0x20, 0x02, 0xe5, 0xe4, 0x33, 0x09, 0x52, 0x7f, 0xd2, 0x74, 0x3b, 0x9d, 0x6e, 0xa7, 0xe9,
+	0x1e, 0xec, 0x44, 0x32, 0x92, 0xd8, 0x47, 0xab, 0xaf, 0x5a, 0x32, 0xb0, 0x22, 0x29, 0xa3, 0x84,
+	0x53, 0x24, 0xbf, 0xf8, 0x4c, 0x41, 0xa4, 0x5c, 0x01, 0x4b, 0xf3, 0xba, 0xc1, 0xfe, 0xba, 0x49,
+	0xee, 0xbc, 0x8a, 0x99, 0xc8, 0x8e, 0x2e, 0xa7, 0xe9, 0xfb, 0xa4, 0x7f, 0x5a, 0x48, 0xe0, 0x5e,
+	0xc8, 0x33, 0x99, 0x1a, 0xda, 0x50, 0x1b, 0x6d, 0x4f, 0x1f, 0xae, 0x4b, 0x4b, 0x5f, 0xb0, 0x34,
+	0x99, 0xd8, 0x9d, 0x43, 0xdb, 0x25, 0x48, 0xaf, 0x2b, 0xd0, 0x63, 0xa2, 0x83, 0x04, 0x96, 0x78,
+	0x73, 0x96, 0x14, 0xdc, 0x4b, 0x64, 0x70, 0xc2, 0x43, 0xe3, 0x3f, 0xd4, 0x4f, 0xce, 0x4b, 0xab,
+	0xf7, 0xbd, 0xb4, 0x1e, 0x04, 0xf8, 0x17, 0x2a, 0x3c, 0x71, 0x84, 0xa4, 0x29, 0x83, 0xd8, 0x39,
+	0xc8, 0x60, 0x5d, 0x5a, 0x8f, 0x6b, 0xf3, 0x3f, 0x0d, 0x6c, 0xf7, 0x2e, 0x16, 0xdf, 0x57, 0xb5,
+	0x43, 0x2c, 0xe9, 0xc7, 0xa4, 0x5f, 0xd9, 0x78, 0xaa, 0xc8, 0xf3, 0x64, 0x61, 0x6c, 0xe0, 0x88,
+	0xbd, 0xeb, 0x46, 0x34, 0xf9, 0x3b, 0x4a, 0xdb, 0x25, 0x15, 0x1d, 0x21, 0xe8, 0x1f, 0xc9, 0x6d,
+	0x3c, 0x13, 0x99, 0x57, 0x5d, 0xb1, 0x32, 0xfe, 0x47, 0xdf, 0x17, 0xd7, 0xf9, 0xee, 0x74, 0x7c,
+	0x5b, 0xad, 0xed, 0x62, 0xc2, 0x83, 0xec, 0x6d, 0x45, 0x57, 0x81, 0x81, 0x55, 0x77, 0xb2, 0xf9,
+	0x0f, 0x81, 0x51, 0xd9, 0x06, 0x46, 0xb8, 0x74, 0x4d, 0xc4, 0x69, 0x21, 0x42, 0x63, 0xeb, 0xef,
+	0x5d, 0x6b, 0x65, 0xe3, 0x7a, 0x88, 0xa0, 0x4f, 0xc8, 0x2d, 0x9e, 0xcb, 0x20, 0xf6, 0xb2, 0x22,
+	0xf5, 0xf9, 0xcc, 0xb8, 0x31, 0xd4, 0x46, 0x1b, 0xd3, 0x47, 0xeb, 0xd2, 0xba, 0x5f, 0x2b, 0xbb,
+	0xa7, 0xb6, 0xdb, 0x47, 0x7c, 0x83, 0xa4, 0x7f, 0x20, 0xa4, 0xc8, 0x43, 0x06, 0x3c, 0xf4, 0x18,
+	0x18, 0x37, 0x87, 0xda, 0xa8, 0xbf, 0x3b, 0x70, 0xea, 0x2d, 0x74, 0xda, 0x2d, 0x74, 0x8e, 0xdb,
+	0x2d, 0x9c, 0x3e, 0xad, 0xc2, 0xae, 0x4b, 0xeb, 0x5e, 0xed, 0x7c, 0xa5, 0xb5, 0xcf, 0x7e, 0x58,
+	0x9a, 0xbb, 0xdd, 0x14, 0x5e, 0xc2, 0xf4, 0xdd, 0xf9, 0xd2, 0xd4, 0x2e, 0x96, 0xa6, 0xf6, 0x73,
+	0x69, 0x6a, 0x67, 0x2b, 0xb3, 0x77, 0xb1, 0x32, 0x7b, 0xdf, 0x56, 0x66, 0xef, 0xd3, 0x7e, 0x24,
+	0x20, 0x2e, 0x7c, 0x27, 0x90, 0x29, 0x6d, 0x9e, 0xc8, 0xf3, 0x84, 0xf9, 0xaa, 0x05, 0x3a, 0xdf,
+	0x1d, 0xd3, 0x2f, 0xbf, 0xbd, 0x31, 0x58, 0xe4, 0x5c, 0xf9, 0x5b, 0x18, 0x68, 0xef, 0xd7, 0x00,
+	0x06, 0x1c, 0x0b, 0x4a, 0x87, 0x03, 0x00, 0x00,
+}
+
+func (m *ChainStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStatistics(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.EpochNumber != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.OsmoLiquid.Size()
+		i -= size
+		if _, err := m.OsmoLiquid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStatistics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OsmoStaked.Size()
+		i -= size
+		if _, err := m.OsmoStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStatistics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OsmoInPools.Size()
+		i -= size
+		if _, err := m.OsmoInPools.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStatistics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OsmoSupply.Size()
+		i -= size
+		if _, err := m.OsmoSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStatistics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalValueLocked.Size()
+		i -= size
+		if _, err := m.TotalValueLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStatistics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintStatistics(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStatistics(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStatistics(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovStatistics(uint64(l))
+	}
+	l = m.TotalValueLocked.Size()
+	n += 1 + l + sovStatistics(uint64(l))
+	l = m.OsmoSupply.Size()
+	n += 1 + l + sovStatistics(uint64(l))
+	l = m.OsmoInPools.Size()
+	n += 1 + l + sovStatistics(uint64(l))
+	l = m.OsmoStaked.Size()
+	n += 1 + l + sovStatistics(uint64(l))
+	l = m.OsmoLiquid.Size()
+	n += 1 + l + sovStatistics(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 1 + sovStatistics(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovStatistics(uint64(l))
+	return n
+}
+
+func sovStatistics(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStatistics(x uint64) (n int) {
+	return sovStatistics(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatistics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValueLocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValueLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoInPools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoInPools.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoLiquid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoLiquid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatistics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStatistics(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStatistics
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStatistics
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStatistics
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStatistics
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStatistics        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStatistics          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStatistics = fmt.Errorf("proto: unexpected end of group")
+)
@@ -218,11 +218,63 @@ func (m *SwapAmountOutSplitRoute) GetPools() []SwapAmountOutRoute {
 	return nil
 }
 
+// RouteWithLiquidity is a route swapping the token in denom of a route
+// discovery query for its token out denom, with the liquidity of its least
+// liquid pool.
+type RouteWithLiquidity struct {
+	Pools []SwapAmountInRoute `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	// liquidity is the amount of the token in denom of its hop held by the least
+	// liquid pool of the route, in units of the token in denom of the route, as
+	// priced by the spot prices of the previous hops.
+	Liquidity cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity" yaml:"liquidity"`
+}
+
+func (m *RouteWithLiquidity) Reset()         { *m = RouteWithLiquidity{} }
+func (m *RouteWithLiquidity) String() string { return proto.CompactTextString(m) }
+func (*RouteWithLiquidity) ProtoMessage()    {}
+func (*RouteWithLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddd97a9a05492a8, []int{4}
+}
+func (m *RouteWithLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteWithLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteWithLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteWithLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteWithLiquidity.Merge(m, src)
+}
+func (m *RouteWithLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteWithLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteWithLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteWithLiquidity proto.InternalMessageInfo
+
+func (m *RouteWithLiquidity) GetPools() []SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutRoute")
 	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInSplitRoute")
 	proto.RegisterType((*SwapAmountOutSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutSplitRoute")
+	proto.RegisterType((*RouteWithLiquidity)(nil), "osmosis.poolmanager.v1beta1.RouteWithLiquidity")
 }
 
 func init() {
@@ -230,35 +282,38 @@ func init() {
 }
 
 var fileDescriptor_cddd97a9a05492a8 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcb, 0xaa, 0xd3, 0x40,
-	0x1c, 0xc6, 0x33, 0x5e, 0x8e, 0x38, 0x1e, 0xab, 0x86, 0x73, 0xa9, 0x47, 0x48, 0x4a, 0x56, 0x05,
-	0x75, 0x86, 0xd6, 0x45, 0xc5, 0x8d, 0x18, 0xdc, 0x64, 0x55, 0x4c, 0x77, 0x75, 0x11, 0x26, 0x4d,
-	0x48, 0x43, 0x93, 0x99, 0xd0, 0x99, 0xb4, 0x76, 0x2b, 0x3e, 0x80, 0x8f, 0xd5, 0x65, 0x37, 0x82,
-	0x74, 0x11, 0xa4, 0x7d, 0x83, 0x3c, 0x81, 0xe4, 0x66, 0x93, 0x0a, 0x55, 0xce, 0x6e, 0x2e, 0xff,
-	0x6f, 0xfe, 0xbf, 0xef, 0x9b, 0x19, 0xf8, 0x8a, 0xf1, 0x90, 0x71, 0x9f, 0xe3, 0x88, 0xb1, 0x20,
-	0x24, 0x94, 0x78, 0xee, 0x1c, 0x2f, 0x7a, 0xb6, 0x2b, 0x48, 0x0f, 0xf3, 0x25, 0x89, 0xac, 0x39,
-	0x8b, 0x85, 0x8b, 0xa2, 0x39, 0x13, 0x4c, 0x7e, 0x51, 0x56, 0xa3, 0x5a, 0x35, 0x2a, 0xab, 0x6f,
-	0x2e, 0x3c, 0xe6, 0xb1, 0xbc, 0x0e, 0x67, 0xa3, 0x42, 0xa2, 0x7d, 0x03, 0xf0, 0xd9, 0x68, 0x49,
-	0xa2, 0x0f, 0x21, 0x8b, 0xa9, 0x30, 0xa8, 0x99, 0x1d, 0x27, 0xbf, 0x84, 0x0f, 0xb2, 0x23, 0x2c,
-	0xdf, 0x69, 0x83, 0x0e, 0xe8, 0xde, 0xd3, 0xe5, 0x34, 0x51, 0x5b, 0x2b, 0x12, 0x06, 0xef, 0xb4,
-	0x72, 0x43, 0x33, 0xcf, 0xb2, 0x91, 0xe1, 0xc8, 0x3a, 0x7c, 0x22, 0xd8, 0xcc, 0xa5, 0x16, 0x8b,
-	0x85, 0xe5, 0xb8, 0x94, 0x85, 0xed, 0x3b, 0x1d, 0xd0, 0x7d, 0xa8, 0xdf, 0xa4, 0x89, 0x7a, 0x55,
-	0x88, 0x8e, 0x0a, 0x34, 0xf3, 0x71, 0xbe, 0x32, 0x8c, 0xc5, 0xc7, 0x7c, 0xfe, 0x15, 0x40, 0xf9,
-	0x80, 0x31, 0x8c, 0xc5, 0x2d, 0x38, 0xde, 0xc3, 0x56, 0xd1, 0xc6, 0xa7, 0x0d, 0x8c, 0xe7, 0x69,
-	0xa2, 0x5e, 0xd6, 0x31, 0xaa, 0x7d, 0xcd, 0x3c, 0xcf, 0x17, 0x0c, 0x5a, 0x40, 0xfc, 0x00, 0xf0,
-	0xaa, 0x9e, 0xc5, 0x28, 0x0a, 0xfc, 0x12, 0x64, 0x0c, 0xef, 0x67, 0x5d, 0x78, 0x1b, 0x74, 0xee,
-	0x76, 0x1f, 0xf5, 0x11, 0x3a, 0x91, 0x34, 0xfa, 0x2b, 0x4f, 0xfd, 0x62, 0x9d, 0xa8, 0x52, 0x9a,
-	0xa8, 0xe7, 0x07, 0x74, 0xae, 0x99, 0xc5, 0x91, 0xb2, 0x55, 0xe5, 0xe7, 0x53, 0x8b, 0xe4, 0xb2,
-	0x12, 0x7c, 0x90, 0xa9, 0xb6, 0x89, 0x7a, 0x39, 0xc9, 0xbb, 0x71, 0x67, 0x86, 0x7c, 0x86, 0x43,
-	0x22, 0xa6, 0xc8, 0xa0, 0xe2, 0x38, 0xdc, 0x3f, 0xea, 0x2a, 0x5c, 0x83, 0x16, 0x10, 0xda, 0x16,
-	0xc0, 0xeb, 0x46, 0xb8, 0x35, 0x63, 0x9f, 0x9b, 0xc6, 0xf0, 0x7f, 0x1a, 0xab, 0x6e, 0xe8, 0xb4,
-	0x33, 0x1b, 0x3e, 0x3d, 0x5c, 0x7c, 0xc3, 0xda, 0xdb, 0x7f, 0x59, 0xbb, 0x3e, 0x7e, 0x37, 0x95,
-	0xb7, 0x56, 0xf5, 0x70, 0x0a, 0x10, 0xfd, 0xd3, 0x7a, 0xa7, 0x80, 0xcd, 0x4e, 0x01, 0xbf, 0x76,
-	0x0a, 0xf8, 0xbe, 0x57, 0xa4, 0xcd, 0x5e, 0x91, 0x7e, 0xee, 0x15, 0x69, 0x3c, 0xf0, 0x7c, 0x31,
-	0x8d, 0x6d, 0x34, 0x61, 0x21, 0x2e, 0x5d, 0xbd, 0x0e, 0x88, 0xcd, 0xab, 0x09, 0x5e, 0xf4, 0x7b,
-	0xf8, 0x4b, 0xe3, 0x67, 0x89, 0x55, 0xe4, 0x72, 0xfb, 0x2c, 0xff, 0x1a, 0x6f, 0x7e, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x3e, 0xb8, 0x73, 0x26, 0x7d, 0x03, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4d, 0x6b, 0xd4, 0x40,
+	0x18, 0xc7, 0x77, 0x7c, 0xa9, 0x74, 0xac, 0x6b, 0x0d, 0x7d, 0x59, 0x2b, 0x24, 0x4b, 0x4e, 0x0b,
+	0xea, 0x0c, 0x5b, 0x0f, 0x15, 0x2f, 0x62, 0xf0, 0x12, 0x10, 0x16, 0xd3, 0x83, 0x50, 0x0f, 0x61,
+	0xd2, 0x84, 0xdd, 0xa1, 0xc9, 0x4c, 0xdc, 0x99, 0xb4, 0xee, 0x55, 0xfc, 0x00, 0x7e, 0x25, 0x6f,
+	0x3d, 0xf6, 0x22, 0x48, 0x0f, 0x41, 0x76, 0xbf, 0xc1, 0x7e, 0x02, 0xc9, 0x64, 0xa6, 0xbb, 0x59,
+	0xa1, 0x15, 0xc1, 0xdb, 0xbc, 0x3c, 0x2f, 0xff, 0xdf, 0xff, 0x99, 0x04, 0x3e, 0xe3, 0x22, 0xe3,
+	0x82, 0x0a, 0x9c, 0x73, 0x9e, 0x66, 0x84, 0x91, 0x61, 0x32, 0xc6, 0xa7, 0xfd, 0x28, 0x91, 0xa4,
+	0x8f, 0xc5, 0x19, 0xc9, 0xc3, 0x31, 0x2f, 0x64, 0x82, 0xf2, 0x31, 0x97, 0xdc, 0x7a, 0xa2, 0xa3,
+	0xd1, 0x52, 0x34, 0xd2, 0xd1, 0x7b, 0x5b, 0x43, 0x3e, 0xe4, 0x2a, 0x0e, 0x57, 0xab, 0x3a, 0xc5,
+	0xfd, 0x0a, 0xe0, 0xa3, 0xc3, 0x33, 0x92, 0xbf, 0xc9, 0x78, 0xc1, 0xa4, 0xcf, 0x82, 0xaa, 0x9c,
+	0xf5, 0x14, 0xde, 0xab, 0x4a, 0x84, 0x34, 0xee, 0x80, 0x2e, 0xe8, 0xdd, 0xf1, 0xac, 0x79, 0xe9,
+	0xb4, 0x27, 0x24, 0x4b, 0x5f, 0xb9, 0xfa, 0xc2, 0x0d, 0xd6, 0xaa, 0x95, 0x1f, 0x5b, 0x1e, 0x7c,
+	0x28, 0xf9, 0x49, 0xc2, 0x42, 0x5e, 0xc8, 0x30, 0x4e, 0x18, 0xcf, 0x3a, 0xb7, 0xba, 0xa0, 0xb7,
+	0xee, 0xed, 0xcd, 0x4b, 0x67, 0xa7, 0x4e, 0x5a, 0x09, 0x70, 0x83, 0x07, 0xea, 0x64, 0x50, 0xc8,
+	0xb7, 0x6a, 0xff, 0x05, 0x40, 0x6b, 0x21, 0x63, 0x50, 0xc8, 0x7f, 0xd0, 0xf1, 0x1a, 0xb6, 0xeb,
+	0x36, 0x94, 0x35, 0x64, 0x3c, 0x9e, 0x97, 0xce, 0xf6, 0xb2, 0x0c, 0x73, 0xef, 0x06, 0x1b, 0xea,
+	0xc0, 0x67, 0xb5, 0x88, 0x1f, 0x00, 0xee, 0x2c, 0x7b, 0x71, 0x98, 0xa7, 0x54, 0x0b, 0x39, 0x82,
+	0x77, 0xab, 0x2e, 0xa2, 0x03, 0xba, 0xb7, 0x7b, 0xf7, 0xf7, 0x11, 0xba, 0xc6, 0x69, 0xf4, 0x87,
+	0x9f, 0xde, 0xd6, 0x79, 0xe9, 0xb4, 0xe6, 0xa5, 0xb3, 0xb1, 0x90, 0x2e, 0xdc, 0xa0, 0x2e, 0x69,
+	0x85, 0xc6, 0x3f, 0xca, 0x42, 0xa2, 0xd2, 0xb4, 0xf0, 0x83, 0x2a, 0xeb, 0xb2, 0x74, 0xb6, 0x8f,
+	0x55, 0x37, 0x11, 0x9f, 0x20, 0xca, 0x71, 0x46, 0xe4, 0x08, 0xf9, 0x4c, 0xae, 0x9a, 0x7b, 0x95,
+	0x6d, 0xcc, 0xf5, 0x59, 0x2d, 0xc2, 0xbd, 0x04, 0x70, 0xb7, 0x61, 0xee, 0x12, 0xd8, 0xc7, 0x26,
+	0x18, 0xfe, 0x4b, 0x30, 0x33, 0xa1, 0xeb, 0xc9, 0x22, 0xb8, 0xb9, 0x18, 0x7c, 0x03, 0xed, 0xe5,
+	0x4d, 0x68, 0xbb, 0xab, 0xef, 0xc6, 0xb0, 0xb5, 0xcd, 0xc3, 0xd1, 0x70, 0xdf, 0x01, 0xb4, 0x94,
+	0x94, 0x0f, 0x54, 0x8e, 0xde, 0xd1, 0x4f, 0x05, 0x8d, 0xa9, 0x9c, 0xfc, 0xd7, 0x81, 0x0d, 0xe0,
+	0x7a, 0x6a, 0x1a, 0x69, 0x9e, 0xfe, 0x4d, 0x3c, 0x9b, 0x75, 0xa1, 0xab, 0x3c, 0x37, 0x58, 0xd4,
+	0xf0, 0xde, 0x9f, 0x4f, 0x6d, 0x70, 0x31, 0xb5, 0xc1, 0xaf, 0xa9, 0x0d, 0xbe, 0xcd, 0xec, 0xd6,
+	0xc5, 0xcc, 0x6e, 0xfd, 0x9c, 0xd9, 0xad, 0xa3, 0x83, 0x21, 0x95, 0xa3, 0x22, 0x42, 0xc7, 0x3c,
+	0xc3, 0x9a, 0xe0, 0x79, 0x4a, 0x22, 0x61, 0x36, 0xf8, 0x74, 0xbf, 0x8f, 0x3f, 0x37, 0xfe, 0x0e,
+	0x72, 0x92, 0x27, 0x22, 0x5a, 0x53, 0x9f, 0xf7, 0x8b, 0xdf, 0x03, 0x00, 0x38, 0x78, 0xa6, 0xbf,
+	0x41, 0x04, 0x00, 0x00,
 }
 
 func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
@@ -425,6 +480,53 @@ func (m *SwapAmountOutSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RouteWithLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteWithLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteWithLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwapRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwapRoute(v)
 	base := offset
@@ -502,6 +604,23 @@ func (m *SwapAmountOutSplitRoute) Size() (n int) {
 	return n
 }
 
+func (m *RouteWithLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSwapRoute(uint64(l))
+		}
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	return n
+}
+
 func sovSwapRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -946,6 +1065,124 @@ func (m *SwapAmountOutSplitRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RouteWithLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteWithLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteWithLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwapRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -431,6 +432,155 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgBatchSwapExactAmountIn
+// MsgBatchSwapExactAmountIn executes multiple independent exact amount in
+// swaps atomically. If any of the swaps fails, including by not meeting its
+// token_out_min_amount, none of them are executed.
+type MsgBatchSwapExactAmountIn struct {
+	Sender string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Swaps  []BatchSwapExactAmountIn `protobuf:"bytes,2,rep,name=swaps,proto3" json:"swaps" yaml:"swaps"`
+}
+
+func (m *MsgBatchSwapExactAmountIn) Reset()         { *m = MsgBatchSwapExactAmountIn{} }
+func (m *MsgBatchSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSwapExactAmountIn) ProtoMessage()    {}
+func (*MsgBatchSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{8}
+}
+func (m *MsgBatchSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSwapExactAmountIn.Merge(m, src)
+}
+func (m *MsgBatchSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSwapExactAmountIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *MsgBatchSwapExactAmountIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBatchSwapExactAmountIn) GetSwaps() []BatchSwapExactAmountIn {
+	if m != nil {
+		return m.Swaps
+	}
+	return nil
+}
+
+// BatchSwapExactAmountIn is a single swap within a MsgBatchSwapExactAmountIn.
+type BatchSwapExactAmountIn struct {
+	Routes            []SwapAmountInRoute   `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	TokenIn           types.Coin            `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *BatchSwapExactAmountIn) Reset()         { *m = BatchSwapExactAmountIn{} }
+func (m *BatchSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*BatchSwapExactAmountIn) ProtoMessage()    {}
+func (*BatchSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{9}
+}
+func (m *BatchSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwapExactAmountIn.Merge(m, src)
+}
+func (m *BatchSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwapExactAmountIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *BatchSwapExactAmountIn) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *BatchSwapExactAmountIn) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+type MsgBatchSwapExactAmountInResponse struct {
+	// token_out_amounts are the amounts received from each swap, in the order
+	// of the swaps in the request.
+	TokenOutAmounts []cosmossdk_io_math.Int `protobuf:"bytes,1,rep,name=token_out_amounts,json=tokenOutAmounts,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amounts" yaml:"token_out_amounts"`
+}
+
+func (m *MsgBatchSwapExactAmountInResponse) Reset()         { *m = MsgBatchSwapExactAmountInResponse{} }
+func (m *MsgBatchSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSwapExactAmountInResponse) ProtoMessage()    {}
+func (*MsgBatchSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{10}
+}
+func (m *MsgBatchSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *MsgBatchSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchSwapExactAmountInResponse proto.InternalMessageInfo
+
 // ===================== MsgSetDenomPairTakerFee
 type MsgSetDenomPairTakerFee struct {
 	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -441,7 +591,7 @@ func (m *MsgSetDenomPairTakerFee) Reset()         { *m = MsgSetDenomPairTakerFee
 func (m *MsgSetDenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFee) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{8}
+	return fileDescriptor_acd130b4825d67dc, []int{11}
 }
 func (m *MsgSetDenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +642,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Reset()         { *m = MsgSetDenomPair
 func (m *MsgSetDenomPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFeeResponse) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{9}
+	return fileDescriptor_acd130b4825d67dc, []int{12}
 }
 func (m *MsgSetDenomPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,26 +678,26 @@ func (m *MsgSetDenomPairTakerFeeResponse) GetSuccess() bool {
 	return false
 }
 
-type DenomPairTakerFee struct {
-	// denom0 and denom1 get automatically lexigographically sorted
-	// when being stored, so the order of input here does not matter.
-	Denom0   string                      `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
-	Denom1   string                      `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
-	TakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+// ===================== MsgUpdateParams
+type MsgUpdateParams struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// params are the new poolmanager module parameters, all of them must be set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
 }
 
-func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
-func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
-func (*DenomPairTakerFee) ProtoMessage()    {}
-func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{10}
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{13}
 }
-func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DenomPairTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DenomPairTakerFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -557,31 +707,67 @@ func (m *DenomPairTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DenomPairTakerFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DenomPairTakerFee.Merge(m, src)
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-func (m *DenomPairTakerFee) XXX_Size() int {
+func (m *MsgUpdateParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *DenomPairTakerFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_DenomPairTakerFee.DiscardUnknown(m)
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DenomPairTakerFee proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
 
-func (m *DenomPairTakerFee) GetDenom0() string {
+func (m *MsgUpdateParams) GetAuthority() string {
 	if m != nil {
-		return m.Denom0
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *DenomPairTakerFee) GetDenom1() string {
+func (m *MsgUpdateParams) GetParams() Params {
 	if m != nil {
-		return m.Denom1
+		return m.Params
 	}
-	return ""
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{14}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
 }
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
@@ -592,9 +778,13 @@ func init() {
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgBatchSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgBatchSwapExactAmountIn")
+	proto.RegisterType((*BatchSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.BatchSwapExactAmountIn")
+	proto.RegisterType((*MsgBatchSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgBatchSwapExactAmountInResponse")
 	proto.RegisterType((*MsgSetDenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFee")
 	proto.RegisterType((*MsgSetDenomPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFeeResponse")
-	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
+	proto.RegisterType((*MsgUpdateParams)(nil), "osmosis.poolmanager.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "osmosis.poolmanager.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() {
@@ -602,69 +792,82 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xc7, 0x33, 0x4e, 0x48, 0x93, 0x29, 0x6d, 0xe3, 0xc5, 0x21, 0xae, 0x53, 0xec, 0x68, 0x5b,
-	0x81, 0x83, 0xd8, 0x5d, 0xec, 0x56, 0x2a, 0x38, 0x91, 0x10, 0x6e, 0x40, 0x8a, 0x54, 0xcb, 0xed,
-	0xd2, 0x13, 0x97, 0xd5, 0xd8, 0x19, 0xdc, 0x25, 0xd9, 0x1d, 0xcb, 0x33, 0xdb, 0x3a, 0x37, 0x40,
-	0x3d, 0x45, 0x1c, 0xf8, 0x06, 0x48, 0x7c, 0x02, 0xbe, 0x01, 0xd7, 0x1e, 0x7b, 0x44, 0x1c, 0x2c,
-	0x94, 0x20, 0x71, 0xcf, 0x09, 0x09, 0x04, 0x68, 0x5e, 0x76, 0x6d, 0xaf, 0x37, 0x7e, 0x49, 0xd4,
-	0x5c, 0x22, 0xef, 0xec, 0xf3, 0xfa, 0x7f, 0x7e, 0xfb, 0x68, 0x02, 0xef, 0x10, 0xea, 0x11, 0xea,
-	0x52, 0xab, 0x4d, 0xc8, 0x81, 0x87, 0x7c, 0xd4, 0xc2, 0x1d, 0xeb, 0x59, 0xa9, 0x81, 0x19, 0x2a,
-	0x59, 0xac, 0x6b, 0xb6, 0x3b, 0x84, 0x11, 0x6d, 0x5d, 0x59, 0x99, 0x03, 0x56, 0xa6, 0xb2, 0xca,
-	0x65, 0x5a, 0xa4, 0x45, 0x84, 0x9d, 0xc5, 0x7f, 0x49, 0x97, 0x5c, 0x1a, 0x79, 0xae, 0x4f, 0x2c,
-	0xf1, 0x57, 0x1d, 0xe5, 0x9b, 0x22, 0x8c, 0xd5, 0x40, 0x14, 0x47, 0x39, 0x9a, 0xc4, 0xf5, 0xd5,
-	0xfb, 0x0f, 0xc6, 0xd5, 0x42, 0x9f, 0xa3, 0xb6, 0xd3, 0x21, 0x01, 0xc3, 0xd2, 0x5a, 0xff, 0x27,
-	0x05, 0x33, 0x35, 0xda, 0xfa, 0xe2, 0x39, 0x6a, 0x7f, 0xd6, 0x45, 0x4d, 0xf6, 0xa9, 0x47, 0x02,
-	0x9f, 0xed, 0xfa, 0xda, 0x26, 0x5c, 0xa4, 0xd8, 0xdf, 0xc3, 0x9d, 0x2c, 0xd8, 0x00, 0xc5, 0xe5,
-	0x6a, 0xfa, 0xb4, 0x57, 0xb8, 0x76, 0x88, 0xbc, 0x83, 0x8a, 0x2e, 0xcf, 0x75, 0x5b, 0x19, 0x68,
-	0x0f, 0xe1, 0xa2, 0x08, 0x49, 0xb3, 0xa9, 0x8d, 0xf9, 0xe2, 0xd5, 0xb2, 0x69, 0x8e, 0x69, 0xd4,
-	0xe4, 0xa9, 0xc2, 0x2c, 0x36, 0x77, 0xab, 0x2e, 0xbc, 0xec, 0x15, 0xe6, 0x6c, 0x15, 0x43, 0xab,
-	0xc1, 0x25, 0x46, 0xf6, 0xb1, 0xef, 0xb8, 0x7e, 0x76, 0x7e, 0x03, 0x14, 0xaf, 0x96, 0x6f, 0x9a,
-	0xb2, 0x65, 0x93, 0xb7, 0x1c, 0xc5, 0x79, 0x40, 0x5c, 0xbf, 0xba, 0xc6, 0x5d, 0x4f, 0x7b, 0x85,
-	0x1b, 0xb2, 0xb2, 0xd0, 0x51, 0xb7, 0xaf, 0x88, 0x9f, 0xbb, 0xbe, 0xe6, 0xc1, 0x8c, 0x3c, 0x25,
-	0x01, 0x73, 0x3c, 0xd7, 0x77, 0x90, 0xc8, 0x9d, 0x5d, 0x10, 0x5d, 0x6d, 0x73, 0xff, 0xdf, 0x7a,
-	0x85, 0x55, 0x99, 0x81, 0xee, 0xed, 0x9b, 0x2e, 0xb1, 0x3c, 0xc4, 0x9e, 0x9a, 0xbb, 0x3e, 0x3b,
-	0xed, 0x15, 0xd6, 0x07, 0x03, 0x0f, 0x87, 0xd0, 0xed, 0xb4, 0x38, 0xae, 0x07, 0xac, 0xe6, 0xfa,
-	0xb2, 0xa5, 0x8a, 0x71, 0xf4, 0xe7, 0xcf, 0xef, 0x17, 0x93, 0x46, 0xc0, 0xa5, 0x37, 0x30, 0xd7,
-	0xd8, 0x90, 0xfe, 0x86, 0xeb, 0xeb, 0xdf, 0x01, 0x78, 0x2b, 0x49, 0x7e, 0x1b, 0xd3, 0x36, 0xf1,
-	0x29, 0xd6, 0x1a, 0x70, 0xa5, 0x9f, 0x5b, 0x95, 0x2e, 0x07, 0xf2, 0xd1, 0xa4, 0xd2, 0xd7, 0xe2,
-	0xa5, 0x87, 0x65, 0x5f, 0x0f, 0xcb, 0x96, 0xd9, 0xf4, 0xbf, 0x52, 0x30, 0xcf, 0x8b, 0x68, 0x1f,
-	0xb8, 0x4c, 0x4c, 0xe4, 0x42, 0x34, 0x3c, 0x8e, 0xd1, 0x70, 0x77, 0x6a, 0x1a, 0xfa, 0x05, 0xc4,
-	0x90, 0xf8, 0x04, 0x5e, 0x0f, 0x27, 0xeb, 0xec, 0x61, 0x9f, 0x78, 0x02, 0x8c, 0xe5, 0xea, 0xcd,
-	0xd3, 0x5e, 0x61, 0x75, 0x78, 0xf2, 0xf2, 0xbd, 0x6e, 0xbf, 0xa9, 0xe6, 0xbf, 0xc3, 0x1f, 0x2f,
-	0x1b, 0x82, 0x22, 0x87, 0xe0, 0x76, 0x22, 0x04, 0xbc, 0xc5, 0x81, 0xf9, 0x7f, 0x0f, 0xe0, 0xbb,
-	0xe3, 0xa5, 0xbf, 0x54, 0x12, 0xfe, 0x4b, 0xc1, 0xd5, 0x51, 0x1c, 0xeb, 0x01, 0x9b, 0x05, 0x80,
-	0x5a, 0x0c, 0x00, 0x6b, 0x4a, 0x00, 0xea, 0x41, 0xe2, 0xf0, 0xbf, 0x86, 0x6f, 0x45, 0xc3, 0xf5,
-	0x50, 0x37, 0x6c, 0x5d, 0x12, 0xb0, 0x35, 0xa9, 0xf5, 0x5c, 0x0c, 0x8f, 0x7e, 0x04, 0xdd, 0x5e,
-	0x51, 0x8c, 0xd4, 0x50, 0x57, 0x56, 0xa0, 0x3d, 0x82, 0xcb, 0x91, 0x48, 0x02, 0x8e, 0xb1, 0xcb,
-	0x27, 0xab, 0x96, 0xcf, 0x4a, 0x4c, 0x5e, 0xdd, 0x5e, 0x0a, 0x75, 0xad, 0x98, 0x1c, 0x85, 0xcd,
-	0xe9, 0xf6, 0x01, 0x77, 0xfd, 0x06, 0xc0, 0x77, 0x12, 0x27, 0x10, 0x71, 0xe0, 0xc0, 0x1b, 0x51,
-	0x37, 0x43, 0x18, 0xdc, 0x9f, 0xa4, 0xc5, 0xdb, 0x31, 0x2d, 0x42, 0x1d, 0xae, 0x29, 0x1d, 0x14,
-	0x04, 0x7f, 0xa7, 0x60, 0x61, 0x1c, 0x93, 0x33, 0xe2, 0x60, 0xc7, 0x70, 0xb8, 0x37, 0x3d, 0x0e,
-	0x67, 0x2e, 0x84, 0x6a, 0xa8, 0x01, 0x87, 0x79, 0x70, 0x23, 0xe4, 0xe2, 0x6d, 0x46, 0x06, 0x61,
-	0x9b, 0xf5, 0x80, 0xc9, 0x9d, 0x70, 0x06, 0x57, 0x0b, 0xaf, 0x81, 0xab, 0xca, 0x26, 0xa7, 0xe0,
-	0xce, 0xc4, 0x85, 0xc0, 0x01, 0x38, 0x02, 0xf0, 0xbd, 0x09, 0xea, 0x5f, 0x1e, 0x0a, 0xff, 0x02,
-	0xb8, 0xc6, 0x8b, 0xc1, 0x52, 0xb3, 0x47, 0xc8, 0xed, 0x3c, 0x41, 0xfb, 0xb8, 0xf3, 0x39, 0xc6,
-	0xb3, 0x20, 0xf0, 0x02, 0xc0, 0x8c, 0x18, 0x82, 0xd3, 0x46, 0x6e, 0xc7, 0x61, 0x3c, 0x84, 0xf3,
-	0x15, 0xc6, 0x53, 0xdd, 0x17, 0x46, 0x32, 0x57, 0x6f, 0xab, 0xef, 0x4e, 0xad, 0xe5, 0xa4, 0xc8,
-	0xba, 0x9d, 0xde, 0x8b, 0xfb, 0x55, 0x4a, 0x7c, 0x0a, 0x89, 0xd7, 0x23, 0x8a, 0x99, 0x21, 0xec,
-	0x0d, 0x1e, 0xc6, 0x10, 0x61, 0x0c, 0x1e, 0x66, 0x4b, 0x7e, 0x0a, 0x09, 0xfd, 0x47, 0x43, 0xc8,
-	0xc2, 0x2b, 0x34, 0x68, 0x36, 0x31, 0xa5, 0x42, 0x88, 0x25, 0x3b, 0x7c, 0xd4, 0x7f, 0x01, 0x30,
-	0x9d, 0xa8, 0x9b, 0x48, 0xf5, 0xe1, 0xa8, 0x6e, 0xf2, 0x5c, 0xb7, 0x95, 0x41, 0x64, 0x5a, 0xca,
-	0xa6, 0x12, 0x4d, 0x4b, 0xa1, 0x69, 0x49, 0x7b, 0x02, 0x97, 0xfb, 0xb2, 0xce, 0x0f, 0x41, 0xb0,
-	0x3e, 0x0a, 0xc1, 0x43, 0xdc, 0x42, 0xcd, 0xc3, 0x1d, 0xdc, 0x1c, 0xd8, 0x5e, 0x7d, 0xe9, 0x96,
-	0x98, 0xaa, 0xb5, 0xfc, 0xc7, 0x1b, 0x70, 0xbe, 0x46, 0x5b, 0xda, 0xb7, 0x00, 0xa6, 0x47, 0x2f,
-	0x05, 0xa5, 0xb1, 0x73, 0x4b, 0xba, 0xd6, 0xe4, 0x3e, 0x9e, 0xd9, 0x25, 0xd2, 0xf9, 0x05, 0x80,
-	0x5a, 0xc2, 0x26, 0x2a, 0xcf, 0x18, 0xb1, 0x1e, 0xb0, 0x5c, 0x65, 0x76, 0x9f, 0xa8, 0x8c, 0x1f,
-	0x01, 0x5c, 0x1f, 0x77, 0x53, 0xda, 0x9a, 0x18, 0xfb, 0x6c, 0xe7, 0xdc, 0x83, 0x0b, 0x38, 0x47,
-	0x15, 0xfe, 0x04, 0xe0, 0xad, 0xb1, 0xcb, 0x7b, 0xfb, 0xdc, 0x59, 0xb8, 0x78, 0x3b, 0x17, 0xf1,
-	0x8e, 0x8a, 0x3c, 0x02, 0x30, 0x93, 0xb8, 0x56, 0xee, 0x4d, 0x0c, 0x9f, 0xe0, 0x95, 0xdb, 0x3e,
-	0x8f, 0x57, 0x58, 0x4c, 0xf5, 0xf1, 0xcb, 0xe3, 0x3c, 0x78, 0x75, 0x9c, 0x07, 0xbf, 0x1f, 0xe7,
-	0xc1, 0x0f, 0x27, 0xf9, 0xb9, 0x57, 0x27, 0xf9, 0xb9, 0x5f, 0x4f, 0xf2, 0x73, 0x5f, 0xde, 0x6f,
-	0xb9, 0xec, 0x69, 0xd0, 0x30, 0x9b, 0xc4, 0xb3, 0x54, 0x06, 0xe3, 0x00, 0x35, 0x68, 0xf8, 0x60,
-	0x3d, 0x2b, 0x97, 0xac, 0xee, 0xd0, 0x2e, 0x61, 0x87, 0x6d, 0x4c, 0x1b, 0x8b, 0xe2, 0xdf, 0xab,
-	0xbb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x7e, 0x3e, 0x7d, 0x1a, 0x0e, 0x00, 0x00,
+	// 1186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xef, 0xa4, 0xa5, 0x7f, 0x66, 0xbb, 0xdb, 0x26, 0xa4, 0xdb, 0x34, 0x5d, 0x92, 0xae, 0xbb,
+	0x5a, 0x5a, 0x54, 0xdb, 0xea, 0x1f, 0xb1, 0x6c, 0x5a, 0x81, 0xd6, 0xbb, 0x20, 0x15, 0x11, 0xb5,
+	0xeb, 0x85, 0x0b, 0x17, 0x6b, 0x92, 0x0c, 0xa9, 0x69, 0x6d, 0x47, 0x9e, 0xf1, 0x6e, 0x7b, 0x03,
+	0xb4, 0xa7, 0x8a, 0xc3, 0x7e, 0x03, 0x24, 0xc4, 0x07, 0xe0, 0xc0, 0x87, 0xd8, 0xe3, 0x8a, 0x13,
+	0x20, 0x14, 0xa1, 0xf6, 0xc0, 0x81, 0x5b, 0x4f, 0x48, 0x20, 0x40, 0xe3, 0x19, 0x3b, 0x8d, 0xeb,
+	0xc4, 0x49, 0x4b, 0x7b, 0xa9, 0x62, 0xfb, 0xbd, 0xdf, 0x7b, 0xef, 0xf7, 0x7e, 0xf3, 0xde, 0xa8,
+	0xf0, 0x8e, 0x43, 0x2c, 0x87, 0x98, 0x44, 0x6d, 0x38, 0xce, 0x9e, 0x85, 0x6c, 0x54, 0xc7, 0xae,
+	0xfa, 0x74, 0xb9, 0x82, 0x29, 0x5a, 0x56, 0xe9, 0xbe, 0xd2, 0x70, 0x1d, 0xea, 0x64, 0x66, 0x85,
+	0x95, 0x72, 0xca, 0x4a, 0x11, 0x56, 0xf9, 0x6c, 0xdd, 0xa9, 0x3b, 0xbe, 0x9d, 0xca, 0x7e, 0x71,
+	0x97, 0x7c, 0x1a, 0x59, 0xa6, 0xed, 0xa8, 0xfe, 0x5f, 0xf1, 0xaa, 0x50, 0xf5, 0x61, 0xd4, 0x0a,
+	0x22, 0x38, 0x8c, 0x51, 0x75, 0x4c, 0x5b, 0x7c, 0x5f, 0xea, 0x96, 0x0b, 0x79, 0x86, 0x1a, 0x86,
+	0xeb, 0x78, 0x14, 0x0b, 0xeb, 0x19, 0x8e, 0x66, 0xf0, 0xc8, 0xfc, 0x41, 0x7c, 0x5a, 0xec, 0x06,
+	0x54, 0xc7, 0x36, 0x26, 0xa6, 0x30, 0x95, 0xfe, 0x4e, 0xc1, 0x6c, 0x99, 0xd4, 0x9f, 0x3c, 0x43,
+	0x8d, 0xf7, 0xf7, 0x51, 0x95, 0x3e, 0xb0, 0x1c, 0xcf, 0xa6, 0x9b, 0x76, 0x66, 0x11, 0x0e, 0x13,
+	0x6c, 0xd7, 0xb0, 0x9b, 0x03, 0x73, 0x60, 0x61, 0x4c, 0x4b, 0x9f, 0x34, 0x8b, 0xd7, 0x0f, 0x90,
+	0xb5, 0x57, 0x92, 0xf8, 0x7b, 0x49, 0x17, 0x06, 0x99, 0x8f, 0xe0, 0xb0, 0x9f, 0x18, 0xc9, 0xa5,
+	0xe6, 0x06, 0x17, 0xae, 0xad, 0x28, 0x4a, 0x17, 0xba, 0x14, 0x16, 0x2a, 0x88, 0xa2, 0x33, 0x37,
+	0x6d, 0xe8, 0x65, 0xb3, 0x38, 0xa0, 0x0b, 0x8c, 0x4c, 0x19, 0x8e, 0x52, 0x67, 0x17, 0xdb, 0x86,
+	0x69, 0xe7, 0x06, 0xe7, 0xc0, 0xc2, 0xb5, 0x95, 0x19, 0x45, 0x54, 0xc7, 0x88, 0x0b, 0x71, 0x1e,
+	0x3a, 0xa6, 0xad, 0x4d, 0x33, 0xd7, 0x93, 0x66, 0x71, 0x82, 0x67, 0x16, 0x38, 0x4a, 0xfa, 0x88,
+	0xff, 0x73, 0xd3, 0xce, 0x58, 0x30, 0xcb, 0xdf, 0x3a, 0x1e, 0x35, 0x2c, 0xd3, 0x36, 0x90, 0x1f,
+	0x3b, 0x37, 0xe4, 0x57, 0xb5, 0xc1, 0xfc, 0x7f, 0x69, 0x16, 0xa7, 0x78, 0x04, 0x52, 0xdb, 0x55,
+	0x4c, 0x47, 0xb5, 0x10, 0xdd, 0x51, 0x36, 0x6d, 0x7a, 0xd2, 0x2c, 0xce, 0x9e, 0x06, 0x6e, 0x87,
+	0x90, 0xf4, 0xb4, 0xff, 0x7a, 0xcb, 0xa3, 0x65, 0xd3, 0xe6, 0x25, 0x95, 0xe4, 0xc3, 0xdf, 0xbf,
+	0x7f, 0x6b, 0x21, 0x8e, 0x7f, 0xd6, 0x40, 0x19, 0x33, 0x8e, 0x65, 0xee, 0x2f, 0x9b, 0xb6, 0xf4,
+	0x15, 0x80, 0xb7, 0xe2, 0xe8, 0xd7, 0x31, 0x69, 0x38, 0x36, 0xc1, 0x99, 0x0a, 0x9c, 0x6c, 0xc5,
+	0x16, 0xa9, 0xf3, 0x86, 0xbc, 0x93, 0x94, 0xfa, 0x74, 0x34, 0xf5, 0x20, 0xed, 0x1b, 0x41, 0xda,
+	0x3c, 0x9a, 0xf4, 0x67, 0x0a, 0x16, 0x58, 0x12, 0x8d, 0x3d, 0x93, 0xfa, 0x1d, 0xb9, 0x90, 0x1a,
+	0x1e, 0x47, 0xd4, 0xb0, 0xda, 0xb3, 0x1a, 0x5a, 0x09, 0x44, 0x24, 0xf1, 0x1e, 0xbc, 0x11, 0x74,
+	0xd6, 0xa8, 0x61, 0xdb, 0xb1, 0x7c, 0x61, 0x8c, 0x69, 0x33, 0x27, 0xcd, 0xe2, 0x54, 0x7b, 0xe7,
+	0xf9, 0x77, 0x49, 0x1f, 0x17, 0xfd, 0x7f, 0xc4, 0x1e, 0xaf, 0x5a, 0x04, 0x0b, 0x4c, 0x04, 0xf3,
+	0xb1, 0x22, 0x60, 0x25, 0x9e, 0xea, 0xff, 0xd7, 0x00, 0xde, 0xed, 0x4e, 0xfd, 0x95, 0x2a, 0xe1,
+	0xdf, 0x14, 0x9c, 0x3a, 0x2b, 0xc7, 0x2d, 0x8f, 0xf6, 0x23, 0x80, 0x72, 0x44, 0x00, 0x6a, 0x8f,
+	0x02, 0xd8, 0xf2, 0x62, 0x9b, 0xff, 0x39, 0x7c, 0x3d, 0x6c, 0xae, 0x85, 0xf6, 0x83, 0xd2, 0xb9,
+	0x02, 0xd6, 0x93, 0x4a, 0xcf, 0x47, 0xe4, 0xd1, 0x42, 0x90, 0xf4, 0x49, 0xa1, 0x91, 0x32, 0xda,
+	0xe7, 0x19, 0x64, 0xb6, 0xe1, 0x58, 0x48, 0x52, 0x6e, 0x28, 0x69, 0xf8, 0xe4, 0xc4, 0xf0, 0x99,
+	0x8c, 0xd0, 0x2b, 0xe9, 0xa3, 0x01, 0xaf, 0x25, 0x85, 0x49, 0x61, 0xb1, 0xb7, 0x79, 0xc0, 0x5c,
+	0xbf, 0x00, 0xf0, 0x8d, 0xd8, 0x0e, 0x84, 0x3a, 0x30, 0xe0, 0x44, 0x58, 0x4d, 0x9b, 0x0c, 0xee,
+	0x25, 0x71, 0x71, 0x33, 0xc2, 0x45, 0xc0, 0xc3, 0x75, 0xc1, 0x83, 0x10, 0xc1, 0x5f, 0x29, 0x58,
+	0xec, 0xa6, 0xc9, 0x3e, 0xe5, 0xa0, 0x47, 0xe4, 0xb0, 0xd6, 0xbb, 0x1c, 0x3a, 0x0e, 0x04, 0x0d,
+	0x4e, 0xb4, 0xc4, 0x7c, 0x7a, 0x22, 0xe4, 0xa3, 0x65, 0x86, 0x06, 0x41, 0x99, 0x5b, 0x1e, 0xe5,
+	0x33, 0xa1, 0x83, 0xae, 0x86, 0x2e, 0x41, 0x57, 0xa5, 0x45, 0xa6, 0x82, 0x3b, 0x89, 0x03, 0x81,
+	0x09, 0xe0, 0x10, 0xc0, 0x37, 0x13, 0xd8, 0xbf, 0x3a, 0x29, 0xfc, 0x0a, 0xe0, 0x4c, 0x99, 0xd4,
+	0x35, 0x44, 0xab, 0x3b, 0x17, 0x5a, 0x0a, 0x06, 0x7c, 0x8d, 0xe9, 0xbd, 0xb7, 0x9d, 0x10, 0x1f,
+	0x4e, 0xcb, 0x8a, 0xe3, 0x36, 0x2e, 0x42, 0x30, 0x3c, 0x49, 0xe7, 0xb8, 0xa5, 0x55, 0xc6, 0xb0,
+	0x12, 0xc7, 0x70, 0x85, 0xa1, 0xc9, 0xb1, 0xdb, 0xf7, 0xbb, 0x14, 0xbc, 0xd9, 0xa1, 0xb6, 0xd6,
+	0x9d, 0x06, 0xfc, 0xcf, 0x77, 0x9a, 0xd4, 0xe5, 0xdd, 0x69, 0x06, 0x2f, 0x65, 0x9d, 0x31, 0x49,
+	0xde, 0xee, 0xa8, 0x82, 0x50, 0x8c, 0x18, 0xa6, 0xa3, 0x0b, 0x86, 0x93, 0x37, 0xa6, 0xdd, 0x4f,
+	0xca, 0x28, 0x17, 0xbf, 0xa0, 0x88, 0xa4, 0x4f, 0xb4, 0x6f, 0x28, 0x22, 0xfd, 0x03, 0xe0, 0x34,
+	0x3b, 0x1f, 0x98, 0x1f, 0xe3, 0x6d, 0x64, 0xba, 0x1f, 0xa3, 0x5d, 0xec, 0x7e, 0x80, 0x71, 0x3f,
+	0x82, 0x7c, 0x0e, 0x60, 0xd6, 0x9f, 0x0b, 0x46, 0x03, 0x99, 0xae, 0x41, 0x19, 0x84, 0xf1, 0x19,
+	0xc6, 0x3d, 0x5d, 0x61, 0xcf, 0x44, 0xd6, 0xe6, 0x45, 0xcf, 0x04, 0xb5, 0x71, 0xc8, 0x92, 0x9e,
+	0xae, 0x45, 0xfd, 0x4a, 0xcb, 0x4c, 0xb6, 0xb1, 0xf7, 0x7e, 0x82, 0xa9, 0xec, 0xdb, 0xcb, 0x0c,
+	0x46, 0xf6, 0x61, 0x64, 0x06, 0xb3, 0x0e, 0x8b, 0x1d, 0xea, 0x0f, 0x5b, 0x91, 0x83, 0x23, 0xc4,
+	0xab, 0x56, 0x31, 0x21, 0x3e, 0x11, 0xa3, 0x7a, 0xf0, 0x28, 0xfd, 0x0c, 0xe0, 0x44, 0x99, 0xd4,
+	0x3f, 0x69, 0xd4, 0x10, 0xc5, 0xdb, 0xc8, 0x45, 0x16, 0xc9, 0x7c, 0x08, 0xc7, 0x90, 0x47, 0x77,
+	0x1c, 0xd7, 0xa4, 0x07, 0x82, 0xb8, 0xa5, 0xd6, 0x56, 0x0b, 0x3f, 0x49, 0x3f, 0xfe, 0x20, 0x67,
+	0x85, 0x68, 0x1f, 0xd4, 0x6a, 0x2e, 0x26, 0xe4, 0x09, 0x75, 0x4d, 0xbb, 0xae, 0xb7, 0xdc, 0xd9,
+	0xb0, 0x6f, 0xf8, 0xa8, 0x42, 0xe6, 0xf3, 0x5d, 0x79, 0xe4, 0x09, 0x68, 0x53, 0x82, 0x3c, 0xd1,
+	0x2a, 0x0e, 0x20, 0xe9, 0x02, 0xa9, 0x74, 0x97, 0x71, 0x74, 0x3b, 0x8e, 0x23, 0xcf, 0x2f, 0x43,
+	0x16, 0x0e, 0x33, 0x70, 0x3a, 0x52, 0x5a, 0x40, 0xc8, 0xca, 0x1f, 0x23, 0x70, 0xb0, 0x4c, 0xea,
+	0x99, 0x2f, 0x01, 0x4c, 0x9f, 0x3d, 0xeb, 0xcb, 0x5d, 0x93, 0x8c, 0xbb, 0x9e, 0xe7, 0xef, 0xf7,
+	0xed, 0x12, 0x36, 0xe7, 0x39, 0x80, 0x99, 0x98, 0x8d, 0xba, 0xd2, 0x27, 0xe2, 0x96, 0x47, 0xf3,
+	0xa5, 0xfe, 0x7d, 0xc2, 0x34, 0xbe, 0x01, 0x70, 0xb6, 0xdb, 0x8d, 0x7f, 0x3d, 0x11, 0xbb, 0xb3,
+	0x73, 0xfe, 0xe1, 0x05, 0x9c, 0xc3, 0x0c, 0xbf, 0x05, 0xf0, 0x56, 0xd7, 0x4b, 0xc8, 0xc6, 0xb9,
+	0xa3, 0x30, 0xf2, 0x1e, 0x5d, 0xc4, 0x3b, 0x4c, 0xf2, 0x10, 0xc0, 0x6c, 0xec, 0x2c, 0x5a, 0x4b,
+	0x84, 0x8f, 0xf1, 0xca, 0x6f, 0x9c, 0xc7, 0x2b, 0x4c, 0xe6, 0x05, 0xe8, 0xb8, 0xcf, 0xde, 0x4e,
+	0x02, 0x8e, 0xf7, 0xcb, 0xbf, 0x7b, 0x3e, 0xbf, 0x30, 0x25, 0x17, 0x8e, 0xb7, 0x0d, 0x9b, 0xa5,
+	0x24, 0xbc, 0xd3, 0xd6, 0xf9, 0xb5, 0x7e, 0xac, 0x83, 0x98, 0xda, 0xe3, 0x97, 0x47, 0x05, 0xf0,
+	0xea, 0xa8, 0x00, 0x7e, 0x3b, 0x2a, 0x80, 0x17, 0xc7, 0x85, 0x81, 0x57, 0xc7, 0x85, 0x81, 0x9f,
+	0x8e, 0x0b, 0x03, 0x9f, 0xde, 0xab, 0x9b, 0x74, 0xc7, 0xab, 0x28, 0x55, 0xc7, 0x52, 0x05, 0xb2,
+	0xbc, 0x87, 0x2a, 0x24, 0x78, 0x50, 0x9f, 0xae, 0x2c, 0xab, 0xfb, 0x6d, 0x33, 0x86, 0x1e, 0x34,
+	0x30, 0xa9, 0x0c, 0xfb, 0xff, 0x2d, 0x59, 0xfd, 0x6f, 0x00, 0x1e, 0xca, 0xb7, 0xb0, 0x2f, 0x12,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -684,6 +887,10 @@ type MsgClient interface {
 	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
 	SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error)
+	BatchSwapExactAmountIn(ctx context.Context, in *MsgBatchSwapExactAmountIn, opts ...grpc.CallOption) (*MsgBatchSwapExactAmountInResponse, error)
+	// UpdateParams sets the poolmanager module parameters. It can only be executed by
+	// governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -739,6 +946,24 @@ func (c *msgClient) SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPai
 	return out, nil
 }
 
+func (c *msgClient) BatchSwapExactAmountIn(ctx context.Context, in *MsgBatchSwapExactAmountIn, opts ...grpc.CallOption) (*MsgBatchSwapExactAmountInResponse, error) {
+	out := new(MsgBatchSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/BatchSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
@@ -746,6 +971,10 @@ type MsgServer interface {
 	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
 	SetDenomPairTakerFee(context.Context, *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error)
+	BatchSwapExactAmountIn(context.Context, *MsgBatchSwapExactAmountIn) (*MsgBatchSwapExactAmountInResponse, error)
+	// UpdateParams sets the poolmanager module parameters. It can only be executed by
+	// governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -767,6 +996,12 @@ func (*UnimplementedMsgServer) SplitRouteSwapExactAmountOut(ctx context.Context,
 func (*UnimplementedMsgServer) SetDenomPairTakerFee(ctx context.Context, req *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPairTakerFee not implemented")
 }
+func (*UnimplementedMsgServer) BatchSwapExactAmountIn(ctx context.Context, req *MsgBatchSwapExactAmountIn) (*MsgBatchSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSwapExactAmountIn not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -862,6 +1097,42 @@ func _Msg_SetDenomPairTakerFee_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSwapExactAmountIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/BatchSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSwapExactAmountIn(ctx, req.(*MsgBatchSwapExactAmountIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -886,6 +1157,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetDenomPairTakerFee",
 			Handler:    _Msg_SetDenomPairTakerFee_Handler,
 		},
+		{
+			MethodName: "BatchSwapExactAmountIn",
+			Handler:    _Msg_BatchSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -1273,7 +1552,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1283,20 +1562,20 @@ func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DenomPairTakerFee) > 0 {
-		for iNdEx := len(m.DenomPairTakerFee) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DenomPairTakerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1317,7 +1596,7 @@ func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *BatchSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1327,30 +1606,54 @@ func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1360,64 +1663,194 @@ func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TakerFee.Size()
-		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TokenOutAmounts) > 0 {
+		for iNdEx := len(m.TokenOutAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TokenOutAmounts[iNdEx].Size()
+				i -= size
+				if _, err := m.TokenOutAmounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Denom1) > 0 {
-		i -= len(m.Denom1)
-		copy(dAtA[i:], m.Denom1)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom0) > 0 {
-		i -= len(m.Denom0)
-		copy(dAtA[i:], m.Denom0)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetDenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	if len(m.DenomPairTakerFee) > 0 {
+		for iNdEx := len(m.DenomPairTakerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairTakerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.Routes) > 0 {
 		for _, e := range m.Routes {
 			l = e.Size()
@@ -1548,6 +1981,59 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBatchSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBatchSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenOutAmounts) > 0 {
+		for _, e := range m.TokenOutAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgSetDenomPairTakerFee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1579,25 +2065,30 @@ func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
 	return n
 }
 
-func (m *DenomPairTakerFee) Size() (n int) {
+func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom0)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom1)
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.TakerFee.Size()
+	l = m.Params.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1894,10 +2385,276 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1961,14 +2718,14 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1996,13 +2753,15 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2012,23 +2771,22 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2053,7 +2811,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2076,15 +2834,15 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2112,7 +2870,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2137,7 +2895,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,10 +2918,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2227,14 +2985,14 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2262,15 +3020,13 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2280,22 +3036,23 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2320,7 +3077,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,10 +3100,10 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2404,7 +3161,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,10 +3184,10 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchSwapExactAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2466,6 +3223,90 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, BatchSwapExactAmountIn{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
@@ -2494,16 +3335,16 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2513,27 +3354,28 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2561,7 +3403,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2586,7 +3428,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2609,15 +3451,15 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2645,7 +3487,9 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.TokenOutAmounts = append(m.TokenOutAmounts, v)
+			if err := m.TokenOutAmounts[len(m.TokenOutAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2856,7 +3700,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2879,15 +3723,15 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomPairTakerFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomPairTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2915,13 +3759,13 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2931,58 +3775,75 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
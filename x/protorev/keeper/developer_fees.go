@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
 )
 
@@ -66,9 +68,42 @@ func (k Keeper) UpdateDeveloperFees(ctx sdk.Context, denom string, profit osmoma
 	return nil
 }
 
-// SendDeveloperFee sends the developer fee from the module account to the developer account
-func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) error {
-	// Developer account must be set in order to be able to withdraw developer fees
+// GetProfitsToDistribute returns the profits accumulated since the last distribution.
+func (k Keeper) GetProfitsToDistribute(ctx sdk.Context) sdk.Coins {
+	var profitsToDistribute poolmanagertypes.TrackedVolume
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyProfitsToDistribute, &profitsToDistribute)
+	if err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+	if !found {
+		return sdk.NewCoins()
+	}
+	return profitsToDistribute.Amount
+}
+
+// SetProfitsToDistribute sets the profits accumulated since the last distribution.
+func (k Keeper) SetProfitsToDistribute(ctx sdk.Context, profits sdk.Coins) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyProfitsToDistribute, &poolmanagertypes.TrackedVolume{Amount: profits})
+}
+
+// AddProfitToDistribute adds the profit of a trade to the profits distributed at the end of the day epoch.
+func (k Keeper) AddProfitToDistribute(ctx sdk.Context, profit sdk.Coin) {
+	k.SetProfitsToDistribute(ctx, k.GetProfitsToDistribute(ctx).Add(profit))
+}
+
+// DistributeProfit distributes the profits accumulated since the last distribution, as tracked by ExecuteTrade.
+// Other funds held by the module account, such as the profits retained before the profits were tracked, are left untouched.
+// The developer account receives its share of the profits, which decreases with the number of days since module
+// genesis. The remaining profits are burned if denominated in osmo and sent to the community pool otherwise.
+func (k Keeper) DistributeProfit(ctx sdk.Context) error {
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	profits := k.GetProfitsToDistribute(ctx)
+	if profits.IsZero() {
+		return nil
+	}
+
+	// Developer account must be set in order to distribute the profits, otherwise they are held in the module account
 	developerAccount, err := k.GetDeveloperAccount(ctx)
 	if err != nil {
 		return err
@@ -80,22 +115,38 @@ func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) error {
 		return err
 	}
 
-	// Initialize the developer profit to 0
-	devProfit := sdk.NewCoin(arbProfit.Denom, osmomath.ZeroInt())
-
-	// Calculate the developer fee
+	// Calculate the developer profit split
+	profitSplit := types.ProfitSplitPhase3
 	if daysSinceGenesis < types.Phase1Length {
-		devProfit.Amount = arbProfit.Amount.MulRaw(types.ProfitSplitPhase1).QuoRaw(100)
+		profitSplit = types.ProfitSplitPhase1
 	} else if daysSinceGenesis < types.Phase2Length {
-		devProfit.Amount = arbProfit.Amount.MulRaw(types.ProfitSplitPhase2).QuoRaw(100)
-	} else {
-		devProfit.Amount = arbProfit.Amount.MulRaw(types.ProfitSplitPhase3).QuoRaw(100)
+		profitSplit = types.ProfitSplitPhase2
+	}
+
+	devProfit := sdk.NewCoins()
+	for _, profit := range profits {
+		devProfit = devProfit.Add(sdk.NewCoin(profit.Denom, profit.Amount.MulRaw(profitSplit).QuoRaw(100)))
 	}
 
 	// Send the developer profit to the developer account
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, developerAccount, sdk.NewCoins(devProfit)); err != nil {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, developerAccount, devProfit); err != nil {
+		return err
+	}
+
+	// Burn the remaining osmo profit and send the rest to the community pool
+	remainingProfit := profits.Sub(devProfit...)
+	osmoProfit := sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, remainingProfit.AmountOf(types.OsmosisDenomination)))
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, osmoProfit); err != nil {
 		return err
 	}
 
+	communityPoolProfit := remainingProfit.Sub(osmoProfit...)
+	if !communityPoolProfit.IsZero() {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, communityPoolProfit, moduleAddress); err != nil {
+			return err
+		}
+	}
+
+	k.SetProfitsToDistribute(ctx, sdk.NewCoins())
 	return nil
 }
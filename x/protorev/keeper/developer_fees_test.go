@@ -8,22 +8,39 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
 )
 
-// TestSendDeveloperFee tests the SendDeveloperFee function
-func (suite *KeeperTestSuite) TestSendDeveloperFee() {
+// TestDistributeProfit tests the DistributeProfit function
+func (suite *KeeperTestSuite) TestDistributeProfit() {
 	cases := []struct {
-		description       string
-		alterState        func()
-		expectedErr       bool
-		expectedDevProfit sdk.Coin
+		description               string
+		alterState                func()
+		expectedErr               bool
+		expectedDevProfit         sdk.Coins
+		expectedBurnedProfit      sdk.Coins
+		expectedCommunityPoolGain sdk.Coins
+		// expectedRetainedFunds are the funds expected to be left in the module account
+		expectedRetainedFunds sdk.Coins
 	}{
 		{
-			description:       "Send with unset developer account",
-			alterState:        func() {},
-			expectedErr:       true,
-			expectedDevProfit: sdk.Coin{},
+			description: "Distribute with unset developer account",
+			alterState: func() {
+				err := suite.pseudoExecuteTrade(types.OsmosisDenomination, osmomath.NewInt(1000), 100)
+				suite.Require().NoError(err)
+			},
+			expectedErr: true,
+		},
+		{
+			description: "Distribute with no profits",
+			alterState: func() {
+				account := apptesting.CreateRandomAccounts(1)[0]
+				suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, account)
+			},
+			expectedErr:               false,
+			expectedDevProfit:         sdk.NewCoins(),
+			expectedBurnedProfit:      sdk.NewCoins(),
+			expectedCommunityPoolGain: sdk.NewCoins(),
 		},
 		{
-			description: "Send with set developer account in first phase",
+			description: "Distribute osmo profit in first phase",
 			alterState: func() {
 				account := apptesting.CreateRandomAccounts(1)[0]
 				suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, account)
@@ -31,32 +48,57 @@ func (suite *KeeperTestSuite) TestSendDeveloperFee() {
 				err := suite.pseudoExecuteTrade(types.OsmosisDenomination, osmomath.NewInt(1000), 100)
 				suite.Require().NoError(err)
 			},
-			expectedErr:       false,
-			expectedDevProfit: sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(20)),
+			expectedErr:               false,
+			expectedDevProfit:         sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(200))),
+			expectedBurnedProfit:      sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(800))),
+			expectedCommunityPoolGain: sdk.NewCoins(),
 		},
 		{
-			description: "Send with set developer account in second phase",
+			description: "Distribute osmo and atom profits in second phase",
 			alterState: func() {
 				account := apptesting.CreateRandomAccounts(1)[0]
 				suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, account)
 
 				err := suite.pseudoExecuteTrade(types.OsmosisDenomination, osmomath.NewInt(1000), 500)
 				suite.Require().NoError(err)
+				err = suite.pseudoExecuteTrade("Atom", osmomath.NewInt(2000), 500)
+				suite.Require().NoError(err)
 			},
-			expectedErr:       false,
-			expectedDevProfit: sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(10)),
+			expectedErr:               false,
+			expectedDevProfit:         sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)), sdk.NewCoin("Atom", osmomath.NewInt(200))),
+			expectedBurnedProfit:      sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(900))),
+			expectedCommunityPoolGain: sdk.NewCoins(sdk.NewCoin("Atom", osmomath.NewInt(1800))),
 		},
 		{
-			description: "Send with set developer account in third (final) phase",
+			description: "Distribute atom profit in third (final) phase",
 			alterState: func() {
 				account := apptesting.CreateRandomAccounts(1)[0]
 				suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, account)
 
-				err := suite.pseudoExecuteTrade(types.OsmosisDenomination, osmomath.NewInt(1000), 1000)
+				err := suite.pseudoExecuteTrade("Atom", osmomath.NewInt(1000), 1000)
 				suite.Require().NoError(err)
 			},
-			expectedErr:       false,
-			expectedDevProfit: sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(5)),
+			expectedErr:               false,
+			expectedDevProfit:         sdk.NewCoins(sdk.NewCoin("Atom", osmomath.NewInt(50))),
+			expectedBurnedProfit:      sdk.NewCoins(),
+			expectedCommunityPoolGain: sdk.NewCoins(sdk.NewCoin("Atom", osmomath.NewInt(950))),
+		},
+		{
+			description: "Funds held in the module account before the profits were tracked are not distributed",
+			alterState: func() {
+				account := apptesting.CreateRandomAccounts(1)[0]
+				suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, account)
+
+				err := suite.App.AppKeepers.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(5000))))
+				suite.Require().NoError(err)
+				err = suite.pseudoExecuteTrade(types.OsmosisDenomination, osmomath.NewInt(1000), 100)
+				suite.Require().NoError(err)
+			},
+			expectedErr:               false,
+			expectedDevProfit:         sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(200))),
+			expectedBurnedProfit:      sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(800))),
+			expectedCommunityPoolGain: sdk.NewCoins(),
+			expectedRetainedFunds:     sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(5000))),
 		},
 	}
 
@@ -65,20 +107,34 @@ func (suite *KeeperTestSuite) TestSendDeveloperFee() {
 			suite.SetupTest()
 			tc.alterState()
 
-			err := suite.App.ProtoRevKeeper.SendDeveloperFee(suite.Ctx, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
+			moduleAddress := suite.App.AppKeepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+			profits := suite.App.AppKeepers.BankKeeper.GetAllBalances(suite.Ctx, moduleAddress)
+			osmoSupplyBefore := suite.App.AppKeepers.BankKeeper.GetSupply(suite.Ctx, types.OsmosisDenomination)
+			communityPoolBefore := suite.App.AppKeepers.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+
+			err := suite.App.ProtoRevKeeper.DistributeProfit(suite.Ctx)
 			if tc.expectedErr {
 				suite.Require().Error(err)
-			} else {
-				suite.Require().NoError(err)
+				// The profits are held in the module account and distributed later
+				suite.Require().Equal(profits, suite.App.AppKeepers.BankKeeper.GetAllBalances(suite.Ctx, moduleAddress))
+				suite.Require().False(suite.App.ProtoRevKeeper.GetProfitsToDistribute(suite.Ctx).IsZero())
+				return
 			}
+			suite.Require().NoError(err)
 
 			developerAccount, err := suite.App.ProtoRevKeeper.GetDeveloperAccount(suite.Ctx)
-			if !tc.expectedErr {
-				developerFee := suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, developerAccount, types.OsmosisDenomination)
-				suite.Require().Equal(tc.expectedDevProfit, developerFee)
-			} else {
-				suite.Require().Error(err)
-			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedDevProfit.String(), suite.App.AppKeepers.BankKeeper.GetAllBalances(suite.Ctx, developerAccount).String())
+
+			osmoSupplyAfter := suite.App.AppKeepers.BankKeeper.GetSupply(suite.Ctx, types.OsmosisDenomination)
+			suite.Require().Equal(tc.expectedBurnedProfit.AmountOf(types.OsmosisDenomination).String(), osmoSupplyBefore.Amount.Sub(osmoSupplyAfter.Amount).String())
+
+			communityPoolAfter := suite.App.AppKeepers.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			suite.Require().Equal(sdk.NewDecCoinsFromCoins(tc.expectedCommunityPoolGain...).String(), communityPoolAfter.Sub(communityPoolBefore).String())
+
+			// All of the tracked profits are distributed
+			suite.Require().Equal(tc.expectedRetainedFunds.String(), suite.App.AppKeepers.BankKeeper.GetAllBalances(suite.Ctx, moduleAddress).String())
+			suite.Require().True(suite.App.ProtoRevKeeper.GetProfitsToDistribute(suite.Ctx).IsZero())
 		})
 	}
 }
@@ -87,11 +143,12 @@ func (suite *KeeperTestSuite) TestSendDeveloperFee() {
 func (suite *KeeperTestSuite) pseudoExecuteTrade(denom string, profit osmomath.Int, daysSinceGenesis uint64) error {
 	// Initialize the number of days since genesis
 	suite.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(suite.Ctx, daysSinceGenesis)
-	// Mint the profit to the module account (which will be sent to the developer account later)
+	// Mint the profit to the module account (which will be distributed later)
 	err := suite.App.AppKeepers.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, profit)))
 	if err != nil {
 		return err
 	}
+	suite.App.ProtoRevKeeper.AddProfitToDistribute(suite.Ctx, sdk.NewCoin(denom, profit))
 
 	return nil
 }
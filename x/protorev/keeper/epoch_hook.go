@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -40,6 +42,11 @@ func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 				h.k.SetDaysSinceModuleGenesis(ctx, daysSinceGenesis+1)
 			}
 
+			// Distribute the profits accumulated since the last epoch, they are held in the module account on failure
+			if err := osmoutils.ApplyFuncIfNoError(ctx, h.k.DistributeProfit); err != nil {
+				ctx.Logger().Error("failed to distribute protorev profits: " + err.Error())
+			}
+
 			// Update the pools in the store
 			return h.k.UpdatePools(ctx)
 		}
@@ -48,6 +55,11 @@ func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 	return nil
 }
 
+// GetModuleName implements EpochHooks.
+func (h EpochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
 // UpdatePools first deletes all of the pools paired with any base denom in the store and then adds the highest liquidity pools that match to the store
 func (k Keeper) UpdatePools(ctx sdk.Context) error {
 	// baseDenomPools maps each base denom to a map of the highest liquidity pools paired with that base denom
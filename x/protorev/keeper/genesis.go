@@ -100,6 +100,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	} else {
 		k.SetCyclicArbProfitTrackerStartHeight(ctx, ctx.BlockHeight())
 	}
+
+	// Set the profits accumulated since the last distribution.
+	k.SetProfitsToDistribute(ctx, genState.ProfitsToDistribute)
 }
 
 // ExportGenesis returns the module's exported genesis. ExportGenesis intentionally ignores a few of the errors thrown
@@ -176,5 +179,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 	genesis.CyclicArbTracker = &cyclicArbTracker
 
+	// Export the profits accumulated since the last distribution.
+	genesis.ProfitsToDistribute = k.GetProfitsToDistribute(ctx)
+
 	return genesis
 }
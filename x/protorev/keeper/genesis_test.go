@@ -66,4 +66,6 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 
 	cyclicArbProfitAccountingHeight := s.App.ProtoRevKeeper.GetCyclicArbProfitTrackerStartHeight(s.Ctx)
 	s.Require().Equal(cyclicArbProfitAccountingHeight, exportedGenesis.CyclicArbTracker.HeightAccountingStartsFrom)
+
+	s.Require().Equal(s.App.ProtoRevKeeper.GetProfitsToDistribute(s.Ctx), exportedGenesis.ProfitsToDistribute)
 }
@@ -63,6 +63,12 @@ func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
 }
 
+// AfterCFMMPoolDestroyed removes the destroyed pool from the highest liquidity pools, so that it is no longer
+// used to build backrun routes and the next pools created for its denom pairs can be stored.
+func (h Hooks) AfterCFMMPoolDestroyed(ctx sdk.Context, poolId uint64) {
+	h.k.DeleteAllDenomPairsForPool(ctx, poolId)
+}
+
 // ----------------------------------------------------------------------------
 // CONCENTRATED LIQUIDITY HOOKS
 // ----------------------------------------------------------------------------
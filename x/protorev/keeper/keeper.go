@@ -21,6 +21,7 @@ type (
 
 		accountKeeper               types.AccountKeeper
 		bankKeeper                  types.BankKeeper
+		distributionKeeper          types.DistributionKeeper
 		gammKeeper                  types.GAMMKeeper
 		epochKeeper                 types.EpochKeeper
 		poolmanagerKeeper           types.PoolManagerKeeper
@@ -35,6 +36,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
 	gammKeeper types.GAMMKeeper,
 	epochKeeper types.EpochKeeper,
 	poolmanagerKeeper types.PoolManagerKeeper,
@@ -52,6 +54,7 @@ func NewKeeper(
 		paramstore:                  ps,
 		accountKeeper:               accountKeeper,
 		bankKeeper:                  bankKeeper,
+		distributionKeeper:          distributionKeeper,
 		gammKeeper:                  gammKeeper,
 		epochKeeper:                 epochKeeper,
 		poolmanagerKeeper:           poolmanagerKeeper,
@@ -169,6 +169,24 @@ func (k Keeper) DeleteAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, key)
 }
 
+// DeleteAllDenomPairsForPool deletes the denom pairs for which the given pool is stored as the highest liquidity pool
+func (k Keeper) DeleteAllDenomPairsForPool(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomPairToPool)
+	iterator := store.Iterator(nil, nil)
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		if sdk.BigEndianToUint64(iterator.Value()) == poolId {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // SetSwapsToBackrun sets the swaps to backrun, updated via hooks
 func (k Keeper) SetSwapsToBackrun(ctx sdk.Context, swapsToBackrun types.Route) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSwapsToBackrun)
@@ -501,6 +519,7 @@ func (k Keeper) GetAllProtocolRevenue(ctx sdk.Context) types.AllProtocolRevenue
 		TakerFeesToStakers:         k.poolmanagerKeeper.GetTakerFeeTrackerForStakers(ctx),
 		TakerFeesToCommunityPool:   k.poolmanagerKeeper.GetTakerFeeTrackerForCommunityPool(ctx),
 		HeightAccountingStartsFrom: k.poolmanagerKeeper.GetTakerFeeTrackerStartHeight(ctx),
+		TakerFeesBurned:            k.poolmanagerKeeper.GetTakerFeeTrackerForBurn(ctx),
 	}
 
 	txFeesTracker := txfeestypes.TxFeesTracker{
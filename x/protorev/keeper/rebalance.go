@@ -361,7 +361,8 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 		return err
 	}
 
-	// Burn the coins from the module account after the trade and leave all remaining coins in the module account
+	// Burn the coins from the module account after the trade and leave all remaining coins in the module account,
+	// the profits are distributed at the end of the day epoch
 	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(inputCoin)); err != nil {
 		return err
 	}
@@ -374,10 +375,8 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 		return err
 	}
 
-	// Send the developer fee to the developer address
-	if err := k.SendDeveloperFee(ctx, sdk.NewCoin(inputCoin.Denom, profit)); err != nil {
-		ctx.Logger().Error("failed to send developer fee: " + err.Error())
-	}
+	// Track the profit to distribute it at the end of the day epoch
+	k.AddProfitToDistribute(ctx, sdk.NewCoin(inputCoin.Denom, profit))
 
 	// Create and emit the backrun event and add it to the context
 	EmitBackrunEvent(ctx, pool, inputCoin, profit, tokenOutAmount, remainingTxPoolPoints, remainingBlockPoolPoints)
@@ -501,9 +501,12 @@ func (s *KeeperTestSuite) TestExecuteTrade() {
 			s.Require().NoError(err)
 			s.Require().Equal(test.expectedNumOfTrades, totalNumberOfTrades)
 
-			// Check the dev account was paid the correct amount
+			// Check the profit is held in the module account until the epoch distribution
 			developerAccBalance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, devAccount, test.arbDenom)
-			s.Require().Equal(test.param.expectedProfit.MulRaw(types.ProfitSplitPhase1).QuoRaw(100), developerAccBalance.Amount)
+			s.Require().True(developerAccBalance.IsZero())
+			moduleAccBalance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, s.App.AppKeepers.AccountKeeper.GetModuleAddress(types.ModuleName), test.arbDenom)
+			s.Require().Equal(test.param.expectedProfit, moduleAccBalance.Amount)
+			s.Require().Equal(test.param.expectedProfit, s.App.ProtoRevKeeper.GetProfitsToDistribute(s.Ctx).AmountOf(test.arbDenom))
 
 		} else {
 			s.Require().Error(err)
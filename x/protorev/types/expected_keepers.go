@@ -21,6 +21,13 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// DistributionKeeper defines the Distribution contract that must be fulfilled when
+// creating a x/protorev keeper.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // GAMMKeeper defines the Gamm contract that must be fulfilled when
@@ -62,6 +69,7 @@ type PoolManagerKeeper interface {
 	RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
 	GetTakerFeeTrackerForStakers(ctx sdk.Context) sdk.Coins
 	GetTakerFeeTrackerForCommunityPool(ctx sdk.Context) sdk.Coins
+	GetTakerFeeTrackerForBurn(ctx sdk.Context) sdk.Coins
 	GetTakerFeeTrackerStartHeight(ctx sdk.Context) int64
 }
 
@@ -103,6 +103,11 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the profits to distribute
+	if err := gs.ProfitsToDistribute.Validate(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -62,6 +63,9 @@ type GenesisState struct {
 	// consumption of a swap on a given pool type.
 	InfoByPoolType   InfoByPoolType    `protobuf:"bytes,13,opt,name=info_by_pool_type,json=infoByPoolType,proto3" json:"info_by_pool_type" yaml:"info_by_pool_type"`
 	CyclicArbTracker *CyclicArbTracker `protobuf:"bytes,14,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker,omitempty" yaml:"cyclic_arb_tracker"`
+	// The profits accumulated since the last distribution, which are
+	// distributed at the end of the day epoch.
+	ProfitsToDistribute github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=profits_to_distribute,json=profitsToDistribute,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"profits_to_distribute" yaml:"profits_to_distribute"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -195,6 +199,13 @@ func (m *GenesisState) GetCyclicArbTracker() *CyclicArbTracker {
 	return nil
 }
 
+func (m *GenesisState) GetProfitsToDistribute() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProfitsToDistribute
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -204,56 +215,59 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 771 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x4e, 0xe3, 0x46,
-	0x18, 0x8f, 0x0b, 0x85, 0x32, 0x81, 0x08, 0x86, 0x06, 0x39, 0x69, 0x71, 0x5c, 0x17, 0xda, 0xa8,
-	0x2a, 0xb6, 0xa0, 0x3d, 0xf5, 0x50, 0x09, 0x53, 0xd1, 0x56, 0x55, 0x51, 0x64, 0x52, 0x55, 0x6a,
-	0xa5, 0x4e, 0xc7, 0xce, 0x24, 0x58, 0xd8, 0x1e, 0xcb, 0x33, 0x09, 0xc9, 0x03, 0xec, 0x7d, 0x1f,
-	0x66, 0x1f, 0x82, 0x23, 0xda, 0xcb, 0xee, 0x29, 0x5a, 0xc1, 0x1b, 0xe4, 0x09, 0x56, 0x9e, 0x99,
-	0x24, 0x10, 0xe2, 0xdd, 0x9b, 0xe7, 0xfb, 0x7e, 0x7f, 0xbe, 0xdf, 0xfc, 0x31, 0xf8, 0x86, 0xb2,
-	0x98, 0xb2, 0x90, 0x39, 0x69, 0x46, 0x39, 0xcd, 0xc8, 0xc0, 0x19, 0x1c, 0xfb, 0x84, 0xe3, 0x63,
-	0xa7, 0x47, 0x12, 0xc2, 0x42, 0x66, 0x8b, 0x06, 0xd4, 0x15, 0xce, 0x9e, 0xe2, 0x6c, 0x85, 0xab,
-	0x7f, 0xde, 0xa3, 0x3d, 0x2a, 0xaa, 0x4e, 0xfe, 0x25, 0x01, 0xf5, 0x6f, 0x0b, 0x75, 0x67, 0x02,
-	0x12, 0x78, 0x58, 0x0c, 0xc4, 0x19, 0x8e, 0x95, 0x61, 0xbd, 0x16, 0x08, 0x1c, 0x92, 0x46, 0x72,
-	0xa1, 0x5a, 0x86, 0x5c, 0x39, 0x3e, 0x66, 0x64, 0x46, 0x0e, 0x68, 0x98, 0xc8, 0xbe, 0xf5, 0x06,
-	0x80, 0xcd, 0x5f, 0x65, 0x98, 0x4b, 0x8e, 0x39, 0x81, 0x3f, 0x83, 0x35, 0xa9, 0xad, 0x6b, 0xa6,
-	0xd6, 0x2c, 0x9f, 0x98, 0x76, 0x51, 0x38, 0xbb, 0x25, 0x70, 0xee, 0xea, 0xed, 0xb8, 0x51, 0xf2,
-	0x14, 0x0b, 0xbe, 0xd0, 0x40, 0x95, 0xd3, 0x6b, 0x92, 0xa0, 0x14, 0x87, 0x19, 0xc2, 0x99, 0x8f,
-	0x32, 0xda, 0xe7, 0x84, 0xe9, 0x9f, 0x98, 0x2b, 0xcd, 0xf2, 0xc9, 0xf7, 0xc5, 0x7a, 0xed, 0x9c,
-	0xd6, 0xc2, 0x61, 0x76, 0x9a, 0xf9, 0x9e, 0xe0, 0xb8, 0x07, 0xb9, 0xf6, 0x64, 0xdc, 0xf8, 0x72,
-	0x84, 0xe3, 0xe8, 0x27, 0x6b, 0xa9, 0xb0, 0xe5, 0x41, 0xfe, 0x8c, 0x09, 0xff, 0x07, 0xe5, 0x3c,
-	0x33, 0xea, 0x90, 0x84, 0xc6, 0x4c, 0x5f, 0x11, 0xe6, 0x5f, 0x17, 0x9b, 0xbb, 0x98, 0x91, 0x5f,
-	0x72, 0xac, 0x5b, 0x57, 0x9e, 0x50, 0x7a, 0x3e, 0x52, 0xb1, 0x3c, 0xe0, 0x4f, 0x61, 0x0c, 0x12,
-	0xb0, 0x99, 0x52, 0x1a, 0xa1, 0x1b, 0x12, 0xf6, 0xae, 0x38, 0xd3, 0x57, 0xc5, 0x7e, 0x1d, 0x7e,
-	0x60, 0xbf, 0x28, 0x8d, 0xfe, 0x96, 0x60, 0xf7, 0x0b, 0x65, 0xb2, 0x2b, 0x4d, 0x1e, 0x0b, 0x59,
-	0x5e, 0x39, 0x9d, 0x23, 0x21, 0x02, 0xb5, 0x0e, 0x1e, 0x31, 0xc4, 0xc2, 0x24, 0x20, 0x28, 0xa6,
-	0x9d, 0x7e, 0x44, 0x90, 0xba, 0x7f, 0xfa, 0xa7, 0xa6, 0xd6, 0x5c, 0x75, 0x0f, 0x26, 0xe3, 0x86,
-	0x29, 0x85, 0x0a, 0xa1, 0x96, 0xb7, 0x97, 0xf7, 0x2e, 0xf3, 0xd6, 0x9f, 0xa2, 0xa3, 0x8e, 0x1d,
-	0x22, 0x50, 0xe9, 0x90, 0x01, 0x89, 0x68, 0x4a, 0x32, 0xd4, 0x25, 0x84, 0xe9, 0x6b, 0x62, 0xb3,
-	0x6a, 0xb6, 0xba, 0x49, 0x79, 0xe6, 0x59, 0x88, 0x33, 0x1a, 0x26, 0xee, 0xbe, 0x9a, 0xbe, 0xaa,
-	0x4c, 0x9f, 0xd0, 0x2d, 0x6f, 0x6b, 0x56, 0x38, 0x27, 0x84, 0xc1, 0x0b, 0xb0, 0x1b, 0x61, 0x4e,
-	0x18, 0x47, 0x7e, 0x44, 0x83, 0x6b, 0x74, 0x25, 0x92, 0xe9, 0xeb, 0x62, 0x76, 0x63, 0x32, 0x6e,
-	0xd4, 0xa5, 0xcc, 0x12, 0x90, 0xe5, 0xed, 0xc8, 0xaa, 0x9b, 0x17, 0x7f, 0x13, 0x35, 0xf8, 0x2f,
-	0xd8, 0x99, 0x3b, 0xe2, 0x4e, 0x27, 0x23, 0x8c, 0xe9, 0x9f, 0x99, 0x5a, 0x73, 0xc3, 0xb5, 0x27,
-	0xe3, 0x86, 0xbe, 0x38, 0x94, 0x82, 0x58, 0xaf, 0x5f, 0x1d, 0x55, 0x54, 0xa4, 0x53, 0x59, 0xf2,
-	0xb6, 0x67, 0x28, 0x55, 0x81, 0xff, 0x81, 0x5a, 0x8c, 0x87, 0x48, 0x1c, 0x48, 0x4a, 0xc3, 0x84,
-	0x33, 0x94, 0x6b, 0x88, 0xa1, 0xf4, 0x8d, 0xc5, 0xed, 0x2e, 0x84, 0x5a, 0x5e, 0x35, 0xc6, 0xc3,
-	0xfc, 0xc4, 0x5b, 0xa2, 0xd3, 0x22, 0x99, 0x88, 0x00, 0xff, 0x02, 0x7b, 0xcb, 0x48, 0x7c, 0xa8,
-	0x03, 0x21, 0xfe, 0xd5, 0x64, 0xdc, 0xd8, 0x2f, 0x16, 0xe7, 0x43, 0xcb, 0x83, 0x8b, 0xca, 0xed,
-	0x21, 0xbc, 0x04, 0x55, 0x81, 0x42, 0x01, 0xed, 0x27, 0x1c, 0x75, 0xe9, 0x74, 0xe4, 0xb2, 0x50,
-	0x35, 0xe7, 0x6f, 0x68, 0x29, 0xcc, 0xf2, 0xa0, 0xa8, 0x9f, 0xe5, 0xe5, 0x73, 0xaa, 0x66, 0xfd,
-	0x03, 0xac, 0xa7, 0x19, 0xed, 0x86, 0x9c, 0xe9, 0x9b, 0x1f, 0xbb, 0x12, 0x7b, 0xea, 0x4a, 0x54,
-	0x94, 0x8b, 0xe4, 0x59, 0xde, 0x54, 0x01, 0xf6, 0xc1, 0x4e, 0x98, 0x74, 0x29, 0xf2, 0x47, 0x32,
-	0x14, 0x1f, 0xa5, 0x44, 0xdf, 0x12, 0x6f, 0xa6, 0x59, 0xfc, 0x66, 0x7e, 0x4f, 0xba, 0xd4, 0x1d,
-	0xe5, 0x69, 0xdb, 0xa3, 0x94, 0xb8, 0xa6, 0x72, 0x51, 0x67, 0xfc, 0x4c, 0xd0, 0xf2, 0x2a, 0xe1,
-	0x13, 0x06, 0xbc, 0x01, 0x30, 0x18, 0x05, 0x51, 0x18, 0x88, 0x3f, 0x06, 0xcf, 0x70, 0x70, 0x4d,
-	0x32, 0xbd, 0x22, 0x7c, 0xbf, 0x2b, 0xf6, 0x3d, 0x13, 0x9c, 0xd3, 0xcc, 0x6f, 0x4b, 0x86, 0xbb,
-	0x3f, 0x19, 0x37, 0x6a, 0xd2, 0xf5, 0xb9, 0x9e, 0xe5, 0x6d, 0x07, 0x8b, 0x84, 0x8b, 0xdb, 0x7b,
-	0x43, 0xbb, 0xbb, 0x37, 0xb4, 0x77, 0xf7, 0x86, 0xf6, 0xf2, 0xc1, 0x28, 0xdd, 0x3d, 0x18, 0xa5,
-	0xb7, 0x0f, 0x46, 0xe9, 0x9f, 0x1f, 0x7b, 0x21, 0xbf, 0xea, 0xfb, 0x76, 0x40, 0x63, 0x47, 0x0d,
-	0x70, 0x14, 0x61, 0x9f, 0x4d, 0x17, 0xce, 0xe0, 0xe4, 0xd8, 0x19, 0xce, 0xff, 0xf9, 0x79, 0x30,
-	0xe6, 0xaf, 0x89, 0xf5, 0x0f, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0x72, 0xe1, 0xd3, 0x73, 0x95,
-	0x06, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xef, 0xb0, 0xa5, 0xcb, 0x3a, 0xdd, 0xb0, 0x75, 0x49, 0xe5, 0x04, 0x9a, 0x04, 0xb3, 0x0b,
+	0x11, 0xa2, 0x89, 0x5a, 0x38, 0x71, 0x40, 0xea, 0x74, 0xb5, 0x80, 0x10, 0xab, 0xc8, 0x0d, 0x42,
+	0x02, 0x09, 0xe3, 0x99, 0x38, 0xa9, 0xd5, 0xc9, 0x78, 0x64, 0x3b, 0xdd, 0xe4, 0x03, 0x70, 0xe7,
+	0x1b, 0x70, 0xe7, 0xcc, 0x87, 0xd8, 0xe3, 0x8a, 0x13, 0xa7, 0x80, 0xda, 0x6f, 0x90, 0x1b, 0x37,
+	0x34, 0xb6, 0x93, 0xb6, 0x69, 0x86, 0x3d, 0x25, 0x7e, 0xef, 0xf7, 0xe7, 0xbd, 0xe7, 0x3f, 0x03,
+	0x3e, 0x94, 0x7a, 0x24, 0xb5, 0xd0, 0x9d, 0x4c, 0x49, 0x23, 0x15, 0xbf, 0xe8, 0x5c, 0x1c, 0x46,
+	0xdc, 0xb0, 0xc3, 0xce, 0x90, 0xa7, 0x5c, 0x0b, 0xdd, 0xb6, 0x09, 0x88, 0x3c, 0xae, 0xbd, 0xc0,
+	0xb5, 0x3d, 0xae, 0xf6, 0xce, 0x50, 0x0e, 0xa5, 0x8d, 0x76, 0xf2, 0x7f, 0x0e, 0x50, 0xfb, 0xa8,
+	0x50, 0x77, 0x29, 0xe0, 0x80, 0x4f, 0x8a, 0x81, 0x4c, 0xb1, 0x91, 0x37, 0xac, 0x55, 0x63, 0x8b,
+	0xa3, 0xce, 0xc8, 0x2d, 0x7c, 0xaa, 0xee, 0x56, 0x9d, 0x88, 0x69, 0xbe, 0x24, 0xc7, 0x52, 0xa4,
+	0x2e, 0x8f, 0xff, 0x2d, 0x81, 0xed, 0x2f, 0x5d, 0x33, 0xa7, 0x86, 0x19, 0x0e, 0xbf, 0x00, 0x5b,
+	0x4e, 0x1b, 0x05, 0xcd, 0xa0, 0x55, 0x3a, 0x6a, 0xb6, 0x8b, 0x9a, 0x6b, 0x77, 0x2d, 0x2e, 0xdc,
+	0x7c, 0x39, 0x6b, 0x6c, 0x10, 0xcf, 0x82, 0xbf, 0x04, 0xa0, 0x62, 0xe4, 0x39, 0x4f, 0x69, 0xc6,
+	0x84, 0xa2, 0x4c, 0x45, 0x54, 0xc9, 0xb1, 0xe1, 0x1a, 0xbd, 0xd1, 0xbc, 0xd7, 0x2a, 0x1d, 0x7d,
+	0x52, 0xac, 0xd7, 0xcb, 0x69, 0x5d, 0x26, 0xd4, 0xb1, 0x8a, 0x88, 0xe5, 0x84, 0x8f, 0x73, 0xed,
+	0xf9, 0xac, 0xf1, 0xde, 0x94, 0x8d, 0x92, 0xcf, 0xf1, 0x5a, 0x61, 0x4c, 0xa0, 0xb9, 0xc3, 0x84,
+	0x3f, 0x83, 0x52, 0xde, 0x33, 0xed, 0xf3, 0x54, 0x8e, 0x34, 0xba, 0x67, 0xcd, 0x3f, 0x28, 0x36,
+	0x0f, 0x99, 0xe6, 0x4f, 0x73, 0x6c, 0x58, 0xf3, 0x9e, 0xd0, 0x79, 0xde, 0x50, 0xc1, 0x04, 0x44,
+	0x0b, 0x98, 0x86, 0x1c, 0x6c, 0x67, 0x52, 0x26, 0xf4, 0x05, 0x17, 0xc3, 0x33, 0xa3, 0xd1, 0xa6,
+	0x9d, 0xd7, 0x93, 0xff, 0x99, 0x97, 0x94, 0xc9, 0xf7, 0x0e, 0x1c, 0xbe, 0xeb, 0x4d, 0x76, 0x9d,
+	0xc9, 0x4d, 0x21, 0x4c, 0x4a, 0xd9, 0x35, 0x12, 0x52, 0x50, 0xed, 0xb3, 0xa9, 0xa6, 0x5a, 0xa4,
+	0x31, 0xa7, 0x23, 0xd9, 0x1f, 0x27, 0x9c, 0xfa, 0xf3, 0x87, 0xde, 0x6c, 0x06, 0xad, 0xcd, 0xf0,
+	0xf1, 0x7c, 0xd6, 0x68, 0x3a, 0xa1, 0x42, 0x28, 0x26, 0x7b, 0x79, 0xee, 0x34, 0x4f, 0x7d, 0x6b,
+	0x33, 0x7e, 0xdb, 0x21, 0x05, 0xe5, 0x3e, 0xbf, 0xe0, 0x89, 0xcc, 0xb8, 0xa2, 0x03, 0xce, 0x35,
+	0xda, 0xb2, 0xc3, 0xaa, 0xb6, 0xfd, 0x49, 0xca, 0x7b, 0x5e, 0x36, 0x71, 0x22, 0x45, 0x1a, 0xee,
+	0xfb, 0xea, 0x2b, 0xde, 0xf4, 0x16, 0x1d, 0x93, 0x87, 0xcb, 0xc0, 0x33, 0xce, 0x35, 0x7c, 0x0e,
+	0x76, 0x13, 0x66, 0xb8, 0x36, 0x34, 0x4a, 0x64, 0x7c, 0x4e, 0xcf, 0x6c, 0x67, 0xe8, 0xbe, 0xad,
+	0xbd, 0x3e, 0x9f, 0x35, 0x6a, 0x4e, 0x66, 0x0d, 0x08, 0x93, 0x1d, 0x17, 0x0d, 0xf3, 0xe0, 0x57,
+	0x36, 0x06, 0x7f, 0x04, 0x3b, 0xd7, 0x8e, 0xac, 0xdf, 0x57, 0x5c, 0x6b, 0xf4, 0x56, 0x33, 0x68,
+	0x3d, 0x08, 0xdb, 0xf3, 0x59, 0x03, 0xad, 0x16, 0xe5, 0x21, 0xf8, 0xcf, 0x3f, 0x0e, 0xca, 0xbe,
+	0xa5, 0x63, 0x17, 0x22, 0x8f, 0x96, 0x28, 0x1f, 0x81, 0x3f, 0x81, 0xea, 0x88, 0x4d, 0xa8, 0xdd,
+	0x90, 0x4c, 0x8a, 0xd4, 0x68, 0x9a, 0x6b, 0xd8, 0xa2, 0xd0, 0x83, 0xd5, 0x71, 0x17, 0x42, 0x31,
+	0xa9, 0x8c, 0xd8, 0x24, 0xdf, 0xf1, 0xae, 0xcd, 0x74, 0xb9, 0xb2, 0x2d, 0xc0, 0xef, 0xc0, 0xde,
+	0x3a, 0x92, 0x99, 0x20, 0x60, 0xc5, 0xdf, 0x9f, 0xcf, 0x1a, 0xfb, 0xc5, 0xe2, 0x66, 0x82, 0x09,
+	0x5c, 0x55, 0xee, 0x4d, 0xe0, 0x29, 0xa8, 0x58, 0x14, 0x8d, 0xe5, 0x38, 0x35, 0x74, 0x20, 0x17,
+	0x25, 0x97, 0xac, 0x6a, 0xf3, 0xfa, 0x0e, 0xad, 0x85, 0x61, 0x02, 0x6d, 0xfc, 0x24, 0x0f, 0x3f,
+	0x93, 0xbe, 0xd6, 0x6f, 0xc0, 0xfd, 0x4c, 0xc9, 0x81, 0x30, 0x1a, 0x6d, 0xbf, 0xee, 0x48, 0xec,
+	0xf9, 0x23, 0x51, 0xf6, 0x2e, 0x8e, 0x87, 0xc9, 0x42, 0x01, 0x8e, 0xc1, 0x8e, 0x48, 0x07, 0x92,
+	0x46, 0x53, 0xd7, 0x94, 0x99, 0x66, 0x1c, 0x3d, 0xb4, 0x77, 0xa6, 0x55, 0x7c, 0x67, 0xbe, 0x4e,
+	0x07, 0x32, 0x9c, 0xe6, 0xdd, 0xf6, 0xa6, 0x19, 0x0f, 0x9b, 0xde, 0xc5, 0xef, 0xf1, 0x1d, 0x41,
+	0x4c, 0xca, 0xe2, 0x16, 0x03, 0xbe, 0x00, 0x30, 0x9e, 0xc6, 0x89, 0x88, 0xed, 0x8b, 0x61, 0x14,
+	0x8b, 0xcf, 0xb9, 0x42, 0x65, 0xeb, 0xfb, 0x71, 0xb1, 0xef, 0x89, 0xe5, 0x1c, 0xab, 0xa8, 0xe7,
+	0x18, 0xe1, 0xfe, 0x7c, 0xd6, 0xa8, 0x3a, 0xd7, 0xbb, 0x7a, 0x98, 0x3c, 0x8a, 0x57, 0x08, 0xf0,
+	0xb7, 0x00, 0x54, 0x7c, 0xef, 0xd4, 0x48, 0xda, 0x17, 0xda, 0x28, 0x11, 0x8d, 0x0d, 0x47, 0x6f,
+	0xbf, 0x6e, 0x96, 0xdd, 0xdb, 0xaf, 0xde, 0x5a, 0x15, 0xfc, 0xfb, 0xdf, 0x8d, 0xd6, 0x50, 0x98,
+	0xb3, 0x71, 0xd4, 0x8e, 0xe5, 0xc8, 0xbf, 0xfa, 0xfe, 0xe7, 0x40, 0xf7, 0xcf, 0x3b, 0xf9, 0x3c,
+	0xb4, 0x15, 0xd4, 0x64, 0xd7, 0x6b, 0xf4, 0xe4, 0xd3, 0xa5, 0x42, 0xf8, 0xfc, 0xe5, 0x65, 0x3d,
+	0x78, 0x75, 0x59, 0x0f, 0xfe, 0xb9, 0xac, 0x07, 0xbf, 0x5e, 0xd5, 0x37, 0x5e, 0x5d, 0xd5, 0x37,
+	0xfe, 0xba, 0xaa, 0x6f, 0xfc, 0xf0, 0xd9, 0x0d, 0x61, 0x3f, 0xa2, 0x83, 0x84, 0x45, 0x7a, 0xb1,
+	0xe8, 0x5c, 0x1c, 0x1d, 0x76, 0x26, 0xd7, 0x5f, 0x25, 0x6b, 0x15, 0x6d, 0xd9, 0xf5, 0xa7, 0xff,
+	0x0d, 0x00, 0x2a, 0x15, 0x96, 0x00, 0x37, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +290,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProfitsToDistribute) > 0 {
+		for iNdEx := len(m.ProfitsToDistribute) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitsToDistribute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.CyclicArbTracker != nil {
 		{
 			size, err := m.CyclicArbTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +507,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.CyclicArbTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ProfitsToDistribute) > 0 {
+		for _, e := range m.ProfitsToDistribute {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -915,6 +949,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitsToDistribute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitsToDistribute = append(m.ProfitsToDistribute, types.Coin{})
+			if err := m.ProfitsToDistribute[len(m.ProfitsToDistribute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
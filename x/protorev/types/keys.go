@@ -38,6 +38,7 @@ const (
 	prefixSwapsToBackrun
 	prefixcyclicArbTracker
 	prefixcyclicArbTrackerStartHeight
+	prefixProfitsToDistribute
 )
 
 var (
@@ -97,6 +98,9 @@ var (
 
 	// KeyCyclicArbTracker is the prefix for store that keeps track of the height we began tracking cyclic arbitrage
 	KeyCyclicArbTrackerStartHeight = []byte{prefixcyclicArbTrackerStartHeight}
+
+	// KeyProfitsToDistribute is the prefix for store that keeps track of the profits accumulated since the last distribution
+	KeyProfitsToDistribute = []byte{prefixProfitsToDistribute}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -0,0 +1,237 @@
+package ante
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+// AuthenticatorDecorator authenticates every message of the tx with the authenticator selected for it
+// in the TxExtension of the tx, instead of verifying the signatures with the public keys of the signers.
+// The authenticators then track the tx, so that they can confirm its execution in the post handler.
+//
+// Each message must have a single signer, and the fee payer must be the signer of the first message.
+// The first message is authenticated before the fees are deducted, with a gas meter limited to the
+// MaximumUnauthenticatedGas param, since a failed authentication is reverted without paying fees.
+// The fees are then deducted by the wrapped deduct fee decorator, before the other messages are authenticated
+// with the gas of the tx.
+type AuthenticatorDecorator struct {
+	smartAccountKeeper keeper.Keeper
+	accountKeeper      authante.AccountKeeper
+	signModeHandler    authsigning.SignModeHandler
+	deductFeeDecorator sdk.AnteDecorator
+}
+
+func NewAuthenticatorDecorator(
+	smartAccountKeeper keeper.Keeper,
+	accountKeeper authante.AccountKeeper,
+	signModeHandler authsigning.SignModeHandler,
+	deductFeeDecorator sdk.AnteDecorator,
+) AuthenticatorDecorator {
+	return AuthenticatorDecorator{
+		smartAccountKeeper: smartAccountKeeper,
+		accountKeeper:      accountKeeper,
+		signModeHandler:    signModeHandler,
+		deductFeeDecorator: deductFeeDecorator,
+	}
+}
+
+// signerSignature is the signature of a signer of the tx and the bytes it signed.
+type signerSignature struct {
+	signature []byte
+	signBytes []byte
+}
+
+func (ad AuthenticatorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+
+	extension := types.GetTxExtension(tx)
+	if extension == nil {
+		return ctx, errorsmod.Wrap(types.ErrInvalidTxExtension, "tx does not select authenticators")
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx must have at least one message")
+	}
+	if len(extension.SelectedAuthenticators) != len(msgs) {
+		return ctx, errorsmod.Wrapf(types.ErrInvalidTxExtension, "expected %d selected authenticators, got %d", len(msgs), len(extension.SelectedAuthenticators))
+	}
+
+	feePayer := feeTx.FeePayer()
+	if firstSigners := msgs[0].GetSigners(); len(firstSigners) != 1 || !feePayer.Equals(firstSigners[0]) {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "fee payer must be the signer of the first message")
+	}
+
+	signatures, err := ad.getSignerSignatures(ctx, sigTx)
+	if err != nil {
+		return ctx, err
+	}
+
+	requests := make([]authenticator.AuthenticationRequest, len(msgs))
+	auths := make([]authenticator.Authenticator, len(msgs))
+	for msgIndex, msg := range msgs {
+		request, auth, err := GetAuthenticationRequest(ctx, ad.smartAccountKeeper, msg, msgIndex, extension.SelectedAuthenticators[msgIndex])
+		if err != nil {
+			return ctx, err
+		}
+		request.FeePayer = feePayer
+		request.Simulate = simulate
+		request.Signature = signatures[request.Account.String()].signature
+		request.SignBytes = signatures[request.Account.String()].signBytes
+		requests[msgIndex], auths[msgIndex] = request, auth
+	}
+
+	// Authenticate the fee payer before deducting the fees, with limited gas.
+	if err := ad.authenticateFeePayer(ctx, feeTx, auths[0], requests[0]); err != nil {
+		return ctx, err
+	}
+
+	ctx, err = ad.deductFeeDecorator.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	tracked := map[string]bool{}
+	for msgIndex := range msgs {
+		request, auth := requests[msgIndex], auths[msgIndex]
+		if msgIndex > 0 {
+			if err := auth.Authenticate(ctx, request); err != nil {
+				return ctx, errorsmod.Wrapf(err, "authenticator %s of account %s failed to authenticate message %d", request.AuthenticatorId, request.Account, msgIndex)
+			}
+		}
+
+		// Authenticators track the tx once, even if they authenticate several of its messages.
+		trackingKey := request.Account.String() + "/" + request.AuthenticatorId
+		if tracked[trackingKey] {
+			continue
+		}
+		tracked[trackingKey] = true
+		if err := auth.Track(ctx, request); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// authenticateFeePayer authenticates the first message of the tx, signed by the fee payer, with a gas meter limited
+// to the maximum unauthenticated gas. The gas used is then consumed from the gas meter of the tx.
+func (ad AuthenticatorDecorator) authenticateFeePayer(ctx sdk.Context, feeTx sdk.FeeTx, auth authenticator.Authenticator, request authenticator.AuthenticationRequest) (err error) {
+	gasLimit := ad.smartAccountKeeper.GetMaximumUnauthenticatedGas(ctx)
+	if feeTx.GetGas() < gasLimit {
+		gasLimit = feeTx.GetGas()
+	}
+	feePayerCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(feePayerCtx.GasMeter().GasConsumedToLimit(), "fee payer authentication")
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "authenticating the fee payer ran out of gas in %s, maximum unauthenticated gas is %d", outOfGas.Descriptor, gasLimit)
+		}
+	}()
+
+	if err := auth.Authenticate(feePayerCtx, request); err != nil {
+		return errorsmod.Wrapf(err, "authenticator %s of fee payer %s failed to authenticate message 0", request.AuthenticatorId, request.Account)
+	}
+	return nil
+}
+
+// getSignerSignatures returns the signature of every signer of the tx, keyed by signer address,
+// after checking the sequence of the signer.
+func (ad AuthenticatorDecorator) getSignerSignatures(ctx sdk.Context, sigTx authsigning.SigVerifiableTx) (map[string]signerSignature, error) {
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	signerAddrs := sigTx.GetSigners()
+	if len(sigs) != len(signerAddrs) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+
+	signatures := make(map[string]signerSignature, len(sigs))
+	for i, sig := range sigs {
+		acc, err := authante.GetSignerAcc(ctx, ad.accountKeeper, signerAddrs[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if sig.Sequence != acc.GetSequence() {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		single, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "authenticators only support single signatures")
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+		signerData := authsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      acc.GetSequence(),
+			PubKey:        acc.GetPubKey(),
+		}
+		signBytes, err := ad.signModeHandler.GetSignBytes(single.SignMode, signerData, sigTx)
+		if err != nil {
+			return nil, err
+		}
+
+		signatures[signerAddrs[i].String()] = signerSignature{
+			signature: single.Signature,
+			signBytes: signBytes,
+		}
+	}
+	return signatures, nil
+}
+
+// GetAuthenticationRequest returns the initialized authenticator of the signer of the message with the given id,
+// and the request of the message to pass it, without signature, fee payer and simulation mode.
+func GetAuthenticationRequest(ctx sdk.Context, smartAccountKeeper keeper.Keeper, msg sdk.Msg, msgIndex int, authenticatorId uint64) (authenticator.AuthenticationRequest, authenticator.Authenticator, error) {
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return authenticator.AuthenticationRequest{}, nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %d must have a single signer to be authenticated by an authenticator, got %d", msgIndex, len(signers))
+	}
+
+	auth, err := smartAccountKeeper.GetInitializedAuthenticatorForAccount(ctx, signers[0], authenticatorId)
+	if err != nil {
+		return authenticator.AuthenticationRequest{}, nil, err
+	}
+
+	return authenticator.AuthenticationRequest{
+		AuthenticatorId: fmt.Sprint(authenticatorId),
+		Account:         signers[0],
+		Msg:             msg,
+		MsgIndex:        uint64(msgIndex),
+	}, auth, nil
+}
@@ -0,0 +1,274 @@
+package ante_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/ante"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/post"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+	txfeeskeeper "github.com/osmosis-labs/osmosis/v21/x/txfees/keeper"
+)
+
+type AnteTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (s *AnteTestSuite) SetupTest() {
+	s.Setup()
+	s.App.SmartAccountKeeper.SetParams(s.Ctx, types.NewParams(true, []string{}, types.DefaultMaximumUnauthenticatedGas))
+	for _, acc := range s.TestAccs {
+		s.FundAcc(acc, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	}
+}
+
+// buildTx builds a tx of the messages signed by the given keys, one per signer, selecting the given authenticators.
+func (s *AnteTestSuite) buildTx(msgs []sdk.Msg, selectedAuthenticators []uint64, signers []sdk.AccAddress, privKeys []cryptotypes.PrivKey) sdk.Tx {
+	txConfig := s.App.GetTxConfig()
+	txBuilder := txConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(msgs...))
+	txBuilder.SetGasLimit(1_000_000)
+
+	if selectedAuthenticators != nil {
+		extension, err := codectypes.NewAnyWithValue(&types.TxExtension{SelectedAuthenticators: selectedAuthenticators})
+		s.Require().NoError(err)
+		txBuilder.(authtx.ExtensionOptionsTxBuilder).SetNonCriticalExtensionOptions(extension)
+	}
+
+	// The signer infos are part of the sign bytes, so they are set before signing.
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		acc := s.App.AccountKeeper.GetAccount(s.Ctx, signer)
+		sigs[i] = signing.SignatureV2{
+			PubKey:   privKeys[i].PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: acc.GetSequence(),
+		}
+	}
+	s.Require().NoError(txBuilder.SetSignatures(sigs...))
+
+	for i, signer := range signers {
+		acc := s.App.AccountKeeper.GetAccount(s.Ctx, signer)
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+			Address:       signer.String(),
+			ChainID:       s.Ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        privKeys[i].PubKey(),
+		}, txBuilder.GetTx())
+		s.Require().NoError(err)
+		signature, err := privKeys[i].Sign(signBytes)
+		s.Require().NoError(err)
+		sigs[i].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: signature}
+	}
+	s.Require().NoError(txBuilder.SetSignatures(sigs...))
+
+	return txBuilder.GetTx()
+}
+
+func (s *AnteTestSuite) newAuthenticatorDecorator() ante.AuthenticatorDecorator {
+	deductFeeDecorator := txfeeskeeper.NewDeductFeeDecorator(*s.App.TxFeesKeeper, s.App.AccountKeeper, s.App.BankKeeper, nil)
+	return ante.NewAuthenticatorDecorator(*s.App.SmartAccountKeeper, s.App.AccountKeeper, s.App.GetTxConfig().SignModeHandler(), deductFeeDecorator)
+}
+
+// runTx runs the authenticator ante handler, the messages of the tx and the authenticator post handler, as baseapp would.
+func (s *AnteTestSuite) runTx(tx sdk.Tx) error {
+	anteHandler := sdk.ChainAnteDecorators(s.newAuthenticatorDecorator())
+	postHandler := sdk.ChainPostDecorators(post.NewAuthenticatorPostDecorator(*s.App.SmartAccountKeeper))
+
+	cacheCtx, write := s.Ctx.CacheContext()
+	ctx, err := anteHandler(cacheCtx, tx, false)
+	if err != nil {
+		return err
+	}
+
+	bankMsgServer := bankkeeper.NewMsgServerImpl(s.App.BankKeeper)
+	for _, msg := range tx.GetMsgs() {
+		if _, err := bankMsgServer.Send(sdk.WrapSDKContext(ctx), msg.(*banktypes.MsgSend)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := postHandler(ctx, tx, false, true); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+func (s *AnteTestSuite) TestAuthenticatorFlow() {
+	account, recipient := s.TestAccs[0], s.TestAccs[1]
+	accountKey, otherKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+
+	signatureId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, accountKey.PubKey().Bytes())
+	s.Require().NoError(err)
+
+	spendLimitConfig, err := json.Marshal([]authenticator.SubAuthenticatorInitData{
+		{Type: authenticator.SignatureVerificationType, Config: accountKey.PubKey().Bytes()},
+		{Type: authenticator.SpendLimitType, Config: []byte(`{"limit":[{"denom":"` + sdk.DefaultBondDenom + `","amount":"100"}]}`)},
+	})
+	s.Require().NoError(err)
+	spendLimitId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.AllOfType, spendLimitConfig)
+	s.Require().NoError(err)
+
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(account, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+	}
+
+	tests := map[string]struct {
+		msgs                   []sdk.Msg
+		selectedAuthenticators []uint64
+		privKey                cryptotypes.PrivKey
+		expectedErr            error
+	}{
+		"signed by the key of the authenticator": {
+			msgs:                   []sdk.Msg{send(1000)},
+			selectedAuthenticators: []uint64{signatureId},
+			privKey:                accountKey,
+		},
+		"signed by another key": {
+			msgs:                   []sdk.Msg{send(1000)},
+			selectedAuthenticators: []uint64{signatureId},
+			privKey:                otherKey,
+			expectedErr:            types.ErrAuthenticationFailed,
+		},
+		"no authenticator selected for every message": {
+			msgs:                   []sdk.Msg{send(1), send(1)},
+			selectedAuthenticators: []uint64{signatureId},
+			privKey:                accountKey,
+			expectedErr:            types.ErrInvalidTxExtension,
+		},
+		"unknown authenticator": {
+			msgs:                   []sdk.Msg{send(1)},
+			selectedAuthenticators: []uint64{spendLimitId + 1},
+			privKey:                accountKey,
+			expectedErr:            types.ErrAuthenticatorNotFound,
+		},
+		"within spend limit": {
+			msgs:                   []sdk.Msg{send(60), send(40)},
+			selectedAuthenticators: []uint64{spendLimitId, spendLimitId},
+			privKey:                accountKey,
+		},
+		"exceeds spend limit across messages": {
+			msgs:                   []sdk.Msg{send(60), send(41)},
+			selectedAuthenticators: []uint64{spendLimitId, spendLimitId},
+			privKey:                accountKey,
+			expectedErr:            types.ErrExecutionRejected,
+		},
+		"spend limit signed by another key": {
+			msgs:                   []sdk.Msg{send(1)},
+			selectedAuthenticators: []uint64{spendLimitId},
+			privKey:                otherKey,
+			expectedErr:            types.ErrAuthenticationFailed,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, account, sdk.DefaultBondDenom)
+			tx := s.buildTx(tc.msgs, tc.selectedAuthenticators, []sdk.AccAddress{account}, []cryptotypes.PrivKey{tc.privKey})
+
+			err := s.runTx(tx)
+
+			balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, account, sdk.DefaultBondDenom)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Equal(balanceBefore, balanceAfter)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(balanceAfter.IsLT(balanceBefore))
+		})
+	}
+}
+
+func (s *AnteTestSuite) TestFeePayerAuthenticationGasLimit() {
+	account, accountKey := s.TestAccs[0], secp256k1.GenPrivKey()
+	signatureId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, accountKey.PubKey().Bytes())
+	s.Require().NoError(err)
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(account, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))}
+	tx := s.buildTx(msgs, []uint64{signatureId}, []sdk.AccAddress{account}, []cryptotypes.PrivKey{accountKey})
+
+	tests := map[string]struct {
+		maximumUnauthenticatedGas uint64
+		expectedErr               error
+	}{
+		"within the maximum unauthenticated gas":  {maximumUnauthenticatedGas: types.DefaultMaximumUnauthenticatedGas},
+		"exceeds the maximum unauthenticated gas": {maximumUnauthenticatedGas: 1_000, expectedErr: sdkerrors.ErrOutOfGas},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.App.SmartAccountKeeper.SetParams(s.Ctx, types.NewParams(true, []string{}, tc.maximumUnauthenticatedGas))
+			ctx := s.Ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+
+			_, err := sdk.ChainAnteDecorators(s.newAuthenticatorDecorator())(ctx, tx, false)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				// The gas used to authenticate the fee payer is still consumed, up to the maximum.
+				s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), tc.maximumUnauthenticatedGas)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *AnteTestSuite) TestCircuitBreaker() {
+	var flow string
+	authenticatorFlow := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		flow = "authenticator"
+		return ctx, nil
+	}
+	classicFlow := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		flow = "classic"
+		return ctx, nil
+	}
+	anteHandler := sdk.ChainAnteDecorators(ante.NewCircuitBreakerDecorator(*s.App.SmartAccountKeeper, authenticatorFlow, classicFlow))
+
+	account := s.TestAccs[0]
+	msgs := []sdk.Msg{banktypes.NewMsgSend(account, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))}
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey()}
+	authenticatorTx := s.buildTx(msgs, []uint64{0}, []sdk.AccAddress{account}, privKeys)
+	classicTx := s.buildTx(msgs, nil, []sdk.AccAddress{account}, privKeys)
+
+	tests := map[string]struct {
+		active       bool
+		tx           sdk.Tx
+		expectedFlow string
+	}{
+		"active, tx selecting authenticators":   {active: true, tx: authenticatorTx, expectedFlow: "authenticator"},
+		"active, classic tx":                    {active: true, tx: classicTx, expectedFlow: "classic"},
+		"inactive, tx selecting authenticators": {active: false, tx: authenticatorTx, expectedFlow: "classic"},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.App.SmartAccountKeeper.SetIsSmartAccountActive(s.Ctx, tc.active)
+
+			_, err := anteHandler(s.Ctx, tc.tx, false)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedFlow, flow)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+// CircuitBreakerDecorator routes the txs selecting authenticators through the authenticator ante handler flow,
+// while smart accounts are active, and every other tx through the classic ante handler flow verifying signatures.
+type CircuitBreakerDecorator struct {
+	smartAccountKeeper           keeper.Keeper
+	authenticatorAnteHandlerFlow sdk.AnteHandler
+	classicAnteHandlerFlow       sdk.AnteHandler
+}
+
+func NewCircuitBreakerDecorator(
+	smartAccountKeeper keeper.Keeper,
+	authenticatorAnteHandlerFlow sdk.AnteHandler,
+	classicAnteHandlerFlow sdk.AnteHandler,
+) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		smartAccountKeeper:           smartAccountKeeper,
+		authenticatorAnteHandlerFlow: authenticatorAnteHandlerFlow,
+		classicAnteHandlerFlow:       classicAnteHandlerFlow,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	flow := cbd.classicAnteHandlerFlow
+	if IsAuthenticatorTx(ctx, cbd.smartAccountKeeper, tx) {
+		flow = cbd.authenticatorAnteHandlerFlow
+	}
+
+	newCtx, err = flow(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+	return next(newCtx, tx, simulate)
+}
+
+// IsAuthenticatorTx returns whether the tx is authenticated by the authenticators of its signers,
+// that is if smart accounts are active and the tx selects authenticators.
+func IsAuthenticatorTx(ctx sdk.Context, smartAccountKeeper keeper.Keeper, tx sdk.Tx) bool {
+	return smartAccountKeeper.GetIsSmartAccountActive(ctx) && types.GetTxExtension(tx) != nil
+}
@@ -0,0 +1,218 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+const (
+	AllOfType = "AllOf"
+	AnyOfType = "AnyOf"
+)
+
+// SubAuthenticatorInitData is the config of a sub-authenticator of a composite authenticator.
+type SubAuthenticatorInitData struct {
+	Type   string `json:"type"`
+	Config []byte `json:"config"`
+}
+
+var _ Authenticator = CompositeAuthenticator{}
+
+// CompositeAuthenticator combines sub-authenticators, configured with a JSON list of SubAuthenticatorInitData.
+// An AllOf authenticator authenticates a message if all of its sub-authenticators do, e.g. to require the
+// signatures of several keys (cosign), while an AnyOf authenticator authenticates it if any of them does.
+//
+// The signature of the request is passed to every sub-authenticator, unless it is a JSON list of as many
+// signatures as sub-authenticators, in which case each sub-authenticator gets the signature at its index.
+type CompositeAuthenticator struct {
+	am                *AuthenticatorManager
+	allOf             bool
+	subAuthenticators []Authenticator
+}
+
+func NewAllOf(am *AuthenticatorManager) CompositeAuthenticator {
+	return CompositeAuthenticator{am: am, allOf: true}
+}
+
+func NewAnyOf(am *AuthenticatorManager) CompositeAuthenticator {
+	return CompositeAuthenticator{am: am, allOf: false}
+}
+
+func (ca CompositeAuthenticator) Type() string {
+	if ca.allOf {
+		return AllOfType
+	}
+	return AnyOfType
+}
+
+func (ca CompositeAuthenticator) Initialize(config []byte) (Authenticator, error) {
+	initData, err := parseSubAuthenticatorInitData(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ca.subAuthenticators = make([]Authenticator, 0, len(initData))
+	for _, data := range initData {
+		subAuthenticator := ca.am.GetAuthenticatorByType(data.Type)
+		if subAuthenticator == nil {
+			return nil, errorsmod.Wrapf(types.ErrUnknownAuthenticatorType, "sub-authenticator type %s", data.Type)
+		}
+		initialized, err := subAuthenticator.Initialize(data.Config)
+		if err != nil {
+			return nil, err
+		}
+		ca.subAuthenticators = append(ca.subAuthenticators, initialized)
+	}
+	return ca, nil
+}
+
+func (ca CompositeAuthenticator) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	signatures := splitSignatures(request.Signature, len(ca.subAuthenticators))
+
+	var lastErr error
+	for i, subAuthenticator := range ca.subAuthenticators {
+		subRequest := subAuthenticationRequest(request, i)
+		subRequest.Signature = signatures[i]
+
+		if ca.allOf {
+			if err := subAuthenticator.Authenticate(ctx, subRequest); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Only the state changes of the sub-authenticator authenticating the message are kept.
+		cacheCtx, write := ctx.CacheContext()
+		if lastErr = subAuthenticator.Authenticate(cacheCtx, subRequest); lastErr == nil {
+			write()
+			return nil
+		}
+	}
+
+	if !ca.allOf {
+		return errorsmod.Wrapf(types.ErrAuthenticationFailed, "no sub-authenticator authenticated the message, last error: %v", lastErr)
+	}
+	return nil
+}
+
+func (ca CompositeAuthenticator) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	for i, subAuthenticator := range ca.subAuthenticators {
+		if err := subAuthenticator.Track(ctx, subAuthenticationRequest(request, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ca CompositeAuthenticator) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	var lastErr error
+	for i, subAuthenticator := range ca.subAuthenticators {
+		lastErr = subAuthenticator.ConfirmExecution(ctx, subAuthenticationRequest(request, i))
+		if ca.allOf && lastErr != nil {
+			return lastErr
+		}
+		if !ca.allOf && lastErr == nil {
+			return nil
+		}
+	}
+	return lastErr
+}
+
+// OnAuthenticatorAdded validates the sub-authenticators. Sub-authenticators that do not authenticate the signer,
+// e.g. a SpendLimit, cannot be part of an AnyOf authenticator, and must have a sibling authenticating the signer in
+// an AllOf authenticator, as the composite authenticator would otherwise authenticate messages without a signature.
+func (ca CompositeAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	initData, err := parseSubAuthenticatorInitData(config)
+	if err != nil {
+		return err
+	}
+
+	authenticatesSigner := false
+	for _, data := range initData {
+		if !isSignerAuthenticator(data.Type) {
+			if !ca.allOf {
+				return errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "sub-authenticator type %s does not authenticate the signer, it cannot be part of an AnyOf authenticator", data.Type)
+			}
+			continue
+		}
+		authenticatesSigner = true
+	}
+	if !authenticatesSigner {
+		return errorsmod.Wrap(types.ErrInvalidAuthenticatorConfig, "an AllOf authenticator must have a sub-authenticator authenticating the signer")
+	}
+
+	for i, data := range initData {
+		subAuthenticator := ca.am.GetAuthenticatorByType(data.Type)
+		if subAuthenticator == nil {
+			return errorsmod.Wrapf(types.ErrUnknownAuthenticatorType, "sub-authenticator type %s", data.Type)
+		}
+		if err := subAuthenticator.OnAuthenticatorAdded(ctx, account, data.Config, subAuthenticatorId(authenticatorId, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ca CompositeAuthenticator) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	initData, err := parseSubAuthenticatorInitData(config)
+	if err != nil {
+		return err
+	}
+
+	for i, data := range initData {
+		subAuthenticator := ca.am.GetAuthenticatorByType(data.Type)
+		if subAuthenticator == nil {
+			return errorsmod.Wrapf(types.ErrUnknownAuthenticatorType, "sub-authenticator type %s", data.Type)
+		}
+		if err := subAuthenticator.OnAuthenticatorRemoved(ctx, account, data.Config, subAuthenticatorId(authenticatorId, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseSubAuthenticatorInitData(config []byte) ([]SubAuthenticatorInitData, error) {
+	var initData []SubAuthenticatorInitData
+	if err := json.Unmarshal(config, &initData); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "invalid sub-authenticators: %v", err)
+	}
+	if len(initData) < 2 {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthenticatorConfig, "composite authenticators must have at least two sub-authenticators")
+	}
+	return initData, nil
+}
+
+// isSignerAuthenticator returns whether the authenticator type authenticates the signer of the messages, unlike a
+// SpendLimit, which authenticates every message and only restricts their execution. Composite authenticators authenticate
+// the signer, as their sub-authenticators are validated when they are added.
+func isSignerAuthenticator(authenticatorType string) bool {
+	return authenticatorType != SpendLimitType
+}
+
+// splitSignatures returns the signature of each of the n sub-authenticators.
+func splitSignatures(signature []byte, n int) [][]byte {
+	var signatures [][]byte
+	if err := json.Unmarshal(signature, &signatures); err == nil && len(signatures) == n {
+		return signatures
+	}
+
+	signatures = make([][]byte, n)
+	for i := range signatures {
+		signatures[i] = signature
+	}
+	return signatures
+}
+
+func subAuthenticatorId(authenticatorId string, index int) string {
+	return fmt.Sprintf("%s.%d", authenticatorId, index)
+}
+
+func subAuthenticationRequest(request AuthenticationRequest, index int) AuthenticationRequest {
+	request.AuthenticatorId = subAuthenticatorId(request.AuthenticatorId, index)
+	return request
+}
@@ -0,0 +1,143 @@
+package authenticator
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+const CosmwasmAuthenticatorType = "CosmwasmAuthenticator"
+
+// CosmwasmAuthenticatorConfig is the config of a CosmwasmAuthenticator.
+type CosmwasmAuthenticatorConfig struct {
+	// Contract is the address of the contract authenticating the messages.
+	Contract string `json:"contract"`
+	// Params are the parameters of the account passed to the contract, optional.
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// CosmwasmAuthenticatorRequest is the request passed to the sudo entry points of the contract.
+type CosmwasmAuthenticatorRequest struct {
+	AuthenticatorId     string          `json:"authenticator_id"`
+	Account             string          `json:"account"`
+	FeePayer            string          `json:"fee_payer,omitempty"`
+	Msg                 *CosmwasmAny    `json:"msg,omitempty"`
+	MsgIndex            uint64          `json:"msg_index"`
+	Signature           []byte          `json:"signature,omitempty"`
+	SignBytes           []byte          `json:"sign_bytes,omitempty"`
+	Simulate            bool            `json:"simulate"`
+	AuthenticatorParams json.RawMessage `json:"authenticator_params,omitempty"`
+}
+
+// CosmwasmAny is a proto encoded message, as passed to the contract.
+type CosmwasmAny struct {
+	TypeUrl string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+var _ Authenticator = CosmwasmAuthenticator{}
+
+// CosmwasmAuthenticator delegates the authentication of the messages of the account to a contract,
+// through its "authenticate", "track", "confirm_execution", "on_authenticator_added" and
+// "on_authenticator_removed" sudo entry points, each passed a CosmwasmAuthenticatorRequest.
+type CosmwasmAuthenticator struct {
+	contractKeeper types.ContractKeeper
+	wasmKeeper     types.WasmKeeper
+
+	contractAddr sdk.AccAddress
+	params       json.RawMessage
+}
+
+func NewCosmwasmAuthenticator(contractKeeper types.ContractKeeper, wasmKeeper types.WasmKeeper) CosmwasmAuthenticator {
+	return CosmwasmAuthenticator{contractKeeper: contractKeeper, wasmKeeper: wasmKeeper}
+}
+
+func (ca CosmwasmAuthenticator) Type() string {
+	return CosmwasmAuthenticatorType
+}
+
+func (ca CosmwasmAuthenticator) Initialize(config []byte) (Authenticator, error) {
+	var cosmwasmConfig CosmwasmAuthenticatorConfig
+	if err := json.Unmarshal(config, &cosmwasmConfig); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "invalid cosmwasm authenticator config: %v", err)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(cosmwasmConfig.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "invalid contract address: %v", err)
+	}
+	ca.contractAddr = contractAddr
+	ca.params = cosmwasmConfig.Params
+	return ca, nil
+}
+
+func (ca CosmwasmAuthenticator) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if err := ca.sudo(ctx, "authenticate", request); err != nil {
+		return errorsmod.Wrapf(types.ErrAuthenticationFailed, "contract %s: %v", ca.contractAddr, err)
+	}
+	return nil
+}
+
+func (ca CosmwasmAuthenticator) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return ca.sudo(ctx, "track", request)
+}
+
+func (ca CosmwasmAuthenticator) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	if err := ca.sudo(ctx, "confirm_execution", request); err != nil {
+		return errorsmod.Wrapf(types.ErrExecutionRejected, "contract %s: %v", ca.contractAddr, err)
+	}
+	return nil
+}
+
+func (ca CosmwasmAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	initialized, err := ca.Initialize(config)
+	if err != nil {
+		return err
+	}
+	ca = initialized.(CosmwasmAuthenticator)
+	if !ca.wasmKeeper.HasContractInfo(ctx, ca.contractAddr) {
+		return errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "contract %s does not exist", ca.contractAddr)
+	}
+	return ca.sudo(ctx, "on_authenticator_added", AuthenticationRequest{AuthenticatorId: authenticatorId, Account: account})
+}
+
+func (ca CosmwasmAuthenticator) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	initialized, err := ca.Initialize(config)
+	if err != nil {
+		return err
+	}
+	ca = initialized.(CosmwasmAuthenticator)
+	return ca.sudo(ctx, "on_authenticator_removed", AuthenticationRequest{AuthenticatorId: authenticatorId, Account: account})
+}
+
+func (ca CosmwasmAuthenticator) sudo(ctx sdk.Context, entryPoint string, request AuthenticationRequest) error {
+	contractRequest := CosmwasmAuthenticatorRequest{
+		AuthenticatorId:     request.AuthenticatorId,
+		Account:             request.Account.String(),
+		MsgIndex:            request.MsgIndex,
+		Signature:           request.Signature,
+		SignBytes:           request.SignBytes,
+		Simulate:            request.Simulate,
+		AuthenticatorParams: ca.params,
+	}
+	if request.FeePayer != nil {
+		contractRequest.FeePayer = request.FeePayer.String()
+	}
+	if request.Msg != nil {
+		msgAny, err := codectypes.NewAnyWithValue(request.Msg)
+		if err != nil {
+			return err
+		}
+		contractRequest.Msg = &CosmwasmAny{TypeUrl: msgAny.TypeUrl, Value: msgAny.Value}
+	}
+
+	bz, err := json.Marshal(map[string]CosmwasmAuthenticatorRequest{entryPoint: contractRequest})
+	if err != nil {
+		return err
+	}
+	_, err = ca.contractKeeper.Sudo(ctx, ca.contractAddr, bz)
+	return err
+}
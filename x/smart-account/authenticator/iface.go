@@ -0,0 +1,35 @@
+package authenticator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authenticator is a type of authenticator accounts can register to authenticate their messages, in place of
+// the signature verification of their public key.
+//
+// The registered authenticator of an account is its type, registered in the AuthenticatorManager, initialized
+// with the config stored for the account.
+type Authenticator interface {
+	// Type returns the type of the authenticator, unique among the registered authenticators.
+	Type() string
+
+	// Initialize returns the authenticator configured with the config registered by an account.
+	Initialize(config []byte) (Authenticator, error)
+
+	// Authenticate authenticates a message of a tx on behalf of its signer, in the ante handler.
+	Authenticate(ctx sdk.Context, request AuthenticationRequest) error
+
+	// Track is called in the ante handler once the messages of the tx are authenticated, once per tx
+	// for each selected authenticator, before the messages are executed.
+	Track(ctx sdk.Context, request AuthenticationRequest) error
+
+	// ConfirmExecution is called in the post handler after the messages of the tx are executed, once per tx
+	// for each selected authenticator. Returning an error rejects the tx, reverting its messages.
+	ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error
+
+	// OnAuthenticatorAdded validates the config of the authenticator when an account registers it.
+	OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error
+
+	// OnAuthenticatorRemoved is called when an account removes the authenticator.
+	OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error
+}
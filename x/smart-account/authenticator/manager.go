@@ -0,0 +1,42 @@
+package authenticator
+
+// AuthenticatorManager is the registry of the types of authenticators accounts can register.
+type AuthenticatorManager struct {
+	registeredAuthenticators map[string]Authenticator
+	orderedTypes             []string
+}
+
+// NewAuthenticatorManager creates an AuthenticatorManager without registered authenticators.
+func NewAuthenticatorManager() *AuthenticatorManager {
+	return &AuthenticatorManager{
+		registeredAuthenticators: make(map[string]Authenticator),
+	}
+}
+
+// RegisterAuthenticator registers a type of authenticator, replacing any authenticator of the same type.
+func (am *AuthenticatorManager) RegisterAuthenticator(authenticator Authenticator) {
+	if _, ok := am.registeredAuthenticators[authenticator.Type()]; !ok {
+		am.orderedTypes = append(am.orderedTypes, authenticator.Type())
+	}
+	am.registeredAuthenticators[authenticator.Type()] = authenticator
+}
+
+// GetRegisteredAuthenticators returns the registered authenticators, in registration order.
+func (am *AuthenticatorManager) GetRegisteredAuthenticators() []Authenticator {
+	authenticators := make([]Authenticator, 0, len(am.orderedTypes))
+	for _, authenticatorType := range am.orderedTypes {
+		authenticators = append(authenticators, am.registeredAuthenticators[authenticatorType])
+	}
+	return authenticators
+}
+
+// IsAuthenticatorTypeRegistered returns whether an authenticator type is registered.
+func (am *AuthenticatorManager) IsAuthenticatorTypeRegistered(authenticatorType string) bool {
+	_, ok := am.registeredAuthenticators[authenticatorType]
+	return ok
+}
+
+// GetAuthenticatorByType returns the registered authenticator of the given type, nil if not registered.
+func (am *AuthenticatorManager) GetAuthenticatorByType(authenticatorType string) Authenticator {
+	return am.registeredAuthenticators[authenticatorType]
+}
@@ -0,0 +1,25 @@
+package authenticator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthenticationRequest is the message of a tx to authenticate on behalf of its signer, the account.
+type AuthenticationRequest struct {
+	// AuthenticatorId is the id of the authenticator. The id of a sub-authenticator of a composite
+	// authenticator is composed of the id of its parent and its index, e.g. "2.1".
+	AuthenticatorId string
+
+	Account  sdk.AccAddress
+	FeePayer sdk.AccAddress
+
+	Msg      sdk.Msg
+	MsgIndex uint64
+
+	// Signature is the signature of the account in the tx, signing SignBytes.
+	Signature []byte
+	SignBytes []byte
+
+	// Simulate is whether the tx is simulated, in which case it is not signed.
+	Simulate bool
+}
@@ -0,0 +1,68 @@
+package authenticator
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+const SignatureVerificationType = "SignatureVerification"
+
+var _ Authenticator = SignatureVerification{}
+
+// SignatureVerification authenticates the messages of an account signed by the secp256k1 public key of its config,
+// which does not need to be the public key of the account, e.g. to rotate the key of an account.
+type SignatureVerification struct {
+	ak     types.AccountKeeper
+	pubKey *secp256k1.PubKey
+}
+
+func NewSignatureVerification(ak types.AccountKeeper) SignatureVerification {
+	return SignatureVerification{ak: ak}
+}
+
+func (sv SignatureVerification) Type() string {
+	return SignatureVerificationType
+}
+
+// Initialize configures the authenticator with a compressed secp256k1 public key.
+func (sv SignatureVerification) Initialize(config []byte) (Authenticator, error) {
+	if len(config) != secp256k1.PubKeySize || (config[0] != 0x02 && config[0] != 0x03) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "config must be a %d bytes compressed secp256k1 public key", secp256k1.PubKeySize)
+	}
+	sv.pubKey = &secp256k1.PubKey{Key: config}
+	return sv, nil
+}
+
+// Authenticate verifies the signature of the request, consuming the secp256k1 verification gas of the auth params.
+// The signature is not verified when simulating.
+func (sv SignatureVerification) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	ctx.GasMeter().ConsumeGas(sv.ak.GetParams(ctx).SigVerifyCostSecp256k1, "ante verify: secp256k1")
+	if request.Simulate {
+		return nil
+	}
+
+	if !sv.pubKey.VerifySignature(request.SignBytes, request.Signature) {
+		return errorsmod.Wrapf(types.ErrAuthenticationFailed, "signature verification failed for account %s", request.Account)
+	}
+	return nil
+}
+
+func (sv SignatureVerification) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (sv SignatureVerification) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (sv SignatureVerification) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, err := sv.Initialize(config)
+	return err
+}
+
+func (sv SignatureVerification) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	return nil
+}
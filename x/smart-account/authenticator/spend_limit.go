@@ -0,0 +1,112 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+const SpendLimitType = "SpendLimit"
+
+// SpendLimitConfig is the config of a SpendLimit authenticator.
+type SpendLimitConfig struct {
+	// Limit is the maximum amount of each denom the account can spend per tx.
+	Limit sdk.Coins `json:"limit"`
+}
+
+var _ Authenticator = SpendLimit{}
+
+// SpendLimit rejects the txs decreasing the balance of the account by more than its limit.
+// It authenticates every message, so it can only be registered as a sub-authenticator of an AllOf
+// authenticator, combined with an authenticator verifying the signature of the account.
+//
+// The balances of the account are tracked in the transient store of the module before the messages are
+// executed, and compared to its balances after their execution.
+type SpendLimit struct {
+	bk           types.BankKeeper
+	transientKey storetypes.StoreKey
+	limit        sdk.Coins
+}
+
+func NewSpendLimit(bk types.BankKeeper, transientKey storetypes.StoreKey) SpendLimit {
+	return SpendLimit{bk: bk, transientKey: transientKey}
+}
+
+func (sl SpendLimit) Type() string {
+	return SpendLimitType
+}
+
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	var spendLimitConfig SpendLimitConfig
+	if err := json.Unmarshal(config, &spendLimitConfig); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "invalid spend limit: %v", err)
+	}
+	if spendLimitConfig.Limit.Empty() {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthenticatorConfig, "spend limit cannot be empty")
+	}
+	if err := spendLimitConfig.Limit.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthenticatorConfig, "invalid spend limit: %v", err)
+	}
+	sl.limit = spendLimitConfig.Limit
+	return sl, nil
+}
+
+func (sl SpendLimit) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// Track records the balances of the limited denoms of the account before the messages are executed.
+func (sl SpendLimit) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	balances := sdk.NewCoins()
+	for _, coin := range sl.limit {
+		balances = balances.Add(sl.bk.GetBalance(ctx, request.Account, coin.Denom))
+	}
+
+	bz, err := json.Marshal(balances)
+	if err != nil {
+		return err
+	}
+	ctx.TransientStore(sl.transientKey).Set(types.KeyAuthenticatorTracking(request.Account, request.AuthenticatorId), bz)
+	return nil
+}
+
+// ConfirmExecution rejects the tx if the account spent more than its limit of any denom.
+func (sl SpendLimit) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	store := ctx.TransientStore(sl.transientKey)
+	key := types.KeyAuthenticatorTracking(request.Account, request.AuthenticatorId)
+	bz := store.Get(key)
+	if bz == nil {
+		return errorsmod.Wrapf(types.ErrExecutionRejected, "balances of account %s were not tracked", request.Account)
+	}
+	store.Delete(key)
+
+	var balancesBefore sdk.Coins
+	if err := json.Unmarshal(bz, &balancesBefore); err != nil {
+		return err
+	}
+
+	for _, coin := range sl.limit {
+		spent := balancesBefore.AmountOf(coin.Denom).Sub(sl.bk.GetBalance(ctx, request.Account, coin.Denom).Amount)
+		if spent.GT(coin.Amount) {
+			return errorsmod.Wrapf(types.ErrExecutionRejected, "spent %s%s, spend limit is %s", spent, coin.Denom, coin)
+		}
+	}
+	return nil
+}
+
+func (sl SpendLimit) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	if !strings.Contains(authenticatorId, ".") {
+		return errorsmod.Wrap(types.ErrInvalidAuthenticatorConfig, "spend limit must be a sub-authenticator of an AllOf authenticator verifying signatures")
+	}
+	_, err := sl.Initialize(config)
+	return err
+}
+
+func (sl SpendLimit) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	return nil
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticators)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticator)
+
+	cmd.AddCommand(
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
+	)
+
+	return cmd
+}
+
+func GetCmdAuthenticators() (*osmocli.QueryDescriptor, *types.GetAuthenticatorsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "authenticators",
+		Short: "Query the authenticators of an account",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} osmo1...`,
+	}, &types.GetAuthenticatorsRequest{}
+}
+
+func GetCmdAuthenticator() (*osmocli.QueryDescriptor, *types.GetAuthenticatorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "authenticator",
+		Short: "Query an authenticator of an account by id",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} osmo1... 1`,
+	}, &types.GetAuthenticatorRequest{}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"encoding/base64"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := osmocli.TxIndexCmd(types.ModuleName)
+	cmd.AddCommand(
+		NewAddAuthenticatorCmd(),
+		NewRemoveAuthenticatorCmd(),
+		NewSetActiveStateCmd(),
+	)
+
+	return cmd
+}
+
+func NewAddAuthenticatorCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgAddAuthenticator](&osmocli.TxCliDesc{
+		Use:   "add-authenticator",
+		Short: "add an authenticator to the sender account, with its config encoded in base64",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} SignatureVerification A0h2...base64 compressed secp256k1 public key`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Config": parseBase64Config,
+		},
+	})
+}
+
+func NewRemoveAuthenticatorCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgRemoveAuthenticator](&osmocli.TxCliDesc{
+		Use:   "remove-authenticator",
+		Short: "remove an authenticator of the sender account by id",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} 1`,
+	})
+}
+
+func NewSetActiveStateCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetActiveState](&osmocli.TxCliDesc{
+		Use:   "set-active-state",
+		Short: "activate or deactivate smart accounts, circuit breaker controllers can only deactivate them",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} false`,
+	})
+}
+
+func parseBase64Config(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	config, err := base64.StdEncoding.DecodeString(arg)
+	return config, osmocli.UsedArg, err
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+// InitGenesis initializes the smartaccount module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.SetNextAuthenticatorId(ctx, genState.NextAuthenticatorId)
+
+	for _, data := range genState.AuthenticatorData {
+		account := sdk.MustAccAddressFromBech32(data.Address)
+		for _, accountAuthenticator := range data.Authenticators {
+			k.SetAccountAuthenticator(ctx, account, accountAuthenticator)
+		}
+	}
+}
+
+// ExportGenesis returns the smartaccount module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	authenticatorData, err := k.GetAllAuthenticatorData(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:              k.GetParams(ctx),
+		NextAuthenticatorId: k.GetNextAuthenticatorId(ctx),
+		AuthenticatorData:   authenticatorData,
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(sdkCtx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (k Keeper) GetAuthenticators(ctx context.Context, req *types.GetAuthenticatorsRequest) (*types.GetAuthenticatorsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	account, err := sdk.AccAddressFromBech32(req.GetAccount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	authenticators, err := k.GetAuthenticatorsForAccount(sdkCtx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetAuthenticatorsResponse{AccountAuthenticators: authenticators}, nil
+}
+
+func (k Keeper) GetAuthenticator(ctx context.Context, req *types.GetAuthenticatorRequest) (*types.GetAuthenticatorResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	account, err := sdk.AccAddressFromBech32(req.GetAccount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	authenticator, err := k.GetAccountAuthenticator(sdkCtx, account, req.GetAuthenticatorId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetAuthenticatorResponse{AccountAuthenticator: authenticator}, nil
+}
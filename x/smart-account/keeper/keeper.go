@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+type Keeper struct {
+	storeKey storetypes.StoreKey
+
+	AuthenticatorManager *authenticator.AuthenticatorManager
+
+	// the address capable of executing a MsgSetActiveState message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper returns a new instance of the x/smartaccount keeper.
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	authenticatorManager *authenticator.AuthenticatorManager,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:             storeKey,
+		AuthenticatorManager: authenticatorManager,
+		authority:            authority,
+	}
+}
+
+// Logger returns a logger for the x/smartaccount module.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetNextAuthenticatorId returns the id of the next registered authenticator.
+func (k Keeper) GetNextAuthenticatorId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextAuthenticatorId)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextAuthenticatorId sets the id of the next registered authenticator.
+func (k Keeper) SetNextAuthenticatorId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextAuthenticatorId, sdk.Uint64ToBigEndian(id))
+}
+
+// AddAuthenticator registers an authenticator of the given type and config for the account,
+// returning its id.
+func (k Keeper) AddAuthenticator(ctx sdk.Context, account sdk.AccAddress, authenticatorType string, config []byte) (uint64, error) {
+	impl := k.AuthenticatorManager.GetAuthenticatorByType(authenticatorType)
+	if impl == nil {
+		return 0, errorsmod.Wrapf(types.ErrUnknownAuthenticatorType, "authenticator type %s is not registered", authenticatorType)
+	}
+	if _, err := impl.Initialize(config); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextAuthenticatorId(ctx)
+	if err := impl.OnAuthenticatorAdded(ctx, account, config, fmt.Sprint(id)); err != nil {
+		return 0, err
+	}
+
+	k.SetAccountAuthenticator(ctx, account, types.AccountAuthenticator{
+		Id:     id,
+		Type:   authenticatorType,
+		Config: config,
+	})
+	k.SetNextAuthenticatorId(ctx, id+1)
+	return id, nil
+}
+
+// RemoveAuthenticator removes the authenticator of the account with the given id.
+func (k Keeper) RemoveAuthenticator(ctx sdk.Context, account sdk.AccAddress, id uint64) error {
+	accountAuthenticator, err := k.GetAccountAuthenticator(ctx, account, id)
+	if err != nil {
+		return err
+	}
+
+	impl := k.AuthenticatorManager.GetAuthenticatorByType(accountAuthenticator.Type)
+	if impl == nil {
+		return errorsmod.Wrapf(types.ErrUnknownAuthenticatorType, "authenticator type %s is not registered", accountAuthenticator.Type)
+	}
+	if err := impl.OnAuthenticatorRemoved(ctx, account, accountAuthenticator.Config, fmt.Sprint(id)); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyAccountAuthenticator(account, id))
+	return nil
+}
+
+// SetAccountAuthenticator stores an authenticator of the account.
+func (k Keeper) SetAccountAuthenticator(ctx sdk.Context, account sdk.AccAddress, accountAuthenticator types.AccountAuthenticator) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyAccountAuthenticator(account, accountAuthenticator.Id), &accountAuthenticator)
+}
+
+// GetAccountAuthenticator returns the authenticator of the account with the given id.
+func (k Keeper) GetAccountAuthenticator(ctx sdk.Context, account sdk.AccAddress, id uint64) (types.AccountAuthenticator, error) {
+	accountAuthenticator := types.AccountAuthenticator{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyAccountAuthenticator(account, id), &accountAuthenticator)
+	if err != nil {
+		return types.AccountAuthenticator{}, err
+	}
+	if !found {
+		return types.AccountAuthenticator{}, errorsmod.Wrapf(types.ErrAuthenticatorNotFound, "account %s, authenticator id %d", account, id)
+	}
+	return accountAuthenticator, nil
+}
+
+// GetAuthenticatorsForAccount returns the authenticators of the account, ordered by id.
+func (k Keeper) GetAuthenticatorsForAccount(ctx sdk.Context, account sdk.AccAddress) ([]types.AccountAuthenticator, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyAccountAuthenticators(account), parseAccountAuthenticator)
+}
+
+// GetInitializedAuthenticatorForAccount returns the authenticator of the account with the given id,
+// initialized with its config.
+func (k Keeper) GetInitializedAuthenticatorForAccount(ctx sdk.Context, account sdk.AccAddress, id uint64) (authenticator.Authenticator, error) {
+	accountAuthenticator, err := k.GetAccountAuthenticator(ctx, account, id)
+	if err != nil {
+		return nil, err
+	}
+
+	impl := k.AuthenticatorManager.GetAuthenticatorByType(accountAuthenticator.Type)
+	if impl == nil {
+		return nil, errorsmod.Wrapf(types.ErrUnknownAuthenticatorType, "authenticator type %s is not registered", accountAuthenticator.Type)
+	}
+	return impl.Initialize(accountAuthenticator.Config)
+}
+
+// GetAllAuthenticatorData returns the authenticators of every account.
+func (k Keeper) GetAllAuthenticatorData(ctx sdk.Context) ([]types.AuthenticatorData, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyAccountAuthenticatorsPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	authenticatorData := []types.AuthenticatorData{}
+	for ; iterator.Valid(); iterator.Next() {
+		// keys are the length prefixed account followed by the authenticator id.
+		key := iterator.Key()
+		account := sdk.AccAddress(key[1 : 1+int(key[0])])
+
+		accountAuthenticator, err := parseAccountAuthenticator(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		last := len(authenticatorData) - 1
+		if last < 0 || authenticatorData[last].Address != account.String() {
+			authenticatorData = append(authenticatorData, types.AuthenticatorData{Address: account.String()})
+			last++
+		}
+		authenticatorData[last].Authenticators = append(authenticatorData[last].Authenticators, accountAuthenticator)
+	}
+	return authenticatorData, nil
+}
+
+func parseAccountAuthenticator(bz []byte) (types.AccountAuthenticator, error) {
+	accountAuthenticator := types.AccountAuthenticator{}
+	err := proto.Unmarshal(bz, &accountAuthenticator)
+	return accountAuthenticator, err
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	msgServer types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.msgServer = keeper.NewMsgServerImpl(*s.App.SmartAccountKeeper)
+}
+
+func (s *KeeperTestSuite) signatureVerificationConfig() []byte {
+	return secp256k1.GenPrivKey().PubKey().Bytes()
+}
+
+func (s *KeeperTestSuite) compositeConfig(subAuthenticators ...authenticator.SubAuthenticatorInitData) []byte {
+	config, err := json.Marshal(subAuthenticators)
+	s.Require().NoError(err)
+	return config
+}
+
+func (s *KeeperTestSuite) TestAddAuthenticator() {
+	spendLimitConfig := []byte(`{"limit":[{"denom":"uosmo","amount":"100"}]}`)
+
+	tests := map[string]struct {
+		authenticatorType string
+		config            []byte
+		expectedErr       error
+	}{
+		"signature verification": {
+			authenticatorType: authenticator.SignatureVerificationType,
+			config:            s.signatureVerificationConfig(),
+		},
+		"all of signature verification and spend limit": {
+			authenticatorType: authenticator.AllOfType,
+			config: s.compositeConfig(
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SpendLimitType, Config: spendLimitConfig},
+			),
+		},
+		"unknown type": {
+			authenticatorType: "Unknown",
+			config:            s.signatureVerificationConfig(),
+			expectedErr:       types.ErrUnknownAuthenticatorType,
+		},
+		"invalid signature verification config": {
+			authenticatorType: authenticator.SignatureVerificationType,
+			config:            []byte("not a public key"),
+			expectedErr:       types.ErrInvalidAuthenticatorConfig,
+		},
+		"spend limit is not a sub-authenticator": {
+			authenticatorType: authenticator.SpendLimitType,
+			config:            spendLimitConfig,
+			expectedErr:       types.ErrInvalidAuthenticatorConfig,
+		},
+		"all of with a single sub-authenticator": {
+			authenticatorType: authenticator.AllOfType,
+			config: s.compositeConfig(
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+			),
+			expectedErr: types.ErrInvalidAuthenticatorConfig,
+		},
+		"any of spend limit and signature verification": {
+			authenticatorType: authenticator.AnyOfType,
+			config: s.compositeConfig(
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SpendLimitType, Config: spendLimitConfig},
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+			),
+			expectedErr: types.ErrInvalidAuthenticatorConfig,
+		},
+		"all of spend limits only": {
+			authenticatorType: authenticator.AllOfType,
+			config: s.compositeConfig(
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SpendLimitType, Config: spendLimitConfig},
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SpendLimitType, Config: spendLimitConfig},
+			),
+			expectedErr: types.ErrInvalidAuthenticatorConfig,
+		},
+		"any of signature verifications": {
+			authenticatorType: authenticator.AnyOfType,
+			config: s.compositeConfig(
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+				authenticator.SubAuthenticatorInitData{Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+			),
+		},
+		"cosmwasm authenticator of a missing contract": {
+			authenticatorType: authenticator.CosmwasmAuthenticatorType,
+			config:            []byte(`{"contract":"` + apptesting.CreateRandomAccounts(1)[0].String() + `"}`),
+			expectedErr:       types.ErrInvalidAuthenticatorConfig,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			account := s.TestAccs[0]
+
+			id, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, tc.authenticatorType, tc.config)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Equal(uint64(0), s.App.SmartAccountKeeper.GetNextAuthenticatorId(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(0), id)
+			s.Require().Equal(uint64(1), s.App.SmartAccountKeeper.GetNextAuthenticatorId(s.Ctx))
+
+			authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorsForAccount(s.Ctx, account)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.AccountAuthenticator{{Id: id, Type: tc.authenticatorType, Config: tc.config}}, authenticators)
+
+			initialized, err := s.App.SmartAccountKeeper.GetInitializedAuthenticatorForAccount(s.Ctx, account, id)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.authenticatorType, initialized.Type())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemoveAuthenticator() {
+	account := s.TestAccs[0]
+	firstId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, s.signatureVerificationConfig())
+	s.Require().NoError(err)
+	secondConfig := s.signatureVerificationConfig()
+	secondId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, secondConfig)
+	s.Require().NoError(err)
+	s.Require().Equal(firstId+1, secondId)
+
+	// The authenticators of an account can only be removed by the account.
+	err = s.App.SmartAccountKeeper.RemoveAuthenticator(s.Ctx, s.TestAccs[1], firstId)
+	s.Require().ErrorIs(err, types.ErrAuthenticatorNotFound)
+
+	err = s.App.SmartAccountKeeper.RemoveAuthenticator(s.Ctx, account, firstId)
+	s.Require().NoError(err)
+
+	authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorsForAccount(s.Ctx, account)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.AccountAuthenticator{{Id: secondId, Type: authenticator.SignatureVerificationType, Config: secondConfig}}, authenticators)
+
+	err = s.App.SmartAccountKeeper.RemoveAuthenticator(s.Ctx, account, firstId)
+	s.Require().ErrorIs(err, types.ErrAuthenticatorNotFound)
+
+	// Ids are not reused.
+	thirdId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, authenticator.SignatureVerificationType, s.signatureVerificationConfig())
+	s.Require().NoError(err)
+	s.Require().Equal(secondId+1, thirdId)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	genesis := types.GenesisState{
+		Params:              types.NewParams(true, []string{s.TestAccs[2].String()}, types.DefaultMaximumUnauthenticatedGas),
+		NextAuthenticatorId: 3,
+		AuthenticatorData: []types.AuthenticatorData{
+			{
+				Address: s.TestAccs[0].String(),
+				Authenticators: []types.AccountAuthenticator{
+					{Id: 0, Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+					{Id: 2, Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+				},
+			},
+			{
+				Address: s.TestAccs[1].String(),
+				Authenticators: []types.AccountAuthenticator{
+					{Id: 1, Type: authenticator.SignatureVerificationType, Config: s.signatureVerificationConfig()},
+				},
+			},
+		},
+	}
+	s.Require().NoError(genesis.Validate())
+
+	s.App.SmartAccountKeeper.InitGenesis(s.Ctx, genesis)
+	exported := s.App.SmartAccountKeeper.ExportGenesis(s.Ctx)
+
+	s.Require().Equal(genesis.Params, exported.Params)
+	s.Require().Equal(genesis.NextAuthenticatorId, exported.NextAuthenticatorId)
+	s.Require().ElementsMatch(genesis.AuthenticatorData, exported.AuthenticatorData)
+}
+
+func (s *KeeperTestSuite) TestGetAuthenticatorsForAccountWithoutAuthenticators() {
+	authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorsForAccount(s.Ctx, s.TestAccs[0])
+	s.Require().NoError(err)
+	s.Require().Empty(authenticators)
+
+	_, err = s.App.SmartAccountKeeper.GetAccountAuthenticator(s.Ctx, s.TestAccs[0], 0)
+	s.Require().ErrorIs(err, types.ErrAuthenticatorNotFound)
+
+	// An account whose address prefixes another account's address does not see its authenticators.
+	_, err = s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, sdk.AccAddress(append(s.TestAccs[0].Bytes(), 0x01)), authenticator.SignatureVerificationType, s.signatureVerificationConfig())
+	s.Require().NoError(err)
+	authenticators, err = s.App.SmartAccountKeeper.GetAuthenticatorsForAccount(s.Ctx, s.TestAccs[0])
+	s.Require().NoError(err)
+	s.Require().Empty(authenticators)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) AddAuthenticator(goCtx context.Context, msg *types.MsgAddAuthenticator) (*types.MsgAddAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := server.Keeper.AddAuthenticator(ctx, sender, msg.AuthenticatorType, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgAddAuthenticator,
+			sdk.NewAttribute(types.AttributeAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeAuthenticatorType, msg.AuthenticatorType),
+			sdk.NewAttribute(types.AttributeAuthenticatorId, fmt.Sprint(id)),
+		),
+	})
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+func (server msgServer) RemoveAuthenticator(goCtx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.Keeper.RemoveAuthenticator(ctx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRemoveAuthenticator,
+			sdk.NewAttribute(types.AttributeAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeAuthenticatorId, fmt.Sprint(msg.Id)),
+		),
+	})
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
+
+// SetActiveState sets whether smart accounts are active. The authority can activate or deactivate them,
+// while the circuit breaker controllers can only deactivate them.
+func (server msgServer) SetActiveState(goCtx context.Context, msg *types.MsgSetActiveState) (*types.MsgSetActiveStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Sender != server.authority {
+		if msg.Active {
+			return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only %s can activate smart accounts", server.authority)
+		}
+		if !osmoutils.Contains(server.GetParams(ctx).CircuitBreakerControllers, msg.Sender) {
+			return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a circuit breaker controller", msg.Sender)
+		}
+	}
+
+	server.SetIsSmartAccountActive(ctx, msg.Active)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetActiveState,
+			sdk.NewAttribute(types.AttributeSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeActive, fmt.Sprint(msg.Active)),
+		),
+	})
+
+	return &types.MsgSetActiveStateResponse{}, nil
+}
+
+// UpdateParams sets the smartaccount module parameters, it can only be executed by governance.
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != server.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	server.SetParams(ctx, msg.Params)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+func (s *KeeperTestSuite) TestMsgAddRemoveAuthenticator() {
+	sender := s.TestAccs[0].String()
+	config := s.signatureVerificationConfig()
+
+	addResponse, err := s.msgServer.AddAuthenticator(sdk.WrapSDKContext(s.Ctx), types.NewMsgAddAuthenticator(sender, authenticator.SignatureVerificationType, config))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeMsgAddAuthenticator, 1)
+
+	queryResponse, err := s.App.SmartAccountKeeper.GetAuthenticator(sdk.WrapSDKContext(s.Ctx), &types.GetAuthenticatorRequest{Account: sender, AuthenticatorId: addResponse.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(types.AccountAuthenticator{Id: addResponse.Id, Type: authenticator.SignatureVerificationType, Config: config}, queryResponse.AccountAuthenticator)
+
+	_, err = s.msgServer.RemoveAuthenticator(sdk.WrapSDKContext(s.Ctx), types.NewMsgRemoveAuthenticator(sender, addResponse.Id))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeMsgRemoveAuthenticator, 1)
+
+	queriedAuthenticators, err := s.App.SmartAccountKeeper.GetAuthenticators(sdk.WrapSDKContext(s.Ctx), &types.GetAuthenticatorsRequest{Account: sender})
+	s.Require().NoError(err)
+	s.Require().Empty(queriedAuthenticators.AccountAuthenticators)
+}
+
+func (s *KeeperTestSuite) TestMsgSetActiveState() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	controller := s.TestAccs[0].String()
+
+	tests := map[string]struct {
+		initialActive bool
+		sender        string
+		active        bool
+		expectedErr   error
+	}{
+		"authority activates smart accounts": {
+			initialActive: false,
+			sender:        authority,
+			active:        true,
+		},
+		"authority deactivates smart accounts": {
+			initialActive: true,
+			sender:        authority,
+			active:        false,
+		},
+		"circuit breaker controller deactivates smart accounts": {
+			initialActive: true,
+			sender:        controller,
+			active:        false,
+		},
+		"circuit breaker controller cannot activate smart accounts": {
+			initialActive: false,
+			sender:        controller,
+			active:        true,
+			expectedErr:   types.ErrUnauthorized,
+		},
+		"other accounts cannot deactivate smart accounts": {
+			initialActive: true,
+			sender:        s.TestAccs[1].String(),
+			active:        false,
+			expectedErr:   types.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.SmartAccountKeeper.SetParams(s.Ctx, types.NewParams(tc.initialActive, []string{controller}, types.DefaultMaximumUnauthenticatedGas))
+
+			_, err := s.msgServer.SetActiveState(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetActiveState(tc.sender, tc.active))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Equal(tc.initialActive, s.App.SmartAccountKeeper.GetIsSmartAccountActive(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.active, s.App.SmartAccountKeeper.GetIsSmartAccountActive(s.Ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := map[string]struct {
+		authority    string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		"authority sets the params": {
+			authority: authority,
+			updateParams: func(params *types.Params) {
+				params.IsSmartAccountActive = true
+				params.CircuitBreakerControllers = []string{s.TestAccs[0].String()}
+				params.MaximumUnauthenticatedGas = 2 * types.DefaultMaximumUnauthenticatedGas
+			},
+		},
+		"circuit breaker controllers cannot set the params": {
+			authority:    s.TestAccs[0].String(),
+			updateParams: func(params *types.Params) { params.MaximumUnauthenticatedGas++ },
+			expectedErr:  "invalid authority",
+		},
+		"invalid circuit breaker controller": {
+			authority:    authority,
+			updateParams: func(params *types.Params) { params.CircuitBreakerControllers = []string{"invalid"} },
+			expectedErr:  "invalid circuit breaker controller",
+		},
+		"zero maximum unauthenticated gas": {
+			authority:    authority,
+			updateParams: func(params *types.Params) { params.MaximumUnauthenticatedGas = 0 },
+			expectedErr:  "maximum unauthenticated gas must be positive",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.SmartAccountKeeper.SetParams(s.Ctx, types.NewParams(false, []string{s.TestAccs[0].String()}, types.DefaultMaximumUnauthenticatedGas))
+			originalParams := s.App.SmartAccountKeeper.GetParams(s.Ctx)
+			newParams := s.App.SmartAccountKeeper.GetParams(s.Ctx)
+			tc.updateParams(&newParams)
+
+			_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(tc.authority, newParams))
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, s.App.SmartAccountKeeper.GetParams(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, s.App.SmartAccountKeeper.GetParams(s.Ctx))
+		})
+	}
+}
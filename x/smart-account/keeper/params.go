@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+// GetParams returns the total set params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
+	return params
+}
+
+// SetParams sets the total set of params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyParams, &params)
+}
+
+// GetIsSmartAccountActive returns whether the authenticators of the accounts are used to authenticate txs.
+// It is false until the params are set, e.g. for the gentxs delivered during InitChain.
+func (k Keeper) GetIsSmartAccountActive(ctx sdk.Context) bool {
+	var params types.Params
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyParams, &params)
+	if err != nil {
+		panic(err)
+	}
+	return found && params.IsSmartAccountActive
+}
+
+// SetIsSmartAccountActive sets whether the authenticators of the accounts are used to authenticate txs.
+func (k Keeper) SetIsSmartAccountActive(ctx sdk.Context, isSmartAccountActive bool) {
+	params := k.GetParams(ctx)
+	params.IsSmartAccountActive = isSmartAccountActive
+	k.SetParams(ctx, params)
+}
+
+// GetMaximumUnauthenticatedGas returns the maximum gas the authentication of the fee payer can consume before the fees are deducted.
+func (k Keeper) GetMaximumUnauthenticatedGas(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaximumUnauthenticatedGas
+}
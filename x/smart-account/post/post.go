@@ -0,0 +1,48 @@
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/ante"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/smart-account/types"
+)
+
+// AuthenticatorPostDecorator asks the authenticators of the txs authenticated by the AuthenticatorDecorator
+// to confirm their execution, reverting the messages of the tx if any of them rejects it.
+type AuthenticatorPostDecorator struct {
+	smartAccountKeeper keeper.Keeper
+}
+
+func NewAuthenticatorPostDecorator(smartAccountKeeper keeper.Keeper) AuthenticatorPostDecorator {
+	return AuthenticatorPostDecorator{smartAccountKeeper: smartAccountKeeper}
+}
+
+func (apd AuthenticatorPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	if !ante.IsAuthenticatorTx(ctx, apd.smartAccountKeeper, tx) {
+		return next(ctx, tx, simulate, success)
+	}
+
+	extension := types.GetTxExtension(tx)
+	confirmed := map[string]bool{}
+	for msgIndex, msg := range tx.GetMsgs() {
+		request, auth, err := ante.GetAuthenticationRequest(ctx, apd.smartAccountKeeper, msg, msgIndex, extension.SelectedAuthenticators[msgIndex])
+		if err != nil {
+			return ctx, err
+		}
+		request.Simulate = simulate
+
+		// Authenticators confirm the execution of the tx once, as they tracked it once.
+		confirmationKey := request.Account.String() + "/" + request.AuthenticatorId
+		if confirmed[confirmationKey] {
+			continue
+		}
+		confirmed[confirmationKey] = true
+		if err := auth.ConfirmExecution(ctx, request); err != nil {
+			return ctx, errorsmod.Wrapf(err, "authenticator %s of account %s rejected the execution of the tx", request.AuthenticatorId, request.Account)
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/smartaccount module sentinel errors
+var (
+	ErrAuthenticatorNotFound      = errorsmod.Register(ModuleName, 2, "authenticator not found")
+	ErrUnknownAuthenticatorType   = errorsmod.Register(ModuleName, 3, "unknown authenticator type")
+	ErrInvalidAuthenticatorConfig = errorsmod.Register(ModuleName, 4, "invalid authenticator config")
+	ErrInvalidTxExtension         = errorsmod.Register(ModuleName, 5, "invalid smart account tx extension")
+	ErrAuthenticationFailed       = errorsmod.Register(ModuleName, 6, "authentication failed")
+	ErrExecutionRejected          = errorsmod.Register(ModuleName, 7, "execution rejected by authenticator")
+	ErrUnauthorized               = errorsmod.Register(ModuleName, 8, "unauthorized")
+)
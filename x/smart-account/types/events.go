@@ -0,0 +1,10 @@
+package types
+
+// event types
+const (
+	AttributeAccount           = "account"
+	AttributeAuthenticatorType = "authenticator_type"
+	AttributeAuthenticatorId   = "authenticator_id"
+	AttributeSender            = "sender"
+	AttributeActive            = "active"
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the account contract that must be fulfilled when
+// creating a x/smartaccount keeper or authenticators.
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	GetParams(ctx sdk.Context) authtypes.Params
+}
+
+// BankKeeper defines the banking contract that must be fulfilled by the
+// spend limit authenticator.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// ContractKeeper defines the contract keeper that must be fulfilled by the
+// cosmwasm authenticator.
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// WasmKeeper defines the wasm keeper that must be fulfilled by the cosmwasm
+// authenticator.
+type WasmKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+}
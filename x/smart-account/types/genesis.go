@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default smartaccount genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:              DefaultParams(),
+		NextAuthenticatorId: 0,
+		AuthenticatorData:   []AuthenticatorData{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenAccounts := map[string]bool{}
+	seenIds := map[uint64]bool{}
+	for _, data := range gs.AuthenticatorData {
+		if _, err := sdk.AccAddressFromBech32(data.Address); err != nil {
+			return fmt.Errorf("invalid authenticator account %s: %w", data.Address, err)
+		}
+		if seenAccounts[data.Address] {
+			return fmt.Errorf("duplicate authenticators of account %s", data.Address)
+		}
+		seenAccounts[data.Address] = true
+
+		for _, authenticator := range data.Authenticators {
+			if authenticator.Id >= gs.NextAuthenticatorId {
+				return fmt.Errorf("authenticator id %d is not lower than the next authenticator id %d", authenticator.Id, gs.NextAuthenticatorId)
+			}
+			if seenIds[authenticator.Id] {
+				return fmt.Errorf("duplicate authenticator id %d", authenticator.Id)
+			}
+			seenIds[authenticator.Id] = true
+
+			if authenticator.Type == "" {
+				return fmt.Errorf("authenticator %d has an empty type", authenticator.Id)
+			}
+		}
+	}
+
+	return nil
+}
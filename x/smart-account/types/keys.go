@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "smartaccount"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TransientStoreKey defines the transient store key, reset at the end of every block.
+	TransientStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for the smartaccount module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyNextAuthenticatorId is the key of the id of the next registered authenticator.
+	KeyNextAuthenticatorId = []byte{0x01}
+
+	// KeyAccountAuthenticatorsPrefix prefixes the authenticators of an account, keyed by account and id.
+	KeyAccountAuthenticatorsPrefix = []byte{0x02}
+
+	// KeyAuthenticatorTrackingPrefix prefixes the data tracked by authenticators between the ante and post
+	// handlers of a tx, in the transient store.
+	KeyAuthenticatorTrackingPrefix = []byte{0x03}
+
+	// KeyParams is the key of the module parameters.
+	KeyParams = []byte{0x04}
+)
+
+// KeyAccountAuthenticators returns the prefix of the authenticators of an account.
+func KeyAccountAuthenticators(account sdk.AccAddress) []byte {
+	return append(KeyAccountAuthenticatorsPrefix, address.MustLengthPrefix(account)...)
+}
+
+// KeyAccountAuthenticator returns the key of an authenticator of an account.
+func KeyAccountAuthenticator(account sdk.AccAddress, id uint64) []byte {
+	return append(KeyAccountAuthenticators(account), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyAuthenticatorTracking returns the key of the data tracked by an authenticator of an account, in the transient store.
+// The id of a sub-authenticator is composed of the id of its parent and its index, e.g. "2.1".
+func KeyAuthenticatorTracking(account sdk.AccAddress, id string) []byte {
+	return append(append(KeyAuthenticatorTrackingPrefix, address.MustLengthPrefix(account)...), []byte(id)...)
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgAddAuthenticator    = "add_authenticator"
+	TypeMsgRemoveAuthenticator = "remove_authenticator"
+	TypeMsgSetActiveState      = "set_active_state"
+	TypeMsgUpdateParams        = "update_params"
+)
+
+var _ sdk.Msg = &MsgAddAuthenticator{}
+
+// NewMsgAddAuthenticator creates a msg to register an authenticator for the sender.
+func NewMsgAddAuthenticator(sender, authenticatorType string, config []byte) *MsgAddAuthenticator {
+	return &MsgAddAuthenticator{
+		Sender:            sender,
+		AuthenticatorType: authenticatorType,
+		Config:            config,
+	}
+}
+
+func (m MsgAddAuthenticator) Route() string { return RouterKey }
+func (m MsgAddAuthenticator) Type() string  { return TypeMsgAddAuthenticator }
+func (m MsgAddAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.AuthenticatorType == "" {
+		return errorsmod.Wrap(ErrUnknownAuthenticatorType, "authenticator type cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgAddAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddAuthenticator) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRemoveAuthenticator{}
+
+// NewMsgRemoveAuthenticator creates a msg to remove an authenticator of the sender.
+func NewMsgRemoveAuthenticator(sender string, id uint64) *MsgRemoveAuthenticator {
+	return &MsgRemoveAuthenticator{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+func (m MsgRemoveAuthenticator) Route() string { return RouterKey }
+func (m MsgRemoveAuthenticator) Type() string  { return TypeMsgRemoveAuthenticator }
+func (m MsgRemoveAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgRemoveAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRemoveAuthenticator) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetActiveState{}
+
+// NewMsgSetActiveState creates a msg to activate or deactivate smart accounts.
+func NewMsgSetActiveState(sender string, active bool) *MsgSetActiveState {
+	return &MsgSetActiveState{
+		Sender: sender,
+		Active: active,
+	}
+}
+
+func (m MsgSetActiveState) Route() string { return RouterKey }
+func (m MsgSetActiveState) Type() string  { return TypeMsgSetActiveState }
+func (m MsgSetActiveState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgSetActiveState) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetActiveState) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a msg to set the smartaccount parameters.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (m MsgUpdateParams) Route() string { return RouterKey }
+func (m MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return m.Params.Validate()
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
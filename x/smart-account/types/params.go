@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaximumUnauthenticatedGas is enough gas to verify a few signatures or run a simple cosmwasm authenticator.
+const DefaultMaximumUnauthenticatedGas = uint64(120_000)
+
+func NewParams(isSmartAccountActive bool, circuitBreakerControllers []string, maximumUnauthenticatedGas uint64) Params {
+	return Params{
+		IsSmartAccountActive:      isSmartAccountActive,
+		CircuitBreakerControllers: circuitBreakerControllers,
+		MaximumUnauthenticatedGas: maximumUnauthenticatedGas,
+	}
+}
+
+// DefaultParams returns the default smartaccount module parameters, with smart accounts inactive.
+func DefaultParams() Params {
+	return Params{
+		IsSmartAccountActive:      false,
+		CircuitBreakerControllers: []string{},
+		MaximumUnauthenticatedGas: DefaultMaximumUnauthenticatedGas,
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateIsSmartAccountActive(p.IsSmartAccountActive); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakerControllers(p.CircuitBreakerControllers); err != nil {
+		return err
+	}
+	if err := validateMaximumUnauthenticatedGas(p.MaximumUnauthenticatedGas); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateIsSmartAccountActive(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerControllers(i interface{}) error {
+	controllers, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, controller := range controllers {
+		if _, err := sdk.AccAddressFromBech32(controller); err != nil {
+			return fmt.Errorf("invalid circuit breaker controller %s: %w", controller, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMaximumUnauthenticatedGas(i interface{}) error {
+	maximumUnauthenticatedGas, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maximumUnauthenticatedGas == 0 {
+		return fmt.Errorf("maximum unauthenticated gas must be positive")
+	}
+
+	return nil
+}
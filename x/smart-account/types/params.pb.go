@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/smartaccount/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the smartaccount module.
+type Params struct {
+	// IsSmartAccountActive defines whether the txs selecting authenticators are
+	// authenticated by the authenticators of their signers. When false, every tx
+	// goes through the classic signature verification, acting as the feature
+	// flag and circuit breaker of the module.
+	IsSmartAccountActive bool `protobuf:"varint,1,opt,name=is_smart_account_active,json=isSmartAccountActive,proto3" json:"is_smart_account_active,omitempty" yaml:"is_smart_account_active"`
+	// CircuitBreakerControllers defines the accounts that can deactivate smart
+	// accounts through MsgSetActiveState, without a governance proposal.
+	CircuitBreakerControllers []string `protobuf:"bytes,2,rep,name=circuit_breaker_controllers,json=circuitBreakerControllers,proto3" json:"circuit_breaker_controllers,omitempty" yaml:"circuit_breaker_controllers"`
+	// MaximumUnauthenticatedGas defines the maximum gas that the authentication
+	// of the fee payer can consume before the fees of the tx are deducted, which
+	// is not paid for if the authentication fails.
+	MaximumUnauthenticatedGas uint64 `protobuf:"varint,3,opt,name=maximum_unauthenticated_gas,json=maximumUnauthenticatedGas,proto3" json:"maximum_unauthenticated_gas,omitempty" yaml:"maximum_unauthenticated_gas"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2a36e3b8e84dacf, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetIsSmartAccountActive() bool {
+	if m != nil {
+		return m.IsSmartAccountActive
+	}
+	return false
+}
+
+func (m *Params) GetCircuitBreakerControllers() []string {
+	if m != nil {
+		return m.CircuitBreakerControllers
+	}
+	return nil
+}
+
+func (m *Params) GetMaximumUnauthenticatedGas() uint64 {
+	if m != nil {
+		return m.MaximumUnauthenticatedGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.smartaccount.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/smartaccount/v1beta1/params.proto", fileDescriptor_f2a36e3b8e84dacf)
+}
+
+var fileDescriptor_f2a36e3b8e84dacf = []byte{
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4f, 0x32, 0x31,
+	0x18, 0xc7, 0x39, 0x78, 0x43, 0x5e, 0x6f, 0x24, 0x24, 0x82, 0x9a, 0x42, 0x3a, 0x18, 0x1c, 0xb8,
+	0x06, 0x9d, 0x74, 0xe3, 0x1c, 0x5c, 0x0d, 0xc4, 0x41, 0x97, 0xe6, 0xb9, 0x5a, 0x8f, 0xc6, 0xeb,
+	0x95, 0xb4, 0x3d, 0x02, 0xdf, 0xc2, 0xef, 0xe2, 0x97, 0x70, 0x64, 0x74, 0x22, 0x06, 0xbe, 0x01,
+	0x9f, 0xc0, 0xdc, 0x5d, 0x89, 0x38, 0xc8, 0xd6, 0x3e, 0xbf, 0xdf, 0xff, 0x79, 0x86, 0xbf, 0x7f,
+	0xa1, 0x8c, 0x54, 0x46, 0x18, 0x62, 0x24, 0x68, 0x0b, 0x8c, 0xa9, 0x2c, 0xb5, 0x64, 0x36, 0x88,
+	0xb8, 0x85, 0x01, 0x99, 0x82, 0x06, 0x69, 0x82, 0xa9, 0x56, 0x56, 0x35, 0xce, 0x9c, 0x1a, 0xec,
+	0xab, 0x81, 0x53, 0x4f, 0x9a, 0xb1, 0x8a, 0x55, 0x21, 0x92, 0xfc, 0x55, 0x66, 0xf0, 0x7b, 0xd5,
+	0xaf, 0xdf, 0x17, 0x4b, 0x1a, 0x8f, 0xfe, 0xb1, 0x30, 0xb4, 0xc8, 0x52, 0x17, 0xa6, 0xc0, 0xac,
+	0x98, 0xf1, 0x96, 0xd7, 0xf5, 0x7a, 0xff, 0x43, 0xbc, 0x5d, 0x75, 0xd0, 0x02, 0x64, 0x72, 0x83,
+	0xff, 0x10, 0xf1, 0xa8, 0x29, 0xcc, 0x38, 0x07, 0xc3, 0x72, 0x3e, 0x2c, 0xc6, 0x8d, 0x17, 0xff,
+	0x94, 0x09, 0xcd, 0x32, 0x61, 0x69, 0xa4, 0x39, 0xbc, 0x72, 0x4d, 0x99, 0x4a, 0xad, 0x56, 0x49,
+	0xc2, 0xb5, 0x69, 0x55, 0xbb, 0xb5, 0xde, 0x51, 0x78, 0xbe, 0x5d, 0x75, 0x70, 0xb9, 0xfe, 0x80,
+	0x8c, 0x47, 0x6d, 0x47, 0xc3, 0x12, 0xde, 0xfe, 0xb0, 0xfc, 0x8e, 0x84, 0xb9, 0x90, 0x99, 0xa4,
+	0x59, 0x0a, 0x99, 0x9d, 0xf0, 0xd4, 0x0a, 0x06, 0x96, 0x3f, 0xd3, 0x18, 0x4c, 0xab, 0xd6, 0xf5,
+	0x7a, 0xff, 0xf6, 0xef, 0x1c, 0x90, 0xf1, 0xa8, 0xed, 0xe8, 0xc3, 0x6f, 0x78, 0x07, 0x26, 0x1c,
+	0x7f, 0xac, 0x91, 0xb7, 0x5c, 0x23, 0xef, 0x6b, 0x8d, 0xbc, 0xb7, 0x0d, 0xaa, 0x2c, 0x37, 0xa8,
+	0xf2, 0xb9, 0x41, 0x95, 0xa7, 0xeb, 0x58, 0xd8, 0x49, 0x16, 0x05, 0x4c, 0x49, 0xe2, 0xea, 0xe8,
+	0x27, 0x10, 0x99, 0xdd, 0x87, 0xcc, 0x2e, 0x07, 0x64, 0x5e, 0x96, 0xd9, 0xdf, 0xb5, 0x69, 0x17,
+	0x53, 0x6e, 0xa2, 0x7a, 0xd1, 0xc8, 0xd5, 0xf7, 0x00, 0x47, 0x7f, 0x85, 0x8c, 0xf2, 0x01, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaximumUnauthenticatedGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaximumUnauthenticatedGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CircuitBreakerControllers) > 0 {
+		for iNdEx := len(m.CircuitBreakerControllers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CircuitBreakerControllers[iNdEx])
+			copy(dAtA[i:], m.CircuitBreakerControllers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.CircuitBreakerControllers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.IsSmartAccountActive {
+		i--
+		if m.IsSmartAccountActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsSmartAccountActive {
+		n += 2
+	}
+	if len(m.CircuitBreakerControllers) > 0 {
+		for _, s := range m.CircuitBreakerControllers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaximumUnauthenticatedGas != 0 {
+		n += 1 + sovParams(uint64(m.MaximumUnauthenticatedGas))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSmartAccountActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSmartAccountActive = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerControllers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerControllers = append(m.CircuitBreakerControllers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumUnauthenticatedGas", wireType)
+			}
+			m.MaximumUnauthenticatedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumUnauthenticatedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// GetTxExtension returns the TxExtension of the non critical extension options of the tx,
+// nil if the tx has none and must be authenticated by the classic signature verification.
+func GetTxExtension(tx sdk.Tx) *TxExtension {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	for _, option := range extTx.GetNonCriticalExtensionOptions() {
+		if extension, ok := option.GetCachedValue().(*TxExtension); ok {
+			return extension
+		}
+	}
+	return nil
+}
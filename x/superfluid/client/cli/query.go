@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdAllSuperfluidAssets(),
 		GetCmdAssetMultiplier(),
+		GetCmdAssetRiskAdjustment(),
 		GetCmdAllIntermediaryAccounts(),
 		GetCmdConnectedIntermediaryAccount(),
 		GetCmdSuperfluidDelegationAmount(),
@@ -85,6 +86,17 @@ func GetCmdAssetMultiplier() *cobra.Command {
 	)
 }
 
+func GetCmdAssetRiskAdjustment() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.AssetRiskAdjustmentRequest](
+		"asset-risk-adjustment",
+		"Query the risk factor and risk adjusted multiplier of an asset by denom",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} asset-risk-adjustment gamm/pool/1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
 func GetCmdAllIntermediaryAccounts() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.AllIntermediaryAccountsRequest](
 		"all-intermediary-accounts",
@@ -416,8 +416,8 @@ func NewUnlockAndMigrateSharesToFullRangeConcentratedPositionCmd() (*osmocli.TxC
 func NewUnbondConvertAndStake() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "unbond-convert-and-stake [lock-id] [valAddr] [min-amount-to-stake](optional) [shares-to-convert](optional)",
-		Short:   "instantly unbond any locked gamm shares convert them into osmo and stake",
-		Example: "unbond-convert-and-stake 10 osmo1xxx 100000uosmo",
+		Short:   "instantly unbond any locked gamm shares (or part of a superfluid bonded lock) convert them into osmo and stake",
+		Example: "unbond-convert-and-stake 10 osmo1xxx 100000 500000000000000000gamm/pool/1",
 		Args:    cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
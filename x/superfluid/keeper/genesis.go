@@ -37,6 +37,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize superfluid asset risk factors
+	for _, assetRiskFactor := range genState.AssetRiskFactors {
+		k.SetSuperfluidAssetRiskFactor(ctx, assetRiskFactor)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +52,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		AssetRiskFactors:              k.GetAllSuperfluidAssetRiskFactors(ctx),
 	}
 }
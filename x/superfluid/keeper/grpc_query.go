@@ -98,6 +98,26 @@ func (q Querier) AssetMultiplier(goCtx context.Context, req *types.AssetMultipli
 	}, nil
 }
 
+// AssetRiskAdjustment returns the risk factor of a superfluid asset, and its osmo equivalent multiplier
+// adjusted by the risk factor.
+func (q Querier) AssetRiskAdjustment(goCtx context.Context, req *types.AssetRiskAdjustmentRequest) (*types.AssetRiskAdjustmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	riskFactor := q.Keeper.GetRiskFactor(ctx, req.Denom)
+	multiplier := q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)
+
+	return &types.AssetRiskAdjustmentResponse{
+		RiskFactor:             riskFactor,
+		RiskAdjustedMultiplier: multiplier.Mul(osmomath.OneDec().Sub(riskFactor)),
+	}, nil
+}
+
 // AllIntermediaryAccounts returns all superfluid intermediary accounts.
 func (q Querier) AllIntermediaryAccounts(goCtx context.Context, req *types.AllIntermediaryAccountsRequest) (*types.AllIntermediaryAccountsResponse, error) {
 	if req == nil {
@@ -484,7 +504,7 @@ func (q Querier) EstimateSuperfluidDelegatedAmountByValidatorDenom(goCtx context
 	}
 
 	syntheticOsmoAmt := delegation.Shares.Quo(val.DelegatorShares).MulInt(val.Tokens)
-	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
+	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, req.Denom, syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
 
 	return &types.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse{
 		TotalDelegatedCoins: sdk.NewCoins(sdk.NewCoin(req.Denom, baseAmount)),
@@ -117,6 +117,26 @@ func (s *KeeperTestSuite) TestGRPCSuperfluidAsset() {
 	s.Require().Len(resp.Assets, 1)
 }
 
+func (s *KeeperTestSuite) TestGRPCAssetRiskAdjustment() {
+	s.SetupTest()
+	s.querier.SetOsmoEquivalentMultiplier(s.Ctx, 1, DefaultGammAsset, osmomath.NewDec(20))
+
+	// minimum risk factor of 50%
+	res, err := s.querier.AssetRiskAdjustment(sdk.WrapSDKContext(s.Ctx), &types.AssetRiskAdjustmentRequest{Denom: DefaultGammAsset})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDecWithPrec(5, 1), res.RiskFactor)
+	s.Require().Equal(osmomath.NewDec(10), res.RiskAdjustedMultiplier)
+
+	s.querier.SetSuperfluidAssetRiskFactor(s.Ctx, types.SuperfluidAssetRiskFactor{Denom: DefaultGammAsset, RiskFactor: osmomath.NewDecWithPrec(75, 2)})
+	res, err = s.querier.AssetRiskAdjustment(sdk.WrapSDKContext(s.Ctx), &types.AssetRiskAdjustmentRequest{Denom: DefaultGammAsset})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDecWithPrec(75, 2), res.RiskFactor)
+	s.Require().Equal(osmomath.NewDec(5), res.RiskAdjustedMultiplier)
+
+	_, err = s.querier.AssetRiskAdjustment(sdk.WrapSDKContext(s.Ctx), &types.AssetRiskAdjustmentRequest{})
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestGRPCQuerySuperfluidDelegations() {
 	s.SetupTest()
 
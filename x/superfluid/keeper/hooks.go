@@ -5,6 +5,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/superfluid/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +33,11 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
 
+// GetModuleName implements EpochHooks.
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
 // lockup hooks
 // if you add tokens to a lock that is superfluid unbonding, nothing happens superfluid side.
 // This lock does as an edge case take on the slashing risk as well for historical slashes.
@@ -64,6 +70,23 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+// BeforeInstantUnlock instantly undelegates the superfluid delegation of the lock, or skips its superfluid unbonding,
+// deleting its synthetic lockup. The unlocked tokens no longer take the slashing risk of the remaining unbonding,
+// which the instant unlock penalty accounts for. A partially unlocked lock remains undelegated.
+func (h Hooks) BeforeInstantUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64) error {
+	intermediaryAccAddr := h.k.GetLockIdIntermediaryAccountConnection(ctx, lockID)
+	if !intermediaryAccAddr.Empty() {
+		_, err := h.k.undelegateCommon(ctx, address.String(), lockID)
+		return err
+	}
+
+	synthLock, found, err := h.k.lk.GetSyntheticLockupByUnderlyingLockId(ctx, lockID)
+	if err != nil || !found {
+		return err
+	}
+	return h.k.lk.DeleteSyntheticLockup(ctx, lockID, synthLock.SynthDenom)
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
 	return nil
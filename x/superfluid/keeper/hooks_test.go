@@ -9,6 +9,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	lockupkeeper "github.com/osmosis-labs/osmosis/v21/x/lockup/keeper"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
 )
 
@@ -365,3 +366,63 @@ func (s *KeeperTestSuite) TestAfterAddTokensToLock_Event() {
 		s.AssertEventEmitted(s.Ctx, types.TypeEvtSuperfluidIncreaseDelegation, 1)
 	}
 }
+
+func (s *KeeperTestSuite) TestBeforeInstantUnlockHook() {
+	tests := map[string]struct {
+		undelegateFirst bool
+		unlockAmount    int64
+	}{
+		"superfluid delegated lock":            {},
+		"superfluid undelegating lock":         {undelegateFirst: true},
+		"superfluid delegated lock, partially": {unlockAmount: 400000},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+			_, intermediaryAccs, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+			lock := locks[0]
+
+			lockupParams := s.App.LockupKeeper.GetParams(s.Ctx)
+			lockupParams.InstantUnlockPenalty = osmomath.NewDecWithPrec(1, 1)
+			s.App.LockupKeeper.SetParams(s.Ctx, lockupParams)
+
+			if tc.undelegateFirst {
+				err := s.App.SuperfluidKeeper.SuperfluidUndelegate(s.Ctx, lock.Owner, lock.ID)
+				s.Require().NoError(err)
+			}
+
+			// Empty coins unlock the lock as a whole.
+			coins, unlockedCoins := sdk.Coins{}, lock.Coins
+			if tc.unlockAmount != 0 {
+				coins = sdk.NewCoins(sdk.NewInt64Coin(denoms[0], tc.unlockAmount))
+				unlockedCoins = coins
+			}
+			penalty, err := s.App.LockupKeeper.InstantUnlock(s.Ctx, lock.OwnerAddress(), lock.ID, coins)
+			s.Require().NoError(err)
+
+			// The synthetic lockup and the superfluid delegation of the lock are removed.
+			s.Require().False(s.App.LockupKeeper.HasAnySyntheticLockups(s.Ctx, lock.ID))
+			s.Require().True(s.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(s.Ctx, lock.ID).Empty())
+			_, found := s.App.StakingKeeper.GetDelegation(s.Ctx, intermediaryAccs[0].GetAccAddress(), valAddrs[0])
+			s.Require().False(found)
+
+			// The owner receives the unlocked coins minus the penalty.
+			s.Require().Equal(unlockedCoins.Sub(penalty...), s.App.BankKeeper.GetAllBalances(s.Ctx, lock.OwnerAddress()))
+
+			// The remaining coins stay locked, without a superfluid delegation.
+			remainingLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			if tc.unlockAmount == 0 {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(lock.Coins.Sub(unlockedCoins...), remainingLock.Coins)
+			}
+
+			reason, broken := keeper.AllInvariants(*s.App.SuperfluidKeeper)(s.Ctx)
+			s.Require().False(broken, reason)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
 )
@@ -44,6 +45,41 @@ func newRemoveSuperfluidAssetEvent(denom string) sdk.Event {
 	)
 }
 
+func EmitSetSuperfluidRiskFactorEvent(ctx sdk.Context, denom string, riskFactor osmomath.Dec) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSetSuperfluidRiskFactorEvent(denom, riskFactor),
+	})
+}
+
+func newSetSuperfluidRiskFactorEvent(denom string, riskFactor osmomath.Dec) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSetSuperfluidRiskFactor,
+		sdk.NewAttribute(types.AttributeDenom, denom),
+		sdk.NewAttribute(types.AttributeRiskFactor, riskFactor.String()),
+	)
+}
+
+func EmitRemoveSuperfluidRiskFactorEvent(ctx sdk.Context, denom string) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newRemoveSuperfluidRiskFactorEvent(denom),
+	})
+}
+
+func newRemoveSuperfluidRiskFactorEvent(denom string) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtRemoveSuperfluidRiskFactor,
+		sdk.NewAttribute(types.AttributeDenom, denom),
+	)
+}
+
 func EmitSuperfluidDelegateEvent(ctx sdk.Context, lockId uint64, valAddress string) {
 	if ctx.EventManager() == nil {
 		return
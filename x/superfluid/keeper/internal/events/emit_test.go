@@ -113,6 +113,91 @@ func (suite *SuperfluidEventsTestSuite) TestEmitRemoveSuperfluidAsset() {
 	}
 }
 
+func (suite *SuperfluidEventsTestSuite) TestEmitSetSuperfluidRiskFactorEvent() {
+	testcases := map[string]struct {
+		ctx        sdk.Context
+		denom      string
+		riskFactor osmomath.Dec
+	}{
+		"basic valid": {
+			ctx:        suite.CreateTestContext(),
+			denom:      testDenomA,
+			riskFactor: osmomath.NewDecWithPrec(3, 1),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSetSuperfluidRiskFactorEvent(tc.ctx, tc.denom, tc.riskFactor)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtSetSuperfluidRiskFactor,
+					sdk.NewAttribute(types.AttributeDenom, tc.denom),
+					sdk.NewAttribute(types.AttributeRiskFactor, tc.riskFactor.String()),
+				),
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *SuperfluidEventsTestSuite) TestEmitRemoveSuperfluidRiskFactorEvent() {
+	testcases := map[string]struct {
+		ctx   sdk.Context
+		denom string
+	}{
+		"basic valid": {
+			ctx:   suite.CreateTestContext(),
+			denom: testDenomA,
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitRemoveSuperfluidRiskFactorEvent(tc.ctx, tc.denom)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtRemoveSuperfluidRiskFactor,
+					sdk.NewAttribute(types.AttributeDenom, tc.denom),
+				),
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidDelegateEvent() {
 	testcases := map[string]struct {
 		ctx     sdk.Context
@@ -6,7 +6,9 @@ import (
 	"strconv"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
@@ -253,3 +255,38 @@ func (server msgServer) UnbondConvertAndStake(goCtx context.Context, msg *types.
 
 	return &types.MsgUnbondConvertAndStakeResponse{TotalAmtStaked: totalAmtConverted}, nil
 }
+
+// SetSuperfluidRiskFactor sets the risk factor of a superfluid asset. It can only be executed by governance.
+// The superfluid delegations of the asset are refreshed to the new risk factor at the next epoch.
+func (server msgServer) SetSuperfluidRiskFactor(goCtx context.Context, msg *types.MsgSetSuperfluidRiskFactor) (*types.MsgSetSuperfluidRiskFactorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
+	}
+
+	if err := server.keeper.SetRiskFactor(ctx, msg.Denom, msg.RiskFactor); err != nil {
+		return nil, err
+	}
+
+	events.EmitSetSuperfluidRiskFactorEvent(ctx, msg.Denom, msg.RiskFactor)
+	return &types.MsgSetSuperfluidRiskFactorResponse{}, nil
+}
+
+// RemoveSuperfluidRiskFactor removes the risk factor of a superfluid asset, which then falls back to the
+// minimum risk factor param. It can only be executed by governance.
+// The superfluid delegations of the asset are refreshed to the minimum risk factor at the next epoch.
+func (server msgServer) RemoveSuperfluidRiskFactor(goCtx context.Context, msg *types.MsgRemoveSuperfluidRiskFactor) (*types.MsgRemoveSuperfluidRiskFactorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if server.keeper.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.keeper.authority, msg.Authority)
+	}
+
+	if err := server.keeper.RemoveRiskFactor(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	events.EmitRemoveSuperfluidRiskFactorEvent(ctx, msg.Denom)
+	return &types.MsgRemoveSuperfluidRiskFactorResponse{}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -597,3 +599,166 @@ func (s *KeeperTestSuite) TestAddToConcentratedLiquiditySuperfluidPosition_Event
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgSetSuperfluidRiskFactor() {
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	tests := map[string]struct {
+		authority  string
+		denom      string
+		riskFactor osmomath.Dec
+
+		expectedRiskFactor  osmomath.Dec
+		expectedDelegations osmomath.Int
+		expectedErr         error
+	}{
+		"risk factor greater than minimum risk factor": {
+			authority:          govAddress,
+			denom:              DefaultGammAsset,
+			riskFactor:         osmomath.NewDecWithPrec(7, 1),
+			expectedRiskFactor: osmomath.NewDecWithPrec(7, 1),
+			// 1_000_000 shares * 20 multiplier * (1 - 0.7)
+			expectedDelegations: osmomath.NewInt(6_000_000),
+		},
+		"risk factor lower than minimum risk factor": {
+			authority:          govAddress,
+			denom:              DefaultGammAsset,
+			riskFactor:         osmomath.NewDecWithPrec(1, 1),
+			expectedRiskFactor: osmomath.NewDecWithPrec(1, 1),
+			// 1_000_000 shares * 20 multiplier * (1 - 0.1)
+			expectedDelegations: osmomath.NewInt(18_000_000),
+		},
+		"zero risk factor": {
+			authority:          govAddress,
+			denom:              DefaultGammAsset,
+			riskFactor:         osmomath.ZeroDec(),
+			expectedRiskFactor: osmomath.ZeroDec(),
+			// 1_000_000 shares * 20 multiplier
+			expectedDelegations: osmomath.NewInt(20_000_000),
+		},
+		"error: not the authority": {
+			authority:   s.TestAccs[0].String(),
+			denom:       DefaultGammAsset,
+			riskFactor:  osmomath.NewDecWithPrec(7, 1),
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		"error: not a superfluid asset": {
+			authority:   govAddress,
+			denom:       "uion",
+			riskFactor:  osmomath.NewDecWithPrec(7, 1),
+			expectedErr: types.ErrNonSuperfluidAsset,
+		},
+		"error: risk factor of one": {
+			authority:   govAddress,
+			denom:       DefaultGammAsset,
+			riskFactor:  osmomath.OneDec(),
+			expectedErr: types.InvalidRiskFactorError{RiskFactor: osmomath.OneDec()},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := keeper.NewMsgServerImpl(s.App.SuperfluidKeeper)
+
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+			_, intermediaryAccs, _ := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+			// A previous risk factor is overwritten.
+			s.App.SuperfluidKeeper.SetSuperfluidAssetRiskFactor(s.Ctx, types.SuperfluidAssetRiskFactor{Denom: DefaultGammAsset, RiskFactor: osmomath.NewDecWithPrec(9, 1)})
+
+			_, err := msgServer.SetSuperfluidRiskFactor(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetSuperfluidRiskFactor(tc.authority, tc.denom, tc.riskFactor))
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSetSuperfluidRiskFactor, 1)
+			s.Require().Equal(tc.expectedRiskFactor, s.App.SuperfluidKeeper.GetRiskFactor(s.Ctx, tc.denom))
+
+			// The intermediary account delegations follow the new risk factor once refreshed at epoch.
+			s.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(s.Ctx)
+			delegation, found := s.App.StakingKeeper.GetDelegation(s.Ctx, intermediaryAccs[0].GetAccAddress(), valAddrs[0])
+			s.Require().True(found)
+			validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, valAddrs[0])
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedDelegations, validator.TokensFromShares(delegation.Shares).RoundInt())
+
+			// Removing the asset removes its risk factor.
+			asset, err := s.App.SuperfluidKeeper.GetSuperfluidAsset(s.Ctx, tc.denom)
+			s.Require().NoError(err)
+			s.App.SuperfluidKeeper.BeginUnwindSuperfluidAsset(s.Ctx, 0, asset)
+			_, found = s.App.SuperfluidKeeper.GetSuperfluidAssetRiskFactor(s.Ctx, tc.denom)
+			s.Require().False(found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveSuperfluidRiskFactor() {
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	tests := map[string]struct {
+		authority           string
+		denom               string
+		hasAssetRiskFactor  bool
+		expectedDelegations osmomath.Int
+		expectedErr         error
+	}{
+		"asset falls back to the minimum risk factor": {
+			authority:          govAddress,
+			denom:              DefaultGammAsset,
+			hasAssetRiskFactor: true,
+			// 1_000_000 shares * 20 multiplier * (1 - 0.5)
+			expectedDelegations: osmomath.NewInt(10_000_000),
+		},
+		"error: not the authority": {
+			authority:          s.TestAccs[0].String(),
+			denom:              DefaultGammAsset,
+			hasAssetRiskFactor: true,
+			expectedErr:        govtypes.ErrInvalidSigner,
+		},
+		"error: not a superfluid asset": {
+			authority:   govAddress,
+			denom:       "uion",
+			expectedErr: types.ErrNonSuperfluidAsset,
+		},
+		"error: asset without risk factor": {
+			authority:   govAddress,
+			denom:       DefaultGammAsset,
+			expectedErr: types.NoAssetRiskFactorError{Denom: DefaultGammAsset},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := keeper.NewMsgServerImpl(s.App.SuperfluidKeeper)
+
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+			_, intermediaryAccs, _ := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+			if tc.hasAssetRiskFactor {
+				s.App.SuperfluidKeeper.SetSuperfluidAssetRiskFactor(s.Ctx, types.SuperfluidAssetRiskFactor{Denom: DefaultGammAsset, RiskFactor: osmomath.ZeroDec()})
+			}
+
+			_, err := msgServer.RemoveSuperfluidRiskFactor(sdk.WrapSDKContext(s.Ctx), types.NewMsgRemoveSuperfluidRiskFactor(tc.authority, tc.denom))
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRemoveSuperfluidRiskFactor, 1)
+			_, found := s.App.SuperfluidKeeper.GetSuperfluidAssetRiskFactor(s.Ctx, tc.denom)
+			s.Require().False(found)
+			s.Require().Equal(s.App.SuperfluidKeeper.GetParams(s.Ctx).MinimumRiskFactor, s.App.SuperfluidKeeper.GetRiskFactor(s.Ctx, tc.denom))
+
+			// The intermediary account delegations follow the minimum risk factor once refreshed at epoch.
+			s.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(s.Ctx)
+			delegation, found := s.App.StakingKeeper.GetDelegation(s.Ctx, intermediaryAccs[0].GetAccAddress(), valAddrs[0])
+			s.Require().True(found)
+			validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, valAddrs[0])
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedDelegations, validator.TokensFromShares(delegation.Shares).RoundInt())
+		})
+	}
+}
@@ -609,6 +609,8 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn
 // UnbondConvertAndStake converts given lock to osmo and stakes it to given validator.
 // Supports conversion of 1)superfluid bonded 2)superfluid undelegating 3)vanilla unlocking.
 // Liquid gamm shares will not be supported for conversion.
+// For superfluid bonded locks, a non-zero sharesToConvert less than the locked shares converts only that part of the lock,
+// and the remainder of the lock stays superfluid delegated to the same validator.
 // Delegation is done in the following logic:
 // - If valAddr provided, single delegate.
 // - If valAddr not provided and valset exists, valsetpref.Delegate
@@ -627,6 +629,17 @@ func (k Keeper) UnbondConvertAndStake(ctx sdk.Context, lockID uint64, sender, va
 		return osmomath.Int{}, err
 	}
 
+	// if superfluid bonded and only part of the lock is requested, convert that part and keep the remainder superfluid delegated.
+	if migrationType == SuperfluidBonded && !sharesToConvert.Amount.IsNil() && sharesToConvert.IsPositive() {
+		lock, err := k.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		if !sharesToConvert.IsEqual(lock.Coins[0]) {
+			return k.partialConvertSuperfluidBondedToStake(ctx, senderAddr, valAddr, lock, sharesToConvert, minAmtToStake)
+		}
+	}
+
 	// if superfluid bonded, first change it into superfluid undelegate to burn minted osmo and instantly undelegate.
 	if migrationType == SuperfluidBonded {
 		_, err = k.undelegateCommon(ctx, sender, lockID)
@@ -701,6 +714,56 @@ func (k Keeper) convertLockToStake(ctx sdk.Context, sender sdk.AccAddress, valAd
 	return totalAmtConverted, nil
 }
 
+// partialConvertSuperfluidBondedToStake converts the given shares of a superfluid bonded lock to staking delegation.
+// The whole lock is undelegated, the shares to convert are force unlocked from it, and the remainder of the lock
+// is superfluid delegated again to the same validator.
+func (k Keeper) partialConvertSuperfluidBondedToStake(ctx sdk.Context, sender sdk.AccAddress, valAddr string, lock *lockuptypes.PeriodLock,
+	sharesToConvert sdk.Coin, minAmtToStake osmomath.Int) (totalAmtConverted osmomath.Int, err error) {
+	lockCoin := lock.Coins[0]
+	if sharesToConvert.Denom != lockCoin.Denom {
+		return osmomath.Int{}, fmt.Errorf("shares to convert denom (%s) does not match lock denom (%s)", sharesToConvert.Denom, lockCoin.Denom)
+	}
+	if sharesToConvert.Amount.GT(lockCoin.Amount) {
+		return osmomath.Int{}, types.MigrateMoreSharesThanLockHasError{SharesToMigrate: sharesToConvert.Amount.String(), SharesInLock: lockCoin.Amount.String()}
+	}
+
+	if !strings.HasPrefix(lockCoin.Denom, gammtypes.GAMMTokenPrefix) {
+		return osmomath.Int{}, types.SharesToMigrateDenomPrefixError{Denom: lockCoin.Denom, ExpectedDenomPrefix: gammtypes.GAMMTokenPrefix}
+	}
+
+	poolIdLeaving, err := gammtypes.GetPoolIdFromShareDenom(lockCoin.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// undelegate the whole lock, burning the minted osmo.
+	intermediaryAcc, err := k.undelegateCommon(ctx, sender.String(), lock.ID)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// split the shares to convert out of the lock and unlock them instantly.
+	err = k.lk.PartialForceUnlock(ctx, *lock, sdk.NewCoins(sharesToConvert))
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// re-delegate the remainder of the lock.
+	err = k.SuperfluidDelegate(ctx, sender.String(), lock.ID, intermediaryAcc.ValAddr)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// Exit the balancer pool position.
+	// we exit with min token out amount zero since we are checking min amount designated to stake later on anyways.
+	exitCoins, err := k.gk.ExitPool(ctx, sender, poolIdLeaving, sharesToConvert.Amount, sdk.NewCoins())
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	return k.convertGammSharesToOsmoAndStake(ctx, sender, valAddr, poolIdLeaving, exitCoins, minAmtToStake, intermediaryAcc.ValAddr)
+}
+
 // convertUnlockedToStake converts liquid gamm shares to staking delegation.
 // minAmtToStake works as slippage bound for the conversion process.
 func (k Keeper) convertUnlockedToStake(ctx sdk.Context, sender sdk.AccAddress, valAddr string, sharesToStake sdk.Coin,
@@ -987,7 +987,7 @@ func (s *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 				denom := intermediaryAcc.Denom
 				_, err := s.App.SuperfluidKeeper.GetSuperfluidAsset(s.Ctx, denom)
 				s.Require().NoError(err)
-				expAmount := s.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(s.Ctx, decAmt.RoundInt())
+				expAmount := s.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(s.Ctx, denom, decAmt.RoundInt())
 
 				// check delegation changes
 				valAddr, err := sdk.ValAddressFromBech32(intermediaryAcc.ValAddr)
@@ -1131,6 +1131,72 @@ func (s *KeeperTestSuite) TestUnbondConvertAndStake() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUnbondConvertAndStake_PartialSuperfluidBonded() {
+	defaultJoinTime := s.Ctx.BlockTime()
+	type tc struct {
+		// sharesToConvertFraction is the fraction of the locked shares to convert.
+		sharesToConvertFraction osmomath.Dec
+		expectedError           bool
+	}
+	testCases := map[string]tc{
+		"convert half of the lock": {
+			sharesToConvertFraction: osmomath.NewDecWithPrec(5, 1),
+		},
+		"convert a small part of the lock": {
+			sharesToConvertFraction: osmomath.NewDecWithPrec(1, 2),
+		},
+		"error: convert more shares than the lock has": {
+			sharesToConvertFraction: osmomath.NewDec(2),
+			expectedError:           true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(defaultJoinTime)
+
+			_, intermediaryAcc, lock, _, sender, _, _, originalValAddr := s.SetupUnbondConvertAndStakeTest(s.Ctx, true, false, false, false)
+			valAddr := s.SetupValidator(stakingtypes.Bonded)
+
+			lockCoin := lock.Coins[0]
+			sharesToConvert := sdk.NewCoin(lockCoin.Denom, tc.sharesToConvertFraction.MulInt(lockCoin.Amount).TruncateInt())
+
+			// system under test
+			totalAmtConverted, err := s.App.SuperfluidKeeper.UnbondConvertAndStake(s.Ctx, lock.ID, sender.String(), valAddr.String(), osmomath.ZeroInt(), sharesToConvert)
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the converted shares are staked to the given validator.
+			delegation, found := s.App.StakingKeeper.GetDelegation(s.Ctx, sender, valAddr)
+			s.Require().True(found)
+			s.Require().Equal(totalAmtConverted.ToLegacyDec(), delegation.Shares)
+
+			// the remainder stays locked and superfluid delegated to the original validator.
+			remainingLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(lockCoin.Sub(sharesToConvert), remainingLock.Coins[0])
+			s.Require().False(remainingLock.IsUnlocking())
+
+			_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, lock.ID, keeper.StakingSyntheticDenom(lockCoin.Denom, originalValAddr.String()))
+			s.Require().NoError(err)
+
+			remainingIntermediaryAcc, found := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, lock.ID)
+			s.Require().True(found)
+			s.Require().Equal(intermediaryAcc.GetAccAddress(), remainingIntermediaryAcc.GetAccAddress())
+
+			expectedDelegationAmt, err := s.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(s.Ctx, intermediaryAcc.Denom, remainingLock.Coins[0].Amount)
+			s.Require().NoError(err)
+			intermediaryDelegation, found := s.App.StakingKeeper.GetDelegation(s.Ctx, intermediaryAcc.GetAccAddress(), originalValAddr)
+			s.Require().True(found)
+			s.Require().Equal(expectedDelegationAmt.ToLegacyDec(), intermediaryDelegation.Shares)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestConvertLockToStake() {
 	defaultJoinTime := s.Ctx.BlockTime()
 	type tc struct {
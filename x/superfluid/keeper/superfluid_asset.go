@@ -20,23 +20,64 @@ func (k Keeper) BeginUnwindSuperfluidAsset(ctx sdk.Context, epochNum int64, asse
 	// Right now set the TWAP to 0, and delete the asset.
 	k.SetOsmoEquivalentMultiplier(ctx, epochNum, asset.Denom, osmomath.ZeroDec())
 	k.DeleteSuperfluidAsset(ctx, asset.Denom)
+	k.DeleteSuperfluidAssetRiskFactor(ctx, asset.Denom)
+}
+
+// GetRiskFactor returns the risk factor of the given superfluid asset, which is the risk factor set by
+// governance for the asset, or the minimum risk factor param if none is set.
+func (k Keeper) GetRiskFactor(ctx sdk.Context, denom string) osmomath.Dec {
+	if assetRiskFactor, found := k.GetSuperfluidAssetRiskFactor(ctx, denom); found {
+		return assetRiskFactor.RiskFactor
+	}
+	return k.GetParams(ctx).MinimumRiskFactor
 }
 
 // Returns amount * (1 - k.RiskFactor(asset))
-// Fow now, the risk factor is a global constant.
-// It will move towards per pool functions.
-func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, amount osmomath.Int) osmomath.Int {
-	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Sub(amount.ToLegacyDec().Mul(minRiskFactor).RoundInt())
+func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, denom string, amount osmomath.Int) osmomath.Int {
+	riskFactor := k.GetRiskFactor(ctx, denom)
+	return amount.Sub(amount.ToLegacyDec().Mul(riskFactor).RoundInt())
+}
+
+// y = x - (x * risk)
+// y = x (1 - risk)
+// y / (1 - risk) = x
+
+func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, denom string, amount osmomath.Dec) osmomath.Dec {
+	riskFactor := k.GetRiskFactor(ctx, denom)
+	return amount.Quo(osmomath.OneDec().Sub(riskFactor))
 }
 
-// y = x - (x * minRisk)
-// y = x (1 - minRisk)
-// y / (1 - minRisk) = x
+// SetRiskFactor sets the risk factor of the given superfluid asset, replacing the minimum risk factor param
+// for the asset, including when it is zero.
+// The superfluid delegations of the asset are refreshed to the new risk factor at the next epoch,
+// along with the osmo equivalent multipliers.
+// Returns error if the denom is not a superfluid asset or the risk factor is not in [0, 1).
+func (k Keeper) SetRiskFactor(ctx sdk.Context, denom string, riskFactor osmomath.Dec) error {
+	if _, err := k.GetSuperfluidAsset(ctx, denom); err != nil {
+		return err
+	}
+	if err := types.ValidateRiskFactor(riskFactor); err != nil {
+		return err
+	}
+
+	k.SetSuperfluidAssetRiskFactor(ctx, types.SuperfluidAssetRiskFactor{Denom: denom, RiskFactor: riskFactor})
+	return nil
+}
+
+// RemoveRiskFactor removes the risk factor of the given superfluid asset, so that the asset falls back
+// to the minimum risk factor param. Like SetRiskFactor, the superfluid delegations of the asset are
+// refreshed at the next epoch.
+// Returns error if the denom is not a superfluid asset or has no risk factor.
+func (k Keeper) RemoveRiskFactor(ctx sdk.Context, denom string) error {
+	if _, err := k.GetSuperfluidAsset(ctx, denom); err != nil {
+		return err
+	}
+	if _, found := k.GetSuperfluidAssetRiskFactor(ctx, denom); !found {
+		return types.NoAssetRiskFactorError{Denom: denom}
+	}
 
-func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, amount osmomath.Dec) osmomath.Dec {
-	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Quo(osmomath.OneDec().Sub(minRiskFactor))
+	k.DeleteSuperfluidAssetRiskFactor(ctx, denom)
+	return nil
 }
 
 func (k Keeper) AddNewSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAsset) error {
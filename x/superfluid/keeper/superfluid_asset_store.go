@@ -63,3 +63,52 @@ func (k Keeper) GetAllSuperfluidAssets(ctx sdk.Context) []types.SuperfluidAsset
 	}
 	return assets
 }
+
+func (k Keeper) SetSuperfluidAssetRiskFactor(ctx sdk.Context, assetRiskFactor types.SuperfluidAssetRiskFactor) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixSuperfluidAssetRiskFactor)
+	bz, err := proto.Marshal(&assetRiskFactor)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set([]byte(assetRiskFactor.Denom), bz)
+}
+
+func (k Keeper) DeleteSuperfluidAssetRiskFactor(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixSuperfluidAssetRiskFactor)
+	prefixStore.Delete([]byte(denom))
+}
+
+// GetSuperfluidAssetRiskFactor returns the risk factor set by governance for the given denom,
+// and false if none is set.
+func (k Keeper) GetSuperfluidAssetRiskFactor(ctx sdk.Context, denom string) (types.SuperfluidAssetRiskFactor, bool) {
+	assetRiskFactor := types.SuperfluidAssetRiskFactor{}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixSuperfluidAssetRiskFactor)
+	found, err := osmoutils.Get(prefixStore, []byte(denom), &assetRiskFactor)
+	if err != nil {
+		panic(err)
+	}
+	return assetRiskFactor, found
+}
+
+func (k Keeper) GetAllSuperfluidAssetRiskFactors(ctx sdk.Context) []types.SuperfluidAssetRiskFactor {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixSuperfluidAssetRiskFactor)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	assetRiskFactors := []types.SuperfluidAssetRiskFactor{}
+	for ; iterator.Valid(); iterator.Next() {
+		assetRiskFactor := types.SuperfluidAssetRiskFactor{}
+
+		err := proto.Unmarshal(iterator.Value(), &assetRiskFactor)
+		if err != nil {
+			panic(err)
+		}
+
+		assetRiskFactors = append(assetRiskFactors, assetRiskFactor)
+	}
+	return assetRiskFactors
+}
@@ -43,11 +43,62 @@ func (s *KeeperTestSuite) TestSuperfluidAssetSetGetDeleteFlow() {
 }
 
 func (s *KeeperTestSuite) TestGetRiskAdjustedOsmoValue() {
-	s.SetupTest()
+	tests := map[string]struct {
+		assetRiskFactor osmomath.Dec
+		denom           string
+
+		expectedRiskFactor    osmomath.Dec
+		expectedAdjustedValue osmomath.Int
+	}{
+		"no asset risk factor, minimum risk factor is used": {
+			denom:                 DefaultGammAsset,
+			expectedRiskFactor:    osmomath.NewDecWithPrec(5, 1),
+			expectedAdjustedValue: osmomath.NewInt(50),
+		},
+		"asset risk factor greater than minimum risk factor": {
+			assetRiskFactor:       osmomath.NewDecWithPrec(7, 1),
+			denom:                 DefaultGammAsset,
+			expectedRiskFactor:    osmomath.NewDecWithPrec(7, 1),
+			expectedAdjustedValue: osmomath.NewInt(30),
+		},
+		"asset risk factor lower than minimum risk factor": {
+			assetRiskFactor:       osmomath.NewDecWithPrec(2, 1),
+			denom:                 DefaultGammAsset,
+			expectedRiskFactor:    osmomath.NewDecWithPrec(2, 1),
+			expectedAdjustedValue: osmomath.NewInt(80),
+		},
+		"zero asset risk factor": {
+			assetRiskFactor:       osmomath.ZeroDec(),
+			denom:                 DefaultGammAsset,
+			expectedRiskFactor:    osmomath.ZeroDec(),
+			expectedAdjustedValue: osmomath.NewInt(100),
+		},
+		"asset risk factor of another denom": {
+			assetRiskFactor:       osmomath.NewDecWithPrec(7, 1),
+			denom:                 "gamm/pool/2",
+			expectedRiskFactor:    osmomath.NewDecWithPrec(5, 1),
+			expectedAdjustedValue: osmomath.NewInt(50),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			if !tc.assetRiskFactor.IsNil() {
+				s.App.SuperfluidKeeper.SetSuperfluidAssetRiskFactor(s.Ctx, types.SuperfluidAssetRiskFactor{
+					Denom:      DefaultGammAsset,
+					RiskFactor: tc.assetRiskFactor,
+				})
+			}
+
+			s.Require().Equal(tc.expectedRiskFactor, s.App.SuperfluidKeeper.GetRiskFactor(s.Ctx, tc.denom))
+
+			adjustedValue := s.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(s.Ctx, tc.denom, osmomath.NewInt(100))
+			s.Require().Equal(tc.expectedAdjustedValue, adjustedValue)
 
-	adjustedValue := s.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(
-		s.Ctx,
-		osmomath.NewInt(100),
-	)
-	s.Require().Equal(osmomath.NewInt(50), adjustedValue)
+			unadjustedValue := s.App.SuperfluidKeeper.UnriskAdjustOsmoValue(s.Ctx, tc.denom, adjustedValue.ToLegacyDec())
+			s.Require().Equal(osmomath.NewDec(100), unadjustedValue)
+		})
+	}
 }
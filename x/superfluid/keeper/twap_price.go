@@ -45,7 +45,7 @@ func (k Keeper) GetSuperfluidOSMOTokens(ctx sdk.Context, denom string, amount os
 	if err != nil {
 		return osmomath.ZeroInt(), err
 	}
-	return k.GetRiskAdjustedOsmoValue(ctx, decAmt.RoundInt()), nil
+	return k.GetRiskAdjustedOsmoValue(ctx, denom, decAmt.RoundInt()), nil
 }
 
 func (k Keeper) DeleteOsmoEquivalentMultiplier(ctx sdk.Context, denom string) {
@@ -23,6 +23,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateFullRangePositionAndSuperfluidDelegate{}, "osmosis/full-range-and-sf-delegate", nil)
 	cdc.RegisterConcrete(&MsgAddToConcentratedLiquiditySuperfluidPosition{}, "osmosis/add-to-cl-superfluid-position", nil)
 	cdc.RegisterConcrete(&MsgUnbondConvertAndStake{}, "osmosis/unbond-convert-and-stake", nil)
+	cdc.RegisterConcrete(&MsgSetSuperfluidRiskFactor{}, "osmosis/set-superfluid-risk-factor", nil)
+	cdc.RegisterConcrete(&MsgRemoveSuperfluidRiskFactor{}, "osmosis/remove-superfluid-risk-factor", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -38,6 +40,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateFullRangePositionAndSuperfluidDelegate{},
 		&MsgAddToConcentratedLiquiditySuperfluidPosition{},
 		&MsgUnbondConvertAndStake{},
+		&MsgSetSuperfluidRiskFactor{},
+		&MsgRemoveSuperfluidRiskFactor{},
 	)
 
 	registry.RegisterImplementations(
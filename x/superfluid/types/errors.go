@@ -125,3 +125,19 @@ type TokenConvertedLessThenDesiredStakeError struct {
 func (e TokenConvertedLessThenDesiredStakeError) Error() string {
 	return fmt.Sprintf("actual amount converted to stake (%s) is less then minimum amount expected to be staked (%s)", e.ActualTotalAmtToStake, e.ExpectedTotalAmtToStake)
 }
+
+type InvalidRiskFactorError struct {
+	RiskFactor osmomath.Dec
+}
+
+func (e InvalidRiskFactorError) Error() string {
+	return fmt.Sprintf("risk factor (%s) must be in [0, 1)", e.RiskFactor)
+}
+
+type NoAssetRiskFactorError struct {
+	Denom string
+}
+
+func (e NoAssetRiskFactorError) Error() string {
+	return fmt.Sprintf("superfluid asset %s has no risk factor", e.Denom)
+}
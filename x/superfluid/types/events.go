@@ -4,6 +4,8 @@ package types
 const (
 	TypeEvtSetSuperfluidAsset                           = "set_superfluid_asset"
 	TypeEvtRemoveSuperfluidAsset                        = "remove_superfluid_asset"
+	TypeEvtSetSuperfluidRiskFactor                      = "set_superfluid_risk_factor"
+	TypeEvtRemoveSuperfluidRiskFactor                   = "remove_superfluid_risk_factor"
 	TypeEvtSuperfluidDelegate                           = "superfluid_delegate"
 	TypeEvtSuperfluidIncreaseDelegation                 = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate                         = "superfluid_undelegate"
@@ -32,4 +34,5 @@ const (
 	AttributeLockId              = "lock_id"
 	AttributeValidator           = "validator"
 	AttributeAmount              = "amount"
+	AttributeRiskFactor          = "risk_factor"
 )
@@ -33,5 +33,10 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	for _, assetRiskFactor := range gs.AssetRiskFactors {
+		if err := ValidateRiskFactor(assetRiskFactor.RiskFactor); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -36,6 +36,9 @@ type GenesisState struct {
 	// plays an intermediary role between validators and the delegators.
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	// asset_risk_factors are the risk factors set by governance for superfluid
+	// assets, replacing the minimum_risk_factor param for these assets.
+	AssetRiskFactors []SuperfluidAssetRiskFactor `protobuf:"bytes,6,rep,name=asset_risk_factors,json=assetRiskFactors,proto3" json:"asset_risk_factors"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +109,13 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetAssetRiskFactors() []SuperfluidAssetRiskFactor {
+	if m != nil {
+		return m.AssetRiskFactors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -113,31 +123,34 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4e, 0xf2, 0x40,
-	0x10, 0xc7, 0xdb, 0x0f, 0x3e, 0x0e, 0xc5, 0x83, 0x36, 0x98, 0x54, 0x8c, 0x85, 0xc8, 0x85, 0x8b,
-	0x6d, 0xa8, 0x89, 0x7a, 0x05, 0x63, 0x0c, 0x89, 0x46, 0x02, 0x89, 0x07, 0x2f, 0xcd, 0x52, 0xd6,
-	0xba, 0xb1, 0xed, 0xd6, 0x9d, 0x2d, 0x81, 0x07, 0xf0, 0xce, 0x63, 0x71, 0xe4, 0xe8, 0xc9, 0x18,
-	0x78, 0x11, 0xd3, 0x76, 0x2d, 0x28, 0xd5, 0xdb, 0xb4, 0xf3, 0xfb, 0xcf, 0x6f, 0x36, 0x19, 0xa5,
-	0x4e, 0xc1, 0xa7, 0x40, 0xc0, 0x84, 0x28, 0xc4, 0xec, 0xd1, 0x8b, 0xc8, 0xc8, 0x74, 0x71, 0x80,
-	0x81, 0x80, 0x11, 0x32, 0xca, 0xa9, 0xaa, 0x0a, 0xc2, 0x58, 0x13, 0xd5, 0x8a, 0x4b, 0x5d, 0x9a,
-	0xb4, 0xcd, 0xb8, 0x4a, 0xc9, 0x6a, 0x23, 0x67, 0xd6, 0xba, 0x14, 0x50, 0x2d, 0x07, 0x0a, 0x11,
-	0x43, 0xbe, 0xf0, 0x1d, 0xcf, 0x8a, 0xca, 0xce, 0x75, 0xba, 0xc1, 0x80, 0x23, 0x8e, 0xd5, 0x0b,
-	0xa5, 0x94, 0x02, 0x9a, 0x5c, 0x97, 0x9b, 0x65, 0xab, 0x6a, 0x6c, 0x6f, 0x64, 0xf4, 0x12, 0xa2,
-	0x53, 0x9c, 0xbf, 0xd7, 0xa4, 0xbe, 0xe0, 0xd5, 0x7b, 0x65, 0x6f, 0x8d, 0xd8, 0x08, 0x00, 0x73,
-	0xd0, 0xfe, 0xd5, 0x0b, 0xcd, 0xb2, 0xd5, 0xc8, 0x1b, 0x32, 0xc8, 0xca, 0x76, 0xcc, 0x8a, 0x69,
-	0xbb, 0xf0, 0xfd, 0x37, 0xa8, 0x13, 0xe5, 0x30, 0x4e, 0xdb, 0xf8, 0x25, 0x22, 0x63, 0xe4, 0xe1,
-	0x80, 0xdb, 0x7e, 0xe4, 0x71, 0x12, 0x7a, 0x04, 0x33, 0xd0, 0x0a, 0x89, 0xc1, 0xca, 0x33, 0xdc,
-	0x81, 0x4f, 0xaf, 0xb2, 0xd4, 0x6d, 0x16, 0xea, 0x63, 0x87, 0xb2, 0x91, 0x10, 0x1e, 0xd0, 0x5f,
-	0x28, 0x50, 0x3d, 0x65, 0x9f, 0x04, 0x1c, 0x33, 0x1f, 0x8f, 0x08, 0x62, 0x53, 0x1b, 0x39, 0x0e,
-	0x8d, 0x02, 0x0e, 0x5a, 0x31, 0x71, 0xb6, 0xfe, 0x7e, 0x55, 0x77, 0x23, 0xda, 0x4e, 0x93, 0x42,
-	0x59, 0x21, 0xdb, 0x2d, 0x50, 0x5f, 0x65, 0xa5, 0x16, 0x37, 0x7e, 0xd8, 0x6c, 0x87, 0x06, 0x01,
-	0x76, 0x38, 0xa1, 0x01, 0x68, 0xff, 0x13, 0xf1, 0x79, 0x9e, 0xf8, 0x86, 0x3a, 0xcf, 0xdd, 0x3c,
-	0xe9, 0x65, 0x96, 0x17, 0xfa, 0xa3, 0x0d, 0xcb, 0x16, 0x03, 0x9d, 0xde, 0x7c, 0xa9, 0xcb, 0x8b,
-	0xa5, 0x2e, 0x7f, 0x2c, 0x75, 0x79, 0xb6, 0xd2, 0xa5, 0xc5, 0x4a, 0x97, 0xde, 0x56, 0xba, 0xf4,
-	0x70, 0xe6, 0x12, 0xfe, 0x14, 0x0d, 0x0d, 0x87, 0xfa, 0xa6, 0xd8, 0xe0, 0xc4, 0x43, 0x43, 0xf8,
-	0xfa, 0x30, 0xc7, 0x56, 0xcb, 0x9c, 0x6c, 0xde, 0x1a, 0x9f, 0x86, 0x18, 0x86, 0xa5, 0xe4, 0xd6,
-	0x4e, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x74, 0xed, 0x8a, 0x27, 0xff, 0x02, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x86, 0x5b, 0x2f, 0xb2, 0x98, 0xeb, 0x42, 0x27, 0xd7, 0xa4, 0x62, 0x2c, 0xc4, 0xbb, 0xb9,
+	0x9b, 0xdb, 0x86, 0x9a, 0xa8, 0x5b, 0x30, 0x6a, 0x48, 0x34, 0x12, 0x48, 0x5c, 0xb8, 0x69, 0x86,
+	0x61, 0xa8, 0x13, 0xda, 0x4e, 0x9d, 0x33, 0x25, 0xf0, 0x00, 0xee, 0x7d, 0x2c, 0x96, 0x2c, 0x5d,
+	0x19, 0x03, 0x8f, 0xe0, 0x0b, 0x98, 0x4e, 0x87, 0x02, 0x52, 0x8d, 0xbb, 0xd3, 0x39, 0xdf, 0x7f,
+	0xbe, 0x69, 0xce, 0xa0, 0x8e, 0x80, 0x44, 0x00, 0x07, 0x1f, 0xf2, 0x8c, 0xc9, 0x59, 0x9c, 0xf3,
+	0xa9, 0x1f, 0xb1, 0x94, 0x01, 0x07, 0x2f, 0x93, 0x42, 0x09, 0x8c, 0x0d, 0xe1, 0x1d, 0x88, 0xd6,
+	0x55, 0x24, 0x22, 0xa1, 0xdb, 0x7e, 0x51, 0x95, 0x64, 0xeb, 0xba, 0x66, 0xd6, 0xa1, 0x34, 0x50,
+	0xbb, 0x06, 0xca, 0x88, 0x24, 0x89, 0xf1, 0x3d, 0xfd, 0xd5, 0x40, 0xf7, 0xde, 0x96, 0x37, 0x18,
+	0x2b, 0xa2, 0x18, 0x7e, 0x89, 0x9a, 0x25, 0xe0, 0xd8, 0x1d, 0xfb, 0xe6, 0x32, 0x68, 0x79, 0xe7,
+	0x37, 0xf2, 0x86, 0x9a, 0xe8, 0x37, 0xd6, 0x3f, 0xda, 0xd6, 0xc8, 0xf0, 0xf8, 0x23, 0x7a, 0x70,
+	0x40, 0x42, 0x02, 0xc0, 0x14, 0x38, 0x77, 0x3a, 0x17, 0x37, 0x97, 0xc1, 0x75, 0xdd, 0x90, 0x71,
+	0x55, 0xf6, 0x0a, 0xd6, 0x4c, 0xbb, 0x0f, 0xa7, 0xc7, 0x80, 0x97, 0xe8, 0x71, 0x91, 0x0e, 0xd9,
+	0x97, 0x9c, 0x2f, 0x48, 0xcc, 0x52, 0x15, 0x26, 0x79, 0xac, 0x78, 0x16, 0x73, 0x26, 0xc1, 0xb9,
+	0xd0, 0x86, 0xa0, 0xce, 0xf0, 0x01, 0x12, 0xf1, 0xba, 0x4a, 0xbd, 0xaf, 0x42, 0x23, 0x46, 0x85,
+	0x9c, 0x1a, 0xe1, 0x23, 0xf1, 0x17, 0x0a, 0x70, 0x8c, 0x1e, 0xf2, 0x54, 0x31, 0x99, 0xb0, 0x29,
+	0x27, 0x72, 0x15, 0x12, 0x4a, 0x45, 0x9e, 0x2a, 0x70, 0x1a, 0xda, 0xd9, 0xfd, 0xf7, 0x5f, 0x0d,
+	0x8e, 0xa2, 0xbd, 0x32, 0x69, 0x94, 0x57, 0xfc, 0xbc, 0x05, 0xf8, 0xab, 0x8d, 0xda, 0x45, 0xe3,
+	0x0f, 0x5b, 0x48, 0x45, 0x9a, 0x32, 0xaa, 0xb8, 0x48, 0xc1, 0xb9, 0xab, 0xc5, 0x2f, 0xea, 0xc4,
+	0xef, 0x04, 0x9d, 0x0f, 0xea, 0xa4, 0xaf, 0xaa, 0xbc, 0xd1, 0x3f, 0x39, 0xb2, 0x9c, 0x31, 0x80,
+	0x09, 0xc2, 0x7a, 0x79, 0xa1, 0xe4, 0x30, 0x0f, 0x67, 0x84, 0x2a, 0x21, 0xc1, 0x69, 0x6a, 0xf3,
+	0xed, 0x7f, 0x2c, 0x72, 0xc4, 0x61, 0xfe, 0x46, 0xa7, 0xf6, 0x2b, 0x25, 0xa7, 0xc7, 0xd0, 0x1f,
+	0xae, 0xb7, 0xae, 0xbd, 0xd9, 0xba, 0xf6, 0xcf, 0xad, 0x6b, 0x7f, 0xdb, 0xb9, 0xd6, 0x66, 0xe7,
+	0x5a, 0xdf, 0x77, 0xae, 0xf5, 0xe9, 0x79, 0xc4, 0xd5, 0xe7, 0x7c, 0xe2, 0x51, 0x91, 0xf8, 0x46,
+	0x75, 0x1b, 0x93, 0x09, 0xec, 0x3f, 0xfc, 0x45, 0xd0, 0xf5, 0x97, 0xc7, 0xcf, 0x59, 0xad, 0x32,
+	0x06, 0x93, 0xa6, 0x7e, 0xce, 0xcf, 0x7e, 0x0f, 0x00, 0x7f, 0x22, 0xec, 0xe3, 0x62, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +173,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssetRiskFactors) > 0 {
+		for iNdEx := len(m.AssetRiskFactors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetRiskFactors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +299,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AssetRiskFactors) > 0 {
+		for _, e := range m.AssetRiskFactors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -479,6 +512,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRiskFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRiskFactors = append(m.AssetRiskFactors, SuperfluidAssetRiskFactor{})
+			if err := m.AssetRiskFactors[len(m.AssetRiskFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
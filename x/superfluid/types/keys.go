@@ -27,4 +27,7 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixSuperfluidAssetRiskFactor defines prefix key for the risk factors set by governance for superfluid assets.
+	KeyPrefixSuperfluidAssetRiskFactor = []byte{0x07}
 )
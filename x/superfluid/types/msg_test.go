@@ -151,3 +151,89 @@ func TestUnbondConvertAndStakeMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestSetSuperfluidRiskFactorMsg(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	testCases := []struct {
+		name          string
+		msg           sdk.Msg
+		expectedError bool
+	}{
+		{
+			name: "happy case",
+			msg:  types.NewMsgSetSuperfluidRiskFactor(addr1, "gamm/pool/1", osmomath.NewDecWithPrec(5, 1)),
+		},
+		{
+			name: "zero risk factor should not fail",
+			msg:  types.NewMsgSetSuperfluidRiskFactor(addr1, "gamm/pool/1", osmomath.ZeroDec()),
+		},
+		{
+			name:          "err: authority is invalid",
+			msg:           types.NewMsgSetSuperfluidRiskFactor("abcd", "gamm/pool/1", osmomath.NewDecWithPrec(5, 1)),
+			expectedError: true,
+		},
+		{
+			name:          "err: denom is invalid",
+			msg:           types.NewMsgSetSuperfluidRiskFactor(addr1, "", osmomath.NewDecWithPrec(5, 1)),
+			expectedError: true,
+		},
+		{
+			name:          "err: risk factor is negative",
+			msg:           types.NewMsgSetSuperfluidRiskFactor(addr1, "gamm/pool/1", osmomath.NewDecWithPrec(5, 1).Neg()),
+			expectedError: true,
+		},
+		{
+			name:          "err: risk factor is one",
+			msg:           types.NewMsgSetSuperfluidRiskFactor(addr1, "gamm/pool/1", osmomath.OneDec()),
+			expectedError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRemoveSuperfluidRiskFactorMsg(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	testCases := []struct {
+		name          string
+		msg           sdk.Msg
+		expectedError bool
+	}{
+		{
+			name: "happy case",
+			msg:  types.NewMsgRemoveSuperfluidRiskFactor(addr1, "gamm/pool/1"),
+		},
+		{
+			name:          "err: authority is invalid",
+			msg:           types.NewMsgRemoveSuperfluidRiskFactor("abcd", "gamm/pool/1"),
+			expectedError: true,
+		},
+		{
+			name:          "err: denom is invalid",
+			msg:           types.NewMsgRemoveSuperfluidRiskFactor(addr1, ""),
+			expectedError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
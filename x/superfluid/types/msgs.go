@@ -24,6 +24,8 @@ const (
 	TypeMsgCreateFullRangePositionAndSuperfluidDelegate = "create_full_range_position_and_delegate"
 	TypeMsgAddToConcentratedLiquiditySuperfluidPosition = "add_to_concentrated_liquidity_superfluid_position"
 	TypeMsgUnbondConvertAndStake                        = "unbond_convert_and_stake"
+	TypeMsgSetSuperfluidRiskFactor                      = "set_superfluid_risk_factor"
+	TypeMsgRemoveSuperfluidRiskFactor                   = "remove_superfluid_risk_factor"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -436,3 +438,80 @@ func (msg MsgUnbondConvertAndStake) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetSuperfluidRiskFactor{}
+
+// NewMsgSetSuperfluidRiskFactor creates a message to set the risk factor of a superfluid asset.
+func NewMsgSetSuperfluidRiskFactor(authority string, denom string, riskFactor osmomath.Dec) *MsgSetSuperfluidRiskFactor {
+	return &MsgSetSuperfluidRiskFactor{
+		Authority:  authority,
+		Denom:      denom,
+		RiskFactor: riskFactor,
+	}
+}
+
+func (msg MsgSetSuperfluidRiskFactor) Route() string { return RouterKey }
+func (msg MsgSetSuperfluidRiskFactor) Type() string {
+	return TypeMsgSetSuperfluidRiskFactor
+}
+
+func (msg MsgSetSuperfluidRiskFactor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return fmt.Errorf("Invalid authority address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateRiskFactor(msg.RiskFactor)
+}
+
+func (msg MsgSetSuperfluidRiskFactor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetSuperfluidRiskFactor) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgRemoveSuperfluidRiskFactor{}
+
+// NewMsgRemoveSuperfluidRiskFactor creates a message to remove the risk factor of a superfluid asset.
+func NewMsgRemoveSuperfluidRiskFactor(authority string, denom string) *MsgRemoveSuperfluidRiskFactor {
+	return &MsgRemoveSuperfluidRiskFactor{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+func (msg MsgRemoveSuperfluidRiskFactor) Route() string { return RouterKey }
+func (msg MsgRemoveSuperfluidRiskFactor) Type() string {
+	return TypeMsgRemoveSuperfluidRiskFactor
+}
+
+func (msg MsgRemoveSuperfluidRiskFactor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return fmt.Errorf("Invalid authority address (%s)", err)
+	}
+
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func (msg MsgRemoveSuperfluidRiskFactor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveSuperfluidRiskFactor) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -374,6 +374,92 @@ func (m *AssetMultiplierResponse) GetOsmoEquivalentMultiplier() *OsmoEquivalentM
 	return nil
 }
 
+type AssetRiskAdjustmentRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *AssetRiskAdjustmentRequest) Reset()         { *m = AssetRiskAdjustmentRequest{} }
+func (m *AssetRiskAdjustmentRequest) String() string { return proto.CompactTextString(m) }
+func (*AssetRiskAdjustmentRequest) ProtoMessage()    {}
+func (*AssetRiskAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{8}
+}
+func (m *AssetRiskAdjustmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRiskAdjustmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRiskAdjustmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetRiskAdjustmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRiskAdjustmentRequest.Merge(m, src)
+}
+func (m *AssetRiskAdjustmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRiskAdjustmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRiskAdjustmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetRiskAdjustmentRequest proto.InternalMessageInfo
+
+func (m *AssetRiskAdjustmentRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type AssetRiskAdjustmentResponse struct {
+	// risk_factor is the risk factor set by governance for the asset, or the
+	// minimum_risk_factor param if none is set.
+	RiskFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=risk_factor,json=riskFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"risk_factor" yaml:"risk_factor"`
+	// risk_adjusted_multiplier is the osmo equivalent multiplier of the asset
+	// used in the most recent epoch, multiplied by (1 - risk_factor).
+	RiskAdjustedMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=risk_adjusted_multiplier,json=riskAdjustedMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"risk_adjusted_multiplier" yaml:"risk_adjusted_multiplier"`
+}
+
+func (m *AssetRiskAdjustmentResponse) Reset()         { *m = AssetRiskAdjustmentResponse{} }
+func (m *AssetRiskAdjustmentResponse) String() string { return proto.CompactTextString(m) }
+func (*AssetRiskAdjustmentResponse) ProtoMessage()    {}
+func (*AssetRiskAdjustmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{9}
+}
+func (m *AssetRiskAdjustmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRiskAdjustmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRiskAdjustmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetRiskAdjustmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRiskAdjustmentResponse.Merge(m, src)
+}
+func (m *AssetRiskAdjustmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRiskAdjustmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRiskAdjustmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetRiskAdjustmentResponse proto.InternalMessageInfo
+
 type SuperfluidIntermediaryAccountInfo struct {
 	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	ValAddr string `protobuf:"bytes,2,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
@@ -385,7 +471,7 @@ func (m *SuperfluidIntermediaryAccountInfo) Reset()         { *m = SuperfluidInt
 func (m *SuperfluidIntermediaryAccountInfo) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidIntermediaryAccountInfo) ProtoMessage()    {}
 func (*SuperfluidIntermediaryAccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{8}
+	return fileDescriptor_e3d9448e4ed3943f, []int{10}
 }
 func (m *SuperfluidIntermediaryAccountInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,7 +536,7 @@ func (m *AllIntermediaryAccountsRequest) Reset()         { *m = AllIntermediaryA
 func (m *AllIntermediaryAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllIntermediaryAccountsRequest) ProtoMessage()    {}
 func (*AllIntermediaryAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{9}
+	return fileDescriptor_e3d9448e4ed3943f, []int{11}
 }
 func (m *AllIntermediaryAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +581,7 @@ func (m *AllIntermediaryAccountsResponse) Reset()         { *m = AllIntermediary
 func (m *AllIntermediaryAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllIntermediaryAccountsResponse) ProtoMessage()    {}
 func (*AllIntermediaryAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{10}
+	return fileDescriptor_e3d9448e4ed3943f, []int{12}
 }
 func (m *AllIntermediaryAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +632,7 @@ func (m *ConnectedIntermediaryAccountRequest) Reset()         { *m = ConnectedIn
 func (m *ConnectedIntermediaryAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectedIntermediaryAccountRequest) ProtoMessage()    {}
 func (*ConnectedIntermediaryAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{11}
+	return fileDescriptor_e3d9448e4ed3943f, []int{13}
 }
 func (m *ConnectedIntermediaryAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +676,7 @@ func (m *ConnectedIntermediaryAccountResponse) Reset()         { *m = ConnectedI
 func (m *ConnectedIntermediaryAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectedIntermediaryAccountResponse) ProtoMessage()    {}
 func (*ConnectedIntermediaryAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{12}
+	return fileDescriptor_e3d9448e4ed3943f, []int{14}
 }
 func (m *ConnectedIntermediaryAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +724,7 @@ func (m *QueryTotalDelegationByValidatorForDenomRequest) String() string {
 }
 func (*QueryTotalDelegationByValidatorForDenomRequest) ProtoMessage() {}
 func (*QueryTotalDelegationByValidatorForDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{13}
+	return fileDescriptor_e3d9448e4ed3943f, []int{15}
 }
 func (m *QueryTotalDelegationByValidatorForDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +772,7 @@ func (m *QueryTotalDelegationByValidatorForDenomResponse) String() string {
 }
 func (*QueryTotalDelegationByValidatorForDenomResponse) ProtoMessage() {}
 func (*QueryTotalDelegationByValidatorForDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{14}
+	return fileDescriptor_e3d9448e4ed3943f, []int{16}
 }
 func (m *QueryTotalDelegationByValidatorForDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +818,7 @@ func (m *Delegations) Reset()         { *m = Delegations{} }
 func (m *Delegations) String() string { return proto.CompactTextString(m) }
 func (*Delegations) ProtoMessage()    {}
 func (*Delegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{15}
+	return fileDescriptor_e3d9448e4ed3943f, []int{17}
 }
 func (m *Delegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +861,7 @@ func (m *TotalSuperfluidDelegationsRequest) Reset()         { *m = TotalSuperflu
 func (m *TotalSuperfluidDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsRequest) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{16}
+	return fileDescriptor_e3d9448e4ed3943f, []int{18}
 }
 func (m *TotalSuperfluidDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +898,7 @@ func (m *TotalSuperfluidDelegationsResponse) Reset()         { *m = TotalSuperfl
 func (m *TotalSuperfluidDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsResponse) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{17}
+	return fileDescriptor_e3d9448e4ed3943f, []int{19}
 }
 func (m *TotalSuperfluidDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +937,7 @@ func (m *SuperfluidDelegationAmountRequest) Reset()         { *m = SuperfluidDel
 func (m *SuperfluidDelegationAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{18}
+	return fileDescriptor_e3d9448e4ed3943f, []int{20}
 }
 func (m *SuperfluidDelegationAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +995,7 @@ func (m *SuperfluidDelegationAmountResponse) Reset()         { *m = SuperfluidDe
 func (m *SuperfluidDelegationAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{19}
+	return fileDescriptor_e3d9448e4ed3943f, []int{21}
 }
 func (m *SuperfluidDelegationAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1041,7 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Reset() {
 func (m *SuperfluidDelegationsByDelegatorRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{20}
+	return fileDescriptor_e3d9448e4ed3943f, []int{22}
 }
 func (m *SuperfluidDelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1089,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Reset() {
 func (m *SuperfluidDelegationsByDelegatorResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{21}
+	return fileDescriptor_e3d9448e4ed3943f, []int{23}
 }
 func (m *SuperfluidDelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1152,7 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorRequest) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{22}
+	return fileDescriptor_e3d9448e4ed3943f, []int{24}
 }
 func (m *SuperfluidUndelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1209,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorResponse) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{23}
+	return fileDescriptor_e3d9448e4ed3943f, []int{25}
 }
 func (m *SuperfluidUndelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1272,7 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomRequest) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{24}
+	return fileDescriptor_e3d9448e4ed3943f, []int{26}
 }
 func (m *SuperfluidDelegationsByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1241,7 +1327,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomResponse) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{25}
+	return fileDescriptor_e3d9448e4ed3943f, []int{27}
 }
 func (m *SuperfluidDelegationsByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,7 +1376,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) String() stri
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{26}
+	return fileDescriptor_e3d9448e4ed3943f, []int{28}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1345,7 +1431,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) String() str
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{27}
+	return fileDescriptor_e3d9448e4ed3943f, []int{29}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,7 +1477,7 @@ func (m *QueryTotalDelegationByDelegatorRequest) Reset() {
 func (m *QueryTotalDelegationByDelegatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDelegationByDelegatorRequest) ProtoMessage()    {}
 func (*QueryTotalDelegationByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{28}
+	return fileDescriptor_e3d9448e4ed3943f, []int{30}
 }
 func (m *QueryTotalDelegationByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1526,7 @@ func (m *QueryTotalDelegationByDelegatorResponse) Reset() {
 func (m *QueryTotalDelegationByDelegatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDelegationByDelegatorResponse) ProtoMessage()    {}
 func (*QueryTotalDelegationByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{29}
+	return fileDescriptor_e3d9448e4ed3943f, []int{31}
 }
 func (m *QueryTotalDelegationByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1504,7 +1590,7 @@ func (m *QueryUnpoolWhitelistRequest) Reset()         { *m = QueryUnpoolWhitelis
 func (m *QueryUnpoolWhitelistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnpoolWhitelistRequest) ProtoMessage()    {}
 func (*QueryUnpoolWhitelistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{30}
+	return fileDescriptor_e3d9448e4ed3943f, []int{32}
 }
 func (m *QueryUnpoolWhitelistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +1627,7 @@ func (m *QueryUnpoolWhitelistResponse) Reset()         { *m = QueryUnpoolWhiteli
 func (m *QueryUnpoolWhitelistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnpoolWhitelistResponse) ProtoMessage()    {}
 func (*QueryUnpoolWhitelistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{31}
+	return fileDescriptor_e3d9448e4ed3943f, []int{33}
 }
 func (m *QueryUnpoolWhitelistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1589,7 +1675,7 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedRequest) String() string {
 }
 func (*UserConcentratedSuperfluidPositionsDelegatedRequest) ProtoMessage() {}
 func (*UserConcentratedSuperfluidPositionsDelegatedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{32}
+	return fileDescriptor_e3d9448e4ed3943f, []int{34}
 }
 func (m *UserConcentratedSuperfluidPositionsDelegatedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1637,7 +1723,7 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedResponse) String() string {
 }
 func (*UserConcentratedSuperfluidPositionsDelegatedResponse) ProtoMessage() {}
 func (*UserConcentratedSuperfluidPositionsDelegatedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{33}
+	return fileDescriptor_e3d9448e4ed3943f, []int{35}
 }
 func (m *UserConcentratedSuperfluidPositionsDelegatedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1685,7 +1771,7 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingRequest) String() string
 }
 func (*UserConcentratedSuperfluidPositionsUndelegatingRequest) ProtoMessage() {}
 func (*UserConcentratedSuperfluidPositionsUndelegatingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{34}
+	return fileDescriptor_e3d9448e4ed3943f, []int{36}
 }
 func (m *UserConcentratedSuperfluidPositionsUndelegatingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1733,7 +1819,7 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) String() strin
 }
 func (*UserConcentratedSuperfluidPositionsUndelegatingResponse) ProtoMessage() {}
 func (*UserConcentratedSuperfluidPositionsUndelegatingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{35}
+	return fileDescriptor_e3d9448e4ed3943f, []int{37}
 }
 func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1778,7 +1864,7 @@ func (m *QueryRestSupplyRequest) Reset()         { *m = QueryRestSupplyRequest{}
 func (m *QueryRestSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRestSupplyRequest) ProtoMessage()    {}
 func (*QueryRestSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{36}
+	return fileDescriptor_e3d9448e4ed3943f, []int{38}
 }
 func (m *QueryRestSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +1909,7 @@ func (m *QueryRestSupplyResponse) Reset()         { *m = QueryRestSupplyResponse
 func (m *QueryRestSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRestSupplyResponse) ProtoMessage()    {}
 func (*QueryRestSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{37}
+	return fileDescriptor_e3d9448e4ed3943f, []int{39}
 }
 func (m *QueryRestSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1868,6 +1954,8 @@ func init() {
 	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
 	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
 	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
+	proto.RegisterType((*AssetRiskAdjustmentRequest)(nil), "osmosis.superfluid.AssetRiskAdjustmentRequest")
+	proto.RegisterType((*AssetRiskAdjustmentResponse)(nil), "osmosis.superfluid.AssetRiskAdjustmentResponse")
 	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
 	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
 	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
@@ -1903,139 +1991,146 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
 
 var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 2099 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
-	0xf5, 0x17, 0x25, 0x45, 0xb2, 0x9f, 0x01, 0x5b, 0x1e, 0x3b, 0x96, 0x44, 0x5b, 0x2b, 0x87, 0x72,
-	0x2c, 0xfd, 0x15, 0x9b, 0x8c, 0xe5, 0x58, 0x52, 0x9c, 0xbf, 0x8d, 0x48, 0x96, 0xe5, 0xa8, 0xb5,
-	0x63, 0x65, 0x65, 0xc9, 0xe8, 0x17, 0x58, 0x6a, 0x39, 0x5a, 0x11, 0xe2, 0x92, 0x2b, 0x0e, 0xa9,
-	0x64, 0x11, 0xb8, 0x05, 0x52, 0x14, 0x68, 0xd0, 0x43, 0x5b, 0xe4, 0x50, 0xe4, 0xd6, 0x4b, 0x0f,
-	0xcd, 0xa1, 0xbd, 0xb5, 0x28, 0xd0, 0x4b, 0xd1, 0x4b, 0x80, 0xa2, 0x40, 0x80, 0x02, 0x45, 0xd1,
-	0x83, 0x13, 0xd8, 0x3d, 0xb6, 0x97, 0x1e, 0xdb, 0x4b, 0xc1, 0x99, 0xe1, 0xc7, 0xee, 0x0e, 0xc9,
-	0xdd, 0xb5, 0x6b, 0xe7, 0xe4, 0x25, 0xe7, 0x7d, 0xfd, 0xde, 0xd7, 0xf0, 0x3d, 0x19, 0x4a, 0x2e,
-	0xa9, 0xb9, 0xc4, 0x22, 0x1a, 0x09, 0xea, 0xd8, 0xdb, 0xb1, 0x03, 0xcb, 0xd4, 0xf6, 0x03, 0xec,
-	0x35, 0xd4, 0xba, 0xe7, 0xfa, 0x2e, 0x42, 0xfc, 0x5c, 0x4d, 0xce, 0xe5, 0x93, 0x55, 0xb7, 0xea,
-	0xd2, 0x63, 0x2d, 0xfc, 0xc5, 0x28, 0xe5, 0x52, 0x85, 0x92, 0x6a, 0xdb, 0x06, 0xc1, 0xda, 0xc1,
-	0xa5, 0x6d, 0xec, 0x1b, 0x97, 0xb4, 0x8a, 0x6b, 0x39, 0xfc, 0xfc, 0x4c, 0xd5, 0x75, 0xab, 0x36,
-	0xd6, 0x8c, 0xba, 0xa5, 0x19, 0x8e, 0xe3, 0xfa, 0x86, 0x6f, 0xb9, 0x0e, 0xe1, 0xa7, 0x93, 0xfc,
-	0x94, 0x3e, 0x6d, 0x07, 0x3b, 0x9a, 0x6f, 0xd5, 0x30, 0xf1, 0x8d, 0x5a, 0x3d, 0x12, 0xdf, 0x4a,
-	0x60, 0x06, 0x1e, 0x95, 0xc0, 0xcf, 0xa7, 0x04, 0x40, 0x92, 0x9f, 0x91, 0x16, 0x01, 0x51, 0xdd,
-	0xf0, 0x8c, 0x5a, 0x64, 0xc6, 0x78, 0x44, 0x60, 0xbb, 0x95, 0xbd, 0xa0, 0x4e, 0xff, 0xe1, 0x47,
-	0xb3, 0x69, 0x7c, 0xd4, 0x45, 0x31, 0xca, 0xba, 0x51, 0xb5, 0x9c, 0xb4, 0x31, 0xe7, 0x38, 0x2d,
-	0xf1, 0x8d, 0x3d, 0xcb, 0xa9, 0xc6, 0x84, 0xfc, 0x99, 0x51, 0x29, 0x27, 0x01, 0xbd, 0x13, 0xca,
-	0x59, 0xa7, 0x16, 0x94, 0xf1, 0x7e, 0x80, 0x89, 0xaf, 0xdc, 0x85, 0x13, 0x4d, 0x6f, 0x49, 0xdd,
-	0x75, 0x08, 0x46, 0x8b, 0x30, 0xc4, 0x2c, 0x1d, 0x93, 0xce, 0x4a, 0x33, 0x47, 0xe6, 0x64, 0xb5,
-	0x3d, 0x32, 0x2a, 0xe3, 0x59, 0x1e, 0xfc, 0xf4, 0xe1, 0x64, 0x5f, 0x99, 0xd3, 0x2b, 0x33, 0x30,
-	0xb2, 0x44, 0x08, 0xf6, 0xef, 0x35, 0xea, 0x98, 0x2b, 0x41, 0x27, 0xe1, 0x05, 0x13, 0x3b, 0x6e,
-	0x8d, 0x0a, 0x3b, 0x5c, 0x66, 0x0f, 0xca, 0x37, 0xe0, 0x78, 0x8a, 0x92, 0x2b, 0x5e, 0x05, 0x30,
-	0xc2, 0x97, 0xba, 0xdf, 0xa8, 0x63, 0x4a, 0x7f, 0x74, 0x6e, 0x5a, 0xa4, 0x7c, 0x23, 0xfe, 0x99,
-	0x08, 0x39, 0x6c, 0x44, 0x3f, 0x15, 0x04, 0x23, 0x4b, 0xb6, 0x4d, 0x8f, 0x62, 0xac, 0x5b, 0x70,
-	0x3c, 0xf5, 0x8e, 0x2b, 0x5c, 0x82, 0x21, 0xca, 0x15, 0x22, 0x1d, 0x98, 0x39, 0x32, 0x37, 0xd5,
-	0x81, 0xb2, 0x08, 0x32, 0x63, 0x54, 0x54, 0x38, 0x45, 0x5f, 0xdf, 0x09, 0x6c, 0xdf, 0xaa, 0xdb,
-	0x16, 0xf6, 0xf2, 0x81, 0xff, 0x50, 0x82, 0xd1, 0x36, 0x06, 0x6e, 0x4e, 0x1d, 0xe4, 0x50, 0xbf,
-	0x8e, 0xf7, 0x03, 0xeb, 0xc0, 0xb0, 0xb1, 0xe3, 0xeb, 0xb5, 0x98, 0x8a, 0x07, 0x63, 0x4e, 0x64,
-	0xe2, 0x5d, 0x52, 0x73, 0x6f, 0xc6, 0x4c, 0x69, 0xc9, 0x15, 0xd7, 0x33, 0xcb, 0x63, 0x6e, 0xc6,
-	0xb9, 0xf2, 0xa1, 0x04, 0x2f, 0x25, 0xf8, 0xd6, 0x1c, 0x1f, 0x7b, 0x35, 0x6c, 0x5a, 0x86, 0xd7,
-	0x58, 0xaa, 0x54, 0xdc, 0xc0, 0xf1, 0xd7, 0x9c, 0x1d, 0x57, 0x8c, 0x04, 0x8d, 0xc3, 0xa1, 0x03,
-	0xc3, 0xd6, 0x0d, 0xd3, 0xf4, 0xc6, 0xfa, 0xe9, 0xc1, 0xf0, 0x81, 0x61, 0x2f, 0x99, 0xa6, 0x17,
-	0x1e, 0x55, 0x8d, 0xa0, 0x8a, 0x75, 0xcb, 0x1c, 0x1b, 0x38, 0x2b, 0xcd, 0x0c, 0x96, 0x87, 0xe9,
-	0xf3, 0x9a, 0x89, 0xc6, 0x60, 0x38, 0xe4, 0xc0, 0x84, 0x8c, 0x0d, 0x32, 0x26, 0xfe, 0xa8, 0xec,
-	0x42, 0x69, 0xc9, 0xb6, 0x05, 0x36, 0x44, 0x31, 0x0c, 0xf3, 0x23, 0xc9, 0x7f, 0xee, 0x8f, 0xf3,
-	0x2a, 0x2b, 0x00, 0x35, 0x2c, 0x16, 0x95, 0xf5, 0x13, 0x5e, 0x03, 0xea, 0xba, 0x51, 0x8d, 0xd2,
-	0xb0, 0x9c, 0xe2, 0x54, 0xfe, 0x20, 0xc1, 0x64, 0xa6, 0x2a, 0x1e, 0x8b, 0xfb, 0x70, 0xc8, 0xe0,
-	0xef, 0x78, 0x72, 0x5c, 0xc9, 0x4f, 0x8e, 0x0c, 0xe7, 0xf1, 0x74, 0x89, 0x85, 0xa1, 0x5b, 0x4d,
-	0x20, 0xfa, 0x29, 0x88, 0xe9, 0x42, 0x10, 0xcc, 0xaa, 0x26, 0x14, 0xd7, 0x61, 0xea, 0x86, 0xeb,
-	0x38, 0xb8, 0xe2, 0x63, 0x91, 0xf2, 0xc8, 0x69, 0xa3, 0x30, 0x1c, 0xb6, 0x96, 0x30, 0x14, 0x12,
-	0x0d, 0xc5, 0x50, 0xf8, 0xb8, 0x66, 0x2a, 0xef, 0xc2, 0xb9, 0x7c, 0x7e, 0xee, 0x89, 0xbb, 0x30,
-	0xcc, 0x8d, 0xe7, 0x2e, 0xef, 0xcd, 0x11, 0xe5, 0x48, 0x8a, 0xb2, 0x0a, 0x2a, 0x6d, 0x3b, 0xf7,
-	0x5c, 0xdf, 0xb0, 0x57, 0xb0, 0x8d, 0xab, 0x14, 0xd0, 0x72, 0x63, 0xcb, 0xb0, 0x2d, 0xd3, 0xf0,
-	0x5d, 0x6f, 0xd5, 0xf5, 0x56, 0xc2, 0x1c, 0xcb, 0x2f, 0xa5, 0x3a, 0x68, 0x1d, 0xcb, 0xe1, 0x58,
-	0xae, 0xb5, 0x14, 0xfc, 0xa4, 0x08, 0x4a, 0x22, 0x8a, 0xb4, 0x14, 0xfb, 0x17, 0x12, 0x1c, 0x49,
-	0x9d, 0x36, 0x95, 0x80, 0xd4, 0x5c, 0x02, 0xf7, 0xe0, 0x88, 0x51, 0x0b, 0xe1, 0xea, 0x64, 0x87,
-	0x98, 0xac, 0x40, 0x96, 0x2f, 0x87, 0xd2, 0xfe, 0xf6, 0x70, 0xf2, 0x45, 0x16, 0x6e, 0x62, 0xee,
-	0xa9, 0x96, 0xab, 0xd5, 0x0c, 0x7f, 0x57, 0x5d, 0x73, 0xfc, 0x7f, 0x3d, 0x9c, 0x44, 0x0d, 0xa3,
-	0x66, 0x5f, 0x55, 0x52, 0x9c, 0x4a, 0x19, 0xd8, 0xd3, 0xc6, 0x0e, 0x31, 0xd1, 0xb7, 0xe1, 0x58,
-	0x4b, 0x87, 0xa0, 0xf5, 0x75, 0x78, 0x79, 0xa1, 0x48, 0xf2, 0x29, 0x26, 0xb9, 0x85, 0x5b, 0x29,
-	0x1f, 0x6d, 0xee, 0x0d, 0xca, 0x14, 0xbc, 0x44, 0xfd, 0x99, 0xc4, 0x33, 0x05, 0x38, 0x6a, 0xa6,
-	0x3f, 0x95, 0x40, 0xc9, 0xa3, 0xe2, 0xde, 0xde, 0x87, 0xe3, 0x7e, 0x48, 0xa5, 0x9b, 0xc9, 0x21,
-	0xf3, 0xd3, 0xf2, 0x4a, 0x91, 0xbd, 0x53, 0xcc, 0x5e, 0xc6, 0x9f, 0x04, 0x27, 0x2d, 0x4a, 0x29,
-	0x8f, 0xf8, 0xcd, 0xa1, 0x27, 0xca, 0x47, 0x4d, 0x0d, 0x2d, 0x39, 0x59, 0xaa, 0xa5, 0x6b, 0xe2,
-	0x15, 0x38, 0xce, 0xe5, 0xb8, 0x9e, 0x1e, 0xb5, 0x23, 0x16, 0xc0, 0x91, 0xf8, 0x60, 0x89, 0xbd,
-	0x0f, 0x89, 0x0f, 0xa2, 0x84, 0x8a, 0x89, 0x59, 0xc3, 0x1b, 0x89, 0x0f, 0x22, 0xe2, 0x38, 0x53,
-	0x07, 0xd2, 0x99, 0xfa, 0xa1, 0x04, 0x4a, 0x9e, 0x55, 0xdc, 0x5f, 0x15, 0x18, 0x62, 0xb1, 0xe6,
-	0xd9, 0x39, 0xde, 0xd4, 0x16, 0xa2, 0x86, 0x70, 0xc3, 0xb5, 0x9c, 0xe5, 0x57, 0x43, 0xff, 0x7d,
-	0xf2, 0xf9, 0xe4, 0x4c, 0xd5, 0xf2, 0x77, 0x83, 0x6d, 0xb5, 0xe2, 0xd6, 0x34, 0xfe, 0x25, 0xc0,
-	0xfe, 0xb9, 0x48, 0xcc, 0x3d, 0x2d, 0xbc, 0x47, 0x09, 0x65, 0x20, 0x65, 0x2e, 0x5a, 0xd9, 0x82,
-	0x69, 0x61, 0xd4, 0x96, 0x1b, 0x2b, 0x11, 0xf2, 0x5e, 0xdc, 0xa4, 0xfc, 0x66, 0x00, 0x66, 0x8a,
-	0x05, 0x73, 0xa4, 0xef, 0xc1, 0x84, 0x30, 0xa6, 0xba, 0x47, 0x6f, 0xac, 0xa8, 0x3c, 0xd5, 0xfc,
-	0x4e, 0x93, 0x28, 0x61, 0x17, 0x1d, 0xaf, 0xd6, 0xd3, 0x24, 0x93, 0x82, 0xa0, 0xef, 0xc2, 0x8b,
-	0x4d, 0x39, 0x89, 0x4d, 0x3d, 0xfc, 0x72, 0x0c, 0x23, 0xfa, 0xd4, 0x5d, 0x7e, 0x22, 0x9d, 0x9e,
-	0xd8, 0xa4, 0x2f, 0xd1, 0x8f, 0x24, 0x28, 0x31, 0x0b, 0x52, 0xd7, 0x7c, 0xf8, 0xb5, 0x86, 0x4d,
-	0x9d, 0x47, 0x7f, 0x80, 0xb6, 0xd9, 0x1c, 0x53, 0x34, 0x6e, 0xca, 0x74, 0x87, 0xa6, 0x94, 0x4f,
-	0x53, 0x8d, 0x49, 0x99, 0x6f, 0x50, 0x7d, 0x2c, 0xfd, 0x14, 0x07, 0xfe, 0x2f, 0xf1, 0xe9, 0xa6,
-	0x63, 0x3e, 0xb5, 0x9c, 0x48, 0xaa, 0xa1, 0x3f, 0x5d, 0x0d, 0xff, 0xee, 0x87, 0xd9, 0x4e, 0x14,
-	0x3e, 0xf7, 0x5c, 0xf9, 0x9e, 0x04, 0xa3, 0x2c, 0x54, 0x81, 0xf3, 0x0c, 0xd2, 0x85, 0x25, 0xe6,
-	0x66, 0xa2, 0x8a, 0x25, 0xcc, 0x6d, 0x38, 0x46, 0x1a, 0x8e, 0xbf, 0x8b, 0x7d, 0xab, 0xa2, 0x87,
-	0x77, 0x37, 0x19, 0x1b, 0xa0, 0xca, 0x27, 0x62, 0xc4, 0x6c, 0x84, 0x50, 0x37, 0x22, 0xb2, 0xdb,
-	0x6e, 0x65, 0x8f, 0x03, 0x3c, 0x4a, 0xd2, 0x2f, 0x89, 0xb2, 0x0f, 0x17, 0x32, 0xaa, 0x34, 0xbe,
-	0x35, 0x9b, 0xae, 0x5e, 0x61, 0xf7, 0x93, 0x8a, 0xba, 0x5f, 0x53, 0xbc, 0x7f, 0x21, 0xc1, 0xc5,
-	0x0e, 0x75, 0x3e, 0xef, 0x90, 0x2b, 0x0f, 0x60, 0xf1, 0x26, 0xf1, 0xad, 0x9a, 0xe1, 0xe3, 0x36,
-	0x41, 0x51, 0xc1, 0xfc, 0x0f, 0x5d, 0xf5, 0x3b, 0x09, 0x5e, 0xef, 0x41, 0x3f, 0x77, 0x5b, 0x66,
-	0x6f, 0x93, 0x9e, 0x4d, 0x6f, 0x53, 0x36, 0xe1, 0xbc, 0xf8, 0x8b, 0xec, 0xc9, 0xae, 0x96, 0x8f,
-	0x07, 0x61, 0xba, 0x50, 0xee, 0x73, 0xef, 0x16, 0x06, 0x9c, 0x68, 0x52, 0xc7, 0x0c, 0xe2, 0x8d,
-	0x62, 0x36, 0xf2, 0x7d, 0x34, 0x97, 0x47, 0xee, 0x4f, 0xcb, 0x61, 0x1c, 0x5c, 0x17, 0x32, 0xdb,
-	0x4e, 0xb2, 0x03, 0x3c, 0xf0, 0xe5, 0xb9, 0xbc, 0x06, 0x9f, 0xed, 0xe5, 0x35, 0x01, 0xa7, 0x69,
-	0x6a, 0x6c, 0x3a, 0x75, 0xd7, 0xb5, 0xef, 0xef, 0x5a, 0x3e, 0xb6, 0x2d, 0x12, 0x7d, 0xe9, 0x29,
-	0xaf, 0xc3, 0x19, 0xf1, 0x31, 0xf7, 0xe8, 0x38, 0x1c, 0x0a, 0x0f, 0x74, 0x8b, 0x67, 0xc6, 0x60,
-	0x79, 0x38, 0x7c, 0x5e, 0x33, 0x89, 0xb2, 0x0d, 0x97, 0x37, 0x09, 0xf6, 0x6e, 0xb8, 0x4e, 0x05,
-	0x3b, 0xbe, 0x17, 0x3a, 0x21, 0x49, 0x90, 0x75, 0x97, 0x58, 0xb4, 0x87, 0xc5, 0x0e, 0xea, 0x29,
-	0xb3, 0x7f, 0x2d, 0xc1, 0x6b, 0xdd, 0x29, 0xe1, 0x76, 0x7f, 0x07, 0x26, 0x2a, 0xb6, 0x4e, 0x4d,
-	0x0f, 0x08, 0xf6, 0xf4, 0x3a, 0x27, 0x6d, 0x49, 0xf3, 0x79, 0x51, 0x9a, 0xa7, 0x95, 0xad, 0xbb,
-	0xae, 0x1d, 0x1a, 0x10, 0xa9, 0x6a, 0x4a, 0xf7, 0xf1, 0x8a, 0x2d, 0x3e, 0x27, 0x0a, 0x86, 0xf9,
-	0x0e, 0xec, 0x4e, 0xee, 0x76, 0xa7, 0xda, 0x93, 0x7f, 0x7e, 0x2b, 0xc1, 0x42, 0xd7, 0x7a, 0xbe,
-	0x24, 0x2e, 0x52, 0xe1, 0x14, 0x4d, 0xbd, 0x32, 0x26, 0xfe, 0x46, 0x50, 0xaf, 0xdb, 0x8d, 0xfc,
-	0x71, 0xb6, 0x0c, 0xa3, 0x6d, 0xf4, 0x1c, 0xca, 0x42, 0x6a, 0x30, 0x28, 0xa8, 0xae, 0x68, 0x60,
-	0xa5, 0xe4, 0x73, 0x7f, 0x99, 0x80, 0x17, 0xa8, 0x50, 0xf4, 0x7d, 0x09, 0x86, 0xd8, 0xce, 0x0e,
-	0x9d, 0x17, 0x21, 0x6e, 0x5f, 0x0f, 0xca, 0xd3, 0x85, 0x74, 0xcc, 0x3c, 0x65, 0xf6, 0x83, 0x3f,
-	0xff, 0xfd, 0xa3, 0xfe, 0x73, 0x48, 0xd1, 0x04, 0x4b, 0xcf, 0x64, 0x73, 0x49, 0x95, 0xff, 0x40,
-	0x82, 0xc3, 0xf1, 0xd2, 0x0e, 0x9d, 0x13, 0xa9, 0x68, 0x5d, 0x21, 0xca, 0x2f, 0x17, 0x50, 0x71,
-	0x33, 0x54, 0x6a, 0xc6, 0x0c, 0x3a, 0x9f, 0x67, 0x46, 0xb2, 0x60, 0x64, 0xa6, 0x44, 0x3b, 0xc1,
-	0x0c, 0x53, 0x5a, 0xd6, 0x88, 0x19, 0xa6, 0xb4, 0x2e, 0x16, 0x3b, 0x34, 0xc5, 0xb6, 0x75, 0xb6,
-	0x58, 0x40, 0x3f, 0x93, 0xe0, 0x58, 0xcb, 0x56, 0x10, 0xcd, 0x66, 0xa2, 0x6e, 0xdb, 0x35, 0xca,
-	0xaf, 0x74, 0x44, 0xcb, 0x8d, 0x7b, 0x8d, 0x1a, 0xa7, 0xa2, 0x0b, 0xc5, 0x7e, 0x4a, 0xd6, 0x8f,
-	0xe8, 0xf7, 0x12, 0x8c, 0x66, 0x2c, 0xcd, 0xd0, 0x5c, 0x86, 0x57, 0x72, 0x96, 0x79, 0xf2, 0xe5,
-	0xae, 0x78, 0xb8, 0xe9, 0xd7, 0xa8, 0xe9, 0x0b, 0xe8, 0x4a, 0x91, 0x5f, 0xad, 0x94, 0x14, 0x3d,
-	0xde, 0xbd, 0x7d, 0x2e, 0xc1, 0x99, 0xbc, 0x9d, 0x17, 0x5a, 0xc8, 0x68, 0x06, 0x45, 0x5b, 0x36,
-	0x79, 0xb1, 0x7b, 0x46, 0x0e, 0xe9, 0x36, 0x85, 0xb4, 0x8a, 0x56, 0xf2, 0x20, 0x55, 0x22, 0x49,
-	0x42, 0x60, 0xda, 0xfb, 0x7c, 0xc3, 0xf7, 0x00, 0xfd, 0x2a, 0xda, 0xcc, 0xe4, 0xee, 0xc3, 0xd0,
-	0x72, 0x66, 0x69, 0x77, 0xbc, 0x94, 0x93, 0x6f, 0x3c, 0x91, 0x0c, 0x8e, 0xbe, 0x0f, 0xfd, 0x51,
-	0x02, 0x39, 0x7b, 0x97, 0x84, 0x84, 0xcb, 0xc6, 0xc2, 0x0d, 0x95, 0x3c, 0xdf, 0x2d, 0x1b, 0xb7,
-	0xe7, 0x3a, 0x8d, 0xc6, 0x22, 0x9a, 0x2f, 0x4a, 0x30, 0xf1, 0x4a, 0x0a, 0xfd, 0x49, 0x02, 0x39,
-	0x7b, 0xd3, 0x83, 0xae, 0x74, 0xfa, 0xd9, 0xd9, 0xb4, 0xaf, 0x12, 0xa3, 0x29, 0x5e, 0x28, 0x29,
-	0x6f, 0x52, 0x34, 0x57, 0xd1, 0x62, 0x1e, 0x1a, 0xf1, 0xe7, 0x32, 0xbb, 0x40, 0xd0, 0x3f, 0x25,
-	0x38, 0x5b, 0xb4, 0xd5, 0x41, 0x6f, 0x74, 0x6a, 0x9e, 0x60, 0xa1, 0x20, 0xff, 0x7f, 0x6f, 0xcc,
-	0x1c, 0xe1, 0xdb, 0x14, 0xe1, 0x5b, 0x68, 0xb5, 0x6b, 0x84, 0x44, 0x7b, 0xbf, 0xed, 0x3b, 0xe4,
-	0x01, 0xfa, 0xa0, 0x3f, 0xbd, 0xa9, 0xcb, 0xda, 0x4d, 0xa0, 0x6b, 0xf9, 0x46, 0x17, 0x2c, 0x51,
-	0xe4, 0xeb, 0xbd, 0xb2, 0x73, 0xd4, 0xdf, 0xa2, 0xa8, 0xef, 0xa3, 0xcd, 0x0e, 0x51, 0x07, 0x69,
-	0x81, 0xfa, 0x76, 0x43, 0x8f, 0x91, 0x0b, 0x9d, 0xf0, 0x1f, 0x09, 0x5e, 0xee, 0x68, 0x60, 0x47,
-	0x6f, 0x76, 0x11, 0x3c, 0xe1, 0xd0, 0x2c, 0x2f, 0x3d, 0x81, 0x04, 0xee, 0x8d, 0x3b, 0xd4, 0x1b,
-	0xb7, 0xd0, 0xcd, 0xee, 0x73, 0x20, 0xf4, 0x45, 0x32, 0xb3, 0xb3, 0xbf, 0x6b, 0xfd, 0xb2, 0x1f,
-	0x2e, 0x75, 0x3d, 0x83, 0xa3, 0xdb, 0x22, 0x1c, 0xbd, 0xae, 0x12, 0xe4, 0x3b, 0x4f, 0x49, 0x1a,
-	0xf7, 0xd0, 0x37, 0xa9, 0x87, 0xb6, 0xd0, 0xbd, 0x3c, 0x0f, 0x61, 0x2e, 0x5e, 0xcf, 0x6b, 0x08,
-	0x22, 0x87, 0xfd, 0x23, 0xea, 0xe0, 0xc2, 0xc9, 0x1c, 0x5d, 0xed, 0xfc, 0x9e, 0x68, 0x2b, 0x94,
-	0x37, 0x7a, 0xe2, 0xe5, 0xa8, 0x37, 0x29, 0xea, 0xbb, 0xe8, 0x4e, 0x1e, 0xea, 0xd6, 0x3f, 0x50,
-	0x14, 0x57, 0xc7, 0x27, 0x12, 0x1c, 0x6b, 0x19, 0x27, 0x91, 0x96, 0x69, 0xa7, 0x78, 0x2e, 0x95,
-	0x5f, 0xed, 0x9c, 0xa1, 0x9b, 0xaf, 0xb6, 0x80, 0x32, 0xeb, 0xef, 0xc6, 0x86, 0x7d, 0xdc, 0x0f,
-	0x17, 0xba, 0x19, 0x30, 0xd1, 0x2d, 0x91, 0x61, 0x3d, 0xcc, 0xc1, 0xf2, 0x5b, 0x4f, 0x2e, 0x88,
-	0x23, 0xdf, 0xa2, 0xc8, 0xd7, 0xd1, 0xdb, 0xb9, 0x77, 0x32, 0xfb, 0x14, 0x4a, 0x6f, 0x46, 0xec,
-	0x78, 0xe4, 0x13, 0xf7, 0xfa, 0x9f, 0xf7, 0x83, 0xd6, 0xe5, 0x70, 0x89, 0xbe, 0xd2, 0x23, 0x2a,
-	0xc1, 0x24, 0x2c, 0x7f, 0xf5, 0xa9, 0xc8, 0xe2, 0x4e, 0xfa, 0x1a, 0x75, 0xd2, 0x06, 0x7a, 0xa7,
-	0x13, 0x27, 0x05, 0x29, 0x09, 0xc5, 0x7e, 0xfa, 0x89, 0x04, 0x90, 0x0c, 0xa5, 0xe2, 0xb9, 0x44,
-	0x3c, 0xe9, 0x8a, 0xe7, 0x92, 0x8c, 0x29, 0xb7, 0xb3, 0x31, 0x92, 0x50, 0x9e, 0xe5, 0xf5, 0x4f,
-	0x1f, 0x95, 0xa4, 0xcf, 0x1e, 0x95, 0xa4, 0x2f, 0x1e, 0x95, 0xa4, 0x1f, 0x3f, 0x2e, 0xf5, 0x7d,
-	0xf6, 0xb8, 0xd4, 0xf7, 0xd7, 0xc7, 0xa5, 0xbe, 0xaf, 0xcf, 0xa7, 0xd6, 0x4a, 0x5c, 0xce, 0x45,
-	0xdb, 0xd8, 0x26, 0xb1, 0xd0, 0x83, 0xb9, 0x4b, 0xda, 0x7b, 0x69, 0xd1, 0x74, 0xd5, 0xb4, 0x3d,
-	0x44, 0xff, 0xa7, 0xcc, 0xe5, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xdb, 0x79, 0xa6, 0xa7,
-	0x24, 0x00, 0x00,
+	// 2222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x6c, 0x1c, 0x57,
+	0x19, 0xcf, 0xd8, 0xae, 0x1d, 0x7f, 0x96, 0x12, 0xe7, 0x25, 0x4d, 0xec, 0x71, 0xb2, 0x4e, 0xc7,
+	0x49, 0x6c, 0x9c, 0x64, 0xa6, 0xb6, 0x1b, 0xdb, 0x49, 0x49, 0xd4, 0x75, 0x1c, 0xa7, 0x06, 0xa7,
+	0x71, 0xc7, 0xb1, 0x23, 0x0a, 0x68, 0x18, 0xef, 0x3c, 0xaf, 0x07, 0xcf, 0xce, 0xac, 0xe7, 0xcd,
+	0xb8, 0x5d, 0x55, 0x01, 0x54, 0x84, 0x44, 0xe1, 0x00, 0xa8, 0x07, 0xd4, 0x1b, 0x17, 0x0e, 0xf4,
+	0x00, 0xe2, 0x02, 0x42, 0xe2, 0x82, 0xb8, 0x54, 0x42, 0x48, 0x95, 0xb8, 0x20, 0x0e, 0x69, 0x95,
+	0x70, 0x84, 0x0b, 0x47, 0xe0, 0x80, 0xe6, 0xcd, 0x9b, 0x3f, 0xbb, 0xfb, 0x76, 0x66, 0x77, 0x13,
+	0x92, 0x9e, 0xb2, 0x33, 0xef, 0xfb, 0xf7, 0xfb, 0xde, 0xf7, 0x7d, 0xf3, 0xde, 0xcf, 0x81, 0x82,
+	0x43, 0x2a, 0x0e, 0x31, 0x89, 0x42, 0xfc, 0x2a, 0x76, 0x77, 0x2c, 0xdf, 0x34, 0x94, 0x7d, 0x1f,
+	0xbb, 0x35, 0xb9, 0xea, 0x3a, 0x9e, 0x83, 0x10, 0x5b, 0x97, 0x93, 0x75, 0xf1, 0x44, 0xd9, 0x29,
+	0x3b, 0x74, 0x59, 0x09, 0x7e, 0x85, 0x92, 0x62, 0xa1, 0x44, 0x45, 0x95, 0x6d, 0x9d, 0x60, 0xe5,
+	0x60, 0x66, 0x1b, 0x7b, 0xfa, 0x8c, 0x52, 0x72, 0x4c, 0x9b, 0xad, 0x9f, 0x2e, 0x3b, 0x4e, 0xd9,
+	0xc2, 0x8a, 0x5e, 0x35, 0x15, 0xdd, 0xb6, 0x1d, 0x4f, 0xf7, 0x4c, 0xc7, 0x26, 0x6c, 0x75, 0x9c,
+	0xad, 0xd2, 0xa7, 0x6d, 0x7f, 0x47, 0xf1, 0xcc, 0x0a, 0x26, 0x9e, 0x5e, 0xa9, 0x46, 0xe6, 0x1b,
+	0x05, 0x0c, 0xdf, 0xa5, 0x16, 0xd8, 0xfa, 0x04, 0x07, 0x48, 0xf2, 0x33, 0xf2, 0xc2, 0x11, 0xaa,
+	0xea, 0xae, 0x5e, 0x89, 0xc2, 0x18, 0x8d, 0x04, 0x2c, 0xa7, 0xb4, 0xe7, 0x57, 0xe9, 0x3f, 0x6c,
+	0x69, 0x3a, 0x8d, 0x8f, 0xa6, 0x28, 0x46, 0x59, 0xd5, 0xcb, 0xa6, 0x9d, 0x0e, 0xe6, 0x1c, 0x93,
+	0x25, 0x9e, 0xbe, 0x67, 0xda, 0xe5, 0x58, 0x90, 0x3d, 0x87, 0x52, 0xd2, 0x09, 0x40, 0x6f, 0x06,
+	0x76, 0xd6, 0x69, 0x04, 0x2a, 0xde, 0xf7, 0x31, 0xf1, 0xa4, 0xbb, 0x70, 0xbc, 0xee, 0x2d, 0xa9,
+	0x3a, 0x36, 0xc1, 0x68, 0x11, 0xfa, 0xc3, 0x48, 0x47, 0x84, 0xb3, 0xc2, 0xd4, 0xd0, 0xac, 0x28,
+	0x37, 0xef, 0x8c, 0x1c, 0xea, 0x2c, 0xf5, 0x7d, 0xfc, 0x70, 0xfc, 0x90, 0xca, 0xe4, 0xa5, 0x29,
+	0x18, 0x2e, 0x12, 0x82, 0xbd, 0x7b, 0xb5, 0x2a, 0x66, 0x4e, 0xd0, 0x09, 0x78, 0xc1, 0xc0, 0xb6,
+	0x53, 0xa1, 0xc6, 0x06, 0xd5, 0xf0, 0x41, 0xfa, 0x2a, 0x1c, 0x4b, 0x49, 0x32, 0xc7, 0x2b, 0x00,
+	0x7a, 0xf0, 0x52, 0xf3, 0x6a, 0x55, 0x4c, 0xe5, 0x8f, 0xcc, 0x4e, 0xf2, 0x9c, 0x6f, 0xc4, 0x3f,
+	0x13, 0x23, 0x83, 0x7a, 0xf4, 0x53, 0x42, 0x30, 0x5c, 0xb4, 0x2c, 0xba, 0x14, 0x63, 0xdd, 0x82,
+	0x63, 0xa9, 0x77, 0xcc, 0x61, 0x11, 0xfa, 0xa9, 0x56, 0x80, 0xb4, 0x77, 0x6a, 0x68, 0x76, 0xa2,
+	0x0d, 0x67, 0x11, 0xe4, 0x50, 0x51, 0x92, 0xe1, 0x24, 0x7d, 0x7d, 0xc7, 0xb7, 0x3c, 0xb3, 0x6a,
+	0x99, 0xd8, 0xcd, 0x06, 0xfe, 0x43, 0x01, 0x4e, 0x35, 0x29, 0xb0, 0x70, 0xaa, 0x20, 0x06, 0xfe,
+	0x35, 0xbc, 0xef, 0x9b, 0x07, 0xba, 0x85, 0x6d, 0x4f, 0xab, 0xc4, 0x52, 0x6c, 0x33, 0x66, 0x79,
+	0x21, 0xde, 0x25, 0x15, 0xe7, 0x56, 0xac, 0x94, 0xb6, 0x5c, 0x72, 0x5c, 0x43, 0x1d, 0x71, 0x5a,
+	0xac, 0x4b, 0xb3, 0x20, 0xd2, 0x60, 0x54, 0x93, 0xec, 0x15, 0x8d, 0x6f, 0xfa, 0xc4, 0xab, 0x60,
+	0xdb, 0xcb, 0x46, 0xf0, 0x5f, 0x01, 0xc6, 0xb8, 0x4a, 0x0c, 0xc5, 0x5b, 0x30, 0xe4, 0x9a, 0x64,
+	0x4f, 0xdb, 0xd1, 0x4b, 0x9e, 0x13, 0x86, 0x3d, 0xb8, 0x74, 0x35, 0x48, 0xda, 0xdf, 0x1e, 0x8e,
+	0x8f, 0x85, 0xe5, 0x4a, 0x8c, 0x3d, 0xd9, 0x74, 0x94, 0x8a, 0xee, 0xed, 0xca, 0x6b, 0xb8, 0xac,
+	0x97, 0x6a, 0xcb, 0xb8, 0xf4, 0xaf, 0x87, 0xe3, 0xa8, 0xa6, 0x57, 0xac, 0x6b, 0x52, 0x4a, 0x5f,
+	0x52, 0x21, 0x78, 0x5a, 0xa1, 0x0f, 0xe8, 0x3b, 0x02, 0x8c, 0xd0, 0x45, 0x9d, 0xfa, 0xc5, 0x46,
+	0x3a, 0x41, 0x3d, 0xd4, 0xd3, 0x4a, 0x7b, 0x9e, 0xc6, 0x53, 0x9e, 0x38, 0xc6, 0x24, 0xf5, 0xa4,
+	0x1b, 0xc3, 0xc3, 0x46, 0x2a, 0x65, 0xef, 0x0b, 0xf0, 0x52, 0x52, 0x12, 0xab, 0xb6, 0x87, 0xdd,
+	0x0a, 0x36, 0x4c, 0xdd, 0xad, 0x15, 0x4b, 0x25, 0xc7, 0xb7, 0xbd, 0x55, 0x7b, 0xc7, 0xe1, 0xa7,
+	0x0e, 0x8d, 0xc2, 0xe1, 0x03, 0xdd, 0xd2, 0x74, 0xc3, 0x60, 0xd1, 0xaa, 0x03, 0x07, 0xba, 0x55,
+	0x34, 0x0c, 0x37, 0x58, 0x2a, 0xeb, 0x7e, 0x19, 0x6b, 0xa6, 0x31, 0xd2, 0x7b, 0x56, 0x98, 0xea,
+	0x53, 0x07, 0xe8, 0xf3, 0xaa, 0x81, 0x46, 0x60, 0x20, 0xd0, 0xc0, 0x84, 0x8c, 0xf4, 0x85, 0x4a,
+	0xec, 0x51, 0xda, 0x85, 0x42, 0xd1, 0xb2, 0x38, 0x31, 0x44, 0x65, 0x1f, 0xb4, 0x54, 0x32, 0x32,
+	0x58, 0x09, 0x5d, 0x90, 0xc3, 0xd4, 0xc8, 0xc1, 0x7c, 0x91, 0xc3, 0x11, 0xcc, 0xc6, 0x86, 0xbc,
+	0xae, 0x97, 0xa3, 0xce, 0x55, 0x53, 0x9a, 0xd2, 0x1f, 0x05, 0x18, 0x6f, 0xe9, 0x8a, 0x6d, 0xfc,
+	0x7d, 0x38, 0xac, 0xb3, 0x77, 0xac, 0x9f, 0xae, 0x64, 0xf7, 0x53, 0x8b, 0xe4, 0xb1, 0x0e, 0x8b,
+	0x8d, 0xa1, 0xdb, 0x75, 0x20, 0x7a, 0x28, 0x88, 0xc9, 0x5c, 0x10, 0x61, 0x54, 0x75, 0x28, 0x6e,
+	0xc0, 0xc4, 0x4d, 0xc7, 0xb6, 0x71, 0xc9, 0xc3, 0x3c, 0xe7, 0x51, 0xd2, 0x4e, 0xc1, 0x40, 0x30,
+	0x8d, 0x83, 0xad, 0x10, 0xe8, 0x56, 0xf4, 0x07, 0x8f, 0xab, 0x86, 0xf4, 0x36, 0x9c, 0xcb, 0xd6,
+	0x67, 0x99, 0xb8, 0x0b, 0x03, 0x2c, 0x78, 0x96, 0xf2, 0xee, 0x12, 0xa1, 0x46, 0x56, 0xa4, 0x15,
+	0x90, 0xe9, 0xa4, 0xbe, 0xe7, 0x78, 0xba, 0xb5, 0x8c, 0x2d, 0x5c, 0xa6, 0x80, 0x96, 0x6a, 0x5b,
+	0xba, 0x65, 0x1a, 0xba, 0xe7, 0xb8, 0x2b, 0x8e, 0xbb, 0x1c, 0xd4, 0x58, 0x76, 0xef, 0x56, 0x41,
+	0x69, 0xdb, 0x0e, 0xc3, 0x72, 0xbd, 0x61, 0x46, 0x8e, 0xf3, 0xa0, 0x24, 0xa6, 0x48, 0xc3, 0x7c,
+	0xfc, 0x4c, 0x80, 0xa1, 0xd4, 0x6a, 0x5d, 0x0b, 0x08, 0xf5, 0x2d, 0x70, 0x0f, 0x86, 0xf4, 0x4a,
+	0x00, 0x57, 0x23, 0x3b, 0xc4, 0x60, 0xed, 0x3c, 0xc7, 0xda, 0xf9, 0xc5, 0xe6, 0x76, 0x5e, 0xb5,
+	0xbd, 0x64, 0x64, 0xa4, 0x34, 0x25, 0x15, 0xc2, 0xa7, 0x8d, 0x1d, 0x62, 0xa0, 0x6f, 0xc0, 0xd1,
+	0x86, 0xa1, 0x4a, 0xfb, 0x6b, 0x70, 0x69, 0x21, 0xcf, 0xf2, 0xc9, 0xd0, 0x72, 0x83, 0xb6, 0xa4,
+	0x1e, 0xa9, 0x1f, 0xa7, 0xd2, 0x04, 0xbc, 0x44, 0xf3, 0x99, 0xec, 0x67, 0x0a, 0x70, 0xf4, 0xfd,
+	0xf9, 0xa9, 0x00, 0x52, 0x96, 0x14, 0xcb, 0xf6, 0x3e, 0x1c, 0xf3, 0x02, 0x29, 0xcd, 0x48, 0x16,
+	0xd9, 0x08, 0x5d, 0xce, 0x8b, 0x77, 0x22, 0x8c, 0x37, 0xd4, 0x4f, 0x36, 0x27, 0x6d, 0x4a, 0x52,
+	0x87, 0xbd, 0xfa, 0xad, 0x27, 0xd2, 0x07, 0x75, 0x03, 0x2d, 0x59, 0x29, 0x56, 0xd2, 0x3d, 0x71,
+	0x11, 0x8e, 0x31, 0x3b, 0x8e, 0xab, 0x45, 0xe3, 0x28, 0xdc, 0xc0, 0xe1, 0x78, 0xa1, 0x18, 0xbe,
+	0x0f, 0x84, 0x0f, 0xa2, 0x82, 0x8a, 0x85, 0xc3, 0x81, 0x37, 0x1c, 0x2f, 0x44, 0xc2, 0x71, 0xa5,
+	0xf6, 0xa6, 0x2b, 0xf5, 0x7d, 0x01, 0xa4, 0xac, 0xa8, 0x58, 0xbe, 0x4a, 0xd0, 0x1f, 0xee, 0x35,
+	0xab, 0xce, 0xd1, 0xba, 0xb1, 0x10, 0x0d, 0x84, 0x9b, 0x8e, 0x69, 0x2f, 0xbd, 0x1c, 0xe4, 0xef,
+	0xa3, 0x4f, 0xc7, 0xa7, 0xca, 0xa6, 0xb7, 0xeb, 0x6f, 0xcb, 0x25, 0xa7, 0xa2, 0x84, 0xc2, 0xec,
+	0x9f, 0xcb, 0xc4, 0xd8, 0x53, 0x82, 0xa3, 0x07, 0xa1, 0x0a, 0x44, 0x65, 0xa6, 0xa5, 0x2d, 0x98,
+	0xe4, 0xee, 0xda, 0x52, 0x6d, 0x39, 0x42, 0xde, 0x4d, 0x9a, 0xa4, 0xdf, 0xf6, 0xc2, 0x54, 0xbe,
+	0x61, 0x86, 0xf4, 0x1d, 0x38, 0xc3, 0xdd, 0x53, 0xcd, 0xa5, 0x1f, 0xf9, 0xa8, 0x3d, 0xe5, 0xec,
+	0x49, 0x93, 0x38, 0x09, 0xcf, 0x06, 0xac, 0x5b, 0xc7, 0x48, 0x4b, 0x09, 0x82, 0xbe, 0x0d, 0x2f,
+	0xd6, 0xd5, 0x24, 0x36, 0xb4, 0xe0, 0xb0, 0x1d, 0xec, 0xe8, 0x53, 0x4f, 0xf9, 0xf1, 0x74, 0x79,
+	0x62, 0x83, 0xbe, 0x44, 0x3f, 0x12, 0xa0, 0x10, 0x46, 0x90, 0x3a, 0x19, 0x05, 0x07, 0x5c, 0x6c,
+	0x68, 0x6c, 0xf7, 0x7b, 0xcf, 0x0a, 0xd9, 0xa1, 0x28, 0x2c, 0x94, 0xc9, 0x36, 0x43, 0x51, 0xc7,
+	0xa8, 0xc7, 0xa4, 0xcd, 0x37, 0xa8, 0xbf, 0xb0, 0xfc, 0x24, 0x1b, 0xbe, 0x90, 0xe4, 0x74, 0xd3,
+	0x36, 0x9e, 0x5a, 0x4d, 0x24, 0xdd, 0xd0, 0x93, 0xee, 0x86, 0x7f, 0xf7, 0xc0, 0x74, 0x3b, 0x0e,
+	0x9f, 0x7b, 0xad, 0x7c, 0x57, 0x80, 0x53, 0xe1, 0x56, 0xf9, 0xf6, 0x33, 0x28, 0x97, 0xb0, 0x30,
+	0x37, 0x13, 0x57, 0x61, 0xc1, 0xac, 0xc1, 0x51, 0x52, 0xb3, 0xbd, 0x5d, 0xec, 0x99, 0x25, 0x2d,
+	0xf8, 0x76, 0x93, 0x91, 0x5e, 0xea, 0xfc, 0x4c, 0x8c, 0x38, 0xbc, 0x75, 0xc9, 0x1b, 0x91, 0xd8,
+	0x9a, 0x53, 0xda, 0x63, 0x00, 0x8f, 0x90, 0xf4, 0x4b, 0x22, 0xed, 0xc3, 0xa5, 0x16, 0x5d, 0x1a,
+	0x7f, 0x35, 0xeb, 0x3e, 0xbd, 0xdc, 0xe9, 0x27, 0xe4, 0x4d, 0xbf, 0xba, 0xfd, 0xfe, 0x85, 0x00,
+	0x97, 0xdb, 0xf4, 0xf9, 0xbc, 0xb7, 0x5c, 0x7a, 0x00, 0x8b, 0xb7, 0x88, 0x67, 0x56, 0x74, 0x0f,
+	0x37, 0x19, 0x8a, 0x1a, 0xe6, 0xff, 0x98, 0xaa, 0xdf, 0x0b, 0x70, 0xb5, 0x0b, 0xff, 0x2c, 0x6d,
+	0x2d, 0x67, 0x9b, 0xf0, 0x6c, 0x66, 0x9b, 0xb4, 0x09, 0x17, 0xf8, 0x27, 0xb2, 0x27, 0xfb, 0xb4,
+	0x7c, 0xd8, 0x07, 0x93, 0xb9, 0x76, 0x9f, 0xfb, 0xb4, 0xd0, 0xe1, 0x78, 0x9d, 0xbb, 0x30, 0x20,
+	0x36, 0x28, 0xa6, 0xa3, 0xdc, 0x47, 0x54, 0x46, 0x94, 0xfe, 0xb4, 0x9d, 0x50, 0x83, 0xf9, 0x42,
+	0x46, 0xd3, 0x4a, 0xeb, 0x0d, 0xee, 0xfd, 0xfc, 0x7c, 0xbc, 0xfa, 0x9e, 0xed, 0xc7, 0xeb, 0x0c,
+	0x8c, 0xd1, 0xd2, 0xd8, 0xb4, 0xab, 0x8e, 0x63, 0xdd, 0xdf, 0x35, 0x3d, 0x6c, 0x99, 0x24, 0x3a,
+	0xe9, 0x49, 0x57, 0xe1, 0x34, 0x7f, 0x99, 0x65, 0x74, 0x14, 0x0e, 0x07, 0x0b, 0x9a, 0xc9, 0x2a,
+	0xa3, 0x4f, 0x1d, 0x08, 0x9e, 0x57, 0x0d, 0x22, 0x6d, 0xc3, 0xdc, 0x26, 0xc1, 0xee, 0x4d, 0xc7,
+	0x2e, 0x61, 0xdb, 0x73, 0x83, 0x24, 0x24, 0x05, 0xb2, 0xee, 0x10, 0x93, 0xce, 0xb0, 0x38, 0x41,
+	0x5d, 0x55, 0xf6, 0x6f, 0x04, 0x78, 0xa5, 0x33, 0x27, 0x2c, 0xee, 0x6f, 0xc1, 0x99, 0x92, 0xa5,
+	0xd1, 0xd0, 0x7d, 0x82, 0x5d, 0xad, 0xca, 0x44, 0x1b, 0xca, 0x7c, 0x9e, 0x57, 0xe6, 0x69, 0x67,
+	0xeb, 0x8e, 0x63, 0x05, 0x01, 0x44, 0xae, 0xea, 0xca, 0x7d, 0xb4, 0x64, 0xf1, 0xd7, 0x89, 0x84,
+	0x61, 0xbe, 0x8d, 0xb8, 0x93, 0x6f, 0xbb, 0x5d, 0xee, 0x2a, 0x3f, 0xbf, 0x13, 0x60, 0xa1, 0x63,
+	0x3f, 0x9f, 0x93, 0x14, 0xc9, 0x70, 0x92, 0x96, 0x9e, 0x8a, 0x89, 0xb7, 0xe1, 0x57, 0xab, 0x56,
+	0x2d, 0xfb, 0x3a, 0xab, 0xc2, 0xa9, 0x26, 0x79, 0x06, 0x65, 0x21, 0x75, 0x31, 0xc8, 0xe9, 0xae,
+	0xe8, 0xc2, 0x4a, 0xc5, 0x67, 0x7f, 0x30, 0x0e, 0x2f, 0x50, 0xa3, 0xe8, 0x7b, 0x02, 0xf4, 0x87,
+	0x34, 0x27, 0xba, 0xc0, 0x43, 0xdc, 0xcc, 0xa8, 0x8a, 0x93, 0xb9, 0x72, 0x61, 0x78, 0xd2, 0xf4,
+	0x7b, 0x7f, 0xf9, 0xfb, 0x07, 0x3d, 0xe7, 0x90, 0xa4, 0x70, 0x78, 0xe2, 0x84, 0xec, 0xa5, 0xce,
+	0xbf, 0x2f, 0xc0, 0x60, 0xcc, 0x73, 0xa2, 0x73, 0x3c, 0x17, 0x8d, 0xac, 0xab, 0x78, 0x3e, 0x47,
+	0x8a, 0x85, 0x21, 0xd3, 0x30, 0xa6, 0xd0, 0x85, 0xac, 0x30, 0x12, 0x4e, 0x36, 0x0c, 0x25, 0xa2,
+	0x51, 0x5b, 0x84, 0xd2, 0xc0, 0xbc, 0x8a, 0xe7, 0x73, 0xa4, 0x3a, 0x0a, 0xc5, 0xb2, 0x34, 0x3d,
+	0x74, 0xfe, 0x33, 0x01, 0x8e, 0x36, 0x10, 0xa9, 0x68, 0xba, 0x25, 0xea, 0x26, 0x7a, 0x56, 0xbc,
+	0xd8, 0x96, 0x2c, 0x0b, 0xee, 0x15, 0x1a, 0x9c, 0x8c, 0x2e, 0xe5, 0xe7, 0x29, 0xe1, 0x10, 0xd1,
+	0xaf, 0x05, 0x38, 0xce, 0x61, 0x4a, 0x91, 0xdc, 0xd2, 0x35, 0x97, 0x87, 0x15, 0x95, 0xb6, 0xe5,
+	0x59, 0xb8, 0x57, 0x69, 0xb8, 0x73, 0x68, 0x26, 0x3f, 0xdc, 0x14, 0x01, 0x4a, 0x63, 0xfb, 0x43,
+	0xc0, 0x4f, 0xf3, 0x89, 0x3e, 0x34, 0xdb, 0x62, 0x27, 0x33, 0x08, 0x48, 0x71, 0xae, 0x23, 0x1d,
+	0x16, 0xff, 0x75, 0x1a, 0xff, 0x02, 0xba, 0x92, 0x57, 0x0b, 0x66, 0xca, 0x8a, 0x16, 0xf3, 0x85,
+	0x9f, 0x0a, 0x70, 0x3a, 0x8b, 0xa7, 0x43, 0x0b, 0x2d, 0x06, 0x58, 0x1e, 0x33, 0x28, 0x2e, 0x76,
+	0xae, 0xc8, 0x20, 0xad, 0x51, 0x48, 0x2b, 0x68, 0x39, 0x0b, 0x52, 0x29, 0xb2, 0xc4, 0x05, 0xa6,
+	0xbc, 0xcb, 0x58, 0xc9, 0x07, 0xe8, 0x57, 0x11, 0x9b, 0x94, 0xc9, 0xe1, 0xa1, 0xa5, 0x96, 0xe3,
+	0xa8, 0x6d, 0x22, 0x51, 0xbc, 0xf9, 0x44, 0x36, 0x18, 0xfa, 0x43, 0xe8, 0x4f, 0x02, 0x88, 0xad,
+	0xf9, 0x2f, 0xc4, 0x25, 0x48, 0x73, 0x59, 0x35, 0x71, 0xbe, 0x53, 0x35, 0x16, 0xcf, 0x0d, 0xba,
+	0x1b, 0x8b, 0x68, 0x3e, 0xaf, 0xc0, 0xf8, 0x34, 0x1a, 0xfa, 0xb3, 0x00, 0x62, 0x6b, 0x76, 0x0a,
+	0x5d, 0x69, 0xf7, 0xa8, 0x5c, 0xc7, 0xb1, 0x89, 0xf3, 0x9d, 0xaa, 0x31, 0x34, 0xaf, 0x51, 0x34,
+	0xd7, 0xd0, 0x62, 0x16, 0x1a, 0xfe, 0x11, 0x3f, 0xfc, 0xe8, 0xa1, 0x7f, 0x0a, 0x70, 0x36, 0x8f,
+	0x89, 0x42, 0xaf, 0xb6, 0x1b, 0x1e, 0x87, 0x04, 0x11, 0xbf, 0xd8, 0x9d, 0x32, 0x43, 0xf8, 0x06,
+	0x45, 0xf8, 0x3a, 0x5a, 0xe9, 0x18, 0x21, 0x51, 0xde, 0x6d, 0x3a, 0x3b, 0x3d, 0x40, 0xef, 0xf5,
+	0xa4, 0xd9, 0xc5, 0x56, 0x7c, 0x0a, 0xba, 0x9e, 0x1d, 0x74, 0x0e, 0xf1, 0x23, 0xde, 0xe8, 0x56,
+	0x9d, 0xa1, 0xfe, 0x3a, 0x45, 0x7d, 0x1f, 0x6d, 0xb6, 0x89, 0xda, 0x4f, 0x1b, 0xd4, 0xb6, 0x6b,
+	0x5a, 0x8c, 0x9c, 0x9b, 0x84, 0xff, 0x08, 0x70, 0xbe, 0x2d, 0x92, 0x01, 0xbd, 0xd6, 0xc1, 0xe6,
+	0x71, 0x2f, 0xfa, 0x62, 0xf1, 0x09, 0x2c, 0xb0, 0x6c, 0xdc, 0xa1, 0xd9, 0xb8, 0x8d, 0x6e, 0x75,
+	0x5e, 0x03, 0x41, 0x2e, 0x12, 0x9e, 0x21, 0xfc, 0x5b, 0xdc, 0x2f, 0x7b, 0x60, 0xa6, 0x63, 0xde,
+	0x00, 0xad, 0xf1, 0x70, 0x74, 0x4b, 0x7f, 0x88, 0x77, 0x9e, 0x92, 0x35, 0x96, 0xa1, 0xaf, 0xd1,
+	0x0c, 0x6d, 0xa1, 0x7b, 0x59, 0x19, 0xc2, 0xcc, 0xbc, 0x96, 0x35, 0x10, 0x78, 0x09, 0xfb, 0x47,
+	0x34, 0xc1, 0xb9, 0x6c, 0x02, 0xba, 0xd6, 0xfe, 0x77, 0xa2, 0xa9, 0x51, 0x5e, 0xed, 0x4a, 0x97,
+	0xa1, 0xde, 0xa4, 0xa8, 0xef, 0xa2, 0x3b, 0x59, 0xa8, 0x1b, 0xff, 0xa8, 0x92, 0xdf, 0x1d, 0x1f,
+	0x09, 0x70, 0xb4, 0xe1, 0x0a, 0x8c, 0x94, 0x96, 0x71, 0xf2, 0xef, 0xd2, 0xe2, 0xcb, 0xed, 0x2b,
+	0x74, 0x72, 0xd2, 0xf4, 0xa9, 0xb2, 0xf6, 0x76, 0x1c, 0xd8, 0x87, 0x3d, 0x70, 0xa9, 0x93, 0x4b,
+	0x31, 0xba, 0xcd, 0x0b, 0xac, 0x8b, 0xbb, 0xbb, 0xf8, 0xfa, 0x93, 0x1b, 0x62, 0xc8, 0xb7, 0x28,
+	0xf2, 0x75, 0xf4, 0x46, 0xe6, 0x37, 0x39, 0x3c, 0x0a, 0xa5, 0xd9, 0x1c, 0x2b, 0xbe, 0xa6, 0xf2,
+	0x67, 0xfd, 0xcf, 0x7b, 0x40, 0xe9, 0xf0, 0x42, 0x8c, 0xbe, 0xd4, 0x25, 0x2a, 0xce, 0xed, 0x5d,
+	0xfc, 0xf2, 0x53, 0xb1, 0xc5, 0x92, 0xf4, 0x15, 0x9a, 0xa4, 0x0d, 0xf4, 0x66, 0x3b, 0x49, 0xf2,
+	0x53, 0x16, 0xf2, 0xf3, 0xf4, 0x13, 0x01, 0x20, 0xb9, 0x48, 0xa3, 0xe9, 0x96, 0xa5, 0xdb, 0x74,
+	0x3b, 0x17, 0x2f, 0xb6, 0x25, 0xdb, 0xc9, 0xd5, 0x97, 0x50, 0x9d, 0xa5, 0xf5, 0x8f, 0x1f, 0x15,
+	0x84, 0x4f, 0x1e, 0x15, 0x84, 0xcf, 0x1e, 0x15, 0x84, 0x1f, 0x3f, 0x2e, 0x1c, 0xfa, 0xe4, 0x71,
+	0xe1, 0xd0, 0x5f, 0x1f, 0x17, 0x0e, 0xbd, 0x35, 0x9f, 0xa2, 0xc2, 0x98, 0x9d, 0xcb, 0x96, 0xbe,
+	0x4d, 0x62, 0xa3, 0x07, 0xb3, 0x33, 0xca, 0x3b, 0x69, 0xd3, 0x94, 0x1e, 0xdb, 0xee, 0xa7, 0xff,
+	0x21, 0x6a, 0xee, 0x7f, 0x03, 0x00, 0xd7, 0x48, 0xb1, 0xac, 0x8e, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2059,6 +2154,9 @@ type QueryClient interface {
 	AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error)
 	// Returns the osmo equivalent multiplier used in the most recent epoch.
 	AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error)
+	// Returns the effective risk factor of a superfluid asset, and its osmo
+	// equivalent multiplier adjusted by the risk factor.
+	AssetRiskAdjustment(ctx context.Context, in *AssetRiskAdjustmentRequest, opts ...grpc.CallOption) (*AssetRiskAdjustmentResponse, error)
 	// Returns all superfluid intermediary accounts.
 	AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error)
 	// Returns intermediary account connected to a superfluid staked lock by id
@@ -2136,6 +2234,15 @@ func (c *queryClient) AssetMultiplier(ctx context.Context, in *AssetMultiplierRe
 	return out, nil
 }
 
+func (c *queryClient) AssetRiskAdjustment(ctx context.Context, in *AssetRiskAdjustmentRequest, opts ...grpc.CallOption) (*AssetRiskAdjustmentResponse, error) {
+	out := new(AssetRiskAdjustmentResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetRiskAdjustment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error) {
 	out := new(AllIntermediaryAccountsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllIntermediaryAccounts", in, out, opts...)
@@ -2273,6 +2380,9 @@ type QueryServer interface {
 	AllAssets(context.Context, *AllAssetsRequest) (*AllAssetsResponse, error)
 	// Returns the osmo equivalent multiplier used in the most recent epoch.
 	AssetMultiplier(context.Context, *AssetMultiplierRequest) (*AssetMultiplierResponse, error)
+	// Returns the effective risk factor of a superfluid asset, and its osmo
+	// equivalent multiplier adjusted by the risk factor.
+	AssetRiskAdjustment(context.Context, *AssetRiskAdjustmentRequest) (*AssetRiskAdjustmentResponse, error)
 	// Returns all superfluid intermediary accounts.
 	AllIntermediaryAccounts(context.Context, *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error)
 	// Returns intermediary account connected to a superfluid staked lock by id
@@ -2322,6 +2432,9 @@ func (*UnimplementedQueryServer) AllAssets(ctx context.Context, req *AllAssetsRe
 func (*UnimplementedQueryServer) AssetMultiplier(ctx context.Context, req *AssetMultiplierRequest) (*AssetMultiplierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplier not implemented")
 }
+func (*UnimplementedQueryServer) AssetRiskAdjustment(ctx context.Context, req *AssetRiskAdjustmentRequest) (*AssetRiskAdjustmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetRiskAdjustment not implemented")
+}
 func (*UnimplementedQueryServer) AllIntermediaryAccounts(ctx context.Context, req *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllIntermediaryAccounts not implemented")
 }
@@ -2441,6 +2554,24 @@ func _Query_AssetMultiplier_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AssetRiskAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetRiskAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetRiskAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AssetRiskAdjustment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetRiskAdjustment(ctx, req.(*AssetRiskAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllIntermediaryAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllIntermediaryAccountsRequest)
 	if err := dec(in); err != nil {
@@ -2713,6 +2844,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AssetMultiplier",
 			Handler:    _Query_AssetMultiplier_Handler,
 		},
+		{
+			MethodName: "AssetRiskAdjustment",
+			Handler:    _Query_AssetRiskAdjustment_Handler,
+		},
 		{
 			MethodName: "AllIntermediaryAccounts",
 			Handler:    _Query_AllIntermediaryAccounts_Handler,
@@ -3013,6 +3148,79 @@ func (m *AssetMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AssetRiskAdjustmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRiskAdjustmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRiskAdjustmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRiskAdjustmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRiskAdjustmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRiskAdjustmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RiskAdjustedMultiplier.Size()
+		i -= size
+		if _, err := m.RiskAdjustedMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RiskFactor.Size()
+		i -= size
+		if _, err := m.RiskFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SuperfluidIntermediaryAccountInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4276,6 +4484,32 @@ func (m *AssetMultiplierResponse) Size() (n int) {
 	return n
 }
 
+func (m *AssetRiskAdjustmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetRiskAdjustmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RiskFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RiskAdjustedMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5341,6 +5575,206 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AssetRiskAdjustmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRiskAdjustmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRiskAdjustmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRiskAdjustmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRiskAdjustmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRiskAdjustmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskAdjustedMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskAdjustedMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // NewSuperfluidAsset returns a new instance of SuperfluidAsset.
@@ -13,6 +15,15 @@ func NewSuperfluidAsset(assetType SuperfluidAssetType, denom string) SuperfluidA
 	}
 }
 
+// ValidateRiskFactor returns an error if the risk factor of a superfluid asset is not in [0, 1).
+// A risk factor of 1 would make the asset worth no OSMO, which is what removing the asset does.
+func ValidateRiskFactor(riskFactor osmomath.Dec) error {
+	if riskFactor.IsNil() || riskFactor.IsNegative() || riskFactor.GTE(osmomath.OneDec()) {
+		return InvalidRiskFactorError{RiskFactor: riskFactor}
+	}
+	return nil
+}
+
 func NewSuperfluidIntermediaryAccount(denom string, valAddr string, gaugeId uint64) SuperfluidIntermediaryAccount {
 	return SuperfluidIntermediaryAccount{
 		Denom:   denom,
@@ -99,6 +99,55 @@ func (m *SuperfluidAsset) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuperfluidAsset proto.InternalMessageInfo
 
+// SuperfluidAssetRiskFactor is the risk factor set by governance for a
+// superfluid asset. It is cut on the OSMO equivalent value of the asset on top
+// of the osmo equivalent multiplier, replacing the minimum_risk_factor param
+// for the asset.
+type SuperfluidAssetRiskFactor struct {
+	Denom      string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RiskFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=risk_factor,json=riskFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"risk_factor" yaml:"risk_factor"`
+}
+
+func (m *SuperfluidAssetRiskFactor) Reset()         { *m = SuperfluidAssetRiskFactor{} }
+func (m *SuperfluidAssetRiskFactor) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidAssetRiskFactor) ProtoMessage()    {}
+func (*SuperfluidAssetRiskFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{1}
+}
+func (m *SuperfluidAssetRiskFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidAssetRiskFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidAssetRiskFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidAssetRiskFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidAssetRiskFactor.Merge(m, src)
+}
+func (m *SuperfluidAssetRiskFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidAssetRiskFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidAssetRiskFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidAssetRiskFactor proto.InternalMessageInfo
+
+func (m *SuperfluidAssetRiskFactor) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // SuperfluidIntermediaryAccount takes the role of intermediary between LP token
 // and OSMO tokens for superfluid staking. The intermediary account is the
 // actual account responsible for delegation, not the validator account itself.
@@ -114,7 +163,7 @@ func (m *SuperfluidIntermediaryAccount) Reset()         { *m = SuperfluidInterme
 func (m *SuperfluidIntermediaryAccount) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidIntermediaryAccount) ProtoMessage()    {}
 func (*SuperfluidIntermediaryAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{1}
+	return fileDescriptor_79d3c29d82dbb734, []int{2}
 }
 func (m *SuperfluidIntermediaryAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +231,7 @@ func (m *OsmoEquivalentMultiplierRecord) Reset()         { *m = OsmoEquivalentMu
 func (m *OsmoEquivalentMultiplierRecord) String() string { return proto.CompactTextString(m) }
 func (*OsmoEquivalentMultiplierRecord) ProtoMessage()    {}
 func (*OsmoEquivalentMultiplierRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{2}
+	return fileDescriptor_79d3c29d82dbb734, []int{3}
 }
 func (m *OsmoEquivalentMultiplierRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +287,7 @@ func (m *SuperfluidDelegationRecord) Reset()         { *m = SuperfluidDelegation
 func (m *SuperfluidDelegationRecord) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationRecord) ProtoMessage()    {}
 func (*SuperfluidDelegationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{3}
+	return fileDescriptor_79d3c29d82dbb734, []int{4}
 }
 func (m *SuperfluidDelegationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +356,7 @@ func (m *LockIdIntermediaryAccountConnection) Reset()         { *m = LockIdInter
 func (m *LockIdIntermediaryAccountConnection) String() string { return proto.CompactTextString(m) }
 func (*LockIdIntermediaryAccountConnection) ProtoMessage()    {}
 func (*LockIdIntermediaryAccountConnection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{4}
+	return fileDescriptor_79d3c29d82dbb734, []int{5}
 }
 func (m *LockIdIntermediaryAccountConnection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +407,7 @@ func (m *UnpoolWhitelistedPools) Reset()         { *m = UnpoolWhitelistedPools{}
 func (m *UnpoolWhitelistedPools) String() string { return proto.CompactTextString(m) }
 func (*UnpoolWhitelistedPools) ProtoMessage()    {}
 func (*UnpoolWhitelistedPools) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{5}
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
 }
 func (m *UnpoolWhitelistedPools) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +456,7 @@ func (m *ConcentratedPoolUserPositionRecord) Reset()         { *m = Concentrated
 func (m *ConcentratedPoolUserPositionRecord) String() string { return proto.CompactTextString(m) }
 func (*ConcentratedPoolUserPositionRecord) ProtoMessage()    {}
 func (*ConcentratedPoolUserPositionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{6}
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
 }
 func (m *ConcentratedPoolUserPositionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,6 +530,7 @@ func (m *ConcentratedPoolUserPositionRecord) GetEquivalentStakedAmount() *types.
 func init() {
 	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
 	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
+	proto.RegisterType((*SuperfluidAssetRiskFactor)(nil), "osmosis.superfluid.SuperfluidAssetRiskFactor")
 	proto.RegisterType((*SuperfluidIntermediaryAccount)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccount")
 	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
 	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
@@ -494,59 +544,62 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x6e, 0xd2, 0x4c, 0xa0, 0xb8, 0xdb, 0xa8, 0x24, 0x41, 0xd9, 0x0d, 0x5b, 0xa4,
-	0x5a, 0xad, 0xba, 0xab, 0x04, 0x09, 0xa1, 0xde, 0x9c, 0x14, 0x24, 0xa3, 0x50, 0xac, 0x35, 0x15,
-	0x88, 0xcb, 0x6a, 0xbc, 0xf3, 0xba, 0x1e, 0x79, 0x76, 0x67, 0xbb, 0x33, 0x6b, 0xf0, 0x8d, 0x03,
-	0x87, 0x1e, 0xf9, 0x13, 0x2a, 0x71, 0xe3, 0xca, 0x3f, 0xd1, 0x63, 0x25, 0x2e, 0x88, 0x43, 0x40,
-	0xc9, 0x85, 0x73, 0xff, 0x02, 0x34, 0xb3, 0x3f, 0xbc, 0x69, 0x1c, 0x21, 0x2e, 0x70, 0xf2, 0xcc,
-	0xfb, 0xde, 0xbc, 0xf7, 0x7d, 0xf3, 0x3e, 0xcf, 0xa2, 0x3b, 0x5c, 0xc4, 0x5c, 0x50, 0xe1, 0x89,
-	0x3c, 0x85, 0xec, 0x29, 0xcb, 0x29, 0x69, 0x2c, 0xdd, 0x34, 0xe3, 0x92, 0x9b, 0x66, 0x99, 0xe4,
-	0x2e, 0x91, 0xdd, 0xad, 0x88, 0x47, 0x5c, 0xc3, 0x9e, 0x5a, 0x15, 0x99, 0xbb, 0x56, 0xc4, 0x79,
-	0xc4, 0xc0, 0xd3, 0xbb, 0x49, 0xfe, 0xd4, 0x23, 0x79, 0x86, 0x25, 0xe5, 0x49, 0x89, 0xdb, 0x6f,
-	0xe2, 0x92, 0xc6, 0x20, 0x24, 0x8e, 0xd3, 0xaa, 0x40, 0xa8, 0x7b, 0x79, 0x13, 0x2c, 0xc0, 0x9b,
-	0x1f, 0x4c, 0x40, 0xe2, 0x03, 0x2f, 0xe4, 0xb4, 0x2a, 0xb0, 0x53, 0xf1, 0x65, 0x3c, 0x9c, 0xe5,
-	0xa9, 0xfe, 0x29, 0x20, 0x67, 0x81, 0xde, 0x19, 0xd7, 0xfc, 0x06, 0x42, 0x80, 0x34, 0xb7, 0xd0,
-	0x35, 0x02, 0x09, 0x8f, 0xb7, 0x8d, 0x7d, 0xa3, 0xbf, 0xe1, 0x17, 0x1b, 0xf3, 0x53, 0x84, 0xb0,
-	0x82, 0x03, 0xb9, 0x48, 0x61, 0xbb, 0xbd, 0x6f, 0xf4, 0x6f, 0x1c, 0xde, 0x75, 0x2f, 0x6b, 0x74,
-	0xdf, 0x28, 0xf7, 0xe5, 0x22, 0x05, 0x7f, 0x03, 0x57, 0xcb, 0x87, 0xd7, 0x9f, 0xbf, 0xb0, 0x5b,
-	0x7f, 0xbd, 0xb0, 0x0d, 0x67, 0x86, 0xf6, 0x96, 0xb9, 0xc3, 0x44, 0x42, 0x16, 0x03, 0xa1, 0x38,
-	0x5b, 0x0c, 0xc2, 0x90, 0xe7, 0xc9, 0x55, 0x44, 0x76, 0xd0, 0xf5, 0x39, 0x66, 0x01, 0x26, 0x24,
-	0xd3, 0x34, 0x36, 0xfc, 0xf5, 0x39, 0x66, 0x03, 0x42, 0x32, 0x05, 0x45, 0x38, 0x8f, 0x20, 0xa0,
-	0x64, 0xbb, 0xb3, 0x6f, 0xf4, 0xbb, 0xfe, 0xba, 0xde, 0x0f, 0x89, 0xf3, 0x8b, 0x81, 0xac, 0x2f,
-	0x44, 0xcc, 0x3f, 0x79, 0x96, 0xd3, 0x39, 0x66, 0x90, 0xc8, 0xcf, 0x73, 0x26, 0x69, 0xca, 0x28,
-	0x64, 0x3e, 0x84, 0x3c, 0x23, 0xe6, 0xfb, 0xe8, 0x2d, 0x48, 0x79, 0x38, 0x0d, 0x92, 0x3c, 0x9e,
-	0x40, 0xa6, 0xbb, 0x76, 0xfc, 0x4d, 0x1d, 0x7b, 0xac, 0x43, 0x4b, 0x46, 0xed, 0x26, 0xa3, 0xaf,
-	0x11, 0x8a, 0xeb, 0x62, 0xba, 0xf1, 0xc6, 0xd1, 0xc7, 0x2f, 0x4f, 0xed, 0xd6, 0xef, 0xa7, 0xf6,
-	0x7b, 0xc5, 0x68, 0x04, 0x99, 0xb9, 0x94, 0x7b, 0x31, 0x96, 0x53, 0xf7, 0x04, 0x22, 0x1c, 0x2e,
-	0x1e, 0x41, 0xf8, 0xfa, 0xd4, 0xbe, 0xb9, 0xc0, 0x31, 0x7b, 0xe8, 0x2c, 0x8f, 0x3b, 0x7e, 0xa3,
-	0x96, 0xf3, 0xba, 0x8d, 0x76, 0x97, 0x77, 0xf4, 0x08, 0x18, 0x44, 0xda, 0x18, 0x25, 0xe3, 0xfb,
-	0xe8, 0x26, 0x29, 0x62, 0x3c, 0xd3, 0x17, 0x02, 0x42, 0x94, 0x97, 0xd5, 0xab, 0x81, 0x41, 0x11,
-	0x57, 0xc9, 0x73, 0xcc, 0x28, 0xb9, 0x90, 0x5c, 0xe8, 0xe8, 0xd5, 0x40, 0x95, 0xfc, 0x6d, 0x5d,
-	0x99, 0xf2, 0x24, 0xc0, 0xb1, 0x9a, 0x87, 0x56, 0xb6, 0x79, 0xb8, 0xe3, 0x16, 0x92, 0x5c, 0xe5,
-	0x36, 0xb7, 0x74, 0x9b, 0x7b, 0xcc, 0x69, 0x72, 0xe4, 0x29, 0xd1, 0x3f, 0xff, 0x61, 0xdf, 0x8d,
-	0xa8, 0x9c, 0xe6, 0x13, 0x37, 0xe4, 0xb1, 0x57, 0x5a, 0xb3, 0xf8, 0x79, 0x20, 0xc8, 0xcc, 0x53,
-	0x06, 0x12, 0xfa, 0x40, 0xcd, 0x92, 0xf2, 0x64, 0xa0, 0x7b, 0x98, 0xdf, 0x1b, 0x68, 0x1b, 0xea,
-	0x19, 0x05, 0x42, 0xe2, 0x19, 0x90, 0x8a, 0x40, 0xf7, 0x9f, 0x08, 0xdc, 0xff, 0x37, 0xcd, 0x6f,
-	0x2f, 0xfb, 0x8c, 0x75, 0x9b, 0x82, 0x82, 0xf3, 0x0c, 0xdd, 0x39, 0xe1, 0xe1, 0x6c, 0xb8, 0xca,
-	0x93, 0xc7, 0x3c, 0x49, 0x20, 0x54, 0x7c, 0xcd, 0x77, 0xd1, 0xba, 0xfa, 0x1f, 0x29, 0xaf, 0x19,
-	0xda, 0x6b, 0x6b, 0x4c, 0x9f, 0x32, 0x0f, 0xd0, 0x16, 0x6d, 0x9c, 0x0c, 0x70, 0x71, 0xb4, 0xbc,
-	0xeb, 0x5b, 0xf4, 0x72, 0x55, 0xe7, 0x1e, 0xba, 0xfd, 0x24, 0x49, 0x39, 0x67, 0x5f, 0x4d, 0xa9,
-	0x04, 0x46, 0x85, 0x04, 0x32, 0xe2, 0x9c, 0x09, 0xb3, 0x87, 0x3a, 0x94, 0xa8, 0xa1, 0x76, 0xfa,
-	0x5d, 0x5f, 0x2d, 0x9d, 0x5f, 0x3b, 0xc8, 0x39, 0xe6, 0x49, 0x08, 0x89, 0xcc, 0x70, 0x99, 0xf7,
-	0x44, 0x40, 0x36, 0xe2, 0x82, 0x5e, 0xf4, 0xc6, 0xe5, 0x71, 0x1b, 0x57, 0x8c, 0xdb, 0x46, 0x9b,
-	0x69, 0x79, 0x5c, 0xe9, 0x69, 0x6b, 0x3d, 0xa8, 0x0a, 0x0d, 0x49, 0x53, 0x6c, 0xe7, 0x82, 0xd8,
-	0xcf, 0xd0, 0x0d, 0xb1, 0x48, 0xe4, 0x14, 0x24, 0x0d, 0x03, 0x15, 0x2b, 0x87, 0xb4, 0x57, 0x3f,
-	0x0d, 0xc5, 0x9b, 0xe3, 0x8e, 0xab, 0x2c, 0x75, 0xb7, 0x47, 0x5d, 0xe5, 0x14, 0xff, 0x6d, 0xd1,
-	0x0c, 0xae, 0x36, 0xdd, 0xb5, 0xff, 0xdb, 0x74, 0x6b, 0xff, 0x85, 0xe9, 0xee, 0xfd, 0x60, 0xa0,
-	0x5b, 0x2b, 0x5e, 0x4e, 0x73, 0x0f, 0xed, 0xac, 0x08, 0x3f, 0xc6, 0x92, 0xce, 0xa1, 0xd7, 0x32,
-	0xad, 0xe6, 0xfb, 0x50, 0xc3, 0x27, 0xa3, 0xf1, 0x14, 0x67, 0xd0, 0x33, 0xcc, 0x3e, 0xfa, 0x60,
-	0x05, 0xde, 0xb4, 0x4f, 0x91, 0xd9, 0xde, 0xed, 0x3e, 0xff, 0xc9, 0x6a, 0x1d, 0x8d, 0x5e, 0x9e,
-	0x59, 0xc6, 0xab, 0x33, 0xcb, 0xf8, 0xf3, 0xcc, 0x32, 0x7e, 0x3c, 0xb7, 0x5a, 0xaf, 0xce, 0xad,
-	0xd6, 0x6f, 0xe7, 0x56, 0xeb, 0x9b, 0x8f, 0x1a, 0x0a, 0xcb, 0xd1, 0x3e, 0x60, 0x78, 0x22, 0xaa,
-	0x8d, 0x37, 0x3f, 0x3c, 0xf0, 0xbe, 0x6b, 0x7e, 0x11, 0xb5, 0xea, 0xc9, 0x9a, 0xfe, 0xce, 0x7c,
-	0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x4c, 0xfb, 0xd1, 0x34, 0x07, 0x00, 0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0xef, 0x6e, 0x93, 0x66, 0x02, 0x65, 0xeb, 0x46, 0x25, 0xbb, 0x28, 0xde, 0xe0, 0x22,
+	0x75, 0xd5, 0xaa, 0xb6, 0x12, 0x24, 0x04, 0xbd, 0xed, 0xa6, 0x54, 0x0a, 0x0a, 0x25, 0xf2, 0x52,
+	0x81, 0x7a, 0xb1, 0x66, 0x3d, 0x6f, 0xbc, 0x23, 0x7f, 0x8c, 0xeb, 0x19, 0x2f, 0xec, 0x8d, 0x03,
+	0x87, 0x5e, 0x90, 0xf8, 0x09, 0x95, 0xb8, 0x71, 0xe5, 0x4f, 0xf4, 0x58, 0x89, 0x0b, 0xe2, 0x10,
+	0x50, 0x72, 0xe1, 0x9c, 0x5f, 0x80, 0x66, 0xfc, 0xb1, 0x4e, 0xb2, 0x11, 0x70, 0xa1, 0x27, 0xcf,
+	0xbc, 0x9f, 0xcf, 0xf3, 0xbe, 0x8f, 0x6d, 0x74, 0x87, 0xf1, 0x88, 0x71, 0xca, 0x6d, 0x9e, 0x25,
+	0x90, 0x1e, 0x85, 0x19, 0x25, 0xb5, 0xa3, 0x95, 0xa4, 0x4c, 0x30, 0x5d, 0x2f, 0x82, 0xac, 0x85,
+	0xa7, 0xb7, 0xe1, 0x33, 0x9f, 0x29, 0xb7, 0x2d, 0x4f, 0x79, 0x64, 0xcf, 0xf0, 0x19, 0xf3, 0x43,
+	0xb0, 0xd5, 0x6d, 0x92, 0x1d, 0xd9, 0x24, 0x4b, 0xb1, 0xa0, 0x2c, 0x2e, 0xfc, 0xfd, 0x8b, 0x7e,
+	0x41, 0x23, 0xe0, 0x02, 0x47, 0x49, 0x59, 0xc0, 0x53, 0xbd, 0xec, 0x09, 0xe6, 0x60, 0xcf, 0x76,
+	0x26, 0x20, 0xf0, 0x8e, 0xed, 0x31, 0x5a, 0x16, 0xe8, 0x96, 0x78, 0x43, 0xe6, 0x05, 0x59, 0xa2,
+	0x1e, 0xb9, 0xcb, 0x9c, 0xa3, 0x77, 0xc6, 0x15, 0xbe, 0x21, 0xe7, 0x20, 0xf4, 0x0d, 0x74, 0x8d,
+	0x40, 0xcc, 0xa2, 0x4d, 0x6d, 0x5b, 0x1b, 0xac, 0x39, 0xf9, 0x45, 0x7f, 0x8c, 0x10, 0x96, 0x6e,
+	0x57, 0xcc, 0x13, 0xd8, 0x6c, 0x6e, 0x6b, 0x83, 0x1b, 0xbb, 0x77, 0xad, 0xcb, 0x1c, 0xad, 0x0b,
+	0xe5, 0xbe, 0x9c, 0x27, 0xe0, 0xac, 0xe1, 0xf2, 0xf8, 0xf0, 0xfa, 0x8b, 0x97, 0xfd, 0xc6, 0x5f,
+	0x2f, 0xfb, 0x9a, 0xf9, 0x83, 0x86, 0xba, 0x17, 0x82, 0x1d, 0xca, 0x83, 0xc7, 0xd8, 0x13, 0x2c,
+	0xbd, 0x02, 0xc5, 0x33, 0xb4, 0x9e, 0x52, 0x1e, 0xb8, 0x47, 0x2a, 0x48, 0xc1, 0x58, 0x1b, 0x7d,
+	0xf2, 0xea, 0xb8, 0xdf, 0xf8, 0xfd, 0xb8, 0xff, 0x5e, 0x3e, 0x06, 0x4e, 0x02, 0x8b, 0x32, 0x3b,
+	0xc2, 0x62, 0x6a, 0x1d, 0x80, 0x8f, 0xbd, 0xf9, 0x23, 0xf0, 0xce, 0x8e, 0xfb, 0xfa, 0x1c, 0x47,
+	0xe1, 0x43, 0xb3, 0x96, 0x6f, 0x3a, 0x28, 0xad, 0x3a, 0x9a, 0x01, 0xda, 0x5a, 0xc0, 0xd9, 0x8f,
+	0x05, 0xa4, 0x11, 0x10, 0x8a, 0xd3, 0xf9, 0xd0, 0xf3, 0x58, 0x16, 0x5f, 0x35, 0x98, 0x2e, 0xba,
+	0x3e, 0xc3, 0xa1, 0x8b, 0x09, 0x29, 0xf0, 0x38, 0xab, 0x33, 0x1c, 0x0e, 0x09, 0x49, 0xa5, 0xcb,
+	0xc7, 0x99, 0x0f, 0x2e, 0x25, 0x9b, 0xad, 0x6d, 0x6d, 0xd0, 0x76, 0x56, 0xd5, 0x7d, 0x9f, 0x98,
+	0xbf, 0x68, 0xc8, 0xf8, 0x82, 0x47, 0xec, 0xd3, 0xe7, 0x19, 0x9d, 0xe1, 0x10, 0x62, 0xf1, 0x79,
+	0x16, 0x0a, 0x9a, 0x84, 0x14, 0x52, 0x07, 0x3c, 0x96, 0x12, 0xfd, 0x7d, 0xf4, 0x16, 0x24, 0xcc,
+	0x9b, 0xba, 0x71, 0x16, 0x4d, 0x20, 0x55, 0x5d, 0x5b, 0xce, 0xba, 0xb2, 0x3d, 0x51, 0xa6, 0x05,
+	0xa2, 0x66, 0x1d, 0xd1, 0xd7, 0x08, 0x45, 0x55, 0x31, 0xd5, 0x78, 0x6d, 0xf4, 0xf1, 0xbf, 0x9b,
+	0xd1, 0xcd, 0x7c, 0x46, 0x8b, 0x74, 0xd3, 0xa9, 0xd5, 0x32, 0xcf, 0x9a, 0xa8, 0xb7, 0x98, 0xd1,
+	0x23, 0x08, 0xc1, 0x57, 0x42, 0x2d, 0x10, 0xdf, 0x47, 0x37, 0x49, 0x6e, 0x63, 0xa9, 0x1a, 0x08,
+	0x70, 0x5e, 0x0c, 0xab, 0x53, 0x39, 0x86, 0xb9, 0x5d, 0x06, 0xcf, 0x70, 0x48, 0xc9, 0xb9, 0xe0,
+	0x9c, 0x47, 0xa7, 0x72, 0x94, 0xc1, 0xdf, 0x54, 0x95, 0x29, 0x8b, 0x5d, 0x1c, 0xc9, 0x7d, 0x28,
+	0x66, 0xeb, 0xbb, 0x5d, 0x2b, 0xa7, 0x64, 0x49, 0xf5, 0x5b, 0x85, 0xfa, 0xad, 0x3d, 0x46, 0xe3,
+	0x91, 0x2d, 0x49, 0xff, 0xfc, 0x47, 0xff, 0xae, 0x4f, 0xc5, 0x34, 0x9b, 0x58, 0x1e, 0x8b, 0xec,
+	0xe2, 0x55, 0xc9, 0x1f, 0x0f, 0x38, 0x09, 0x6c, 0x29, 0x68, 0xae, 0x12, 0x2a, 0x94, 0x94, 0xc5,
+	0x43, 0xd5, 0x43, 0xff, 0x4e, 0x43, 0x9b, 0x50, 0xed, 0xc8, 0xe5, 0x02, 0x07, 0x40, 0x4a, 0x00,
+	0xed, 0x7f, 0x02, 0x70, 0xff, 0xbf, 0x34, 0xbf, 0xbd, 0xe8, 0x33, 0x56, 0x6d, 0x72, 0x08, 0xe6,
+	0x73, 0x74, 0xe7, 0x80, 0x79, 0xc1, 0xfe, 0x32, 0x4d, 0xee, 0xb1, 0x38, 0x06, 0x4f, 0xe2, 0xd5,
+	0xdf, 0x45, 0xab, 0xf2, 0xbd, 0x96, 0x5a, 0xd3, 0x94, 0xd6, 0x56, 0x42, 0x95, 0xa5, 0xef, 0xa0,
+	0x0d, 0x5a, 0xcb, 0x74, 0x71, 0x9e, 0x5a, 0xcc, 0xfa, 0x16, 0xbd, 0x5c, 0xd5, 0xbc, 0x87, 0x6e,
+	0x3f, 0x8d, 0x13, 0xc6, 0xc2, 0xaf, 0xa6, 0x54, 0x40, 0x48, 0xb9, 0x00, 0x72, 0xc8, 0x58, 0xc8,
+	0xf5, 0x0e, 0x6a, 0x51, 0x22, 0x97, 0xda, 0x1a, 0xb4, 0x1d, 0x79, 0x34, 0x7f, 0x6d, 0x21, 0x73,
+	0x8f, 0xc5, 0x1e, 0xc4, 0x22, 0xc5, 0x45, 0xdc, 0x53, 0x0e, 0xe9, 0x21, 0xe3, 0xf4, 0xbc, 0x36,
+	0x2e, 0xaf, 0x5b, 0xbb, 0x62, 0xdd, 0x7d, 0xb4, 0x9e, 0x14, 0xe9, 0x92, 0x4f, 0x53, 0xf1, 0x41,
+	0xa5, 0x69, 0x9f, 0xd4, 0xc9, 0xb6, 0xce, 0x91, 0xfd, 0x0c, 0xdd, 0xe0, 0xf3, 0x58, 0x4c, 0x41,
+	0x50, 0xcf, 0x95, 0xb6, 0x62, 0x49, 0x5b, 0xd5, 0xa7, 0x2a, 0xff, 0x06, 0x5a, 0xe3, 0x32, 0x4a,
+	0xce, 0x76, 0xd4, 0x96, 0x4a, 0x71, 0xde, 0xe6, 0x75, 0xe3, 0x72, 0xd1, 0x5d, 0x7b, 0xd3, 0xa2,
+	0x5b, 0xf9, 0x3f, 0x44, 0x77, 0xef, 0x7b, 0x0d, 0xdd, 0x5a, 0xf2, 0x25, 0xd7, 0xb7, 0x50, 0x77,
+	0x89, 0xf9, 0x09, 0x16, 0x74, 0x06, 0x9d, 0x86, 0x6e, 0xa0, 0xde, 0x12, 0xf7, 0xc1, 0xe1, 0x78,
+	0x8a, 0x53, 0xe8, 0x68, 0xfa, 0x00, 0x7d, 0xb0, 0xc4, 0x5f, 0x97, 0x4f, 0x1e, 0xd9, 0xec, 0xb5,
+	0x5f, 0xfc, 0x64, 0x34, 0x46, 0x87, 0xaf, 0x4e, 0x0c, 0xed, 0xf5, 0x89, 0xa1, 0xfd, 0x79, 0x62,
+	0x68, 0x3f, 0x9e, 0x1a, 0x8d, 0xd7, 0xa7, 0x46, 0xe3, 0xb7, 0x53, 0xa3, 0xf1, 0xec, 0xa3, 0x1a,
+	0xc3, 0x62, 0xb5, 0x0f, 0x42, 0x3c, 0xe1, 0xe5, 0xc5, 0x9e, 0xed, 0xee, 0xd8, 0xdf, 0xd6, 0xff,
+	0xd0, 0x8a, 0xf5, 0x64, 0x45, 0xfd, 0xf7, 0x3e, 0xfc, 0x7b, 0x00, 0x06, 0x88, 0xc2, 0xb4, 0xc4,
+	0x07, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -611,6 +664,46 @@ func (m *SuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SuperfluidAssetRiskFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidAssetRiskFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidAssetRiskFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RiskFactor.Size()
+		i -= size
+		if _, err := m.RiskFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SuperfluidIntermediaryAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -932,6 +1025,21 @@ func (m *SuperfluidAsset) Size() (n int) {
 	return n
 }
 
+func (m *SuperfluidAssetRiskFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = m.RiskFactor.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
 func (m *SuperfluidIntermediaryAccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1159,6 +1267,122 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SuperfluidAssetRiskFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidAssetRiskFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidAssetRiskFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -1036,7 +1037,10 @@ type MsgUnbondConvertAndStake struct {
 	// min_amt_to_stake indicates the minimum amount to stake after conversion
 	MinAmtToStake cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_amt_to_stake,json=minAmtToStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_amt_to_stake" yaml:"min_amt_to_stake"`
 	// shares_to_convert indicates shares wanted to stake.
-	// Note that this field is only used for liquid(unlocked) gamm shares.
+	// Note that this field is only used for liquid(unlocked) gamm shares and
+	// superfluid bonded locks. For superfluid bonded locks, a non-zero amount
+	// less than the locked shares converts only that part of the lock, and the
+	// remainder stays superfluid delegated.
 	// For all other cases, this field would be disregarded.
 	SharesToConvert types.Coin `protobuf:"bytes,5,opt,name=shares_to_convert,json=sharesToConvert,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"shares_to_convert" yaml:"shares_to_convert"`
 }
@@ -1139,6 +1143,191 @@ func (m *MsgUnbondConvertAndStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnbondConvertAndStakeResponse proto.InternalMessageInfo
 
+// ===================== MsgSetSuperfluidRiskFactor
+type MsgSetSuperfluidRiskFactor struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// denom is the denom of the superfluid asset.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// risk_factor is the new risk factor of the asset, in [0, 1). It replaces
+	// the minimum_risk_factor param for the asset, including when it is zero.
+	RiskFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=risk_factor,json=riskFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"risk_factor" yaml:"risk_factor"`
+}
+
+func (m *MsgSetSuperfluidRiskFactor) Reset()         { *m = MsgSetSuperfluidRiskFactor{} }
+func (m *MsgSetSuperfluidRiskFactor) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSuperfluidRiskFactor) ProtoMessage()    {}
+func (*MsgSetSuperfluidRiskFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{20}
+}
+func (m *MsgSetSuperfluidRiskFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSuperfluidRiskFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSuperfluidRiskFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSuperfluidRiskFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSuperfluidRiskFactor.Merge(m, src)
+}
+func (m *MsgSetSuperfluidRiskFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSuperfluidRiskFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSuperfluidRiskFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSuperfluidRiskFactor proto.InternalMessageInfo
+
+func (m *MsgSetSuperfluidRiskFactor) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSuperfluidRiskFactor) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgSetSuperfluidRiskFactorResponse struct {
+}
+
+func (m *MsgSetSuperfluidRiskFactorResponse) Reset()         { *m = MsgSetSuperfluidRiskFactorResponse{} }
+func (m *MsgSetSuperfluidRiskFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSuperfluidRiskFactorResponse) ProtoMessage()    {}
+func (*MsgSetSuperfluidRiskFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{21}
+}
+func (m *MsgSetSuperfluidRiskFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSuperfluidRiskFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSuperfluidRiskFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSuperfluidRiskFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSuperfluidRiskFactorResponse.Merge(m, src)
+}
+func (m *MsgSetSuperfluidRiskFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSuperfluidRiskFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSuperfluidRiskFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSuperfluidRiskFactorResponse proto.InternalMessageInfo
+
+// ===================== MsgRemoveSuperfluidRiskFactor
+type MsgRemoveSuperfluidRiskFactor struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// denom is the denom of the superfluid asset.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgRemoveSuperfluidRiskFactor) Reset()         { *m = MsgRemoveSuperfluidRiskFactor{} }
+func (m *MsgRemoveSuperfluidRiskFactor) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSuperfluidRiskFactor) ProtoMessage()    {}
+func (*MsgRemoveSuperfluidRiskFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{22}
+}
+func (m *MsgRemoveSuperfluidRiskFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSuperfluidRiskFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSuperfluidRiskFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSuperfluidRiskFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSuperfluidRiskFactor.Merge(m, src)
+}
+func (m *MsgRemoveSuperfluidRiskFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSuperfluidRiskFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSuperfluidRiskFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSuperfluidRiskFactor proto.InternalMessageInfo
+
+func (m *MsgRemoveSuperfluidRiskFactor) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveSuperfluidRiskFactor) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgRemoveSuperfluidRiskFactorResponse struct {
+}
+
+func (m *MsgRemoveSuperfluidRiskFactorResponse) Reset()         { *m = MsgRemoveSuperfluidRiskFactorResponse{} }
+func (m *MsgRemoveSuperfluidRiskFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSuperfluidRiskFactorResponse) ProtoMessage()    {}
+func (*MsgRemoveSuperfluidRiskFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{23}
+}
+func (m *MsgRemoveSuperfluidRiskFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSuperfluidRiskFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSuperfluidRiskFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSuperfluidRiskFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSuperfluidRiskFactorResponse.Merge(m, src)
+}
+func (m *MsgRemoveSuperfluidRiskFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSuperfluidRiskFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSuperfluidRiskFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSuperfluidRiskFactorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -1160,107 +1349,124 @@ func init() {
 	proto.RegisterType((*MsgAddToConcentratedLiquiditySuperfluidPositionResponse)(nil), "osmosis.superfluid.MsgAddToConcentratedLiquiditySuperfluidPositionResponse")
 	proto.RegisterType((*MsgUnbondConvertAndStake)(nil), "osmosis.superfluid.MsgUnbondConvertAndStake")
 	proto.RegisterType((*MsgUnbondConvertAndStakeResponse)(nil), "osmosis.superfluid.MsgUnbondConvertAndStakeResponse")
+	proto.RegisterType((*MsgSetSuperfluidRiskFactor)(nil), "osmosis.superfluid.MsgSetSuperfluidRiskFactor")
+	proto.RegisterType((*MsgSetSuperfluidRiskFactorResponse)(nil), "osmosis.superfluid.MsgSetSuperfluidRiskFactorResponse")
+	proto.RegisterType((*MsgRemoveSuperfluidRiskFactor)(nil), "osmosis.superfluid.MsgRemoveSuperfluidRiskFactor")
+	proto.RegisterType((*MsgRemoveSuperfluidRiskFactorResponse)(nil), "osmosis.superfluid.MsgRemoveSuperfluidRiskFactorResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 1520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x13, 0x47,
-	0x1b, 0xcf, 0xda, 0x21, 0x81, 0x09, 0x09, 0xc9, 0xbe, 0x04, 0x8c, 0x01, 0xdb, 0x0c, 0xf0, 0x12,
-	0x3e, 0xbc, 0x1b, 0x87, 0xf7, 0x85, 0x28, 0x3d, 0x94, 0x38, 0x56, 0x2b, 0x97, 0x44, 0x45, 0x4b,
-	0x50, 0xa5, 0x5e, 0xdc, 0xb5, 0x67, 0xb2, 0xd9, 0x66, 0x77, 0xc7, 0x78, 0xc6, 0x21, 0x51, 0x4f,
-	0x6d, 0xa5, 0x56, 0xe2, 0x84, 0x7a, 0x69, 0x2f, 0x55, 0xcf, 0xad, 0xaa, 0x8a, 0x3f, 0xa1, 0x47,
-	0x8e, 0x1c, 0xab, 0x56, 0x0a, 0x15, 0x1c, 0x7a, 0xcf, 0xa5, 0xd7, 0x6a, 0x76, 0x67, 0xd7, 0xeb,
-	0x78, 0x37, 0xf6, 0x9a, 0x5c, 0x7a, 0x49, 0x76, 0x66, 0x9e, 0x8f, 0xdf, 0xf3, 0x3d, 0x63, 0x70,
-	0x9e, 0x50, 0x9b, 0x50, 0x93, 0xaa, 0xb4, 0xdd, 0xc4, 0xad, 0x0d, 0xab, 0x6d, 0x22, 0x95, 0xed,
-	0x28, 0xcd, 0x16, 0x61, 0x44, 0x96, 0xc5, 0xa1, 0xd2, 0x39, 0xcc, 0x9e, 0x36, 0x88, 0x41, 0xdc,
-	0x63, 0x95, 0x7f, 0x79, 0x94, 0xd9, 0x19, 0xdd, 0x36, 0x1d, 0xa2, 0xba, 0x7f, 0xc5, 0x56, 0xce,
-	0x20, 0xc4, 0xb0, 0xb0, 0xea, 0xae, 0xea, 0xed, 0x0d, 0x15, 0xb5, 0x5b, 0x3a, 0x33, 0x89, 0xe3,
-	0x9f, 0x37, 0x5c, 0xe9, 0x6a, 0x5d, 0xa7, 0x58, 0xdd, 0x2e, 0xd5, 0x31, 0xd3, 0x4b, 0x6a, 0x83,
-	0x98, 0xfe, 0x79, 0xfe, 0x20, 0x3f, 0x33, 0x6d, 0x4c, 0x99, 0x6e, 0x37, 0x05, 0xc1, 0xe5, 0x08,
-	0xe8, 0x9d, 0x4f, 0x8f, 0x08, 0x7e, 0x27, 0x81, 0xd9, 0x35, 0x6a, 0x3c, 0x0c, 0xf6, 0x2b, 0xd8,
-	0xc2, 0x86, 0xce, 0xb0, 0x7c, 0x1d, 0x8c, 0x51, 0xec, 0x20, 0xdc, 0xca, 0x48, 0x05, 0x69, 0xee,
-	0x44, 0x79, 0x66, 0x7f, 0x2f, 0x3f, 0xb9, 0xab, 0xdb, 0xd6, 0x12, 0xf4, 0xf6, 0xa1, 0x26, 0x08,
-	0xe4, 0xb3, 0x60, 0xdc, 0x22, 0x8d, 0xad, 0x9a, 0x89, 0x32, 0xa9, 0x82, 0x34, 0x37, 0xaa, 0x8d,
-	0xf1, 0x65, 0x15, 0xc9, 0xe7, 0xc0, 0xf1, 0x6d, 0xdd, 0xaa, 0xe9, 0x08, 0xb5, 0x32, 0x69, 0x2e,
-	0x45, 0x1b, 0xdf, 0xd6, 0xad, 0x65, 0x84, 0x5a, 0x4b, 0x85, 0xa7, 0x7f, 0x3d, 0xbf, 0x11, 0xe1,
-	0xdd, 0x22, 0x12, 0x00, 0x60, 0x1e, 0x5c, 0x8c, 0x44, 0xa6, 0x61, 0xda, 0x24, 0x0e, 0xc5, 0xf0,
-	0x73, 0x09, 0x9c, 0xed, 0xa2, 0x78, 0xe4, 0xa0, 0x23, 0x44, 0xbf, 0x04, 0x39, 0xc4, 0x8b, 0x11,
-	0x10, 0xdb, 0x81, 0x1e, 0x78, 0x09, 0xe4, 0x63, 0x20, 0x04, 0x30, 0xbf, 0xe8, 0x85, 0x59, 0x27,
-	0x0e, 0x5a, 0x25, 0x8d, 0xad, 0x23, 0x81, 0x79, 0x99, 0xc3, 0xcc, 0x45, 0xc2, 0xe4, 0x7a, 0x8a,
-	0x9c, 0x2c, 0x02, 0xa7, 0x8f, 0x21, 0xc0, 0xf9, 0x8b, 0x04, 0xae, 0xc4, 0xd8, 0xb2, 0xec, 0x1c,
-	0x31, 0x68, 0xb9, 0x0c, 0x46, 0x79, 0x2e, 0xbb, 0x59, 0x31, 0xb1, 0x70, 0x4e, 0xf1, 0x92, 0x5d,
-	0xe1, 0xc9, 0xae, 0x88, 0x64, 0x57, 0x56, 0x88, 0xe9, 0x94, 0xff, 0xf3, 0x62, 0x2f, 0x3f, 0xb2,
-	0xbf, 0x97, 0x9f, 0xf0, 0x14, 0x70, 0x26, 0xa8, 0xb9, 0xbc, 0xf0, 0x7d, 0x70, 0x6b, 0x10, 0xbc,
-	0xbe, 0x81, 0x61, 0x30, 0x52, 0x18, 0x0c, 0xdc, 0x97, 0xc0, 0x85, 0x35, 0x6a, 0x70, 0xe2, 0x65,
-	0x07, 0xbd, 0x5d, 0x2d, 0xe8, 0xe0, 0x18, 0x07, 0x47, 0x33, 0xa9, 0x42, 0xfa, 0x70, 0xcb, 0xe6,
-	0xb9, 0x65, 0x3f, 0xbd, 0xca, 0xcf, 0x19, 0x26, 0xdb, 0x6c, 0xd7, 0x95, 0x06, 0xb1, 0x55, 0x51,
-	0xf3, 0xde, 0xbf, 0x22, 0x45, 0x5b, 0x2a, 0xdb, 0x6d, 0x62, 0xea, 0x32, 0x50, 0xcd, 0x93, 0x7c,
-	0x58, 0x55, 0x5d, 0xe7, 0xb9, 0x70, 0xc5, 0xcf, 0x05, 0x6e, 0x5e, 0x51, 0x77, 0x50, 0x31, 0xaa,
-	0xbc, 0xee, 0xb8, 0xd1, 0x8e, 0xb5, 0x39, 0xf0, 0xda, 0x14, 0x48, 0x55, 0x2b, 0xc2, 0x61, 0xa9,
-	0x6a, 0x05, 0x3e, 0x4f, 0x01, 0x75, 0x8d, 0x1a, 0x2b, 0x2d, 0xac, 0x33, 0xfc, 0x5e, 0xdb, 0xb2,
-	0x34, 0xdd, 0x31, 0xf0, 0x03, 0x42, 0x4d, 0xde, 0xbc, 0xfe, 0xdd, 0xfe, 0x93, 0x6f, 0x82, 0xf1,
-	0x26, 0x21, 0x16, 0x4f, 0x91, 0x51, 0x6e, 0x71, 0x59, 0xde, 0xdf, 0xcb, 0x4f, 0x79, 0x48, 0xc5,
-	0x01, 0xd4, 0xc6, 0xf8, 0x57, 0x15, 0x2d, 0x5d, 0xe3, 0xce, 0x86, 0xbe, 0xb3, 0x37, 0xda, 0x96,
-	0x55, 0x6c, 0x71, 0x5f, 0x78, 0x2e, 0xdf, 0xe8, 0xb8, 0xfa, 0x31, 0xb8, 0x9b, 0xd0, 0x63, 0x81,
-	0xf7, 0xcf, 0x00, 0x2f, 0x49, 0x2b, 0x5d, 0x29, 0x5b, 0x91, 0x73, 0x00, 0x34, 0x85, 0x80, 0x6a,
-	0x45, 0xd4, 0x56, 0x68, 0x87, 0xf7, 0xf5, 0xcc, 0x1a, 0x35, 0x1e, 0x39, 0x0f, 0x08, 0xb1, 0x3e,
-	0xda, 0x34, 0x19, 0xb6, 0x4c, 0xca, 0x30, 0xe2, 0xcb, 0x24, 0xe1, 0x08, 0x39, 0x24, 0xd5, 0xd7,
-	0x21, 0x57, 0xb8, 0x43, 0xf2, 0xbe, 0x43, 0xda, 0x0e, 0xdf, 0x2e, 0x3e, 0xe9, 0x28, 0x2f, 0xf2,
-	0x0d, 0xf8, 0x01, 0x28, 0xc4, 0x21, 0x0b, 0xcc, 0xfe, 0x2f, 0x38, 0x85, 0x77, 0x4c, 0x86, 0x51,
-	0x4d, 0x54, 0x2c, 0xcd, 0x48, 0x85, 0xf4, 0xdc, 0xa8, 0x36, 0xe9, 0x6d, 0xaf, 0xba, 0x85, 0x4b,
-	0xe1, 0x8f, 0x69, 0xb0, 0xe8, 0x0a, 0xb3, 0xbc, 0x3c, 0x5e, 0x33, 0x8d, 0x96, 0xce, 0xf0, 0xc3,
-	0x4d, 0xbd, 0x85, 0xe9, 0x3a, 0x09, 0x9c, 0xbd, 0x42, 0x9c, 0x06, 0x76, 0x18, 0x3f, 0x43, 0xbe,
-	0xe3, 0x13, 0xba, 0x21, 0xdc, 0xc7, 0xd2, 0x61, 0x37, 0x88, 0x03, 0x18, 0xf4, 0x36, 0x03, 0xcc,
-	0x50, 0x17, 0x40, 0x8d, 0x91, 0x9a, 0xed, 0x21, 0xea, 0xdf, 0xe8, 0x0a, 0xa2, 0xd1, 0x65, 0x04,
-	0x82, 0x83, 0x12, 0xa0, 0x76, 0x8a, 0x0a, 0xb3, 0x84, 0x95, 0xf2, 0x53, 0x09, 0x4c, 0x31, 0xb2,
-	0x85, 0x9d, 0x1a, 0x69, 0xb3, 0x9a, 0xcd, 0xab, 0x66, 0xb4, 0x5f, 0xd5, 0x54, 0x85, 0x9a, 0x59,
-	0x4f, 0x4d, 0x37, 0x3b, 0x4c, 0x54, 0x4e, 0x27, 0x5d, 0xe6, 0x0f, 0xdb, 0x6c, 0xcd, 0x74, 0xe8,
-	0x52, 0x9e, 0x07, 0x3f, 0xdb, 0x09, 0x7e, 0xd0, 0x7c, 0x7c, 0xfc, 0xdf, 0xa7, 0xc1, 0xbd, 0x61,
-	0x63, 0x15, 0x24, 0x46, 0x15, 0x8c, 0xeb, 0x36, 0x69, 0x3b, 0x6c, 0x5e, 0x04, 0x4d, 0xe5, 0xf6,
-	0xfc, 0xbe, 0x97, 0x9f, 0xf5, 0x40, 0x52, 0xb4, 0xa5, 0x98, 0x44, 0xb5, 0x75, 0xb6, 0xa9, 0x54,
-	0x1d, 0xd6, 0x89, 0x92, 0xe0, 0x82, 0x9a, 0xcf, 0xdf, 0x11, 0x55, 0x72, 0x63, 0x9a, 0x54, 0x54,
-	0x29, 0x10, 0x55, 0x92, 0x2d, 0x30, 0x63, 0x99, 0x8f, 0xdb, 0x26, 0x32, 0xd9, 0x6e, 0xad, 0xe1,
-	0xd6, 0x39, 0xf2, 0x5a, 0x4b, 0xf9, 0x5d, 0x21, 0xf4, 0x7c, 0xaf, 0xd0, 0x55, 0x6c, 0xe8, 0x8d,
-	0xdd, 0x0a, 0x6e, 0x74, 0xa2, 0xde, 0x23, 0x05, 0x6a, 0xd3, 0xc1, 0x9e, 0xd7, 0x40, 0x90, 0xfc,
-	0x08, 0x9c, 0xf8, 0x94, 0x98, 0x4e, 0x8d, 0x5f, 0xf8, 0xdc, 0x36, 0x35, 0xb1, 0x90, 0x55, 0xbc,
-	0xdb, 0xa0, 0xe2, 0xdf, 0x06, 0x95, 0x75, 0xff, 0x36, 0x58, 0xbe, 0x20, 0x22, 0x3e, 0xed, 0xa9,
-	0x08, 0x58, 0xe1, 0xb3, 0x57, 0x79, 0x49, 0x3b, 0xce, 0xd7, 0x9c, 0x18, 0x7e, 0x99, 0x76, 0x1b,
-	0xfb, 0x32, 0x42, 0xeb, 0x24, 0x1c, 0x83, 0x55, 0x5f, 0x7f, 0xa7, 0x4d, 0x05, 0x25, 0x74, 0x17,
-	0x4c, 0xf8, 0x4d, 0x27, 0x18, 0xab, 0xe5, 0x33, 0xfb, 0x7b, 0x79, 0xd9, 0x6f, 0x11, 0xc1, 0x21,
-	0x0c, 0xf5, 0x27, 0x14, 0xaa, 0xbd, 0x54, 0xbf, 0xda, 0xab, 0xf9, 0x49, 0x8e, 0x30, 0x35, 0x5b,
-	0x18, 0xcd, 0xf7, 0xaf, 0xa5, 0x8b, 0x51, 0x49, 0xee, 0xb3, 0x43, 0x6d, 0xd2, 0xdd, 0xa8, 0x88,
-	0x75, 0x8f, 0x82, 0x92, 0x70, 0xea, 0x90, 0x0a, 0x4a, 0x07, 0x14, 0x94, 0x96, 0x6e, 0xf0, 0xd2,
-	0xb8, 0xea, 0x97, 0x86, 0x8e, 0x50, 0x91, 0x91, 0x62, 0xc3, 0x0a, 0x8f, 0x65, 0xdf, 0x35, 0xf0,
-	0xdb, 0xb4, 0x3b, 0x2c, 0x92, 0x44, 0x21, 0x28, 0x8e, 0xa1, 0xa3, 0x11, 0xaa, 0xaa, 0xd4, 0xd1,
-	0x55, 0x55, 0xfa, 0x2d, 0xab, 0xea, 0x13, 0x30, 0xe9, 0xe0, 0x27, 0xb5, 0x20, 0xff, 0x33, 0xc7,
-	0x5c, 0x81, 0xef, 0x0c, 0x56, 0x51, 0xa7, 0x3d, 0xb1, 0x5d, 0x12, 0xa0, 0x76, 0xd2, 0xc1, 0x4f,
-	0x02, 0x57, 0x86, 0xdb, 0x7a, 0xcf, 0xb8, 0x3f, 0xd8, 0xd6, 0xe1, 0xcf, 0x69, 0x31, 0x52, 0xf9,
-	0xc5, 0x72, 0x85, 0x38, 0xdb, 0xb8, 0xc5, 0xf8, 0xf0, 0x66, 0xfa, 0x16, 0x0e, 0x4b, 0x92, 0xfa,
-	0x49, 0x4a, 0x92, 0xfc, 0x87, 0xdc, 0x55, 0x74, 0x30, 0x6d, 0x9b, 0x4e, 0x4d, 0xb7, 0x19, 0x9f,
-	0x12, 0x94, 0xc3, 0x70, 0xad, 0x38, 0x51, 0x5e, 0xec, 0xe7, 0xf2, 0xb3, 0x9e, 0xb2, 0x83, 0xec,
-	0x50, 0x9b, 0xb4, 0x4d, 0x67, 0xd9, 0x66, 0xeb, 0xc4, 0xb3, 0xea, 0x1b, 0x29, 0x3c, 0xca, 0x1a,
-	0x9e, 0xcd, 0x6e, 0x18, 0x0e, 0xad, 0x8e, 0xfb, 0x71, 0xa3, 0x4c, 0x48, 0xe0, 0x63, 0xe6, 0xda,
-	0x80, 0x63, 0xa6, 0x33, 0xf5, 0x84, 0xcb, 0x97, 0xae, 0xf2, 0x6a, 0x2a, 0x74, 0x06, 0x8d, 0xfb,
-	0xc8, 0x11, 0x92, 0xbd, 0xab, 0x97, 0x6b, 0xcb, 0x57, 0x92, 0xb8, 0x67, 0x44, 0x84, 0x2b, 0xa8,
-	0x98, 0x3a, 0x98, 0x66, 0x84, 0x71, 0x07, 0xdb, 0xcc, 0xf3, 0x01, 0x12, 0x73, 0x65, 0x50, 0x1f,
-	0x1e, 0x64, 0x87, 0xda, 0x94, 0xbb, 0xb5, 0x6c, 0x33, 0x57, 0x15, 0x5a, 0xf8, 0x7b, 0x02, 0xa4,
-	0xd7, 0xa8, 0x21, 0xb7, 0x80, 0x1c, 0x75, 0x35, 0x56, 0x7a, 0x7f, 0x44, 0x50, 0x22, 0xdf, 0xbd,
-	0xd9, 0xd2, 0xc0, 0xa4, 0x81, 0x7d, 0x3b, 0xe0, 0x74, 0xe4, 0xf3, 0xf8, 0x66, 0x5f, 0x51, 0x1d,
-	0xe2, 0xec, 0xed, 0x04, 0xc4, 0x71, 0x9a, 0x83, 0xc7, 0xe3, 0x20, 0x9a, 0x7d, 0xe2, 0x81, 0x34,
-	0xf7, 0x3c, 0xf3, 0x7e, 0x90, 0xc0, 0xa5, 0xfe, 0x8f, 0xd8, 0xc5, 0x04, 0x46, 0x75, 0x71, 0x66,
-	0xef, 0x0d, 0xcb, 0x19, 0x20, 0xfc, 0x5a, 0x02, 0xe7, 0xe2, 0x1f, 0x9b, 0xf3, 0x31, 0xf2, 0x63,
-	0x39, 0xb2, 0x8b, 0x49, 0x39, 0x02, 0x24, 0xbf, 0x4a, 0xe0, 0x56, 0xa2, 0x97, 0xdc, 0x4a, 0x8c,
-	0xaa, 0x24, 0x42, 0xb2, 0xf7, 0x8f, 0x40, 0x48, 0x60, 0xc2, 0x67, 0x60, 0x36, 0xfa, 0x95, 0x73,
-	0x2b, 0x46, 0x4b, 0x24, 0x75, 0xf6, 0x7f, 0x49, 0xa8, 0x03, 0xe5, 0x7f, 0x48, 0xe0, 0xff, 0xc3,
-	0x3d, 0x3e, 0x56, 0x63, 0xf5, 0x0d, 0x21, 0x2d, 0xbb, 0x7e, 0x94, 0xd2, 0xba, 0xb2, 0x23, 0xd1,
-	0x75, 0x30, 0x2e, 0x3b, 0x92, 0x08, 0x89, 0xcd, 0x8e, 0xa1, 0xae, 0x44, 0x6e, 0x76, 0x44, 0x0d,
-	0xec, 0xf8, 0xec, 0x88, 0xa0, 0x3e, 0x24, 0x3b, 0x0e, 0x99, 0x2e, 0xe5, 0x07, 0x2f, 0x5e, 0xe7,
-	0xa4, 0x97, 0xaf, 0x73, 0xd2, 0x9f, 0xaf, 0x73, 0xd2, 0xb3, 0x37, 0xb9, 0x91, 0x97, 0x6f, 0x72,
-	0x23, 0xbf, 0xbd, 0xc9, 0x8d, 0x7c, 0x7c, 0x27, 0x34, 0xfd, 0x84, 0xe4, 0xa2, 0xa5, 0xd7, 0xa9,
-	0xbf, 0x50, 0xb7, 0x17, 0x4a, 0xea, 0x4e, 0xd7, 0x8f, 0xce, 0x7c, 0x22, 0xd6, 0xc7, 0xdc, 0xfb,
-	0xfd, 0xed, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x85, 0xea, 0xaa, 0x00, 0x97, 0x16, 0x00, 0x00,
+	// 1714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0x7f, 0xac, 0xc7, 0xb1, 0xd7, 0x66, 0xed, 0x8d, 0xcc, 0xdd, 0x48, 0xda, 0x59,
+	0x67, 0xe3, 0xdd, 0xb5, 0x24, 0xcb, 0xd9, 0x26, 0x5e, 0xf7, 0xd0, 0xb5, 0x2c, 0x6c, 0xa1, 0x8d,
+	0x8d, 0x06, 0xb4, 0x83, 0x02, 0x7b, 0x51, 0x29, 0xcd, 0x98, 0x66, 0x45, 0x72, 0x1c, 0xce, 0xc8,
+	0xb1, 0xd1, 0x53, 0x5b, 0xa0, 0x2d, 0x72, 0x0a, 0x0a, 0x14, 0xed, 0xa5, 0xe8, 0xb9, 0x45, 0x51,
+	0xe4, 0xd0, 0x7b, 0x7b, 0xcc, 0x31, 0xe8, 0xa9, 0x68, 0x01, 0xa7, 0x48, 0x0e, 0xbd, 0xfb, 0x2f,
+	0x28, 0x86, 0x1c, 0x8e, 0x28, 0x99, 0xb4, 0x4c, 0xc7, 0x87, 0xf6, 0x92, 0x88, 0x33, 0xef, 0xe3,
+	0xf7, 0xde, 0xbc, 0xdf, 0x9b, 0x0f, 0x83, 0xf7, 0x09, 0x75, 0x08, 0xb5, 0x68, 0x85, 0x76, 0x0f,
+	0xb1, 0xb7, 0x6f, 0x77, 0x2d, 0x54, 0x61, 0xc7, 0xe5, 0x43, 0x8f, 0x30, 0xa2, 0xaa, 0x62, 0xb2,
+	0xdc, 0x9b, 0xd4, 0xe6, 0x4d, 0x62, 0x12, 0x7f, 0xba, 0xc2, 0x7f, 0x05, 0x92, 0xda, 0x9c, 0xe1,
+	0x58, 0x2e, 0xa9, 0xf8, 0xff, 0x8a, 0xa1, 0xc5, 0xb6, 0xaf, 0xdd, 0x0c, 0x64, 0x83, 0x0f, 0x31,
+	0x95, 0x37, 0x09, 0x31, 0x6d, 0x5c, 0xf1, 0xbf, 0x5a, 0xdd, 0xfd, 0x0a, 0xea, 0x7a, 0x06, 0xb3,
+	0x88, 0x1b, 0xce, 0x07, 0xd2, 0x95, 0x96, 0x41, 0x71, 0xe5, 0xa8, 0xda, 0xc2, 0xcc, 0xa8, 0x56,
+	0xda, 0xc4, 0x0a, 0xe7, 0x0b, 0x83, 0xfa, 0xcc, 0x72, 0x30, 0x65, 0x86, 0x73, 0x28, 0x04, 0x3e,
+	0x8a, 0x89, 0xaa, 0xf7, 0x33, 0x10, 0x82, 0xbf, 0x55, 0xc0, 0xc2, 0x0e, 0x35, 0x77, 0xe5, 0x78,
+	0x1d, 0xdb, 0xd8, 0x34, 0x18, 0x56, 0x3f, 0x01, 0xe3, 0x14, 0xbb, 0x08, 0x7b, 0x39, 0xa5, 0xa8,
+	0x2c, 0x4f, 0xd6, 0xe6, 0xce, 0x4e, 0x0b, 0xd3, 0x27, 0x86, 0x63, 0x6f, 0xc0, 0x60, 0x1c, 0xea,
+	0x42, 0x40, 0xbd, 0x09, 0x26, 0x6c, 0xd2, 0xee, 0x34, 0x2d, 0x94, 0xcb, 0x14, 0x95, 0xe5, 0x51,
+	0x7d, 0x9c, 0x7f, 0x36, 0x90, 0xba, 0x08, 0xde, 0x39, 0x32, 0xec, 0xa6, 0x81, 0x90, 0x97, 0xcb,
+	0x72, 0x2b, 0xfa, 0xc4, 0x91, 0x61, 0x6f, 0x22, 0xe4, 0x6d, 0x14, 0x9f, 0xfe, 0xe7, 0xf9, 0xa7,
+	0x31, 0x89, 0x2f, 0x21, 0x01, 0x00, 0x16, 0xc0, 0xad, 0x58, 0x64, 0x3a, 0xa6, 0x87, 0xc4, 0xa5,
+	0x18, 0xfe, 0x44, 0x01, 0x37, 0xfb, 0x24, 0x1e, 0xb9, 0xe8, 0x1a, 0xd1, 0x6f, 0x40, 0x0e, 0xf1,
+	0x56, 0x0c, 0xc4, 0xae, 0xf4, 0x03, 0x3f, 0x04, 0x85, 0x04, 0x08, 0x12, 0xe6, 0x4f, 0xcf, 0xc3,
+	0x6c, 0x11, 0x17, 0x6d, 0x93, 0x76, 0xe7, 0x5a, 0x60, 0x7e, 0xc4, 0x61, 0xe6, 0x63, 0x61, 0x72,
+	0x3f, 0x25, 0x2e, 0x16, 0x83, 0x33, 0xc4, 0x20, 0x71, 0xfe, 0x59, 0x01, 0x4b, 0x09, 0xb1, 0x6c,
+	0xba, 0xd7, 0x0c, 0x5a, 0xad, 0x81, 0x51, 0x5e, 0xcb, 0x7e, 0x55, 0x4c, 0xad, 0x2d, 0x96, 0x05,
+	0x35, 0x78, 0xb1, 0x97, 0x45, 0xb1, 0x97, 0xb7, 0x88, 0xe5, 0xd6, 0xbe, 0xf5, 0xe2, 0xb4, 0x30,
+	0x72, 0x76, 0x5a, 0x98, 0x0a, 0x1c, 0x70, 0x25, 0xa8, 0xfb, 0xba, 0xf0, 0x7b, 0x60, 0xe5, 0x32,
+	0x78, 0xc3, 0x00, 0xa3, 0x60, 0x94, 0x28, 0x18, 0x78, 0xa6, 0x80, 0x0f, 0x76, 0xa8, 0xc9, 0x85,
+	0x37, 0x5d, 0xf4, 0x76, 0x5c, 0x30, 0xc0, 0x18, 0x07, 0x47, 0x73, 0x99, 0x62, 0xf6, 0xe2, 0xc8,
+	0x56, 0x79, 0x64, 0x7f, 0x7c, 0x55, 0x58, 0x36, 0x2d, 0x76, 0xd0, 0x6d, 0x95, 0xdb, 0xc4, 0x11,
+	0x1d, 0x42, 0xfc, 0x57, 0xa2, 0xa8, 0x53, 0x61, 0x27, 0x87, 0x98, 0xfa, 0x0a, 0x54, 0x0f, 0x2c,
+	0x5f, 0xc4, 0xaa, 0x4f, 0x78, 0x2d, 0x2c, 0x85, 0xb5, 0xc0, 0xc3, 0x2b, 0x19, 0x2e, 0x2a, 0xc5,
+	0xd1, 0xeb, 0x1e, 0x58, 0xba, 0x28, 0x66, 0x99, 0xb5, 0x19, 0x90, 0x69, 0xd4, 0x45, 0xc2, 0x32,
+	0x8d, 0x3a, 0x7c, 0x9e, 0x01, 0x95, 0x1d, 0x6a, 0x6e, 0x79, 0xd8, 0x60, 0xf8, 0xab, 0xae, 0x6d,
+	0xeb, 0x86, 0x6b, 0xe2, 0x87, 0x84, 0x5a, 0xbc, 0x79, 0xfd, 0x7f, 0xe7, 0x4f, 0xfd, 0x0c, 0x4c,
+	0x1c, 0x12, 0x62, 0xf3, 0x12, 0x19, 0xe5, 0x11, 0xd7, 0xd4, 0xb3, 0xd3, 0xc2, 0x4c, 0x80, 0x54,
+	0x4c, 0x40, 0x7d, 0x9c, 0xff, 0x6a, 0xa0, 0x8d, 0x3b, 0x3c, 0xd9, 0x30, 0x4c, 0xf6, 0x7e, 0xd7,
+	0xb6, 0x4b, 0x1e, 0xcf, 0x45, 0x90, 0xf2, 0xfd, 0x5e, 0xaa, 0x1f, 0x83, 0xfb, 0x29, 0x33, 0x26,
+	0xb3, 0xff, 0x1e, 0x08, 0x8a, 0xb4, 0xde, 0x57, 0xb2, 0x75, 0x35, 0x0f, 0xc0, 0xa1, 0x30, 0xd0,
+	0xa8, 0x0b, 0x6e, 0x45, 0x46, 0x78, 0x5f, 0xcf, 0xed, 0x50, 0xf3, 0x91, 0xfb, 0x90, 0x10, 0xfb,
+	0x07, 0x07, 0x16, 0xc3, 0xb6, 0x45, 0x19, 0x46, 0xfc, 0x33, 0xcd, 0x72, 0x44, 0x12, 0x92, 0x19,
+	0x9a, 0x90, 0x25, 0x9e, 0x90, 0x42, 0x98, 0x90, 0xae, 0xcb, 0x87, 0x4b, 0x4f, 0x7a, 0xce, 0x4b,
+	0x7c, 0x00, 0x7e, 0x0d, 0x8a, 0x49, 0xc8, 0x64, 0xd8, 0x1f, 0x83, 0x77, 0xf1, 0xb1, 0xc5, 0x30,
+	0x6a, 0x0a, 0xc6, 0xd2, 0x9c, 0x52, 0xcc, 0x2e, 0x8f, 0xea, 0xd3, 0xc1, 0xf0, 0xb6, 0x4f, 0x5c,
+	0x0a, 0xff, 0x90, 0x05, 0xeb, 0xbe, 0x31, 0x3b, 0xa8, 0xe3, 0x1d, 0xcb, 0xf4, 0x0c, 0x86, 0x77,
+	0x0f, 0x0c, 0x0f, 0xd3, 0x3d, 0x22, 0x93, 0xbd, 0x45, 0xdc, 0x36, 0x76, 0x19, 0x9f, 0x43, 0x61,
+	0xe2, 0x53, 0xa6, 0x21, 0xda, 0xc7, 0xb2, 0xd1, 0x34, 0x88, 0x09, 0x28, 0x7b, 0x9b, 0x09, 0xe6,
+	0xa8, 0x0f, 0xa0, 0xc9, 0x48, 0xd3, 0x09, 0x10, 0x0d, 0x6f, 0x74, 0x45, 0xd1, 0xe8, 0x72, 0x02,
+	0xc1, 0xa0, 0x05, 0xa8, 0xbf, 0x4b, 0x45, 0x58, 0x22, 0x4a, 0xf5, 0xa9, 0x02, 0x66, 0x18, 0xe9,
+	0x60, 0xb7, 0x49, 0xba, 0xac, 0xe9, 0x70, 0xd6, 0x8c, 0x0e, 0x63, 0x4d, 0x43, 0xb8, 0x59, 0x08,
+	0xdc, 0xf4, 0xab, 0xc3, 0x54, 0x74, 0xba, 0xe1, 0x2b, 0x7f, 0xbf, 0xcb, 0x76, 0x2c, 0x97, 0x6e,
+	0x14, 0xf8, 0xe2, 0x6b, 0xbd, 0xc5, 0x97, 0xcd, 0x27, 0xc4, 0xff, 0xbb, 0x2c, 0xf8, 0xf2, 0xaa,
+	0x6b, 0x25, 0x0b, 0xa3, 0x01, 0x26, 0x0c, 0x87, 0x74, 0x5d, 0xb6, 0x2a, 0x16, 0xad, 0xc2, 0xe3,
+	0xf9, 0xe7, 0x69, 0x61, 0x21, 0x00, 0x49, 0x51, 0xa7, 0x6c, 0x91, 0x8a, 0x63, 0xb0, 0x83, 0x72,
+	0xc3, 0x65, 0xbd, 0x55, 0x12, 0x5a, 0x50, 0x0f, 0xf5, 0x7b, 0xa6, 0xaa, 0xb9, 0xcc, 0x15, 0x4c,
+	0x55, 0xa5, 0xa9, 0xaa, 0x6a, 0x83, 0x39, 0xdb, 0x7a, 0xdc, 0xb5, 0x90, 0xc5, 0x4e, 0x9a, 0x6d,
+	0x9f, 0xe7, 0x28, 0x68, 0x2d, 0xb5, 0xef, 0x0a, 0xa3, 0xef, 0x9f, 0x37, 0xba, 0x8d, 0x4d, 0xa3,
+	0x7d, 0x52, 0xc7, 0xed, 0xde, 0xaa, 0x9f, 0xb3, 0x02, 0xf5, 0x59, 0x39, 0x16, 0x34, 0x10, 0xa4,
+	0x3e, 0x02, 0x93, 0x3f, 0x22, 0x96, 0xdb, 0xe4, 0x07, 0x3e, 0xbf, 0x4d, 0x4d, 0xad, 0x69, 0xe5,
+	0xe0, 0x34, 0x58, 0x0e, 0x4f, 0x83, 0xe5, 0xbd, 0xf0, 0x34, 0x58, 0xfb, 0x40, 0xac, 0xf8, 0x6c,
+	0xe0, 0x42, 0xaa, 0xc2, 0x67, 0xaf, 0x0a, 0x8a, 0xfe, 0x0e, 0xff, 0xe6, 0xc2, 0xf0, 0x67, 0x59,
+	0xbf, 0xb1, 0x6f, 0x22, 0xb4, 0x47, 0xa2, 0x6b, 0xb0, 0x1d, 0xfa, 0xef, 0xb5, 0x29, 0x49, 0xa1,
+	0xfb, 0x60, 0x2a, 0x6c, 0x3a, 0x72, 0x5b, 0xad, 0xbd, 0x77, 0x76, 0x5a, 0x50, 0xc3, 0x16, 0x21,
+	0x27, 0x61, 0xa4, 0x3f, 0xa1, 0x08, 0xf7, 0x32, 0xc3, 0xb8, 0xd7, 0x0c, 0x8b, 0x1c, 0x61, 0x6a,
+	0x79, 0x18, 0xad, 0x0e, 0xe7, 0xd2, 0xad, 0xb8, 0x22, 0x0f, 0xd5, 0xa1, 0x3e, 0xed, 0x0f, 0xd4,
+	0xc5, 0xf7, 0x39, 0x07, 0xd5, 0xdc, 0xe8, 0xdb, 0x38, 0xa8, 0x0e, 0x38, 0xa8, 0x6e, 0x7c, 0xca,
+	0xa9, 0x71, 0x3b, 0xa4, 0x86, 0x81, 0x50, 0x89, 0x91, 0x52, 0xdb, 0x8e, 0x6e, 0xcb, 0x61, 0x6a,
+	0xe0, 0x6f, 0xb2, 0xe0, 0x7e, 0xca, 0x55, 0x90, 0xe4, 0xb8, 0xf2, 0x6a, 0x44, 0x58, 0x95, 0xb9,
+	0x3e, 0x56, 0x65, 0xdf, 0x92, 0x55, 0x3f, 0x04, 0xd3, 0x2e, 0x7e, 0xd2, 0x94, 0xf5, 0x9f, 0x1b,
+	0xf3, 0x0d, 0x7e, 0xe7, 0x72, 0x8c, 0x9a, 0x0f, 0xcc, 0xf6, 0x59, 0x80, 0xfa, 0x0d, 0x17, 0x3f,
+	0x91, 0xa9, 0x8c, 0xb6, 0xf5, 0x73, 0xdb, 0xfd, 0x60, 0x5b, 0x87, 0x7f, 0xca, 0x8a, 0x2d, 0x95,
+	0x1f, 0x2c, 0xb7, 0x88, 0x7b, 0x84, 0x3d, 0xc6, 0x37, 0x6f, 0x66, 0x74, 0x70, 0xd4, 0x92, 0x32,
+	0xcc, 0x52, 0x9a, 0xe2, 0xbf, 0xe0, 0xac, 0x62, 0x80, 0x59, 0xc7, 0x72, 0x9b, 0x86, 0xc3, 0xf8,
+	0x2e, 0x41, 0x39, 0x0c, 0x3f, 0x8a, 0xc9, 0xda, 0xfa, 0xb0, 0x94, 0xdf, 0x0c, 0x9c, 0x0d, 0xaa,
+	0x43, 0x7d, 0xda, 0xb1, 0xdc, 0x4d, 0x87, 0xed, 0x91, 0x20, 0xaa, 0x5f, 0x29, 0xd1, 0xad, 0xac,
+	0x1d, 0xc4, 0x9c, 0x1b, 0x1b, 0xc6, 0x8e, 0x07, 0x49, 0x5b, 0x99, 0xb0, 0xc0, 0xb7, 0x99, 0x3b,
+	0x97, 0xdc, 0x66, 0x7a, 0xbb, 0x9e, 0x48, 0xf9, 0xc6, 0x6d, 0xce, 0xa6, 0x62, 0x6f, 0xa3, 0xf1,
+	0x2f, 0x39, 0xc2, 0x72, 0x70, 0xf4, 0xf2, 0x63, 0xf9, 0xb9, 0x22, 0xce, 0x19, 0x31, 0xcb, 0x25,
+	0x19, 0xd3, 0x02, 0xb3, 0x8c, 0x30, 0x9e, 0x60, 0x87, 0x05, 0x39, 0x40, 0x39, 0x25, 0x55, 0x0e,
+	0x07, 0xd5, 0xa1, 0x3e, 0xe3, 0x0f, 0x6d, 0x3a, 0x6c, 0x37, 0x18, 0xf8, 0x75, 0x06, 0x68, 0xfc,
+	0x9e, 0x82, 0x59, 0x8f, 0xba, 0xba, 0x45, 0x3b, 0x5f, 0x19, 0x6d, 0x46, 0x3c, 0xf5, 0x6b, 0x30,
+	0x69, 0x74, 0xd9, 0x01, 0xf1, 0x78, 0x85, 0x07, 0xbe, 0x57, 0x7a, 0xdd, 0x5a, 0x4e, 0xc1, 0xbf,
+	0xff, 0xa5, 0x34, 0x2f, 0x32, 0xce, 0x97, 0x1f, 0x53, 0xba, 0xcb, 0x3c, 0xcb, 0x35, 0xf5, 0x9e,
+	0xba, 0xfa, 0x31, 0x18, 0x43, 0xd8, 0x25, 0x8e, 0xa8, 0xab, 0xd9, 0xb3, 0xd3, 0xc2, 0x8d, 0xc0,
+	0x8e, 0x3f, 0x0c, 0xf5, 0x60, 0x5a, 0xfd, 0x06, 0x4c, 0x79, 0x16, 0xed, 0x34, 0xf7, 0x7d, 0x08,
+	0x82, 0xa8, 0x5f, 0x5c, 0x8e, 0x57, 0xa2, 0x97, 0x44, 0xf4, 0xa1, 0x0e, 0x3c, 0x19, 0xcf, 0xc0,
+	0xa9, 0x98, 0x62, 0x16, 0x6d, 0x73, 0x5c, 0xae, 0x24, 0xb4, 0x96, 0x00, 0x4c, 0x4e, 0x8b, 0xbc,
+	0x95, 0xfe, 0x55, 0xf1, 0x9f, 0x01, 0x74, 0xec, 0x90, 0x23, 0xfc, 0xbf, 0x92, 0xc0, 0x81, 0x8e,
+	0xee, 0xf9, 0xe0, 0x92, 0xe2, 0xbc, 0x03, 0x6e, 0x5f, 0x18, 0x40, 0x18, 0xea, 0xda, 0x2f, 0x67,
+	0x40, 0x76, 0x87, 0x9a, 0xaa, 0x07, 0xd4, 0xb8, 0x3b, 0x54, 0xf9, 0xfc, 0x43, 0x54, 0x39, 0xf6,
+	0x81, 0x44, 0xab, 0x5e, 0x5a, 0x54, 0x12, 0xe1, 0x18, 0xcc, 0xc7, 0xbe, 0xa3, 0x7c, 0x36, 0xd4,
+	0x54, 0x4f, 0x58, 0xbb, 0x9b, 0x42, 0x38, 0xc9, 0xb3, 0x7c, 0x65, 0xb8, 0x8c, 0xe7, 0x50, 0x58,
+	0xbb, 0x9b, 0x42, 0x58, 0x7a, 0xfe, 0xbd, 0x02, 0x3e, 0x1c, 0xfe, 0xda, 0xb1, 0x9e, 0x22, 0xa8,
+	0x3e, 0x4d, 0xed, 0xcb, 0xab, 0x6a, 0x4a, 0x84, 0xbf, 0x50, 0xc0, 0x62, 0xf2, 0xab, 0xc4, 0x6a,
+	0x82, 0xfd, 0x44, 0x0d, 0x6d, 0x3d, 0xad, 0x86, 0x44, 0xf2, 0x37, 0x05, 0xac, 0xa4, 0xba, 0xf2,
+	0x6f, 0x25, 0xb8, 0x4a, 0x63, 0x44, 0x7b, 0x70, 0x0d, 0x46, 0x64, 0x08, 0x3f, 0x06, 0x0b, 0xf1,
+	0xd7, 0xe1, 0x95, 0x04, 0x2f, 0xb1, 0xd2, 0xda, 0xe7, 0x69, 0xa4, 0xa5, 0xf3, 0x7f, 0x29, 0xe0,
+	0xdb, 0x57, 0xbb, 0xa5, 0x6e, 0x27, 0xfa, 0xbb, 0x82, 0x35, 0x6d, 0xef, 0x3a, 0xad, 0xf5, 0x55,
+	0x47, 0xaa, 0x7b, 0x43, 0x52, 0x75, 0xa4, 0x31, 0xa2, 0x3d, 0xb8, 0x06, 0x23, 0xfd, 0xd5, 0x11,
+	0x77, 0xb2, 0x4b, 0xae, 0x8e, 0x18, 0x69, 0xed, 0xf3, 0x34, 0xd2, 0xd2, 0x39, 0x7f, 0xc9, 0x4e,
+	0x3a, 0x1f, 0x94, 0x93, 0xba, 0x48, 0xbc, 0xbc, 0x76, 0x2f, 0x9d, 0xbc, 0xc4, 0xf0, 0x54, 0x01,
+	0xda, 0x05, 0xbb, 0x6c, 0xd2, 0x9e, 0x92, 0xac, 0xa2, 0x7d, 0x91, 0x5a, 0x25, 0x04, 0x53, 0x7b,
+	0xf8, 0xe2, 0x75, 0x5e, 0x79, 0xf9, 0x3a, 0xaf, 0xfc, 0xfb, 0x75, 0x5e, 0x79, 0xf6, 0x26, 0x3f,
+	0xf2, 0xf2, 0x4d, 0x7e, 0xe4, 0x1f, 0x6f, 0xf2, 0x23, 0xdf, 0xdc, 0x8b, 0x9c, 0x1b, 0x85, 0xf9,
+	0x92, 0x6d, 0xb4, 0x68, 0xf8, 0x51, 0x39, 0x5a, 0xab, 0x56, 0x8e, 0xfb, 0xfe, 0x92, 0xc3, 0xcf,
+	0x92, 0xad, 0x71, 0xff, 0x66, 0x7c, 0xf7, 0xbf, 0x03, 0x00, 0x45, 0x71, 0xbd, 0x15, 0xec, 0x19,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1293,6 +1499,14 @@ type MsgClient interface {
 	// UnbondConvertAndStake breaks all locks / superfluid staked assets,
 	// converts them to osmo then stakes the osmo to the designated validator.
 	UnbondConvertAndStake(ctx context.Context, in *MsgUnbondConvertAndStake, opts ...grpc.CallOption) (*MsgUnbondConvertAndStakeResponse, error)
+	// SetSuperfluidRiskFactor sets the risk factor of a superfluid asset. It can
+	// only be executed by governance, and the superfluid delegations of the
+	// asset are updated to the new risk factor at the next epoch.
+	SetSuperfluidRiskFactor(ctx context.Context, in *MsgSetSuperfluidRiskFactor, opts ...grpc.CallOption) (*MsgSetSuperfluidRiskFactorResponse, error)
+	// RemoveSuperfluidRiskFactor removes the risk factor of a superfluid asset,
+	// which then falls back to the minimum_risk_factor param. It can only be
+	// executed by governance.
+	RemoveSuperfluidRiskFactor(ctx context.Context, in *MsgRemoveSuperfluidRiskFactor, opts ...grpc.CallOption) (*MsgRemoveSuperfluidRiskFactorResponse, error)
 }
 
 type msgClient struct {
@@ -1393,6 +1607,24 @@ func (c *msgClient) UnbondConvertAndStake(ctx context.Context, in *MsgUnbondConv
 	return out, nil
 }
 
+func (c *msgClient) SetSuperfluidRiskFactor(ctx context.Context, in *MsgSetSuperfluidRiskFactor, opts ...grpc.CallOption) (*MsgSetSuperfluidRiskFactorResponse, error) {
+	out := new(MsgSetSuperfluidRiskFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SetSuperfluidRiskFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSuperfluidRiskFactor(ctx context.Context, in *MsgRemoveSuperfluidRiskFactor, opts ...grpc.CallOption) (*MsgRemoveSuperfluidRiskFactorResponse, error) {
+	out := new(MsgRemoveSuperfluidRiskFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/RemoveSuperfluidRiskFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
@@ -1413,6 +1645,14 @@ type MsgServer interface {
 	// UnbondConvertAndStake breaks all locks / superfluid staked assets,
 	// converts them to osmo then stakes the osmo to the designated validator.
 	UnbondConvertAndStake(context.Context, *MsgUnbondConvertAndStake) (*MsgUnbondConvertAndStakeResponse, error)
+	// SetSuperfluidRiskFactor sets the risk factor of a superfluid asset. It can
+	// only be executed by governance, and the superfluid delegations of the
+	// asset are updated to the new risk factor at the next epoch.
+	SetSuperfluidRiskFactor(context.Context, *MsgSetSuperfluidRiskFactor) (*MsgSetSuperfluidRiskFactorResponse, error)
+	// RemoveSuperfluidRiskFactor removes the risk factor of a superfluid asset,
+	// which then falls back to the minimum_risk_factor param. It can only be
+	// executed by governance.
+	RemoveSuperfluidRiskFactor(context.Context, *MsgRemoveSuperfluidRiskFactor) (*MsgRemoveSuperfluidRiskFactorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1449,6 +1689,12 @@ func (*UnimplementedMsgServer) AddToConcentratedLiquiditySuperfluidPosition(ctx
 func (*UnimplementedMsgServer) UnbondConvertAndStake(ctx context.Context, req *MsgUnbondConvertAndStake) (*MsgUnbondConvertAndStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbondConvertAndStake not implemented")
 }
+func (*UnimplementedMsgServer) SetSuperfluidRiskFactor(ctx context.Context, req *MsgSetSuperfluidRiskFactor) (*MsgSetSuperfluidRiskFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSuperfluidRiskFactor not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSuperfluidRiskFactor(ctx context.Context, req *MsgRemoveSuperfluidRiskFactor) (*MsgRemoveSuperfluidRiskFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSuperfluidRiskFactor not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1634,6 +1880,42 @@ func _Msg_UnbondConvertAndStake_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSuperfluidRiskFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSuperfluidRiskFactor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSuperfluidRiskFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SetSuperfluidRiskFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSuperfluidRiskFactor(ctx, req.(*MsgSetSuperfluidRiskFactor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSuperfluidRiskFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSuperfluidRiskFactor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSuperfluidRiskFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/RemoveSuperfluidRiskFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSuperfluidRiskFactor(ctx, req.(*MsgRemoveSuperfluidRiskFactor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1678,6 +1960,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnbondConvertAndStake",
 			Handler:    _Msg_UnbondConvertAndStake_Handler,
 		},
+		{
+			MethodName: "SetSuperfluidRiskFactor",
+			Handler:    _Msg_SetSuperfluidRiskFactor_Handler,
+		},
+		{
+			MethodName: "RemoveSuperfluidRiskFactor",
+			Handler:    _Msg_RemoveSuperfluidRiskFactor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -2514,6 +2804,136 @@ func (m *MsgUnbondConvertAndStakeResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSuperfluidRiskFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSuperfluidRiskFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSuperfluidRiskFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RiskFactor.Size()
+		i -= size
+		if _, err := m.RiskFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSuperfluidRiskFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSuperfluidRiskFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSuperfluidRiskFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSuperfluidRiskFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSuperfluidRiskFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSuperfluidRiskFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSuperfluidRiskFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSuperfluidRiskFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSuperfluidRiskFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2859,14 +3279,68 @@ func (m *MsgUnbondConvertAndStakeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *MsgSetSuperfluidRiskFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RiskFactor.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSuperfluidRiskFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSuperfluidRiskFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSuperfluidRiskFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -5246,6 +5720,368 @@ func (m *MsgUnbondConvertAndStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSuperfluidRiskFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSuperfluidRiskFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSuperfluidRiskFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RiskFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSuperfluidRiskFactorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSuperfluidRiskFactorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSuperfluidRiskFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSuperfluidRiskFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSuperfluidRiskFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSuperfluidRiskFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSuperfluidRiskFactorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSuperfluidRiskFactorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSuperfluidRiskFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
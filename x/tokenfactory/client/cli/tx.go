@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"os"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
 
 	// "github.com/cosmos/cosmos-sdk/client/flags"
@@ -18,9 +21,10 @@ func GetTxCmd() *cobra.Command {
 		NewCreateDenomCmd(),
 		NewMintCmd(),
 		NewBurnCmd(),
-		// NewForceTransferCmd(),
+		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHookCmd(),
+		NewSetDenomMetadataCmd(),
 	)
 
 	return cmd
@@ -47,6 +51,13 @@ func NewBurnCmd() *cobra.Command {
 	})
 }
 
+func NewForceTransferCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgForceTransfer](&osmocli.TxCliDesc{
+		Use:   "force-transfer",
+		Short: "Force transfer tokens from one address to another address. Must have admin authority to do so.",
+	})
+}
+
 func NewChangeAdminCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgChangeAdmin](&osmocli.TxCliDesc{
 		Use:   "change-admin",
@@ -85,3 +96,59 @@ func NewSetBeforeSendHookCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetDenomMetadataCmd broadcast MsgSetDenomMetadata
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file] [flags]",
+		Short: "Set the bank metadata of a factory-created denom from a JSON file. Must have admin authority to do so.",
+		Long: `Set the bank metadata of a factory-created denom from a JSON file. Must have admin authority to do so.
+The base of the metadata must be the factory-created denom.
+
+Example metadata file:
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/osmo1.../mytoken", "exponent": 0},
+    {"denom": "mytoken", "exponent": 6}
+  ],
+  "base": "factory/osmo1.../mytoken",
+  "display": "mytoken",
+  "name": "My Token",
+  "symbol": "MTK"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(
+				clientCtx.GetFromAddress().String(),
+				metadata,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
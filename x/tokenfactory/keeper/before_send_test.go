@@ -22,7 +22,9 @@ func (s *KeeperTestSuite) TestBeforeSendHook() {
 	for _, tc := range []struct {
 		desc     string
 		wasmFile string
-		sendMsgs []SendMsgTestCase
+		// if zero, the default gas limit is used
+		beforeSendHookGasLimit uint64
+		sendMsgs               []SendMsgTestCase
 	}{
 		{
 			desc:     "should not allow sending 100 amount of *any* denom",
@@ -85,11 +87,44 @@ func (s *KeeperTestSuite) TestBeforeSendHook() {
 				},
 			},
 		},
+		{
+			desc:                   "should not allow sending factorydenom when the hook runs out of gas",
+			wasmFile:               "./testdata/no100.wasm",
+			beforeSendHookGasLimit: 1,
+			sendMsgs: []SendMsgTestCase{
+				{
+					desc: "sending 1 of factorydenom should error",
+					msg: func(factorydenom string) *banktypes.MsgSend {
+						return banktypes.NewMsgSend(
+							s.TestAccs[0],
+							s.TestAccs[1],
+							sdk.NewCoins(sdk.NewInt64Coin(factorydenom, 1)),
+						)
+					},
+					expectPass: false,
+				},
+				{
+					desc: "sending 1 of non-factorydenom should not error",
+					msg: func(factorydenom string) *banktypes.MsgSend {
+						return banktypes.NewMsgSend(
+							s.TestAccs[0],
+							s.TestAccs[1],
+							sdk.NewCoins(sdk.NewInt64Coin("foo", 1)),
+						)
+					},
+					expectPass: true,
+				},
+			},
+		},
 	} {
 		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
 			// setup test
 			s.SetupTest()
 
+			if tc.beforeSendHookGasLimit != 0 {
+				s.App.TokenFactoryKeeper.SetParam(s.Ctx, types.KeyBeforeSendHookGasLimit, tc.beforeSendHookGasLimit)
+			}
+
 			// upload and instantiate wasm code
 			wasmCode, err := os.ReadFile(tc.wasmFile)
 			s.Require().NoError(err, "test: %v", tc.desc)
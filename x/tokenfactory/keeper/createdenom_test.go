@@ -78,10 +78,10 @@ func (s *KeeperTestSuite) TestCreateDenom() {
 	var (
 		primaryDenom            = "uosmo"
 		secondaryDenom          = apptesting.SecondaryDenom
-		defaultDenomCreationFee = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, osmomath.NewInt(50000000)))}
-		twoDenomCreationFee     = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, osmomath.NewInt(50000000)), sdk.NewCoin(secondaryDenom, osmomath.NewInt(50000000)))}
-		nilCreationFee          = types.Params{DenomCreationFee: nil}
-		largeCreationFee        = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, osmomath.NewInt(5000000000)))}
+		defaultDenomCreationFee = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, osmomath.NewInt(50000000))), BeforeSendHookGasLimit: types.DefaultBeforeSendHookGasLimit}
+		twoDenomCreationFee     = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, osmomath.NewInt(50000000)), sdk.NewCoin(secondaryDenom, osmomath.NewInt(50000000))), BeforeSendHookGasLimit: types.DefaultBeforeSendHookGasLimit}
+		nilCreationFee          = types.Params{DenomCreationFee: nil, BeforeSendHookGasLimit: types.DefaultBeforeSendHookGasLimit}
+		largeCreationFee        = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, osmomath.NewInt(5000000000))), BeforeSendHookGasLimit: types.DefaultBeforeSendHookGasLimit}
 	)
 
 	for _, tc := range []struct {
@@ -234,7 +234,7 @@ func (s *KeeperTestSuite) TestGasConsume() {
 		s.SetupTest()
 		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
 			// set params with the gas consume amount
-			s.App.TokenFactoryKeeper.SetParams(s.Ctx, types.NewParams(nil, tc.gasConsume))
+			s.App.TokenFactoryKeeper.SetParams(s.Ctx, types.NewParams(nil, tc.gasConsume, types.DefaultBeforeSendHookGasLimit))
 
 			// amount of gas consumed prior to the denom creation
 			gasConsumedBefore := s.Ctx.GasMeter().GasConsumed()
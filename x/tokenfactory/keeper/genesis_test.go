@@ -9,6 +9,7 @@ import (
 
 func (s *KeeperTestSuite) TestGenesis() {
 	genesisState := types.GenesisState{
+		Params: types.NewParams(nil, uint64(types.DefaultCreationGasFee), types.DefaultBeforeSendHookGasLimit),
 		FactoryDenoms: []types.GenesisDenom{
 			{
 				Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -55,7 +56,7 @@ func (s *KeeperTestSuite) TestGenesis() {
 	tokenfactoryModuleAccount := app.AccountKeeper.GetAccount(s.Ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName))
 	s.Require().Nil(tokenfactoryModuleAccount)
 
-	app.TokenFactoryKeeper.SetParams(s.Ctx, types.Params{DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}})
+	app.TokenFactoryKeeper.SetParams(s.Ctx, types.Params{DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}, BeforeSendHookGasLimit: types.DefaultBeforeSendHookGasLimit})
 	app.TokenFactoryKeeper.InitGenesis(s.Ctx, genesisState)
 
 	// check that the module account is now initialized
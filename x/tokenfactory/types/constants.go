@@ -1,5 +1,5 @@
 package types
 
 var (
-	BeforeSendHookGasLimit = uint64(500_000)
+	DefaultBeforeSendHookGasLimit = uint64(500_000)
 )
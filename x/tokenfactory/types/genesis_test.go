@@ -22,6 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				FactoryDenoms: []types.GenesisDenom{
 					{
 						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -36,6 +37,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "different admin from creator",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				FactoryDenoms: []types.GenesisDenom{
 					{
 						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -50,6 +52,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "empty admin",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				FactoryDenoms: []types.GenesisDenom{
 					{
 						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -64,6 +67,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "no admin",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				FactoryDenoms: []types.GenesisDenom{
 					{
 						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -75,6 +79,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid admin",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				FactoryDenoms: []types.GenesisDenom{
 					{
 						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -89,6 +94,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "multiple denoms",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				FactoryDenoms: []types.GenesisDenom{
 					{
 						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
@@ -106,9 +112,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "zero before send hook gas limit",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, uint64(types.DefaultCreationGasFee), 0),
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicate denoms",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				FactoryDenoms: []types.GenesisDenom{
 					{
 						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
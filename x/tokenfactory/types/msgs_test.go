@@ -54,6 +54,29 @@ func TestAuthzMsg(t *testing.T) {
 				NewAdmin: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
 			},
 		},
+		{
+			name: "MsgForceTransfer",
+			msg: &types.MsgForceTransfer{
+				Sender:              addr1,
+				Amount:              coin,
+				TransferFromAddress: addr1,
+				TransferToAddress:   "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+			},
+		},
+		{
+			name: "MsgSetDenomMetadata",
+			msg: &types.MsgSetDenomMetadata{
+				Sender: addr1,
+				Metadata: banktypes.Metadata{
+					Description: "description",
+					DenomUnits:  []*banktypes.DenomUnit{{Denom: "denom", Exponent: 0}},
+					Base:        "denom",
+					Display:     "denom",
+					Name:        "name",
+					Symbol:      "DENOM",
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
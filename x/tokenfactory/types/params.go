@@ -11,6 +11,7 @@ import (
 var (
 	KeyDenomCreationFee        = []byte("DenomCreationFee")
 	KeyDenomCreationGasConsume = []byte("DenomCreationGasConsume")
+	KeyBeforeSendHookGasLimit  = []byte("BeforeSendHookGasLimit")
 
 	// chosen as an arbitrary large number, less than the max_gas_wanted_per_tx in config.
 	DefaultCreationGasFee = 1_000_000
@@ -21,10 +22,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume uint64) Params {
+func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume uint64, beforeSendHookGasLimit uint64) Params {
 	return Params{
 		DenomCreationFee:        denomCreationFee,
 		DenomCreationGasConsume: denomCreationGasConsume,
+		BeforeSendHookGasLimit:  beforeSendHookGasLimit,
 	}
 }
 
@@ -34,6 +36,7 @@ func DefaultParams() Params {
 		// For choice, see: https://github.com/osmosis-labs/osmosis/pull/4983
 		DenomCreationFee:        sdk.NewCoins(), // used to be 10 OSMO at launch.
 		DenomCreationGasConsume: uint64(DefaultCreationGasFee),
+		BeforeSendHookGasLimit:  DefaultBeforeSendHookGasLimit,
 	}
 }
 
@@ -42,6 +45,9 @@ func (p Params) Validate() error {
 	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
 		return err
 	}
+	if err := validateBeforeSendHookGasLimit(p.BeforeSendHookGasLimit); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -51,6 +57,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
 		paramtypes.NewParamSetPair(KeyDenomCreationGasConsume, &p.DenomCreationGasConsume, validateDenomCreationGasConsume),
+		paramtypes.NewParamSetPair(KeyBeforeSendHookGasLimit, &p.BeforeSendHookGasLimit, validateBeforeSendHookGasLimit),
 	}
 }
 
@@ -75,3 +82,16 @@ func validateDenomCreationGasConsume(i interface{}) error {
 
 	return nil
 }
+
+func validateBeforeSendHookGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("before send hook gas limit must be positive")
+	}
+
+	return nil
+}
@@ -37,6 +37,9 @@ type Params struct {
 	//
 	// See: https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// BeforeSendHookGasLimit defines the gas limit of every call to the before
+	// send hook contract of a denom.
+	BeforeSendHookGasLimit uint64 `protobuf:"varint,3,opt,name=before_send_hook_gas_limit,json=beforeSendHookGasLimit,proto3" json:"before_send_hook_gas_limit,omitempty" yaml:"before_send_hook_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -86,6 +89,13 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetBeforeSendHookGasLimit() uint64 {
+	if m != nil {
+		return m.BeforeSendHookGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.tokenfactory.v1beta1.Params")
 }
@@ -95,30 +105,32 @@ func init() {
 }
 
 var fileDescriptor_cc8299d306f3ff47 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x6e, 0xea, 0x30,
-	0x14, 0x86, 0x63, 0xee, 0x15, 0x43, 0xee, 0x72, 0x15, 0x55, 0x2a, 0xa0, 0xca, 0xa1, 0x99, 0x60,
-	0x20, 0x16, 0xb4, 0x43, 0xd5, 0x11, 0xa4, 0x76, 0x42, 0xaa, 0x18, 0xbb, 0x44, 0x27, 0xc1, 0x84,
-	0x08, 0x92, 0x83, 0x62, 0x83, 0x9a, 0x47, 0xe8, 0xd6, 0xa9, 0x0f, 0xd1, 0x27, 0x61, 0x64, 0xec,
-	0x94, 0x56, 0xf0, 0x06, 0x3c, 0x41, 0x85, 0x63, 0x2a, 0x68, 0xab, 0x4e, 0xf6, 0xd1, 0xf9, 0xff,
-	0xcf, 0xff, 0xf1, 0x31, 0x9b, 0x28, 0x62, 0x14, 0x91, 0x60, 0x12, 0x27, 0x3c, 0x19, 0x41, 0x20,
-	0x31, 0xcd, 0xd8, 0xa2, 0xed, 0x73, 0x09, 0x6d, 0x36, 0x83, 0x14, 0x62, 0xe1, 0xce, 0x52, 0x94,
-	0x68, 0x9d, 0x69, 0xa9, 0x7b, 0x28, 0x75, 0xb5, 0xb4, 0x76, 0x12, 0x62, 0x88, 0x4a, 0xc8, 0x76,
-	0xb7, 0xc2, 0x53, 0xbb, 0xfc, 0x15, 0x0f, 0x73, 0x39, 0xc6, 0x34, 0x92, 0x59, 0x9f, 0x4b, 0x18,
-	0x82, 0x04, 0xed, 0xaa, 0x06, 0xca, 0xe6, 0x15, 0xb8, 0xa2, 0xd0, 0x2d, 0x5a, 0x54, 0xcc, 0x07,
-	0xc1, 0x3f, 0x39, 0x01, 0x46, 0x49, 0xd1, 0x77, 0x1e, 0x4b, 0x66, 0xf9, 0x4e, 0xa5, 0xb6, 0x9e,
-	0x89, 0x69, 0x0d, 0x79, 0x82, 0xb1, 0x17, 0xa4, 0x1c, 0x64, 0x84, 0x89, 0x37, 0xe2, 0xbc, 0x42,
-	0xea, 0x7f, 0x1a, 0xff, 0x3a, 0x55, 0x57, 0x63, 0x77, 0xa0, 0xfd, 0x10, 0x6e, 0x0f, 0xa3, 0xa4,
-	0xdb, 0x5f, 0xe6, 0xb6, 0xb1, 0xcd, 0xed, 0x6a, 0x06, 0xf1, 0xf4, 0xda, 0xf9, 0x8e, 0x70, 0x5e,
-	0xde, 0xec, 0x46, 0x18, 0xc9, 0xf1, 0xdc, 0x77, 0x03, 0x8c, 0x75, 0x40, 0x7d, 0xb4, 0xc4, 0x70,
-	0xc2, 0x64, 0x36, 0xe3, 0x42, 0xd1, 0xc4, 0xe0, 0xbf, 0x02, 0xf4, 0xb4, 0xff, 0x86, 0x73, 0x6b,
-	0x64, 0xd6, 0xbe, 0x40, 0x43, 0x10, 0x5e, 0x80, 0x89, 0x98, 0xc7, 0xbc, 0x52, 0xaa, 0x93, 0xc6,
-	0xdf, 0x6e, 0x73, 0x99, 0xdb, 0x64, 0x9b, 0xdb, 0xe7, 0x3f, 0x86, 0x38, 0xd0, 0x3b, 0x83, 0xd3,
-	0xa3, 0x07, 0x6e, 0x41, 0xf4, 0x8a, 0x4e, 0x77, 0xb0, 0x5c, 0x53, 0xb2, 0x5a, 0x53, 0xf2, 0xbe,
-	0xa6, 0xe4, 0x69, 0x43, 0x8d, 0xd5, 0x86, 0x1a, 0xaf, 0x1b, 0x6a, 0xdc, 0x5f, 0x1d, 0xa4, 0xd7,
-	0x1b, 0x6a, 0x4d, 0xc1, 0x17, 0xfb, 0x82, 0x2d, 0x3a, 0x6d, 0xf6, 0x70, 0xbc, 0x34, 0x35, 0x93,
-	0x5f, 0x56, 0xdf, 0x7c, 0xf1, 0x11, 0x00, 0x00, 0xff, 0xff, 0x67, 0x6c, 0x06, 0x7a, 0x38, 0x02,
-	0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xed, 0x3b, 0x74, 0x85, 0x69, 0x90, 0x85, 0x20, 0xb1, 0x90, 0x7d, 0x58, 0x42, 0xca,
+	0x15, 0xe7, 0x55, 0x0e, 0x0a, 0x44, 0x99, 0x48, 0x1c, 0x05, 0x27, 0x21, 0xd3, 0xd1, 0x58, 0x63,
+	0x7b, 0xec, 0xac, 0x1c, 0xef, 0x44, 0xde, 0xcd, 0x09, 0xbf, 0x05, 0x15, 0x0f, 0xc1, 0x93, 0xa4,
+	0xbc, 0x92, 0xca, 0xa0, 0xa4, 0xa5, 0xba, 0x27, 0x40, 0x59, 0x6f, 0x20, 0xe1, 0xcf, 0x55, 0xf6,
+	0xe8, 0xfb, 0xbe, 0xdf, 0x8c, 0xc7, 0xe3, 0x9c, 0x91, 0xac, 0x49, 0x72, 0xc9, 0x14, 0x55, 0x28,
+	0x0a, 0xc8, 0x14, 0x35, 0x2d, 0xbb, 0x1e, 0xa7, 0xa8, 0x60, 0xcc, 0x16, 0xd0, 0x40, 0x2d, 0xa3,
+	0x45, 0x43, 0x8a, 0xdc, 0x27, 0xc6, 0x1a, 0xed, 0x5b, 0x23, 0x63, 0xf5, 0x1e, 0x96, 0x54, 0x92,
+	0x36, 0xb2, 0xed, 0x5b, 0x9f, 0xf1, 0x5e, 0xdc, 0x89, 0x87, 0xa5, 0x9a, 0x51, 0xc3, 0x55, 0x7b,
+	0x85, 0x0a, 0x72, 0x50, 0x60, 0x52, 0xc3, 0x4c, 0xc7, 0x92, 0x1e, 0xd7, 0x17, 0x46, 0xf2, 0xfb,
+	0x8a, 0xa5, 0x20, 0xf1, 0x17, 0x27, 0x23, 0x2e, 0x7a, 0x3d, 0xfc, 0x71, 0xe4, 0x9c, 0xbc, 0xd3,
+	0x53, 0xbb, 0x9f, 0x6d, 0xc7, 0xcd, 0x51, 0x50, 0x9d, 0x64, 0x0d, 0x82, 0xe2, 0x24, 0x92, 0x02,
+	0x71, 0x60, 0x9f, 0x1e, 0x8f, 0xee, 0x5f, 0x0c, 0x23, 0x83, 0xdd, 0x82, 0x76, 0x1f, 0x11, 0x4d,
+	0x89, 0x8b, 0xc9, 0xd5, 0xaa, 0x0b, 0xac, 0xdb, 0x2e, 0x18, 0xb6, 0x50, 0xcf, 0x5f, 0x85, 0x7f,
+	0x23, 0xc2, 0x2f, 0xdf, 0x82, 0x51, 0xc9, 0xd5, 0x6c, 0x99, 0x46, 0x19, 0xd5, 0x66, 0x40, 0xf3,
+	0x38, 0x97, 0x79, 0xc5, 0x54, 0xbb, 0x40, 0xa9, 0x69, 0x32, 0x7e, 0xa0, 0x01, 0x53, 0x93, 0x7f,
+	0x8d, 0xe8, 0x16, 0x8e, 0xf7, 0x07, 0xb4, 0x04, 0x99, 0x64, 0x24, 0xe4, 0xb2, 0xc6, 0xc1, 0xd1,
+	0xa9, 0x3d, 0xba, 0x37, 0x39, 0x5b, 0x75, 0x81, 0x7d, 0xdb, 0x05, 0x4f, 0xff, 0x39, 0xc4, 0x9e,
+	0x3f, 0x8c, 0x1f, 0x1f, 0x34, 0xb8, 0x04, 0x39, 0xed, 0x15, 0x17, 0x1c, 0x2f, 0xc5, 0x82, 0x1a,
+	0x4c, 0x24, 0x8a, 0x3c, 0x99, 0x11, 0x55, 0x3a, 0x39, 0xe7, 0x35, 0x57, 0x83, 0x63, 0xdd, 0xe7,
+	0xd9, 0xef, 0x1e, 0xff, 0xf7, 0x86, 0xf1, 0xa3, 0x5e, 0x7c, 0x8f, 0x22, 0x7f, 0x43, 0x54, 0x5d,
+	0x82, 0x7c, 0xbb, 0x15, 0x26, 0xf1, 0x6a, 0xed, 0xdb, 0x37, 0x6b, 0xdf, 0xfe, 0xbe, 0xf6, 0xed,
+	0x4f, 0x1b, 0xdf, 0xba, 0xd9, 0xf8, 0xd6, 0xd7, 0x8d, 0x6f, 0x7d, 0x78, 0xb9, 0xb7, 0x20, 0x73,
+	0x04, 0xe7, 0x73, 0x48, 0xe5, 0xae, 0x60, 0xd7, 0x17, 0x63, 0xf6, 0xf1, 0xf0, 0x2e, 0xf4, 0xda,
+	0xd2, 0x13, 0xfd, 0x27, 0x9f, 0xff, 0x1c, 0x00, 0x81, 0x61, 0x15, 0x6a, 0x9b, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +153,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BeforeSendHookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BeforeSendHookGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -189,6 +206,9 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if m.BeforeSendHookGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.BeforeSendHookGasLimit))
+	}
 	return n
 }
 
@@ -280,6 +300,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookGasLimit", wireType)
+			}
+			m.BeforeSendHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -631,6 +631,9 @@ func (m *MsgSetDenomMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetDenomMetadataResponse proto.InternalMessageInfo
 
+// MsgForceTransfer is the sdk.Msg type for allowing an admin account to
+// transfer a token from one account to another.
+// Only the admin of the token factory denom has permission to force transfer.
 type MsgForceTransfer struct {
 	Sender              string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Amount              types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
@@ -757,63 +760,64 @@ func init() {
 }
 
 var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x36, 0x93, 0xd4, 0x75, 0x2e, 0x75, 0x25, 0xd1, 0x6e, 0xa2, 0x30, 0x0e, 0x99, 0x5e, 0x91,
-	0xc0, 0x2d, 0x2a, 0x12, 0x72, 0x8b, 0xa2, 0xd5, 0xd4, 0x28, 0x85, 0x91, 0xa1, 0x5a, 0x18, 0x4f,
-	0x45, 0x00, 0xe1, 0x28, 0x9d, 0x68, 0x42, 0xe1, 0x9d, 0xcb, 0x3b, 0x45, 0xf1, 0x56, 0xa0, 0x5b,
-	0xa7, 0x0e, 0xf9, 0x27, 0xba, 0xf5, 0x2f, 0xe8, 0x9c, 0x31, 0x40, 0x97, 0x4e, 0x84, 0x61, 0x03,
-	0xed, 0xce, 0xbf, 0xa0, 0xb8, 0x1f, 0xa4, 0x44, 0x4a, 0xb0, 0xa5, 0x21, 0xc8, 0x62, 0x98, 0x77,
-	0xdf, 0xf7, 0xee, 0x7d, 0xdf, 0xbd, 0xf7, 0x4e, 0xe0, 0x21, 0x65, 0x31, 0x65, 0x11, 0xf3, 0x38,
-	0x1d, 0x63, 0x32, 0x42, 0x03, 0x4e, 0x93, 0x53, 0xef, 0x65, 0x3b, 0xc0, 0x1c, 0xb5, 0x3d, 0xfe,
-	0xca, 0x3d, 0x49, 0x28, 0xa7, 0xe6, 0x9e, 0x86, 0xb9, 0xf3, 0x30, 0x57, 0xc3, 0xac, 0xdd, 0x90,
-	0x86, 0x54, 0x02, 0x3d, 0xf1, 0x9f, 0xe2, 0x58, 0x0d, 0x14, 0x47, 0x84, 0x7a, 0xf2, 0xaf, 0x5e,
-	0xb2, 0x07, 0x32, 0x8e, 0x17, 0x20, 0x86, 0x8b, 0x43, 0x06, 0x34, 0x22, 0x0b, 0xfb, 0x64, 0x5c,
-	0xec, 0x8b, 0x0f, 0xb5, 0x0f, 0x5f, 0x1b, 0xe0, 0xe3, 0x1e, 0x0b, 0x9f, 0x24, 0x18, 0x71, 0xfc,
-	0x03, 0x26, 0x34, 0x36, 0x3f, 0x07, 0x9b, 0x0c, 0x93, 0x21, 0x4e, 0x9a, 0xc6, 0x03, 0x63, 0xff,
-	0x66, 0xb7, 0x91, 0xa5, 0xce, 0xf6, 0x29, 0x8a, 0x5f, 0x74, 0xa0, 0x5a, 0x87, 0xbe, 0x06, 0x98,
-	0x1e, 0xd8, 0x62, 0x93, 0x60, 0x28, 0x68, 0xcd, 0x6b, 0x12, 0xbc, 0x93, 0xa5, 0x4e, 0x4d, 0x83,
-	0xf5, 0x0e, 0xf4, 0x0b, 0x50, 0xe7, 0xd1, 0x6f, 0xff, 0xfd, 0xf9, 0xc5, 0xa7, 0x4b, 0x1d, 0x1a,
-	0xc8, 0x14, 0x5a, 0x8a, 0xf2, 0x1c, 0xdc, 0x2e, 0x67, 0xe5, 0x63, 0x76, 0x42, 0x09, 0xc3, 0x66,
-	0x17, 0xd4, 0x08, 0x9e, 0xf6, 0x25, 0xb5, 0xaf, 0x4e, 0x56, 0x69, 0x5a, 0x59, 0xea, 0xdc, 0x56,
-	0x27, 0x57, 0x00, 0xd0, 0xdf, 0x26, 0x78, 0x7a, 0x24, 0x16, 0x64, 0x2c, 0x78, 0x66, 0x80, 0x0f,
-	0x7b, 0x2c, 0xec, 0x45, 0x84, 0xaf, 0xa3, 0xf6, 0x29, 0xd8, 0x44, 0x31, 0x9d, 0x10, 0x2e, 0xb5,
-	0xde, 0x3a, 0xb8, 0xeb, 0x2a, 0x73, 0x5d, 0x61, 0x7e, 0x7e, 0x75, 0xee, 0x13, 0x1a, 0x91, 0xee,
-	0x27, 0x6f, 0x52, 0x67, 0x63, 0x16, 0x49, 0xd1, 0xa0, 0xaf, 0xf9, 0xe6, 0xf7, 0x60, 0x3b, 0x8e,
-	0x08, 0x3f, 0xa2, 0x8f, 0x87, 0xc3, 0x04, 0x33, 0xd6, 0xbc, 0x5e, 0x95, 0x20, 0xb6, 0xfb, 0x9c,
-	0xf6, 0x91, 0x02, 0x40, 0xbf, 0x4c, 0xe8, 0xd8, 0xc2, 0xc8, 0xbb, 0x4b, 0x8d, 0x14, 0x40, 0xd8,
-	0x00, 0x35, 0xad, 0x30, 0x77, 0x0e, 0xfe, 0xab, 0x54, 0x77, 0x27, 0x09, 0x79, 0x3f, 0xaa, 0x0f,
-	0x41, 0x2d, 0x98, 0x24, 0xe4, 0x30, 0xa1, 0x71, 0x59, 0xf7, 0x5e, 0x96, 0x3a, 0x4d, 0xc5, 0x11,
-	0x80, 0xfe, 0x28, 0xa1, 0xf1, 0x4c, 0x79, 0x95, 0x74, 0x99, 0x76, 0x01, 0xd5, 0xda, 0x85, 0xce,
-	0x42, 0xfb, 0x5f, 0xba, 0xcc, 0x8f, 0x11, 0x09, 0xf1, 0xe3, 0x61, 0x1c, 0xad, 0x65, 0xc1, 0x23,
-	0xf0, 0xc1, 0x7c, 0x8d, 0xd7, 0xb3, 0xd4, 0xf9, 0x48, 0x21, 0x75, 0x7d, 0xa9, 0x6d, 0xb3, 0x0d,
-	0x6e, 0x8a, 0xd2, 0x43, 0x22, 0xbe, 0x96, 0xb6, 0x9b, 0xa5, 0x4e, 0x7d, 0x56, 0x95, 0x72, 0x0b,
-	0xfa, 0x5b, 0x04, 0x4f, 0x65, 0x16, 0x97, 0x36, 0x84, 0x4c, 0xb6, 0xa5, 0x28, 0x4d, 0xd5, 0x10,
-	0xb3, 0xfc, 0x0b, 0x69, 0x67, 0x06, 0xd8, 0xed, 0xb1, 0xf0, 0x19, 0xe6, 0x5d, 0x3c, 0xa2, 0x09,
-	0x7e, 0x86, 0xc9, 0xf0, 0x29, 0xa5, 0xe3, 0x77, 0x21, 0xf0, 0x10, 0xd4, 0xc5, 0xe5, 0x4f, 0x11,
-	0x2b, 0xee, 0x47, 0xeb, 0xbc, 0x97, 0xa5, 0xce, 0x1d, 0x45, 0xa9, 0x22, 0xa0, 0x5f, 0xcb, 0x97,
-	0xf2, 0x1b, 0x6c, 0x09, 0xd5, 0xfb, 0x4b, 0x55, 0x33, 0xcc, 0x5b, 0x81, 0x14, 0x22, 0x72, 0x6b,
-	0x1d, 0x53, 0x3a, 0x86, 0x36, 0xd8, 0x5b, 0xa6, 0xb0, 0xb0, 0xe0, 0xb5, 0x01, 0x76, 0x14, 0x40,
-	0xf6, 0x77, 0x0f, 0x73, 0x34, 0x44, 0x1c, 0xad, 0xe3, 0x80, 0x0f, 0xb6, 0x62, 0x4d, 0xd3, 0x75,
-	0x7e, 0x7f, 0x56, 0xe7, 0x64, 0x5c, 0xd4, 0x79, 0x1e, 0xbb, 0x7b, 0x47, 0xd7, 0xba, 0x1e, 0x76,
-	0x39, 0x19, 0xfa, 0x45, 0x1c, 0x78, 0x1f, 0xdc, 0x5b, 0x92, 0x55, 0x91, 0xf5, 0xdf, 0xd7, 0x40,
-	0xbd, 0xc7, 0xc2, 0x43, 0x9a, 0x0c, 0xf0, 0x51, 0x82, 0x08, 0x1b, 0xe1, 0xe4, 0xfd, 0x34, 0xa6,
-	0x0f, 0x76, 0xb8, 0x4e, 0x60, 0xb1, 0x39, 0x1f, 0x64, 0xa9, 0xb3, 0xa7, 0x78, 0x39, 0xa8, 0xd2,
-	0xa0, 0xcb, 0xc8, 0xe6, 0x8f, 0xa0, 0x91, 0x2f, 0xcf, 0xc6, 0xdc, 0x0d, 0x19, 0xd1, 0xce, 0x52,
-	0xc7, 0xaa, 0x44, 0x9c, 0x1f, 0x75, 0x8b, 0xc4, 0xce, 0xbe, 0x28, 0x98, 0xcf, 0x96, 0x16, 0xcc,
-	0x48, 0xf8, 0xd7, 0xca, 0x29, 0xd0, 0x02, 0xcd, 0xaa, 0xa9, 0xb9, 0xe3, 0x07, 0x7f, 0x6c, 0x82,
-	0xeb, 0x3d, 0x16, 0x9a, 0x3f, 0x83, 0x5b, 0xf3, 0x0f, 0xde, 0x97, 0xee, 0x65, 0x6f, 0xb1, 0x5b,
-	0x7e, 0x88, 0xac, 0xaf, 0xd7, 0x41, 0x17, 0xcf, 0xd6, 0x73, 0x70, 0x43, 0x3e, 0x37, 0x0f, 0xaf,
-	0x64, 0x0b, 0x98, 0xd5, 0x5a, 0x09, 0x36, 0x1f, 0x5d, 0x8e, 0xf5, 0xab, 0xa3, 0x0b, 0xd8, 0x0a,
-	0xd1, 0xe7, 0x87, 0xa7, 0xb4, 0x6b, 0x6e, 0x70, 0xae, 0x60, 0xd7, 0x0c, 0xbd, 0x8a, 0x5d, 0x8b,
-	0x43, 0xcd, 0xfc, 0xc5, 0x00, 0xf5, 0x85, 0x76, 0x6e, 0x5f, 0x19, 0xaa, 0x4a, 0xb1, 0xbe, 0x5b,
-	0x9b, 0x52, 0xa4, 0xf0, 0xab, 0x01, 0x1a, 0x8b, 0x43, 0xf5, 0x60, 0x95, 0x80, 0x65, 0x8e, 0xd5,
-	0x59, 0x9f, 0x53, 0x64, 0x31, 0x05, 0xdb, 0xe5, 0x01, 0xe1, 0x5e, 0x19, 0xac, 0x84, 0xb7, 0xbe,
-	0x59, 0x0f, 0x9f, 0x1f, 0xdc, 0xf5, 0xdf, 0x9c, 0xdb, 0xc6, 0xdb, 0x73, 0xdb, 0x38, 0x3b, 0xb7,
-	0x8d, 0xdf, 0x2f, 0xec, 0x8d, 0xb7, 0x17, 0xf6, 0xc6, 0x3f, 0x17, 0xf6, 0xc6, 0x4f, 0xdf, 0x86,
-	0x11, 0x3f, 0x9e, 0x04, 0xee, 0x80, 0xc6, 0x9e, 0x8e, 0xdd, 0x7a, 0x81, 0x02, 0x96, 0x7f, 0x78,
-	0x2f, 0x0f, 0xda, 0xde, 0xab, 0x72, 0x93, 0xf2, 0xd3, 0x13, 0xcc, 0x82, 0x4d, 0xf9, 0x9b, 0xf3,
-	0xab, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x99, 0x19, 0x55, 0x23, 0x0b, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x3f, 0x6f, 0xdb, 0x46,
+	0x1c, 0x35, 0x93, 0xd4, 0x75, 0x2e, 0x75, 0xf5, 0xc7, 0x6e, 0xa2, 0x30, 0x0e, 0x99, 0xb2, 0x48,
+	0xea, 0x16, 0x21, 0x09, 0xb9, 0x41, 0xd1, 0x6a, 0x6a, 0x94, 0xc2, 0xc8, 0x50, 0x2d, 0x8c, 0xa7,
+	0x22, 0x80, 0x70, 0x92, 0x4e, 0x34, 0xa1, 0xf0, 0xce, 0xe5, 0x9d, 0xa2, 0x78, 0x2b, 0xd0, 0xad,
+	0x53, 0x87, 0x7e, 0x89, 0x6e, 0xfd, 0x04, 0x1d, 0x3a, 0x65, 0x0c, 0xd0, 0xa5, 0x13, 0x61, 0xd8,
+	0x40, 0xbb, 0xf3, 0x13, 0x14, 0xf7, 0x87, 0x27, 0x91, 0x52, 0x6d, 0x73, 0x08, 0xb2, 0x18, 0xe6,
+	0xdd, 0x7b, 0xef, 0x7e, 0xef, 0xdd, 0xef, 0xee, 0x04, 0xee, 0x13, 0x1a, 0x13, 0x1a, 0x51, 0x9f,
+	0x91, 0x09, 0xc2, 0x63, 0x38, 0x64, 0x24, 0x39, 0xf6, 0x5f, 0xb6, 0x07, 0x88, 0xc1, 0xb6, 0xcf,
+	0x5e, 0x79, 0x47, 0x09, 0x61, 0xa4, 0xb9, 0xa3, 0x60, 0xde, 0x22, 0xcc, 0x53, 0x30, 0x73, 0x3b,
+	0x24, 0x21, 0x11, 0x40, 0x9f, 0xff, 0x27, 0x39, 0x66, 0x03, 0xc6, 0x11, 0x26, 0xbe, 0xf8, 0xab,
+	0x86, 0xac, 0xa1, 0xd0, 0xf1, 0x07, 0x90, 0x22, 0xbd, 0xc8, 0x90, 0x44, 0x78, 0x69, 0x1e, 0x4f,
+	0xf4, 0x3c, 0xff, 0x90, 0xf3, 0xce, 0xaf, 0x06, 0xf8, 0xb0, 0x47, 0xc3, 0x27, 0x09, 0x82, 0x0c,
+	0x7d, 0x8b, 0x30, 0x89, 0x9b, 0x9f, 0x81, 0x75, 0x8a, 0xf0, 0x08, 0x25, 0x2d, 0xe3, 0x9e, 0xb1,
+	0x7b, 0xbd, 0xdb, 0xc8, 0x52, 0x7b, 0xf3, 0x18, 0xc6, 0x2f, 0x3a, 0x8e, 0x1c, 0x77, 0x02, 0x05,
+	0x68, 0xfa, 0x60, 0x83, 0x4e, 0x07, 0x23, 0x4e, 0x6b, 0x5d, 0x11, 0xe0, 0xad, 0x2c, 0xb5, 0x6b,
+	0x0a, 0xac, 0x66, 0x9c, 0x40, 0x83, 0x3a, 0x0f, 0x7e, 0xfe, 0xf7, 0xf7, 0xcf, 0x3f, 0x5e, 0x99,
+	0xd0, 0x50, 0x94, 0xe0, 0x4a, 0xca, 0x73, 0x70, 0xb3, 0x58, 0x55, 0x80, 0xe8, 0x11, 0xc1, 0x14,
+	0x35, 0xbb, 0xa0, 0x86, 0xd1, 0xac, 0x2f, 0xa8, 0x7d, 0xb9, 0xb2, 0x2c, 0xd3, 0xcc, 0x52, 0xfb,
+	0xa6, 0x5c, 0xb9, 0x04, 0x70, 0x82, 0x4d, 0x8c, 0x66, 0x07, 0x7c, 0x40, 0x68, 0x39, 0x27, 0x06,
+	0x78, 0xbf, 0x47, 0xc3, 0x5e, 0x84, 0x59, 0x15, 0xb7, 0x4f, 0xc1, 0x3a, 0x8c, 0xc9, 0x14, 0x33,
+	0xe1, 0xf5, 0xc6, 0xde, 0x6d, 0x4f, 0x86, 0xeb, 0xf1, 0xf0, 0xf3, 0xad, 0xf3, 0x9e, 0x90, 0x08,
+	0x77, 0x3f, 0x7a, 0x9d, 0xda, 0x6b, 0x73, 0x25, 0x49, 0x73, 0x02, 0xc5, 0x6f, 0x7e, 0x03, 0x36,
+	0xe3, 0x08, 0xb3, 0x03, 0xf2, 0x78, 0x34, 0x4a, 0x10, 0xa5, 0xad, 0xab, 0x65, 0x0b, 0x7c, 0xba,
+	0xcf, 0x48, 0x1f, 0x4a, 0x80, 0x13, 0x14, 0x09, 0x1d, 0x8b, 0x07, 0x79, 0x7b, 0x65, 0x90, 0x1c,
+	0xe8, 0x34, 0x40, 0x4d, 0x39, 0xcc, 0x93, 0x73, 0xfe, 0x91, 0xae, 0xbb, 0xd3, 0x04, 0xbf, 0x1b,
+	0xd7, 0xfb, 0xa0, 0x36, 0x98, 0x26, 0x78, 0x3f, 0x21, 0x71, 0xd1, 0xf7, 0x4e, 0x96, 0xda, 0x2d,
+	0xc9, 0xe1, 0x80, 0xfe, 0x38, 0x21, 0xf1, 0xdc, 0x79, 0x99, 0x74, 0x9e, 0x77, 0x0e, 0x55, 0xde,
+	0xb9, 0x4f, 0xed, 0xfd, 0x0f, 0xd5, 0xe6, 0x87, 0x10, 0x87, 0xe8, 0xf1, 0x28, 0x8e, 0x2a, 0x45,
+	0xf0, 0x00, 0xbc, 0xb7, 0xd8, 0xe3, 0xf5, 0x2c, 0xb5, 0x3f, 0x90, 0x48, 0xd5, 0x5f, 0x72, 0xba,
+	0xd9, 0x06, 0xd7, 0x79, 0xeb, 0x41, 0xae, 0xaf, 0xac, 0x6d, 0x67, 0xa9, 0x5d, 0x9f, 0x77, 0xa5,
+	0x98, 0x72, 0x82, 0x0d, 0x8c, 0x66, 0xa2, 0x8a, 0x73, 0x0f, 0x84, 0x28, 0xd6, 0x95, 0x94, 0x96,
+	0x3c, 0x10, 0xf3, 0xfa, 0xb5, 0xb5, 0x13, 0x03, 0x6c, 0xf7, 0x68, 0xf8, 0x0c, 0xb1, 0x2e, 0x1a,
+	0x93, 0x04, 0x3d, 0x43, 0x78, 0xf4, 0x94, 0x90, 0xc9, 0xdb, 0x30, 0xb8, 0x0f, 0xea, 0x7c, 0xf3,
+	0x67, 0x90, 0xea, 0xfd, 0x51, 0x3e, 0xef, 0x64, 0xa9, 0x7d, 0x4b, 0x52, 0xca, 0x08, 0x27, 0xa8,
+	0xe5, 0x43, 0xf9, 0x0e, 0xba, 0xdc, 0xf5, 0xee, 0x4a, 0xd7, 0x14, 0x31, 0x77, 0x20, 0x8c, 0xf0,
+	0xda, 0xdc, 0x43, 0x42, 0x26, 0x8e, 0x05, 0x76, 0x56, 0x39, 0xd4, 0x11, 0xfc, 0x69, 0x80, 0x2d,
+	0x09, 0x10, 0xe7, 0xbb, 0x87, 0x18, 0x1c, 0x41, 0x06, 0xab, 0x24, 0x10, 0x80, 0x8d, 0x58, 0xd1,
+	0x54, 0x9f, 0xdf, 0x9d, 0xf7, 0x39, 0x9e, 0xe8, 0x3e, 0xcf, 0xb5, 0xbb, 0xb7, 0x54, 0xaf, 0xab,
+	0xcb, 0x2e, 0x27, 0x3b, 0x81, 0xd6, 0xe9, 0x3c, 0xe4, 0x2e, 0x3f, 0xfd, 0x5f, 0x97, 0x22, 0x52,
+	0x57, 0x13, 0xef, 0x82, 0x3b, 0x2b, 0x3c, 0x68, 0x8f, 0x7f, 0x5d, 0x01, 0xf5, 0x1e, 0x0d, 0xf7,
+	0x49, 0x32, 0x44, 0x07, 0x09, 0xc4, 0x74, 0x8c, 0x92, 0x77, 0x73, 0x8c, 0x03, 0xb0, 0xc5, 0x54,
+	0x01, 0xcb, 0x47, 0xf9, 0x5e, 0x96, 0xda, 0x3b, 0x92, 0x97, 0x83, 0x4a, 0xc7, 0x79, 0x15, 0xb9,
+	0xf9, 0x1d, 0x68, 0xe4, 0xc3, 0xf3, 0x4b, 0xf1, 0x9a, 0x50, 0xb4, 0xb2, 0xd4, 0x36, 0x4b, 0x8a,
+	0x8b, 0x17, 0xe3, 0x32, 0xb1, 0xb3, 0xcb, 0x83, 0xff, 0x64, 0x65, 0xf0, 0x63, 0x9e, 0x9f, 0x9b,
+	0x53, 0x1c, 0x13, 0xb4, 0xca, 0xa1, 0xe6, 0x89, 0xef, 0xfd, 0xb6, 0x0e, 0xae, 0xf6, 0x68, 0xd8,
+	0xfc, 0x01, 0xdc, 0x58, 0x7c, 0x1e, 0x1f, 0x7a, 0xe7, 0xbd, 0xdc, 0x5e, 0xf1, 0xd9, 0x32, 0x1f,
+	0x55, 0x41, 0xeb, 0x47, 0xee, 0x39, 0xb8, 0x26, 0x1e, 0xa7, 0xfb, 0x17, 0xb2, 0x39, 0xcc, 0x74,
+	0x2f, 0x05, 0x5b, 0x54, 0x17, 0x8f, 0xc0, 0xc5, 0xea, 0x1c, 0x66, 0xba, 0x97, 0x82, 0x69, 0x75,
+	0x1e, 0xd7, 0xc2, 0x35, 0x7b, 0x89, 0xb8, 0xe6, 0x68, 0xf3, 0x51, 0x15, 0xb4, 0x5e, 0xf2, 0x47,
+	0x03, 0xd4, 0x97, 0x0e, 0x7f, 0xfb, 0x42, 0xa9, 0x32, 0xc5, 0xfc, 0xba, 0x32, 0x45, 0x97, 0xf0,
+	0x93, 0x01, 0x1a, 0xcb, 0x57, 0xf0, 0xde, 0x65, 0x04, 0x8b, 0x1c, 0xb3, 0x53, 0x9d, 0xa3, 0xab,
+	0x98, 0x81, 0xcd, 0xe2, 0x05, 0xe1, 0x5d, 0x28, 0x56, 0xc0, 0x9b, 0x5f, 0x56, 0xc3, 0xe7, 0x0b,
+	0x77, 0x83, 0xd7, 0xa7, 0x96, 0xf1, 0xe6, 0xd4, 0x32, 0x4e, 0x4e, 0x2d, 0xe3, 0x97, 0x33, 0x6b,
+	0xed, 0xcd, 0x99, 0xb5, 0xf6, 0xf7, 0x99, 0xb5, 0xf6, 0xfd, 0x57, 0x61, 0xc4, 0x0e, 0xa7, 0x03,
+	0x6f, 0x48, 0x62, 0x5f, 0x69, 0xbb, 0x2f, 0xe0, 0x80, 0xe6, 0x1f, 0xfe, 0xcb, 0xbd, 0xb6, 0xff,
+	0xaa, 0x78, 0x48, 0xd9, 0xf1, 0x11, 0xa2, 0x83, 0x75, 0xf1, 0x0b, 0xf5, 0x8b, 0xff, 0x06, 0x00,
+	0x89, 0xd0, 0x1f, 0x34, 0x51, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
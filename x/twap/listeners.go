@@ -6,6 +6,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v21/x/twap/types"
 	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -31,6 +32,11 @@ func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, ep
 	return nil
 }
 
+// GetModuleName implements EpochHooks.
+func (hook *epochhook) GetModuleName() string {
+	return types.ModuleName
+}
+
 func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return nil
 }
@@ -61,6 +67,15 @@ func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, pool
 	hook.k.trackChangedPool(ctx, poolId)
 }
 
+// AfterCFMMPoolDestroyed is called after a CFMM pool is destroyed in x/gamm.
+// The twap records of a destroyed pool can no longer be updated, so they are pruned.
+func (hook *gammhook) AfterCFMMPoolDestroyed(ctx sdk.Context, poolId uint64) {
+	hook.k.untrackChangedPool(ctx, poolId)
+	if err := hook.k.pruneRecordsForPool(ctx, poolId); err != nil {
+		ctx.Logger().Error("Error pruning twaps of destroyed pool", "pool_id", poolId, "error", err)
+	}
+}
+
 type concentratedLiquidityListener struct {
 	k Keeper
 }
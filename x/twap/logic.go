@@ -38,6 +38,8 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 // returns spot prices for both pairs of assets, and the 'latest error time'.
 // The latest error time is the previous time if there is no error in getting spot prices.
 // if there is an error in getting spot prices, then the latest error time is ctx.Blocktime()
+// Panics in getting spot prices are recovered and treated as errors, so that a single
+// faulty pool can neither halt the chain in end block nor silently keep stale spot prices.
 func getSpotPrices(
 	ctx sdk.Context,
 	k types.PoolManagerInterface,
@@ -47,9 +49,9 @@ func getSpotPrices(
 ) (sp0 osmomath.Dec, sp1 osmomath.Dec, latestErrTime time.Time) {
 	latestErrTime = previousErrorTime
 	// sp0 = denom0 quote, denom1 base.
-	sp0BigDec, err0 := k.RouteCalculateSpotPrice(ctx, poolId, denom0, denom1)
+	sp0BigDec, err0 := routeCalculateSpotPriceNoPanic(ctx, k, poolId, denom0, denom1)
 	// sp1 = denom0 base, denom1 quote.
-	sp1BigDec, err1 := k.RouteCalculateSpotPrice(ctx, poolId, denom1, denom0)
+	sp1BigDec, err1 := routeCalculateSpotPriceNoPanic(ctx, k, poolId, denom1, denom0)
 
 	if err0 != nil || err1 != nil {
 		latestErrTime = ctx.BlockTime()
@@ -75,6 +77,17 @@ func getSpotPrices(
 	return sp0BigDec.Dec(), sp1BigDec.Dec(), latestErrTime
 }
 
+// routeCalculateSpotPriceNoPanic is a wrapper around RouteCalculateSpotPrice that returns an error
+// instead of panicking.
+func routeCalculateSpotPriceNoPanic(ctx sdk.Context, k types.PoolManagerInterface, poolId uint64, quoteDenom, baseDenom string) (sp osmomath.BigDec, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			sp, err = osmomath.BigDec{}, fmt.Errorf("twap: spot price computation of pool %d panicked: %v", poolId, r)
+		}
+	}()
+	return k.RouteCalculateSpotPrice(ctx, poolId, quoteDenom, baseDenom)
+}
+
 // mustTrackCreatedPool is a wrapper around afterCreatePool that panics on error.
 func (k Keeper) mustTrackCreatedPool(ctx sdk.Context, poolId uint64) {
 	err := k.afterCreatePool(ctx, poolId)
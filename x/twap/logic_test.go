@@ -46,6 +46,7 @@ func (s *TestSuite) TestGetSpotPrices() {
 		mockSp1               osmomath.Dec
 		mockSp0Err            error
 		mockSp1Err            error
+		mockSp0Panic          bool
 		expectedSp0           osmomath.Dec
 		expectedSp1           osmomath.Dec
 		expectedLatestErrTime time.Time
@@ -60,6 +61,15 @@ func (s *TestSuite) TestGetSpotPrices() {
 			expectedSp1:           osmomath.ZeroDec(),
 			expectedLatestErrTime: ctx.BlockTime(),
 		},
+		"spot price panics": {
+			poolID:                poolID,
+			prevErrTime:           currTime,
+			mockSp1:               osmomath.NewDecWithPrec(6, 1),
+			mockSp0Panic:          true,
+			expectedSp0:           osmomath.ZeroDec(),
+			expectedSp1:           osmomath.NewDecWithPrec(6, 1),
+			expectedLatestErrTime: ctx.BlockTime(),
+		},
 		"exceeds max spot price": {
 			poolID:                poolID,
 			prevErrTime:           currTime,
@@ -84,6 +94,9 @@ func (s *TestSuite) TestGetSpotPrices() {
 		s.Run(name, func() {
 			mockAMMI.ProgramPoolSpotPriceOverride(tc.poolID, denom0, denom1, tc.mockSp0, tc.mockSp0Err)
 			mockAMMI.ProgramPoolSpotPriceOverride(tc.poolID, denom1, denom0, tc.mockSp1, tc.mockSp1Err)
+			if tc.mockSp0Panic {
+				mockAMMI.ProgramPoolSpotPricePanic(tc.poolID, denom0, denom1)
+			}
 
 			sp0, sp1, latestErrTime := twap.GetSpotPrices(ctx, mockAMMI, tc.poolID, denom0, denom1, tc.prevErrTime)
 			s.Require().Equal(tc.expectedSp0, sp0)
@@ -34,6 +34,16 @@ func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
 	store.Set(poolIdBz, sentinelExistsValue)
 }
 
+// untrackChangedPool removes the pool from the pools changed this block,
+// so that no new TWAP record is created for it in EndBlock.
+func (k Keeper) untrackChangedPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	poolIdBz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(poolIdBz, poolId)
+
+	store.Delete(poolIdBz)
+}
+
 // getChangedPools returns all poolIDs that changed this block.
 // This is to be guaranteed by trackChangedPool being called on every
 // price-affecting pool action.
@@ -115,6 +125,28 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 	return nil
 }
 
+// pruneRecordsForPool deletes the most recent and all the historical records of the given pool.
+func (k Keeper) pruneRecordsForPool(ctx sdk.Context, poolId uint64) error {
+	mostRecentRecords, err := k.GetAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	for _, record := range mostRecentRecords {
+		k.DeleteMostRecentRecord(ctx, record)
+	}
+
+	// The pool id is followed by the separator, so that the records of e.g. pool 10 are not matched for pool 1.
+	poolPrefix := append(types.FormatKeyPoolTwapRecords(poolId), []byte(types.KeySeparator)...)
+	historicalRecords, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), poolPrefix, types.ParseTwapFromBz)
+	if err != nil {
+		return err
+	}
+	for _, record := range historicalRecords {
+		k.DeleteHistoricalRecord(ctx, record)
+	}
+	return nil
+}
+
 func (k Keeper) DeleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
 	key1 := types.FormatHistoricalTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
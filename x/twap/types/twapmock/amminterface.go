@@ -22,8 +22,9 @@ type SpotPriceInput struct {
 	quoteDenom string
 }
 type SpotPriceResult struct {
-	Sp  osmomath.Dec
-	Err error
+	Sp    osmomath.Dec
+	Err   error
+	Panic bool
 }
 
 type poolDenomsResult struct {
@@ -56,7 +57,13 @@ func (p *ProgrammedPoolManagerInterface) ProgramPoolSpotPriceOverride(poolId uin
 	quoteDenom, baseDenom string, overrideSp osmomath.Dec, overrideErr error,
 ) {
 	input := SpotPriceInput{poolId, baseDenom, quoteDenom}
-	p.programmedSpotPrice[input] = SpotPriceResult{overrideSp, overrideErr}
+	p.programmedSpotPrice[input] = SpotPriceResult{Sp: overrideSp, Err: overrideErr}
+}
+
+// ProgramPoolSpotPricePanic programs the spot price computation of the given pool and denoms to panic.
+func (p *ProgrammedPoolManagerInterface) ProgramPoolSpotPricePanic(poolId uint64, quoteDenom, baseDenom string) {
+	input := SpotPriceInput{poolId, baseDenom, quoteDenom}
+	p.programmedSpotPrice[input] = SpotPriceResult{Panic: true}
 }
 
 func (p *ProgrammedPoolManagerInterface) RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) (denoms []string, err error) {
@@ -77,6 +84,9 @@ func (p *ProgrammedPoolManagerInterface) RouteCalculateSpotPrice(ctx sdk.Context
 ) (price osmomath.BigDec, err error) {
 	input := SpotPriceInput{poolId, baseDenom, quoteDenom}
 	if res, ok := p.programmedSpotPrice[input]; ok {
+		if res.Panic {
+			panic("programmed spot price panic")
+		}
 		if (res.Sp == osmomath.Dec{}) {
 			return osmomath.BigDec{}, res.Err
 		}
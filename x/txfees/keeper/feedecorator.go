@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"path/filepath"
 
 	errorsmod "cosmossdk.io/errors"
@@ -16,6 +17,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// priorityGasUnit is the amount of gas per which the fee of a tx is measured for its mempool priority,
+// so that the gas prices below 1 base denom per gas still order the txs.
+const priorityGasUnit = 1_000_000
+
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
 // as the local validator's minimum gasFee (defined in validator config).
 // If fee is too low, decorator returns error and tx is rejected from mempool.
@@ -47,6 +52,16 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
+	// Ensure that the provided gas does not exceed the governance controlled maximum gas per tx.
+	// Unlike the local mempool limit below, this is a consensus rule, so it is also ran on deliver tx.
+	if !simulate {
+		maxGasWantedPerTx := mfd.TxFeesKeeper.GetParams(ctx).MaxGasWantedPerTx
+		if feeTx.GetGas() > maxGasWantedPerTx {
+			msg := "Too much gas wanted: %d, maximum allowed by params is %d"
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, msg, feeTx.GetGas(), maxGasWantedPerTx)
+		}
+	}
+
 	// Ensure that the provided gas is less than the maximum gas per tx,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
@@ -92,6 +107,15 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 	}
 
+	// Set the priority of the tx in the mempool, only used on CheckTx by the priority mempool.
+	if ctx.IsCheckTx() && !simulate {
+		priority, err := mfd.TxFeesKeeper.GetTxPriority(ctx, feeTx)
+		if err != nil {
+			return ctx, err
+		}
+		ctx = ctx.WithPriority(priority)
+	}
+
 	// Determine if these fees are sufficient for the tx to pass.
 	// Once ABCI++ Process Proposal lands, we can have block validity conditions enforce this.
 	minBaseGasPrice := mfd.getMinBaseGasPrice(ctx, baseDenom, simulate, feeTx)
@@ -157,6 +181,55 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice osmomath.Dec, g
 	return nil
 }
 
+// GetTxPriority returns the mempool priority of the tx: the fee it pays per million gas, in the base denom,
+// plus the priority boost of its message types. The boost only applies if all the messages of the tx have
+// a boosted type, and never to arbitrage txs, so that boosted messages cannot prioritize arbitrary txs.
+func (k Keeper) GetTxPriority(ctx sdk.Context, tx sdk.FeeTx) (int64, error) {
+	priority := osmomath.ZeroInt()
+	feeCoins := tx.GetFee()
+	if len(feeCoins) == 1 && tx.GetGas() > 0 {
+		convertedFee, err := k.ConvertToBaseToken(ctx, feeCoins[0])
+		if err != nil {
+			return 0, err
+		}
+		priority = convertedFee.Amount.MulRaw(priorityGasUnit).Quo(osmomath.NewIntFromUint64(tx.GetGas()))
+	}
+
+	if !txfee_filters.IsArbTxLoose(tx) {
+		priority = priority.Add(osmomath.NewInt(k.getMsgsPriorityBoost(ctx, tx.GetMsgs())))
+	}
+
+	if !priority.IsInt64() {
+		return math.MaxInt64, nil
+	}
+	return priority.Int64(), nil
+}
+
+// getMsgsPriorityBoost returns the smallest priority boost of the given messages' types,
+// which is zero if any of them has no boost.
+func (k Keeper) getMsgsPriorityBoost(ctx sdk.Context, msgs []sdk.Msg) int64 {
+	if len(msgs) == 0 {
+		return 0
+	}
+
+	boosts := make(map[string]int64)
+	for _, boost := range k.GetParams(ctx).MsgTypePriorityBoosts {
+		boosts[boost.MsgTypeUrl] = boost.PriorityBoost
+	}
+
+	minBoost := int64(math.MaxInt64)
+	for _, msg := range msgs {
+		boost, ok := boosts[sdk.MsgTypeURL(msg)]
+		if !ok {
+			return 0
+		}
+		if boost < minBoost {
+			minBoost = boost
+		}
+	}
+	return minBoost
+}
+
 func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) osmomath.Dec {
 	var is1559enabled = mfd.Opts.Mempool1559Enabled
 
@@ -4,8 +4,13 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/keeper"
 	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 )
 
@@ -29,7 +34,9 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 		gasRequested uint64       // if blank, set to base gas
 		isCheckTx    bool
 		isSimulate   bool // if blank, is false
-		expectPass   bool
+		// if blank, the default max gas wanted per tx param is used
+		maxGasWantedPerTxParam uint64
+		expectPass             bool
 	}
 
 	tests := []testcase{}
@@ -89,6 +96,24 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 				isCheckTx:    isCheckTx == 1,
 				expectPass:   isCheckTx != 1,
 			},
+			{
+				name:                   fmt.Sprintf("tx with gas wanted equal to the maximum allowed by params - %s", txType[isCheckTx]),
+				txFee:                  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_000_000)),
+				minGasPrices:           point1BaseDenomMinGasPrices,
+				gasRequested:           types.DefaultMaxGasWantedPerTx / 2,
+				maxGasWantedPerTxParam: types.DefaultMaxGasWantedPerTx / 2,
+				isCheckTx:              isCheckTx == 1,
+				expectPass:             true,
+			},
+			{
+				name:                   fmt.Sprintf("tx with gas wanted more than allowed by params - %s", txType[isCheckTx]),
+				txFee:                  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_000_000)),
+				minGasPrices:           point1BaseDenomMinGasPrices,
+				gasRequested:           types.DefaultMaxGasWantedPerTx / 2,
+				maxGasWantedPerTxParam: types.DefaultMaxGasWantedPerTx/2 - 1,
+				isCheckTx:              isCheckTx == 1,
+				expectPass:             false,
+			},
 			{
 				name:       "invalid fee denom",
 				txFee:      sdk.NewCoins(sdk.NewInt64Coin("moooooo", 1000)),
@@ -146,6 +171,11 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 		// reset pool and accounts for each test
 		s.SetupTest(false)
 		s.Run(tc.name, func() {
+			if tc.maxGasWantedPerTxParam != 0 {
+				params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+				params.MaxGasWantedPerTx = tc.maxGasWantedPerTxParam
+				s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+			}
 			preFeeDecoratorTxFeeTrackerValue := s.App.TxFeesKeeper.GetTxFeesTrackerValue(s.Ctx)
 			err := s.SetupTxFeeAnteHandlerAndChargeFee(s.clientCtx, tc.minGasPrices, tc.gasRequested, tc.isCheckTx, tc.isSimulate, tc.txFee)
 			if tc.expectPass {
@@ -166,3 +196,107 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetTxPriority() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+	recvPacketMsg := &channeltypes.MsgRecvPacket{}
+	updateClientMsg := &clienttypes.MsgUpdateClient{}
+	sendMsg := &banktypes.MsgSend{}
+	arbSwapMsg := &poolmanagertypes.MsgSwapExactAmountIn{
+		Routes:  []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: baseDenom}},
+		TokenIn: sdk.NewInt64Coin(baseDenom, 10),
+	}
+
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MsgTypePriorityBoosts = []types.MsgTypePriorityBoost{
+		{MsgTypeUrl: sdk.MsgTypeURL(recvPacketMsg), PriorityBoost: 1_000_000},
+		{MsgTypeUrl: sdk.MsgTypeURL(updateClientMsg), PriorityBoost: 500_000},
+		{MsgTypeUrl: sdk.MsgTypeURL(arbSwapMsg), PriorityBoost: 1_000_000},
+	}
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+	tests := map[string]struct {
+		msgs             []sdk.Msg
+		txFee            sdk.Coins
+		gas              uint64
+		expectedPriority int64
+	}{
+		"no boost is the fee per million gas": {
+			msgs:             []sdk.Msg{sendMsg},
+			txFee:            sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_500)),
+			gas:              1_000_000,
+			expectedPriority: 2_500,
+		},
+		"no fee": {
+			msgs:             []sdk.Msg{sendMsg},
+			gas:              1_000_000,
+			expectedPriority: 0,
+		},
+		"boosted message": {
+			msgs:             []sdk.Msg{recvPacketMsg},
+			txFee:            sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_500)),
+			gas:              1_000_000,
+			expectedPriority: 1_002_500,
+		},
+		"smallest boost of the messages applies": {
+			msgs:             []sdk.Msg{updateClientMsg, recvPacketMsg},
+			txFee:            sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_500)),
+			gas:              1_000_000,
+			expectedPriority: 502_500,
+		},
+		"no boost if a message is not boosted": {
+			msgs:             []sdk.Msg{recvPacketMsg, sendMsg},
+			txFee:            sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_500)),
+			gas:              1_000_000,
+			expectedPriority: 2_500,
+		},
+		"no boost for arbitrage txs": {
+			msgs:             []sdk.Msg{arbSwapMsg},
+			txFee:            sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_500)),
+			gas:              1_000_000,
+			expectedPriority: 2_500,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetFeeAmount(tc.txFee)
+			txBuilder.SetGasLimit(tc.gas)
+
+			priority, err := s.App.TxFeesKeeper.GetTxPriority(s.Ctx, txBuilder.GetTx())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedPriority, priority)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFeeDecoratorSetsPriority() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(&channeltypes.MsgRecvPacket{}))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 2_500)))
+	txBuilder.SetGasLimit(1_000_000)
+
+	mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, types.NewDefaultMempoolFeeOptions())
+	for _, isCheckTx := range []bool{true, false} {
+		var priority int64
+		_, err := mfd.AnteHandle(s.Ctx.WithIsCheckTx(isCheckTx), txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			priority = ctx.Priority()
+			return ctx, nil
+		})
+		s.Require().NoError(err)
+
+		// The priority is only set on CheckTx, with the default boost of IBC relaying.
+		if isCheckTx {
+			s.Require().Equal(types.DefaultIBCRelayPriorityBoost+2_500, priority)
+		} else {
+			s.Require().Zero(priority)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -27,7 +28,7 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
+	spotPrice, err := k.getCachedFeeSpotPrice(ctx, feeToken.Denom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -60,6 +61,37 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (osmomath.B
 	return spotPrice, nil
 }
 
+// getCachedFeeSpotPrice returns the spot price of the fee token, calculated once per block on CheckTx.
+// The spot price is cached in the transient store on first use, so that the mempool fee checks of the
+// following txs of the block do not query the pool. It is always calculated on DeliverTx, as swaps of
+// the block change the spot price.
+// The transient store is accessed with an infinite gas meter, so that caching does not change the gas used.
+func (k Keeper) getCachedFeeSpotPrice(ctx sdk.Context, inputDenom string) (osmomath.BigDec, error) {
+	if !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+		return k.CalcFeeSpotPrice(ctx, inputDenom)
+	}
+
+	store := prefix.NewStore(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).TransientStore(k.transientKey), types.FeeTokenSpotPricePrefix)
+	if bz := store.Get([]byte(inputDenom)); bz != nil {
+		var spotPrice osmomath.BigDec
+		if err := spotPrice.Unmarshal(bz); err != nil {
+			return osmomath.BigDec{}, err
+		}
+		return spotPrice, nil
+	}
+
+	spotPrice, err := k.CalcFeeSpotPrice(ctx, inputDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	bz, err := spotPrice.Marshal()
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	store.Set([]byte(inputDenom), bz)
+	return spotPrice, nil
+}
+
 // GetFeeToken returns the fee token record for a specific denom,
 // In our case the baseDenom is uosmo.
 func (k Keeper) GetBaseDenom(ctx sdk.Context) (denom string, err error) {
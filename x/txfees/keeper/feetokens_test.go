@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -225,3 +227,39 @@ func (s *KeeperTestSuite) TestFeeTokenConversions() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestFeeTokenConversionCache() {
+	s.SetupTest(false)
+
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	poolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(baseDenom, 1000),
+		sdk.NewInt64Coin("foo", 2000),
+	)
+	err := s.ExecuteUpgradeFeeTokenProposal("foo", poolId)
+	s.Require().NoError(err)
+
+	inputFee := sdk.NewInt64Coin("foo", 100)
+	checkTxCtx := s.Ctx.WithIsCheckTx(true)
+
+	// The spot price is cached on first use on CheckTx.
+	converted, err := s.App.TxFeesKeeper.ConvertToBaseToken(checkTxCtx, inputFee)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(baseDenom, 50), converted)
+
+	// Swap to change the spot price of the fee token.
+	tokenIn := sdk.NewInt64Coin("foo", 2000)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: baseDenom}}, tokenIn, osmomath.OneInt())
+	s.Require().NoError(err)
+
+	// CheckTx keeps using the spot price cached for the block.
+	converted, err = s.App.TxFeesKeeper.ConvertToBaseToken(checkTxCtx, inputFee)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(baseDenom, 50), converted)
+
+	// DeliverTx always uses the current spot price.
+	converted, err = s.App.TxFeesKeeper.ConvertToBaseToken(s.Ctx, inputFee)
+	s.Require().NoError(err)
+	s.Require().True(converted.Amount.LT(osmomath.NewInt(50)))
+}
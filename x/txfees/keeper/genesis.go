@@ -9,6 +9,8 @@ import (
 // InitGenesis initializes the txfees module's state from a provided genesis
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	err := k.SetBaseDenom(ctx, genState.Basedenom)
 	if err != nil {
 		panic(err)
@@ -42,5 +44,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
 	genesis.TxFeesTracker = &txFeesTracker
+	genesis.Params = k.GetParams(ctx)
 	return genesis
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 )
 
@@ -23,6 +24,8 @@ var (
 		TxFees:                     sdk.Coins{sdk.NewCoin("uosmo", sdk.NewInt(1000))},
 		HeightAccountingStartsFrom: 100,
 	}
+
+	testParams = types.NewParams(10_000_000, 50_000_000, osmomath.NewDecWithPrec(2, 1), osmomath.NewDecWithPrec(5, 2), []types.MsgTypePriorityBoost{{MsgTypeUrl: "/ibc.core.channel.v1.MsgRecvPacket", PriorityBoost: 10}}, []types.MsgTypeGasLimit{{MsgTypeUrl: "/cosmwasm.wasm.v1.MsgInstantiateContract", MaxGasPerTx: 5_000_000, MaxGasPerBlock: 20_000_000}})
 )
 
 func (s *KeeperTestSuite) TestInitGenesis() {
@@ -34,6 +37,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		Basedenom:     testBaseDenom,
 		Feetokens:     testFeeTokens,
 		TxFeesTracker: &testTxFeesTracker,
+		Params:        testParams,
 	})
 
 	actualBaseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
@@ -43,6 +47,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(testFeeTokens, s.App.TxFeesKeeper.GetFeeTokens(s.Ctx))
 	s.Require().Equal(testTxFeesTracker.TxFees, s.App.TxFeesKeeper.GetTxFeesTrackerValue(s.Ctx))
 	s.Require().Equal(testTxFeesTracker.HeightAccountingStartsFrom, s.App.TxFeesKeeper.GetTxFeesTrackerStartHeight(s.Ctx))
+	s.Require().Equal(testParams, s.App.TxFeesKeeper.GetParams(s.Ctx))
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -54,6 +59,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		Basedenom:     testBaseDenom,
 		Feetokens:     testFeeTokens,
 		TxFeesTracker: &testTxFeesTracker,
+		Params:        testParams,
 	})
 
 	genesis := s.App.TxFeesKeeper.ExportGenesis(s.Ctx)
@@ -61,4 +67,5 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testFeeTokens, genesis.Feetokens)
 	s.Require().Equal(testTxFeesTracker.TxFees, genesis.TxFeesTracker.TxFees)
 	s.Require().Equal(testTxFeesTracker.HeightAccountingStartsFrom, genesis.TxFeesTracker.HeightAccountingStartsFrom)
+	s.Require().Equal(testParams, genesis.Params)
 }
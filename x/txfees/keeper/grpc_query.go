@@ -90,3 +90,10 @@ func (q Querier) GetEipBaseFee(_ context.Context, _ *types.QueryEipBaseFeeReques
 	response := mempool1559.CurEipState.GetCurBaseFee()
 	return &types.QueryEipBaseFeeResponse{BaseFee: response}, nil
 }
+
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := q.Keeper.GetParams(sdkCtx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
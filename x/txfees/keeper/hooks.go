@@ -75,6 +75,11 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
 
+// GetModuleName implements EpochHooks.
+func (h Hooks) GetModuleName() string {
+	return txfeestypes.ModuleName
+}
+
 // swapNonNativeFeeToDenom swaps the given non-native fees into the given denom from the given fee collector address.
 // If an error in swap occurs for a given denom, it will be silently skipped.
 // CONTRACT: a pool must exist between each denom in the balance and denomToSwapTo. If doesn't exist. Silently skip swap.
@@ -8,13 +8,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
 type Keeper struct {
-	storeKey storetypes.StoreKey
+	storeKey     storetypes.StoreKey
+	transientKey *storetypes.TransientStoreKey
 
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
@@ -23,6 +25,9 @@ type Keeper struct {
 	protorevKeeper      types.ProtorevKeeper
 	distributionKeeper  types.DistributionKeeper
 	dataDir             string
+
+	// authority is the address allowed to update the txfees parameters, the governance module account.
+	authority string
 }
 
 var _ types.TxFeesKeeper = (*Keeper)(nil)
@@ -31,21 +36,25 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	storeKey storetypes.StoreKey,
+	transientKey *storetypes.TransientStoreKey,
 	poolManager types.PoolManager,
 	spotPriceCalculator types.SpotPriceCalculator,
 	protorevKeeper types.ProtorevKeeper,
 	distributionKeeper types.DistributionKeeper,
 	dataDir string,
+	authority string,
 ) Keeper {
 	return Keeper{
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		storeKey:            storeKey,
+		transientKey:        transientKey,
 		poolManager:         poolManager,
 		spotPriceCalculator: spotPriceCalculator,
 		protorevKeeper:      protorevKeeper,
 		distributionKeeper:  distributionKeeper,
 		dataDir:             dataDir,
+		authority:           authority,
 	}
 }
 
@@ -53,6 +62,17 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetParams returns the total set of txfees parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	osmoutils.MustGet(ctx.KVStore(k.storeKey), types.ParamsKey, &params)
+	return params
+}
+
+// SetParams sets the total set of txfees parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.ParamsKey, &params)
+}
+
 func (k Keeper) GetFeeTokensStore(ctx sdk.Context) sdk.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.FeeTokensStorePrefix)
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	osmomath "github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 )
 
 /*
@@ -31,10 +32,13 @@ import (
    - DefaultBaseFee: Default base fee, initialized to 0.01.
    - MinBaseFee: Minimum base fee, initialized to 0.0025.
    - MaxBaseFee: Maximum base fee, initialized to 10.
-   - MaxBlockChangeRate: The maximum block change rate, initialized to 1/10.
+
+   Governance controlled txfees params:
+   - BaseFeeTargetGas: Gas wanted per block, defaults to 70,000,000.
+   - BaseFeeMaxChangeRate: The maximum block change rate when blocks are above target, defaults to 1/10.
+   - BaseFeeRecoveryRate: The maximum block change rate when blocks are below target, defaults to 1/10.
 
    Global constants:
-   - TargetGas: Gas wanted per block, initialized to 70,000,000.
    - ResetInterval: The interval at which eipState is reset, initialized to 1000 blocks.
    - BackupFile: File for backup, set to "eip1559state.json".
    - RecheckFeeConstant: A constant value for rechecking fees, initialized to 4.
@@ -45,9 +49,6 @@ var (
 	MinBaseFee     = sdk.MustNewDecFromStr("0.0025")
 	MaxBaseFee     = sdk.MustNewDecFromStr("10")
 
-	// Max increase per block is a factor of 15/14, max decrease is 9/10
-	MaxBlockChangeRate = sdk.NewDec(1).Quo(sdk.NewDec(10))
-	TargetGas          = int64(70_000_000)
 	// In face of continuous spam, will take ~21 blocks from base fee > spam cost, to mempool eviction
 	// ceil(log_{15/14}(RecheckFee mnConstant))
 	// So potentially 2 minutes of impaired UX from 1559 nodes on top of time to get to base fee > spam.
@@ -112,20 +113,27 @@ func (e *EipState) deliverTxCode(ctx sdk.Context, tx sdk.FeeTx) {
 // updateBaseFee updates of a base fee in Osmosis.
 // It employs the following equation to calculate the new base fee:
 //
-//	baseFeeMultiplier = 1 + (gasUsed - targetGas) / targetGas * maxChangeRate
+//	baseFeeMultiplier = 1 + (gasUsed - targetGas) / targetGas * changeRate
 //	newBaseFee = baseFee * baseFeeMultiplier
 //
+// where changeRate is the max change rate when the block is above target, and
+// the recovery rate when it is below. Both, and the target gas, are txfees params.
+//
 // updateBaseFee runs at the end of every block
-func (e *EipState) updateBaseFee(height int64) {
+func (e *EipState) updateBaseFee(height int64, params types.Params) {
 	if height != e.lastBlockHeight {
 		fmt.Println("Something is off here? height != e.lastBlockHeight", height, e.lastBlockHeight)
 	}
 	e.lastBlockHeight = height
 
 	gasUsed := e.totalGasWantedThisBlock
-	gasDiff := gasUsed - TargetGas
-	//  (gasUsed - targetGas) / targetGas * maxChangeRate
-	baseFeeIncrement := sdk.NewDec(gasDiff).Quo(sdk.NewDec(TargetGas)).Mul(MaxBlockChangeRate)
+	gasDiff := gasUsed - params.BaseFeeTargetGas
+	changeRate := params.BaseFeeMaxChangeRate
+	if gasDiff < 0 {
+		changeRate = params.BaseFeeRecoveryRate
+	}
+	//  (gasUsed - targetGas) / targetGas * changeRate
+	baseFeeIncrement := sdk.NewDec(gasDiff).Quo(sdk.NewDec(params.BaseFeeTargetGas)).Mul(changeRate)
 	baseFeeMultiplier := sdk.NewDec(1).Add(baseFeeIncrement)
 	e.CurBaseFee.MulMut(baseFeeMultiplier)
 
@@ -8,18 +8,34 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gotest.tools/assert"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/noapptest"
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 )
 
 // TestUpdateBaseFee simulates the update of a base fee in Osmosis.
 // It employs the following equation to calculate the new base fee:
 //
-//	baseFeeMultiplier = 1 + (gasUsed - targetGas) / targetGas * maxChangeRate
+//	baseFeeMultiplier = 1 + (gasUsed - targetGas) / targetGas * changeRate
 //	newBaseFee = baseFee * baseFeeMultiplier
 //
 // The function iterates through a series of simulated blocks and transactions,
 // updating and validating the base fee at each step to ensure it follows the equation.
 func TestUpdateBaseFee(t *testing.T) {
+	tests := map[string]types.Params{
+		"default params":                types.DefaultParams(),
+		"faster increase than recovery": types.NewParams(types.DefaultMaxGasWantedPerTx, 50_000_000, osmomath.NewDecWithPrec(2, 1), osmomath.NewDecWithPrec(5, 2), nil, nil),
+		"faster recovery than increase": types.NewParams(types.DefaultMaxGasWantedPerTx, 100_000_000, osmomath.NewDecWithPrec(5, 2), osmomath.OneDec(), nil, nil),
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			testUpdateBaseFee(t, params)
+		})
+	}
+}
+
+func testUpdateBaseFee(t *testing.T, params types.Params) {
 	// Create an instance of eipState
 	eip := &EipState{
 		lastBlockHeight:         0,
@@ -45,10 +61,10 @@ func TestUpdateBaseFee(t *testing.T) {
 		baseFeeBeforeUpdate := eip.GetCurBaseFee()
 
 		// update base fee
-		eip.updateBaseFee(int64(i))
+		eip.updateBaseFee(int64(i), params)
 
 		// calcualte the base fees
-		expectedBaseFee := calculateBaseFee(eip.totalGasWantedThisBlock, baseFeeBeforeUpdate)
+		expectedBaseFee := calculateBaseFee(eip.totalGasWantedThisBlock, baseFeeBeforeUpdate, params)
 
 		// Assert that the actual result matches the expected result
 		assert.DeepEqual(t, expectedBaseFee, eip.CurBaseFee)
@@ -56,11 +72,15 @@ func TestUpdateBaseFee(t *testing.T) {
 }
 
 // calculateBaseFee is the same as in is defined on the eip1559 code
-func calculateBaseFee(totalGasWantedThisBlock int64, eipStateCurBaseFee sdk.Dec) (expectedBaseFee sdk.Dec) {
+func calculateBaseFee(totalGasWantedThisBlock int64, eipStateCurBaseFee sdk.Dec, params types.Params) (expectedBaseFee sdk.Dec) {
 	gasUsed := totalGasWantedThisBlock
-	gasDiff := gasUsed - TargetGas
+	gasDiff := gasUsed - params.BaseFeeTargetGas
 
-	baseFeeIncrement := sdk.NewDec(gasDiff).Quo(sdk.NewDec(TargetGas)).Mul(MaxBlockChangeRate)
+	changeRate := params.BaseFeeMaxChangeRate
+	if gasDiff < 0 {
+		changeRate = params.BaseFeeRecoveryRate
+	}
+	baseFeeIncrement := sdk.NewDec(gasDiff).Quo(sdk.NewDec(params.BaseFeeTargetGas)).Mul(changeRate)
 	expectedBaseFeeMultiplier := sdk.NewDec(1).Add(baseFeeIncrement)
 	expectedBaseFee = eipStateCurBaseFee.MulMut(expectedBaseFeeMultiplier)
 
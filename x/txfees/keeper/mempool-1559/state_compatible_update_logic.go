@@ -1,6 +1,10 @@
 package mempool1559
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
+)
 
 // DeliverTxCode is run on every transaction and will collect
 // the gas for every transaction for use calculating gas
@@ -15,7 +19,7 @@ func BeginBlockCode(ctx sdk.Context) {
 }
 
 // EndBlockCode runs at the end of every block and it
-// updates the base fee based on the block attributes
-func EndBlockCode(ctx sdk.Context) {
-	CurEipState.updateBaseFee(ctx.BlockHeight())
+// updates the base fee based on the block attributes and the txfees params
+func EndBlockCode(ctx sdk.Context, params types.Params) {
+	CurEipState.updateBaseFee(ctx.BlockHeight(), params)
 }
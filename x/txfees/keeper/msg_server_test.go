@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
+)
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	s.SetupTest(false)
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgSendTypeUrl := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	tests := map[string]struct {
+		authority    string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		"update max gas wanted per tx and base fee rates": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.MaxGasWantedPerTx = 50_000_000
+				params.BaseFeeMaxChangeRate = osmomath.NewDecWithPrec(2, 1)
+				params.BaseFeeRecoveryRate = osmomath.OneDec()
+			},
+		},
+		"update message type priority boosts and gas limits": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.MsgTypePriorityBoosts = []types.MsgTypePriorityBoost{{MsgTypeUrl: msgSendTypeUrl, PriorityBoost: 10}}
+				params.MsgTypeGasLimits = []types.MsgTypeGasLimit{{MsgTypeUrl: msgSendTypeUrl, MaxGasPerTx: 100_000, MaxGasPerBlock: 1_000_000}}
+			},
+		},
+		"error: not the governance authority": {
+			authority:    s.TestAccs[0].String(),
+			updateParams: func(params *types.Params) { params.BaseFeeTargetGas++ },
+			expectedErr:  "invalid authority",
+		},
+		"error: zero max gas wanted per tx": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.MaxGasWantedPerTx = 0 },
+			expectedErr:  "max gas wanted per tx must be positive",
+		},
+		"error: zero base fee target gas": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.BaseFeeTargetGas = 0 },
+			expectedErr:  "base fee target gas must be positive",
+		},
+		"error: base fee recovery rate above one": {
+			authority:    govAuthority,
+			updateParams: func(params *types.Params) { params.BaseFeeRecoveryRate = osmomath.NewDec(2) },
+			expectedErr:  "base fee recovery rate must be in (0, 1]",
+		},
+		"error: duplicate message type priority boosts": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.MsgTypePriorityBoosts = []types.MsgTypePriorityBoost{
+					{MsgTypeUrl: msgSendTypeUrl, PriorityBoost: 10},
+					{MsgTypeUrl: msgSendTypeUrl, PriorityBoost: 20},
+				}
+			},
+			expectedErr: "duplicate priority boost",
+		},
+		"error: message type gas limit per tx above its limit per block": {
+			authority: govAuthority,
+			updateParams: func(params *types.Params) {
+				params.MsgTypeGasLimits = []types.MsgTypeGasLimit{{MsgTypeUrl: msgSendTypeUrl, MaxGasPerTx: 1_000_000, MaxGasPerBlock: 100_000}}
+			},
+			expectedErr: "exceeds its max gas per block",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			originalParams := s.App.TxFeesKeeper.GetParams(s.Ctx)
+			newParams := s.App.TxFeesKeeper.GetParams(s.Ctx)
+			tc.updateParams(&newParams)
+			msgServer := keeper.NewMsgServerImpl(s.App.TxFeesKeeper)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: tc.authority, Params: newParams})
+
+			params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				s.Require().Equal(originalParams, params)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, params)
+		})
+	}
+}
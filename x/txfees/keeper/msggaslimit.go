@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
+)
+
+// MsgGasLimitDecorator enforces the MsgTypeGasLimits param: the txs containing a gas limited message type
+// may not want more gas than its max gas per tx, and the gas wanted by all such txs in a block may not exceed
+// its max gas per block.
+//
+// The gas of a block is counted in the transient store on DeliverTx only, as the gas wanted of the txs is
+// only known once they are included. On CheckTx, only the txs that could never fit in a block are rejected,
+// so that the mempool can hold the heavy txs of the next blocks.
+type MsgGasLimitDecorator struct {
+	TxFeesKeeper Keeper
+}
+
+func NewMsgGasLimitDecorator(tfk Keeper) MsgGasLimitDecorator {
+	return MsgGasLimitDecorator{
+		TxFeesKeeper: tfk,
+	}
+}
+
+func (mgd MsgGasLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// The gas wanted is not known yet when simulating, and gentxs are not limited.
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if err := mgd.TxFeesKeeper.ConsumeMsgTypeGasLimits(ctx, feeTx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ConsumeMsgTypeGasLimits checks the gas wanted by the tx against the gas limits of its message types.
+// On DeliverTx, the gas wanted is then added to the gas of the block of every gas limited message type of the tx.
+// The transient store is accessed with an infinite gas meter, so that simulations estimate the same gas.
+func (k Keeper) ConsumeMsgTypeGasLimits(ctx sdk.Context, tx sdk.FeeTx) error {
+	msgTypeGasLimits := k.GetParams(ctx).MsgTypeGasLimits
+	if len(msgTypeGasLimits) == 0 {
+		return nil
+	}
+
+	limits := make(map[string]types.MsgTypeGasLimit, len(msgTypeGasLimits))
+	for _, limit := range msgTypeGasLimits {
+		limits[limit.MsgTypeUrl] = limit
+	}
+
+	// Collect the distinct gas limited message types of the tx, in order, for determinism.
+	txLimits := []types.MsgTypeGasLimit{}
+	seen := map[string]bool{}
+	for _, msg := range tx.GetMsgs() {
+		txLimits = appendMsgTypeGasLimits(msg, limits, seen, txLimits)
+	}
+
+	gasWanted := tx.GetGas()
+	isDeliverTx := !ctx.IsCheckTx() && !ctx.IsReCheckTx()
+	infiniteGasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, limit := range txLimits {
+		if limit.MaxGasPerTx != 0 && gasWanted > limit.MaxGasPerTx {
+			return errorsmod.Wrapf(types.ErrMsgTypeGasLimit, "tx wants %d gas, maximum for %s txs is %d per tx", gasWanted, limit.MsgTypeUrl, limit.MaxGasPerTx)
+		}
+		if limit.MaxGasPerBlock == 0 {
+			continue
+		}
+		blockGas := uint64(0)
+		if isDeliverTx {
+			blockGas = k.GetMsgTypeBlockGas(infiniteGasCtx, limit.MsgTypeUrl)
+		}
+		// The block gas never exceeds the max gas per block, so this cannot underflow.
+		if gasWanted > limit.MaxGasPerBlock-blockGas {
+			return errorsmod.Wrapf(types.ErrMsgTypeGasLimit, "tx wants %d gas, %d of the %d gas per block for %s txs is left", gasWanted, limit.MaxGasPerBlock-blockGas, limit.MaxGasPerBlock, limit.MsgTypeUrl)
+		}
+	}
+
+	// Only count the gas once all the limits are checked, so that a rejected tx does not use any.
+	if isDeliverTx {
+		for _, limit := range txLimits {
+			if limit.MaxGasPerBlock != 0 {
+				k.setMsgTypeBlockGas(infiniteGasCtx, limit.MsgTypeUrl, k.GetMsgTypeBlockGas(infiniteGasCtx, limit.MsgTypeUrl)+gasWanted)
+			}
+		}
+	}
+
+	return nil
+}
+
+// appendMsgTypeGasLimits appends the gas limit of the message type, if limited and not seen yet, to txLimits.
+// The messages executed by an authz MsgExec are checked as well, so that wrapping them does not bypass their limits.
+func appendMsgTypeGasLimits(msg sdk.Msg, limits map[string]types.MsgTypeGasLimit, seen map[string]bool, txLimits []types.MsgTypeGasLimit) []types.MsgTypeGasLimit {
+	msgTypeUrl := sdk.MsgTypeURL(msg)
+	if limit, ok := limits[msgTypeUrl]; ok && !seen[msgTypeUrl] {
+		seen[msgTypeUrl] = true
+		txLimits = append(txLimits, limit)
+	}
+
+	if authzMsg, ok := msg.(*authztypes.MsgExec); ok {
+		msgs, _ := authzMsg.GetMessages()
+		for _, m := range msgs {
+			txLimits = appendMsgTypeGasLimits(m, limits, seen, txLimits)
+		}
+	}
+	return txLimits
+}
+
+// GetMsgTypeBlockGas returns the gas wanted in the current block by the txs containing the given message type.
+func (k Keeper) GetMsgTypeBlockGas(ctx sdk.Context, msgTypeUrl string) uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.MsgTypeBlockGasPrefix)
+	bz := store.Get([]byte(msgTypeUrl))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setMsgTypeBlockGas(ctx sdk.Context, msgTypeUrl string, gas uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.MsgTypeBlockGasPrefix)
+	store.Set([]byte(msgTypeUrl), sdk.Uint64ToBigEndian(gas))
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/txfees/types"
+)
+
+func (s *KeeperTestSuite) TestMsgGasLimitDecorator() {
+	createPositionUrl := sdk.MsgTypeURL(&cltypes.MsgCreatePosition{})
+	gasLimits := []types.MsgTypeGasLimit{
+		{MsgTypeUrl: createPositionUrl, MaxGasPerTx: 2_000_000, MaxGasPerBlock: 5_000_000},
+	}
+
+	grantee := sdk.AccAddress("grantee")
+	authzExec := func(msgs ...sdk.Msg) sdk.Msg {
+		msgExec := authz.NewMsgExec(grantee, msgs)
+		return &msgExec
+	}
+
+	type tx struct {
+		msgs       []sdk.Msg
+		gas        uint64
+		isCheckTx  bool
+		simulate   bool
+		expectPass bool
+	}
+
+	tests := map[string]struct {
+		txs              []tx
+		expectedBlockGas uint64
+	}{
+		"unlimited msg type": {
+			txs: []tx{
+				{msgs: []sdk.Msg{&banktypes.MsgSend{}}, gas: 10_000_000, expectPass: true},
+			},
+		},
+		"over the max gas per tx": {
+			txs: []tx{
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 2_000_001, isCheckTx: true},
+				{msgs: []sdk.Msg{&banktypes.MsgSend{}, &cltypes.MsgCreatePosition{}}, gas: 2_000_001},
+			},
+		},
+		"over the max gas per tx, wrapped in authz exec": {
+			txs: []tx{
+				{msgs: []sdk.Msg{authzExec(&cltypes.MsgCreatePosition{})}, gas: 2_000_001},
+				{msgs: []sdk.Msg{authzExec(&banktypes.MsgSend{}, authzExec(&cltypes.MsgCreatePosition{}))}, gas: 2_000_001},
+			},
+		},
+		"over the max gas per block, wrapped in authz exec": {
+			txs: []tx{
+				{msgs: []sdk.Msg{authzExec(&cltypes.MsgCreatePosition{})}, gas: 2_000_000, expectPass: true},
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}, authzExec(&cltypes.MsgCreatePosition{})}, gas: 2_000_000, expectPass: true},
+				{msgs: []sdk.Msg{authzExec(authzExec(&cltypes.MsgCreatePosition{}))}, gas: 1_000_001},
+			},
+			expectedBlockGas: 4_000_000,
+		},
+		"simulation is not limited": {
+			txs: []tx{
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 2_000_001, simulate: true, expectPass: true},
+			},
+		},
+		"block gas is counted once per tx on deliver tx": {
+			txs: []tx{
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}, &cltypes.MsgCreatePosition{}}, gas: 2_000_000, expectPass: true},
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 1_500_000, expectPass: true},
+			},
+			expectedBlockGas: 3_500_000,
+		},
+		"block gas is not counted on check tx": {
+			txs: []tx{
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 2_000_000, isCheckTx: true, expectPass: true},
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 2_000_000, isCheckTx: true, expectPass: true},
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 2_000_000, isCheckTx: true, expectPass: true},
+			},
+		},
+		"over the max gas per block": {
+			txs: []tx{
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 2_000_000, expectPass: true},
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 2_000_000, expectPass: true},
+				// rejected txs do not count, so that the remaining gas can still be used.
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 1_000_001},
+				{msgs: []sdk.Msg{&cltypes.MsgCreatePosition{}}, gas: 1_000_000, expectPass: true},
+			},
+			expectedBlockGas: 5_000_000,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+			params.MsgTypeGasLimits = gasLimits
+			s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+			mgd := keeper.NewMsgGasLimitDecorator(*s.App.TxFeesKeeper)
+			for i, tx := range tc.txs {
+				txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+				s.Require().NoError(txBuilder.SetMsgs(tx.msgs...))
+				txBuilder.SetGasLimit(tx.gas)
+
+				_, err := mgd.AnteHandle(s.Ctx.WithIsCheckTx(tx.isCheckTx), txBuilder.GetTx(), tx.simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+					return ctx, nil
+				})
+				if tx.expectPass {
+					s.Require().NoError(err, "tx %d", i)
+				} else {
+					s.Require().ErrorIs(err, types.ErrMsgTypeGasLimit, "tx %d", i)
+				}
+			}
+
+			s.Require().Equal(tc.expectedBlockGas, s.App.TxFeesKeeper.GetMsgTypeBlockGas(s.Ctx, createPositionUrl))
+		})
+	}
+}
@@ -118,9 +118,10 @@ func (am AppModule) Name() string {
 // QuerierRoute returns the txfees module's query routing key.
 func (AppModule) QuerierRoute() string { return "" }
 
-// RegisterServices registers a GRPC query service to respond to the
+// RegisterServices registers the txfees module's Msg service and a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 }
 
@@ -156,7 +157,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 // EndBlock executes all ABCI EndBlock logic respective to the txfees module. It
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	mempool1559.EndBlockCode(ctx)
+	mempool1559.EndBlockCode(ctx, am.keeper.GetParams(ctx))
 	return []abci.ValidatorUpdate{}
 }
 
@@ -11,4 +11,5 @@ var (
 	ErrNoBaseDenom     = errorsmod.Register(ModuleName, 1, "no base denom was set")
 	ErrTooManyFeeCoins = errorsmod.Register(ModuleName, 2, "too many fee coins. only accepts fees in one denom")
 	ErrInvalidFeeToken = errorsmod.Register(ModuleName, 3, "invalid fee token")
+	ErrMsgTypeGasLimit = errorsmod.Register(ModuleName, 4, "msg type gas limit exceeded")
 )
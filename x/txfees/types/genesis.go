@@ -11,6 +11,7 @@ func DefaultGenesis() *GenesisState {
 			TxFees:                     sdk.NewCoins(),
 			HeightAccountingStartsFrom: 0,
 		},
+		Params: DefaultParams(),
 	}
 }
 
@@ -30,5 +31,9 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
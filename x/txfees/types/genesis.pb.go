@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,8 @@ type GenesisState struct {
 	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
 	// KVStore state
 	TxFeesTracker *TxFeesTracker `protobuf:"bytes,3,opt,name=txFeesTracker,proto3" json:"txFeesTracker,omitempty"`
+	// params are the governance controlled parameters of the txfees module.
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +90,227 @@ func (m *GenesisState) GetTxFeesTracker() *TxFeesTracker {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params holds the governance controlled parameters of the txfees module.
+type Params struct {
+	// max_gas_wanted_per_tx is the maximum amount of gas a transaction may
+	// request. Transactions requesting more gas are rejected by the ante
+	// handler, both when entering the mempool and during block execution.
+	MaxGasWantedPerTx uint64 `protobuf:"varint,1,opt,name=max_gas_wanted_per_tx,json=maxGasWantedPerTx,proto3" json:"max_gas_wanted_per_tx,omitempty" yaml:"max_gas_wanted_per_tx"`
+	// base_fee_target_gas is the amount of gas wanted per block that the
+	// EIP-1559 mempool base fee aims for. Fuller blocks raise the base fee,
+	// emptier blocks lower it.
+	BaseFeeTargetGas int64 `protobuf:"varint,2,opt,name=base_fee_target_gas,json=baseFeeTargetGas,proto3" json:"base_fee_target_gas,omitempty" yaml:"base_fee_target_gas"`
+	// base_fee_max_change_rate bounds how fast the base fee rises when blocks
+	// are fuller than the target: a block using twice the target gas multiplies
+	// the base fee by 1 + base_fee_max_change_rate.
+	BaseFeeMaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=base_fee_max_change_rate,json=baseFeeMaxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_max_change_rate" yaml:"base_fee_max_change_rate"`
+	// base_fee_recovery_rate bounds how fast the base fee falls back when blocks
+	// are emptier than the target: an empty block multiplies the base fee by
+	// 1 - base_fee_recovery_rate.
+	BaseFeeRecoveryRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=base_fee_recovery_rate,json=baseFeeRecoveryRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_recovery_rate" yaml:"base_fee_recovery_rate"`
+	// msg_type_priority_boosts are added to the mempool priority of the
+	// transactions whose messages all have a boosted type, so that critical
+	// messages such as IBC relaying are included before arbitrage spam.
+	MsgTypePriorityBoosts []MsgTypePriorityBoost `protobuf:"bytes,5,rep,name=msg_type_priority_boosts,json=msgTypePriorityBoosts,proto3" json:"msg_type_priority_boosts" yaml:"msg_type_priority_boosts"`
+	// msg_type_gas_limits bound the gas wanted by the transactions containing
+	// heavy message types, both per transaction and cumulatively per block, to
+	// smooth block times under load.
+	MsgTypeGasLimits []MsgTypeGasLimit `protobuf:"bytes,6,rep,name=msg_type_gas_limits,json=msgTypeGasLimits,proto3" json:"msg_type_gas_limits" yaml:"msg_type_gas_limits"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxGasWantedPerTx() uint64 {
+	if m != nil {
+		return m.MaxGasWantedPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeTargetGas() int64 {
+	if m != nil {
+		return m.BaseFeeTargetGas
+	}
+	return 0
+}
+
+func (m *Params) GetMsgTypePriorityBoosts() []MsgTypePriorityBoost {
+	if m != nil {
+		return m.MsgTypePriorityBoosts
+	}
+	return nil
+}
+
+func (m *Params) GetMsgTypeGasLimits() []MsgTypeGasLimit {
+	if m != nil {
+		return m.MsgTypeGasLimits
+	}
+	return nil
+}
+
+// MsgTypePriorityBoost is the mempool priority boost of a message type.
+type MsgTypePriorityBoost struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// /ibc.core.channel.v1.MsgRecvPacket.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// priority_boost is added to the priority of the transactions, which is
+	// otherwise the fee paid per million gas in the base denom.
+	PriorityBoost int64 `protobuf:"varint,2,opt,name=priority_boost,json=priorityBoost,proto3" json:"priority_boost,omitempty" yaml:"priority_boost"`
+}
+
+func (m *MsgTypePriorityBoost) Reset()         { *m = MsgTypePriorityBoost{} }
+func (m *MsgTypePriorityBoost) String() string { return proto.CompactTextString(m) }
+func (*MsgTypePriorityBoost) ProtoMessage()    {}
+func (*MsgTypePriorityBoost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{2}
+}
+func (m *MsgTypePriorityBoost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypePriorityBoost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypePriorityBoost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypePriorityBoost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypePriorityBoost.Merge(m, src)
+}
+func (m *MsgTypePriorityBoost) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypePriorityBoost) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypePriorityBoost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypePriorityBoost proto.InternalMessageInfo
+
+func (m *MsgTypePriorityBoost) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypePriorityBoost) GetPriorityBoost() int64 {
+	if m != nil {
+		return m.PriorityBoost
+	}
+	return 0
+}
+
+// MsgTypeGasLimit bounds the gas wanted by the transactions containing a
+// message type. A zero limit means no limit.
+type MsgTypeGasLimit struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// /osmosis.concentratedliquidity.v1beta1.MsgCreatePosition.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// max_gas_per_tx is the maximum gas a transaction containing the message
+	// type may request.
+	MaxGasPerTx uint64 `protobuf:"varint,2,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty" yaml:"max_gas_per_tx"`
+	// max_gas_per_block is the maximum cumulative gas wanted by the
+	// transactions containing the message type in a block.
+	MaxGasPerBlock uint64 `protobuf:"varint,3,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty" yaml:"max_gas_per_block"`
+}
+
+func (m *MsgTypeGasLimit) Reset()         { *m = MsgTypeGasLimit{} }
+func (m *MsgTypeGasLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeGasLimit) ProtoMessage()    {}
+func (*MsgTypeGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{3}
+}
+func (m *MsgTypeGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeGasLimit.Merge(m, src)
+}
+func (m *MsgTypeGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeGasLimit proto.InternalMessageInfo
+
+func (m *MsgTypeGasLimit) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeGasLimit) GetMaxGasPerTx() uint64 {
+	if m != nil {
+		return m.MaxGasPerTx
+	}
+	return 0
+}
+
+func (m *MsgTypeGasLimit) GetMaxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxGasPerBlock
+	}
+	return 0
+}
+
 type TxFeesTracker struct {
 	TxFees                     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tx_fees,json=txFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tx_fees"`
 	HeightAccountingStartsFrom int64                                    `protobuf:"varint,2,opt,name=height_accounting_starts_from,json=heightAccountingStartsFrom,proto3" json:"height_accounting_starts_from,omitempty" yaml:"height_accounting_starts_from"`
@@ -96,7 +320,7 @@ func (m *TxFeesTracker) Reset()         { *m = TxFeesTracker{} }
 func (m *TxFeesTracker) String() string { return proto.CompactTextString(m) }
 func (*TxFeesTracker) ProtoMessage()    {}
 func (*TxFeesTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4423c18e3d020b37, []int{1}
+	return fileDescriptor_4423c18e3d020b37, []int{4}
 }
 func (m *TxFeesTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,6 +365,9 @@ func (m *TxFeesTracker) GetHeightAccountingStartsFrom() int64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+	proto.RegisterType((*MsgTypePriorityBoost)(nil), "osmosis.txfees.v1beta1.MsgTypePriorityBoost")
+	proto.RegisterType((*MsgTypeGasLimit)(nil), "osmosis.txfees.v1beta1.MsgTypeGasLimit")
 	proto.RegisterType((*TxFeesTracker)(nil), "osmosis.txfees.v1beta1.TxFeesTracker")
 }
 
@@ -149,33 +376,59 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0xc7, 0x3b, 0x17, 0x73, 0x0d, 0x73, 0xbd, 0x9b, 0xc6, 0x98, 0x4a, 0xb4, 0x34, 0x0d, 0x24,
-	0xdd, 0x30, 0x23, 0x75, 0xe7, 0xce, 0x6a, 0x70, 0xa1, 0xab, 0xc2, 0xca, 0x4d, 0x33, 0x2d, 0x87,
-	0xd2, 0x94, 0x76, 0x48, 0x67, 0x20, 0xe5, 0x2d, 0x7c, 0x0e, 0x5f, 0xc2, 0x2d, 0x4b, 0x96, 0xae,
-	0xd0, 0x94, 0x37, 0xf0, 0x09, 0x4c, 0x3f, 0xf8, 0x4a, 0xc4, 0x55, 0x3f, 0xfe, 0xbf, 0xf3, 0x3f,
-	0xff, 0x73, 0x66, 0x70, 0x8f, 0x8b, 0x84, 0x8b, 0x48, 0x50, 0x99, 0xcf, 0x00, 0x04, 0x5d, 0x0f,
-	0x7d, 0x90, 0x6c, 0x48, 0x43, 0x48, 0x41, 0x44, 0x82, 0x2c, 0x33, 0x2e, 0xb9, 0xfa, 0xa2, 0xa1,
-	0x48, 0x4d, 0x91, 0x86, 0xea, 0x3c, 0x0f, 0x79, 0xc8, 0x2b, 0x84, 0x96, 0x6f, 0x35, 0xdd, 0xe9,
-	0xdf, 0xf0, 0x9c, 0x01, 0x48, 0x1e, 0x43, 0xda, 0x60, 0x7a, 0x50, 0x71, 0xd4, 0x67, 0x02, 0x4e,
-	0x4c, 0xc0, 0xa3, 0x46, 0x37, 0x7f, 0x20, 0xfc, 0xec, 0x53, 0x1d, 0x63, 0x2c, 0x99, 0x04, 0xf5,
-	0x15, 0x6e, 0x97, 0xec, 0x14, 0x52, 0x9e, 0x68, 0xc8, 0x40, 0x56, 0xdb, 0x3d, 0xff, 0x50, 0x3f,
-	0xe2, 0xf6, 0xb1, 0x81, 0xd0, 0xee, 0x8c, 0x96, 0xf5, 0x60, 0x1b, 0xe4, 0xdf, 0xb9, 0xc9, 0x08,
-	0x60, 0x52, 0x82, 0xce, 0x93, 0xed, 0xbe, 0xab, 0xb8, 0xe7, 0x42, 0xf5, 0x33, 0x7e, 0x94, 0xf9,
-	0x08, 0x40, 0x4c, 0x32, 0x16, 0xc4, 0x90, 0x69, 0x2d, 0x03, 0x59, 0x0f, 0x76, 0xff, 0x96, 0xd3,
-	0xe4, 0x12, 0x76, 0xaf, 0x6b, 0xcd, 0x02, 0xe1, 0xc7, 0x2b, 0x40, 0x9d, 0xe2, 0xa7, 0x32, 0xf7,
-	0x4a, 0x07, 0x0d, 0x55, 0x11, 0x5f, 0x92, 0x7a, 0x0b, 0xa4, 0x1c, 0xe4, 0xe4, 0xfa, 0x81, 0x47,
-	0xa9, 0xf3, 0xa6, 0xcc, 0xf6, 0xfd, 0x57, 0xd7, 0x0a, 0x23, 0x39, 0x5f, 0xf9, 0x24, 0xe0, 0x09,
-	0x6d, 0x56, 0x56, 0x3f, 0x06, 0x62, 0x1a, 0x53, 0xb9, 0x59, 0x82, 0xa8, 0x0a, 0x84, 0x7b, 0x5f,
-	0xb7, 0x57, 0x63, 0xfc, 0x7a, 0x0e, 0x51, 0x38, 0x97, 0x1e, 0x0b, 0x02, 0xbe, 0x4a, 0x65, 0x94,
-	0x86, 0x9e, 0x90, 0x2c, 0x93, 0xc2, 0x9b, 0x65, 0x3c, 0xd1, 0xee, 0x0c, 0x64, 0xb5, 0x1c, 0xeb,
-	0xcf, 0xbe, 0xdb, 0xdb, 0xb0, 0x64, 0xf1, 0xce, 0xfc, 0x2f, 0x6e, 0xba, 0x9d, 0x5a, 0x7f, 0x7f,
-	0x92, 0xc7, 0x95, 0x3a, 0xca, 0x78, 0xe2, 0x7c, 0xd9, 0x16, 0x3a, 0xda, 0x15, 0x3a, 0xfa, 0x5d,
-	0xe8, 0xe8, 0xdb, 0x41, 0x57, 0x76, 0x07, 0x5d, 0xf9, 0x79, 0xd0, 0x95, 0xaf, 0xf6, 0x45, 0xf0,
-	0x66, 0x7d, 0x83, 0x05, 0xf3, 0xc5, 0xf1, 0x83, 0xae, 0xed, 0x21, 0xcd, 0x8f, 0xb7, 0xa4, 0x1a,
-	0xc4, 0xbf, 0xaf, 0xce, 0xfe, 0xed, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x46, 0x2a, 0xc3,
-	0x98, 0x02, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xdb, 0x6c, 0x50, 0xa7, 0xdb, 0xb2, 0xeb, 0xb6, 0x8b, 0x37, 0x74, 0xed, 0x68, 0xb4,
+	0xab, 0xcd, 0x81, 0xb5, 0x69, 0x39, 0x81, 0x10, 0x02, 0x6f, 0xd5, 0x1c, 0x68, 0xa5, 0x6a, 0x36,
+	0x08, 0x89, 0x8b, 0x35, 0x71, 0xa6, 0x8e, 0x95, 0xd8, 0x13, 0xcd, 0x4c, 0x8b, 0xc3, 0x81, 0x8f,
+	0x80, 0x90, 0xf8, 0x16, 0x5c, 0xf9, 0x12, 0x7b, 0xdc, 0x0b, 0x12, 0xe2, 0x60, 0x50, 0xfb, 0x0d,
+	0x72, 0x47, 0x42, 0xf3, 0x27, 0xce, 0x1f, 0x12, 0x40, 0xda, 0x53, 0x9b, 0xf7, 0x7e, 0xbf, 0xdf,
+	0xfb, 0xcd, 0xbc, 0xf7, 0xc6, 0xe0, 0x29, 0xe5, 0x19, 0xe5, 0x29, 0x0f, 0x44, 0x71, 0x45, 0x08,
+	0x0f, 0x6e, 0x8e, 0xbb, 0x44, 0xe0, 0xe3, 0x20, 0x21, 0x39, 0xe1, 0x29, 0xf7, 0x47, 0x8c, 0x0a,
+	0x6a, 0x3f, 0x32, 0x28, 0x5f, 0xa3, 0x7c, 0x83, 0x6a, 0x1c, 0x24, 0x34, 0xa1, 0x0a, 0x12, 0xc8,
+	0xff, 0x34, 0xba, 0xf1, 0x6c, 0x8d, 0xe6, 0x15, 0x21, 0x82, 0x0e, 0x48, 0x6e, 0x60, 0x6e, 0xac,
+	0x70, 0x41, 0x17, 0x73, 0x52, 0x61, 0x62, 0x9a, 0x9a, 0x3c, 0xfc, 0xcb, 0x02, 0xf7, 0xdb, 0xda,
+	0xc6, 0x2b, 0x81, 0x05, 0xb1, 0x8f, 0xc0, 0xb6, 0xc4, 0xf6, 0x48, 0x4e, 0x33, 0xc7, 0x6a, 0x5a,
+	0xad, 0x6d, 0x34, 0x0b, 0xd8, 0xa7, 0x60, 0x7b, 0x5a, 0x80, 0x3b, 0x9b, 0xcd, 0xad, 0xd6, 0xce,
+	0x49, 0xd3, 0x5f, 0xed, 0xdb, 0x3f, 0x23, 0xa4, 0x23, 0x81, 0x61, 0xed, 0x75, 0xe9, 0x6d, 0xa0,
+	0x19, 0xd1, 0xfe, 0x12, 0xec, 0x8a, 0xe2, 0x8c, 0x10, 0xde, 0x61, 0x38, 0x1e, 0x10, 0xe6, 0x6c,
+	0x35, 0xad, 0xd6, 0xce, 0xc9, 0xb3, 0x75, 0x4a, 0x9d, 0x79, 0x30, 0x5a, 0xe4, 0xda, 0x9f, 0x82,
+	0xfa, 0x08, 0x33, 0x9c, 0x71, 0xa7, 0xa6, 0x54, 0xdc, 0x75, 0x2a, 0x97, 0x0a, 0x65, 0xdc, 0x18,
+	0x0e, 0xfc, 0xe5, 0x1e, 0xa8, 0xeb, 0x84, 0x8d, 0xc0, 0x61, 0x86, 0x8b, 0x28, 0xc1, 0x3c, 0xfa,
+	0x16, 0xe7, 0x82, 0xf4, 0xa2, 0x11, 0x61, 0x91, 0x28, 0xd4, 0x2d, 0xd4, 0xc2, 0xe6, 0xa4, 0xf4,
+	0x8e, 0xc6, 0x38, 0x1b, 0x7e, 0x02, 0x57, 0xc2, 0x20, 0x7a, 0x98, 0xe1, 0xa2, 0x8d, 0xf9, 0xd7,
+	0x2a, 0x7a, 0x49, 0x58, 0xa7, 0xb0, 0x2f, 0xc0, 0xbe, 0xbc, 0xbc, 0xe8, 0x8a, 0x90, 0x48, 0x60,
+	0x96, 0x10, 0x21, 0x89, 0xce, 0x66, 0xd3, 0x6a, 0x6d, 0x85, 0xee, 0xa4, 0xf4, 0x1a, 0x5a, 0x71,
+	0x05, 0x08, 0xa2, 0x07, 0x32, 0x2a, 0x6f, 0x51, 0xc5, 0xda, 0x98, 0xdb, 0xdf, 0x03, 0xa7, 0x42,
+	0x4a, 0x13, 0x71, 0x1f, 0xe7, 0x09, 0x89, 0x18, 0x16, 0x44, 0xdd, 0xe1, 0x76, 0x78, 0x26, 0x4f,
+	0xf7, 0x7b, 0xe9, 0xbd, 0xaf, 0xfb, 0xce, 0x7b, 0x03, 0x3f, 0xa5, 0x41, 0x86, 0x45, 0xdf, 0x3f,
+	0x27, 0x09, 0x8e, 0xc7, 0xa7, 0x24, 0x9e, 0x94, 0x9e, 0xb7, 0x54, 0x76, 0x49, 0x0c, 0xa2, 0x03,
+	0x53, 0xfb, 0x02, 0x17, 0x2f, 0x55, 0x1c, 0xc9, 0xe1, 0x18, 0x83, 0x47, 0x15, 0x85, 0x91, 0x98,
+	0xde, 0x10, 0x36, 0xd6, 0xd5, 0x6b, 0xaa, 0xfa, 0xe9, 0xff, 0xab, 0xfe, 0x64, 0xa9, 0xfa, 0x82,
+	0x14, 0x44, 0xfb, 0xa6, 0x36, 0x32, 0x61, 0x55, 0xfa, 0x07, 0x0b, 0x38, 0x19, 0x4f, 0x22, 0x31,
+	0x1e, 0x91, 0x68, 0xc4, 0x52, 0xca, 0x52, 0x31, 0x8e, 0xba, 0x94, 0x72, 0xc1, 0x9d, 0x7b, 0x6a,
+	0x12, 0x3f, 0x58, 0xd7, 0xf9, 0x0b, 0x9e, 0x74, 0xc6, 0x23, 0x72, 0x69, 0x58, 0xa1, 0x24, 0x85,
+	0xcf, 0xa5, 0xd7, 0xd9, 0x55, 0xac, 0xd3, 0x86, 0xe8, 0x30, 0x5b, 0x41, 0xe7, 0xf6, 0x77, 0x60,
+	0xbf, 0xe2, 0xc8, 0x61, 0x18, 0xa6, 0x59, 0x2a, 0xb8, 0x53, 0x57, 0x56, 0x9e, 0xff, 0x87, 0x95,
+	0x36, 0xe6, 0xe7, 0x12, 0x1f, 0x42, 0xe3, 0xa2, 0xb1, 0xe4, 0x62, 0xa6, 0x08, 0xd1, 0x83, 0x6c,
+	0x91, 0xc4, 0xe1, 0x4f, 0x16, 0x38, 0x58, 0x75, 0x28, 0xfb, 0x63, 0x70, 0xbf, 0x92, 0xb8, 0x66,
+	0x43, 0xbd, 0xc0, 0xe1, 0x7b, 0x93, 0xd2, 0xdb, 0x5f, 0x2a, 0x70, 0xcd, 0x86, 0x10, 0x01, 0xa3,
+	0xfc, 0x15, 0x1b, 0xda, 0x9f, 0x83, 0xbd, 0xc5, 0xa3, 0x9b, 0x29, 0x7d, 0x3c, 0x29, 0xbd, 0x43,
+	0x4d, 0x5e, 0xcc, 0x43, 0xb4, 0x3b, 0x9a, 0x2f, 0x0e, 0x7f, 0xb5, 0xc0, 0xbb, 0x4b, 0xe7, 0x7b,
+	0x1b, 0x43, 0x9f, 0x81, 0xbd, 0xe9, 0xa2, 0x99, 0x45, 0xdc, 0x54, 0x8b, 0x38, 0x67, 0x68, 0x31,
+	0x0f, 0xd1, 0x8e, 0xde, 0x40, 0xbd, 0x7b, 0x6d, 0xf0, 0x70, 0x3e, 0xdf, 0x1d, 0xd2, 0x78, 0xa0,
+	0xb6, 0xa4, 0x16, 0x1e, 0x4d, 0x4a, 0xcf, 0xf9, 0xa7, 0x84, 0x82, 0x40, 0xb4, 0x57, 0xa9, 0x84,
+	0x2a, 0x70, 0x6b, 0x81, 0xdd, 0x85, 0x27, 0xc8, 0xee, 0x81, 0x77, 0x44, 0x21, 0x47, 0x97, 0x3b,
+	0x96, 0xea, 0xf7, 0x63, 0x5f, 0x4f, 0xbc, 0x2f, 0x47, 0xb7, 0x6a, 0xf6, 0x4b, 0x9a, 0xe6, 0xe1,
+	0x87, 0xb2, 0xc3, 0x3f, 0xff, 0xe1, 0xb5, 0x92, 0x54, 0xf4, 0xaf, 0xbb, 0x7e, 0x4c, 0xb3, 0xc0,
+	0x3c, 0xca, 0xfa, 0xcf, 0x0b, 0xde, 0x1b, 0x04, 0xf2, 0x0a, 0xb8, 0x22, 0x70, 0x54, 0xd7, 0x0f,
+	0x9c, 0x3d, 0x00, 0x4f, 0xfa, 0x24, 0x4d, 0xfa, 0x22, 0xc2, 0x71, 0x4c, 0xaf, 0x73, 0x91, 0xe6,
+	0x49, 0xc4, 0x05, 0x66, 0x82, 0x47, 0x57, 0x8c, 0x66, 0xa6, 0x41, 0xad, 0x49, 0xe9, 0x3d, 0xd5,
+	0x87, 0xf9, 0x57, 0x38, 0x44, 0x0d, 0x9d, 0xff, 0xa2, 0x4a, 0xbf, 0x52, 0xd9, 0x33, 0x46, 0xb3,
+	0xf0, 0xfc, 0xf5, 0xad, 0x6b, 0xbd, 0xb9, 0x75, 0xad, 0x3f, 0x6f, 0x5d, 0xeb, 0xc7, 0x3b, 0x77,
+	0xe3, 0xcd, 0x9d, 0xbb, 0xf1, 0xdb, 0x9d, 0xbb, 0xf1, 0xcd, 0xc9, 0x9c, 0x71, 0x33, 0xd5, 0x2f,
+	0x86, 0xb8, 0xcb, 0xa7, 0x3f, 0x82, 0x9b, 0x93, 0xe3, 0xa0, 0x98, 0x7e, 0x87, 0xd4, 0x41, 0xba,
+	0x75, 0xf5, 0x75, 0xf9, 0xe8, 0xef, 0x01, 0x00, 0x29, 0xf6, 0xe8, 0x64, 0xfa, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +451,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.TxFeesTracker != nil {
 		{
 			size, err := m.TxFeesTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -234,6 +497,162 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeGasLimits) > 0 {
+		for iNdEx := len(m.MsgTypeGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MsgTypePriorityBoosts) > 0 {
+		for iNdEx := len(m.MsgTypePriorityBoosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypePriorityBoosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.BaseFeeRecoveryRate.Size()
+		i -= size
+		if _, err := m.BaseFeeRecoveryRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseFeeMaxChangeRate.Size()
+		i -= size
+		if _, err := m.BaseFeeMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BaseFeeTargetGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BaseFeeTargetGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxGasWantedPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGasWantedPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypePriorityBoost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypePriorityBoost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypePriorityBoost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriorityBoost != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PriorityBoost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxFeesTracker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -307,32 +726,100 @@ func (m *GenesisState) Size() (n int) {
 		l = m.TxFeesTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *TxFeesTracker) Size() (n int) {
+func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TxFees) > 0 {
-		for _, e := range m.TxFees {
+	if m.MaxGasWantedPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGasWantedPerTx))
+	}
+	if m.BaseFeeTargetGas != 0 {
+		n += 1 + sovGenesis(uint64(m.BaseFeeTargetGas))
+	}
+	l = m.BaseFeeMaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFeeRecoveryRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MsgTypePriorityBoosts) > 0 {
+		for _, e := range m.MsgTypePriorityBoosts {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if m.HeightAccountingStartsFrom != 0 {
-		n += 1 + sovGenesis(uint64(m.HeightAccountingStartsFrom))
+	if len(m.MsgTypeGasLimits) > 0 {
+		for _, e := range m.MsgTypeGasLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgTypePriorityBoost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PriorityBoost != 0 {
+		n += 1 + sovGenesis(uint64(m.PriorityBoost))
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgTypeGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGasPerTx))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGasPerBlock))
+	}
+	return n
+}
+
+func (m *TxFeesTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxFees) > 0 {
+		for _, e := range m.TxFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HeightAccountingStartsFrom != 0 {
+		n += 1 + sovGenesis(uint64(m.HeightAccountingStartsFrom))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -465,6 +952,484 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasWantedPerTx", wireType)
+			}
+			m.MaxGasWantedPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasWantedPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTargetGas", wireType)
+			}
+			m.BaseFeeTargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeTargetGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecoveryRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeRecoveryRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypePriorityBoosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypePriorityBoosts = append(m.MsgTypePriorityBoosts, MsgTypePriorityBoost{})
+			if err := m.MsgTypePriorityBoosts[len(m.MsgTypePriorityBoosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeGasLimits = append(m.MsgTypeGasLimits, MsgTypeGasLimit{})
+			if err := m.MsgTypeGasLimits[len(m.MsgTypeGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypePriorityBoost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypePriorityBoost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypePriorityBoost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityBoost", wireType)
+			}
+			m.PriorityBoost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityBoost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8,6 +8,9 @@ const (
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
 
+	// TransientStoreKey defines the transient store key, reset at the end of every block.
+	TransientStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for slashing.
 	RouterKey = ModuleName
 
@@ -31,4 +34,13 @@ var (
 	FeeTokensStorePrefix               = []byte("fee_tokens")
 	KeyTxFeeProtorevTracker            = []byte("txfee_protorev_tracker")
 	KeyTxFeeProtorevTrackerStartHeight = []byte("txfee_protorev_tracker_start_height")
+	ParamsKey                          = []byte("params")
+
+	// MsgTypeBlockGasPrefix prefixes the gas wanted in the current block by the txs containing a message type,
+	// in the transient store.
+	MsgTypeBlockGasPrefix = []byte("msg_type_block_gas")
+
+	// FeeTokenSpotPricePrefix prefixes the spot price of a fee token cached for the current block,
+	// in the transient store.
+	FeeTokenSpotPricePrefix = []byte("fee_token_spot_price")
 )
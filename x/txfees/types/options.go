@@ -24,6 +24,22 @@ var (
 	DefaultMaxGasWantedPerTx       = uint64(25 * 1000 * 1000)
 	DefaultHighGasTxThreshold      = uint64(1 * 1000 * 1000)
 	DefaultMempool1559Enabled      = true
+
+	DefaultBaseFeeTargetGas     = int64(70_000_000)
+	DefaultBaseFeeMaxChangeRate = osmomath.NewDecWithPrec(1, 1)
+	DefaultBaseFeeRecoveryRate  = osmomath.NewDecWithPrec(1, 1)
+
+	// DefaultIBCRelayPriorityBoost is worth a fee of 1 base denom per gas, so that IBC relaying
+	// is prioritized over the arbitrage transactions paying a typical gas price.
+	DefaultIBCRelayPriorityBoost = int64(1_000_000)
+	DefaultMsgTypePriorityBoosts = []MsgTypePriorityBoost{
+		{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", PriorityBoost: DefaultIBCRelayPriorityBoost},
+		{MsgTypeUrl: "/ibc.core.channel.v1.MsgRecvPacket", PriorityBoost: DefaultIBCRelayPriorityBoost},
+		{MsgTypeUrl: "/ibc.core.channel.v1.MsgAcknowledgement", PriorityBoost: DefaultIBCRelayPriorityBoost},
+		{MsgTypeUrl: "/ibc.core.channel.v1.MsgTimeout", PriorityBoost: DefaultIBCRelayPriorityBoost},
+	}
+	// No message type is gas limited by default, governance sets the limits once the heavy messages are identified.
+	DefaultMsgTypeGasLimits []MsgTypeGasLimit
 )
 
 var GlobalMempool1559Enabled = false
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func NewParams(maxGasWantedPerTx uint64, baseFeeTargetGas int64, baseFeeMaxChangeRate, baseFeeRecoveryRate osmomath.Dec, msgTypePriorityBoosts []MsgTypePriorityBoost, msgTypeGasLimits []MsgTypeGasLimit) Params {
+	return Params{
+		MaxGasWantedPerTx:     maxGasWantedPerTx,
+		BaseFeeTargetGas:      baseFeeTargetGas,
+		BaseFeeMaxChangeRate:  baseFeeMaxChangeRate,
+		BaseFeeRecoveryRate:   baseFeeRecoveryRate,
+		MsgTypePriorityBoosts: msgTypePriorityBoosts,
+		MsgTypeGasLimits:      msgTypeGasLimits,
+	}
+}
+
+// default txfees module parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxGasWantedPerTx:     DefaultMaxGasWantedPerTx,
+		BaseFeeTargetGas:      DefaultBaseFeeTargetGas,
+		BaseFeeMaxChangeRate:  DefaultBaseFeeMaxChangeRate,
+		BaseFeeRecoveryRate:   DefaultBaseFeeRecoveryRate,
+		MsgTypePriorityBoosts: DefaultMsgTypePriorityBoosts,
+		MsgTypeGasLimits:      DefaultMsgTypeGasLimits,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateMaxGasWantedPerTx(p.MaxGasWantedPerTx); err != nil {
+		return err
+	}
+	if err := validateBaseFeeTargetGas(p.BaseFeeTargetGas); err != nil {
+		return err
+	}
+	if err := validateBaseFeeMaxChangeRate(p.BaseFeeMaxChangeRate); err != nil {
+		return err
+	}
+	if err := validateBaseFeeRecoveryRate(p.BaseFeeRecoveryRate); err != nil {
+		return err
+	}
+	if err := validateMsgTypePriorityBoosts(p.MsgTypePriorityBoosts); err != nil {
+		return err
+	}
+	if err := validateMsgTypeGasLimits(p.MsgTypeGasLimits); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMaxGasWantedPerTx(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max gas wanted per tx must be positive")
+	}
+
+	return nil
+}
+
+func validateBaseFeeTargetGas(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("base fee target gas must be positive")
+	}
+
+	return nil
+}
+
+func validateBaseFeeMaxChangeRate(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("base fee max change rate must be positive")
+	}
+
+	return nil
+}
+
+// validateBaseFeeRecoveryRate checks that the recovery rate is in (0, 1],
+// so that an empty block can never make the base fee negative.
+func validateBaseFeeRecoveryRate(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(osmomath.OneDec()) {
+		return fmt.Errorf("base fee recovery rate must be in (0, 1], got %s", v)
+	}
+
+	return nil
+}
+
+// validateMsgTypePriorityBoosts checks that the boosted message type URLs are unique and well formed,
+// and that the boosts are positive.
+func validateMsgTypePriorityBoosts(i interface{}) error {
+	v, ok := i.([]MsgTypePriorityBoost)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, boost := range v {
+		if !strings.HasPrefix(boost.MsgTypeUrl, "/") {
+			return fmt.Errorf("msg type url must start with /, got %q", boost.MsgTypeUrl)
+		}
+		if seen[boost.MsgTypeUrl] {
+			return fmt.Errorf("duplicate priority boost for msg type url %s", boost.MsgTypeUrl)
+		}
+		seen[boost.MsgTypeUrl] = true
+		if boost.PriorityBoost <= 0 {
+			return fmt.Errorf("priority boost of msg type url %s must be positive, got %d", boost.MsgTypeUrl, boost.PriorityBoost)
+		}
+	}
+
+	return nil
+}
+
+// validateMsgTypeGasLimits checks that the gas limited message type URLs are unique and well formed,
+// that every entry sets at least one limit, and that a transaction within the per tx limit fits in the per block one.
+func validateMsgTypeGasLimits(i interface{}) error {
+	v, ok := i.([]MsgTypeGasLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, limit := range v {
+		if !strings.HasPrefix(limit.MsgTypeUrl, "/") {
+			return fmt.Errorf("msg type url must start with /, got %q", limit.MsgTypeUrl)
+		}
+		if seen[limit.MsgTypeUrl] {
+			return fmt.Errorf("duplicate gas limit for msg type url %s", limit.MsgTypeUrl)
+		}
+		seen[limit.MsgTypeUrl] = true
+		if limit.MaxGasPerTx == 0 && limit.MaxGasPerBlock == 0 {
+			return fmt.Errorf("gas limit of msg type url %s must set a max gas per tx or per block", limit.MsgTypeUrl)
+		}
+		if limit.MaxGasPerTx != 0 && limit.MaxGasPerBlock != 0 && limit.MaxGasPerTx > limit.MaxGasPerBlock {
+			return fmt.Errorf("max gas per tx %d of msg type url %s exceeds its max gas per block %d", limit.MaxGasPerTx, limit.MsgTypeUrl, limit.MaxGasPerBlock)
+		}
+	}
+
+	return nil
+}
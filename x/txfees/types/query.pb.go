@@ -445,6 +445,86 @@ func (m *QueryEipBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEipBaseFeeResponse proto.InternalMessageInfo
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -456,6 +536,8 @@ func init() {
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
 	proto.RegisterType((*QueryEipBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeRequest")
 	proto.RegisterType((*QueryEipBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.txfees.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.txfees.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -463,51 +545,55 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x4e, 0x13, 0x51,
-	0x14, 0xed, 0xa0, 0xa0, 0x7d, 0x28, 0xea, 0x8b, 0x40, 0x1d, 0xcc, 0x94, 0xbc, 0xa8, 0x21, 0x98,
-	0xce, 0x83, 0xa2, 0xc6, 0xb8, 0xb3, 0xa9, 0x18, 0x13, 0x62, 0x60, 0x34, 0x31, 0x61, 0x33, 0x99,
-	0x69, 0xef, 0x94, 0x09, 0x6d, 0xdf, 0xd0, 0xf7, 0x4a, 0x68, 0x8c, 0x1b, 0xbf, 0xc0, 0xc4, 0xc4,
-	0x0f, 0x60, 0xe3, 0xce, 0xbd, 0x7f, 0xc0, 0x92, 0xc4, 0x8d, 0x71, 0xd1, 0x18, 0xf0, 0x0b, 0xf8,
-	0x02, 0x33, 0x6f, 0xde, 0x74, 0x0a, 0x74, 0x68, 0xd9, 0x75, 0xe6, 0xde, 0x7b, 0xce, 0xb9, 0x73,
-	0xcf, 0x49, 0x11, 0x61, 0xbc, 0xc1, 0xb8, 0xcf, 0xa9, 0xd8, 0xf3, 0x00, 0x38, 0xdd, 0x5d, 0x76,
-	0x41, 0x38, 0xcb, 0x74, 0xa7, 0x0d, 0xad, 0x8e, 0x19, 0xb4, 0x98, 0x60, 0x78, 0x46, 0xf5, 0x98,
-	0x51, 0x8f, 0xa9, 0x7a, 0xf4, 0xbb, 0x35, 0x56, 0x63, 0xb2, 0x85, 0x86, 0xbf, 0xa2, 0x6e, 0xfd,
-	0x7e, 0x8d, 0xb1, 0x5a, 0x1d, 0xa8, 0x13, 0xf8, 0xd4, 0x69, 0x36, 0x99, 0x70, 0x84, 0xcf, 0x9a,
-	0x5c, 0x55, 0x0d, 0x55, 0x95, 0x4f, 0x6e, 0xdb, 0xa3, 0xd5, 0x76, 0x4b, 0x36, 0xa8, 0xfa, 0xc3,
-	0x14, 0x3d, 0x1e, 0x80, 0x60, 0xdb, 0xa0, 0xda, 0xc8, 0x2c, 0x9a, 0xde, 0x08, 0x15, 0xae, 0x02,
-	0xbc, 0x0f, 0x5f, 0x73, 0x0b, 0x76, 0xda, 0xc0, 0x05, 0x11, 0x68, 0xe6, 0x6c, 0x81, 0x07, 0xac,
-	0xc9, 0x01, 0x6f, 0x22, 0xe4, 0x01, 0xd8, 0x12, 0x85, 0xe7, 0xb4, 0xf9, 0x2b, 0x0b, 0x93, 0xc5,
-	0x79, 0x73, 0xf0, 0x6a, 0x66, 0x3c, 0x5e, 0xba, 0x77, 0xd0, 0xcd, 0x67, 0x4e, 0xba, 0xf9, 0x3b,
-	0x1d, 0xa7, 0x51, 0x7f, 0x41, 0x12, 0x04, 0x62, 0x65, 0xbd, 0x98, 0x83, 0x94, 0x91, 0x2e, 0x59,
-	0xcb, 0xd0, 0x64, 0x8d, 0x77, 0x01, 0x13, 0xeb, 0x2d, 0xbf, 0x02, 0x4a, 0x13, 0x7e, 0x84, 0xc6,
-	0xab, 0x61, 0x21, 0xa7, 0xcd, 0x6b, 0x0b, 0xd9, 0xd2, 0xed, 0x93, 0x6e, 0xfe, 0x46, 0x04, 0x27,
-	0x5f, 0x13, 0x2b, 0x2a, 0x93, 0x7d, 0x0d, 0xcd, 0x0d, 0x84, 0x51, 0x1b, 0x2c, 0xa2, 0x89, 0x80,
-	0xb1, 0xfa, 0x9b, 0xb2, 0x04, 0xba, 0x5a, 0xc2, 0x27, 0xdd, 0xfc, 0x54, 0x04, 0x14, 0xbe, 0xb7,
-	0xfd, 0x2a, 0xb1, 0x54, 0x07, 0xfe, 0x80, 0x10, 0x0f, 0x98, 0xb0, 0x83, 0x10, 0x21, 0x37, 0x26,
-	0x89, 0x9f, 0x87, 0xbb, 0xfc, 0xe9, 0xe6, 0xe7, 0x2a, 0x72, 0x6b, 0x5e, 0xdd, 0x36, 0x7d, 0x46,
-	0x1b, 0x8e, 0xd8, 0x32, 0xd7, 0xa0, 0xe6, 0x54, 0x3a, 0x65, 0xa8, 0x24, 0xab, 0x26, 0xe3, 0xc4,
-	0xca, 0xf2, 0x58, 0x0c, 0x79, 0x89, 0x66, 0x13, 0x8d, 0xeb, 0x21, 0x59, 0xf5, 0xb2, 0x7b, 0xae,
-	0xa2, 0xdc, 0x79, 0x88, 0xcb, 0xef, 0xd8, 0x33, 0x41, 0xc9, 0xe1, 0x20, 0xb1, 0x62, 0x13, 0xbc,
-	0x55, 0x26, 0xe8, 0x2b, 0x28, 0xf8, 0x27, 0x08, 0xb9, 0x0e, 0x07, 0xbb, 0x5f, 0xe7, 0x74, 0xb2,
-	0x73, 0x52, 0x23, 0x56, 0xd6, 0x8d, 0xa7, 0x49, 0x4e, 0xe1, 0xbd, 0xf2, 0x83, 0x10, 0x72, 0x15,
-	0xe2, 0xd3, 0x92, 0xba, 0xfa, 0x1a, 0xfd, 0x15, 0x45, 0xb5, 0x81, 0xae, 0x4b, 0x38, 0x0f, 0x40,
-	0x11, 0x3d, 0x1b, 0xed, 0xfb, 0xdf, 0xea, 0xd3, 0xe2, 0x01, 0x10, 0xeb, 0x9a, 0x1b, 0x41, 0x17,
-	0x7f, 0x4e, 0xa0, 0x71, 0x49, 0x87, 0xbf, 0x69, 0x28, 0xdb, 0xb3, 0x38, 0x2e, 0xa4, 0xd9, 0x78,
-	0x60, 0x46, 0x74, 0x73, 0xd4, 0xf6, 0x68, 0x13, 0xb2, 0xf8, 0xf9, 0xd7, 0xbf, 0xaf, 0x63, 0x0f,
-	0x30, 0xa1, 0xe9, 0xe1, 0x54, 0xa9, 0xc0, 0x3f, 0x34, 0x34, 0x75, 0xda, 0xbe, 0xb8, 0x78, 0x21,
-	0xdd, 0xc0, 0xc8, 0xe8, 0x2b, 0x97, 0x9a, 0x51, 0x3a, 0x57, 0xa4, 0xce, 0x02, 0x7e, 0x9c, 0xa6,
-	0x33, 0xb1, 0xb4, 0xed, 0x76, 0xa2, 0x3b, 0xe3, 0xef, 0x1a, 0x9a, 0xec, 0x33, 0x22, 0xa6, 0xc3,
-	0x99, 0x4f, 0xb9, 0x5e, 0x5f, 0x1a, 0x7d, 0x40, 0xe9, 0x7c, 0x2a, 0x75, 0x52, 0x5c, 0x48, 0xd3,
-	0x29, 0x95, 0xd9, 0xca, 0xef, 0xf4, 0xa3, 0x7c, 0xfc, 0x24, 0x6f, 0xde, 0x73, 0xf4, 0x90, 0x9b,
-	0x9f, 0x8d, 0xc4, 0x90, 0x9b, 0x9f, 0x0b, 0xca, 0xf0, 0x9b, 0x27, 0x51, 0xc1, 0xfb, 0x1a, 0xba,
-	0xf9, 0x1a, 0x44, 0x92, 0x01, 0x7c, 0x31, 0xdb, 0xb9, 0x18, 0xe9, 0x74, 0xe4, 0x7e, 0x25, 0x6f,
-	0x49, 0xca, 0x5b, 0xc4, 0x0b, 0x69, 0xf2, 0x2a, 0xed, 0x96, 0x0d, 0x7e, 0x60, 0xc7, 0x29, 0x2a,
-	0xad, 0x1d, 0x1c, 0x19, 0xda, 0xe1, 0x91, 0xa1, 0xfd, 0x3d, 0x32, 0xb4, 0x2f, 0xc7, 0x46, 0xe6,
-	0xf0, 0xd8, 0xc8, 0xfc, 0x3e, 0x36, 0x32, 0x9b, 0xc5, 0x9a, 0x2f, 0xb6, 0xda, 0xae, 0x59, 0x61,
-	0x8d, 0x18, 0xad, 0x50, 0x77, 0x5c, 0xde, 0x83, 0xde, 0x2d, 0x2e, 0xd3, 0xbd, 0x98, 0x40, 0x74,
-	0x02, 0xe0, 0xee, 0x84, 0xfc, 0x1b, 0x5a, 0xf9, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x6c, 0x42,
-	0x75, 0x3f, 0x07, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0xf9, 0x20, 0x7c, 0x19, 0xbe, 0x8f, 0xb6, 0x53, 0x7e, 0x52, 0x53, 0x39, 0xd1, 0x88,
+	0x22, 0x14, 0x14, 0x1b, 0x42, 0x5b, 0x55, 0x55, 0x37, 0x8d, 0x52, 0xaa, 0x4a, 0xa8, 0x02, 0x53,
+	0xa9, 0x12, 0x1b, 0xcb, 0x4e, 0x6e, 0x82, 0x45, 0x92, 0x31, 0x99, 0x09, 0x22, 0xaa, 0xba, 0xe9,
+	0xae, 0xbb, 0x4a, 0x95, 0xfa, 0x00, 0x6c, 0xba, 0xeb, 0x5b, 0x54, 0x62, 0x89, 0xd4, 0x4d, 0xd5,
+	0x45, 0x54, 0x41, 0x9f, 0x80, 0x27, 0xa8, 0x3c, 0x1e, 0xc7, 0x04, 0x62, 0x08, 0xbb, 0x78, 0xee,
+	0xb9, 0xe7, 0x9c, 0xeb, 0xb9, 0x27, 0x46, 0x84, 0xb2, 0x06, 0x65, 0x2e, 0x33, 0xf8, 0x41, 0x15,
+	0x80, 0x19, 0xfb, 0x2b, 0x0e, 0x70, 0x7b, 0xc5, 0xd8, 0x6b, 0x43, 0xab, 0xa3, 0x7b, 0x2d, 0xca,
+	0x29, 0x9e, 0x91, 0x18, 0x3d, 0xc0, 0xe8, 0x12, 0xa3, 0x4e, 0xd5, 0x68, 0x8d, 0x0a, 0x88, 0xe1,
+	0xff, 0x0a, 0xd0, 0xea, 0xfd, 0x1a, 0xa5, 0xb5, 0x3a, 0x18, 0xb6, 0xe7, 0x1a, 0x76, 0xb3, 0x49,
+	0xb9, 0xcd, 0x5d, 0xda, 0x64, 0xb2, 0xaa, 0xc9, 0xaa, 0x78, 0x72, 0xda, 0x55, 0xa3, 0xd2, 0x6e,
+	0x09, 0x80, 0xac, 0x3f, 0x88, 0xf1, 0x53, 0x05, 0xe0, 0x74, 0x17, 0x42, 0xd8, 0x7c, 0x0c, 0xac,
+	0x06, 0x4d, 0xf0, 0x9d, 0x0a, 0x14, 0x99, 0x45, 0xd3, 0x9b, 0xfe, 0x1c, 0x6b, 0x00, 0x6f, 0xfc,
+	0x66, 0x66, 0xc2, 0x5e, 0x1b, 0x18, 0x27, 0x1c, 0xcd, 0x5c, 0x2c, 0x30, 0x8f, 0x36, 0x19, 0xe0,
+	0x6d, 0x84, 0xaa, 0x00, 0x96, 0xd0, 0x62, 0x69, 0x25, 0xfb, 0xcf, 0xe2, 0x44, 0x21, 0xab, 0x0f,
+	0x7e, 0x01, 0x7a, 0xd8, 0x5e, 0xbc, 0x77, 0xd4, 0xcd, 0x24, 0xce, 0xba, 0x99, 0x3b, 0x1d, 0xbb,
+	0x51, 0x7f, 0x4a, 0x22, 0x06, 0x62, 0xa6, 0xaa, 0xa1, 0x06, 0x29, 0x21, 0x55, 0xa8, 0x96, 0xa0,
+	0x49, 0x1b, 0x5b, 0x1e, 0xe5, 0x1b, 0x2d, 0xb7, 0x0c, 0xd2, 0x13, 0x5e, 0x40, 0x63, 0x15, 0xbf,
+	0x90, 0x56, 0xb2, 0xca, 0x62, 0xaa, 0x78, 0xfb, 0xac, 0x9b, 0xf9, 0x2f, 0xa0, 0x13, 0xc7, 0xc4,
+	0x0c, 0xca, 0xe4, 0x50, 0x41, 0x73, 0x03, 0x69, 0xe4, 0x04, 0x39, 0x94, 0xf4, 0x28, 0xad, 0xbf,
+	0x2a, 0x09, 0xa2, 0xd1, 0x22, 0x3e, 0xeb, 0x66, 0x26, 0x03, 0x22, 0xff, 0xdc, 0x72, 0x2b, 0xc4,
+	0x94, 0x08, 0xfc, 0x16, 0x21, 0xe6, 0x51, 0x6e, 0x79, 0x3e, 0x43, 0x7a, 0x44, 0x08, 0x3f, 0xf1,
+	0x67, 0xf9, 0xd5, 0xcd, 0xcc, 0x95, 0xc5, 0xd4, 0xac, 0xb2, 0xab, 0xbb, 0xd4, 0x68, 0xd8, 0x7c,
+	0x47, 0x5f, 0x87, 0x9a, 0x5d, 0xee, 0x94, 0xa0, 0x1c, 0x8d, 0x1a, 0xb5, 0x13, 0x33, 0xc5, 0x42,
+	0x33, 0xe4, 0x39, 0x9a, 0x8d, 0x3c, 0x6e, 0xf8, 0x62, 0x95, 0x9b, 0xce, 0xb9, 0x86, 0xd2, 0x97,
+	0x29, 0x6e, 0x3e, 0x63, 0x6f, 0x09, 0x8a, 0x36, 0x03, 0xc1, 0x15, 0x2e, 0xc1, 0x6b, 0x34, 0x73,
+	0xb1, 0x20, 0xe9, 0x1f, 0x22, 0xe4, 0xd8, 0x0c, 0xac, 0xf3, 0x3e, 0xa7, 0xa3, 0x99, 0xa3, 0x1a,
+	0x31, 0x53, 0x4e, 0xd8, 0x4d, 0xd2, 0x92, 0xef, 0x85, 0xeb, 0xf9, 0x94, 0x6b, 0x10, 0x5e, 0x2d,
+	0xa9, 0xa3, 0xd9, 0x4b, 0x15, 0x29, 0xb5, 0x89, 0xfe, 0x15, 0x74, 0x55, 0x00, 0x29, 0xf4, 0x78,
+	0xb8, 0xf7, 0x7f, 0xeb, 0x9c, 0x97, 0x2a, 0x00, 0x31, 0xc7, 0x9d, 0x80, 0x9a, 0x4c, 0x21, 0x2c,
+	0xd4, 0x36, 0xec, 0x96, 0xdd, 0xe8, 0xad, 0xfc, 0x16, 0xba, 0xdb, 0x77, 0x2a, 0xf5, 0x9f, 0xa1,
+	0xa4, 0x27, 0x4e, 0x84, 0xfa, 0x44, 0x41, 0x8b, 0xdb, 0xf5, 0xa0, 0xaf, 0x38, 0xea, 0xbb, 0x33,
+	0x65, 0x4f, 0xe1, 0xfb, 0x38, 0x1a, 0x13, 0xac, 0xf8, 0x8b, 0x82, 0x52, 0xbd, 0x34, 0xe1, 0x7c,
+	0x1c, 0xcb, 0xc0, 0x38, 0xaa, 0xfa, 0xb0, 0xf0, 0xc0, 0x34, 0xc9, 0x7d, 0xf8, 0xf1, 0xe7, 0xf3,
+	0xc8, 0x3c, 0x26, 0x46, 0xfc, 0xbf, 0x85, 0x0c, 0x20, 0xfe, 0xa6, 0xa0, 0xc9, 0xfe, 0xa4, 0xe0,
+	0xc2, 0x95, 0x72, 0x03, 0xd3, 0xa9, 0xae, 0xde, 0xa8, 0x47, 0xfa, 0x5c, 0x15, 0x3e, 0xf3, 0x78,
+	0x29, 0xce, 0x67, 0x94, 0x1e, 0xcb, 0xe9, 0x04, 0x2b, 0x85, 0xbf, 0x2a, 0x68, 0xe2, 0xdc, 0xce,
+	0x63, 0xe3, 0x7a, 0xe5, 0xbe, 0x80, 0xa9, 0xcb, 0xc3, 0x37, 0x48, 0x9f, 0x8f, 0x84, 0x4f, 0x03,
+	0xe7, 0xe3, 0x7c, 0x0a, 0x67, 0x96, 0x8c, 0x96, 0xf1, 0x4e, 0x3c, 0xbe, 0x17, 0x77, 0xde, 0x0b,
+	0xcf, 0x35, 0x77, 0x7e, 0x31, 0x7d, 0xaa, 0x3e, 0x2c, 0x7c, 0xd8, 0x3b, 0x8f, 0x52, 0x89, 0x0f,
+	0x15, 0xf4, 0xff, 0x4b, 0xe0, 0x51, 0xdc, 0xf0, 0xd5, 0x6a, 0x97, 0x12, 0xab, 0x1a, 0x43, 0xe3,
+	0xa5, 0xbd, 0x65, 0x61, 0x2f, 0x87, 0x17, 0xe3, 0xec, 0x95, 0xdb, 0x2d, 0x0b, 0x5c, 0xcf, 0x0a,
+	0x03, 0x8b, 0x3f, 0x2a, 0x28, 0x19, 0x84, 0x0a, 0xe7, 0xae, 0x54, 0xeb, 0xcb, 0xb1, 0xba, 0x34,
+	0x14, 0x56, 0xba, 0x5a, 0x10, 0xae, 0xb2, 0x58, 0x8b, 0x73, 0x15, 0xe4, 0xb8, 0xb8, 0x7e, 0x74,
+	0xa2, 0x29, 0xc7, 0x27, 0x9a, 0xf2, 0xfb, 0x44, 0x53, 0x3e, 0x9d, 0x6a, 0x89, 0xe3, 0x53, 0x2d,
+	0xf1, 0xf3, 0x54, 0x4b, 0x6c, 0x17, 0x6a, 0x2e, 0xdf, 0x69, 0x3b, 0x7a, 0x99, 0x36, 0x42, 0x8e,
+	0x7c, 0xdd, 0x76, 0x58, 0x8f, 0x70, 0xbf, 0xb0, 0x62, 0x1c, 0x84, 0xb4, 0xbc, 0xe3, 0x01, 0x73,
+	0x92, 0xe2, 0xeb, 0xbb, 0xfa, 0x77, 0x00, 0x20, 0xa1, 0x86, 0x05, 0x5c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -534,6 +620,8 @@ type QueryClient interface {
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeResponse, error)
+	// Params returns the governance controlled parameters of the txfees module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -589,6 +677,15 @@ func (c *queryClient) GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequ
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -603,6 +700,8 @@ type QueryServer interface {
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(context.Context, *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error)
+	// Params returns the governance controlled parameters of the txfees module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -624,6 +723,9 @@ func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDe
 func (*UnimplementedQueryServer) GetEipBaseFee(ctx context.Context, req *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEipBaseFee not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -719,6 +821,24 @@ func _Query_GetEipBaseFee_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -743,6 +863,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEipBaseFee",
 			Handler:    _Query_GetEipBaseFee_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -1043,6 +1167,62 @@ func (m *QueryEipBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1172,6 +1352,26 @@ func (m *QueryEipBaseFeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1914,6 +2114,139 @@ func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0